@@ -24,17 +24,53 @@ const _ = grpc.SupportPackageIsVersion7
 type ExamServiceClient interface {
 	CreateExam(ctx context.Context, in *CreateExamRequest, opts ...grpc.CallOption) (*CreateExamResponse, error)
 	UpdateExam(ctx context.Context, in *UpdateExamRequest, opts ...grpc.CallOption) (*UpdateExamResponse, error)
+	GetExam(ctx context.Context, in *GetExamRequest, opts ...grpc.CallOption) (*GetExamResponse, error)
 	FindExams(ctx context.Context, in *FindExamsRequest, opts ...grpc.CallOption) (*FindExamsResponse, error)
 	DeleteExam(ctx context.Context, in *DeleteExamRequest, opts ...grpc.CallOption) (*DeleteExamResponse, error)
+	CreatePracticeExam(ctx context.Context, in *CreatePracticeExamRequest, opts ...grpc.CallOption) (*CreatePracticeExamResponse, error)
+	FindExamsByTags(ctx context.Context, in *FindExamsByTagsRequest, opts ...grpc.CallOption) (*FindExamsByTagsResponse, error)
+	FindExamTagCounts(ctx context.Context, in *FindExamTagCountsRequest, opts ...grpc.CallOption) (*FindExamTagCountsResponse, error)
+	ForkExam(ctx context.Context, in *ForkExamRequest, opts ...grpc.CallOption) (*ForkExamResponse, error)
+	PublishExam(ctx context.Context, in *PublishExamRequest, opts ...grpc.CallOption) (*PublishExamResponse, error)
+	ShareExam(ctx context.Context, in *ShareExamRequest, opts ...grpc.CallOption) (*ShareExamResponse, error)
+	UnshareExam(ctx context.Context, in *UnshareExamRequest, opts ...grpc.CallOption) (*UnshareExamResponse, error)
+	FindExamShares(ctx context.Context, in *FindExamSharesRequest, opts ...grpc.CallOption) (*FindExamSharesResponse, error)
+	ImportExam(ctx context.Context, in *ImportExamRequest, opts ...grpc.CallOption) (*ImportExamResponse, error)
+	ExportExam(ctx context.Context, in *ExportExamRequest, opts ...grpc.CallOption) (ExamService_ExportExamClient, error)
 	CreateQuestion(ctx context.Context, in *CreateQuestionRequest, opts ...grpc.CallOption) (*CreateQuestionResponse, error)
 	UpdateQuestion(ctx context.Context, in *UpdateQuestionRequest, opts ...grpc.CallOption) (*UpdateQuestionResponse, error)
+	GetQuestion(ctx context.Context, in *GetQuestionRequest, opts ...grpc.CallOption) (*GetQuestionResponse, error)
 	FindQuestions(ctx context.Context, in *FindQuestionsRequest, opts ...grpc.CallOption) (*FindQuestionsResponse, error)
 	DeleteQuestion(ctx context.Context, in *DeleteQuestionRequest, opts ...grpc.CallOption) (*DeleteQuestionResponse, error)
+	BatchMutateQuestions(ctx context.Context, in *BatchMutateQuestionsRequest, opts ...grpc.CallOption) (*BatchMutateQuestionsResponse, error)
 	FindRandomQuestions(ctx context.Context, in *FindRandomQuestionsRequest, opts ...grpc.CallOption) (*FindRandomQuestionsResponse, error)
+	RevealQuestionHint(ctx context.Context, in *RevealQuestionHintRequest, opts ...grpc.CallOption) (*RevealQuestionHintResponse, error)
+	CreateBankQuestion(ctx context.Context, in *CreateBankQuestionRequest, opts ...grpc.CallOption) (*CreateBankQuestionResponse, error)
+	UpdateBankQuestion(ctx context.Context, in *UpdateBankQuestionRequest, opts ...grpc.CallOption) (*UpdateBankQuestionResponse, error)
+	DeleteBankQuestion(ctx context.Context, in *DeleteBankQuestionRequest, opts ...grpc.CallOption) (*DeleteBankQuestionResponse, error)
+	FindBankQuestions(ctx context.Context, in *FindBankQuestionsRequest, opts ...grpc.CallOption) (*FindBankQuestionsResponse, error)
+	SetExamBankSource(ctx context.Context, in *SetExamBankSourceRequest, opts ...grpc.CallOption) (*SetExamBankSourceResponse, error)
+	CreateExamSection(ctx context.Context, in *CreateExamSectionRequest, opts ...grpc.CallOption) (*CreateExamSectionResponse, error)
+	UpdateExamSection(ctx context.Context, in *UpdateExamSectionRequest, opts ...grpc.CallOption) (*UpdateExamSectionResponse, error)
+	DeleteExamSection(ctx context.Context, in *DeleteExamSectionRequest, opts ...grpc.CallOption) (*DeleteExamSectionResponse, error)
+	FindExamSections(ctx context.Context, in *FindExamSectionsRequest, opts ...grpc.CallOption) (*FindExamSectionsResponse, error)
 	CreateExamRecord(ctx context.Context, in *CreateExamRecordRequest, opts ...grpc.CallOption) (*CreateExamRecordResponse, error)
 	FindExamRecords(ctx context.Context, in *FindExamRecordsRequest, opts ...grpc.CallOption) (*FindExamRecordsResponse, error)
+	GetExamRecordDetail(ctx context.Context, in *GetExamRecordDetailRequest, opts ...grpc.CallOption) (*GetExamRecordDetailResponse, error)
 	FindExamRecordOverview(ctx context.Context, in *FindExamRecordOverviewRequest, opts ...grpc.CallOption) (*FindExamRecordOverviewResponse, error)
+	FindExamLeaderboard(ctx context.Context, in *FindExamLeaderboardRequest, opts ...grpc.CallOption) (*FindExamLeaderboardResponse, error)
+	FindQuestionStatistics(ctx context.Context, in *FindQuestionStatisticsRequest, opts ...grpc.CallOption) (*FindQuestionStatisticsResponse, error)
+	FindMistakeNotebook(ctx context.Context, in *FindMistakeNotebookRequest, opts ...grpc.CallOption) (*FindMistakeNotebookResponse, error)
+	CreateMistakeDrill(ctx context.Context, in *CreateMistakeDrillRequest, opts ...grpc.CallOption) (*CreateMistakeDrillResponse, error)
+	AnswerMistakeDrill(ctx context.Context, in *AnswerMistakeDrillRequest, opts ...grpc.CallOption) (*AnswerMistakeDrillResponse, error)
 	FindExamInfos(ctx context.Context, in *FindExamInfosRequest, opts ...grpc.CallOption) (*FindExamInfosResponse, error)
+	FindExamCatalog(ctx context.Context, in *FindExamCatalogRequest, opts ...grpc.CallOption) (*FindExamCatalogResponse, error)
+	CreateClass(ctx context.Context, in *CreateClassRequest, opts ...grpc.CallOption) (*CreateClassResponse, error)
+	JoinClass(ctx context.Context, in *JoinClassRequest, opts ...grpc.CallOption) (*JoinClassResponse, error)
+	FindClasses(ctx context.Context, in *FindClassesRequest, opts ...grpc.CallOption) (*FindClassesResponse, error)
+	CreateAssignment(ctx context.Context, in *CreateAssignmentRequest, opts ...grpc.CallOption) (*CreateAssignmentResponse, error)
+	FindAssignments(ctx context.Context, in *FindAssignmentsRequest, opts ...grpc.CallOption) (*FindAssignmentsResponse, error)
+	FindClassGradebook(ctx context.Context, in *FindClassGradebookRequest, opts ...grpc.CallOption) (*FindClassGradebookResponse, error)
 }
 
 type examServiceClient struct {
@@ -63,6 +99,15 @@ func (c *examServiceClient) UpdateExam(ctx context.Context, in *UpdateExamReques
 	return out, nil
 }
 
+func (c *examServiceClient) GetExam(ctx context.Context, in *GetExamRequest, opts ...grpc.CallOption) (*GetExamResponse, error) {
+	out := new(GetExamResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/GetExam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *examServiceClient) FindExams(ctx context.Context, in *FindExamsRequest, opts ...grpc.CallOption) (*FindExamsResponse, error) {
 	out := new(FindExamsResponse)
 	err := c.cc.Invoke(ctx, "/pb.ExamService/FindExams", in, out, opts...)
@@ -81,6 +126,119 @@ func (c *examServiceClient) DeleteExam(ctx context.Context, in *DeleteExamReques
 	return out, nil
 }
 
+func (c *examServiceClient) CreatePracticeExam(ctx context.Context, in *CreatePracticeExamRequest, opts ...grpc.CallOption) (*CreatePracticeExamResponse, error) {
+	out := new(CreatePracticeExamResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/CreatePracticeExam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) FindExamsByTags(ctx context.Context, in *FindExamsByTagsRequest, opts ...grpc.CallOption) (*FindExamsByTagsResponse, error) {
+	out := new(FindExamsByTagsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/FindExamsByTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) FindExamTagCounts(ctx context.Context, in *FindExamTagCountsRequest, opts ...grpc.CallOption) (*FindExamTagCountsResponse, error) {
+	out := new(FindExamTagCountsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/FindExamTagCounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) ForkExam(ctx context.Context, in *ForkExamRequest, opts ...grpc.CallOption) (*ForkExamResponse, error) {
+	out := new(ForkExamResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/ForkExam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) PublishExam(ctx context.Context, in *PublishExamRequest, opts ...grpc.CallOption) (*PublishExamResponse, error) {
+	out := new(PublishExamResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/PublishExam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) ShareExam(ctx context.Context, in *ShareExamRequest, opts ...grpc.CallOption) (*ShareExamResponse, error) {
+	out := new(ShareExamResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/ShareExam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) UnshareExam(ctx context.Context, in *UnshareExamRequest, opts ...grpc.CallOption) (*UnshareExamResponse, error) {
+	out := new(UnshareExamResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/UnshareExam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) FindExamShares(ctx context.Context, in *FindExamSharesRequest, opts ...grpc.CallOption) (*FindExamSharesResponse, error) {
+	out := new(FindExamSharesResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/FindExamShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) ImportExam(ctx context.Context, in *ImportExamRequest, opts ...grpc.CallOption) (*ImportExamResponse, error) {
+	out := new(ImportExamResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/ImportExam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) ExportExam(ctx context.Context, in *ExportExamRequest, opts ...grpc.CallOption) (ExamService_ExportExamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExamService_ServiceDesc.Streams[0], "/pb.ExamService/ExportExam", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &examServiceExportExamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExamService_ExportExamClient interface {
+	Recv() (*ExportExamResponse, error)
+	grpc.ClientStream
+}
+
+type examServiceExportExamClient struct {
+	grpc.ClientStream
+}
+
+func (x *examServiceExportExamClient) Recv() (*ExportExamResponse, error) {
+	m := new(ExportExamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *examServiceClient) CreateQuestion(ctx context.Context, in *CreateQuestionRequest, opts ...grpc.CallOption) (*CreateQuestionResponse, error) {
 	out := new(CreateQuestionResponse)
 	err := c.cc.Invoke(ctx, "/pb.ExamService/CreateQuestion", in, out, opts...)
@@ -99,6 +257,15 @@ func (c *examServiceClient) UpdateQuestion(ctx context.Context, in *UpdateQuesti
 	return out, nil
 }
 
+func (c *examServiceClient) GetQuestion(ctx context.Context, in *GetQuestionRequest, opts ...grpc.CallOption) (*GetQuestionResponse, error) {
+	out := new(GetQuestionResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/GetQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *examServiceClient) FindQuestions(ctx context.Context, in *FindQuestionsRequest, opts ...grpc.CallOption) (*FindQuestionsResponse, error) {
 	out := new(FindQuestionsResponse)
 	err := c.cc.Invoke(ctx, "/pb.ExamService/FindQuestions", in, out, opts...)
@@ -117,6 +284,15 @@ func (c *examServiceClient) DeleteQuestion(ctx context.Context, in *DeleteQuesti
 	return out, nil
 }
 
+func (c *examServiceClient) BatchMutateQuestions(ctx context.Context, in *BatchMutateQuestionsRequest, opts ...grpc.CallOption) (*BatchMutateQuestionsResponse, error) {
+	out := new(BatchMutateQuestionsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/BatchMutateQuestions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *examServiceClient) FindRandomQuestions(ctx context.Context, in *FindRandomQuestionsRequest, opts ...grpc.CallOption) (*FindRandomQuestionsResponse, error) {
 	out := new(FindRandomQuestionsResponse)
 	err := c.cc.Invoke(ctx, "/pb.ExamService/FindRandomQuestions", in, out, opts...)
@@ -126,6 +302,96 @@ func (c *examServiceClient) FindRandomQuestions(ctx context.Context, in *FindRan
 	return out, nil
 }
 
+func (c *examServiceClient) RevealQuestionHint(ctx context.Context, in *RevealQuestionHintRequest, opts ...grpc.CallOption) (*RevealQuestionHintResponse, error) {
+	out := new(RevealQuestionHintResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/RevealQuestionHint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) CreateBankQuestion(ctx context.Context, in *CreateBankQuestionRequest, opts ...grpc.CallOption) (*CreateBankQuestionResponse, error) {
+	out := new(CreateBankQuestionResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/CreateBankQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) UpdateBankQuestion(ctx context.Context, in *UpdateBankQuestionRequest, opts ...grpc.CallOption) (*UpdateBankQuestionResponse, error) {
+	out := new(UpdateBankQuestionResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/UpdateBankQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) DeleteBankQuestion(ctx context.Context, in *DeleteBankQuestionRequest, opts ...grpc.CallOption) (*DeleteBankQuestionResponse, error) {
+	out := new(DeleteBankQuestionResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/DeleteBankQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) FindBankQuestions(ctx context.Context, in *FindBankQuestionsRequest, opts ...grpc.CallOption) (*FindBankQuestionsResponse, error) {
+	out := new(FindBankQuestionsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/FindBankQuestions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) SetExamBankSource(ctx context.Context, in *SetExamBankSourceRequest, opts ...grpc.CallOption) (*SetExamBankSourceResponse, error) {
+	out := new(SetExamBankSourceResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/SetExamBankSource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) CreateExamSection(ctx context.Context, in *CreateExamSectionRequest, opts ...grpc.CallOption) (*CreateExamSectionResponse, error) {
+	out := new(CreateExamSectionResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/CreateExamSection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) UpdateExamSection(ctx context.Context, in *UpdateExamSectionRequest, opts ...grpc.CallOption) (*UpdateExamSectionResponse, error) {
+	out := new(UpdateExamSectionResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/UpdateExamSection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) DeleteExamSection(ctx context.Context, in *DeleteExamSectionRequest, opts ...grpc.CallOption) (*DeleteExamSectionResponse, error) {
+	out := new(DeleteExamSectionResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/DeleteExamSection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) FindExamSections(ctx context.Context, in *FindExamSectionsRequest, opts ...grpc.CallOption) (*FindExamSectionsResponse, error) {
+	out := new(FindExamSectionsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/FindExamSections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *examServiceClient) CreateExamRecord(ctx context.Context, in *CreateExamRecordRequest, opts ...grpc.CallOption) (*CreateExamRecordResponse, error) {
 	out := new(CreateExamRecordResponse)
 	err := c.cc.Invoke(ctx, "/pb.ExamService/CreateExamRecord", in, out, opts...)
@@ -141,333 +407,1245 @@ func (c *examServiceClient) FindExamRecords(ctx context.Context, in *FindExamRec
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *examServiceClient) GetExamRecordDetail(ctx context.Context, in *GetExamRecordDetailRequest, opts ...grpc.CallOption) (*GetExamRecordDetailResponse, error) {
+	out := new(GetExamRecordDetailResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/GetExamRecordDetail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) FindExamRecordOverview(ctx context.Context, in *FindExamRecordOverviewRequest, opts ...grpc.CallOption) (*FindExamRecordOverviewResponse, error) {
+	out := new(FindExamRecordOverviewResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/FindExamRecordOverview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) FindExamLeaderboard(ctx context.Context, in *FindExamLeaderboardRequest, opts ...grpc.CallOption) (*FindExamLeaderboardResponse, error) {
+	out := new(FindExamLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/FindExamLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) FindQuestionStatistics(ctx context.Context, in *FindQuestionStatisticsRequest, opts ...grpc.CallOption) (*FindQuestionStatisticsResponse, error) {
+	out := new(FindQuestionStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/FindQuestionStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) FindMistakeNotebook(ctx context.Context, in *FindMistakeNotebookRequest, opts ...grpc.CallOption) (*FindMistakeNotebookResponse, error) {
+	out := new(FindMistakeNotebookResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/FindMistakeNotebook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) CreateMistakeDrill(ctx context.Context, in *CreateMistakeDrillRequest, opts ...grpc.CallOption) (*CreateMistakeDrillResponse, error) {
+	out := new(CreateMistakeDrillResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/CreateMistakeDrill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) AnswerMistakeDrill(ctx context.Context, in *AnswerMistakeDrillRequest, opts ...grpc.CallOption) (*AnswerMistakeDrillResponse, error) {
+	out := new(AnswerMistakeDrillResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/AnswerMistakeDrill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) FindExamInfos(ctx context.Context, in *FindExamInfosRequest, opts ...grpc.CallOption) (*FindExamInfosResponse, error) {
+	out := new(FindExamInfosResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/FindExamInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) FindExamCatalog(ctx context.Context, in *FindExamCatalogRequest, opts ...grpc.CallOption) (*FindExamCatalogResponse, error) {
+	out := new(FindExamCatalogResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/FindExamCatalog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) CreateClass(ctx context.Context, in *CreateClassRequest, opts ...grpc.CallOption) (*CreateClassResponse, error) {
+	out := new(CreateClassResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/CreateClass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) JoinClass(ctx context.Context, in *JoinClassRequest, opts ...grpc.CallOption) (*JoinClassResponse, error) {
+	out := new(JoinClassResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/JoinClass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) FindClasses(ctx context.Context, in *FindClassesRequest, opts ...grpc.CallOption) (*FindClassesResponse, error) {
+	out := new(FindClassesResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/FindClasses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) CreateAssignment(ctx context.Context, in *CreateAssignmentRequest, opts ...grpc.CallOption) (*CreateAssignmentResponse, error) {
+	out := new(CreateAssignmentResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/CreateAssignment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) FindAssignments(ctx context.Context, in *FindAssignmentsRequest, opts ...grpc.CallOption) (*FindAssignmentsResponse, error) {
+	out := new(FindAssignmentsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/FindAssignments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examServiceClient) FindClassGradebook(ctx context.Context, in *FindClassGradebookRequest, opts ...grpc.CallOption) (*FindClassGradebookResponse, error) {
+	out := new(FindClassGradebookResponse)
+	err := c.cc.Invoke(ctx, "/pb.ExamService/FindClassGradebook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExamServiceServer is the server API for ExamService service.
+// All implementations must embed UnimplementedExamServiceServer
+// for forward compatibility
+type ExamServiceServer interface {
+	CreateExam(context.Context, *CreateExamRequest) (*CreateExamResponse, error)
+	UpdateExam(context.Context, *UpdateExamRequest) (*UpdateExamResponse, error)
+	GetExam(context.Context, *GetExamRequest) (*GetExamResponse, error)
+	FindExams(context.Context, *FindExamsRequest) (*FindExamsResponse, error)
+	DeleteExam(context.Context, *DeleteExamRequest) (*DeleteExamResponse, error)
+	CreatePracticeExam(context.Context, *CreatePracticeExamRequest) (*CreatePracticeExamResponse, error)
+	FindExamsByTags(context.Context, *FindExamsByTagsRequest) (*FindExamsByTagsResponse, error)
+	FindExamTagCounts(context.Context, *FindExamTagCountsRequest) (*FindExamTagCountsResponse, error)
+	ForkExam(context.Context, *ForkExamRequest) (*ForkExamResponse, error)
+	PublishExam(context.Context, *PublishExamRequest) (*PublishExamResponse, error)
+	ShareExam(context.Context, *ShareExamRequest) (*ShareExamResponse, error)
+	UnshareExam(context.Context, *UnshareExamRequest) (*UnshareExamResponse, error)
+	FindExamShares(context.Context, *FindExamSharesRequest) (*FindExamSharesResponse, error)
+	ImportExam(context.Context, *ImportExamRequest) (*ImportExamResponse, error)
+	ExportExam(*ExportExamRequest, ExamService_ExportExamServer) error
+	CreateQuestion(context.Context, *CreateQuestionRequest) (*CreateQuestionResponse, error)
+	UpdateQuestion(context.Context, *UpdateQuestionRequest) (*UpdateQuestionResponse, error)
+	GetQuestion(context.Context, *GetQuestionRequest) (*GetQuestionResponse, error)
+	FindQuestions(context.Context, *FindQuestionsRequest) (*FindQuestionsResponse, error)
+	DeleteQuestion(context.Context, *DeleteQuestionRequest) (*DeleteQuestionResponse, error)
+	BatchMutateQuestions(context.Context, *BatchMutateQuestionsRequest) (*BatchMutateQuestionsResponse, error)
+	FindRandomQuestions(context.Context, *FindRandomQuestionsRequest) (*FindRandomQuestionsResponse, error)
+	RevealQuestionHint(context.Context, *RevealQuestionHintRequest) (*RevealQuestionHintResponse, error)
+	CreateBankQuestion(context.Context, *CreateBankQuestionRequest) (*CreateBankQuestionResponse, error)
+	UpdateBankQuestion(context.Context, *UpdateBankQuestionRequest) (*UpdateBankQuestionResponse, error)
+	DeleteBankQuestion(context.Context, *DeleteBankQuestionRequest) (*DeleteBankQuestionResponse, error)
+	FindBankQuestions(context.Context, *FindBankQuestionsRequest) (*FindBankQuestionsResponse, error)
+	SetExamBankSource(context.Context, *SetExamBankSourceRequest) (*SetExamBankSourceResponse, error)
+	CreateExamSection(context.Context, *CreateExamSectionRequest) (*CreateExamSectionResponse, error)
+	UpdateExamSection(context.Context, *UpdateExamSectionRequest) (*UpdateExamSectionResponse, error)
+	DeleteExamSection(context.Context, *DeleteExamSectionRequest) (*DeleteExamSectionResponse, error)
+	FindExamSections(context.Context, *FindExamSectionsRequest) (*FindExamSectionsResponse, error)
+	CreateExamRecord(context.Context, *CreateExamRecordRequest) (*CreateExamRecordResponse, error)
+	FindExamRecords(context.Context, *FindExamRecordsRequest) (*FindExamRecordsResponse, error)
+	GetExamRecordDetail(context.Context, *GetExamRecordDetailRequest) (*GetExamRecordDetailResponse, error)
+	FindExamRecordOverview(context.Context, *FindExamRecordOverviewRequest) (*FindExamRecordOverviewResponse, error)
+	FindExamLeaderboard(context.Context, *FindExamLeaderboardRequest) (*FindExamLeaderboardResponse, error)
+	FindQuestionStatistics(context.Context, *FindQuestionStatisticsRequest) (*FindQuestionStatisticsResponse, error)
+	FindMistakeNotebook(context.Context, *FindMistakeNotebookRequest) (*FindMistakeNotebookResponse, error)
+	CreateMistakeDrill(context.Context, *CreateMistakeDrillRequest) (*CreateMistakeDrillResponse, error)
+	AnswerMistakeDrill(context.Context, *AnswerMistakeDrillRequest) (*AnswerMistakeDrillResponse, error)
+	FindExamInfos(context.Context, *FindExamInfosRequest) (*FindExamInfosResponse, error)
+	FindExamCatalog(context.Context, *FindExamCatalogRequest) (*FindExamCatalogResponse, error)
+	CreateClass(context.Context, *CreateClassRequest) (*CreateClassResponse, error)
+	JoinClass(context.Context, *JoinClassRequest) (*JoinClassResponse, error)
+	FindClasses(context.Context, *FindClassesRequest) (*FindClassesResponse, error)
+	CreateAssignment(context.Context, *CreateAssignmentRequest) (*CreateAssignmentResponse, error)
+	FindAssignments(context.Context, *FindAssignmentsRequest) (*FindAssignmentsResponse, error)
+	FindClassGradebook(context.Context, *FindClassGradebookRequest) (*FindClassGradebookResponse, error)
+	mustEmbedUnimplementedExamServiceServer()
+}
+
+// UnimplementedExamServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedExamServiceServer struct {
+}
+
+func (UnimplementedExamServiceServer) CreateExam(context.Context, *CreateExamRequest) (*CreateExamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExam not implemented")
+}
+func (UnimplementedExamServiceServer) UpdateExam(context.Context, *UpdateExamRequest) (*UpdateExamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExam not implemented")
+}
+func (UnimplementedExamServiceServer) GetExam(context.Context, *GetExamRequest) (*GetExamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExam not implemented")
+}
+func (UnimplementedExamServiceServer) FindExams(context.Context, *FindExamsRequest) (*FindExamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindExams not implemented")
+}
+func (UnimplementedExamServiceServer) DeleteExam(context.Context, *DeleteExamRequest) (*DeleteExamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExam not implemented")
+}
+func (UnimplementedExamServiceServer) CreatePracticeExam(context.Context, *CreatePracticeExamRequest) (*CreatePracticeExamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePracticeExam not implemented")
+}
+func (UnimplementedExamServiceServer) FindExamsByTags(context.Context, *FindExamsByTagsRequest) (*FindExamsByTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindExamsByTags not implemented")
+}
+func (UnimplementedExamServiceServer) FindExamTagCounts(context.Context, *FindExamTagCountsRequest) (*FindExamTagCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindExamTagCounts not implemented")
+}
+func (UnimplementedExamServiceServer) ForkExam(context.Context, *ForkExamRequest) (*ForkExamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForkExam not implemented")
+}
+func (UnimplementedExamServiceServer) PublishExam(context.Context, *PublishExamRequest) (*PublishExamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishExam not implemented")
+}
+func (UnimplementedExamServiceServer) ShareExam(context.Context, *ShareExamRequest) (*ShareExamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareExam not implemented")
+}
+func (UnimplementedExamServiceServer) UnshareExam(context.Context, *UnshareExamRequest) (*UnshareExamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshareExam not implemented")
+}
+func (UnimplementedExamServiceServer) FindExamShares(context.Context, *FindExamSharesRequest) (*FindExamSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindExamShares not implemented")
+}
+func (UnimplementedExamServiceServer) ImportExam(context.Context, *ImportExamRequest) (*ImportExamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportExam not implemented")
+}
+func (UnimplementedExamServiceServer) ExportExam(*ExportExamRequest, ExamService_ExportExamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportExam not implemented")
+}
+func (UnimplementedExamServiceServer) CreateQuestion(context.Context, *CreateQuestionRequest) (*CreateQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuestion not implemented")
+}
+func (UnimplementedExamServiceServer) UpdateQuestion(context.Context, *UpdateQuestionRequest) (*UpdateQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQuestion not implemented")
+}
+func (UnimplementedExamServiceServer) GetQuestion(context.Context, *GetQuestionRequest) (*GetQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuestion not implemented")
+}
+func (UnimplementedExamServiceServer) FindQuestions(context.Context, *FindQuestionsRequest) (*FindQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindQuestions not implemented")
+}
+func (UnimplementedExamServiceServer) DeleteQuestion(context.Context, *DeleteQuestionRequest) (*DeleteQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuestion not implemented")
+}
+func (UnimplementedExamServiceServer) BatchMutateQuestions(context.Context, *BatchMutateQuestionsRequest) (*BatchMutateQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchMutateQuestions not implemented")
+}
+func (UnimplementedExamServiceServer) FindRandomQuestions(context.Context, *FindRandomQuestionsRequest) (*FindRandomQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindRandomQuestions not implemented")
+}
+func (UnimplementedExamServiceServer) RevealQuestionHint(context.Context, *RevealQuestionHintRequest) (*RevealQuestionHintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealQuestionHint not implemented")
+}
+func (UnimplementedExamServiceServer) CreateBankQuestion(context.Context, *CreateBankQuestionRequest) (*CreateBankQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBankQuestion not implemented")
+}
+func (UnimplementedExamServiceServer) UpdateBankQuestion(context.Context, *UpdateBankQuestionRequest) (*UpdateBankQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBankQuestion not implemented")
+}
+func (UnimplementedExamServiceServer) DeleteBankQuestion(context.Context, *DeleteBankQuestionRequest) (*DeleteBankQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBankQuestion not implemented")
+}
+func (UnimplementedExamServiceServer) FindBankQuestions(context.Context, *FindBankQuestionsRequest) (*FindBankQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindBankQuestions not implemented")
+}
+func (UnimplementedExamServiceServer) SetExamBankSource(context.Context, *SetExamBankSourceRequest) (*SetExamBankSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExamBankSource not implemented")
+}
+func (UnimplementedExamServiceServer) CreateExamSection(context.Context, *CreateExamSectionRequest) (*CreateExamSectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExamSection not implemented")
+}
+func (UnimplementedExamServiceServer) UpdateExamSection(context.Context, *UpdateExamSectionRequest) (*UpdateExamSectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExamSection not implemented")
+}
+func (UnimplementedExamServiceServer) DeleteExamSection(context.Context, *DeleteExamSectionRequest) (*DeleteExamSectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExamSection not implemented")
+}
+func (UnimplementedExamServiceServer) FindExamSections(context.Context, *FindExamSectionsRequest) (*FindExamSectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindExamSections not implemented")
+}
+func (UnimplementedExamServiceServer) CreateExamRecord(context.Context, *CreateExamRecordRequest) (*CreateExamRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExamRecord not implemented")
+}
+func (UnimplementedExamServiceServer) FindExamRecords(context.Context, *FindExamRecordsRequest) (*FindExamRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindExamRecords not implemented")
+}
+func (UnimplementedExamServiceServer) GetExamRecordDetail(context.Context, *GetExamRecordDetailRequest) (*GetExamRecordDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExamRecordDetail not implemented")
+}
+func (UnimplementedExamServiceServer) FindExamRecordOverview(context.Context, *FindExamRecordOverviewRequest) (*FindExamRecordOverviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindExamRecordOverview not implemented")
+}
+func (UnimplementedExamServiceServer) FindExamLeaderboard(context.Context, *FindExamLeaderboardRequest) (*FindExamLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindExamLeaderboard not implemented")
+}
+func (UnimplementedExamServiceServer) FindQuestionStatistics(context.Context, *FindQuestionStatisticsRequest) (*FindQuestionStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindQuestionStatistics not implemented")
+}
+func (UnimplementedExamServiceServer) FindMistakeNotebook(context.Context, *FindMistakeNotebookRequest) (*FindMistakeNotebookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindMistakeNotebook not implemented")
+}
+func (UnimplementedExamServiceServer) CreateMistakeDrill(context.Context, *CreateMistakeDrillRequest) (*CreateMistakeDrillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMistakeDrill not implemented")
+}
+func (UnimplementedExamServiceServer) AnswerMistakeDrill(context.Context, *AnswerMistakeDrillRequest) (*AnswerMistakeDrillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnswerMistakeDrill not implemented")
+}
+func (UnimplementedExamServiceServer) FindExamInfos(context.Context, *FindExamInfosRequest) (*FindExamInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindExamInfos not implemented")
+}
+func (UnimplementedExamServiceServer) FindExamCatalog(context.Context, *FindExamCatalogRequest) (*FindExamCatalogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindExamCatalog not implemented")
+}
+func (UnimplementedExamServiceServer) CreateClass(context.Context, *CreateClassRequest) (*CreateClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClass not implemented")
+}
+func (UnimplementedExamServiceServer) JoinClass(context.Context, *JoinClassRequest) (*JoinClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinClass not implemented")
+}
+func (UnimplementedExamServiceServer) FindClasses(context.Context, *FindClassesRequest) (*FindClassesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindClasses not implemented")
+}
+func (UnimplementedExamServiceServer) CreateAssignment(context.Context, *CreateAssignmentRequest) (*CreateAssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAssignment not implemented")
+}
+func (UnimplementedExamServiceServer) FindAssignments(context.Context, *FindAssignmentsRequest) (*FindAssignmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAssignments not implemented")
+}
+func (UnimplementedExamServiceServer) FindClassGradebook(context.Context, *FindClassGradebookRequest) (*FindClassGradebookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindClassGradebook not implemented")
+}
+func (UnimplementedExamServiceServer) mustEmbedUnimplementedExamServiceServer() {}
+
+// UnsafeExamServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExamServiceServer will
+// result in compilation errors.
+type UnsafeExamServiceServer interface {
+	mustEmbedUnimplementedExamServiceServer()
+}
+
+func RegisterExamServiceServer(s grpc.ServiceRegistrar, srv ExamServiceServer) {
+	s.RegisterService(&ExamService_ServiceDesc, srv)
+}
+
+func _ExamService_CreateExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).CreateExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/CreateExam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).CreateExam(ctx, req.(*CreateExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_UpdateExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).UpdateExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/UpdateExam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).UpdateExam(ctx, req.(*UpdateExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/GetExam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetExam(ctx, req.(*GetExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_FindExams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindExamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).FindExams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/FindExams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).FindExams(ctx, req.(*FindExamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_DeleteExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).DeleteExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/DeleteExam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).DeleteExam(ctx, req.(*DeleteExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_CreatePracticeExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePracticeExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).CreatePracticeExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/CreatePracticeExam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).CreatePracticeExam(ctx, req.(*CreatePracticeExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_FindExamsByTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindExamsByTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).FindExamsByTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/FindExamsByTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).FindExamsByTags(ctx, req.(*FindExamsByTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_FindExamTagCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindExamTagCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).FindExamTagCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/FindExamTagCounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).FindExamTagCounts(ctx, req.(*FindExamTagCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_ForkExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).ForkExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/ForkExam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).ForkExam(ctx, req.(*ForkExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_PublishExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).PublishExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/PublishExam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).PublishExam(ctx, req.(*PublishExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_ShareExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).ShareExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/ShareExam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).ShareExam(ctx, req.(*ShareExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_UnshareExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).UnshareExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/UnshareExam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).UnshareExam(ctx, req.(*UnshareExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_FindExamShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindExamSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).FindExamShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/FindExamShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).FindExamShares(ctx, req.(*FindExamSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_ImportExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).ImportExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/ImportExam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).ImportExam(ctx, req.(*ImportExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_ExportExam_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportExamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExamServiceServer).ExportExam(m, &examServiceExportExamServer{stream})
+}
+
+type ExamService_ExportExamServer interface {
+	Send(*ExportExamResponse) error
+	grpc.ServerStream
+}
+
+type examServiceExportExamServer struct {
+	grpc.ServerStream
+}
+
+func (x *examServiceExportExamServer) Send(m *ExportExamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExamService_CreateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).CreateQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/CreateQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).CreateQuestion(ctx, req.(*CreateQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_UpdateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).UpdateQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/UpdateQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).UpdateQuestion(ctx, req.(*UpdateQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_GetQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/GetQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetQuestion(ctx, req.(*GetQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_FindQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).FindQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/FindQuestions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).FindQuestions(ctx, req.(*FindQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_DeleteQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).DeleteQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/DeleteQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).DeleteQuestion(ctx, req.(*DeleteQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_BatchMutateQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchMutateQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).BatchMutateQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/BatchMutateQuestions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).BatchMutateQuestions(ctx, req.(*BatchMutateQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_FindRandomQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindRandomQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).FindRandomQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/FindRandomQuestions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).FindRandomQuestions(ctx, req.(*FindRandomQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_RevealQuestionHint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealQuestionHintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).RevealQuestionHint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/RevealQuestionHint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).RevealQuestionHint(ctx, req.(*RevealQuestionHintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_CreateBankQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBankQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).CreateBankQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/CreateBankQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).CreateBankQuestion(ctx, req.(*CreateBankQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_UpdateBankQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBankQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).UpdateBankQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/UpdateBankQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).UpdateBankQuestion(ctx, req.(*UpdateBankQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_DeleteBankQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBankQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).DeleteBankQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/DeleteBankQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).DeleteBankQuestion(ctx, req.(*DeleteBankQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_FindBankQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindBankQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).FindBankQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/FindBankQuestions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).FindBankQuestions(ctx, req.(*FindBankQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_SetExamBankSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExamBankSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).SetExamBankSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/SetExamBankSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).SetExamBankSource(ctx, req.(*SetExamBankSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_CreateExamSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExamSectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).CreateExamSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/CreateExamSection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).CreateExamSection(ctx, req.(*CreateExamSectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_UpdateExamSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExamSectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).UpdateExamSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/UpdateExamSection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).UpdateExamSection(ctx, req.(*UpdateExamSectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_DeleteExamSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExamSectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).DeleteExamSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/DeleteExamSection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).DeleteExamSection(ctx, req.(*DeleteExamSectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_FindExamSections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindExamSectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).FindExamSections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/FindExamSections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).FindExamSections(ctx, req.(*FindExamSectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_CreateExamRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExamRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).CreateExamRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/CreateExamRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).CreateExamRecord(ctx, req.(*CreateExamRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamService_FindExamRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindExamRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamServiceServer).FindExamRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/FindExamRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).FindExamRecords(ctx, req.(*FindExamRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *examServiceClient) FindExamRecordOverview(ctx context.Context, in *FindExamRecordOverviewRequest, opts ...grpc.CallOption) (*FindExamRecordOverviewResponse, error) {
-	out := new(FindExamRecordOverviewResponse)
-	err := c.cc.Invoke(ctx, "/pb.ExamService/FindExamRecordOverview", in, out, opts...)
-	if err != nil {
+func _ExamService_GetExamRecordDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExamRecordDetailRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ExamServiceServer).GetExamRecordDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/GetExamRecordDetail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).GetExamRecordDetail(ctx, req.(*GetExamRecordDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *examServiceClient) FindExamInfos(ctx context.Context, in *FindExamInfosRequest, opts ...grpc.CallOption) (*FindExamInfosResponse, error) {
-	out := new(FindExamInfosResponse)
-	err := c.cc.Invoke(ctx, "/pb.ExamService/FindExamInfos", in, out, opts...)
-	if err != nil {
+func _ExamService_FindExamRecordOverview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindExamRecordOverviewRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// ExamServiceServer is the server API for ExamService service.
-// All implementations must embed UnimplementedExamServiceServer
-// for forward compatibility
-type ExamServiceServer interface {
-	CreateExam(context.Context, *CreateExamRequest) (*CreateExamResponse, error)
-	UpdateExam(context.Context, *UpdateExamRequest) (*UpdateExamResponse, error)
-	FindExams(context.Context, *FindExamsRequest) (*FindExamsResponse, error)
-	DeleteExam(context.Context, *DeleteExamRequest) (*DeleteExamResponse, error)
-	CreateQuestion(context.Context, *CreateQuestionRequest) (*CreateQuestionResponse, error)
-	UpdateQuestion(context.Context, *UpdateQuestionRequest) (*UpdateQuestionResponse, error)
-	FindQuestions(context.Context, *FindQuestionsRequest) (*FindQuestionsResponse, error)
-	DeleteQuestion(context.Context, *DeleteQuestionRequest) (*DeleteQuestionResponse, error)
-	FindRandomQuestions(context.Context, *FindRandomQuestionsRequest) (*FindRandomQuestionsResponse, error)
-	CreateExamRecord(context.Context, *CreateExamRecordRequest) (*CreateExamRecordResponse, error)
-	FindExamRecords(context.Context, *FindExamRecordsRequest) (*FindExamRecordsResponse, error)
-	FindExamRecordOverview(context.Context, *FindExamRecordOverviewRequest) (*FindExamRecordOverviewResponse, error)
-	FindExamInfos(context.Context, *FindExamInfosRequest) (*FindExamInfosResponse, error)
-	mustEmbedUnimplementedExamServiceServer()
-}
-
-// UnimplementedExamServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedExamServiceServer struct {
-}
-
-func (UnimplementedExamServiceServer) CreateExam(context.Context, *CreateExamRequest) (*CreateExamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateExam not implemented")
-}
-func (UnimplementedExamServiceServer) UpdateExam(context.Context, *UpdateExamRequest) (*UpdateExamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateExam not implemented")
-}
-func (UnimplementedExamServiceServer) FindExams(context.Context, *FindExamsRequest) (*FindExamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindExams not implemented")
-}
-func (UnimplementedExamServiceServer) DeleteExam(context.Context, *DeleteExamRequest) (*DeleteExamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteExam not implemented")
-}
-func (UnimplementedExamServiceServer) CreateQuestion(context.Context, *CreateQuestionRequest) (*CreateQuestionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateQuestion not implemented")
-}
-func (UnimplementedExamServiceServer) UpdateQuestion(context.Context, *UpdateQuestionRequest) (*UpdateQuestionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateQuestion not implemented")
-}
-func (UnimplementedExamServiceServer) FindQuestions(context.Context, *FindQuestionsRequest) (*FindQuestionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindQuestions not implemented")
-}
-func (UnimplementedExamServiceServer) DeleteQuestion(context.Context, *DeleteQuestionRequest) (*DeleteQuestionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuestion not implemented")
-}
-func (UnimplementedExamServiceServer) FindRandomQuestions(context.Context, *FindRandomQuestionsRequest) (*FindRandomQuestionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindRandomQuestions not implemented")
-}
-func (UnimplementedExamServiceServer) CreateExamRecord(context.Context, *CreateExamRecordRequest) (*CreateExamRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateExamRecord not implemented")
-}
-func (UnimplementedExamServiceServer) FindExamRecords(context.Context, *FindExamRecordsRequest) (*FindExamRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindExamRecords not implemented")
-}
-func (UnimplementedExamServiceServer) FindExamRecordOverview(context.Context, *FindExamRecordOverviewRequest) (*FindExamRecordOverviewResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindExamRecordOverview not implemented")
-}
-func (UnimplementedExamServiceServer) FindExamInfos(context.Context, *FindExamInfosRequest) (*FindExamInfosResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindExamInfos not implemented")
-}
-func (UnimplementedExamServiceServer) mustEmbedUnimplementedExamServiceServer() {}
-
-// UnsafeExamServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ExamServiceServer will
-// result in compilation errors.
-type UnsafeExamServiceServer interface {
-	mustEmbedUnimplementedExamServiceServer()
-}
-
-func RegisterExamServiceServer(s grpc.ServiceRegistrar, srv ExamServiceServer) {
-	s.RegisterService(&ExamService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ExamServiceServer).FindExamRecordOverview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ExamService/FindExamRecordOverview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamServiceServer).FindExamRecordOverview(ctx, req.(*FindExamRecordOverviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_CreateExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateExamRequest)
+func _ExamService_FindExamLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindExamLeaderboardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).CreateExam(ctx, in)
+		return srv.(ExamServiceServer).FindExamLeaderboard(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ExamService/CreateExam",
+		FullMethod: "/pb.ExamService/FindExamLeaderboard",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).CreateExam(ctx, req.(*CreateExamRequest))
+		return srv.(ExamServiceServer).FindExamLeaderboard(ctx, req.(*FindExamLeaderboardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_UpdateExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateExamRequest)
+func _ExamService_FindQuestionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindQuestionStatisticsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).UpdateExam(ctx, in)
+		return srv.(ExamServiceServer).FindQuestionStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ExamService/UpdateExam",
+		FullMethod: "/pb.ExamService/FindQuestionStatistics",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).UpdateExam(ctx, req.(*UpdateExamRequest))
+		return srv.(ExamServiceServer).FindQuestionStatistics(ctx, req.(*FindQuestionStatisticsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_FindExams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindExamsRequest)
+func _ExamService_FindMistakeNotebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindMistakeNotebookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).FindExams(ctx, in)
+		return srv.(ExamServiceServer).FindMistakeNotebook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ExamService/FindExams",
+		FullMethod: "/pb.ExamService/FindMistakeNotebook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).FindExams(ctx, req.(*FindExamsRequest))
+		return srv.(ExamServiceServer).FindMistakeNotebook(ctx, req.(*FindMistakeNotebookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_DeleteExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteExamRequest)
+func _ExamService_CreateMistakeDrill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMistakeDrillRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).DeleteExam(ctx, in)
+		return srv.(ExamServiceServer).CreateMistakeDrill(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ExamService/DeleteExam",
+		FullMethod: "/pb.ExamService/CreateMistakeDrill",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).DeleteExam(ctx, req.(*DeleteExamRequest))
+		return srv.(ExamServiceServer).CreateMistakeDrill(ctx, req.(*CreateMistakeDrillRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_CreateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateQuestionRequest)
+func _ExamService_AnswerMistakeDrill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerMistakeDrillRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).CreateQuestion(ctx, in)
+		return srv.(ExamServiceServer).AnswerMistakeDrill(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ExamService/CreateQuestion",
+		FullMethod: "/pb.ExamService/AnswerMistakeDrill",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).CreateQuestion(ctx, req.(*CreateQuestionRequest))
+		return srv.(ExamServiceServer).AnswerMistakeDrill(ctx, req.(*AnswerMistakeDrillRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_UpdateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateQuestionRequest)
+func _ExamService_FindExamInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindExamInfosRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).UpdateQuestion(ctx, in)
+		return srv.(ExamServiceServer).FindExamInfos(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ExamService/UpdateQuestion",
+		FullMethod: "/pb.ExamService/FindExamInfos",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).UpdateQuestion(ctx, req.(*UpdateQuestionRequest))
+		return srv.(ExamServiceServer).FindExamInfos(ctx, req.(*FindExamInfosRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_FindQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindQuestionsRequest)
+func _ExamService_FindExamCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindExamCatalogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).FindQuestions(ctx, in)
+		return srv.(ExamServiceServer).FindExamCatalog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ExamService/FindQuestions",
+		FullMethod: "/pb.ExamService/FindExamCatalog",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).FindQuestions(ctx, req.(*FindQuestionsRequest))
+		return srv.(ExamServiceServer).FindExamCatalog(ctx, req.(*FindExamCatalogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_DeleteQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteQuestionRequest)
+func _ExamService_CreateClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClassRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).DeleteQuestion(ctx, in)
+		return srv.(ExamServiceServer).CreateClass(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ExamService/DeleteQuestion",
+		FullMethod: "/pb.ExamService/CreateClass",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).DeleteQuestion(ctx, req.(*DeleteQuestionRequest))
+		return srv.(ExamServiceServer).CreateClass(ctx, req.(*CreateClassRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_FindRandomQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindRandomQuestionsRequest)
+func _ExamService_JoinClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinClassRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).FindRandomQuestions(ctx, in)
+		return srv.(ExamServiceServer).JoinClass(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ExamService/FindRandomQuestions",
+		FullMethod: "/pb.ExamService/JoinClass",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).FindRandomQuestions(ctx, req.(*FindRandomQuestionsRequest))
+		return srv.(ExamServiceServer).JoinClass(ctx, req.(*JoinClassRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_CreateExamRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateExamRecordRequest)
+func _ExamService_FindClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindClassesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).CreateExamRecord(ctx, in)
+		return srv.(ExamServiceServer).FindClasses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ExamService/CreateExamRecord",
+		FullMethod: "/pb.ExamService/FindClasses",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).CreateExamRecord(ctx, req.(*CreateExamRecordRequest))
+		return srv.(ExamServiceServer).FindClasses(ctx, req.(*FindClassesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_FindExamRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindExamRecordsRequest)
+func _ExamService_CreateAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssignmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).FindExamRecords(ctx, in)
+		return srv.(ExamServiceServer).CreateAssignment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ExamService/FindExamRecords",
+		FullMethod: "/pb.ExamService/CreateAssignment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).FindExamRecords(ctx, req.(*FindExamRecordsRequest))
+		return srv.(ExamServiceServer).CreateAssignment(ctx, req.(*CreateAssignmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_FindExamRecordOverview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindExamRecordOverviewRequest)
+func _ExamService_FindAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAssignmentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).FindExamRecordOverview(ctx, in)
+		return srv.(ExamServiceServer).FindAssignments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ExamService/FindExamRecordOverview",
+		FullMethod: "/pb.ExamService/FindAssignments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).FindExamRecordOverview(ctx, req.(*FindExamRecordOverviewRequest))
+		return srv.(ExamServiceServer).FindAssignments(ctx, req.(*FindAssignmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ExamService_FindExamInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindExamInfosRequest)
+func _ExamService_FindClassGradebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindClassGradebookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ExamServiceServer).FindExamInfos(ctx, in)
+		return srv.(ExamServiceServer).FindClassGradebook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.ExamService/FindExamInfos",
+		FullMethod: "/pb.ExamService/FindClassGradebook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ExamServiceServer).FindExamInfos(ctx, req.(*FindExamInfosRequest))
+		return srv.(ExamServiceServer).FindClassGradebook(ctx, req.(*FindClassGradebookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -487,6 +1665,10 @@ var ExamService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateExam",
 			Handler:    _ExamService_UpdateExam_Handler,
 		},
+		{
+			MethodName: "GetExam",
+			Handler:    _ExamService_GetExam_Handler,
+		},
 		{
 			MethodName: "FindExams",
 			Handler:    _ExamService_FindExams_Handler,
@@ -495,6 +1677,42 @@ var ExamService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteExam",
 			Handler:    _ExamService_DeleteExam_Handler,
 		},
+		{
+			MethodName: "CreatePracticeExam",
+			Handler:    _ExamService_CreatePracticeExam_Handler,
+		},
+		{
+			MethodName: "FindExamsByTags",
+			Handler:    _ExamService_FindExamsByTags_Handler,
+		},
+		{
+			MethodName: "FindExamTagCounts",
+			Handler:    _ExamService_FindExamTagCounts_Handler,
+		},
+		{
+			MethodName: "ForkExam",
+			Handler:    _ExamService_ForkExam_Handler,
+		},
+		{
+			MethodName: "PublishExam",
+			Handler:    _ExamService_PublishExam_Handler,
+		},
+		{
+			MethodName: "ShareExam",
+			Handler:    _ExamService_ShareExam_Handler,
+		},
+		{
+			MethodName: "UnshareExam",
+			Handler:    _ExamService_UnshareExam_Handler,
+		},
+		{
+			MethodName: "FindExamShares",
+			Handler:    _ExamService_FindExamShares_Handler,
+		},
+		{
+			MethodName: "ImportExam",
+			Handler:    _ExamService_ImportExam_Handler,
+		},
 		{
 			MethodName: "CreateQuestion",
 			Handler:    _ExamService_CreateQuestion_Handler,
@@ -503,6 +1721,10 @@ var ExamService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateQuestion",
 			Handler:    _ExamService_UpdateQuestion_Handler,
 		},
+		{
+			MethodName: "GetQuestion",
+			Handler:    _ExamService_GetQuestion_Handler,
+		},
 		{
 			MethodName: "FindQuestions",
 			Handler:    _ExamService_FindQuestions_Handler,
@@ -511,10 +1733,54 @@ var ExamService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteQuestion",
 			Handler:    _ExamService_DeleteQuestion_Handler,
 		},
+		{
+			MethodName: "BatchMutateQuestions",
+			Handler:    _ExamService_BatchMutateQuestions_Handler,
+		},
 		{
 			MethodName: "FindRandomQuestions",
 			Handler:    _ExamService_FindRandomQuestions_Handler,
 		},
+		{
+			MethodName: "RevealQuestionHint",
+			Handler:    _ExamService_RevealQuestionHint_Handler,
+		},
+		{
+			MethodName: "CreateBankQuestion",
+			Handler:    _ExamService_CreateBankQuestion_Handler,
+		},
+		{
+			MethodName: "UpdateBankQuestion",
+			Handler:    _ExamService_UpdateBankQuestion_Handler,
+		},
+		{
+			MethodName: "DeleteBankQuestion",
+			Handler:    _ExamService_DeleteBankQuestion_Handler,
+		},
+		{
+			MethodName: "FindBankQuestions",
+			Handler:    _ExamService_FindBankQuestions_Handler,
+		},
+		{
+			MethodName: "SetExamBankSource",
+			Handler:    _ExamService_SetExamBankSource_Handler,
+		},
+		{
+			MethodName: "CreateExamSection",
+			Handler:    _ExamService_CreateExamSection_Handler,
+		},
+		{
+			MethodName: "UpdateExamSection",
+			Handler:    _ExamService_UpdateExamSection_Handler,
+		},
+		{
+			MethodName: "DeleteExamSection",
+			Handler:    _ExamService_DeleteExamSection_Handler,
+		},
+		{
+			MethodName: "FindExamSections",
+			Handler:    _ExamService_FindExamSections_Handler,
+		},
 		{
 			MethodName: "CreateExamRecord",
 			Handler:    _ExamService_CreateExamRecord_Handler,
@@ -523,15 +1789,73 @@ var ExamService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindExamRecords",
 			Handler:    _ExamService_FindExamRecords_Handler,
 		},
+		{
+			MethodName: "GetExamRecordDetail",
+			Handler:    _ExamService_GetExamRecordDetail_Handler,
+		},
 		{
 			MethodName: "FindExamRecordOverview",
 			Handler:    _ExamService_FindExamRecordOverview_Handler,
 		},
+		{
+			MethodName: "FindExamLeaderboard",
+			Handler:    _ExamService_FindExamLeaderboard_Handler,
+		},
+		{
+			MethodName: "FindQuestionStatistics",
+			Handler:    _ExamService_FindQuestionStatistics_Handler,
+		},
+		{
+			MethodName: "FindMistakeNotebook",
+			Handler:    _ExamService_FindMistakeNotebook_Handler,
+		},
+		{
+			MethodName: "CreateMistakeDrill",
+			Handler:    _ExamService_CreateMistakeDrill_Handler,
+		},
+		{
+			MethodName: "AnswerMistakeDrill",
+			Handler:    _ExamService_AnswerMistakeDrill_Handler,
+		},
 		{
 			MethodName: "FindExamInfos",
 			Handler:    _ExamService_FindExamInfos_Handler,
 		},
+		{
+			MethodName: "FindExamCatalog",
+			Handler:    _ExamService_FindExamCatalog_Handler,
+		},
+		{
+			MethodName: "CreateClass",
+			Handler:    _ExamService_CreateClass_Handler,
+		},
+		{
+			MethodName: "JoinClass",
+			Handler:    _ExamService_JoinClass_Handler,
+		},
+		{
+			MethodName: "FindClasses",
+			Handler:    _ExamService_FindClasses_Handler,
+		},
+		{
+			MethodName: "CreateAssignment",
+			Handler:    _ExamService_CreateAssignment_Handler,
+		},
+		{
+			MethodName: "FindAssignments",
+			Handler:    _ExamService_FindAssignments_Handler,
+		},
+		{
+			MethodName: "FindClassGradebook",
+			Handler:    _ExamService_FindClassGradebook_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportExam",
+			Handler:       _ExamService_ExportExam_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "exam_service.proto",
 }
@@ -12,26 +12,66 @@ import (
 	"golang.org/x/time/rate"
 
 	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/model"
+	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/repository"
 	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/service"
 )
 
 type Endpoints struct {
-	CreateExam endpoint.Endpoint
-	UpdateExam endpoint.Endpoint
-	FindExams  endpoint.Endpoint
-	DeleteExam endpoint.Endpoint
-
-	CreateQuestion      endpoint.Endpoint
-	UpdateQuestion      endpoint.Endpoint
-	FindQuestions       endpoint.Endpoint
-	DeleteQuestion      endpoint.Endpoint
-	FindRandomQuestions endpoint.Endpoint
+	CreateExam         endpoint.Endpoint
+	UpdateExam         endpoint.Endpoint
+	GetExam            endpoint.Endpoint
+	FindExams          endpoint.Endpoint
+	DeleteExam         endpoint.Endpoint
+	CreatePracticeExam endpoint.Endpoint
+	FindExamsByTags    endpoint.Endpoint
+	FindExamTagCounts  endpoint.Endpoint
+	ForkExam           endpoint.Endpoint
+	PublishExam        endpoint.Endpoint
+	ShareExam          endpoint.Endpoint
+	UnshareExam        endpoint.Endpoint
+	FindExamShares     endpoint.Endpoint
+	ImportExam         endpoint.Endpoint
+	ExportExam         endpoint.Endpoint
+
+	CreateQuestion       endpoint.Endpoint
+	UpdateQuestion       endpoint.Endpoint
+	GetQuestion          endpoint.Endpoint
+	FindQuestions        endpoint.Endpoint
+	DeleteQuestion       endpoint.Endpoint
+	BatchMutateQuestions endpoint.Endpoint
+	FindRandomQuestions  endpoint.Endpoint
+	RevealQuestionHint   endpoint.Endpoint
+
+	CreateBankQuestion endpoint.Endpoint
+	UpdateBankQuestion endpoint.Endpoint
+	DeleteBankQuestion endpoint.Endpoint
+	FindBankQuestions  endpoint.Endpoint
+	SetExamBankSource  endpoint.Endpoint
+
+	CreateExamSection endpoint.Endpoint
+	UpdateExamSection endpoint.Endpoint
+	DeleteExamSection endpoint.Endpoint
+	FindExamSections  endpoint.Endpoint
 
 	CreateExamRecord       endpoint.Endpoint
 	FindExamRecords        endpoint.Endpoint
+	GetExamRecordDetail    endpoint.Endpoint
 	FindExamRecordOverview endpoint.Endpoint
-
-	FindExamInfos endpoint.Endpoint
+	FindExamLeaderboard    endpoint.Endpoint
+	FindQuestionStatistics endpoint.Endpoint
+
+	FindMistakeNotebook endpoint.Endpoint
+	CreateMistakeDrill  endpoint.Endpoint
+	AnswerMistakeDrill  endpoint.Endpoint
+
+	FindExamInfos      endpoint.Endpoint
+	FindExamCatalog    endpoint.Endpoint
+	CreateClass        endpoint.Endpoint
+	JoinClass          endpoint.Endpoint
+	FindClasses        endpoint.Endpoint
+	CreateAssignment   endpoint.Endpoint
+	FindAssignments    endpoint.Endpoint
+	FindClassGradebook endpoint.Endpoint
 }
 
 func MakeEndpoints(examService service.ExamService, logger log.Logger) Endpoints {
@@ -75,6 +115,25 @@ func MakeEndpoints(examService service.ExamService, logger log.Logger) Endpoints
 			log.With(logger, "method", "UpdateExam"))(updateExamEndpoint)
 	}
 
+	var getExamEndpoint endpoint.Endpoint
+	{
+		getExamEndpoint = makeGetExamEndpoint(examService)
+		getExamEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			getExamEndpoint,
+		)
+		getExamEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			getExamEndpoint,
+		)
+		getExamEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "GetExam"))(getExamEndpoint)
+		getExamEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "GetExam"))(getExamEndpoint)
+	}
+
 	var findExamsEndpoint endpoint.Endpoint
 	{
 		findExamsEndpoint = makeFindExamsEndpoint(examService)
@@ -113,6 +172,196 @@ func MakeEndpoints(examService service.ExamService, logger log.Logger) Endpoints
 			log.With(logger, "method", "DeleteExam"))(deleteExamEndpoint)
 	}
 
+	var createPracticeExamEndpoint endpoint.Endpoint
+	{
+		createPracticeExamEndpoint = makeCreatePracticeExamEndpoint(examService)
+		createPracticeExamEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			createPracticeExamEndpoint,
+		)
+		createPracticeExamEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			createPracticeExamEndpoint,
+		)
+		createPracticeExamEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "CreatePracticeExam"))(createPracticeExamEndpoint)
+		createPracticeExamEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "CreatePracticeExam"))(createPracticeExamEndpoint)
+	}
+
+	var findExamsByTagsEndpoint endpoint.Endpoint
+	{
+		findExamsByTagsEndpoint = makeFindExamsByTagsEndpoint(examService)
+		findExamsByTagsEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			findExamsByTagsEndpoint,
+		)
+		findExamsByTagsEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			findExamsByTagsEndpoint,
+		)
+		findExamsByTagsEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "FindExamsByTags"))(findExamsByTagsEndpoint)
+		findExamsByTagsEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "FindExamsByTags"))(findExamsByTagsEndpoint)
+	}
+
+	var findExamTagCountsEndpoint endpoint.Endpoint
+	{
+		findExamTagCountsEndpoint = makeFindExamTagCountsEndpoint(examService)
+		findExamTagCountsEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			findExamTagCountsEndpoint,
+		)
+		findExamTagCountsEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			findExamTagCountsEndpoint,
+		)
+		findExamTagCountsEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "FindExamTagCounts"))(findExamTagCountsEndpoint)
+		findExamTagCountsEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "FindExamTagCounts"))(findExamTagCountsEndpoint)
+	}
+
+	var forkExamEndpoint endpoint.Endpoint
+	{
+		forkExamEndpoint = makeForkExamEndpoint(examService)
+		forkExamEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			forkExamEndpoint,
+		)
+		forkExamEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			forkExamEndpoint,
+		)
+		forkExamEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "ForkExam"))(forkExamEndpoint)
+		forkExamEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "ForkExam"))(forkExamEndpoint)
+	}
+
+	var publishExamEndpoint endpoint.Endpoint
+	{
+		publishExamEndpoint = makePublishExamEndpoint(examService)
+		publishExamEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			publishExamEndpoint,
+		)
+		publishExamEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			publishExamEndpoint,
+		)
+		publishExamEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "PublishExam"))(publishExamEndpoint)
+		publishExamEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "PublishExam"))(publishExamEndpoint)
+	}
+
+	var shareExamEndpoint endpoint.Endpoint
+	{
+		shareExamEndpoint = makeShareExamEndpoint(examService)
+		shareExamEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			shareExamEndpoint,
+		)
+		shareExamEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			shareExamEndpoint,
+		)
+		shareExamEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "ShareExam"))(shareExamEndpoint)
+		shareExamEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "ShareExam"))(shareExamEndpoint)
+	}
+
+	var unshareExamEndpoint endpoint.Endpoint
+	{
+		unshareExamEndpoint = makeUnshareExamEndpoint(examService)
+		unshareExamEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			unshareExamEndpoint,
+		)
+		unshareExamEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			unshareExamEndpoint,
+		)
+		unshareExamEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "UnshareExam"))(unshareExamEndpoint)
+		unshareExamEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "UnshareExam"))(unshareExamEndpoint)
+	}
+
+	var findExamSharesEndpoint endpoint.Endpoint
+	{
+		findExamSharesEndpoint = makeFindExamSharesEndpoint(examService)
+		findExamSharesEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			findExamSharesEndpoint,
+		)
+		findExamSharesEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			findExamSharesEndpoint,
+		)
+		findExamSharesEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "FindExamShares"))(findExamSharesEndpoint)
+		findExamSharesEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "FindExamShares"))(findExamSharesEndpoint)
+	}
+
+	var importExamEndpoint endpoint.Endpoint
+	{
+		importExamEndpoint = makeImportExamEndpoint(examService)
+		importExamEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			importExamEndpoint,
+		)
+		importExamEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			importExamEndpoint,
+		)
+		importExamEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "ImportExam"))(importExamEndpoint)
+		importExamEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "ImportExam"))(importExamEndpoint)
+	}
+
+	var exportExamEndpoint endpoint.Endpoint
+	{
+		exportExamEndpoint = makeExportExamEndpoint(examService)
+		exportExamEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			exportExamEndpoint,
+		)
+		exportExamEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			exportExamEndpoint,
+		)
+		exportExamEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "ExportExam"))(exportExamEndpoint)
+		exportExamEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "ExportExam"))(exportExamEndpoint)
+	}
+
 	var createQuestionEndpoint endpoint.Endpoint
 	{
 		createQuestionEndpoint = makeCreateQuestionEndpoint(examService)
@@ -151,6 +400,25 @@ func MakeEndpoints(examService service.ExamService, logger log.Logger) Endpoints
 			log.With(logger, "method", "UpdateQuestion"))(updateQuestionEndpoint)
 	}
 
+	var getQuestionEndpoint endpoint.Endpoint
+	{
+		getQuestionEndpoint = makeGetQuestionEndpoint(examService)
+		getQuestionEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			getQuestionEndpoint,
+		)
+		getQuestionEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			getQuestionEndpoint,
+		)
+		getQuestionEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "GetQuestion"))(getQuestionEndpoint)
+		getQuestionEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "GetQuestion"))(getQuestionEndpoint)
+	}
+
 	var findQuestionsEndpoint endpoint.Endpoint
 	{
 		findQuestionsEndpoint = makeFindQuestionsEndpoint(examService)
@@ -189,6 +457,25 @@ func MakeEndpoints(examService service.ExamService, logger log.Logger) Endpoints
 			log.With(logger, "method", "DeleteQuestion"))(deleteQuestionEndpoint)
 	}
 
+	var batchMutateQuestionsEndpoint endpoint.Endpoint
+	{
+		batchMutateQuestionsEndpoint = makeBatchMutateQuestionsEndpoint(examService)
+		batchMutateQuestionsEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			batchMutateQuestionsEndpoint,
+		)
+		batchMutateQuestionsEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			batchMutateQuestionsEndpoint,
+		)
+		batchMutateQuestionsEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "BatchMutateQuestions"))(batchMutateQuestionsEndpoint)
+		batchMutateQuestionsEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "BatchMutateQuestions"))(batchMutateQuestionsEndpoint)
+	}
+
 	var findRandomQuestionsEndpoint endpoint.Endpoint
 	{
 		findRandomQuestionsEndpoint = makeFindRandomQuestionsEndpoint(examService)
@@ -208,6 +495,196 @@ func MakeEndpoints(examService service.ExamService, logger log.Logger) Endpoints
 			log.With(logger, "method", "FindRandomQuestions"))(findRandomQuestionsEndpoint)
 	}
 
+	var revealQuestionHintEndpoint endpoint.Endpoint
+	{
+		revealQuestionHintEndpoint = makeRevealQuestionHintEndpoint(examService)
+		revealQuestionHintEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			revealQuestionHintEndpoint,
+		)
+		revealQuestionHintEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			revealQuestionHintEndpoint,
+		)
+		revealQuestionHintEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "RevealQuestionHint"))(revealQuestionHintEndpoint)
+		revealQuestionHintEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "RevealQuestionHint"))(revealQuestionHintEndpoint)
+	}
+
+	var createBankQuestionEndpoint endpoint.Endpoint
+	{
+		createBankQuestionEndpoint = makeCreateBankQuestionEndpoint(examService)
+		createBankQuestionEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			createBankQuestionEndpoint,
+		)
+		createBankQuestionEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			createBankQuestionEndpoint,
+		)
+		createBankQuestionEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "CreateBankQuestion"))(createBankQuestionEndpoint)
+		createBankQuestionEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "CreateBankQuestion"))(createBankQuestionEndpoint)
+	}
+
+	var updateBankQuestionEndpoint endpoint.Endpoint
+	{
+		updateBankQuestionEndpoint = makeUpdateBankQuestionEndpoint(examService)
+		updateBankQuestionEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			updateBankQuestionEndpoint,
+		)
+		updateBankQuestionEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			updateBankQuestionEndpoint,
+		)
+		updateBankQuestionEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "UpdateBankQuestion"))(updateBankQuestionEndpoint)
+		updateBankQuestionEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "UpdateBankQuestion"))(updateBankQuestionEndpoint)
+	}
+
+	var deleteBankQuestionEndpoint endpoint.Endpoint
+	{
+		deleteBankQuestionEndpoint = makeDeleteBankQuestionEndpoint(examService)
+		deleteBankQuestionEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			deleteBankQuestionEndpoint,
+		)
+		deleteBankQuestionEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			deleteBankQuestionEndpoint,
+		)
+		deleteBankQuestionEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "DeleteBankQuestion"))(deleteBankQuestionEndpoint)
+		deleteBankQuestionEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "DeleteBankQuestion"))(deleteBankQuestionEndpoint)
+	}
+
+	var findBankQuestionsEndpoint endpoint.Endpoint
+	{
+		findBankQuestionsEndpoint = makeFindBankQuestionsEndpoint(examService)
+		findBankQuestionsEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			findBankQuestionsEndpoint,
+		)
+		findBankQuestionsEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			findBankQuestionsEndpoint,
+		)
+		findBankQuestionsEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "FindBankQuestions"))(findBankQuestionsEndpoint)
+		findBankQuestionsEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "FindBankQuestions"))(findBankQuestionsEndpoint)
+	}
+
+	var setExamBankSourceEndpoint endpoint.Endpoint
+	{
+		setExamBankSourceEndpoint = makeSetExamBankSourceEndpoint(examService)
+		setExamBankSourceEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			setExamBankSourceEndpoint,
+		)
+		setExamBankSourceEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			setExamBankSourceEndpoint,
+		)
+		setExamBankSourceEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "SetExamBankSource"))(setExamBankSourceEndpoint)
+		setExamBankSourceEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "SetExamBankSource"))(setExamBankSourceEndpoint)
+	}
+
+	var createExamSectionEndpoint endpoint.Endpoint
+	{
+		createExamSectionEndpoint = makeCreateExamSectionEndpoint(examService)
+		createExamSectionEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			createExamSectionEndpoint,
+		)
+		createExamSectionEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			createExamSectionEndpoint,
+		)
+		createExamSectionEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "CreateExamSection"))(createExamSectionEndpoint)
+		createExamSectionEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "CreateExamSection"))(createExamSectionEndpoint)
+	}
+
+	var updateExamSectionEndpoint endpoint.Endpoint
+	{
+		updateExamSectionEndpoint = makeUpdateExamSectionEndpoint(examService)
+		updateExamSectionEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			updateExamSectionEndpoint,
+		)
+		updateExamSectionEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			updateExamSectionEndpoint,
+		)
+		updateExamSectionEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "UpdateExamSection"))(updateExamSectionEndpoint)
+		updateExamSectionEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "UpdateExamSection"))(updateExamSectionEndpoint)
+	}
+
+	var deleteExamSectionEndpoint endpoint.Endpoint
+	{
+		deleteExamSectionEndpoint = makeDeleteExamSectionEndpoint(examService)
+		deleteExamSectionEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			deleteExamSectionEndpoint,
+		)
+		deleteExamSectionEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			deleteExamSectionEndpoint,
+		)
+		deleteExamSectionEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "DeleteExamSection"))(deleteExamSectionEndpoint)
+		deleteExamSectionEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "DeleteExamSection"))(deleteExamSectionEndpoint)
+	}
+
+	var findExamSectionsEndpoint endpoint.Endpoint
+	{
+		findExamSectionsEndpoint = makeFindExamSectionsEndpoint(examService)
+		findExamSectionsEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			findExamSectionsEndpoint,
+		)
+		findExamSectionsEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			findExamSectionsEndpoint,
+		)
+		findExamSectionsEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "FindExamSections"))(findExamSectionsEndpoint)
+		findExamSectionsEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "FindExamSections"))(findExamSectionsEndpoint)
+	}
+
 	var createExamRecordEndpoint endpoint.Endpoint
 	{
 		createExamRecordEndpoint = makeCreateExamRecordEndpoint(examService)
@@ -246,6 +723,25 @@ func MakeEndpoints(examService service.ExamService, logger log.Logger) Endpoints
 			log.With(logger, "method", "FindExamRecords"))(findExamRecordsEndpoint)
 	}
 
+	var getExamRecordDetailEndpoint endpoint.Endpoint
+	{
+		getExamRecordDetailEndpoint = makeGetExamRecordDetailEndpoint(examService)
+		getExamRecordDetailEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			getExamRecordDetailEndpoint,
+		)
+		getExamRecordDetailEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			getExamRecordDetailEndpoint,
+		)
+		getExamRecordDetailEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "GetExamRecordDetail"))(getExamRecordDetailEndpoint)
+		getExamRecordDetailEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "GetExamRecordDetail"))(getExamRecordDetailEndpoint)
+	}
+
 	var findExamRecordOverviewEndpoint endpoint.Endpoint
 	{
 		findExamRecordOverviewEndpoint = makeFindExamRecordOverviewEndpoint(examService)
@@ -265,51 +761,319 @@ func MakeEndpoints(examService service.ExamService, logger log.Logger) Endpoints
 			log.With(logger, "method", "FindExamRecordOverview"))(findExamRecordOverviewEndpoint)
 	}
 
-	var findExamInfosEndpoint endpoint.Endpoint
+	var findExamLeaderboardEndpoint endpoint.Endpoint
 	{
-		findExamInfosEndpoint = makeFindExamInfosEndpoint(examService)
-		findExamInfosEndpoint = ratelimit.NewErroringLimiter(
+		findExamLeaderboardEndpoint = makeFindExamLeaderboardEndpoint(examService)
+		findExamLeaderboardEndpoint = ratelimit.NewErroringLimiter(
 			rate.NewLimiter(rate.Every(time.Second), limitCount),
 		)(
-			findExamInfosEndpoint,
+			findExamLeaderboardEndpoint,
 		)
-		findExamInfosEndpoint = circuitbreaker.Gobreaker(
+		findExamLeaderboardEndpoint = circuitbreaker.Gobreaker(
 			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
 		)(
-			findExamInfosEndpoint,
+			findExamLeaderboardEndpoint,
 		)
-		findExamInfosEndpoint = LoggingMiddleware(
-			log.With(logger, "method", "FindExamInfos"))(findExamInfosEndpoint)
-		findExamInfosEndpoint = RecoverMiddleware(
-			log.With(logger, "method", "FindExamInfos"))(findExamInfosEndpoint)
+		findExamLeaderboardEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "FindExamLeaderboard"))(findExamLeaderboardEndpoint)
+		findExamLeaderboardEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "FindExamLeaderboard"))(findExamLeaderboardEndpoint)
 	}
 
-	return Endpoints{
-		CreateExam: createExamEndpoint,
-		UpdateExam: updateExamEndpoint,
-		FindExams:  findExamsEndpoint,
-		DeleteExam: deleteExamEndpoint,
-
-		CreateQuestion:      createQuestionEndpoint,
-		UpdateQuestion:      updateQuestionEndpoint,
-		FindQuestions:       findQuestionsEndpoint,
-		DeleteQuestion:      deleteQuestionEndpoint,
-		FindRandomQuestions: findRandomQuestionsEndpoint,
-
-		CreateExamRecord:       createExamRecordEndpoint,
-		FindExamRecords:        findExamRecordsEndpoint,
-		FindExamRecordOverview: findExamRecordOverviewEndpoint,
+	var findQuestionStatisticsEndpoint endpoint.Endpoint
+	{
+		findQuestionStatisticsEndpoint = makeFindQuestionStatisticsEndpoint(examService)
+		findQuestionStatisticsEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			findQuestionStatisticsEndpoint,
+		)
+		findQuestionStatisticsEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			findQuestionStatisticsEndpoint,
+		)
+		findQuestionStatisticsEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "FindQuestionStatistics"))(findQuestionStatisticsEndpoint)
+		findQuestionStatisticsEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "FindQuestionStatistics"))(findQuestionStatisticsEndpoint)
+	}
 
-		FindExamInfos: findExamInfosEndpoint,
+	var findMistakeNotebookEndpoint endpoint.Endpoint
+	{
+		findMistakeNotebookEndpoint = makeFindMistakeNotebookEndpoint(examService)
+		findMistakeNotebookEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			findMistakeNotebookEndpoint,
+		)
+		findMistakeNotebookEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			findMistakeNotebookEndpoint,
+		)
+		findMistakeNotebookEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "FindMistakeNotebook"))(findMistakeNotebookEndpoint)
+		findMistakeNotebookEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "FindMistakeNotebook"))(findMistakeNotebookEndpoint)
 	}
-}
 
-type CreateExamRequest struct {
-	Topic       string
-	Description string
-	IsPublic    bool
-	UserId      string
-}
+	var createMistakeDrillEndpoint endpoint.Endpoint
+	{
+		createMistakeDrillEndpoint = makeCreateMistakeDrillEndpoint(examService)
+		createMistakeDrillEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			createMistakeDrillEndpoint,
+		)
+		createMistakeDrillEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			createMistakeDrillEndpoint,
+		)
+		createMistakeDrillEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "CreateMistakeDrill"))(createMistakeDrillEndpoint)
+		createMistakeDrillEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "CreateMistakeDrill"))(createMistakeDrillEndpoint)
+	}
+
+	var answerMistakeDrillEndpoint endpoint.Endpoint
+	{
+		answerMistakeDrillEndpoint = makeAnswerMistakeDrillEndpoint(examService)
+		answerMistakeDrillEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			answerMistakeDrillEndpoint,
+		)
+		answerMistakeDrillEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			answerMistakeDrillEndpoint,
+		)
+		answerMistakeDrillEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "AnswerMistakeDrill"))(answerMistakeDrillEndpoint)
+		answerMistakeDrillEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "AnswerMistakeDrill"))(answerMistakeDrillEndpoint)
+	}
+
+	var findExamInfosEndpoint endpoint.Endpoint
+	{
+		findExamInfosEndpoint = makeFindExamInfosEndpoint(examService)
+		findExamInfosEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			findExamInfosEndpoint,
+		)
+		findExamInfosEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			findExamInfosEndpoint,
+		)
+		findExamInfosEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "FindExamInfos"))(findExamInfosEndpoint)
+		findExamInfosEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "FindExamInfos"))(findExamInfosEndpoint)
+	}
+
+	var findExamCatalogEndpoint endpoint.Endpoint
+	{
+		findExamCatalogEndpoint = makeFindExamCatalogEndpoint(examService)
+		findExamCatalogEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			findExamCatalogEndpoint,
+		)
+		findExamCatalogEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			findExamCatalogEndpoint,
+		)
+		findExamCatalogEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "FindExamCatalog"))(findExamCatalogEndpoint)
+		findExamCatalogEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "FindExamCatalog"))(findExamCatalogEndpoint)
+	}
+
+	var createClassEndpoint endpoint.Endpoint
+	{
+		createClassEndpoint = makeCreateClassEndpoint(examService)
+		createClassEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			createClassEndpoint,
+		)
+		createClassEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			createClassEndpoint,
+		)
+		createClassEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "CreateClass"))(createClassEndpoint)
+		createClassEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "CreateClass"))(createClassEndpoint)
+	}
+
+	var joinClassEndpoint endpoint.Endpoint
+	{
+		joinClassEndpoint = makeJoinClassEndpoint(examService)
+		joinClassEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			joinClassEndpoint,
+		)
+		joinClassEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			joinClassEndpoint,
+		)
+		joinClassEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "JoinClass"))(joinClassEndpoint)
+		joinClassEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "JoinClass"))(joinClassEndpoint)
+	}
+
+	var findClassesEndpoint endpoint.Endpoint
+	{
+		findClassesEndpoint = makeFindClassesEndpoint(examService)
+		findClassesEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			findClassesEndpoint,
+		)
+		findClassesEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			findClassesEndpoint,
+		)
+		findClassesEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "FindClasses"))(findClassesEndpoint)
+		findClassesEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "FindClasses"))(findClassesEndpoint)
+	}
+
+	var createAssignmentEndpoint endpoint.Endpoint
+	{
+		createAssignmentEndpoint = makeCreateAssignmentEndpoint(examService)
+		createAssignmentEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			createAssignmentEndpoint,
+		)
+		createAssignmentEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			createAssignmentEndpoint,
+		)
+		createAssignmentEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "CreateAssignment"))(createAssignmentEndpoint)
+		createAssignmentEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "CreateAssignment"))(createAssignmentEndpoint)
+	}
+
+	var findAssignmentsEndpoint endpoint.Endpoint
+	{
+		findAssignmentsEndpoint = makeFindAssignmentsEndpoint(examService)
+		findAssignmentsEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			findAssignmentsEndpoint,
+		)
+		findAssignmentsEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			findAssignmentsEndpoint,
+		)
+		findAssignmentsEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "FindAssignments"))(findAssignmentsEndpoint)
+		findAssignmentsEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "FindAssignments"))(findAssignmentsEndpoint)
+	}
+
+	var findClassGradebookEndpoint endpoint.Endpoint
+	{
+		findClassGradebookEndpoint = makeFindClassGradebookEndpoint(examService)
+		findClassGradebookEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			findClassGradebookEndpoint,
+		)
+		findClassGradebookEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			findClassGradebookEndpoint,
+		)
+		findClassGradebookEndpoint = LoggingMiddleware(
+			log.With(logger, "method", "FindClassGradebook"))(findClassGradebookEndpoint)
+		findClassGradebookEndpoint = RecoverMiddleware(
+			log.With(logger, "method", "FindClassGradebook"))(findClassGradebookEndpoint)
+	}
+
+	return Endpoints{
+		CreateExam:         createExamEndpoint,
+		UpdateExam:         updateExamEndpoint,
+		GetExam:            getExamEndpoint,
+		FindExams:          findExamsEndpoint,
+		DeleteExam:         deleteExamEndpoint,
+		CreatePracticeExam: createPracticeExamEndpoint,
+		FindExamsByTags:    findExamsByTagsEndpoint,
+		FindExamTagCounts:  findExamTagCountsEndpoint,
+		ForkExam:           forkExamEndpoint,
+		PublishExam:        publishExamEndpoint,
+		ShareExam:          shareExamEndpoint,
+		UnshareExam:        unshareExamEndpoint,
+		FindExamShares:     findExamSharesEndpoint,
+		ImportExam:         importExamEndpoint,
+		ExportExam:         exportExamEndpoint,
+
+		CreateQuestion:       createQuestionEndpoint,
+		UpdateQuestion:       updateQuestionEndpoint,
+		GetQuestion:          getQuestionEndpoint,
+		FindQuestions:        findQuestionsEndpoint,
+		DeleteQuestion:       deleteQuestionEndpoint,
+		BatchMutateQuestions: batchMutateQuestionsEndpoint,
+		FindRandomQuestions:  findRandomQuestionsEndpoint,
+		RevealQuestionHint:   revealQuestionHintEndpoint,
+
+		CreateBankQuestion: createBankQuestionEndpoint,
+		UpdateBankQuestion: updateBankQuestionEndpoint,
+		DeleteBankQuestion: deleteBankQuestionEndpoint,
+		FindBankQuestions:  findBankQuestionsEndpoint,
+		SetExamBankSource:  setExamBankSourceEndpoint,
+
+		CreateExamSection: createExamSectionEndpoint,
+		UpdateExamSection: updateExamSectionEndpoint,
+		DeleteExamSection: deleteExamSectionEndpoint,
+		FindExamSections:  findExamSectionsEndpoint,
+
+		CreateExamRecord:       createExamRecordEndpoint,
+		FindExamRecords:        findExamRecordsEndpoint,
+		GetExamRecordDetail:    getExamRecordDetailEndpoint,
+		FindExamRecordOverview: findExamRecordOverviewEndpoint,
+		FindExamLeaderboard:    findExamLeaderboardEndpoint,
+		FindQuestionStatistics: findQuestionStatisticsEndpoint,
+
+		FindMistakeNotebook: findMistakeNotebookEndpoint,
+		CreateMistakeDrill:  createMistakeDrillEndpoint,
+		AnswerMistakeDrill:  answerMistakeDrillEndpoint,
+
+		FindExamInfos:      findExamInfosEndpoint,
+		FindExamCatalog:    findExamCatalogEndpoint,
+		CreateClass:        createClassEndpoint,
+		JoinClass:          joinClassEndpoint,
+		FindClasses:        findClassesEndpoint,
+		CreateAssignment:   createAssignmentEndpoint,
+		FindAssignments:    findAssignmentsEndpoint,
+		FindClassGradebook: findClassGradebookEndpoint,
+	}
+}
+
+type CreateExamRequest struct {
+	Topic       string
+	Description string
+	Tags        []string
+	IsPublic    bool
+	UserId      string
+}
 
 type CreateExamResponse struct {
 	ExamId string
@@ -319,330 +1083,1437 @@ func makeCreateExamEndpoint(examService service.ExamService) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(CreateExamRequest)
 		examId, err := examService.CreateExam(
-			ctx, req.Topic, req.Description, req.IsPublic, req.UserId)
+			ctx, req.Topic, req.Description, req.Tags, req.IsPublic, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		return CreateExamResponse{ExamId: examId}, nil
+	}
+}
+
+type UpdateExamRequest struct {
+	ExamId          string
+	Topic           string
+	Description     string
+	Tags            []string
+	IsPublic        bool
+	Settings        *model.ExamSettings
+	ExpectedVersion *int32
+	UserId          string
+}
+
+type UpdateExamResponse struct {
+	ExamId  string
+	Version int32
+}
+
+func makeUpdateExamEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(UpdateExamRequest)
+		examId, version, err := examService.UpdateExam(
+			ctx,
+			req.ExamId,
+			req.Topic,
+			req.Description,
+			req.Tags,
+			req.IsPublic,
+			req.Settings,
+			req.ExpectedVersion,
+			req.UserId,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return UpdateExamResponse{ExamId: examId, Version: version}, nil
+	}
+}
+
+type GetExamRequest struct {
+	ExamId string
+	UserId string
+}
+
+type GetExamResponse struct {
+	Exam *model.Exam
+}
+
+func makeGetExamEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetExamRequest)
+		exam, err := examService.GetExam(ctx, req.ExamId, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		return GetExamResponse{Exam: exam}, nil
+	}
+}
+
+type FindExamsRequest struct {
+	PageIndex int32
+	PageSize  int32
+	UserId    string
+	Cursor    string
+}
+
+type FindExamsResponse struct {
+	Total      int32
+	PageCount  int32
+	Exams      []model.Exam
+	NextCursor string
+}
+
+func makeFindExamsEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(FindExamsRequest)
+		total, pageCount, exams, nextCursor, err := examService.FindExams(
+			ctx,
+			req.PageIndex,
+			req.PageSize,
+			req.UserId,
+			req.Cursor,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return FindExamsResponse{
+			Total:      total,
+			PageCount:  pageCount,
+			Exams:      exams,
+			NextCursor: nextCursor,
+		}, nil
+	}
+}
+
+type DeleteExamRequest struct {
+	ExamId string
+	UserId string
+}
+
+type DeleteExamResponse struct{}
+
+func makeDeleteExamEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(DeleteExamRequest)
+		err := examService.DeleteExam(ctx, req.ExamId, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		return DeleteExamResponse{}, nil
+	}
+}
+
+type CreatePracticeExamRequest struct {
+	Topic         string
+	Description   string
+	PracticeWords []service.PracticeWord
+	QuestionTypes []string
+	UserId        string
+}
+
+type CreatePracticeExamResponse struct {
+	ExamId        string
+	QuestionCount int32
+}
+
+func makeCreatePracticeExamEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CreatePracticeExamRequest)
+		examId, questionCount, err := examService.CreatePracticeExam(
+			ctx,
+			req.Topic,
+			req.Description,
+			req.PracticeWords,
+			req.QuestionTypes,
+			req.UserId,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return CreatePracticeExamResponse{
+			ExamId:        examId,
+			QuestionCount: questionCount,
+		}, nil
+	}
+}
+
+type FindExamsByTagsRequest struct {
+	Tags      []string
+	UserId    string
+	PageIndex int32
+	PageSize  int32
+}
+
+type FindExamsByTagsResponse struct {
+	Total     int32
+	PageCount int32
+	Exams     []model.Exam
+}
+
+func makeFindExamsByTagsEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(FindExamsByTagsRequest)
+		total, pageCount, exams, err := examService.FindExamsByTags(
+			ctx,
+			req.Tags,
+			req.UserId,
+			req.PageIndex,
+			req.PageSize,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return FindExamsByTagsResponse{
+			Total:     total,
+			PageCount: pageCount,
+			Exams:     exams,
+		}, nil
+	}
+}
+
+type FindExamTagCountsRequest struct {
+	UserId string
+	Size   int32
+}
+
+type FindExamTagCountsResponse struct {
+	TagCounts []repository.TagCount
+}
+
+func makeFindExamTagCountsEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(FindExamTagCountsRequest)
+		tagCounts, err := examService.FindExamTagCounts(ctx, req.UserId, req.Size)
+		if err != nil {
+			return nil, err
+		}
+		return FindExamTagCountsResponse{TagCounts: tagCounts}, nil
+	}
+}
+
+type ForkExamRequest struct {
+	ExamId string
+	UserId string
+}
+
+type ForkExamResponse struct {
+	ExamId        string
+	QuestionCount int32
+}
+
+func makeForkExamEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ForkExamRequest)
+		examId, questionCount, err := examService.ForkExam(ctx, req.ExamId, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		return ForkExamResponse{
+			ExamId:        examId,
+			QuestionCount: questionCount,
+		}, nil
+	}
+}
+
+type PublishExamRequest struct {
+	ExamId string
+	UserId string
+}
+
+type PublishExamResponse struct {
+	ExamId        string
+	Version       int32
+	QuestionCount int32
+}
+
+func makePublishExamEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(PublishExamRequest)
+		version, questionCount, err := examService.PublishExam(ctx, req.ExamId, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		return PublishExamResponse{
+			ExamId:        req.ExamId,
+			Version:       version,
+			QuestionCount: questionCount,
+		}, nil
+	}
+}
+
+type ShareExamRequest struct {
+	ExamId       string
+	SharedUserId string
+	Role         string
+	UserId       string
+}
+
+type ShareExamResponse struct{}
+
+func makeShareExamEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ShareExamRequest)
+		err := examService.ShareExam(ctx, req.ExamId, req.SharedUserId, req.Role, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		return ShareExamResponse{}, nil
+	}
+}
+
+type UnshareExamRequest struct {
+	ExamId       string
+	SharedUserId string
+	UserId       string
+}
+
+type UnshareExamResponse struct{}
+
+func makeUnshareExamEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(UnshareExamRequest)
+		err := examService.UnshareExam(ctx, req.ExamId, req.SharedUserId, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		return UnshareExamResponse{}, nil
+	}
+}
+
+type FindExamSharesRequest struct {
+	ExamId string
+	UserId string
+}
+
+type FindExamSharesResponse struct {
+	Shares []model.ExamShare
+}
+
+func makeFindExamSharesEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(FindExamSharesRequest)
+		shares, err := examService.FindExamShares(ctx, req.ExamId, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		return FindExamSharesResponse{
+			Shares: shares,
+		}, nil
+	}
+}
+
+type ImportExamRequest struct {
+	Topic       string
+	Description string
+	Tags        []string
+	Format      string
+	Content     []byte
+	UserId      string
+}
+
+type ImportExamResponse struct {
+	ExamId        string
+	QuestionCount int32
+	ImportErrors  []service.ExamImportError
+}
+
+func makeImportExamEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ImportExamRequest)
+		examId, questionCount, importErrors, err := examService.ImportExam(
+			ctx,
+			req.Topic,
+			req.Description,
+			req.Tags,
+			req.Format,
+			req.Content,
+			req.UserId,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return ImportExamResponse{
+			ExamId:        examId,
+			QuestionCount: questionCount,
+			ImportErrors:  importErrors,
+		}, nil
+	}
+}
+
+/*
+Send 用來將匯出的內容分批傳送給 gRPC stream，
+讓串流的 RPC 也能使用相同的 endpoint middleware
+*/
+type ExportExamRequest struct {
+	ExamId string
+	UserId string
+	Format string
+	Send   func(chunk []byte) error
+}
+
+type ExportExamResponse struct{}
+
+func makeExportExamEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ExportExamRequest)
+		err := examService.ExportExam(ctx, req.ExamId, req.UserId, req.Format, req.Send)
+		if err != nil {
+			return nil, err
+		}
+		return ExportExamResponse{}, nil
+	}
+}
+
+type CreateQuestionRequest struct {
+	ExamId          string
+	Ask             string
+	Answers         []string
+	AcceptedAnswers [][]string
+	Points          int32
+	PartialCredit   bool
+	Hint            string
+	Explanation     string
+	SectionId       string
+	UserId          string
+}
+
+type CreateQuestionResponse struct {
+	QuestionId string
+}
+
+func makeCreateQuestionEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CreateQuestionRequest)
+		questionId, err := examService.CreateQuestion(
+			ctx,
+			req.ExamId,
+			req.Ask,
+			req.Answers,
+			req.AcceptedAnswers,
+			req.Points,
+			req.PartialCredit,
+			req.Hint,
+			req.Explanation,
+			req.SectionId,
+			req.UserId,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return CreateQuestionResponse{QuestionId: questionId}, nil
+	}
+}
+
+type UpdateQuestionRequest struct {
+	QuestionId      string
+	Ask             string
+	Answers         []string
+	AcceptedAnswers [][]string
+	Points          int32
+	PartialCredit   bool
+	Hint            string
+	Explanation     string
+	SectionId       string
+	ExpectedVersion *int32
+	UserId          string
+}
+
+type UpdateQuestionResponse struct {
+	QuestionId string
+	Version    int32
+}
+
+func makeUpdateQuestionEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(UpdateQuestionRequest)
+		questionId, version, err := examService.UpdateQuestion(
+			ctx,
+			req.QuestionId,
+			req.Ask,
+			req.Answers,
+			req.AcceptedAnswers,
+			req.Points,
+			req.PartialCredit,
+			req.Hint,
+			req.Explanation,
+			req.SectionId,
+			req.ExpectedVersion,
+			req.UserId,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return UpdateQuestionResponse{QuestionId: questionId, Version: version}, nil
+	}
+}
+
+type GetQuestionRequest struct {
+	QuestionId string
+	UserId     string
+}
+
+type GetQuestionResponse struct {
+	Question *model.Question
+}
+
+func makeGetQuestionEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetQuestionRequest)
+		question, err := examService.GetQuestion(ctx, req.QuestionId, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		return GetQuestionResponse{Question: question}, nil
+	}
+}
+
+type FindQuestionsRequest struct {
+	PageIndex int32
+	PageSize  int32
+	ExamId    string
+	UserId    string
+	Cursor    string
+}
+
+type FindQuestionsResponse struct {
+	Total      int32
+	PageCount  int32
+	Questions  []model.Question
+	NextCursor string
+}
+
+func makeFindQuestionsEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(FindQuestionsRequest)
+		total, pageCount, quesitons, nextCursor, err := examService.FindQuestions(
+			ctx,
+			req.PageIndex,
+			req.PageSize,
+			req.ExamId,
+			req.UserId,
+			req.Cursor,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return FindQuestionsResponse{
+			Total:      total,
+			PageCount:  pageCount,
+			Questions:  quesitons,
+			NextCursor: nextCursor,
+		}, nil
+	}
+}
+
+type DeleteQuestionRequest struct {
+	QuestionId string
+	UserId     string
+}
+
+type DeleteQuestionResponse struct{}
+
+func makeDeleteQuestionEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(DeleteQuestionRequest)
+		err := examService.DeleteQuestion(
+			ctx, req.QuestionId, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		return DeleteQuestionResponse{}, nil
+	}
+}
+
+type BatchMutateQuestionsRequest struct {
+	ExamId     string
+	Operations []service.QuestionOperation
+	UserId     string
+}
+
+type BatchMutateQuestionsResponse struct {
+	Applied bool
+	Results []service.QuestionOperationResult
+}
+
+func makeBatchMutateQuestionsEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(BatchMutateQuestionsRequest)
+		applied, results, err := examService.BatchMutateQuestions(
+			ctx,
+			req.ExamId,
+			req.Operations,
+			req.UserId,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return BatchMutateQuestionsResponse{
+			Applied: applied,
+			Results: results,
+		}, nil
+	}
+}
+
+type CreateExamRecordRequest struct {
+	ExamId          string
+	ExamAttemptId   string
+	DurationSeconds int32
+	Answers         []model.ExamAttemptAnswer
+	UserId          string
+}
+
+type CreateExamRecordResponse struct {
+	ExamRecord *model.ExamRecord
+	Questions  []model.Question
+}
+
+func makeCreateExamRecordEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CreateExamRecordRequest)
+		examRecord, questions, err := examService.CreateExamRecord(
+			ctx,
+			req.ExamId,
+			req.ExamAttemptId,
+			req.DurationSeconds,
+			req.Answers,
+			req.UserId,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return CreateExamRecordResponse{
+			ExamRecord: examRecord,
+			Questions:  questions,
+		}, nil
+	}
+}
+
+type FindExamRecordsRequest struct {
+	PageIndex int32
+	PageSize  int32
+	ExamId    string
+	UserId    string
+	Cursor    string
+}
+
+type FindExamRecordsResponse struct {
+	Total       int32
+	PageCount   int32
+	ExamRecords []model.ExamRecord
+	NextCursor  string
+}
+
+func makeFindExamRecordsEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(FindExamRecordsRequest)
+		total, pageCount, examRecords, nextCursor, err := examService.FindExamRecords(
+			ctx,
+			req.PageIndex,
+			req.PageSize,
+			req.ExamId,
+			req.UserId,
+			req.Cursor,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return FindExamRecordsResponse{
+			Total:       total,
+			PageCount:   pageCount,
+			ExamRecords: examRecords,
+			NextCursor:  nextCursor,
+		}, nil
+	}
+}
+
+type GetExamRecordDetailRequest struct {
+	ExamId       string
+	ExamRecordId string
+	UserId       string
+}
+
+type GetExamRecordDetailResponse struct {
+	ExamRecord *model.ExamRecord
+	Questions  []model.Question
+}
+
+func makeGetExamRecordDetailEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetExamRecordDetailRequest)
+		examRecord, questions, err := examService.GetExamRecordDetail(
+			ctx,
+			req.ExamId,
+			req.ExamRecordId,
+			req.UserId,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return GetExamRecordDetailResponse{
+			ExamRecord: examRecord,
+			Questions:  questions,
+		}, nil
+	}
+}
+
+type FindExamRecordOverviewRequest struct {
+	ExamId              string
+	UserId              string
+	StartDate           time.Time
+	EndDate             time.Time
+	ExamVersion         int32
+	Range               string
+	Timezone            string
+	MovingAverageWindow int32
+}
+
+type FindExamRecordOverviewResponse struct {
+	StartDate            string
+	Exam                 *model.Exam
+	ExamVersion          int32
+	ExamVersionSummaries []repository.ExamVersionSummary
+	Questions            []model.Question
+	AnswerWrongs         []model.AnswerWrong
+	ExamRecords          []model.ExamRecord
+	Analytics            service.ExamRecordAnalytics
+}
+
+func makeFindExamRecordOverviewEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(FindExamRecordOverviewRequest)
+		startDate,
+			exam,
+			examVersion,
+			examVersionSummaries,
+			quesitons,
+			answerWrongs,
+			examRecords,
+			analytics,
+			err := examService.FindExamRecordOverview(
+			ctx,
+			req.ExamId,
+			req.UserId,
+			req.StartDate,
+			req.EndDate,
+			req.ExamVersion,
+			req.Range,
+			req.Timezone,
+			req.MovingAverageWindow,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return FindExamRecordOverviewResponse{
+			StartDate:            startDate,
+			Exam:                 exam,
+			ExamVersion:          examVersion,
+			ExamVersionSummaries: examVersionSummaries,
+			Questions:            quesitons,
+			AnswerWrongs:         answerWrongs,
+			ExamRecords:          examRecords,
+			Analytics:            analytics,
+		}, nil
+	}
+}
+
+type FindExamLeaderboardRequest struct {
+	ExamId          string
+	Window          string
+	Size            int32
+	ExcludedUserIds []string
+}
+
+type FindExamLeaderboardResponse struct {
+	LeaderboardEntries []repository.LeaderboardEntry
+}
+
+func makeFindExamLeaderboardEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(FindExamLeaderboardRequest)
+		leaderboardEntries, err := examService.FindExamLeaderboard(
+			ctx,
+			req.ExamId,
+			req.Window,
+			req.Size,
+			req.ExcludedUserIds,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return FindExamLeaderboardResponse{
+			LeaderboardEntries: leaderboardEntries,
+		}, nil
+	}
+}
+
+type FindQuestionStatisticsRequest struct {
+	ExamId string
+	UserId string
+}
+
+type FindQuestionStatisticsResponse struct {
+	Summary            repository.ExamStatisticsSummary
+	QuestionStatistics []service.QuestionStatistic
+}
+
+func makeFindQuestionStatisticsEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(FindQuestionStatisticsRequest)
+		summary, questionStatistics, err := examService.FindQuestionStatistics(
+			ctx,
+			req.ExamId,
+			req.UserId,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return FindQuestionStatisticsResponse{
+			Summary:            summary,
+			QuestionStatistics: questionStatistics,
+		}, nil
+	}
+}
+
+type FindMistakeNotebookRequest struct {
+	UserId    string
+	ExamId    string
+	StartDate time.Time
+	EndDate   time.Time
+	PageIndex int32
+	PageSize  int32
+}
+
+type FindMistakeNotebookResponse struct {
+	Total     int32
+	PageCount int32
+	Entries   []service.MistakeNotebookEntry
+}
+
+func makeFindMistakeNotebookEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(FindMistakeNotebookRequest)
+		total, pageCount, entries, err := examService.FindMistakeNotebook(
+			ctx,
+			req.UserId,
+			req.ExamId,
+			req.StartDate,
+			req.EndDate,
+			req.PageIndex,
+			req.PageSize,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return FindMistakeNotebookResponse{
+			Total:     total,
+			PageCount: pageCount,
+			Entries:   entries,
+		}, nil
+	}
+}
+
+type CreateMistakeDrillRequest struct {
+	UserId string
+	ExamId string
+	Size   int32
+}
+
+type CreateMistakeDrillResponse struct {
+	Entries               []service.MistakeNotebookEntry
+	RequiredCorrectStreak int32
+}
+
+func makeCreateMistakeDrillEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CreateMistakeDrillRequest)
+		entries, requiredCorrectStreak, err := examService.CreateMistakeDrill(
+			ctx,
+			req.UserId,
+			req.ExamId,
+			req.Size,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return CreateMistakeDrillResponse{
+			Entries:               entries,
+			RequiredCorrectStreak: requiredCorrectStreak,
+		}, nil
+	}
+}
+
+type AnswerMistakeDrillRequest struct {
+	AnswerWrongId string
+	UserId        string
+	BlankAnswers  []string
+}
+
+type AnswerMistakeDrillResponse struct {
+	IsCorrect      bool
+	CorrectAnswers []string
+	CorrectStreak  int32
+	Removed        bool
+}
+
+func makeAnswerMistakeDrillEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(AnswerMistakeDrillRequest)
+		isCorrect, correctAnswers, correctStreak, removed, err := examService.AnswerMistakeDrill(
+			ctx,
+			req.AnswerWrongId,
+			req.UserId,
+			req.BlankAnswers,
+		)
 		if err != nil {
 			return nil, err
 		}
-		return CreateExamResponse{ExamId: examId}, nil
+		return AnswerMistakeDrillResponse{
+			IsCorrect:      isCorrect,
+			CorrectAnswers: correctAnswers,
+			CorrectStreak:  correctStreak,
+			Removed:        removed,
+		}, nil
 	}
 }
 
-type UpdateExamRequest struct {
-	ExamId      string
-	Topic       string
-	Description string
-	IsPublic    bool
-	UserId      string
+type FindExamInfosRequest struct {
+	UserId   string
+	IsPublic bool
+	Tags     []string
 }
 
-type UpdateExamResponse struct {
-	ExamId string
+type FindExamInfosResponse struct {
+	ExamInfos []service.ExamInfo
 }
 
-func makeUpdateExamEndpoint(examService service.ExamService) endpoint.Endpoint {
+func makeFindExamInfosEndpoint(examService service.ExamService) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(UpdateExamRequest)
-		examId, err := examService.UpdateExam(
-			ctx, req.ExamId, req.Topic, req.Description, req.IsPublic, req.UserId)
+		req := request.(FindExamInfosRequest)
+		examInfos, err := examService.FindExamInfos(
+			ctx,
+			req.UserId,
+			req.IsPublic,
+			req.Tags,
+		)
 		if err != nil {
 			return nil, err
 		}
-		return UpdateExamResponse{ExamId: examId}, nil
+		return FindExamInfosResponse{
+			ExamInfos: examInfos,
+		}, nil
 	}
 }
 
-type FindExamsRequest struct {
+type FindExamCatalogRequest struct {
+	Keyword   string
+	Tags      []string
+	SortBy    string
 	PageIndex int32
 	PageSize  int32
-	UserId    string
 }
 
-type FindExamsResponse struct {
-	Total     int32
-	PageCount int32
-	Exams     []model.Exam
+type FindExamCatalogResponse struct {
+	Total         int32
+	PageCount     int32
+	ExamSummaries []repository.ExamSummary
 }
 
-func makeFindExamsEndpoint(examService service.ExamService) endpoint.Endpoint {
+func makeFindExamCatalogEndpoint(examService service.ExamService) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(FindExamsRequest)
-		total, pageCount, exams, err := examService.FindExams(
+		req := request.(FindExamCatalogRequest)
+		total, pageCount, examSummaries, err := examService.FindExamCatalog(
 			ctx,
+			req.Keyword,
+			req.Tags,
+			req.SortBy,
 			req.PageIndex,
 			req.PageSize,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return FindExamCatalogResponse{
+			Total:         total,
+			PageCount:     pageCount,
+			ExamSummaries: examSummaries,
+		}, nil
+	}
+}
+
+type CreateClassRequest struct {
+	Name        string
+	Description string
+	UserId      string
+}
+
+type CreateClassResponse struct {
+	ClassId  string
+	JoinCode string
+}
+
+func makeCreateClassEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CreateClassRequest)
+		classId, joinCode, err := examService.CreateClass(
+			ctx,
+			req.Name,
+			req.Description,
 			req.UserId,
 		)
 		if err != nil {
 			return nil, err
 		}
-		return FindExamsResponse{
-			Total:     total,
-			PageCount: pageCount,
-			Exams:     exams,
+		return CreateClassResponse{
+			ClassId:  classId,
+			JoinCode: joinCode,
 		}, nil
 	}
 }
 
-type DeleteExamRequest struct {
-	ExamId string
+type JoinClassRequest struct {
+	JoinCode string
+	UserId   string
+}
+
+type JoinClassResponse struct {
+	Class *model.Class
+}
+
+func makeJoinClassEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(JoinClassRequest)
+		class, err := examService.JoinClass(ctx, req.JoinCode, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		return JoinClassResponse{
+			Class: class,
+		}, nil
+	}
+}
+
+type FindClassesRequest struct {
 	UserId string
 }
 
-type DeleteExamResponse struct{}
+type FindClassesResponse struct {
+	Classes []model.Class
+}
 
-func makeDeleteExamEndpoint(examService service.ExamService) endpoint.Endpoint {
+func makeFindClassesEndpoint(examService service.ExamService) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(DeleteExamRequest)
-		err := examService.DeleteExam(ctx, req.ExamId, req.UserId)
+		req := request.(FindClassesRequest)
+		classes, err := examService.FindClasses(ctx, req.UserId)
 		if err != nil {
 			return nil, err
 		}
-		return DeleteExamResponse{}, nil
+		return FindClassesResponse{
+			Classes: classes,
+		}, nil
 	}
 }
 
-type CreateQuestionRequest struct {
+type CreateAssignmentRequest struct {
+	ClassId string
 	ExamId  string
-	Ask     string
-	Answers []string
+	Title   string
+	DueAt   time.Time
 	UserId  string
 }
 
-type CreateQuestionResponse struct {
-	QuestionId string
+type CreateAssignmentResponse struct {
+	AssignmentId string
 }
 
-func makeCreateQuestionEndpoint(examService service.ExamService) endpoint.Endpoint {
+func makeCreateAssignmentEndpoint(examService service.ExamService) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(CreateQuestionRequest)
-		questionId, err := examService.CreateQuestion(
-			ctx, req.ExamId, req.Ask, req.Answers, req.UserId)
+		req := request.(CreateAssignmentRequest)
+		assignmentId, err := examService.CreateAssignment(
+			ctx,
+			req.ClassId,
+			req.ExamId,
+			req.Title,
+			req.DueAt,
+			req.UserId,
+		)
 		if err != nil {
 			return nil, err
 		}
-		return CreateQuestionResponse{QuestionId: questionId}, nil
+		return CreateAssignmentResponse{
+			AssignmentId: assignmentId,
+		}, nil
 	}
 }
 
-type UpdateQuestionRequest struct {
-	QuestionId string
-	Ask        string
-	Answers    []string
-	UserId     string
+type FindAssignmentsRequest struct {
+	ClassId string
+	UserId  string
 }
 
-type UpdateQuestionResponse struct {
-	QuestionId string
+type FindAssignmentsResponse struct {
+	Assignments []model.Assignment
 }
 
-func makeUpdateQuestionEndpoint(examService service.ExamService) endpoint.Endpoint {
+func makeFindAssignmentsEndpoint(examService service.ExamService) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(UpdateQuestionRequest)
-		questionId, err := examService.UpdateQuestion(
-			ctx, req.QuestionId, req.Ask, req.Answers, req.UserId)
+		req := request.(FindAssignmentsRequest)
+		assignments, err := examService.FindAssignments(ctx, req.ClassId, req.UserId)
 		if err != nil {
 			return nil, err
 		}
-		return UpdateQuestionResponse{QuestionId: questionId}, nil
+		return FindAssignmentsResponse{
+			Assignments: assignments,
+		}, nil
 	}
 }
 
-type FindQuestionsRequest struct {
-	PageIndex int32
-	PageSize  int32
-	ExamId    string
-	UserId    string
+type FindClassGradebookRequest struct {
+	ClassId string
+	UserId  string
 }
 
-type FindQuestionsResponse struct {
-	Total     int32
-	PageCount int32
-	Questions []model.Question
+type FindClassGradebookResponse struct {
+	Class       *model.Class
+	Assignments []model.Assignment
+	Grades      []service.ClassGrade
 }
 
-func makeFindQuestionsEndpoint(examService service.ExamService) endpoint.Endpoint {
+func makeFindClassGradebookEndpoint(examService service.ExamService) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(FindQuestionsRequest)
-		total, pageCount, quesitons, err := examService.FindQuestions(
+		req := request.(FindClassGradebookRequest)
+		class, assignments, grades, err := examService.FindClassGradebook(
+			ctx,
+			req.ClassId,
+			req.UserId,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return FindClassGradebookResponse{
+			Class:       class,
+			Assignments: assignments,
+			Grades:      grades,
+		}, nil
+	}
+}
+
+type FindRandomQuestionsRequest struct {
+	ExamId string
+	UserId string
+	Size   int32
+}
+
+type FindRandomQuestionsResponse struct {
+	Exam          *model.Exam
+	Questions     []model.Question
+	Sections      []model.ExamSection
+	ExamAttemptId string
+}
+
+func makeFindRandomQuestionsEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(FindRandomQuestionsRequest)
+		exam, quesitons, sections, examAttemptId, err := examService.FindRandomQuestions(
 			ctx,
-			req.PageIndex,
-			req.PageSize,
 			req.ExamId,
 			req.UserId,
+			req.Size,
 		)
 		if err != nil {
 			return nil, err
 		}
-		return FindQuestionsResponse{
-			Total:     total,
-			PageCount: pageCount,
-			Questions: quesitons,
+		return FindRandomQuestionsResponse{
+			Exam:          exam,
+			Questions:     quesitons,
+			Sections:      sections,
+			ExamAttemptId: examAttemptId,
 		}, nil
 	}
 }
 
-type DeleteQuestionRequest struct {
-	QuestionId string
-	UserId     string
+type RevealQuestionHintRequest struct {
+	ExamId        string
+	ExamAttemptId string
+	QuestionId    string
+	UserId        string
 }
 
-type DeleteQuestionResponse struct{}
+type RevealQuestionHintResponse struct {
+	Hint string
+}
 
-func makeDeleteQuestionEndpoint(examService service.ExamService) endpoint.Endpoint {
+func makeRevealQuestionHintEndpoint(examService service.ExamService) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(DeleteQuestionRequest)
-		err := examService.DeleteQuestion(
-			ctx, req.QuestionId, req.UserId)
+		req := request.(RevealQuestionHintRequest)
+		hint, err := examService.RevealQuestionHint(
+			ctx,
+			req.ExamId,
+			req.ExamAttemptId,
+			req.QuestionId,
+			req.UserId,
+		)
 		if err != nil {
 			return nil, err
 		}
-		return DeleteQuestionResponse{}, nil
+		return RevealQuestionHintResponse{Hint: hint}, nil
 	}
 }
 
-type CreateExamRecordRequest struct {
-	ExamId           string
-	Score            int32
-	WrongQuestionIds []string
-	UserId           string
+type CreateBankQuestionRequest struct {
+	Ask           string
+	Answers       []string
+	Tags          []string
+	Points        int32
+	PartialCredit bool
+	Hint          string
+	Explanation   string
+	UserId        string
 }
 
-type CreateExamRecordResponse struct{}
+type CreateBankQuestionResponse struct {
+	BankQuestionId string
+}
 
-func makeCreateExamRecordEndpoint(examService service.ExamService) endpoint.Endpoint {
+func makeCreateBankQuestionEndpoint(examService service.ExamService) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(CreateExamRecordRequest)
-		err := examService.CreateExamRecord(
-			ctx, req.ExamId, req.Score, req.WrongQuestionIds, req.UserId)
+		req := request.(CreateBankQuestionRequest)
+		bankQuestionId, err := examService.CreateBankQuestion(
+			ctx,
+			req.Ask,
+			req.Answers,
+			req.Tags,
+			req.Points,
+			req.PartialCredit,
+			req.Hint,
+			req.Explanation,
+			req.UserId,
+		)
 		if err != nil {
 			return nil, err
 		}
-		return CreateExamRecordResponse{}, nil
+		return CreateBankQuestionResponse{BankQuestionId: bankQuestionId}, nil
 	}
 }
 
-type FindExamRecordsRequest struct {
+type UpdateBankQuestionRequest struct {
+	BankQuestionId string
+	Ask            string
+	Answers        []string
+	Tags           []string
+	Points         int32
+	PartialCredit  bool
+	Hint           string
+	Explanation    string
+	UserId         string
+}
+
+type UpdateBankQuestionResponse struct {
+	BankQuestionId string
+}
+
+func makeUpdateBankQuestionEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(UpdateBankQuestionRequest)
+		bankQuestionId, err := examService.UpdateBankQuestion(
+			ctx,
+			req.BankQuestionId,
+			req.Ask,
+			req.Answers,
+			req.Tags,
+			req.Points,
+			req.PartialCredit,
+			req.Hint,
+			req.Explanation,
+			req.UserId,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return UpdateBankQuestionResponse{BankQuestionId: bankQuestionId}, nil
+	}
+}
+
+type DeleteBankQuestionRequest struct {
+	BankQuestionId string
+	UserId         string
+}
+
+type DeleteBankQuestionResponse struct{}
+
+func makeDeleteBankQuestionEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(DeleteBankQuestionRequest)
+		err := examService.DeleteBankQuestion(ctx, req.BankQuestionId, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		return DeleteBankQuestionResponse{}, nil
+	}
+}
+
+type FindBankQuestionsRequest struct {
 	PageIndex int32
 	PageSize  int32
-	ExamId    string
+	Tag       string
 	UserId    string
 }
 
-type FindExamRecordsResponse struct {
-	Total       int32
-	PageCount   int32
-	ExamRecords []model.ExamRecord
+type FindBankQuestionsResponse struct {
+	Total         int32
+	PageCount     int32
+	BankQuestions []model.BankQuestion
 }
 
-func makeFindExamRecordsEndpoint(examService service.ExamService) endpoint.Endpoint {
+func makeFindBankQuestionsEndpoint(examService service.ExamService) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(FindExamRecordsRequest)
-		total, pageCount, examRecords, err := examService.FindExamRecords(
+		req := request.(FindBankQuestionsRequest)
+		total, pageCount, bankQuestions, err := examService.FindBankQuestions(
 			ctx,
 			req.PageIndex,
 			req.PageSize,
-			req.ExamId,
+			req.Tag,
 			req.UserId,
 		)
 		if err != nil {
 			return nil, err
 		}
-		return FindExamRecordsResponse{
-			Total:       total,
-			PageCount:   pageCount,
-			ExamRecords: examRecords,
+		return FindBankQuestionsResponse{
+			Total:         total,
+			PageCount:     pageCount,
+			BankQuestions: bankQuestions,
 		}, nil
 	}
 }
 
-type FindExamRecordOverviewRequest struct {
-	ExamId    string
-	UserId    string
-	StartDate time.Time
+type SetExamBankSourceRequest struct {
+	ExamId      string
+	QuestionIds []string
+	Pools       []model.ExamBankPool
+	UserId      string
 }
 
-type FindExamRecordOverviewResponse struct {
-	StartDate    string
-	Exam         *model.Exam
-	Questions    []model.Question
-	AnswerWrongs []model.AnswerWrong
-	ExamRecords  []model.ExamRecord
+type SetExamBankSourceResponse struct {
+	Exam *model.Exam
 }
 
-func makeFindExamRecordOverviewEndpoint(examService service.ExamService) endpoint.Endpoint {
+func makeSetExamBankSourceEndpoint(examService service.ExamService) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(FindExamRecordOverviewRequest)
-		startDate, exam, quesitons, answerWrongs, examRecords, err := examService.FindExamRecordOverview(
+		req := request.(SetExamBankSourceRequest)
+		exam, err := examService.SetExamBankSource(
 			ctx,
 			req.ExamId,
+			req.QuestionIds,
+			req.Pools,
 			req.UserId,
-			req.StartDate,
 		)
 		if err != nil {
 			return nil, err
 		}
-		return FindExamRecordOverviewResponse{
-			StartDate:    startDate,
-			Exam:         exam,
-			Questions:    quesitons,
-			AnswerWrongs: answerWrongs,
-			ExamRecords:  examRecords,
-		}, nil
+		return SetExamBankSourceResponse{Exam: exam}, nil
 	}
 }
 
-type FindExamInfosRequest struct {
+type CreateExamSectionRequest struct {
+	ExamId   string
+	Type     string
+	Title    string
+	Passage  string
+	AudioUrl string
+	Order    int32
 	UserId   string
-	IsPublic bool
 }
 
-type FindExamInfosResponse struct {
-	ExamInfos []service.ExamInfo
+type CreateExamSectionResponse struct {
+	SectionId string
 }
 
-func makeFindExamInfosEndpoint(examService service.ExamService) endpoint.Endpoint {
+func makeCreateExamSectionEndpoint(examService service.ExamService) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(FindExamInfosRequest)
-		examInfos, err := examService.FindExamInfos(
+		req := request.(CreateExamSectionRequest)
+		sectionId, err := examService.CreateExamSection(
 			ctx,
+			req.ExamId,
+			req.Type,
+			req.Title,
+			req.Passage,
+			req.AudioUrl,
+			req.Order,
 			req.UserId,
-			req.IsPublic,
 		)
 		if err != nil {
 			return nil, err
 		}
-		return FindExamInfosResponse{
-			ExamInfos: examInfos,
-		}, nil
+		return CreateExamSectionResponse{SectionId: sectionId}, nil
 	}
 }
 
-type FindRandomQuestionsRequest struct {
-	ExamId string
-	UserId string
-	Size   int32
+type UpdateExamSectionRequest struct {
+	SectionId string
+	Type      string
+	Title     string
+	Passage   string
+	AudioUrl  string
+	Order     int32
+	UserId    string
 }
 
-type FindRandomQuestionsResponse struct {
-	Exam      *model.Exam
-	Questions []model.Question
+type UpdateExamSectionResponse struct {
+	SectionId string
 }
 
-func makeFindRandomQuestionsEndpoint(examService service.ExamService) endpoint.Endpoint {
+func makeUpdateExamSectionEndpoint(examService service.ExamService) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(FindRandomQuestionsRequest)
-		exam, quesitons, err := examService.FindRandomQuestions(
+		req := request.(UpdateExamSectionRequest)
+		sectionId, err := examService.UpdateExamSection(
 			ctx,
-			req.ExamId,
+			req.SectionId,
+			req.Type,
+			req.Title,
+			req.Passage,
+			req.AudioUrl,
+			req.Order,
 			req.UserId,
-			req.Size,
 		)
 		if err != nil {
 			return nil, err
 		}
-		return FindRandomQuestionsResponse{
-			Exam:      exam,
-			Questions: quesitons,
-		}, nil
+		return UpdateExamSectionResponse{SectionId: sectionId}, nil
+	}
+}
+
+type DeleteExamSectionRequest struct {
+	SectionId string
+	UserId    string
+}
+
+type DeleteExamSectionResponse struct{}
+
+func makeDeleteExamSectionEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(DeleteExamSectionRequest)
+		err := examService.DeleteExamSection(ctx, req.SectionId, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		return DeleteExamSectionResponse{}, nil
+	}
+}
+
+type FindExamSectionsRequest struct {
+	ExamId string
+	UserId string
+}
+
+type FindExamSectionsResponse struct {
+	Sections []model.ExamSection
+}
+
+func makeFindExamSectionsEndpoint(examService service.ExamService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(FindExamSectionsRequest)
+		sections, err := examService.FindExamSections(ctx, req.ExamId, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		return FindExamSectionsResponse{Sections: sections}, nil
 	}
 }
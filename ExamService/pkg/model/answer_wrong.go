@@ -6,12 +6,18 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+/*
+使用者答錯的題目，也就是錯題本中的一題，UpdatedAt 是最後一次答錯的時間，
+CorrectStreak 是錯題練習中連續答對的次數，再次答錯時歸零
+*/
 type AnswerWrong struct {
-	Id         primitive.ObjectID `json:"_id"        bson:"_id,omitempty"`
-	ExamId     string             `json:"examId"     bson:"examId"`
-	QuestionId string             `json:"questionId" bson:"questionId"`
-	Times      int32              `json:"times"      bson:"times"`
-	UserId     string             `json:"userId"     bson:"userId"`
-	CreatedAt  time.Time          `json:"createdAt"  bson:"createdAt"`
-	UpdatedAt  time.Time          `json:"updatedAt"  bson:"updatedAt"`
+	Id            primitive.ObjectID `json:"_id"           bson:"_id,omitempty"`
+	ExamId        string             `json:"examId"        bson:"examId"`
+	ExamVersion   int32              `json:"examVersion"   bson:"examVersion"`
+	QuestionId    string             `json:"questionId"    bson:"questionId"`
+	Times         int32              `json:"times"         bson:"times"`
+	CorrectStreak int32              `json:"correctStreak" bson:"correctStreak,omitempty"`
+	UserId        string             `json:"userId"        bson:"userId"`
+	CreatedAt     time.Time          `json:"createdAt"     bson:"createdAt"`
+	UpdatedAt     time.Time          `json:"updatedAt"     bson:"updatedAt"`
 }
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 老師指派給班級的測驗，UserId 是指派作業的老師
+type Assignment struct {
+	Id        primitive.ObjectID `json:"_id"       bson:"_id,omitempty"`
+	ClassId   string             `json:"classId"   bson:"classId"`
+	ExamId    string             `json:"examId"    bson:"examId"`
+	Title     string             `json:"title"     bson:"title"`
+	DueAt     time.Time          `json:"dueAt"     bson:"dueAt"`
+	UserId    string             `json:"userId"    bson:"userId"`
+	CreatedAt time.Time          `json:"createdAt" bson:"createdAt"`
+	UpdatedAt time.Time          `json:"updatedAt" bson:"updatedAt"`
+}
@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+/*
+使用者題庫中的題目，不屬於任何測驗，測驗透過 Exam.BankSource 引用，
+Tags 用於從題庫中隨機抽題，其他欄位與 Question 相同
+*/
+type BankQuestion struct {
+	Id            primitive.ObjectID `json:"_id"           bson:"_id,omitempty"`
+	Ask           string             `json:"ask"           bson:"ask"`
+	Answers       []string           `json:"answers"       bson:"answers"`
+	Tags          []string           `json:"tags"          bson:"tags"`
+	Points        int32              `json:"points"        bson:"points,omitempty"`
+	PartialCredit bool               `json:"partialCredit" bson:"partialCredit,omitempty"`
+	Hint          string             `json:"hint"          bson:"hint,omitempty"`
+	Explanation   string             `json:"explanation"   bson:"explanation,omitempty"`
+	UserId        string             `json:"userId"        bson:"userId"`
+	CreatedAt     time.Time          `json:"createdAt"     bson:"createdAt"`
+	UpdatedAt     time.Time          `json:"updatedAt"     bson:"updatedAt"`
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 班級，UserId 是建立班級的老師，學生使用加入代碼加入
+type Class struct {
+	Id          primitive.ObjectID `json:"_id"         bson:"_id,omitempty"`
+	Name        string             `json:"name"        bson:"name"`
+	Description string             `json:"description" bson:"description"`
+	JoinCode    string             `json:"joinCode"    bson:"joinCode"`
+	UserId      string             `json:"userId"      bson:"userId"`
+	Students    []ClassStudent     `json:"students"    bson:"students"`
+	CreatedAt   time.Time          `json:"createdAt"   bson:"createdAt"`
+	UpdatedAt   time.Time          `json:"updatedAt"   bson:"updatedAt"`
+}
+
+type ClassStudent struct {
+	UserId   string    `json:"userId"   bson:"userId"`
+	JoinedAt time.Time `json:"joinedAt" bson:"joinedAt"`
+}
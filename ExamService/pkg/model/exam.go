@@ -7,12 +7,56 @@ import (
 )
 
 type Exam struct {
-	Id          primitive.ObjectID `json:"_id"         bson:"_id,omitempty"`
-	Topic       string             `json:"topic"       bson:"topic"`
-	Description string             `json:"description" bson:"description"`
-	Tags        []string           `json:"tags"        bson:"tags"`
-	IsPublic    bool               `json:"isPublic"    bson:"isPublic"`
-	UserId      string             `json:"userId"      bson:"userId"`
-	CreatedAt   time.Time          `json:"createdAt"   bson:"createdAt"`
-	UpdatedAt   time.Time          `json:"updatedAt"   bson:"updatedAt"`
+	Id               primitive.ObjectID `json:"_id"              bson:"_id,omitempty"`
+	Topic            string             `json:"topic"            bson:"topic"`
+	Description      string             `json:"description"      bson:"description"`
+	Tags             []string           `json:"tags"             bson:"tags"`
+	IsPublic         bool               `json:"isPublic"         bson:"isPublic"`
+	UserId           string             `json:"userId"           bson:"userId"`
+	ForkedFromExamId string             `json:"forkedFromExamId" bson:"forkedFromExamId,omitempty"`
+	ForkedFromUserId string             `json:"forkedFromUserId" bson:"forkedFromUserId,omitempty"`
+	LatestVersion    int32              `json:"latestVersion"    bson:"latestVersion"`
+	Shares           []ExamShare        `json:"shares"           bson:"shares,omitempty"`
+	Settings         *ExamSettings      `json:"settings"         bson:"settings,omitempty"`
+	BankSource       *ExamBankSource    `json:"bankSource"       bson:"bankSource,omitempty"`
+	Version          int32              `json:"version"          bson:"version"`
+	CreatedAt        time.Time          `json:"createdAt"        bson:"createdAt"`
+	UpdatedAt        time.Time          `json:"updatedAt"        bson:"updatedAt"`
+}
+
+// 測驗分享的對象與權限，擁有者是 Exam.UserId，不會在分享列表中
+type ExamShare struct {
+	UserId    string    `json:"userId"    bson:"userId"`
+	Role      string    `json:"role"      bson:"role"`
+	CreatedAt time.Time `json:"createdAt" bson:"createdAt"`
+}
+
+/*
+測驗的設定，沒有設定過的測驗 Exam.Settings 為 nil，使用預設的設定，
+QuestionsPerAttempt 為 0 表示由呼叫端決定題數，PassingScorePercent 為 0 表示不設定及格分數，
+MaxAttempts 為 0 表示不限制作答次數，HintPenaltyPercent 為 0 表示查看提示不扣分
+*/
+type ExamSettings struct {
+	QuestionsPerAttempt    int32 `json:"questionsPerAttempt"    bson:"questionsPerAttempt"`
+	ShuffleQuestions       bool  `json:"shuffleQuestions"       bson:"shuffleQuestions"`
+	ShuffleAnswers         bool  `json:"shuffleAnswers"         bson:"shuffleAnswers"`
+	PassingScorePercent    int32 `json:"passingScorePercent"    bson:"passingScorePercent"`
+	MaxAttempts            int32 `json:"maxAttempts"            bson:"maxAttempts"`
+	ShowAnswersAfterSubmit bool  `json:"showAnswersAfterSubmit" bson:"showAnswersAfterSubmit"`
+	HintPenaltyPercent     int32 `json:"hintPenaltyPercent"     bson:"hintPenaltyPercent"`
+}
+
+/*
+測驗引用的題庫題目，題庫是測驗擁有者的題庫，沒有引用過的測驗 Exam.BankSource 為 nil，
+QuestionIds 是每次作答都會出現的題目，Pools 是每次作答從含有標籤的題目中隨機抽題
+*/
+type ExamBankSource struct {
+	QuestionIds []string       `json:"questionIds" bson:"questionIds"`
+	Pools       []ExamBankPool `json:"pools"       bson:"pools"`
+}
+
+// 從題庫中含有 Tag 標籤的題目隨機抽出 Size 題
+type ExamBankPool struct {
+	Tag  string `json:"tag"  bson:"tag"`
+	Size int32  `json:"size" bson:"size"`
 }
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+/*
+開始作答時建立，記錄出給使用者的測驗版本與題目，交卷時只能作答這些題目，
+HintQuestionIds 是作答時查看了提示的題目，交卷時依此扣分，
+SubmittedAt 是交卷的時間，nil 表示還沒有交卷，交卷後不能再次使用
+*/
+type ExamAttempt struct {
+	Id              primitive.ObjectID `json:"_id"             bson:"_id,omitempty"`
+	ExamId          string             `json:"examId"          bson:"examId"`
+	ExamVersion     int32              `json:"examVersion"     bson:"examVersion"`
+	QuestionIds     []string           `json:"questionIds"     bson:"questionIds"`
+	HintQuestionIds []string           `json:"hintQuestionIds" bson:"hintQuestionIds,omitempty"`
+	UserId          string             `json:"userId"          bson:"userId"`
+	SubmittedAt     *time.Time         `json:"submittedAt"     bson:"submittedAt,omitempty"`
+	CreatedAt       time.Time          `json:"createdAt"       bson:"createdAt"`
+	UpdatedAt       time.Time          `json:"updatedAt"       bson:"updatedAt"`
+}
@@ -0,0 +1,17 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 使用者對一個測驗的作答次數，交卷時以條件更新遞增，用來限制作答次數
+type ExamAttemptCount struct {
+	Id        primitive.ObjectID `json:"_id"       bson:"_id,omitempty"`
+	ExamId    string             `json:"examId"    bson:"examId"`
+	UserId    string             `json:"userId"    bson:"userId"`
+	Count     int32              `json:"count"     bson:"count"`
+	CreatedAt time.Time          `json:"createdAt" bson:"createdAt"`
+	UpdatedAt time.Time          `json:"updatedAt" bson:"updatedAt"`
+}
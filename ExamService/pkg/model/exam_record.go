@@ -6,11 +6,41 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+/*
+PassingScorePercent 是作答時的及格分數百分比，0 表示當時沒有設定及格分數，
+EarnedPoints、MaxPoints 和 Percentage 是依照題目配分計算的得分、滿分與百分比，
+舊的作答紀錄沒有這些欄位
+*/
 type ExamRecord struct {
-	Id        primitive.ObjectID `json:"_id"       bson:"_id,omitempty"`
-	ExamId    string             `json:"examId"    bson:"examId"`
-	Score     int32              `json:"score"     bson:"score"`
-	UserId    string             `json:"userId"    bson:"userId"`
-	CreatedAt time.Time          `json:"createdAt" bson:"createdAt"`
-	UpdatedAt time.Time          `json:"updatedAt" bson:"updatedAt"`
+	Id                  primitive.ObjectID  `json:"_id"                 bson:"_id,omitempty"`
+	ExamId              string              `json:"examId"              bson:"examId"`
+	ExamVersion         int32               `json:"examVersion"         bson:"examVersion"`
+	Score               int32               `json:"score"               bson:"score"`
+	DurationSeconds     int32               `json:"durationSeconds"     bson:"durationSeconds"`
+	QuestionIds         []string            `json:"questionIds"         bson:"questionIds,omitempty"`
+	WrongQuestionIds    []string            `json:"wrongQuestionIds"    bson:"wrongQuestionIds,omitempty"`
+	Answers             []ExamAttemptAnswer `json:"answers"             bson:"answers,omitempty"`
+	PassingScorePercent int32               `json:"passingScorePercent" bson:"passingScorePercent,omitempty"`
+	Passed              bool                `json:"passed"              bson:"passed,omitempty"`
+	EarnedPoints        float64             `json:"earnedPoints"        bson:"earnedPoints,omitempty"`
+	MaxPoints           int32               `json:"maxPoints"           bson:"maxPoints,omitempty"`
+	Percentage          float64             `json:"percentage"          bson:"percentage,omitempty"`
+	UserId              string              `json:"userId"              bson:"userId"`
+	CreatedAt           time.Time           `json:"createdAt"           bson:"createdAt"`
+	UpdatedAt           time.Time           `json:"updatedAt"           bson:"updatedAt"`
+}
+
+/*
+作答紀錄中每一題的作答內容，BlankAnswers 是每個填空填入的答案，Answer 是合併後用來顯示的答案，
+IsCorrect 與 Credit 由後端比對題目的答案產生，Credit 是該題得到的分數比例，0 到 1，
+HintUsed 表示作答時查看了提示
+*/
+type ExamAttemptAnswer struct {
+	QuestionId       string   `json:"questionId"       bson:"questionId"`
+	Answer           string   `json:"answer"           bson:"answer"`
+	BlankAnswers     []string `json:"blankAnswers"     bson:"blankAnswers,omitempty"`
+	IsCorrect        bool     `json:"isCorrect"        bson:"isCorrect"`
+	TimeSpentSeconds int32    `json:"timeSpentSeconds" bson:"timeSpentSeconds"`
+	Credit           float64  `json:"credit"           bson:"credit,omitempty"`
+	HintUsed         bool     `json:"hintUsed"         bson:"hintUsed,omitempty"`
 }
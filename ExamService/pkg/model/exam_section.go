@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+/*
+題組，閱讀題組有一篇文章（Passage），聽力題組有一段音檔（AudioUrl），
+題目以 Question.SectionId 關聯到題組，UserId 是測驗的擁有者
+*/
+type ExamSection struct {
+	Id        primitive.ObjectID `json:"_id"       bson:"_id,omitempty"`
+	ExamId    string             `json:"examId"    bson:"examId"`
+	Type      string             `json:"type"      bson:"type"`
+	Title     string             `json:"title"     bson:"title"`
+	Passage   string             `json:"passage"   bson:"passage,omitempty"`
+	AudioUrl  string             `json:"audioUrl"  bson:"audioUrl,omitempty"`
+	Order     int32              `json:"order"     bson:"order"`
+	UserId    string             `json:"userId"    bson:"userId"`
+	CreatedAt time.Time          `json:"createdAt" bson:"createdAt"`
+	UpdatedAt time.Time          `json:"updatedAt" bson:"updatedAt"`
+}
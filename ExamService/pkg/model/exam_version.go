@@ -0,0 +1,54 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+/*
+發佈測驗時建立的題目與題組快照，建立後不再修改，
+Questions 包含引用的題庫題目，BankPools 是隨機抽題的題庫快照
+*/
+type ExamVersion struct {
+	Id          primitive.ObjectID    `json:"_id"         bson:"_id,omitempty"`
+	ExamId      string                `json:"examId"      bson:"examId"`
+	Version     int32                 `json:"version"     bson:"version"`
+	Topic       string                `json:"topic"       bson:"topic"`
+	Description string                `json:"description" bson:"description"`
+	Questions   []ExamVersionQuestion `json:"questions"   bson:"questions"`
+	Sections    []ExamVersionSection  `json:"sections"    bson:"sections,omitempty"`
+	BankPools   []ExamVersionBankPool `json:"bankPools"   bson:"bankPools,omitempty"`
+	UserId      string                `json:"userId"      bson:"userId"`
+	CreatedAt   time.Time             `json:"createdAt"   bson:"createdAt"`
+	UpdatedAt   time.Time             `json:"updatedAt"   bson:"updatedAt"`
+}
+
+type ExamVersionQuestion struct {
+	QuestionId      string     `json:"questionId"    bson:"questionId"`
+	Ask             string     `json:"ask"           bson:"ask"`
+	Answers         []string   `json:"answers"       bson:"answers"`
+	AcceptedAnswers [][]string `json:"acceptedAnswers" bson:"acceptedAnswers,omitempty"`
+	Points          int32      `json:"points"        bson:"points,omitempty"`
+	PartialCredit   bool       `json:"partialCredit" bson:"partialCredit,omitempty"`
+	Hint            string     `json:"hint"          bson:"hint,omitempty"`
+	Explanation     string     `json:"explanation"   bson:"explanation,omitempty"`
+	SectionId       string     `json:"sectionId"     bson:"sectionId,omitempty"`
+	Choices         []string   `json:"choices"       bson:"choices,omitempty"`
+}
+
+type ExamVersionSection struct {
+	SectionId string `json:"sectionId" bson:"sectionId"`
+	Type      string `json:"type"      bson:"type"`
+	Title     string `json:"title"     bson:"title"`
+	Passage   string `json:"passage"   bson:"passage,omitempty"`
+	AudioUrl  string `json:"audioUrl"  bson:"audioUrl,omitempty"`
+	Order     int32  `json:"order"     bson:"order"`
+}
+
+// 隨機抽題的題庫快照，QuestionIds 是發佈時可以抽出的題目，題目內容在 ExamVersion.Questions
+type ExamVersionBankPool struct {
+	Tag         string   `json:"tag"         bson:"tag"`
+	Size        int32    `json:"size"        bson:"size"`
+	QuestionIds []string `json:"questionIds" bson:"questionIds"`
+}
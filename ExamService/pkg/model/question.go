@@ -6,12 +6,32 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+/*
+AcceptedAnswers 是每個填空可以接受的其他答案，依照 Answers 的順序，
+Points 是題目的配分，0 表示預設的 1 分，PartialCredit 表示可以部分給分，
+Hint 是作答時可以查看的提示，Explanation 是交卷後顯示的解說，
+SectionId 是題目所屬的題組，空字串表示獨立的題目，Choices 是作答時顯示的選項，
+AnswerCount 是答案的數量，不儲存，作答時不回傳答案，用來顯示空格的數量，
+HasHint 表示題目有提示，不儲存，作答時不回傳提示，用來顯示查看提示的按鈕，
+Version 每次修改後加 1，用來檢查修改時是否已被其他人修改，0 表示還沒有版本號的舊資料
+*/
 type Question struct {
-	Id        primitive.ObjectID `json:"_id"       bson:"_id,omitempty"`
-	ExamId    string             `json:"examId"    bson:"examId"`
-	Ask       string             `json:"ask"       bson:"ask"`
-	Answers   []string           `json:"answers"   bson:"answers"`
-	UserId    string             `json:"userId"    bson:"userId"`
-	CreatedAt time.Time          `json:"createdAt" bson:"createdAt"`
-	UpdatedAt time.Time          `json:"updatedAt" bson:"updatedAt"`
+	Id              primitive.ObjectID `json:"_id"           bson:"_id,omitempty"`
+	ExamId          string             `json:"examId"        bson:"examId"`
+	Ask             string             `json:"ask"           bson:"ask"`
+	Answers         []string           `json:"answers"       bson:"answers"`
+	AcceptedAnswers [][]string         `json:"acceptedAnswers" bson:"acceptedAnswers,omitempty"`
+	UserId          string             `json:"userId"        bson:"userId"`
+	WordMeaningId   string             `json:"wordMeaningId" bson:"wordMeaningId,omitempty"`
+	Points          int32              `json:"points"        bson:"points,omitempty"`
+	PartialCredit   bool               `json:"partialCredit" bson:"partialCredit,omitempty"`
+	Hint            string             `json:"hint"          bson:"hint,omitempty"`
+	Explanation     string             `json:"explanation"   bson:"explanation,omitempty"`
+	SectionId       string             `json:"sectionId"     bson:"sectionId,omitempty"`
+	Choices         []string           `json:"choices"       bson:"choices,omitempty"`
+	AnswerCount     int32              `json:"answerCount"   bson:"-"`
+	HasHint         bool               `json:"hasHint"       bson:"-"`
+	Version         int32              `json:"version"       bson:"version"`
+	CreatedAt       time.Time          `json:"createdAt"     bson:"createdAt"`
+	UpdatedAt       time.Time          `json:"updatedAt"     bson:"updatedAt"`
 }
@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/model"
+)
+
+/*
+測驗目錄使用的統計欄位直接存在測驗的 document 上，讓目錄可以先排序分頁，不需要每次彙總題目和作答紀錄：
+questionCount 是題目數量，recordCount 是全部使用者的作答次數，
+percentageRecordCount 和 percentageSum 是可以換算成百分比的作答紀錄數量與百分比總和，
+averagePercentage 是平均得分百分比，沒有可以換算的作答紀錄時為 0
+*/
+
+// 重新計算測驗的題目數量，新增或刪除題目之後呼叫
+func (repo *MongoDBRepository) refreshExamQuestionCounts(
+	ctx context.Context,
+	examIds []string,
+) error {
+	questionCollection := repo.getCollection(QUESTION_COLLECTION)
+	examCollection := repo.getCollection(EXAM_COLLECTION)
+	refreshedExamIds := map[string]bool{}
+
+	for _, examId := range examIds {
+		if refreshedExamIds[examId] {
+			continue
+		}
+
+		refreshedExamIds[examId] = true
+
+		// 不是 ObjectID 的 examId 沒有對應的測驗
+		id, err := primitive.ObjectIDFromHex(examId)
+		if err != nil {
+			continue
+		}
+
+		count, err := questionCollection.CountDocuments(ctx, bson.D{{"examId", examId}})
+		if err != nil {
+			return err
+		}
+
+		_, err = examCollection.UpdateOne(
+			ctx,
+			bson.D{{"_id", id}},
+			bson.D{{"$set", bson.D{{"questionCount", count}}}},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/*
+新增作答紀錄之後累加測驗的作答次數與百分比，
+新的作答紀錄都有依照配分計算的百分比，舊的作答紀錄在 migrateExamStats 時換算
+*/
+func (repo *MongoDBRepository) increaseExamRecordStats(
+	ctx context.Context,
+	examRecord model.ExamRecord,
+) error {
+	id, err := primitive.ObjectIDFromHex(examRecord.ExamId)
+	if err != nil {
+		return nil
+	}
+
+	percentageRecordCount := 0
+	percentage := 0.0
+
+	if examRecord.MaxPoints > 0 {
+		percentageRecordCount = 1
+		percentage = examRecord.Percentage
+	}
+
+	update := mongo.Pipeline{
+		{{"$set", bson.D{
+			{"recordCount", bson.D{{"$add", bson.A{
+				bson.D{{"$ifNull", bson.A{"$recordCount", 0}}}, 1,
+			}}}},
+			{"percentageRecordCount", bson.D{{"$add", bson.A{
+				bson.D{{"$ifNull", bson.A{"$percentageRecordCount", 0}}}, percentageRecordCount,
+			}}}},
+			{"percentageSum", bson.D{{"$add", bson.A{
+				bson.D{{"$ifNull", bson.A{"$percentageSum", 0}}}, percentage,
+			}}}},
+		}}},
+		{{"$set", averagePercentageField()}},
+	}
+	collection := repo.getCollection(EXAM_COLLECTION)
+	_, err = collection.UpdateOne(ctx, bson.D{{"_id", id}}, update)
+	return err
+}
+
+/*
+作答紀錄的得分百分比，新的作答紀錄直接使用依照配分計算的百分比，
+舊的作答紀錄沒有配分資料時以答對題數佔題數的比例換算，連題目都沒有記錄的作答紀錄無法換算，結果為 null
+*/
+func examRecordPercentageExpression() bson.D {
+	return bson.D{{"$cond", bson.A{
+		bson.D{{"$gt", bson.A{bson.D{{"$ifNull", bson.A{"$maxPoints", 0}}}, 0}}},
+		"$percentage",
+		bson.D{{"$cond", bson.A{
+			bson.D{{"$gt", bson.A{
+				bson.D{{"$size", bson.D{{"$ifNull", bson.A{"$questionIds", bson.A{}}}}}}, 0,
+			}}},
+			bson.D{{"$divide", bson.A{
+				bson.D{{"$multiply", bson.A{"$score", 100}}},
+				bson.D{{"$size", "$questionIds"}},
+			}}},
+			nil,
+		}}},
+	}}}
+}
+
+// 有配分資料或有記錄出現題目的作答紀錄才能換算成百分比
+func hasPercentageFilter() bson.E {
+	return bson.E{"$or", bson.A{
+		bson.D{{"maxPoints", bson.D{{"$gt", 0}}}},
+		bson.D{{"questionIds.0", bson.D{{"$exists", true}}}},
+	}}
+}
+
+func averagePercentageField() bson.D {
+	return bson.D{{"averagePercentage", bson.D{{"$cond", bson.A{
+		bson.D{{"$gt", bson.A{"$percentageRecordCount", 0}}},
+		bson.D{{"$divide", bson.A{"$percentageSum", "$percentageRecordCount"}}},
+		0,
+	}}}}}
+}
+
+/*
+替還沒有統計欄位的測驗計算統計欄位，連線時執行，已經有統計欄位的測驗不會重新計算，
+無法換算成百分比的舊作答紀錄不列入平均
+*/
+func (repo *MongoDBRepository) migrateExamStats(ctx context.Context) error {
+	pipeline := mongo.Pipeline{
+		{{"$match", bson.D{{"questionCount", bson.D{{"$exists", false}}}}}},
+		// 題目和作答紀錄的 examId 是字串，所以要先將測驗的 _id 轉成字串
+		{{"$lookup", bson.D{
+			{"from", QUESTION_COLLECTION},
+			{"let", bson.D{{"examId", bson.D{{"$toString", "$_id"}}}}},
+			{"pipeline", bson.A{
+				bson.D{{"$match", bson.D{
+					{"$expr", bson.D{{"$eq", bson.A{"$examId", "$$examId"}}}},
+				}}},
+				bson.D{{"$count", "count"}},
+			}},
+			{"as", "questionStats"},
+		}}},
+		{{"$lookup", bson.D{
+			{"from", EXAM_RECORD_COLLECTION},
+			{"let", bson.D{{"examId", bson.D{{"$toString", "$_id"}}}}},
+			{"pipeline", bson.A{
+				bson.D{{"$match", bson.D{
+					{"$expr", bson.D{{"$eq", bson.A{"$examId", "$$examId"}}}},
+				}}},
+				bson.D{{"$project", bson.D{{"percentage", examRecordPercentageExpression()}}}},
+				bson.D{{"$group", bson.D{
+					{"_id", nil},
+					{"count", bson.D{{"$sum", 1}}},
+					{"percentageCount", bson.D{{"$sum", bson.D{{"$cond", bson.A{
+						bson.D{{"$eq", bson.A{"$percentage", nil}}}, 0, 1,
+					}}}}}},
+					{"percentageSum", bson.D{{"$sum", "$percentage"}}},
+				}}},
+			}},
+			{"as", "recordStats"},
+		}}},
+		{{"$project", bson.D{
+			{"questionCount", bson.D{{"$ifNull", bson.A{
+				bson.D{{"$first", "$questionStats.count"}}, 0,
+			}}}},
+			{"recordCount", bson.D{{"$ifNull", bson.A{
+				bson.D{{"$first", "$recordStats.count"}}, 0,
+			}}}},
+			{"percentageRecordCount", bson.D{{"$ifNull", bson.A{
+				bson.D{{"$first", "$recordStats.percentageCount"}}, 0,
+			}}}},
+			{"percentageSum", bson.D{{"$ifNull", bson.A{
+				bson.D{{"$first", "$recordStats.percentageSum"}}, 0,
+			}}}},
+		}}},
+		{{"$set", averagePercentageField()}},
+		{{"$merge", bson.D{
+			{"into", EXAM_COLLECTION},
+			{"on", "_id"},
+			{"whenMatched", "merge"},
+			{"whenNotMatched", "discard"},
+		}}},
+	}
+
+	collection := repo.getCollection(EXAM_COLLECTION)
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+
+	return cursor.Close(ctx)
+}
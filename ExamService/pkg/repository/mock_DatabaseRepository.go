@@ -23,6 +23,122 @@ func (_m *MockDatabaseRepository) EXPECT() *MockDatabaseRepository_Expecter {
 	return &MockDatabaseRepository_Expecter{mock: &_m.Mock}
 }
 
+// AddClassStudent provides a mock function with given fields: ctx, classId, student
+func (_m *MockDatabaseRepository) AddClassStudent(ctx context.Context, classId string, student model.ClassStudent) (int32, error) {
+	ret := _m.Called(ctx, classId, student)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddClassStudent")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.ClassStudent) (int32, error)); ok {
+		return rf(ctx, classId, student)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.ClassStudent) int32); ok {
+		r0 = rf(ctx, classId, student)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.ClassStudent) error); ok {
+		r1 = rf(ctx, classId, student)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_AddClassStudent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddClassStudent'
+type MockDatabaseRepository_AddClassStudent_Call struct {
+	*mock.Call
+}
+
+// AddClassStudent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - classId string
+//   - student model.ClassStudent
+func (_e *MockDatabaseRepository_Expecter) AddClassStudent(ctx interface{}, classId interface{}, student interface{}) *MockDatabaseRepository_AddClassStudent_Call {
+	return &MockDatabaseRepository_AddClassStudent_Call{Call: _e.mock.On("AddClassStudent", ctx, classId, student)}
+}
+
+func (_c *MockDatabaseRepository_AddClassStudent_Call) Run(run func(ctx context.Context, classId string, student model.ClassStudent)) *MockDatabaseRepository_AddClassStudent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.ClassStudent))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_AddClassStudent_Call) Return(modifiedCount int32, err error) *MockDatabaseRepository_AddClassStudent_Call {
+	_c.Call.Return(modifiedCount, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_AddClassStudent_Call) RunAndReturn(run func(context.Context, string, model.ClassStudent) (int32, error)) *MockDatabaseRepository_AddClassStudent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddExamAttemptHintQuestionId provides a mock function with given fields: ctx, examAttemptId, questionId
+func (_m *MockDatabaseRepository) AddExamAttemptHintQuestionId(ctx context.Context, examAttemptId string, questionId string) (bool, error) {
+	ret := _m.Called(ctx, examAttemptId, questionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddExamAttemptHintQuestionId")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, examAttemptId, questionId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, examAttemptId, questionId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, examAttemptId, questionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_AddExamAttemptHintQuestionId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddExamAttemptHintQuestionId'
+type MockDatabaseRepository_AddExamAttemptHintQuestionId_Call struct {
+	*mock.Call
+}
+
+// AddExamAttemptHintQuestionId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examAttemptId string
+//   - questionId string
+func (_e *MockDatabaseRepository_Expecter) AddExamAttemptHintQuestionId(ctx interface{}, examAttemptId interface{}, questionId interface{}) *MockDatabaseRepository_AddExamAttemptHintQuestionId_Call {
+	return &MockDatabaseRepository_AddExamAttemptHintQuestionId_Call{Call: _e.mock.On("AddExamAttemptHintQuestionId", ctx, examAttemptId, questionId)}
+}
+
+func (_c *MockDatabaseRepository_AddExamAttemptHintQuestionId_Call) Run(run func(ctx context.Context, examAttemptId string, questionId string)) *MockDatabaseRepository_AddExamAttemptHintQuestionId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_AddExamAttemptHintQuestionId_Call) Return(added bool, err error) *MockDatabaseRepository_AddExamAttemptHintQuestionId_Call {
+	_c.Call.Return(added, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_AddExamAttemptHintQuestionId_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *MockDatabaseRepository_AddExamAttemptHintQuestionId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ConnectDB provides a mock function with given fields: ctx, uri
 func (_m *MockDatabaseRepository) ConnectDB(ctx context.Context, uri string) error {
 	ret := _m.Called(ctx, uri)
@@ -70,6 +186,182 @@ func (_c *MockDatabaseRepository_ConnectDB_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween provides a mock function with given fields: ctx, userId, examId, startDate, endDate
+func (_m *MockDatabaseRepository) CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween(ctx context.Context, userId string, examId string, startDate time.Time, endDate time.Time) (int64, error) {
+	ret := _m.Called(ctx, userId, examId, startDate, endDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time, time.Time) (int64, error)); ok {
+		return rf(ctx, userId, examId, startDate, endDate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time, time.Time) int64); ok {
+		r0 = rf(ctx, userId, examId, startDate, endDate)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, userId, examId, startDate, endDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween'
+type MockDatabaseRepository_CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween_Call struct {
+	*mock.Call
+}
+
+// CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - examId string
+//   - startDate time.Time
+//   - endDate time.Time
+func (_e *MockDatabaseRepository_Expecter) CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween(ctx interface{}, userId interface{}, examId interface{}, startDate interface{}, endDate interface{}) *MockDatabaseRepository_CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween_Call {
+	return &MockDatabaseRepository_CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween_Call{Call: _e.mock.On("CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween", ctx, userId, examId, startDate, endDate)}
+}
+
+func (_c *MockDatabaseRepository_CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween_Call) Run(run func(ctx context.Context, userId string, examId string, startDate time.Time, endDate time.Time)) *MockDatabaseRepository_CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween_Call) Return(count int64, err error) *MockDatabaseRepository_CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween_Call {
+	_c.Call.Return(count, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween_Call) RunAndReturn(run func(context.Context, string, string, time.Time, time.Time) (int64, error)) *MockDatabaseRepository_CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountAssignmentsByClassIdsAndExamId provides a mock function with given fields: ctx, classIds, examId
+func (_m *MockDatabaseRepository) CountAssignmentsByClassIdsAndExamId(ctx context.Context, classIds []string, examId string) (int32, error) {
+	ret := _m.Called(ctx, classIds, examId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountAssignmentsByClassIdsAndExamId")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string) (int32, error)); ok {
+		return rf(ctx, classIds, examId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string) int32); ok {
+		r0 = rf(ctx, classIds, examId)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string) error); ok {
+		r1 = rf(ctx, classIds, examId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_CountAssignmentsByClassIdsAndExamId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountAssignmentsByClassIdsAndExamId'
+type MockDatabaseRepository_CountAssignmentsByClassIdsAndExamId_Call struct {
+	*mock.Call
+}
+
+// CountAssignmentsByClassIdsAndExamId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - classIds []string
+//   - examId string
+func (_e *MockDatabaseRepository_Expecter) CountAssignmentsByClassIdsAndExamId(ctx interface{}, classIds interface{}, examId interface{}) *MockDatabaseRepository_CountAssignmentsByClassIdsAndExamId_Call {
+	return &MockDatabaseRepository_CountAssignmentsByClassIdsAndExamId_Call{Call: _e.mock.On("CountAssignmentsByClassIdsAndExamId", ctx, classIds, examId)}
+}
+
+func (_c *MockDatabaseRepository_CountAssignmentsByClassIdsAndExamId_Call) Run(run func(ctx context.Context, classIds []string, examId string)) *MockDatabaseRepository_CountAssignmentsByClassIdsAndExamId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CountAssignmentsByClassIdsAndExamId_Call) Return(count int32, err error) *MockDatabaseRepository_CountAssignmentsByClassIdsAndExamId_Call {
+	_c.Call.Return(count, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CountAssignmentsByClassIdsAndExamId_Call) RunAndReturn(run func(context.Context, []string, string) (int32, error)) *MockDatabaseRepository_CountAssignmentsByClassIdsAndExamId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountBankQuestionsByUserIdAndTag provides a mock function with given fields: ctx, userId, tag
+func (_m *MockDatabaseRepository) CountBankQuestionsByUserIdAndTag(ctx context.Context, userId string, tag string) (int32, error) {
+	ret := _m.Called(ctx, userId, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountBankQuestionsByUserIdAndTag")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (int32, error)); ok {
+		return rf(ctx, userId, tag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int32); ok {
+		r0 = rf(ctx, userId, tag)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userId, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_CountBankQuestionsByUserIdAndTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountBankQuestionsByUserIdAndTag'
+type MockDatabaseRepository_CountBankQuestionsByUserIdAndTag_Call struct {
+	*mock.Call
+}
+
+// CountBankQuestionsByUserIdAndTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - tag string
+func (_e *MockDatabaseRepository_Expecter) CountBankQuestionsByUserIdAndTag(ctx interface{}, userId interface{}, tag interface{}) *MockDatabaseRepository_CountBankQuestionsByUserIdAndTag_Call {
+	return &MockDatabaseRepository_CountBankQuestionsByUserIdAndTag_Call{Call: _e.mock.On("CountBankQuestionsByUserIdAndTag", ctx, userId, tag)}
+}
+
+func (_c *MockDatabaseRepository_CountBankQuestionsByUserIdAndTag_Call) Run(run func(ctx context.Context, userId string, tag string)) *MockDatabaseRepository_CountBankQuestionsByUserIdAndTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CountBankQuestionsByUserIdAndTag_Call) Return(count int32, err error) *MockDatabaseRepository_CountBankQuestionsByUserIdAndTag_Call {
+	_c.Call.Return(count, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CountBankQuestionsByUserIdAndTag_Call) RunAndReturn(run func(context.Context, string, string) (int32, error)) *MockDatabaseRepository_CountBankQuestionsByUserIdAndTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CountExamRecordsByExamIdAndUserId provides a mock function with given fields: ctx, examId, userId
 func (_m *MockDatabaseRepository) CountExamRecordsByExamIdAndUserId(ctx context.Context, examId string, userId string) (int32, error) {
 	ret := _m.Called(ctx, examId, userId)
@@ -128,6 +420,64 @@ func (_c *MockDatabaseRepository_CountExamRecordsByExamIdAndUserId_Call) RunAndR
 	return _c
 }
 
+// CountExamsByTags provides a mock function with given fields: ctx, tags, userId
+func (_m *MockDatabaseRepository) CountExamsByTags(ctx context.Context, tags []string, userId string) (int32, error) {
+	ret := _m.Called(ctx, tags, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountExamsByTags")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string) (int32, error)); ok {
+		return rf(ctx, tags, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string) int32); ok {
+		r0 = rf(ctx, tags, userId)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string) error); ok {
+		r1 = rf(ctx, tags, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_CountExamsByTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountExamsByTags'
+type MockDatabaseRepository_CountExamsByTags_Call struct {
+	*mock.Call
+}
+
+// CountExamsByTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tags []string
+//   - userId string
+func (_e *MockDatabaseRepository_Expecter) CountExamsByTags(ctx interface{}, tags interface{}, userId interface{}) *MockDatabaseRepository_CountExamsByTags_Call {
+	return &MockDatabaseRepository_CountExamsByTags_Call{Call: _e.mock.On("CountExamsByTags", ctx, tags, userId)}
+}
+
+func (_c *MockDatabaseRepository_CountExamsByTags_Call) Run(run func(ctx context.Context, tags []string, userId string)) *MockDatabaseRepository_CountExamsByTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CountExamsByTags_Call) Return(count int32, err error) *MockDatabaseRepository_CountExamsByTags_Call {
+	_c.Call.Return(count, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CountExamsByTags_Call) RunAndReturn(run func(context.Context, []string, string) (int32, error)) *MockDatabaseRepository_CountExamsByTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CountExamsByUserId provides a mock function with given fields: ctx, userId
 func (_m *MockDatabaseRepository) CountExamsByUserId(ctx context.Context, userId string) (int32, error) {
 	ret := _m.Called(ctx, userId)
@@ -242,27 +592,27 @@ func (_c *MockDatabaseRepository_CountQuestionsByExamId_Call) RunAndReturn(run f
 	return _c
 }
 
-// CreateExam provides a mock function with given fields: ctx, exam
-func (_m *MockDatabaseRepository) CreateExam(ctx context.Context, exam model.Exam) (string, error) {
-	ret := _m.Called(ctx, exam)
+// CreateAssignment provides a mock function with given fields: ctx, assignment
+func (_m *MockDatabaseRepository) CreateAssignment(ctx context.Context, assignment model.Assignment) (string, error) {
+	ret := _m.Called(ctx, assignment)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateExam")
+		panic("no return value specified for CreateAssignment")
 	}
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.Exam) (string, error)); ok {
-		return rf(ctx, exam)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Assignment) (string, error)); ok {
+		return rf(ctx, assignment)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, model.Exam) string); ok {
-		r0 = rf(ctx, exam)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Assignment) string); ok {
+		r0 = rf(ctx, assignment)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, model.Exam) error); ok {
-		r1 = rf(ctx, exam)
+	if rf, ok := ret.Get(1).(func(context.Context, model.Assignment) error); ok {
+		r1 = rf(ctx, assignment)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -270,56 +620,3079 @@ func (_m *MockDatabaseRepository) CreateExam(ctx context.Context, exam model.Exa
 	return r0, r1
 }
 
-// MockDatabaseRepository_CreateExam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExam'
-type MockDatabaseRepository_CreateExam_Call struct {
+// MockDatabaseRepository_CreateAssignment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAssignment'
+type MockDatabaseRepository_CreateAssignment_Call struct {
 	*mock.Call
 }
 
-// CreateExam is a helper method to define mock.On call
+// CreateAssignment is a helper method to define mock.On call
 //   - ctx context.Context
-//   - exam model.Exam
-func (_e *MockDatabaseRepository_Expecter) CreateExam(ctx interface{}, exam interface{}) *MockDatabaseRepository_CreateExam_Call {
-	return &MockDatabaseRepository_CreateExam_Call{Call: _e.mock.On("CreateExam", ctx, exam)}
+//   - assignment model.Assignment
+func (_e *MockDatabaseRepository_Expecter) CreateAssignment(ctx interface{}, assignment interface{}) *MockDatabaseRepository_CreateAssignment_Call {
+	return &MockDatabaseRepository_CreateAssignment_Call{Call: _e.mock.On("CreateAssignment", ctx, assignment)}
 }
 
-func (_c *MockDatabaseRepository_CreateExam_Call) Run(run func(ctx context.Context, exam model.Exam)) *MockDatabaseRepository_CreateExam_Call {
+func (_c *MockDatabaseRepository_CreateAssignment_Call) Run(run func(ctx context.Context, assignment model.Assignment)) *MockDatabaseRepository_CreateAssignment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(model.Exam))
+		run(args[0].(context.Context), args[1].(model.Assignment))
 	})
 	return _c
 }
 
-func (_c *MockDatabaseRepository_CreateExam_Call) Return(examId string, err error) *MockDatabaseRepository_CreateExam_Call {
-	_c.Call.Return(examId, err)
+func (_c *MockDatabaseRepository_CreateAssignment_Call) Return(assignmentId string, err error) *MockDatabaseRepository_CreateAssignment_Call {
+	_c.Call.Return(assignmentId, err)
 	return _c
 }
 
-func (_c *MockDatabaseRepository_CreateExam_Call) RunAndReturn(run func(context.Context, model.Exam) (string, error)) *MockDatabaseRepository_CreateExam_Call {
+func (_c *MockDatabaseRepository_CreateAssignment_Call) RunAndReturn(run func(context.Context, model.Assignment) (string, error)) *MockDatabaseRepository_CreateAssignment_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateExamRecord provides a mock function with given fields: ctx, examRecord
-func (_m *MockDatabaseRepository) CreateExamRecord(ctx context.Context, examRecord model.ExamRecord) (string, error) {
-	ret := _m.Called(ctx, examRecord)
+// CreateBankQuestion provides a mock function with given fields: ctx, bankQuestion
+func (_m *MockDatabaseRepository) CreateBankQuestion(ctx context.Context, bankQuestion model.BankQuestion) (string, error) {
+	ret := _m.Called(ctx, bankQuestion)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateExamRecord")
+		panic("no return value specified for CreateBankQuestion")
 	}
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.ExamRecord) (string, error)); ok {
-		return rf(ctx, examRecord)
+	if rf, ok := ret.Get(0).(func(context.Context, model.BankQuestion) (string, error)); ok {
+		return rf(ctx, bankQuestion)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, model.ExamRecord) string); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, model.BankQuestion) string); ok {
+		r0 = rf(ctx, bankQuestion)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.BankQuestion) error); ok {
+		r1 = rf(ctx, bankQuestion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_CreateBankQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBankQuestion'
+type MockDatabaseRepository_CreateBankQuestion_Call struct {
+	*mock.Call
+}
+
+// CreateBankQuestion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bankQuestion model.BankQuestion
+func (_e *MockDatabaseRepository_Expecter) CreateBankQuestion(ctx interface{}, bankQuestion interface{}) *MockDatabaseRepository_CreateBankQuestion_Call {
+	return &MockDatabaseRepository_CreateBankQuestion_Call{Call: _e.mock.On("CreateBankQuestion", ctx, bankQuestion)}
+}
+
+func (_c *MockDatabaseRepository_CreateBankQuestion_Call) Run(run func(ctx context.Context, bankQuestion model.BankQuestion)) *MockDatabaseRepository_CreateBankQuestion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.BankQuestion))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CreateBankQuestion_Call) Return(bankQuestionId string, err error) *MockDatabaseRepository_CreateBankQuestion_Call {
+	_c.Call.Return(bankQuestionId, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CreateBankQuestion_Call) RunAndReturn(run func(context.Context, model.BankQuestion) (string, error)) *MockDatabaseRepository_CreateBankQuestion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateClass provides a mock function with given fields: ctx, class
+func (_m *MockDatabaseRepository) CreateClass(ctx context.Context, class model.Class) (string, error) {
+	ret := _m.Called(ctx, class)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateClass")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Class) (string, error)); ok {
+		return rf(ctx, class)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Class) string); ok {
+		r0 = rf(ctx, class)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Class) error); ok {
+		r1 = rf(ctx, class)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_CreateClass_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateClass'
+type MockDatabaseRepository_CreateClass_Call struct {
+	*mock.Call
+}
+
+// CreateClass is a helper method to define mock.On call
+//   - ctx context.Context
+//   - class model.Class
+func (_e *MockDatabaseRepository_Expecter) CreateClass(ctx interface{}, class interface{}) *MockDatabaseRepository_CreateClass_Call {
+	return &MockDatabaseRepository_CreateClass_Call{Call: _e.mock.On("CreateClass", ctx, class)}
+}
+
+func (_c *MockDatabaseRepository_CreateClass_Call) Run(run func(ctx context.Context, class model.Class)) *MockDatabaseRepository_CreateClass_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Class))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CreateClass_Call) Return(classId string, err error) *MockDatabaseRepository_CreateClass_Call {
+	_c.Call.Return(classId, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CreateClass_Call) RunAndReturn(run func(context.Context, model.Class) (string, error)) *MockDatabaseRepository_CreateClass_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateExam provides a mock function with given fields: ctx, exam
+func (_m *MockDatabaseRepository) CreateExam(ctx context.Context, exam model.Exam) (string, error) {
+	ret := _m.Called(ctx, exam)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExam")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Exam) (string, error)); ok {
+		return rf(ctx, exam)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Exam) string); ok {
+		r0 = rf(ctx, exam)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Exam) error); ok {
+		r1 = rf(ctx, exam)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_CreateExam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExam'
+type MockDatabaseRepository_CreateExam_Call struct {
+	*mock.Call
+}
+
+// CreateExam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - exam model.Exam
+func (_e *MockDatabaseRepository_Expecter) CreateExam(ctx interface{}, exam interface{}) *MockDatabaseRepository_CreateExam_Call {
+	return &MockDatabaseRepository_CreateExam_Call{Call: _e.mock.On("CreateExam", ctx, exam)}
+}
+
+func (_c *MockDatabaseRepository_CreateExam_Call) Run(run func(ctx context.Context, exam model.Exam)) *MockDatabaseRepository_CreateExam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Exam))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CreateExam_Call) Return(examId string, err error) *MockDatabaseRepository_CreateExam_Call {
+	_c.Call.Return(examId, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CreateExam_Call) RunAndReturn(run func(context.Context, model.Exam) (string, error)) *MockDatabaseRepository_CreateExam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateExamAttempt provides a mock function with given fields: ctx, examAttempt
+func (_m *MockDatabaseRepository) CreateExamAttempt(ctx context.Context, examAttempt model.ExamAttempt) (string, error) {
+	ret := _m.Called(ctx, examAttempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExamAttempt")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ExamAttempt) (string, error)); ok {
+		return rf(ctx, examAttempt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ExamAttempt) string); ok {
+		r0 = rf(ctx, examAttempt)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ExamAttempt) error); ok {
+		r1 = rf(ctx, examAttempt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_CreateExamAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExamAttempt'
+type MockDatabaseRepository_CreateExamAttempt_Call struct {
+	*mock.Call
+}
+
+// CreateExamAttempt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examAttempt model.ExamAttempt
+func (_e *MockDatabaseRepository_Expecter) CreateExamAttempt(ctx interface{}, examAttempt interface{}) *MockDatabaseRepository_CreateExamAttempt_Call {
+	return &MockDatabaseRepository_CreateExamAttempt_Call{Call: _e.mock.On("CreateExamAttempt", ctx, examAttempt)}
+}
+
+func (_c *MockDatabaseRepository_CreateExamAttempt_Call) Run(run func(ctx context.Context, examAttempt model.ExamAttempt)) *MockDatabaseRepository_CreateExamAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.ExamAttempt))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CreateExamAttempt_Call) Return(examAttemptId string, err error) *MockDatabaseRepository_CreateExamAttempt_Call {
+	_c.Call.Return(examAttemptId, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CreateExamAttempt_Call) RunAndReturn(run func(context.Context, model.ExamAttempt) (string, error)) *MockDatabaseRepository_CreateExamAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateExamRecord provides a mock function with given fields: ctx, examRecord
+func (_m *MockDatabaseRepository) CreateExamRecord(ctx context.Context, examRecord model.ExamRecord) (string, error) {
+	ret := _m.Called(ctx, examRecord)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExamRecord")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ExamRecord) (string, error)); ok {
+		return rf(ctx, examRecord)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ExamRecord) string); ok {
 		r0 = rf(ctx, examRecord)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, model.ExamRecord) error); ok {
-		r1 = rf(ctx, examRecord)
+	if rf, ok := ret.Get(1).(func(context.Context, model.ExamRecord) error); ok {
+		r1 = rf(ctx, examRecord)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_CreateExamRecord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExamRecord'
+type MockDatabaseRepository_CreateExamRecord_Call struct {
+	*mock.Call
+}
+
+// CreateExamRecord is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examRecord model.ExamRecord
+func (_e *MockDatabaseRepository_Expecter) CreateExamRecord(ctx interface{}, examRecord interface{}) *MockDatabaseRepository_CreateExamRecord_Call {
+	return &MockDatabaseRepository_CreateExamRecord_Call{Call: _e.mock.On("CreateExamRecord", ctx, examRecord)}
+}
+
+func (_c *MockDatabaseRepository_CreateExamRecord_Call) Run(run func(ctx context.Context, examRecord model.ExamRecord)) *MockDatabaseRepository_CreateExamRecord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.ExamRecord))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CreateExamRecord_Call) Return(examRecordId string, err error) *MockDatabaseRepository_CreateExamRecord_Call {
+	_c.Call.Return(examRecordId, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CreateExamRecord_Call) RunAndReturn(run func(context.Context, model.ExamRecord) (string, error)) *MockDatabaseRepository_CreateExamRecord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateExamSection provides a mock function with given fields: ctx, examSection
+func (_m *MockDatabaseRepository) CreateExamSection(ctx context.Context, examSection model.ExamSection) (string, error) {
+	ret := _m.Called(ctx, examSection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExamSection")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ExamSection) (string, error)); ok {
+		return rf(ctx, examSection)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ExamSection) string); ok {
+		r0 = rf(ctx, examSection)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ExamSection) error); ok {
+		r1 = rf(ctx, examSection)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_CreateExamSection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExamSection'
+type MockDatabaseRepository_CreateExamSection_Call struct {
+	*mock.Call
+}
+
+// CreateExamSection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examSection model.ExamSection
+func (_e *MockDatabaseRepository_Expecter) CreateExamSection(ctx interface{}, examSection interface{}) *MockDatabaseRepository_CreateExamSection_Call {
+	return &MockDatabaseRepository_CreateExamSection_Call{Call: _e.mock.On("CreateExamSection", ctx, examSection)}
+}
+
+func (_c *MockDatabaseRepository_CreateExamSection_Call) Run(run func(ctx context.Context, examSection model.ExamSection)) *MockDatabaseRepository_CreateExamSection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.ExamSection))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CreateExamSection_Call) Return(sectionId string, err error) *MockDatabaseRepository_CreateExamSection_Call {
+	_c.Call.Return(sectionId, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CreateExamSection_Call) RunAndReturn(run func(context.Context, model.ExamSection) (string, error)) *MockDatabaseRepository_CreateExamSection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateExamVersion provides a mock function with given fields: ctx, examVersion
+func (_m *MockDatabaseRepository) CreateExamVersion(ctx context.Context, examVersion model.ExamVersion) (string, error) {
+	ret := _m.Called(ctx, examVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExamVersion")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ExamVersion) (string, error)); ok {
+		return rf(ctx, examVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ExamVersion) string); ok {
+		r0 = rf(ctx, examVersion)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ExamVersion) error); ok {
+		r1 = rf(ctx, examVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_CreateExamVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExamVersion'
+type MockDatabaseRepository_CreateExamVersion_Call struct {
+	*mock.Call
+}
+
+// CreateExamVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examVersion model.ExamVersion
+func (_e *MockDatabaseRepository_Expecter) CreateExamVersion(ctx interface{}, examVersion interface{}) *MockDatabaseRepository_CreateExamVersion_Call {
+	return &MockDatabaseRepository_CreateExamVersion_Call{Call: _e.mock.On("CreateExamVersion", ctx, examVersion)}
+}
+
+func (_c *MockDatabaseRepository_CreateExamVersion_Call) Run(run func(ctx context.Context, examVersion model.ExamVersion)) *MockDatabaseRepository_CreateExamVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.ExamVersion))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CreateExamVersion_Call) Return(examVersionId string, err error) *MockDatabaseRepository_CreateExamVersion_Call {
+	_c.Call.Return(examVersionId, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CreateExamVersion_Call) RunAndReturn(run func(context.Context, model.ExamVersion) (string, error)) *MockDatabaseRepository_CreateExamVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateQuestion provides a mock function with given fields: ctx, question
+func (_m *MockDatabaseRepository) CreateQuestion(ctx context.Context, question model.Question) (string, error) {
+	ret := _m.Called(ctx, question)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateQuestion")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Question) (string, error)); ok {
+		return rf(ctx, question)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Question) string); ok {
+		r0 = rf(ctx, question)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Question) error); ok {
+		r1 = rf(ctx, question)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_CreateQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateQuestion'
+type MockDatabaseRepository_CreateQuestion_Call struct {
+	*mock.Call
+}
+
+// CreateQuestion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - question model.Question
+func (_e *MockDatabaseRepository_Expecter) CreateQuestion(ctx interface{}, question interface{}) *MockDatabaseRepository_CreateQuestion_Call {
+	return &MockDatabaseRepository_CreateQuestion_Call{Call: _e.mock.On("CreateQuestion", ctx, question)}
+}
+
+func (_c *MockDatabaseRepository_CreateQuestion_Call) Run(run func(ctx context.Context, question model.Question)) *MockDatabaseRepository_CreateQuestion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Question))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CreateQuestion_Call) Return(questionId string, err error) *MockDatabaseRepository_CreateQuestion_Call {
+	_c.Call.Return(questionId, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CreateQuestion_Call) RunAndReturn(run func(context.Context, model.Question) (string, error)) *MockDatabaseRepository_CreateQuestion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateQuestions provides a mock function with given fields: ctx, questions
+func (_m *MockDatabaseRepository) CreateQuestions(ctx context.Context, questions []model.Question) ([]string, error) {
+	ret := _m.Called(ctx, questions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateQuestions")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []model.Question) ([]string, error)); ok {
+		return rf(ctx, questions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []model.Question) []string); ok {
+		r0 = rf(ctx, questions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []model.Question) error); ok {
+		r1 = rf(ctx, questions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_CreateQuestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateQuestions'
+type MockDatabaseRepository_CreateQuestions_Call struct {
+	*mock.Call
+}
+
+// CreateQuestions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - questions []model.Question
+func (_e *MockDatabaseRepository_Expecter) CreateQuestions(ctx interface{}, questions interface{}) *MockDatabaseRepository_CreateQuestions_Call {
+	return &MockDatabaseRepository_CreateQuestions_Call{Call: _e.mock.On("CreateQuestions", ctx, questions)}
+}
+
+func (_c *MockDatabaseRepository_CreateQuestions_Call) Run(run func(ctx context.Context, questions []model.Question)) *MockDatabaseRepository_CreateQuestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]model.Question))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CreateQuestions_Call) Return(questionIds []string, err error) *MockDatabaseRepository_CreateQuestions_Call {
+	_c.Call.Return(questionIds, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CreateQuestions_Call) RunAndReturn(run func(context.Context, []model.Question) ([]string, error)) *MockDatabaseRepository_CreateQuestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAnswerWrongById provides a mock function with given fields: ctx, answerWrongId
+func (_m *MockDatabaseRepository) DeleteAnswerWrongById(ctx context.Context, answerWrongId string) (int32, error) {
+	ret := _m.Called(ctx, answerWrongId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAnswerWrongById")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
+		return rf(ctx, answerWrongId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
+		r0 = rf(ctx, answerWrongId)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, answerWrongId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_DeleteAnswerWrongById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAnswerWrongById'
+type MockDatabaseRepository_DeleteAnswerWrongById_Call struct {
+	*mock.Call
+}
+
+// DeleteAnswerWrongById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - answerWrongId string
+func (_e *MockDatabaseRepository_Expecter) DeleteAnswerWrongById(ctx interface{}, answerWrongId interface{}) *MockDatabaseRepository_DeleteAnswerWrongById_Call {
+	return &MockDatabaseRepository_DeleteAnswerWrongById_Call{Call: _e.mock.On("DeleteAnswerWrongById", ctx, answerWrongId)}
+}
+
+func (_c *MockDatabaseRepository_DeleteAnswerWrongById_Call) Run(run func(ctx context.Context, answerWrongId string)) *MockDatabaseRepository_DeleteAnswerWrongById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteAnswerWrongById_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteAnswerWrongById_Call {
+	_c.Call.Return(deletedCount, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteAnswerWrongById_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_DeleteAnswerWrongById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAnswerWrongsByExamId provides a mock function with given fields: ctx, examId
+func (_m *MockDatabaseRepository) DeleteAnswerWrongsByExamId(ctx context.Context, examId string) (int32, error) {
+	ret := _m.Called(ctx, examId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAnswerWrongsByExamId")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
+		return rf(ctx, examId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
+		r0 = rf(ctx, examId)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, examId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_DeleteAnswerWrongsByExamId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAnswerWrongsByExamId'
+type MockDatabaseRepository_DeleteAnswerWrongsByExamId_Call struct {
+	*mock.Call
+}
+
+// DeleteAnswerWrongsByExamId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examId string
+func (_e *MockDatabaseRepository_Expecter) DeleteAnswerWrongsByExamId(ctx interface{}, examId interface{}) *MockDatabaseRepository_DeleteAnswerWrongsByExamId_Call {
+	return &MockDatabaseRepository_DeleteAnswerWrongsByExamId_Call{Call: _e.mock.On("DeleteAnswerWrongsByExamId", ctx, examId)}
+}
+
+func (_c *MockDatabaseRepository_DeleteAnswerWrongsByExamId_Call) Run(run func(ctx context.Context, examId string)) *MockDatabaseRepository_DeleteAnswerWrongsByExamId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteAnswerWrongsByExamId_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteAnswerWrongsByExamId_Call {
+	_c.Call.Return(deletedCount, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteAnswerWrongsByExamId_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_DeleteAnswerWrongsByExamId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAnswerWrongsByQuestionId provides a mock function with given fields: ctx, questionId
+func (_m *MockDatabaseRepository) DeleteAnswerWrongsByQuestionId(ctx context.Context, questionId string) (int32, error) {
+	ret := _m.Called(ctx, questionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAnswerWrongsByQuestionId")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
+		return rf(ctx, questionId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
+		r0 = rf(ctx, questionId)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, questionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_DeleteAnswerWrongsByQuestionId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAnswerWrongsByQuestionId'
+type MockDatabaseRepository_DeleteAnswerWrongsByQuestionId_Call struct {
+	*mock.Call
+}
+
+// DeleteAnswerWrongsByQuestionId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - questionId string
+func (_e *MockDatabaseRepository_Expecter) DeleteAnswerWrongsByQuestionId(ctx interface{}, questionId interface{}) *MockDatabaseRepository_DeleteAnswerWrongsByQuestionId_Call {
+	return &MockDatabaseRepository_DeleteAnswerWrongsByQuestionId_Call{Call: _e.mock.On("DeleteAnswerWrongsByQuestionId", ctx, questionId)}
+}
+
+func (_c *MockDatabaseRepository_DeleteAnswerWrongsByQuestionId_Call) Run(run func(ctx context.Context, questionId string)) *MockDatabaseRepository_DeleteAnswerWrongsByQuestionId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteAnswerWrongsByQuestionId_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteAnswerWrongsByQuestionId_Call {
+	_c.Call.Return(deletedCount, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteAnswerWrongsByQuestionId_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_DeleteAnswerWrongsByQuestionId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAnswerWrongsByQuestionIds provides a mock function with given fields: ctx, questionIds
+func (_m *MockDatabaseRepository) DeleteAnswerWrongsByQuestionIds(ctx context.Context, questionIds []string) (int32, error) {
+	ret := _m.Called(ctx, questionIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAnswerWrongsByQuestionIds")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (int32, error)); ok {
+		return rf(ctx, questionIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) int32); ok {
+		r0 = rf(ctx, questionIds)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, questionIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_DeleteAnswerWrongsByQuestionIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAnswerWrongsByQuestionIds'
+type MockDatabaseRepository_DeleteAnswerWrongsByQuestionIds_Call struct {
+	*mock.Call
+}
+
+// DeleteAnswerWrongsByQuestionIds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - questionIds []string
+func (_e *MockDatabaseRepository_Expecter) DeleteAnswerWrongsByQuestionIds(ctx interface{}, questionIds interface{}) *MockDatabaseRepository_DeleteAnswerWrongsByQuestionIds_Call {
+	return &MockDatabaseRepository_DeleteAnswerWrongsByQuestionIds_Call{Call: _e.mock.On("DeleteAnswerWrongsByQuestionIds", ctx, questionIds)}
+}
+
+func (_c *MockDatabaseRepository_DeleteAnswerWrongsByQuestionIds_Call) Run(run func(ctx context.Context, questionIds []string)) *MockDatabaseRepository_DeleteAnswerWrongsByQuestionIds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteAnswerWrongsByQuestionIds_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteAnswerWrongsByQuestionIds_Call {
+	_c.Call.Return(deletedCount, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteAnswerWrongsByQuestionIds_Call) RunAndReturn(run func(context.Context, []string) (int32, error)) *MockDatabaseRepository_DeleteAnswerWrongsByQuestionIds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAssignmentsByExamId provides a mock function with given fields: ctx, examId
+func (_m *MockDatabaseRepository) DeleteAssignmentsByExamId(ctx context.Context, examId string) (int32, error) {
+	ret := _m.Called(ctx, examId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAssignmentsByExamId")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
+		return rf(ctx, examId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
+		r0 = rf(ctx, examId)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, examId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_DeleteAssignmentsByExamId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAssignmentsByExamId'
+type MockDatabaseRepository_DeleteAssignmentsByExamId_Call struct {
+	*mock.Call
+}
+
+// DeleteAssignmentsByExamId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examId string
+func (_e *MockDatabaseRepository_Expecter) DeleteAssignmentsByExamId(ctx interface{}, examId interface{}) *MockDatabaseRepository_DeleteAssignmentsByExamId_Call {
+	return &MockDatabaseRepository_DeleteAssignmentsByExamId_Call{Call: _e.mock.On("DeleteAssignmentsByExamId", ctx, examId)}
+}
+
+func (_c *MockDatabaseRepository_DeleteAssignmentsByExamId_Call) Run(run func(ctx context.Context, examId string)) *MockDatabaseRepository_DeleteAssignmentsByExamId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteAssignmentsByExamId_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteAssignmentsByExamId_Call {
+	_c.Call.Return(deletedCount, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteAssignmentsByExamId_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_DeleteAssignmentsByExamId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBankQuestionById provides a mock function with given fields: ctx, bankQuestionId
+func (_m *MockDatabaseRepository) DeleteBankQuestionById(ctx context.Context, bankQuestionId string) (int32, error) {
+	ret := _m.Called(ctx, bankQuestionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBankQuestionById")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
+		return rf(ctx, bankQuestionId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
+		r0 = rf(ctx, bankQuestionId)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, bankQuestionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_DeleteBankQuestionById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBankQuestionById'
+type MockDatabaseRepository_DeleteBankQuestionById_Call struct {
+	*mock.Call
+}
+
+// DeleteBankQuestionById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bankQuestionId string
+func (_e *MockDatabaseRepository_Expecter) DeleteBankQuestionById(ctx interface{}, bankQuestionId interface{}) *MockDatabaseRepository_DeleteBankQuestionById_Call {
+	return &MockDatabaseRepository_DeleteBankQuestionById_Call{Call: _e.mock.On("DeleteBankQuestionById", ctx, bankQuestionId)}
+}
+
+func (_c *MockDatabaseRepository_DeleteBankQuestionById_Call) Run(run func(ctx context.Context, bankQuestionId string)) *MockDatabaseRepository_DeleteBankQuestionById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteBankQuestionById_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteBankQuestionById_Call {
+	_c.Call.Return(deletedCount, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteBankQuestionById_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_DeleteBankQuestionById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExamAttemptCountsByExamId provides a mock function with given fields: ctx, examId
+func (_m *MockDatabaseRepository) DeleteExamAttemptCountsByExamId(ctx context.Context, examId string) (int32, error) {
+	ret := _m.Called(ctx, examId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExamAttemptCountsByExamId")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
+		return rf(ctx, examId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
+		r0 = rf(ctx, examId)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, examId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_DeleteExamAttemptCountsByExamId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExamAttemptCountsByExamId'
+type MockDatabaseRepository_DeleteExamAttemptCountsByExamId_Call struct {
+	*mock.Call
+}
+
+// DeleteExamAttemptCountsByExamId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examId string
+func (_e *MockDatabaseRepository_Expecter) DeleteExamAttemptCountsByExamId(ctx interface{}, examId interface{}) *MockDatabaseRepository_DeleteExamAttemptCountsByExamId_Call {
+	return &MockDatabaseRepository_DeleteExamAttemptCountsByExamId_Call{Call: _e.mock.On("DeleteExamAttemptCountsByExamId", ctx, examId)}
+}
+
+func (_c *MockDatabaseRepository_DeleteExamAttemptCountsByExamId_Call) Run(run func(ctx context.Context, examId string)) *MockDatabaseRepository_DeleteExamAttemptCountsByExamId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteExamAttemptCountsByExamId_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteExamAttemptCountsByExamId_Call {
+	_c.Call.Return(deletedCount, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteExamAttemptCountsByExamId_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_DeleteExamAttemptCountsByExamId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExamAttemptsByExamId provides a mock function with given fields: ctx, examId
+func (_m *MockDatabaseRepository) DeleteExamAttemptsByExamId(ctx context.Context, examId string) (int32, error) {
+	ret := _m.Called(ctx, examId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExamAttemptsByExamId")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
+		return rf(ctx, examId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
+		r0 = rf(ctx, examId)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, examId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_DeleteExamAttemptsByExamId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExamAttemptsByExamId'
+type MockDatabaseRepository_DeleteExamAttemptsByExamId_Call struct {
+	*mock.Call
+}
+
+// DeleteExamAttemptsByExamId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examId string
+func (_e *MockDatabaseRepository_Expecter) DeleteExamAttemptsByExamId(ctx interface{}, examId interface{}) *MockDatabaseRepository_DeleteExamAttemptsByExamId_Call {
+	return &MockDatabaseRepository_DeleteExamAttemptsByExamId_Call{Call: _e.mock.On("DeleteExamAttemptsByExamId", ctx, examId)}
+}
+
+func (_c *MockDatabaseRepository_DeleteExamAttemptsByExamId_Call) Run(run func(ctx context.Context, examId string)) *MockDatabaseRepository_DeleteExamAttemptsByExamId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteExamAttemptsByExamId_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteExamAttemptsByExamId_Call {
+	_c.Call.Return(deletedCount, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteExamAttemptsByExamId_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_DeleteExamAttemptsByExamId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExamById provides a mock function with given fields: ctx, examId
+func (_m *MockDatabaseRepository) DeleteExamById(ctx context.Context, examId string) (int32, error) {
+	ret := _m.Called(ctx, examId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExamById")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
+		return rf(ctx, examId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
+		r0 = rf(ctx, examId)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, examId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_DeleteExamById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExamById'
+type MockDatabaseRepository_DeleteExamById_Call struct {
+	*mock.Call
+}
+
+// DeleteExamById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examId string
+func (_e *MockDatabaseRepository_Expecter) DeleteExamById(ctx interface{}, examId interface{}) *MockDatabaseRepository_DeleteExamById_Call {
+	return &MockDatabaseRepository_DeleteExamById_Call{Call: _e.mock.On("DeleteExamById", ctx, examId)}
+}
+
+func (_c *MockDatabaseRepository_DeleteExamById_Call) Run(run func(ctx context.Context, examId string)) *MockDatabaseRepository_DeleteExamById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteExamById_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteExamById_Call {
+	_c.Call.Return(deletedCount, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteExamById_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_DeleteExamById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExamRecordsByExamId provides a mock function with given fields: ctx, examId
+func (_m *MockDatabaseRepository) DeleteExamRecordsByExamId(ctx context.Context, examId string) (int32, error) {
+	ret := _m.Called(ctx, examId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExamRecordsByExamId")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
+		return rf(ctx, examId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
+		r0 = rf(ctx, examId)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, examId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_DeleteExamRecordsByExamId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExamRecordsByExamId'
+type MockDatabaseRepository_DeleteExamRecordsByExamId_Call struct {
+	*mock.Call
+}
+
+// DeleteExamRecordsByExamId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examId string
+func (_e *MockDatabaseRepository_Expecter) DeleteExamRecordsByExamId(ctx interface{}, examId interface{}) *MockDatabaseRepository_DeleteExamRecordsByExamId_Call {
+	return &MockDatabaseRepository_DeleteExamRecordsByExamId_Call{Call: _e.mock.On("DeleteExamRecordsByExamId", ctx, examId)}
+}
+
+func (_c *MockDatabaseRepository_DeleteExamRecordsByExamId_Call) Run(run func(ctx context.Context, examId string)) *MockDatabaseRepository_DeleteExamRecordsByExamId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteExamRecordsByExamId_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteExamRecordsByExamId_Call {
+	_c.Call.Return(deletedCount, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteExamRecordsByExamId_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_DeleteExamRecordsByExamId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExamSectionById provides a mock function with given fields: ctx, sectionId
+func (_m *MockDatabaseRepository) DeleteExamSectionById(ctx context.Context, sectionId string) (int32, error) {
+	ret := _m.Called(ctx, sectionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExamSectionById")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
+		return rf(ctx, sectionId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
+		r0 = rf(ctx, sectionId)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sectionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_DeleteExamSectionById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExamSectionById'
+type MockDatabaseRepository_DeleteExamSectionById_Call struct {
+	*mock.Call
+}
+
+// DeleteExamSectionById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sectionId string
+func (_e *MockDatabaseRepository_Expecter) DeleteExamSectionById(ctx interface{}, sectionId interface{}) *MockDatabaseRepository_DeleteExamSectionById_Call {
+	return &MockDatabaseRepository_DeleteExamSectionById_Call{Call: _e.mock.On("DeleteExamSectionById", ctx, sectionId)}
+}
+
+func (_c *MockDatabaseRepository_DeleteExamSectionById_Call) Run(run func(ctx context.Context, sectionId string)) *MockDatabaseRepository_DeleteExamSectionById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteExamSectionById_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteExamSectionById_Call {
+	_c.Call.Return(deletedCount, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteExamSectionById_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_DeleteExamSectionById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExamSectionsByExamId provides a mock function with given fields: ctx, examId
+func (_m *MockDatabaseRepository) DeleteExamSectionsByExamId(ctx context.Context, examId string) (int32, error) {
+	ret := _m.Called(ctx, examId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExamSectionsByExamId")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
+		return rf(ctx, examId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
+		r0 = rf(ctx, examId)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, examId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_DeleteExamSectionsByExamId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExamSectionsByExamId'
+type MockDatabaseRepository_DeleteExamSectionsByExamId_Call struct {
+	*mock.Call
+}
+
+// DeleteExamSectionsByExamId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examId string
+func (_e *MockDatabaseRepository_Expecter) DeleteExamSectionsByExamId(ctx interface{}, examId interface{}) *MockDatabaseRepository_DeleteExamSectionsByExamId_Call {
+	return &MockDatabaseRepository_DeleteExamSectionsByExamId_Call{Call: _e.mock.On("DeleteExamSectionsByExamId", ctx, examId)}
+}
+
+func (_c *MockDatabaseRepository_DeleteExamSectionsByExamId_Call) Run(run func(ctx context.Context, examId string)) *MockDatabaseRepository_DeleteExamSectionsByExamId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteExamSectionsByExamId_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteExamSectionsByExamId_Call {
+	_c.Call.Return(deletedCount, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteExamSectionsByExamId_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_DeleteExamSectionsByExamId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExamVersionsByExamId provides a mock function with given fields: ctx, examId
+func (_m *MockDatabaseRepository) DeleteExamVersionsByExamId(ctx context.Context, examId string) (int32, error) {
+	ret := _m.Called(ctx, examId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExamVersionsByExamId")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
+		return rf(ctx, examId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
+		r0 = rf(ctx, examId)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, examId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_DeleteExamVersionsByExamId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExamVersionsByExamId'
+type MockDatabaseRepository_DeleteExamVersionsByExamId_Call struct {
+	*mock.Call
+}
+
+// DeleteExamVersionsByExamId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examId string
+func (_e *MockDatabaseRepository_Expecter) DeleteExamVersionsByExamId(ctx interface{}, examId interface{}) *MockDatabaseRepository_DeleteExamVersionsByExamId_Call {
+	return &MockDatabaseRepository_DeleteExamVersionsByExamId_Call{Call: _e.mock.On("DeleteExamVersionsByExamId", ctx, examId)}
+}
+
+func (_c *MockDatabaseRepository_DeleteExamVersionsByExamId_Call) Run(run func(ctx context.Context, examId string)) *MockDatabaseRepository_DeleteExamVersionsByExamId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteExamVersionsByExamId_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteExamVersionsByExamId_Call {
+	_c.Call.Return(deletedCount, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteExamVersionsByExamId_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_DeleteExamVersionsByExamId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteQuestionById provides a mock function with given fields: ctx, questionId
+func (_m *MockDatabaseRepository) DeleteQuestionById(ctx context.Context, questionId string) (int32, error) {
+	ret := _m.Called(ctx, questionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteQuestionById")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
+		return rf(ctx, questionId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
+		r0 = rf(ctx, questionId)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, questionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_DeleteQuestionById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQuestionById'
+type MockDatabaseRepository_DeleteQuestionById_Call struct {
+	*mock.Call
+}
+
+// DeleteQuestionById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - questionId string
+func (_e *MockDatabaseRepository_Expecter) DeleteQuestionById(ctx interface{}, questionId interface{}) *MockDatabaseRepository_DeleteQuestionById_Call {
+	return &MockDatabaseRepository_DeleteQuestionById_Call{Call: _e.mock.On("DeleteQuestionById", ctx, questionId)}
+}
+
+func (_c *MockDatabaseRepository_DeleteQuestionById_Call) Run(run func(ctx context.Context, questionId string)) *MockDatabaseRepository_DeleteQuestionById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteQuestionById_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteQuestionById_Call {
+	_c.Call.Return(deletedCount, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteQuestionById_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_DeleteQuestionById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteQuestionsByExamId provides a mock function with given fields: ctx, examId
+func (_m *MockDatabaseRepository) DeleteQuestionsByExamId(ctx context.Context, examId string) (int32, error) {
+	ret := _m.Called(ctx, examId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteQuestionsByExamId")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
+		return rf(ctx, examId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
+		r0 = rf(ctx, examId)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, examId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_DeleteQuestionsByExamId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQuestionsByExamId'
+type MockDatabaseRepository_DeleteQuestionsByExamId_Call struct {
+	*mock.Call
+}
+
+// DeleteQuestionsByExamId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examId string
+func (_e *MockDatabaseRepository_Expecter) DeleteQuestionsByExamId(ctx interface{}, examId interface{}) *MockDatabaseRepository_DeleteQuestionsByExamId_Call {
+	return &MockDatabaseRepository_DeleteQuestionsByExamId_Call{Call: _e.mock.On("DeleteQuestionsByExamId", ctx, examId)}
+}
+
+func (_c *MockDatabaseRepository_DeleteQuestionsByExamId_Call) Run(run func(ctx context.Context, examId string)) *MockDatabaseRepository_DeleteQuestionsByExamId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteQuestionsByExamId_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteQuestionsByExamId_Call {
+	_c.Call.Return(deletedCount, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteQuestionsByExamId_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_DeleteQuestionsByExamId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteQuestionsByIds provides a mock function with given fields: ctx, questionIds
+func (_m *MockDatabaseRepository) DeleteQuestionsByIds(ctx context.Context, questionIds []string) (int32, error) {
+	ret := _m.Called(ctx, questionIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteQuestionsByIds")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (int32, error)); ok {
+		return rf(ctx, questionIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) int32); ok {
+		r0 = rf(ctx, questionIds)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, questionIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_DeleteQuestionsByIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQuestionsByIds'
+type MockDatabaseRepository_DeleteQuestionsByIds_Call struct {
+	*mock.Call
+}
+
+// DeleteQuestionsByIds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - questionIds []string
+func (_e *MockDatabaseRepository_Expecter) DeleteQuestionsByIds(ctx interface{}, questionIds interface{}) *MockDatabaseRepository_DeleteQuestionsByIds_Call {
+	return &MockDatabaseRepository_DeleteQuestionsByIds_Call{Call: _e.mock.On("DeleteQuestionsByIds", ctx, questionIds)}
+}
+
+func (_c *MockDatabaseRepository_DeleteQuestionsByIds_Call) Run(run func(ctx context.Context, questionIds []string)) *MockDatabaseRepository_DeleteQuestionsByIds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteQuestionsByIds_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteQuestionsByIds_Call {
+	_c.Call.Return(deletedCount, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteQuestionsByIds_Call) RunAndReturn(run func(context.Context, []string) (int32, error)) *MockDatabaseRepository_DeleteQuestionsByIds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DisconnectDB provides a mock function with given fields: ctx
+func (_m *MockDatabaseRepository) DisconnectDB(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisconnectDB")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatabaseRepository_DisconnectDB_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisconnectDB'
+type MockDatabaseRepository_DisconnectDB_Call struct {
+	*mock.Call
+}
+
+// DisconnectDB is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDatabaseRepository_Expecter) DisconnectDB(ctx interface{}) *MockDatabaseRepository_DisconnectDB_Call {
+	return &MockDatabaseRepository_DisconnectDB_Call{Call: _e.mock.On("DisconnectDB", ctx)}
+}
+
+func (_c *MockDatabaseRepository_DisconnectDB_Call) Run(run func(ctx context.Context)) *MockDatabaseRepository_DisconnectDB_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DisconnectDB_Call) Return(_a0 error) *MockDatabaseRepository_DisconnectDB_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DisconnectDB_Call) RunAndReturn(run func(context.Context) error) *MockDatabaseRepository_DisconnectDB_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc provides a mock function with given fields: ctx, examId, examVersion, userId, limit
+func (_m *MockDatabaseRepository) FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc(ctx context.Context, examId string, examVersion int32, userId string, limit int32) ([]model.AnswerWrong, error) {
+	ret := _m.Called(ctx, examId, examVersion, userId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc")
+	}
+
+	var r0 []model.AnswerWrong
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32, string, int32) ([]model.AnswerWrong, error)); ok {
+		return rf(ctx, examId, examVersion, userId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32, string, int32) []model.AnswerWrong); ok {
+		r0 = rf(ctx, examId, examVersion, userId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.AnswerWrong)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32, string, int32) error); ok {
+		r1 = rf(ctx, examId, examVersion, userId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc'
+type MockDatabaseRepository_FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc_Call struct {
+	*mock.Call
+}
+
+// FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examId string
+//   - examVersion int32
+//   - userId string
+//   - limit int32
+func (_e *MockDatabaseRepository_Expecter) FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc(ctx interface{}, examId interface{}, examVersion interface{}, userId interface{}, limit interface{}) *MockDatabaseRepository_FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc_Call {
+	return &MockDatabaseRepository_FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc_Call{Call: _e.mock.On("FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc", ctx, examId, examVersion, userId, limit)}
+}
+
+func (_c *MockDatabaseRepository_FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc_Call) Run(run func(ctx context.Context, examId string, examVersion int32, userId string, limit int32)) *MockDatabaseRepository_FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32), args[3].(string), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc_Call) Return(answerWrongs []model.AnswerWrong, err error) *MockDatabaseRepository_FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc_Call {
+	_c.Call.Return(answerWrongs, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc_Call) RunAndReturn(run func(context.Context, string, int32, string, int32) ([]model.AnswerWrong, error)) *MockDatabaseRepository_FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetweenOrderByUpdatedAtDesc provides a mock function with given fields: ctx, userId, examId, startDate, endDate, skip, limit
+func (_m *MockDatabaseRepository) FindAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetweenOrderByUpdatedAtDesc(ctx context.Context, userId string, examId string, startDate time.Time, endDate time.Time, skip int32, limit int32) ([]model.AnswerWrong, error) {
+	ret := _m.Called(ctx, userId, examId, startDate, endDate, skip, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetweenOrderByUpdatedAtDesc")
+	}
+
+	var r0 []model.AnswerWrong
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time, time.Time, int32, int32) ([]model.AnswerWrong, error)); ok {
+		return rf(ctx, userId, examId, startDate, endDate, skip, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time, time.Time, int32, int32) []model.AnswerWrong); ok {
+		r0 = rf(ctx, userId, examId, startDate, endDate, skip, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.AnswerWrong)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time, time.Time, int32, int32) error); ok {
+		r1 = rf(ctx, userId, examId, startDate, endDate, skip, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetweenOrderByUpdatedAtDesc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetweenOrderByUpdatedAtDesc'
+type MockDatabaseRepository_FindAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetweenOrderByUpdatedAtDesc_Call struct {
+	*mock.Call
+}
+
+// FindAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetweenOrderByUpdatedAtDesc is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - examId string
+//   - startDate time.Time
+//   - endDate time.Time
+//   - skip int32
+//   - limit int32
+func (_e *MockDatabaseRepository_Expecter) FindAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetweenOrderByUpdatedAtDesc(ctx interface{}, userId interface{}, examId interface{}, startDate interface{}, endDate interface{}, skip interface{}, limit interface{}) *MockDatabaseRepository_FindAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetweenOrderByUpdatedAtDesc_Call {
+	return &MockDatabaseRepository_FindAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetweenOrderByUpdatedAtDesc_Call{Call: _e.mock.On("FindAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetweenOrderByUpdatedAtDesc", ctx, userId, examId, startDate, endDate, skip, limit)}
+}
+
+func (_c *MockDatabaseRepository_FindAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetweenOrderByUpdatedAtDesc_Call) Run(run func(ctx context.Context, userId string, examId string, startDate time.Time, endDate time.Time, skip int32, limit int32)) *MockDatabaseRepository_FindAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetweenOrderByUpdatedAtDesc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time), args[4].(time.Time), args[5].(int32), args[6].(int32))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetweenOrderByUpdatedAtDesc_Call) Return(answerWrongs []model.AnswerWrong, err error) *MockDatabaseRepository_FindAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetweenOrderByUpdatedAtDesc_Call {
+	_c.Call.Return(answerWrongs, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetweenOrderByUpdatedAtDesc_Call) RunAndReturn(run func(context.Context, string, string, time.Time, time.Time, int32, int32) ([]model.AnswerWrong, error)) *MockDatabaseRepository_FindAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetweenOrderByUpdatedAtDesc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAssignmentsByClassIdOrderByDueAtAsc provides a mock function with given fields: ctx, classId
+func (_m *MockDatabaseRepository) FindAssignmentsByClassIdOrderByDueAtAsc(ctx context.Context, classId string) ([]model.Assignment, error) {
+	ret := _m.Called(ctx, classId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAssignmentsByClassIdOrderByDueAtAsc")
+	}
+
+	var r0 []model.Assignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.Assignment, error)); ok {
+		return rf(ctx, classId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.Assignment); ok {
+		r0 = rf(ctx, classId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Assignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, classId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindAssignmentsByClassIdOrderByDueAtAsc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAssignmentsByClassIdOrderByDueAtAsc'
+type MockDatabaseRepository_FindAssignmentsByClassIdOrderByDueAtAsc_Call struct {
+	*mock.Call
+}
+
+// FindAssignmentsByClassIdOrderByDueAtAsc is a helper method to define mock.On call
+//   - ctx context.Context
+//   - classId string
+func (_e *MockDatabaseRepository_Expecter) FindAssignmentsByClassIdOrderByDueAtAsc(ctx interface{}, classId interface{}) *MockDatabaseRepository_FindAssignmentsByClassIdOrderByDueAtAsc_Call {
+	return &MockDatabaseRepository_FindAssignmentsByClassIdOrderByDueAtAsc_Call{Call: _e.mock.On("FindAssignmentsByClassIdOrderByDueAtAsc", ctx, classId)}
+}
+
+func (_c *MockDatabaseRepository_FindAssignmentsByClassIdOrderByDueAtAsc_Call) Run(run func(ctx context.Context, classId string)) *MockDatabaseRepository_FindAssignmentsByClassIdOrderByDueAtAsc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindAssignmentsByClassIdOrderByDueAtAsc_Call) Return(assignments []model.Assignment, err error) *MockDatabaseRepository_FindAssignmentsByClassIdOrderByDueAtAsc_Call {
+	_c.Call.Return(assignments, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindAssignmentsByClassIdOrderByDueAtAsc_Call) RunAndReturn(run func(context.Context, string) ([]model.Assignment, error)) *MockDatabaseRepository_FindAssignmentsByClassIdOrderByDueAtAsc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBankQuestionsByIds provides a mock function with given fields: ctx, bankQuestionIds
+func (_m *MockDatabaseRepository) FindBankQuestionsByIds(ctx context.Context, bankQuestionIds []string) ([]model.BankQuestion, error) {
+	ret := _m.Called(ctx, bankQuestionIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBankQuestionsByIds")
+	}
+
+	var r0 []model.BankQuestion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]model.BankQuestion, error)); ok {
+		return rf(ctx, bankQuestionIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []model.BankQuestion); ok {
+		r0 = rf(ctx, bankQuestionIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.BankQuestion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, bankQuestionIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindBankQuestionsByIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBankQuestionsByIds'
+type MockDatabaseRepository_FindBankQuestionsByIds_Call struct {
+	*mock.Call
+}
+
+// FindBankQuestionsByIds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bankQuestionIds []string
+func (_e *MockDatabaseRepository_Expecter) FindBankQuestionsByIds(ctx interface{}, bankQuestionIds interface{}) *MockDatabaseRepository_FindBankQuestionsByIds_Call {
+	return &MockDatabaseRepository_FindBankQuestionsByIds_Call{Call: _e.mock.On("FindBankQuestionsByIds", ctx, bankQuestionIds)}
+}
+
+func (_c *MockDatabaseRepository_FindBankQuestionsByIds_Call) Run(run func(ctx context.Context, bankQuestionIds []string)) *MockDatabaseRepository_FindBankQuestionsByIds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindBankQuestionsByIds_Call) Return(bankQuestions []model.BankQuestion, err error) *MockDatabaseRepository_FindBankQuestionsByIds_Call {
+	_c.Call.Return(bankQuestions, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindBankQuestionsByIds_Call) RunAndReturn(run func(context.Context, []string) ([]model.BankQuestion, error)) *MockDatabaseRepository_FindBankQuestionsByIds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc provides a mock function with given fields: ctx, userId, tag, skip, limit
+func (_m *MockDatabaseRepository) FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc(ctx context.Context, userId string, tag string, skip int32, limit int32) ([]model.BankQuestion, error) {
+	ret := _m.Called(ctx, userId, tag, skip, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc")
+	}
+
+	var r0 []model.BankQuestion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32, int32) ([]model.BankQuestion, error)); ok {
+		return rf(ctx, userId, tag, skip, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32, int32) []model.BankQuestion); ok {
+		r0 = rf(ctx, userId, tag, skip, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.BankQuestion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32, int32) error); ok {
+		r1 = rf(ctx, userId, tag, skip, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc'
+type MockDatabaseRepository_FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc_Call struct {
+	*mock.Call
+}
+
+// FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - tag string
+//   - skip int32
+//   - limit int32
+func (_e *MockDatabaseRepository_Expecter) FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc(ctx interface{}, userId interface{}, tag interface{}, skip interface{}, limit interface{}) *MockDatabaseRepository_FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc_Call {
+	return &MockDatabaseRepository_FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc_Call{Call: _e.mock.On("FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc", ctx, userId, tag, skip, limit)}
+}
+
+func (_c *MockDatabaseRepository_FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc_Call) Run(run func(ctx context.Context, userId string, tag string, skip int32, limit int32)) *MockDatabaseRepository_FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int32), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc_Call) Return(bankQuestions []model.BankQuestion, err error) *MockDatabaseRepository_FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc_Call {
+	_c.Call.Return(bankQuestions, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc_Call) RunAndReturn(run func(context.Context, string, string, int32, int32) ([]model.BankQuestion, error)) *MockDatabaseRepository_FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc provides a mock function with given fields: ctx, userId
+func (_m *MockDatabaseRepository) FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc(ctx context.Context, userId string) ([]model.Class, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc")
+	}
+
+	var r0 []model.Class
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.Class, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.Class); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Class)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc'
+type MockDatabaseRepository_FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc_Call struct {
+	*mock.Call
+}
+
+// FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *MockDatabaseRepository_Expecter) FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc(ctx interface{}, userId interface{}) *MockDatabaseRepository_FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc_Call {
+	return &MockDatabaseRepository_FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc_Call{Call: _e.mock.On("FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc", ctx, userId)}
+}
+
+func (_c *MockDatabaseRepository_FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc_Call) Run(run func(ctx context.Context, userId string)) *MockDatabaseRepository_FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc_Call) Return(classes []model.Class, err error) *MockDatabaseRepository_FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc_Call {
+	_c.Call.Return(classes, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc_Call) RunAndReturn(run func(context.Context, string) ([]model.Class, error)) *MockDatabaseRepository_FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween provides a mock function with given fields: ctx, examId, userIds, startAt, endAt
+func (_m *MockDatabaseRepository) FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween(ctx context.Context, examId string, userIds []string, startAt time.Time, endAt time.Time) ([]ExamRecordScore, error) {
+	ret := _m.Called(ctx, examId, userIds, startAt, endAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween")
+	}
+
+	var r0 []ExamRecordScore
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, time.Time, time.Time) ([]ExamRecordScore, error)); ok {
+		return rf(ctx, examId, userIds, startAt, endAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, time.Time, time.Time) []ExamRecordScore); ok {
+		r0 = rf(ctx, examId, userIds, startAt, endAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ExamRecordScore)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, examId, userIds, startAt, endAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween'
+type MockDatabaseRepository_FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween_Call struct {
+	*mock.Call
+}
+
+// FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examId string
+//   - userIds []string
+//   - startAt time.Time
+//   - endAt time.Time
+func (_e *MockDatabaseRepository_Expecter) FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween(ctx interface{}, examId interface{}, userIds interface{}, startAt interface{}, endAt interface{}) *MockDatabaseRepository_FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween_Call {
+	return &MockDatabaseRepository_FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween_Call{Call: _e.mock.On("FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween", ctx, examId, userIds, startAt, endAt)}
+}
+
+func (_c *MockDatabaseRepository_FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween_Call) Run(run func(ctx context.Context, examId string, userIds []string, startAt time.Time, endAt time.Time)) *MockDatabaseRepository_FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string), args[3].(time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween_Call) Return(examRecordScores []ExamRecordScore, err error) *MockDatabaseRepository_FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween_Call {
+	_c.Call.Return(examRecordScores, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween_Call) RunAndReturn(run func(context.Context, string, []string, time.Time, time.Time) ([]ExamRecordScore, error)) *MockDatabaseRepository_FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween provides a mock function with given fields: ctx, examId, userId, startAt, endAt
+func (_m *MockDatabaseRepository) FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween(ctx context.Context, examId string, userId string, startAt time.Time, endAt time.Time) ([]model.ExamRecord, error) {
+	ret := _m.Called(ctx, examId, userId, startAt, endAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween")
+	}
+
+	var r0 []model.ExamRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time, time.Time) ([]model.ExamRecord, error)); ok {
+		return rf(ctx, examId, userId, startAt, endAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time, time.Time) []model.ExamRecord); ok {
+		r0 = rf(ctx, examId, userId, startAt, endAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ExamRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, examId, userId, startAt, endAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween'
+type MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween_Call struct {
+	*mock.Call
+}
+
+// FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examId string
+//   - userId string
+//   - startAt time.Time
+//   - endAt time.Time
+func (_e *MockDatabaseRepository_Expecter) FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween(ctx interface{}, examId interface{}, userId interface{}, startAt interface{}, endAt interface{}) *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween_Call {
+	return &MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween_Call{Call: _e.mock.On("FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween", ctx, examId, userId, startAt, endAt)}
+}
+
+func (_c *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween_Call) Run(run func(ctx context.Context, examId string, userId string, startAt time.Time, endAt time.Time)) *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween_Call) Return(examRecords []model.ExamRecord, err error) *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween_Call {
+	_c.Call.Return(examRecords, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween_Call) RunAndReturn(run func(context.Context, string, string, time.Time, time.Time) ([]model.ExamRecord, error)) *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc provides a mock function with given fields: ctx, examId, userId, cursor, limit
+func (_m *MockDatabaseRepository) FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc(ctx context.Context, examId string, userId string, cursor string, limit int32) ([]model.ExamRecord, string, error) {
+	ret := _m.Called(ctx, examId, userId, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc")
+	}
+
+	var r0 []model.ExamRecord
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int32) ([]model.ExamRecord, string, error)); ok {
+		return rf(ctx, examId, userId, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int32) []model.ExamRecord); ok {
+		r0 = rf(ctx, examId, userId, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ExamRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, int32) string); ok {
+		r1 = rf(ctx, examId, userId, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, string, int32) error); ok {
+		r2 = rf(ctx, examId, userId, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc'
+type MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc_Call struct {
+	*mock.Call
+}
+
+// FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examId string
+//   - userId string
+//   - cursor string
+//   - limit int32
+func (_e *MockDatabaseRepository_Expecter) FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc(ctx interface{}, examId interface{}, userId interface{}, cursor interface{}, limit interface{}) *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc_Call {
+	return &MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc_Call{Call: _e.mock.On("FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc", ctx, examId, userId, cursor, limit)}
+}
+
+func (_c *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc_Call) Run(run func(ctx context.Context, examId string, userId string, cursor string, limit int32)) *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc_Call) Return(examRecords []model.ExamRecord, nextCursor string, err error) *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc_Call {
+	_c.Call.Return(examRecords, nextCursor, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc_Call) RunAndReturn(run func(context.Context, string, string, string, int32) ([]model.ExamRecord, string, error)) *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc provides a mock function with given fields: ctx, examId, userId, skip, limit
+func (_m *MockDatabaseRepository) FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc(ctx context.Context, examId string, userId string, skip int32, limit int32) ([]model.ExamRecord, error) {
+	ret := _m.Called(ctx, examId, userId, skip, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc")
+	}
+
+	var r0 []model.ExamRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32, int32) ([]model.ExamRecord, error)); ok {
+		return rf(ctx, examId, userId, skip, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32, int32) []model.ExamRecord); ok {
+		r0 = rf(ctx, examId, userId, skip, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ExamRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32, int32) error); ok {
+		r1 = rf(ctx, examId, userId, skip, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc'
+type MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc_Call struct {
+	*mock.Call
+}
+
+// FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examId string
+//   - userId string
+//   - skip int32
+//   - limit int32
+func (_e *MockDatabaseRepository_Expecter) FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc(ctx interface{}, examId interface{}, userId interface{}, skip interface{}, limit interface{}) *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc_Call {
+	return &MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc_Call{Call: _e.mock.On("FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc", ctx, examId, userId, skip, limit)}
+}
+
+func (_c *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc_Call) Run(run func(ctx context.Context, examId string, userId string, skip int32, limit int32)) *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int32), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc_Call) Return(examRecords []model.ExamRecord, err error) *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc_Call {
+	_c.Call.Return(examRecords, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc_Call) RunAndReturn(run func(context.Context, string, string, int32, int32) ([]model.ExamRecord, error)) *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExamSectionsByExamIdOrderByOrderAsc provides a mock function with given fields: ctx, examId
+func (_m *MockDatabaseRepository) FindExamSectionsByExamIdOrderByOrderAsc(ctx context.Context, examId string) ([]model.ExamSection, error) {
+	ret := _m.Called(ctx, examId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExamSectionsByExamIdOrderByOrderAsc")
+	}
+
+	var r0 []model.ExamSection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.ExamSection, error)); ok {
+		return rf(ctx, examId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.ExamSection); ok {
+		r0 = rf(ctx, examId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ExamSection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, examId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindExamSectionsByExamIdOrderByOrderAsc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamSectionsByExamIdOrderByOrderAsc'
+type MockDatabaseRepository_FindExamSectionsByExamIdOrderByOrderAsc_Call struct {
+	*mock.Call
+}
+
+// FindExamSectionsByExamIdOrderByOrderAsc is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examId string
+func (_e *MockDatabaseRepository_Expecter) FindExamSectionsByExamIdOrderByOrderAsc(ctx interface{}, examId interface{}) *MockDatabaseRepository_FindExamSectionsByExamIdOrderByOrderAsc_Call {
+	return &MockDatabaseRepository_FindExamSectionsByExamIdOrderByOrderAsc_Call{Call: _e.mock.On("FindExamSectionsByExamIdOrderByOrderAsc", ctx, examId)}
+}
+
+func (_c *MockDatabaseRepository_FindExamSectionsByExamIdOrderByOrderAsc_Call) Run(run func(ctx context.Context, examId string)) *MockDatabaseRepository_FindExamSectionsByExamIdOrderByOrderAsc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamSectionsByExamIdOrderByOrderAsc_Call) Return(examSections []model.ExamSection, err error) *MockDatabaseRepository_FindExamSectionsByExamIdOrderByOrderAsc_Call {
+	_c.Call.Return(examSections, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamSectionsByExamIdOrderByOrderAsc_Call) RunAndReturn(run func(context.Context, string) ([]model.ExamSection, error)) *MockDatabaseRepository_FindExamSectionsByExamIdOrderByOrderAsc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExamStatisticsSummaryByExamId provides a mock function with given fields: ctx, examId
+func (_m *MockDatabaseRepository) FindExamStatisticsSummaryByExamId(ctx context.Context, examId string) (ExamStatisticsSummary, error) {
+	ret := _m.Called(ctx, examId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExamStatisticsSummaryByExamId")
+	}
+
+	var r0 ExamStatisticsSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (ExamStatisticsSummary, error)); ok {
+		return rf(ctx, examId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) ExamStatisticsSummary); ok {
+		r0 = rf(ctx, examId)
+	} else {
+		r0 = ret.Get(0).(ExamStatisticsSummary)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, examId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindExamStatisticsSummaryByExamId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamStatisticsSummaryByExamId'
+type MockDatabaseRepository_FindExamStatisticsSummaryByExamId_Call struct {
+	*mock.Call
+}
+
+// FindExamStatisticsSummaryByExamId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examId string
+func (_e *MockDatabaseRepository_Expecter) FindExamStatisticsSummaryByExamId(ctx interface{}, examId interface{}) *MockDatabaseRepository_FindExamStatisticsSummaryByExamId_Call {
+	return &MockDatabaseRepository_FindExamStatisticsSummaryByExamId_Call{Call: _e.mock.On("FindExamStatisticsSummaryByExamId", ctx, examId)}
+}
+
+func (_c *MockDatabaseRepository_FindExamStatisticsSummaryByExamId_Call) Run(run func(ctx context.Context, examId string)) *MockDatabaseRepository_FindExamStatisticsSummaryByExamId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamStatisticsSummaryByExamId_Call) Return(summary ExamStatisticsSummary, err error) *MockDatabaseRepository_FindExamStatisticsSummaryByExamId_Call {
+	_c.Call.Return(summary, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamStatisticsSummaryByExamId_Call) RunAndReturn(run func(context.Context, string) (ExamStatisticsSummary, error)) *MockDatabaseRepository_FindExamStatisticsSummaryByExamId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc provides a mock function with given fields: ctx, userId, isPublic, tags
+func (_m *MockDatabaseRepository) FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc(ctx context.Context, userId string, isPublic bool, tags []string) ([]ExamSummary, error) {
+	ret := _m.Called(ctx, userId, isPublic, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc")
+	}
+
+	var r0 []ExamSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, []string) ([]ExamSummary, error)); ok {
+		return rf(ctx, userId, isPublic, tags)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, []string) []ExamSummary); ok {
+		r0 = rf(ctx, userId, isPublic, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ExamSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool, []string) error); ok {
+		r1 = rf(ctx, userId, isPublic, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc'
+type MockDatabaseRepository_FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc_Call struct {
+	*mock.Call
+}
+
+// FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - isPublic bool
+//   - tags []string
+func (_e *MockDatabaseRepository_Expecter) FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc(ctx interface{}, userId interface{}, isPublic interface{}, tags interface{}) *MockDatabaseRepository_FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc_Call {
+	return &MockDatabaseRepository_FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc_Call{Call: _e.mock.On("FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc", ctx, userId, isPublic, tags)}
+}
+
+func (_c *MockDatabaseRepository_FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc_Call) Run(run func(ctx context.Context, userId string, isPublic bool, tags []string)) *MockDatabaseRepository_FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc_Call) Return(examSummaries []ExamSummary, err error) *MockDatabaseRepository_FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc_Call {
+	_c.Call.Return(examSummaries, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc_Call) RunAndReturn(run func(context.Context, string, bool, []string) ([]ExamSummary, error)) *MockDatabaseRepository_FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExamTagCounts provides a mock function with given fields: ctx, userId, limit
+func (_m *MockDatabaseRepository) FindExamTagCounts(ctx context.Context, userId string, limit int32) ([]TagCount, error) {
+	ret := _m.Called(ctx, userId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExamTagCounts")
+	}
+
+	var r0 []TagCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) ([]TagCount, error)); ok {
+		return rf(ctx, userId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) []TagCount); ok {
+		r0 = rf(ctx, userId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]TagCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32) error); ok {
+		r1 = rf(ctx, userId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindExamTagCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamTagCounts'
+type MockDatabaseRepository_FindExamTagCounts_Call struct {
+	*mock.Call
+}
+
+// FindExamTagCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - limit int32
+func (_e *MockDatabaseRepository_Expecter) FindExamTagCounts(ctx interface{}, userId interface{}, limit interface{}) *MockDatabaseRepository_FindExamTagCounts_Call {
+	return &MockDatabaseRepository_FindExamTagCounts_Call{Call: _e.mock.On("FindExamTagCounts", ctx, userId, limit)}
+}
+
+func (_c *MockDatabaseRepository_FindExamTagCounts_Call) Run(run func(ctx context.Context, userId string, limit int32)) *MockDatabaseRepository_FindExamTagCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamTagCounts_Call) Return(tagCounts []TagCount, err error) *MockDatabaseRepository_FindExamTagCounts_Call {
+	_c.Call.Return(tagCounts, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamTagCounts_Call) RunAndReturn(run func(context.Context, string, int32) ([]TagCount, error)) *MockDatabaseRepository_FindExamTagCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExamVersionSummariesByExamIdOrderByVersionDesc provides a mock function with given fields: ctx, examId
+func (_m *MockDatabaseRepository) FindExamVersionSummariesByExamIdOrderByVersionDesc(ctx context.Context, examId string) ([]ExamVersionSummary, error) {
+	ret := _m.Called(ctx, examId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExamVersionSummariesByExamIdOrderByVersionDesc")
+	}
+
+	var r0 []ExamVersionSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]ExamVersionSummary, error)); ok {
+		return rf(ctx, examId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []ExamVersionSummary); ok {
+		r0 = rf(ctx, examId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ExamVersionSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, examId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindExamVersionSummariesByExamIdOrderByVersionDesc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamVersionSummariesByExamIdOrderByVersionDesc'
+type MockDatabaseRepository_FindExamVersionSummariesByExamIdOrderByVersionDesc_Call struct {
+	*mock.Call
+}
+
+// FindExamVersionSummariesByExamIdOrderByVersionDesc is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examId string
+func (_e *MockDatabaseRepository_Expecter) FindExamVersionSummariesByExamIdOrderByVersionDesc(ctx interface{}, examId interface{}) *MockDatabaseRepository_FindExamVersionSummariesByExamIdOrderByVersionDesc_Call {
+	return &MockDatabaseRepository_FindExamVersionSummariesByExamIdOrderByVersionDesc_Call{Call: _e.mock.On("FindExamVersionSummariesByExamIdOrderByVersionDesc", ctx, examId)}
+}
+
+func (_c *MockDatabaseRepository_FindExamVersionSummariesByExamIdOrderByVersionDesc_Call) Run(run func(ctx context.Context, examId string)) *MockDatabaseRepository_FindExamVersionSummariesByExamIdOrderByVersionDesc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamVersionSummariesByExamIdOrderByVersionDesc_Call) Return(examVersionSummaries []ExamVersionSummary, err error) *MockDatabaseRepository_FindExamVersionSummariesByExamIdOrderByVersionDesc_Call {
+	_c.Call.Return(examVersionSummaries, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamVersionSummariesByExamIdOrderByVersionDesc_Call) RunAndReturn(run func(context.Context, string) ([]ExamVersionSummary, error)) *MockDatabaseRepository_FindExamVersionSummariesByExamIdOrderByVersionDesc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExamsByTagsOrderByUpdateAtDesc provides a mock function with given fields: ctx, tags, userId, skip, limit
+func (_m *MockDatabaseRepository) FindExamsByTagsOrderByUpdateAtDesc(ctx context.Context, tags []string, userId string, skip int32, limit int32) ([]model.Exam, error) {
+	ret := _m.Called(ctx, tags, userId, skip, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExamsByTagsOrderByUpdateAtDesc")
+	}
+
+	var r0 []model.Exam
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, int32, int32) ([]model.Exam, error)); ok {
+		return rf(ctx, tags, userId, skip, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, int32, int32) []model.Exam); ok {
+		r0 = rf(ctx, tags, userId, skip, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Exam)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string, int32, int32) error); ok {
+		r1 = rf(ctx, tags, userId, skip, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindExamsByTagsOrderByUpdateAtDesc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamsByTagsOrderByUpdateAtDesc'
+type MockDatabaseRepository_FindExamsByTagsOrderByUpdateAtDesc_Call struct {
+	*mock.Call
+}
+
+// FindExamsByTagsOrderByUpdateAtDesc is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tags []string
+//   - userId string
+//   - skip int32
+//   - limit int32
+func (_e *MockDatabaseRepository_Expecter) FindExamsByTagsOrderByUpdateAtDesc(ctx interface{}, tags interface{}, userId interface{}, skip interface{}, limit interface{}) *MockDatabaseRepository_FindExamsByTagsOrderByUpdateAtDesc_Call {
+	return &MockDatabaseRepository_FindExamsByTagsOrderByUpdateAtDesc_Call{Call: _e.mock.On("FindExamsByTagsOrderByUpdateAtDesc", ctx, tags, userId, skip, limit)}
+}
+
+func (_c *MockDatabaseRepository_FindExamsByTagsOrderByUpdateAtDesc_Call) Run(run func(ctx context.Context, tags []string, userId string, skip int32, limit int32)) *MockDatabaseRepository_FindExamsByTagsOrderByUpdateAtDesc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(string), args[3].(int32), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamsByTagsOrderByUpdateAtDesc_Call) Return(exams []model.Exam, err error) *MockDatabaseRepository_FindExamsByTagsOrderByUpdateAtDesc_Call {
+	_c.Call.Return(exams, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamsByTagsOrderByUpdateAtDesc_Call) RunAndReturn(run func(context.Context, []string, string, int32, int32) ([]model.Exam, error)) *MockDatabaseRepository_FindExamsByTagsOrderByUpdateAtDesc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExamsByUserIdAndCursorOrderByUpdateAtDesc provides a mock function with given fields: ctx, userId, cursor, limit
+func (_m *MockDatabaseRepository) FindExamsByUserIdAndCursorOrderByUpdateAtDesc(ctx context.Context, userId string, cursor string, limit int32) ([]model.Exam, string, error) {
+	ret := _m.Called(ctx, userId, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExamsByUserIdAndCursorOrderByUpdateAtDesc")
+	}
+
+	var r0 []model.Exam
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) ([]model.Exam, string, error)); ok {
+		return rf(ctx, userId, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) []model.Exam); ok {
+		r0 = rf(ctx, userId, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Exam)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32) string); ok {
+		r1 = rf(ctx, userId, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, int32) error); ok {
+		r2 = rf(ctx, userId, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockDatabaseRepository_FindExamsByUserIdAndCursorOrderByUpdateAtDesc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamsByUserIdAndCursorOrderByUpdateAtDesc'
+type MockDatabaseRepository_FindExamsByUserIdAndCursorOrderByUpdateAtDesc_Call struct {
+	*mock.Call
+}
+
+// FindExamsByUserIdAndCursorOrderByUpdateAtDesc is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - cursor string
+//   - limit int32
+func (_e *MockDatabaseRepository_Expecter) FindExamsByUserIdAndCursorOrderByUpdateAtDesc(ctx interface{}, userId interface{}, cursor interface{}, limit interface{}) *MockDatabaseRepository_FindExamsByUserIdAndCursorOrderByUpdateAtDesc_Call {
+	return &MockDatabaseRepository_FindExamsByUserIdAndCursorOrderByUpdateAtDesc_Call{Call: _e.mock.On("FindExamsByUserIdAndCursorOrderByUpdateAtDesc", ctx, userId, cursor, limit)}
+}
+
+func (_c *MockDatabaseRepository_FindExamsByUserIdAndCursorOrderByUpdateAtDesc_Call) Run(run func(ctx context.Context, userId string, cursor string, limit int32)) *MockDatabaseRepository_FindExamsByUserIdAndCursorOrderByUpdateAtDesc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamsByUserIdAndCursorOrderByUpdateAtDesc_Call) Return(exams []model.Exam, nextCursor string, err error) *MockDatabaseRepository_FindExamsByUserIdAndCursorOrderByUpdateAtDesc_Call {
+	_c.Call.Return(exams, nextCursor, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamsByUserIdAndCursorOrderByUpdateAtDesc_Call) RunAndReturn(run func(context.Context, string, string, int32) ([]model.Exam, string, error)) *MockDatabaseRepository_FindExamsByUserIdAndCursorOrderByUpdateAtDesc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc provides a mock function with given fields: ctx, userId, isPublic, tags
+func (_m *MockDatabaseRepository) FindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc(ctx context.Context, userId string, isPublic bool, tags []string) ([]model.Exam, error) {
+	ret := _m.Called(ctx, userId, isPublic, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc")
+	}
+
+	var r0 []model.Exam
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, []string) ([]model.Exam, error)); ok {
+		return rf(ctx, userId, isPublic, tags)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, []string) []model.Exam); ok {
+		r0 = rf(ctx, userId, isPublic, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Exam)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool, []string) error); ok {
+		r1 = rf(ctx, userId, isPublic, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc'
+type MockDatabaseRepository_FindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc_Call struct {
+	*mock.Call
+}
+
+// FindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - isPublic bool
+//   - tags []string
+func (_e *MockDatabaseRepository_Expecter) FindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc(ctx interface{}, userId interface{}, isPublic interface{}, tags interface{}) *MockDatabaseRepository_FindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc_Call {
+	return &MockDatabaseRepository_FindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc_Call{Call: _e.mock.On("FindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc", ctx, userId, isPublic, tags)}
+}
+
+func (_c *MockDatabaseRepository_FindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc_Call) Run(run func(ctx context.Context, userId string, isPublic bool, tags []string)) *MockDatabaseRepository_FindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc_Call) Return(exams []model.Exam, err error) *MockDatabaseRepository_FindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc_Call {
+	_c.Call.Return(exams, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc_Call) RunAndReturn(run func(context.Context, string, bool, []string) ([]model.Exam, error)) *MockDatabaseRepository_FindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExamsByUserIdOrderByUpdateAtDesc provides a mock function with given fields: ctx, userId, skip, limit
+func (_m *MockDatabaseRepository) FindExamsByUserIdOrderByUpdateAtDesc(ctx context.Context, userId string, skip int32, limit int32) ([]model.Exam, error) {
+	ret := _m.Called(ctx, userId, skip, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExamsByUserIdOrderByUpdateAtDesc")
+	}
+
+	var r0 []model.Exam
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32, int32) ([]model.Exam, error)); ok {
+		return rf(ctx, userId, skip, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32, int32) []model.Exam); ok {
+		r0 = rf(ctx, userId, skip, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Exam)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32, int32) error); ok {
+		r1 = rf(ctx, userId, skip, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindExamsByUserIdOrderByUpdateAtDesc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamsByUserIdOrderByUpdateAtDesc'
+type MockDatabaseRepository_FindExamsByUserIdOrderByUpdateAtDesc_Call struct {
+	*mock.Call
+}
+
+// FindExamsByUserIdOrderByUpdateAtDesc is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - skip int32
+//   - limit int32
+func (_e *MockDatabaseRepository_Expecter) FindExamsByUserIdOrderByUpdateAtDesc(ctx interface{}, userId interface{}, skip interface{}, limit interface{}) *MockDatabaseRepository_FindExamsByUserIdOrderByUpdateAtDesc_Call {
+	return &MockDatabaseRepository_FindExamsByUserIdOrderByUpdateAtDesc_Call{Call: _e.mock.On("FindExamsByUserIdOrderByUpdateAtDesc", ctx, userId, skip, limit)}
+}
+
+func (_c *MockDatabaseRepository_FindExamsByUserIdOrderByUpdateAtDesc_Call) Run(run func(ctx context.Context, userId string, skip int32, limit int32)) *MockDatabaseRepository_FindExamsByUserIdOrderByUpdateAtDesc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamsByUserIdOrderByUpdateAtDesc_Call) Return(exams []model.Exam, err error) *MockDatabaseRepository_FindExamsByUserIdOrderByUpdateAtDesc_Call {
+	_c.Call.Return(exams, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindExamsByUserIdOrderByUpdateAtDesc_Call) RunAndReturn(run func(context.Context, string, int32, int32) ([]model.Exam, error)) *MockDatabaseRepository_FindExamsByUserIdOrderByUpdateAtDesc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn provides a mock function with given fields: ctx, examId, createdAt, excludedUserIds, limit
+func (_m *MockDatabaseRepository) FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn(ctx context.Context, examId string, createdAt time.Time, excludedUserIds []string, limit int32) ([]LeaderboardEntry, error) {
+	ret := _m.Called(ctx, examId, createdAt, excludedUserIds, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn")
+	}
+
+	var r0 []LeaderboardEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, []string, int32) ([]LeaderboardEntry, error)); ok {
+		return rf(ctx, examId, createdAt, excludedUserIds, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, []string, int32) []LeaderboardEntry); ok {
+		r0 = rf(ctx, examId, createdAt, excludedUserIds, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]LeaderboardEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, []string, int32) error); ok {
+		r1 = rf(ctx, examId, createdAt, excludedUserIds, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn'
+type MockDatabaseRepository_FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn_Call struct {
+	*mock.Call
+}
+
+// FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examId string
+//   - createdAt time.Time
+//   - excludedUserIds []string
+//   - limit int32
+func (_e *MockDatabaseRepository_Expecter) FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn(ctx interface{}, examId interface{}, createdAt interface{}, excludedUserIds interface{}, limit interface{}) *MockDatabaseRepository_FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn_Call {
+	return &MockDatabaseRepository_FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn_Call{Call: _e.mock.On("FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn", ctx, examId, createdAt, excludedUserIds, limit)}
+}
+
+func (_c *MockDatabaseRepository_FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn_Call) Run(run func(ctx context.Context, examId string, createdAt time.Time, excludedUserIds []string, limit int32)) *MockDatabaseRepository_FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].([]string), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn_Call) Return(leaderboardEntries []LeaderboardEntry, err error) *MockDatabaseRepository_FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn_Call {
+	_c.Call.Return(leaderboardEntries, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn_Call) RunAndReturn(run func(context.Context, string, time.Time, []string, int32) ([]LeaderboardEntry, error)) *MockDatabaseRepository_FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPublicExamSummaries provides a mock function with given fields: ctx, keyword, tags, sortBy, skip, limit
+func (_m *MockDatabaseRepository) FindPublicExamSummaries(ctx context.Context, keyword string, tags []string, sortBy string, skip int32, limit int32) (int32, []ExamSummary, error) {
+	ret := _m.Called(ctx, keyword, tags, sortBy, skip, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPublicExamSummaries")
+	}
+
+	var r0 int32
+	var r1 []ExamSummary
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, string, int32, int32) (int32, []ExamSummary, error)); ok {
+		return rf(ctx, keyword, tags, sortBy, skip, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, string, int32, int32) int32); ok {
+		r0 = rf(ctx, keyword, tags, sortBy, skip, limit)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, string, int32, int32) []ExamSummary); ok {
+		r1 = rf(ctx, keyword, tags, sortBy, skip, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]ExamSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, []string, string, int32, int32) error); ok {
+		r2 = rf(ctx, keyword, tags, sortBy, skip, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockDatabaseRepository_FindPublicExamSummaries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPublicExamSummaries'
+type MockDatabaseRepository_FindPublicExamSummaries_Call struct {
+	*mock.Call
+}
+
+// FindPublicExamSummaries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keyword string
+//   - tags []string
+//   - sortBy string
+//   - skip int32
+//   - limit int32
+func (_e *MockDatabaseRepository_Expecter) FindPublicExamSummaries(ctx interface{}, keyword interface{}, tags interface{}, sortBy interface{}, skip interface{}, limit interface{}) *MockDatabaseRepository_FindPublicExamSummaries_Call {
+	return &MockDatabaseRepository_FindPublicExamSummaries_Call{Call: _e.mock.On("FindPublicExamSummaries", ctx, keyword, tags, sortBy, skip, limit)}
+}
+
+func (_c *MockDatabaseRepository_FindPublicExamSummaries_Call) Run(run func(ctx context.Context, keyword string, tags []string, sortBy string, skip int32, limit int32)) *MockDatabaseRepository_FindPublicExamSummaries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string), args[3].(string), args[4].(int32), args[5].(int32))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindPublicExamSummaries_Call) Return(total int32, examSummaries []ExamSummary, err error) *MockDatabaseRepository_FindPublicExamSummaries_Call {
+	_c.Call.Return(total, examSummaries, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindPublicExamSummaries_Call) RunAndReturn(run func(context.Context, string, []string, string, int32, int32) (int32, []ExamSummary, error)) *MockDatabaseRepository_FindPublicExamSummaries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindQuestionAttemptCountsByExamId provides a mock function with given fields: ctx, examId, groupRatio
+func (_m *MockDatabaseRepository) FindQuestionAttemptCountsByExamId(ctx context.Context, examId string, groupRatio float64) ([]QuestionAttemptCount, error) {
+	ret := _m.Called(ctx, examId, groupRatio)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindQuestionAttemptCountsByExamId")
+	}
+
+	var r0 []QuestionAttemptCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64) ([]QuestionAttemptCount, error)); ok {
+		return rf(ctx, examId, groupRatio)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64) []QuestionAttemptCount); ok {
+		r0 = rf(ctx, examId, groupRatio)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]QuestionAttemptCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, float64) error); ok {
+		r1 = rf(ctx, examId, groupRatio)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindQuestionAttemptCountsByExamId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindQuestionAttemptCountsByExamId'
+type MockDatabaseRepository_FindQuestionAttemptCountsByExamId_Call struct {
+	*mock.Call
+}
+
+// FindQuestionAttemptCountsByExamId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examId string
+//   - groupRatio float64
+func (_e *MockDatabaseRepository_Expecter) FindQuestionAttemptCountsByExamId(ctx interface{}, examId interface{}, groupRatio interface{}) *MockDatabaseRepository_FindQuestionAttemptCountsByExamId_Call {
+	return &MockDatabaseRepository_FindQuestionAttemptCountsByExamId_Call{Call: _e.mock.On("FindQuestionAttemptCountsByExamId", ctx, examId, groupRatio)}
+}
+
+func (_c *MockDatabaseRepository_FindQuestionAttemptCountsByExamId_Call) Run(run func(ctx context.Context, examId string, groupRatio float64)) *MockDatabaseRepository_FindQuestionAttemptCountsByExamId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindQuestionAttemptCountsByExamId_Call) Return(questionAttemptCounts []QuestionAttemptCount, err error) *MockDatabaseRepository_FindQuestionAttemptCountsByExamId_Call {
+	_c.Call.Return(questionAttemptCounts, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindQuestionAttemptCountsByExamId_Call) RunAndReturn(run func(context.Context, string, float64) ([]QuestionAttemptCount, error)) *MockDatabaseRepository_FindQuestionAttemptCountsByExamId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindQuestionsByExamId provides a mock function with given fields: ctx, examId
+func (_m *MockDatabaseRepository) FindQuestionsByExamId(ctx context.Context, examId string) ([]model.Question, error) {
+	ret := _m.Called(ctx, examId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindQuestionsByExamId")
+	}
+
+	var r0 []model.Question
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]model.Question, error)); ok {
+		return rf(ctx, examId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.Question); ok {
+		r0 = rf(ctx, examId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Question)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, examId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindQuestionsByExamId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindQuestionsByExamId'
+type MockDatabaseRepository_FindQuestionsByExamId_Call struct {
+	*mock.Call
+}
+
+// FindQuestionsByExamId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examId string
+func (_e *MockDatabaseRepository_Expecter) FindQuestionsByExamId(ctx interface{}, examId interface{}) *MockDatabaseRepository_FindQuestionsByExamId_Call {
+	return &MockDatabaseRepository_FindQuestionsByExamId_Call{Call: _e.mock.On("FindQuestionsByExamId", ctx, examId)}
+}
+
+func (_c *MockDatabaseRepository_FindQuestionsByExamId_Call) Run(run func(ctx context.Context, examId string)) *MockDatabaseRepository_FindQuestionsByExamId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindQuestionsByExamId_Call) Return(questions []model.Question, err error) *MockDatabaseRepository_FindQuestionsByExamId_Call {
+	_c.Call.Return(questions, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindQuestionsByExamId_Call) RunAndReturn(run func(context.Context, string) ([]model.Question, error)) *MockDatabaseRepository_FindQuestionsByExamId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc provides a mock function with given fields: ctx, examId, cursor, limit
+func (_m *MockDatabaseRepository) FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc(ctx context.Context, examId string, cursor string, limit int32) ([]model.Question, string, error) {
+	ret := _m.Called(ctx, examId, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc")
+	}
+
+	var r0 []model.Question
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) ([]model.Question, string, error)); ok {
+		return rf(ctx, examId, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) []model.Question); ok {
+		r0 = rf(ctx, examId, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Question)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32) string); ok {
+		r1 = rf(ctx, examId, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, int32) error); ok {
+		r2 = rf(ctx, examId, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockDatabaseRepository_FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc'
+type MockDatabaseRepository_FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc_Call struct {
+	*mock.Call
+}
+
+// FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examId string
+//   - cursor string
+//   - limit int32
+func (_e *MockDatabaseRepository_Expecter) FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc(ctx interface{}, examId interface{}, cursor interface{}, limit interface{}) *MockDatabaseRepository_FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc_Call {
+	return &MockDatabaseRepository_FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc_Call{Call: _e.mock.On("FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc", ctx, examId, cursor, limit)}
+}
+
+func (_c *MockDatabaseRepository_FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc_Call) Run(run func(ctx context.Context, examId string, cursor string, limit int32)) *MockDatabaseRepository_FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc_Call) Return(questions []model.Question, nextCursor string, err error) *MockDatabaseRepository_FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc_Call {
+	_c.Call.Return(questions, nextCursor, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc_Call) RunAndReturn(run func(context.Context, string, string, int32) ([]model.Question, string, error)) *MockDatabaseRepository_FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindQuestionsByExamIdOrderByUpdateAtDesc provides a mock function with given fields: ctx, examId, skip, limit
+func (_m *MockDatabaseRepository) FindQuestionsByExamIdOrderByUpdateAtDesc(ctx context.Context, examId string, skip int32, limit int32) ([]model.Question, error) {
+	ret := _m.Called(ctx, examId, skip, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindQuestionsByExamIdOrderByUpdateAtDesc")
+	}
+
+	var r0 []model.Question
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32, int32) ([]model.Question, error)); ok {
+		return rf(ctx, examId, skip, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32, int32) []model.Question); ok {
+		r0 = rf(ctx, examId, skip, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Question)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32, int32) error); ok {
+		r1 = rf(ctx, examId, skip, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindQuestionsByExamIdOrderByUpdateAtDesc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindQuestionsByExamIdOrderByUpdateAtDesc'
+type MockDatabaseRepository_FindQuestionsByExamIdOrderByUpdateAtDesc_Call struct {
+	*mock.Call
+}
+
+// FindQuestionsByExamIdOrderByUpdateAtDesc is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examId string
+//   - skip int32
+//   - limit int32
+func (_e *MockDatabaseRepository_Expecter) FindQuestionsByExamIdOrderByUpdateAtDesc(ctx interface{}, examId interface{}, skip interface{}, limit interface{}) *MockDatabaseRepository_FindQuestionsByExamIdOrderByUpdateAtDesc_Call {
+	return &MockDatabaseRepository_FindQuestionsByExamIdOrderByUpdateAtDesc_Call{Call: _e.mock.On("FindQuestionsByExamIdOrderByUpdateAtDesc", ctx, examId, skip, limit)}
+}
+
+func (_c *MockDatabaseRepository_FindQuestionsByExamIdOrderByUpdateAtDesc_Call) Run(run func(ctx context.Context, examId string, skip int32, limit int32)) *MockDatabaseRepository_FindQuestionsByExamIdOrderByUpdateAtDesc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindQuestionsByExamIdOrderByUpdateAtDesc_Call) Return(questions []model.Question, err error) *MockDatabaseRepository_FindQuestionsByExamIdOrderByUpdateAtDesc_Call {
+	_c.Call.Return(questions, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindQuestionsByExamIdOrderByUpdateAtDesc_Call) RunAndReturn(run func(context.Context, string, int32, int32) ([]model.Question, error)) *MockDatabaseRepository_FindQuestionsByExamIdOrderByUpdateAtDesc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindQuestionsByQuestionIds provides a mock function with given fields: ctx, questionIds
+func (_m *MockDatabaseRepository) FindQuestionsByQuestionIds(ctx context.Context, questionIds []string) ([]model.Question, error) {
+	ret := _m.Called(ctx, questionIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindQuestionsByQuestionIds")
+	}
+
+	var r0 []model.Question
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]model.Question, error)); ok {
+		return rf(ctx, questionIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []model.Question); ok {
+		r0 = rf(ctx, questionIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Question)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, questionIds)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -327,56 +3700,58 @@ func (_m *MockDatabaseRepository) CreateExamRecord(ctx context.Context, examReco
 	return r0, r1
 }
 
-// MockDatabaseRepository_CreateExamRecord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExamRecord'
-type MockDatabaseRepository_CreateExamRecord_Call struct {
+// MockDatabaseRepository_FindQuestionsByQuestionIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindQuestionsByQuestionIds'
+type MockDatabaseRepository_FindQuestionsByQuestionIds_Call struct {
 	*mock.Call
 }
 
-// CreateExamRecord is a helper method to define mock.On call
+// FindQuestionsByQuestionIds is a helper method to define mock.On call
 //   - ctx context.Context
-//   - examRecord model.ExamRecord
-func (_e *MockDatabaseRepository_Expecter) CreateExamRecord(ctx interface{}, examRecord interface{}) *MockDatabaseRepository_CreateExamRecord_Call {
-	return &MockDatabaseRepository_CreateExamRecord_Call{Call: _e.mock.On("CreateExamRecord", ctx, examRecord)}
+//   - questionIds []string
+func (_e *MockDatabaseRepository_Expecter) FindQuestionsByQuestionIds(ctx interface{}, questionIds interface{}) *MockDatabaseRepository_FindQuestionsByQuestionIds_Call {
+	return &MockDatabaseRepository_FindQuestionsByQuestionIds_Call{Call: _e.mock.On("FindQuestionsByQuestionIds", ctx, questionIds)}
 }
 
-func (_c *MockDatabaseRepository_CreateExamRecord_Call) Run(run func(ctx context.Context, examRecord model.ExamRecord)) *MockDatabaseRepository_CreateExamRecord_Call {
+func (_c *MockDatabaseRepository_FindQuestionsByQuestionIds_Call) Run(run func(ctx context.Context, questionIds []string)) *MockDatabaseRepository_FindQuestionsByQuestionIds_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(model.ExamRecord))
+		run(args[0].(context.Context), args[1].([]string))
 	})
 	return _c
 }
 
-func (_c *MockDatabaseRepository_CreateExamRecord_Call) Return(examRecordId string, err error) *MockDatabaseRepository_CreateExamRecord_Call {
-	_c.Call.Return(examRecordId, err)
+func (_c *MockDatabaseRepository_FindQuestionsByQuestionIds_Call) Return(questions []model.Question, err error) *MockDatabaseRepository_FindQuestionsByQuestionIds_Call {
+	_c.Call.Return(questions, err)
 	return _c
 }
 
-func (_c *MockDatabaseRepository_CreateExamRecord_Call) RunAndReturn(run func(context.Context, model.ExamRecord) (string, error)) *MockDatabaseRepository_CreateExamRecord_Call {
+func (_c *MockDatabaseRepository_FindQuestionsByQuestionIds_Call) RunAndReturn(run func(context.Context, []string) ([]model.Question, error)) *MockDatabaseRepository_FindQuestionsByQuestionIds_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateQuestion provides a mock function with given fields: ctx, question
-func (_m *MockDatabaseRepository) CreateQuestion(ctx context.Context, question model.Question) (string, error) {
-	ret := _m.Called(ctx, question)
+// FindRandomAnswerWrongsByUserIdAndExamId provides a mock function with given fields: ctx, userId, examId, size
+func (_m *MockDatabaseRepository) FindRandomAnswerWrongsByUserIdAndExamId(ctx context.Context, userId string, examId string, size int32) ([]model.AnswerWrong, error) {
+	ret := _m.Called(ctx, userId, examId, size)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateQuestion")
+		panic("no return value specified for FindRandomAnswerWrongsByUserIdAndExamId")
 	}
 
-	var r0 string
+	var r0 []model.AnswerWrong
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.Question) (string, error)); ok {
-		return rf(ctx, question)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) ([]model.AnswerWrong, error)); ok {
+		return rf(ctx, userId, examId, size)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, model.Question) string); ok {
-		r0 = rf(ctx, question)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) []model.AnswerWrong); ok {
+		r0 = rf(ctx, userId, examId, size)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.AnswerWrong)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, model.Question) error); ok {
-		r1 = rf(ctx, question)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32) error); ok {
+		r1 = rf(ctx, userId, examId, size)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -384,56 +3759,60 @@ func (_m *MockDatabaseRepository) CreateQuestion(ctx context.Context, question m
 	return r0, r1
 }
 
-// MockDatabaseRepository_CreateQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateQuestion'
-type MockDatabaseRepository_CreateQuestion_Call struct {
+// MockDatabaseRepository_FindRandomAnswerWrongsByUserIdAndExamId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRandomAnswerWrongsByUserIdAndExamId'
+type MockDatabaseRepository_FindRandomAnswerWrongsByUserIdAndExamId_Call struct {
 	*mock.Call
 }
 
-// CreateQuestion is a helper method to define mock.On call
+// FindRandomAnswerWrongsByUserIdAndExamId is a helper method to define mock.On call
 //   - ctx context.Context
-//   - question model.Question
-func (_e *MockDatabaseRepository_Expecter) CreateQuestion(ctx interface{}, question interface{}) *MockDatabaseRepository_CreateQuestion_Call {
-	return &MockDatabaseRepository_CreateQuestion_Call{Call: _e.mock.On("CreateQuestion", ctx, question)}
+//   - userId string
+//   - examId string
+//   - size int32
+func (_e *MockDatabaseRepository_Expecter) FindRandomAnswerWrongsByUserIdAndExamId(ctx interface{}, userId interface{}, examId interface{}, size interface{}) *MockDatabaseRepository_FindRandomAnswerWrongsByUserIdAndExamId_Call {
+	return &MockDatabaseRepository_FindRandomAnswerWrongsByUserIdAndExamId_Call{Call: _e.mock.On("FindRandomAnswerWrongsByUserIdAndExamId", ctx, userId, examId, size)}
 }
 
-func (_c *MockDatabaseRepository_CreateQuestion_Call) Run(run func(ctx context.Context, question model.Question)) *MockDatabaseRepository_CreateQuestion_Call {
+func (_c *MockDatabaseRepository_FindRandomAnswerWrongsByUserIdAndExamId_Call) Run(run func(ctx context.Context, userId string, examId string, size int32)) *MockDatabaseRepository_FindRandomAnswerWrongsByUserIdAndExamId_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(model.Question))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int32))
 	})
 	return _c
 }
 
-func (_c *MockDatabaseRepository_CreateQuestion_Call) Return(questionId string, err error) *MockDatabaseRepository_CreateQuestion_Call {
-	_c.Call.Return(questionId, err)
+func (_c *MockDatabaseRepository_FindRandomAnswerWrongsByUserIdAndExamId_Call) Return(answerWrongs []model.AnswerWrong, err error) *MockDatabaseRepository_FindRandomAnswerWrongsByUserIdAndExamId_Call {
+	_c.Call.Return(answerWrongs, err)
 	return _c
 }
 
-func (_c *MockDatabaseRepository_CreateQuestion_Call) RunAndReturn(run func(context.Context, model.Question) (string, error)) *MockDatabaseRepository_CreateQuestion_Call {
+func (_c *MockDatabaseRepository_FindRandomAnswerWrongsByUserIdAndExamId_Call) RunAndReturn(run func(context.Context, string, string, int32) ([]model.AnswerWrong, error)) *MockDatabaseRepository_FindRandomAnswerWrongsByUserIdAndExamId_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteAnswerWrongsByExamId provides a mock function with given fields: ctx, examId
-func (_m *MockDatabaseRepository) DeleteAnswerWrongsByExamId(ctx context.Context, examId string) (int32, error) {
-	ret := _m.Called(ctx, examId)
+// FindRandomBankQuestionsByUserIdAndTag provides a mock function with given fields: ctx, userId, tag, size
+func (_m *MockDatabaseRepository) FindRandomBankQuestionsByUserIdAndTag(ctx context.Context, userId string, tag string, size int32) ([]model.BankQuestion, error) {
+	ret := _m.Called(ctx, userId, tag, size)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteAnswerWrongsByExamId")
+		panic("no return value specified for FindRandomBankQuestionsByUserIdAndTag")
 	}
 
-	var r0 int32
+	var r0 []model.BankQuestion
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
-		return rf(ctx, examId)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) ([]model.BankQuestion, error)); ok {
+		return rf(ctx, userId, tag, size)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
-		r0 = rf(ctx, examId)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) []model.BankQuestion); ok {
+		r0 = rf(ctx, userId, tag, size)
 	} else {
-		r0 = ret.Get(0).(int32)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.BankQuestion)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, examId)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32) error); ok {
+		r1 = rf(ctx, userId, tag, size)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -441,56 +3820,60 @@ func (_m *MockDatabaseRepository) DeleteAnswerWrongsByExamId(ctx context.Context
 	return r0, r1
 }
 
-// MockDatabaseRepository_DeleteAnswerWrongsByExamId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAnswerWrongsByExamId'
-type MockDatabaseRepository_DeleteAnswerWrongsByExamId_Call struct {
+// MockDatabaseRepository_FindRandomBankQuestionsByUserIdAndTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRandomBankQuestionsByUserIdAndTag'
+type MockDatabaseRepository_FindRandomBankQuestionsByUserIdAndTag_Call struct {
 	*mock.Call
 }
 
-// DeleteAnswerWrongsByExamId is a helper method to define mock.On call
+// FindRandomBankQuestionsByUserIdAndTag is a helper method to define mock.On call
 //   - ctx context.Context
-//   - examId string
-func (_e *MockDatabaseRepository_Expecter) DeleteAnswerWrongsByExamId(ctx interface{}, examId interface{}) *MockDatabaseRepository_DeleteAnswerWrongsByExamId_Call {
-	return &MockDatabaseRepository_DeleteAnswerWrongsByExamId_Call{Call: _e.mock.On("DeleteAnswerWrongsByExamId", ctx, examId)}
+//   - userId string
+//   - tag string
+//   - size int32
+func (_e *MockDatabaseRepository_Expecter) FindRandomBankQuestionsByUserIdAndTag(ctx interface{}, userId interface{}, tag interface{}, size interface{}) *MockDatabaseRepository_FindRandomBankQuestionsByUserIdAndTag_Call {
+	return &MockDatabaseRepository_FindRandomBankQuestionsByUserIdAndTag_Call{Call: _e.mock.On("FindRandomBankQuestionsByUserIdAndTag", ctx, userId, tag, size)}
 }
 
-func (_c *MockDatabaseRepository_DeleteAnswerWrongsByExamId_Call) Run(run func(ctx context.Context, examId string)) *MockDatabaseRepository_DeleteAnswerWrongsByExamId_Call {
+func (_c *MockDatabaseRepository_FindRandomBankQuestionsByUserIdAndTag_Call) Run(run func(ctx context.Context, userId string, tag string, size int32)) *MockDatabaseRepository_FindRandomBankQuestionsByUserIdAndTag_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int32))
 	})
 	return _c
 }
 
-func (_c *MockDatabaseRepository_DeleteAnswerWrongsByExamId_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteAnswerWrongsByExamId_Call {
-	_c.Call.Return(deletedCount, err)
+func (_c *MockDatabaseRepository_FindRandomBankQuestionsByUserIdAndTag_Call) Return(bankQuestions []model.BankQuestion, err error) *MockDatabaseRepository_FindRandomBankQuestionsByUserIdAndTag_Call {
+	_c.Call.Return(bankQuestions, err)
 	return _c
 }
 
-func (_c *MockDatabaseRepository_DeleteAnswerWrongsByExamId_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_DeleteAnswerWrongsByExamId_Call {
+func (_c *MockDatabaseRepository_FindRandomBankQuestionsByUserIdAndTag_Call) RunAndReturn(run func(context.Context, string, string, int32) ([]model.BankQuestion, error)) *MockDatabaseRepository_FindRandomBankQuestionsByUserIdAndTag_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteAnswerWrongsByQuestionId provides a mock function with given fields: ctx, questionId
-func (_m *MockDatabaseRepository) DeleteAnswerWrongsByQuestionId(ctx context.Context, questionId string) (int32, error) {
-	ret := _m.Called(ctx, questionId)
+// GetAnswerWrongById provides a mock function with given fields: ctx, answerWrongId
+func (_m *MockDatabaseRepository) GetAnswerWrongById(ctx context.Context, answerWrongId string) (*model.AnswerWrong, error) {
+	ret := _m.Called(ctx, answerWrongId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteAnswerWrongsByQuestionId")
+		panic("no return value specified for GetAnswerWrongById")
 	}
 
-	var r0 int32
+	var r0 *model.AnswerWrong
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
-		return rf(ctx, questionId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.AnswerWrong, error)); ok {
+		return rf(ctx, answerWrongId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
-		r0 = rf(ctx, questionId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.AnswerWrong); ok {
+		r0 = rf(ctx, answerWrongId)
 	} else {
-		r0 = ret.Get(0).(int32)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AnswerWrong)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, questionId)
+		r1 = rf(ctx, answerWrongId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -498,56 +3881,58 @@ func (_m *MockDatabaseRepository) DeleteAnswerWrongsByQuestionId(ctx context.Con
 	return r0, r1
 }
 
-// MockDatabaseRepository_DeleteAnswerWrongsByQuestionId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAnswerWrongsByQuestionId'
-type MockDatabaseRepository_DeleteAnswerWrongsByQuestionId_Call struct {
+// MockDatabaseRepository_GetAnswerWrongById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnswerWrongById'
+type MockDatabaseRepository_GetAnswerWrongById_Call struct {
 	*mock.Call
 }
 
-// DeleteAnswerWrongsByQuestionId is a helper method to define mock.On call
+// GetAnswerWrongById is a helper method to define mock.On call
 //   - ctx context.Context
-//   - questionId string
-func (_e *MockDatabaseRepository_Expecter) DeleteAnswerWrongsByQuestionId(ctx interface{}, questionId interface{}) *MockDatabaseRepository_DeleteAnswerWrongsByQuestionId_Call {
-	return &MockDatabaseRepository_DeleteAnswerWrongsByQuestionId_Call{Call: _e.mock.On("DeleteAnswerWrongsByQuestionId", ctx, questionId)}
+//   - answerWrongId string
+func (_e *MockDatabaseRepository_Expecter) GetAnswerWrongById(ctx interface{}, answerWrongId interface{}) *MockDatabaseRepository_GetAnswerWrongById_Call {
+	return &MockDatabaseRepository_GetAnswerWrongById_Call{Call: _e.mock.On("GetAnswerWrongById", ctx, answerWrongId)}
 }
 
-func (_c *MockDatabaseRepository_DeleteAnswerWrongsByQuestionId_Call) Run(run func(ctx context.Context, questionId string)) *MockDatabaseRepository_DeleteAnswerWrongsByQuestionId_Call {
+func (_c *MockDatabaseRepository_GetAnswerWrongById_Call) Run(run func(ctx context.Context, answerWrongId string)) *MockDatabaseRepository_GetAnswerWrongById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockDatabaseRepository_DeleteAnswerWrongsByQuestionId_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteAnswerWrongsByQuestionId_Call {
-	_c.Call.Return(deletedCount, err)
+func (_c *MockDatabaseRepository_GetAnswerWrongById_Call) Return(answerWrong *model.AnswerWrong, err error) *MockDatabaseRepository_GetAnswerWrongById_Call {
+	_c.Call.Return(answerWrong, err)
 	return _c
 }
 
-func (_c *MockDatabaseRepository_DeleteAnswerWrongsByQuestionId_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_DeleteAnswerWrongsByQuestionId_Call {
+func (_c *MockDatabaseRepository_GetAnswerWrongById_Call) RunAndReturn(run func(context.Context, string) (*model.AnswerWrong, error)) *MockDatabaseRepository_GetAnswerWrongById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteExamById provides a mock function with given fields: ctx, examId
-func (_m *MockDatabaseRepository) DeleteExamById(ctx context.Context, examId string) (int32, error) {
-	ret := _m.Called(ctx, examId)
+// GetBankQuestionById provides a mock function with given fields: ctx, bankQuestionId
+func (_m *MockDatabaseRepository) GetBankQuestionById(ctx context.Context, bankQuestionId string) (*model.BankQuestion, error) {
+	ret := _m.Called(ctx, bankQuestionId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteExamById")
+		panic("no return value specified for GetBankQuestionById")
 	}
 
-	var r0 int32
+	var r0 *model.BankQuestion
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
-		return rf(ctx, examId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.BankQuestion, error)); ok {
+		return rf(ctx, bankQuestionId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
-		r0 = rf(ctx, examId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.BankQuestion); ok {
+		r0 = rf(ctx, bankQuestionId)
 	} else {
-		r0 = ret.Get(0).(int32)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BankQuestion)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, examId)
+		r1 = rf(ctx, bankQuestionId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -555,56 +3940,58 @@ func (_m *MockDatabaseRepository) DeleteExamById(ctx context.Context, examId str
 	return r0, r1
 }
 
-// MockDatabaseRepository_DeleteExamById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExamById'
-type MockDatabaseRepository_DeleteExamById_Call struct {
+// MockDatabaseRepository_GetBankQuestionById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBankQuestionById'
+type MockDatabaseRepository_GetBankQuestionById_Call struct {
 	*mock.Call
 }
 
-// DeleteExamById is a helper method to define mock.On call
+// GetBankQuestionById is a helper method to define mock.On call
 //   - ctx context.Context
-//   - examId string
-func (_e *MockDatabaseRepository_Expecter) DeleteExamById(ctx interface{}, examId interface{}) *MockDatabaseRepository_DeleteExamById_Call {
-	return &MockDatabaseRepository_DeleteExamById_Call{Call: _e.mock.On("DeleteExamById", ctx, examId)}
+//   - bankQuestionId string
+func (_e *MockDatabaseRepository_Expecter) GetBankQuestionById(ctx interface{}, bankQuestionId interface{}) *MockDatabaseRepository_GetBankQuestionById_Call {
+	return &MockDatabaseRepository_GetBankQuestionById_Call{Call: _e.mock.On("GetBankQuestionById", ctx, bankQuestionId)}
 }
 
-func (_c *MockDatabaseRepository_DeleteExamById_Call) Run(run func(ctx context.Context, examId string)) *MockDatabaseRepository_DeleteExamById_Call {
+func (_c *MockDatabaseRepository_GetBankQuestionById_Call) Run(run func(ctx context.Context, bankQuestionId string)) *MockDatabaseRepository_GetBankQuestionById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockDatabaseRepository_DeleteExamById_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteExamById_Call {
-	_c.Call.Return(deletedCount, err)
+func (_c *MockDatabaseRepository_GetBankQuestionById_Call) Return(bankQuestion *model.BankQuestion, err error) *MockDatabaseRepository_GetBankQuestionById_Call {
+	_c.Call.Return(bankQuestion, err)
 	return _c
 }
 
-func (_c *MockDatabaseRepository_DeleteExamById_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_DeleteExamById_Call {
+func (_c *MockDatabaseRepository_GetBankQuestionById_Call) RunAndReturn(run func(context.Context, string) (*model.BankQuestion, error)) *MockDatabaseRepository_GetBankQuestionById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteExamRecordsByExamId provides a mock function with given fields: ctx, examId
-func (_m *MockDatabaseRepository) DeleteExamRecordsByExamId(ctx context.Context, examId string) (int32, error) {
-	ret := _m.Called(ctx, examId)
+// GetClassById provides a mock function with given fields: ctx, classId
+func (_m *MockDatabaseRepository) GetClassById(ctx context.Context, classId string) (*model.Class, error) {
+	ret := _m.Called(ctx, classId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteExamRecordsByExamId")
+		panic("no return value specified for GetClassById")
 	}
 
-	var r0 int32
+	var r0 *model.Class
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
-		return rf(ctx, examId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Class, error)); ok {
+		return rf(ctx, classId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
-		r0 = rf(ctx, examId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Class); ok {
+		r0 = rf(ctx, classId)
 	} else {
-		r0 = ret.Get(0).(int32)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Class)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, examId)
+		r1 = rf(ctx, classId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -612,56 +3999,58 @@ func (_m *MockDatabaseRepository) DeleteExamRecordsByExamId(ctx context.Context,
 	return r0, r1
 }
 
-// MockDatabaseRepository_DeleteExamRecordsByExamId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExamRecordsByExamId'
-type MockDatabaseRepository_DeleteExamRecordsByExamId_Call struct {
+// MockDatabaseRepository_GetClassById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClassById'
+type MockDatabaseRepository_GetClassById_Call struct {
 	*mock.Call
 }
 
-// DeleteExamRecordsByExamId is a helper method to define mock.On call
+// GetClassById is a helper method to define mock.On call
 //   - ctx context.Context
-//   - examId string
-func (_e *MockDatabaseRepository_Expecter) DeleteExamRecordsByExamId(ctx interface{}, examId interface{}) *MockDatabaseRepository_DeleteExamRecordsByExamId_Call {
-	return &MockDatabaseRepository_DeleteExamRecordsByExamId_Call{Call: _e.mock.On("DeleteExamRecordsByExamId", ctx, examId)}
+//   - classId string
+func (_e *MockDatabaseRepository_Expecter) GetClassById(ctx interface{}, classId interface{}) *MockDatabaseRepository_GetClassById_Call {
+	return &MockDatabaseRepository_GetClassById_Call{Call: _e.mock.On("GetClassById", ctx, classId)}
 }
 
-func (_c *MockDatabaseRepository_DeleteExamRecordsByExamId_Call) Run(run func(ctx context.Context, examId string)) *MockDatabaseRepository_DeleteExamRecordsByExamId_Call {
+func (_c *MockDatabaseRepository_GetClassById_Call) Run(run func(ctx context.Context, classId string)) *MockDatabaseRepository_GetClassById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockDatabaseRepository_DeleteExamRecordsByExamId_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteExamRecordsByExamId_Call {
-	_c.Call.Return(deletedCount, err)
+func (_c *MockDatabaseRepository_GetClassById_Call) Return(class *model.Class, err error) *MockDatabaseRepository_GetClassById_Call {
+	_c.Call.Return(class, err)
 	return _c
 }
 
-func (_c *MockDatabaseRepository_DeleteExamRecordsByExamId_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_DeleteExamRecordsByExamId_Call {
+func (_c *MockDatabaseRepository_GetClassById_Call) RunAndReturn(run func(context.Context, string) (*model.Class, error)) *MockDatabaseRepository_GetClassById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteQuestionById provides a mock function with given fields: ctx, questionId
-func (_m *MockDatabaseRepository) DeleteQuestionById(ctx context.Context, questionId string) (int32, error) {
-	ret := _m.Called(ctx, questionId)
+// GetClassByJoinCode provides a mock function with given fields: ctx, joinCode
+func (_m *MockDatabaseRepository) GetClassByJoinCode(ctx context.Context, joinCode string) (*model.Class, error) {
+	ret := _m.Called(ctx, joinCode)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteQuestionById")
+		panic("no return value specified for GetClassByJoinCode")
 	}
 
-	var r0 int32
+	var r0 *model.Class
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
-		return rf(ctx, questionId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Class, error)); ok {
+		return rf(ctx, joinCode)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
-		r0 = rf(ctx, questionId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Class); ok {
+		r0 = rf(ctx, joinCode)
 	} else {
-		r0 = ret.Get(0).(int32)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Class)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, questionId)
+		r1 = rf(ctx, joinCode)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -669,56 +4058,58 @@ func (_m *MockDatabaseRepository) DeleteQuestionById(ctx context.Context, questi
 	return r0, r1
 }
 
-// MockDatabaseRepository_DeleteQuestionById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQuestionById'
-type MockDatabaseRepository_DeleteQuestionById_Call struct {
+// MockDatabaseRepository_GetClassByJoinCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClassByJoinCode'
+type MockDatabaseRepository_GetClassByJoinCode_Call struct {
 	*mock.Call
 }
 
-// DeleteQuestionById is a helper method to define mock.On call
+// GetClassByJoinCode is a helper method to define mock.On call
 //   - ctx context.Context
-//   - questionId string
-func (_e *MockDatabaseRepository_Expecter) DeleteQuestionById(ctx interface{}, questionId interface{}) *MockDatabaseRepository_DeleteQuestionById_Call {
-	return &MockDatabaseRepository_DeleteQuestionById_Call{Call: _e.mock.On("DeleteQuestionById", ctx, questionId)}
+//   - joinCode string
+func (_e *MockDatabaseRepository_Expecter) GetClassByJoinCode(ctx interface{}, joinCode interface{}) *MockDatabaseRepository_GetClassByJoinCode_Call {
+	return &MockDatabaseRepository_GetClassByJoinCode_Call{Call: _e.mock.On("GetClassByJoinCode", ctx, joinCode)}
 }
 
-func (_c *MockDatabaseRepository_DeleteQuestionById_Call) Run(run func(ctx context.Context, questionId string)) *MockDatabaseRepository_DeleteQuestionById_Call {
+func (_c *MockDatabaseRepository_GetClassByJoinCode_Call) Run(run func(ctx context.Context, joinCode string)) *MockDatabaseRepository_GetClassByJoinCode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockDatabaseRepository_DeleteQuestionById_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteQuestionById_Call {
-	_c.Call.Return(deletedCount, err)
+func (_c *MockDatabaseRepository_GetClassByJoinCode_Call) Return(class *model.Class, err error) *MockDatabaseRepository_GetClassByJoinCode_Call {
+	_c.Call.Return(class, err)
 	return _c
 }
 
-func (_c *MockDatabaseRepository_DeleteQuestionById_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_DeleteQuestionById_Call {
+func (_c *MockDatabaseRepository_GetClassByJoinCode_Call) RunAndReturn(run func(context.Context, string) (*model.Class, error)) *MockDatabaseRepository_GetClassByJoinCode_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteQuestionsByExamId provides a mock function with given fields: ctx, examId
-func (_m *MockDatabaseRepository) DeleteQuestionsByExamId(ctx context.Context, examId string) (int32, error) {
-	ret := _m.Called(ctx, examId)
+// GetExamAttemptById provides a mock function with given fields: ctx, examAttemptId
+func (_m *MockDatabaseRepository) GetExamAttemptById(ctx context.Context, examAttemptId string) (*model.ExamAttempt, error) {
+	ret := _m.Called(ctx, examAttemptId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteQuestionsByExamId")
+		panic("no return value specified for GetExamAttemptById")
 	}
 
-	var r0 int32
+	var r0 *model.ExamAttempt
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
-		return rf(ctx, examId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.ExamAttempt, error)); ok {
+		return rf(ctx, examAttemptId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
-		r0 = rf(ctx, examId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.ExamAttempt); ok {
+		r0 = rf(ctx, examAttemptId)
 	} else {
-		r0 = ret.Get(0).(int32)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ExamAttempt)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, examId)
+		r1 = rf(ctx, examAttemptId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -726,104 +4117,117 @@ func (_m *MockDatabaseRepository) DeleteQuestionsByExamId(ctx context.Context, e
 	return r0, r1
 }
 
-// MockDatabaseRepository_DeleteQuestionsByExamId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQuestionsByExamId'
-type MockDatabaseRepository_DeleteQuestionsByExamId_Call struct {
+// MockDatabaseRepository_GetExamAttemptById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExamAttemptById'
+type MockDatabaseRepository_GetExamAttemptById_Call struct {
 	*mock.Call
 }
 
-// DeleteQuestionsByExamId is a helper method to define mock.On call
+// GetExamAttemptById is a helper method to define mock.On call
 //   - ctx context.Context
-//   - examId string
-func (_e *MockDatabaseRepository_Expecter) DeleteQuestionsByExamId(ctx interface{}, examId interface{}) *MockDatabaseRepository_DeleteQuestionsByExamId_Call {
-	return &MockDatabaseRepository_DeleteQuestionsByExamId_Call{Call: _e.mock.On("DeleteQuestionsByExamId", ctx, examId)}
+//   - examAttemptId string
+func (_e *MockDatabaseRepository_Expecter) GetExamAttemptById(ctx interface{}, examAttemptId interface{}) *MockDatabaseRepository_GetExamAttemptById_Call {
+	return &MockDatabaseRepository_GetExamAttemptById_Call{Call: _e.mock.On("GetExamAttemptById", ctx, examAttemptId)}
 }
 
-func (_c *MockDatabaseRepository_DeleteQuestionsByExamId_Call) Run(run func(ctx context.Context, examId string)) *MockDatabaseRepository_DeleteQuestionsByExamId_Call {
+func (_c *MockDatabaseRepository_GetExamAttemptById_Call) Run(run func(ctx context.Context, examAttemptId string)) *MockDatabaseRepository_GetExamAttemptById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockDatabaseRepository_DeleteQuestionsByExamId_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteQuestionsByExamId_Call {
-	_c.Call.Return(deletedCount, err)
+func (_c *MockDatabaseRepository_GetExamAttemptById_Call) Return(examAttempt *model.ExamAttempt, err error) *MockDatabaseRepository_GetExamAttemptById_Call {
+	_c.Call.Return(examAttempt, err)
 	return _c
 }
 
-func (_c *MockDatabaseRepository_DeleteQuestionsByExamId_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_DeleteQuestionsByExamId_Call {
+func (_c *MockDatabaseRepository_GetExamAttemptById_Call) RunAndReturn(run func(context.Context, string) (*model.ExamAttempt, error)) *MockDatabaseRepository_GetExamAttemptById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DisconnectDB provides a mock function with given fields: ctx
-func (_m *MockDatabaseRepository) DisconnectDB(ctx context.Context) error {
-	ret := _m.Called(ctx)
+// GetExamById provides a mock function with given fields: ctx, examId
+func (_m *MockDatabaseRepository) GetExamById(ctx context.Context, examId string) (*model.Exam, error) {
+	ret := _m.Called(ctx, examId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DisconnectDB")
+		panic("no return value specified for GetExamById")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
-		r0 = rf(ctx)
+	var r0 *model.Exam
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Exam, error)); ok {
+		return rf(ctx, examId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Exam); ok {
+		r0 = rf(ctx, examId)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Exam)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, examId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockDatabaseRepository_DisconnectDB_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisconnectDB'
-type MockDatabaseRepository_DisconnectDB_Call struct {
+// MockDatabaseRepository_GetExamById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExamById'
+type MockDatabaseRepository_GetExamById_Call struct {
 	*mock.Call
 }
 
-// DisconnectDB is a helper method to define mock.On call
+// GetExamById is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockDatabaseRepository_Expecter) DisconnectDB(ctx interface{}) *MockDatabaseRepository_DisconnectDB_Call {
-	return &MockDatabaseRepository_DisconnectDB_Call{Call: _e.mock.On("DisconnectDB", ctx)}
+//   - examId string
+func (_e *MockDatabaseRepository_Expecter) GetExamById(ctx interface{}, examId interface{}) *MockDatabaseRepository_GetExamById_Call {
+	return &MockDatabaseRepository_GetExamById_Call{Call: _e.mock.On("GetExamById", ctx, examId)}
 }
 
-func (_c *MockDatabaseRepository_DisconnectDB_Call) Run(run func(ctx context.Context)) *MockDatabaseRepository_DisconnectDB_Call {
+func (_c *MockDatabaseRepository_GetExamById_Call) Run(run func(ctx context.Context, examId string)) *MockDatabaseRepository_GetExamById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockDatabaseRepository_DisconnectDB_Call) Return(_a0 error) *MockDatabaseRepository_DisconnectDB_Call {
-	_c.Call.Return(_a0)
+func (_c *MockDatabaseRepository_GetExamById_Call) Return(exam *model.Exam, err error) *MockDatabaseRepository_GetExamById_Call {
+	_c.Call.Return(exam, err)
 	return _c
 }
 
-func (_c *MockDatabaseRepository_DisconnectDB_Call) RunAndReturn(run func(context.Context) error) *MockDatabaseRepository_DisconnectDB_Call {
+func (_c *MockDatabaseRepository_GetExamById_Call) RunAndReturn(run func(context.Context, string) (*model.Exam, error)) *MockDatabaseRepository_GetExamById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc provides a mock function with given fields: ctx, examId, userId, limit
-func (_m *MockDatabaseRepository) FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc(ctx context.Context, examId string, userId string, limit int32) ([]model.AnswerWrong, error) {
-	ret := _m.Called(ctx, examId, userId, limit)
+// GetExamRecordById provides a mock function with given fields: ctx, examRecordId
+func (_m *MockDatabaseRepository) GetExamRecordById(ctx context.Context, examRecordId string) (*model.ExamRecord, error) {
+	ret := _m.Called(ctx, examRecordId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc")
+		panic("no return value specified for GetExamRecordById")
 	}
 
-	var r0 []model.AnswerWrong
+	var r0 *model.ExamRecord
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) ([]model.AnswerWrong, error)); ok {
-		return rf(ctx, examId, userId, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.ExamRecord, error)); ok {
+		return rf(ctx, examRecordId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32) []model.AnswerWrong); ok {
-		r0 = rf(ctx, examId, userId, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.ExamRecord); ok {
+		r0 = rf(ctx, examRecordId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.AnswerWrong)
+			r0 = ret.Get(0).(*model.ExamRecord)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32) error); ok {
-		r1 = rf(ctx, examId, userId, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, examRecordId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -831,60 +4235,58 @@ func (_m *MockDatabaseRepository) FindAnswerWrongsByExamIdAndUserIdOrderByTimesD
 	return r0, r1
 }
 
-// MockDatabaseRepository_FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc'
-type MockDatabaseRepository_FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc_Call struct {
+// MockDatabaseRepository_GetExamRecordById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExamRecordById'
+type MockDatabaseRepository_GetExamRecordById_Call struct {
 	*mock.Call
 }
 
-// FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc is a helper method to define mock.On call
+// GetExamRecordById is a helper method to define mock.On call
 //   - ctx context.Context
-//   - examId string
-//   - userId string
-//   - limit int32
-func (_e *MockDatabaseRepository_Expecter) FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc(ctx interface{}, examId interface{}, userId interface{}, limit interface{}) *MockDatabaseRepository_FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc_Call {
-	return &MockDatabaseRepository_FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc_Call{Call: _e.mock.On("FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc", ctx, examId, userId, limit)}
+//   - examRecordId string
+func (_e *MockDatabaseRepository_Expecter) GetExamRecordById(ctx interface{}, examRecordId interface{}) *MockDatabaseRepository_GetExamRecordById_Call {
+	return &MockDatabaseRepository_GetExamRecordById_Call{Call: _e.mock.On("GetExamRecordById", ctx, examRecordId)}
 }
 
-func (_c *MockDatabaseRepository_FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc_Call) Run(run func(ctx context.Context, examId string, userId string, limit int32)) *MockDatabaseRepository_FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc_Call {
+func (_c *MockDatabaseRepository_GetExamRecordById_Call) Run(run func(ctx context.Context, examRecordId string)) *MockDatabaseRepository_GetExamRecordById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int32))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockDatabaseRepository_FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc_Call) Return(answerWrongs []model.AnswerWrong, err error) *MockDatabaseRepository_FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc_Call {
-	_c.Call.Return(answerWrongs, err)
+func (_c *MockDatabaseRepository_GetExamRecordById_Call) Return(examRecord *model.ExamRecord, err error) *MockDatabaseRepository_GetExamRecordById_Call {
+	_c.Call.Return(examRecord, err)
 	return _c
 }
 
-func (_c *MockDatabaseRepository_FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc_Call) RunAndReturn(run func(context.Context, string, string, int32) ([]model.AnswerWrong, error)) *MockDatabaseRepository_FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc_Call {
+func (_c *MockDatabaseRepository_GetExamRecordById_Call) RunAndReturn(run func(context.Context, string) (*model.ExamRecord, error)) *MockDatabaseRepository_GetExamRecordById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindExamRecordsByExamIdAndUserIdAndCreatedAt provides a mock function with given fields: ctx, examId, userId, createdAt
-func (_m *MockDatabaseRepository) FindExamRecordsByExamIdAndUserIdAndCreatedAt(ctx context.Context, examId string, userId string, createdAt time.Time) ([]model.ExamRecord, error) {
-	ret := _m.Called(ctx, examId, userId, createdAt)
+// GetExamSectionById provides a mock function with given fields: ctx, sectionId
+func (_m *MockDatabaseRepository) GetExamSectionById(ctx context.Context, sectionId string) (*model.ExamSection, error) {
+	ret := _m.Called(ctx, sectionId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindExamRecordsByExamIdAndUserIdAndCreatedAt")
+		panic("no return value specified for GetExamSectionById")
 	}
 
-	var r0 []model.ExamRecord
+	var r0 *model.ExamSection
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) ([]model.ExamRecord, error)); ok {
-		return rf(ctx, examId, userId, createdAt)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.ExamSection, error)); ok {
+		return rf(ctx, sectionId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) []model.ExamRecord); ok {
-		r0 = rf(ctx, examId, userId, createdAt)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.ExamSection); ok {
+		r0 = rf(ctx, sectionId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.ExamRecord)
+			r0 = ret.Get(0).(*model.ExamSection)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
-		r1 = rf(ctx, examId, userId, createdAt)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sectionId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -892,60 +4294,58 @@ func (_m *MockDatabaseRepository) FindExamRecordsByExamIdAndUserIdAndCreatedAt(c
 	return r0, r1
 }
 
-// MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCreatedAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamRecordsByExamIdAndUserIdAndCreatedAt'
-type MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCreatedAt_Call struct {
+// MockDatabaseRepository_GetExamSectionById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExamSectionById'
+type MockDatabaseRepository_GetExamSectionById_Call struct {
 	*mock.Call
 }
 
-// FindExamRecordsByExamIdAndUserIdAndCreatedAt is a helper method to define mock.On call
+// GetExamSectionById is a helper method to define mock.On call
 //   - ctx context.Context
-//   - examId string
-//   - userId string
-//   - createdAt time.Time
-func (_e *MockDatabaseRepository_Expecter) FindExamRecordsByExamIdAndUserIdAndCreatedAt(ctx interface{}, examId interface{}, userId interface{}, createdAt interface{}) *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCreatedAt_Call {
-	return &MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCreatedAt_Call{Call: _e.mock.On("FindExamRecordsByExamIdAndUserIdAndCreatedAt", ctx, examId, userId, createdAt)}
+//   - sectionId string
+func (_e *MockDatabaseRepository_Expecter) GetExamSectionById(ctx interface{}, sectionId interface{}) *MockDatabaseRepository_GetExamSectionById_Call {
+	return &MockDatabaseRepository_GetExamSectionById_Call{Call: _e.mock.On("GetExamSectionById", ctx, sectionId)}
 }
 
-func (_c *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCreatedAt_Call) Run(run func(ctx context.Context, examId string, userId string, createdAt time.Time)) *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCreatedAt_Call {
+func (_c *MockDatabaseRepository_GetExamSectionById_Call) Run(run func(ctx context.Context, sectionId string)) *MockDatabaseRepository_GetExamSectionById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCreatedAt_Call) Return(examRecords []model.ExamRecord, err error) *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCreatedAt_Call {
-	_c.Call.Return(examRecords, err)
+func (_c *MockDatabaseRepository_GetExamSectionById_Call) Return(examSection *model.ExamSection, err error) *MockDatabaseRepository_GetExamSectionById_Call {
+	_c.Call.Return(examSection, err)
 	return _c
 }
 
-func (_c *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCreatedAt_Call) RunAndReturn(run func(context.Context, string, string, time.Time) ([]model.ExamRecord, error)) *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdAndCreatedAt_Call {
+func (_c *MockDatabaseRepository_GetExamSectionById_Call) RunAndReturn(run func(context.Context, string) (*model.ExamSection, error)) *MockDatabaseRepository_GetExamSectionById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc provides a mock function with given fields: ctx, examId, userId, skip, limit
-func (_m *MockDatabaseRepository) FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc(ctx context.Context, examId string, userId string, skip int32, limit int32) ([]model.ExamRecord, error) {
-	ret := _m.Called(ctx, examId, userId, skip, limit)
+// GetExamVersionByExamIdAndVersion provides a mock function with given fields: ctx, examId, version
+func (_m *MockDatabaseRepository) GetExamVersionByExamIdAndVersion(ctx context.Context, examId string, version int32) (*model.ExamVersion, error) {
+	ret := _m.Called(ctx, examId, version)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc")
+		panic("no return value specified for GetExamVersionByExamIdAndVersion")
 	}
 
-	var r0 []model.ExamRecord
+	var r0 *model.ExamVersion
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32, int32) ([]model.ExamRecord, error)); ok {
-		return rf(ctx, examId, userId, skip, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) (*model.ExamVersion, error)); ok {
+		return rf(ctx, examId, version)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32, int32) []model.ExamRecord); ok {
-		r0 = rf(ctx, examId, userId, skip, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) *model.ExamVersion); ok {
+		r0 = rf(ctx, examId, version)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.ExamRecord)
+			r0 = ret.Get(0).(*model.ExamVersion)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32, int32) error); ok {
-		r1 = rf(ctx, examId, userId, skip, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32) error); ok {
+		r1 = rf(ctx, examId, version)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -953,61 +4353,59 @@ func (_m *MockDatabaseRepository) FindExamRecordsByExamIdAndUserIdOrderByUpdateA
 	return r0, r1
 }
 
-// MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc'
-type MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc_Call struct {
+// MockDatabaseRepository_GetExamVersionByExamIdAndVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExamVersionByExamIdAndVersion'
+type MockDatabaseRepository_GetExamVersionByExamIdAndVersion_Call struct {
 	*mock.Call
 }
 
-// FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc is a helper method to define mock.On call
+// GetExamVersionByExamIdAndVersion is a helper method to define mock.On call
 //   - ctx context.Context
 //   - examId string
-//   - userId string
-//   - skip int32
-//   - limit int32
-func (_e *MockDatabaseRepository_Expecter) FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc(ctx interface{}, examId interface{}, userId interface{}, skip interface{}, limit interface{}) *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc_Call {
-	return &MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc_Call{Call: _e.mock.On("FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc", ctx, examId, userId, skip, limit)}
+//   - version int32
+func (_e *MockDatabaseRepository_Expecter) GetExamVersionByExamIdAndVersion(ctx interface{}, examId interface{}, version interface{}) *MockDatabaseRepository_GetExamVersionByExamIdAndVersion_Call {
+	return &MockDatabaseRepository_GetExamVersionByExamIdAndVersion_Call{Call: _e.mock.On("GetExamVersionByExamIdAndVersion", ctx, examId, version)}
 }
 
-func (_c *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc_Call) Run(run func(ctx context.Context, examId string, userId string, skip int32, limit int32)) *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc_Call {
+func (_c *MockDatabaseRepository_GetExamVersionByExamIdAndVersion_Call) Run(run func(ctx context.Context, examId string, version int32)) *MockDatabaseRepository_GetExamVersionByExamIdAndVersion_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int32), args[4].(int32))
+		run(args[0].(context.Context), args[1].(string), args[2].(int32))
 	})
 	return _c
 }
 
-func (_c *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc_Call) Return(examRecords []model.ExamRecord, err error) *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc_Call {
-	_c.Call.Return(examRecords, err)
+func (_c *MockDatabaseRepository_GetExamVersionByExamIdAndVersion_Call) Return(examVersion *model.ExamVersion, err error) *MockDatabaseRepository_GetExamVersionByExamIdAndVersion_Call {
+	_c.Call.Return(examVersion, err)
 	return _c
 }
 
-func (_c *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc_Call) RunAndReturn(run func(context.Context, string, string, int32, int32) ([]model.ExamRecord, error)) *MockDatabaseRepository_FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc_Call {
+func (_c *MockDatabaseRepository_GetExamVersionByExamIdAndVersion_Call) RunAndReturn(run func(context.Context, string, int32) (*model.ExamVersion, error)) *MockDatabaseRepository_GetExamVersionByExamIdAndVersion_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc provides a mock function with given fields: ctx, userId, isPublic
-func (_m *MockDatabaseRepository) FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc(ctx context.Context, userId string, isPublic bool) ([]model.Exam, error) {
-	ret := _m.Called(ctx, userId, isPublic)
+// GetQuestionById provides a mock function with given fields: ctx, questionId
+func (_m *MockDatabaseRepository) GetQuestionById(ctx context.Context, questionId string) (*model.Question, error) {
+	ret := _m.Called(ctx, questionId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc")
+		panic("no return value specified for GetQuestionById")
 	}
 
-	var r0 []model.Exam
+	var r0 *model.Question
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, bool) ([]model.Exam, error)); ok {
-		return rf(ctx, userId, isPublic)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Question, error)); ok {
+		return rf(ctx, questionId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, bool) []model.Exam); ok {
-		r0 = rf(ctx, userId, isPublic)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Question); ok {
+		r0 = rf(ctx, questionId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Exam)
+			r0 = ret.Get(0).(*model.Question)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
-		r1 = rf(ctx, userId, isPublic)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, questionId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1015,59 +4413,56 @@ func (_m *MockDatabaseRepository) FindExamsByUserIdAndIsPublicOrderByUpdateAtDes
 	return r0, r1
 }
 
-// MockDatabaseRepository_FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc'
-type MockDatabaseRepository_FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc_Call struct {
+// MockDatabaseRepository_GetQuestionById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQuestionById'
+type MockDatabaseRepository_GetQuestionById_Call struct {
 	*mock.Call
 }
 
-// FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc is a helper method to define mock.On call
+// GetQuestionById is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userId string
-//   - isPublic bool
-func (_e *MockDatabaseRepository_Expecter) FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc(ctx interface{}, userId interface{}, isPublic interface{}) *MockDatabaseRepository_FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc_Call {
-	return &MockDatabaseRepository_FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc_Call{Call: _e.mock.On("FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc", ctx, userId, isPublic)}
+//   - questionId string
+func (_e *MockDatabaseRepository_Expecter) GetQuestionById(ctx interface{}, questionId interface{}) *MockDatabaseRepository_GetQuestionById_Call {
+	return &MockDatabaseRepository_GetQuestionById_Call{Call: _e.mock.On("GetQuestionById", ctx, questionId)}
 }
 
-func (_c *MockDatabaseRepository_FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc_Call) Run(run func(ctx context.Context, userId string, isPublic bool)) *MockDatabaseRepository_FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc_Call {
+func (_c *MockDatabaseRepository_GetQuestionById_Call) Run(run func(ctx context.Context, questionId string)) *MockDatabaseRepository_GetQuestionById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockDatabaseRepository_FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc_Call) Return(exams []model.Exam, err error) *MockDatabaseRepository_FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc_Call {
-	_c.Call.Return(exams, err)
+func (_c *MockDatabaseRepository_GetQuestionById_Call) Return(question *model.Question, err error) *MockDatabaseRepository_GetQuestionById_Call {
+	_c.Call.Return(question, err)
 	return _c
 }
 
-func (_c *MockDatabaseRepository_FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc_Call) RunAndReturn(run func(context.Context, string, bool) ([]model.Exam, error)) *MockDatabaseRepository_FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc_Call {
+func (_c *MockDatabaseRepository_GetQuestionById_Call) RunAndReturn(run func(context.Context, string) (*model.Question, error)) *MockDatabaseRepository_GetQuestionById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindExamsByUserIdOrderByUpdateAtDesc provides a mock function with given fields: ctx, userId, skip, limit
-func (_m *MockDatabaseRepository) FindExamsByUserIdOrderByUpdateAtDesc(ctx context.Context, userId string, skip int32, limit int32) ([]model.Exam, error) {
-	ret := _m.Called(ctx, userId, skip, limit)
+// IncreaseAnswerWrongCorrectStreak provides a mock function with given fields: ctx, answerWrongId
+func (_m *MockDatabaseRepository) IncreaseAnswerWrongCorrectStreak(ctx context.Context, answerWrongId string) (int32, error) {
+	ret := _m.Called(ctx, answerWrongId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindExamsByUserIdOrderByUpdateAtDesc")
+		panic("no return value specified for IncreaseAnswerWrongCorrectStreak")
 	}
 
-	var r0 []model.Exam
+	var r0 int32
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, int32, int32) ([]model.Exam, error)); ok {
-		return rf(ctx, userId, skip, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
+		return rf(ctx, answerWrongId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, int32, int32) []model.Exam); ok {
-		r0 = rf(ctx, userId, skip, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
+		r0 = rf(ctx, answerWrongId)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Exam)
-		}
+		r0 = ret.Get(0).(int32)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, int32, int32) error); ok {
-		r1 = rf(ctx, userId, skip, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, answerWrongId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1075,60 +4470,56 @@ func (_m *MockDatabaseRepository) FindExamsByUserIdOrderByUpdateAtDesc(ctx conte
 	return r0, r1
 }
 
-// MockDatabaseRepository_FindExamsByUserIdOrderByUpdateAtDesc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamsByUserIdOrderByUpdateAtDesc'
-type MockDatabaseRepository_FindExamsByUserIdOrderByUpdateAtDesc_Call struct {
+// MockDatabaseRepository_IncreaseAnswerWrongCorrectStreak_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncreaseAnswerWrongCorrectStreak'
+type MockDatabaseRepository_IncreaseAnswerWrongCorrectStreak_Call struct {
 	*mock.Call
 }
 
-// FindExamsByUserIdOrderByUpdateAtDesc is a helper method to define mock.On call
+// IncreaseAnswerWrongCorrectStreak is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userId string
-//   - skip int32
-//   - limit int32
-func (_e *MockDatabaseRepository_Expecter) FindExamsByUserIdOrderByUpdateAtDesc(ctx interface{}, userId interface{}, skip interface{}, limit interface{}) *MockDatabaseRepository_FindExamsByUserIdOrderByUpdateAtDesc_Call {
-	return &MockDatabaseRepository_FindExamsByUserIdOrderByUpdateAtDesc_Call{Call: _e.mock.On("FindExamsByUserIdOrderByUpdateAtDesc", ctx, userId, skip, limit)}
+//   - answerWrongId string
+func (_e *MockDatabaseRepository_Expecter) IncreaseAnswerWrongCorrectStreak(ctx interface{}, answerWrongId interface{}) *MockDatabaseRepository_IncreaseAnswerWrongCorrectStreak_Call {
+	return &MockDatabaseRepository_IncreaseAnswerWrongCorrectStreak_Call{Call: _e.mock.On("IncreaseAnswerWrongCorrectStreak", ctx, answerWrongId)}
 }
 
-func (_c *MockDatabaseRepository_FindExamsByUserIdOrderByUpdateAtDesc_Call) Run(run func(ctx context.Context, userId string, skip int32, limit int32)) *MockDatabaseRepository_FindExamsByUserIdOrderByUpdateAtDesc_Call {
+func (_c *MockDatabaseRepository_IncreaseAnswerWrongCorrectStreak_Call) Run(run func(ctx context.Context, answerWrongId string)) *MockDatabaseRepository_IncreaseAnswerWrongCorrectStreak_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(int32), args[3].(int32))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockDatabaseRepository_FindExamsByUserIdOrderByUpdateAtDesc_Call) Return(exams []model.Exam, err error) *MockDatabaseRepository_FindExamsByUserIdOrderByUpdateAtDesc_Call {
-	_c.Call.Return(exams, err)
+func (_c *MockDatabaseRepository_IncreaseAnswerWrongCorrectStreak_Call) Return(correctStreak int32, err error) *MockDatabaseRepository_IncreaseAnswerWrongCorrectStreak_Call {
+	_c.Call.Return(correctStreak, err)
 	return _c
 }
 
-func (_c *MockDatabaseRepository_FindExamsByUserIdOrderByUpdateAtDesc_Call) RunAndReturn(run func(context.Context, string, int32, int32) ([]model.Exam, error)) *MockDatabaseRepository_FindExamsByUserIdOrderByUpdateAtDesc_Call {
+func (_c *MockDatabaseRepository_IncreaseAnswerWrongCorrectStreak_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_IncreaseAnswerWrongCorrectStreak_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindQuestionsByExamIdOrderByUpdateAtDesc provides a mock function with given fields: ctx, examId, skip, limit
-func (_m *MockDatabaseRepository) FindQuestionsByExamIdOrderByUpdateAtDesc(ctx context.Context, examId string, skip int32, limit int32) ([]model.Question, error) {
-	ret := _m.Called(ctx, examId, skip, limit)
+// IncreaseExamAttemptCount provides a mock function with given fields: ctx, examId, userId, initialCount, maxAttempts
+func (_m *MockDatabaseRepository) IncreaseExamAttemptCount(ctx context.Context, examId string, userId string, initialCount int32, maxAttempts int32) (bool, error) {
+	ret := _m.Called(ctx, examId, userId, initialCount, maxAttempts)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindQuestionsByExamIdOrderByUpdateAtDesc")
+		panic("no return value specified for IncreaseExamAttemptCount")
 	}
 
-	var r0 []model.Question
+	var r0 bool
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, int32, int32) ([]model.Question, error)); ok {
-		return rf(ctx, examId, skip, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32, int32) (bool, error)); ok {
+		return rf(ctx, examId, userId, initialCount, maxAttempts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, int32, int32) []model.Question); ok {
-		r0 = rf(ctx, examId, skip, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32, int32) bool); ok {
+		r0 = rf(ctx, examId, userId, initialCount, maxAttempts)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Question)
-		}
+		r0 = ret.Get(0).(bool)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, int32, int32) error); ok {
-		r1 = rf(ctx, examId, skip, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32, int32) error); ok {
+		r1 = rf(ctx, examId, userId, initialCount, maxAttempts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1136,60 +4527,59 @@ func (_m *MockDatabaseRepository) FindQuestionsByExamIdOrderByUpdateAtDesc(ctx c
 	return r0, r1
 }
 
-// MockDatabaseRepository_FindQuestionsByExamIdOrderByUpdateAtDesc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindQuestionsByExamIdOrderByUpdateAtDesc'
-type MockDatabaseRepository_FindQuestionsByExamIdOrderByUpdateAtDesc_Call struct {
+// MockDatabaseRepository_IncreaseExamAttemptCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncreaseExamAttemptCount'
+type MockDatabaseRepository_IncreaseExamAttemptCount_Call struct {
 	*mock.Call
 }
 
-// FindQuestionsByExamIdOrderByUpdateAtDesc is a helper method to define mock.On call
+// IncreaseExamAttemptCount is a helper method to define mock.On call
 //   - ctx context.Context
 //   - examId string
-//   - skip int32
-//   - limit int32
-func (_e *MockDatabaseRepository_Expecter) FindQuestionsByExamIdOrderByUpdateAtDesc(ctx interface{}, examId interface{}, skip interface{}, limit interface{}) *MockDatabaseRepository_FindQuestionsByExamIdOrderByUpdateAtDesc_Call {
-	return &MockDatabaseRepository_FindQuestionsByExamIdOrderByUpdateAtDesc_Call{Call: _e.mock.On("FindQuestionsByExamIdOrderByUpdateAtDesc", ctx, examId, skip, limit)}
+//   - userId string
+//   - initialCount int32
+//   - maxAttempts int32
+func (_e *MockDatabaseRepository_Expecter) IncreaseExamAttemptCount(ctx interface{}, examId interface{}, userId interface{}, initialCount interface{}, maxAttempts interface{}) *MockDatabaseRepository_IncreaseExamAttemptCount_Call {
+	return &MockDatabaseRepository_IncreaseExamAttemptCount_Call{Call: _e.mock.On("IncreaseExamAttemptCount", ctx, examId, userId, initialCount, maxAttempts)}
 }
 
-func (_c *MockDatabaseRepository_FindQuestionsByExamIdOrderByUpdateAtDesc_Call) Run(run func(ctx context.Context, examId string, skip int32, limit int32)) *MockDatabaseRepository_FindQuestionsByExamIdOrderByUpdateAtDesc_Call {
+func (_c *MockDatabaseRepository_IncreaseExamAttemptCount_Call) Run(run func(ctx context.Context, examId string, userId string, initialCount int32, maxAttempts int32)) *MockDatabaseRepository_IncreaseExamAttemptCount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(int32), args[3].(int32))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int32), args[4].(int32))
 	})
 	return _c
 }
 
-func (_c *MockDatabaseRepository_FindQuestionsByExamIdOrderByUpdateAtDesc_Call) Return(questions []model.Question, err error) *MockDatabaseRepository_FindQuestionsByExamIdOrderByUpdateAtDesc_Call {
-	_c.Call.Return(questions, err)
+func (_c *MockDatabaseRepository_IncreaseExamAttemptCount_Call) Return(increased bool, err error) *MockDatabaseRepository_IncreaseExamAttemptCount_Call {
+	_c.Call.Return(increased, err)
 	return _c
 }
 
-func (_c *MockDatabaseRepository_FindQuestionsByExamIdOrderByUpdateAtDesc_Call) RunAndReturn(run func(context.Context, string, int32, int32) ([]model.Question, error)) *MockDatabaseRepository_FindQuestionsByExamIdOrderByUpdateAtDesc_Call {
+func (_c *MockDatabaseRepository_IncreaseExamAttemptCount_Call) RunAndReturn(run func(context.Context, string, string, int32, int32) (bool, error)) *MockDatabaseRepository_IncreaseExamAttemptCount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindQuestionsByQuestionIds provides a mock function with given fields: ctx, questionIds
-func (_m *MockDatabaseRepository) FindQuestionsByQuestionIds(ctx context.Context, questionIds []string) ([]model.Question, error) {
-	ret := _m.Called(ctx, questionIds)
+// SubmitExamAttempt provides a mock function with given fields: ctx, examAttemptId
+func (_m *MockDatabaseRepository) SubmitExamAttempt(ctx context.Context, examAttemptId string) (bool, error) {
+	ret := _m.Called(ctx, examAttemptId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindQuestionsByQuestionIds")
+		panic("no return value specified for SubmitExamAttempt")
 	}
 
-	var r0 []model.Question
+	var r0 bool
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]model.Question, error)); ok {
-		return rf(ctx, questionIds)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, examAttemptId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []string) []model.Question); ok {
-		r0 = rf(ctx, questionIds)
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, examAttemptId)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Question)
-		}
+		r0 = ret.Get(0).(bool)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
-		r1 = rf(ctx, questionIds)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, examAttemptId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1197,58 +4587,56 @@ func (_m *MockDatabaseRepository) FindQuestionsByQuestionIds(ctx context.Context
 	return r0, r1
 }
 
-// MockDatabaseRepository_FindQuestionsByQuestionIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindQuestionsByQuestionIds'
-type MockDatabaseRepository_FindQuestionsByQuestionIds_Call struct {
+// MockDatabaseRepository_SubmitExamAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmitExamAttempt'
+type MockDatabaseRepository_SubmitExamAttempt_Call struct {
 	*mock.Call
 }
 
-// FindQuestionsByQuestionIds is a helper method to define mock.On call
+// SubmitExamAttempt is a helper method to define mock.On call
 //   - ctx context.Context
-//   - questionIds []string
-func (_e *MockDatabaseRepository_Expecter) FindQuestionsByQuestionIds(ctx interface{}, questionIds interface{}) *MockDatabaseRepository_FindQuestionsByQuestionIds_Call {
-	return &MockDatabaseRepository_FindQuestionsByQuestionIds_Call{Call: _e.mock.On("FindQuestionsByQuestionIds", ctx, questionIds)}
+//   - examAttemptId string
+func (_e *MockDatabaseRepository_Expecter) SubmitExamAttempt(ctx interface{}, examAttemptId interface{}) *MockDatabaseRepository_SubmitExamAttempt_Call {
+	return &MockDatabaseRepository_SubmitExamAttempt_Call{Call: _e.mock.On("SubmitExamAttempt", ctx, examAttemptId)}
 }
 
-func (_c *MockDatabaseRepository_FindQuestionsByQuestionIds_Call) Run(run func(ctx context.Context, questionIds []string)) *MockDatabaseRepository_FindQuestionsByQuestionIds_Call {
+func (_c *MockDatabaseRepository_SubmitExamAttempt_Call) Run(run func(ctx context.Context, examAttemptId string)) *MockDatabaseRepository_SubmitExamAttempt_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockDatabaseRepository_FindQuestionsByQuestionIds_Call) Return(questions []model.Question, err error) *MockDatabaseRepository_FindQuestionsByQuestionIds_Call {
-	_c.Call.Return(questions, err)
+func (_c *MockDatabaseRepository_SubmitExamAttempt_Call) Return(submitted bool, err error) *MockDatabaseRepository_SubmitExamAttempt_Call {
+	_c.Call.Return(submitted, err)
 	return _c
 }
 
-func (_c *MockDatabaseRepository_FindQuestionsByQuestionIds_Call) RunAndReturn(run func(context.Context, []string) ([]model.Question, error)) *MockDatabaseRepository_FindQuestionsByQuestionIds_Call {
+func (_c *MockDatabaseRepository_SubmitExamAttempt_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *MockDatabaseRepository_SubmitExamAttempt_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetExamById provides a mock function with given fields: ctx, examId
-func (_m *MockDatabaseRepository) GetExamById(ctx context.Context, examId string) (*model.Exam, error) {
-	ret := _m.Called(ctx, examId)
+// UnsetQuestionsSectionIdBySectionId provides a mock function with given fields: ctx, sectionId
+func (_m *MockDatabaseRepository) UnsetQuestionsSectionIdBySectionId(ctx context.Context, sectionId string) (int32, error) {
+	ret := _m.Called(ctx, sectionId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetExamById")
+		panic("no return value specified for UnsetQuestionsSectionIdBySectionId")
 	}
 
-	var r0 *model.Exam
+	var r0 int32
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Exam, error)); ok {
-		return rf(ctx, examId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
+		return rf(ctx, sectionId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Exam); ok {
-		r0 = rf(ctx, examId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
+		r0 = rf(ctx, sectionId)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Exam)
-		}
+		r0 = ret.Get(0).(int32)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, examId)
+		r1 = rf(ctx, sectionId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1256,90 +4644,78 @@ func (_m *MockDatabaseRepository) GetExamById(ctx context.Context, examId string
 	return r0, r1
 }
 
-// MockDatabaseRepository_GetExamById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExamById'
-type MockDatabaseRepository_GetExamById_Call struct {
+// MockDatabaseRepository_UnsetQuestionsSectionIdBySectionId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsetQuestionsSectionIdBySectionId'
+type MockDatabaseRepository_UnsetQuestionsSectionIdBySectionId_Call struct {
 	*mock.Call
 }
 
-// GetExamById is a helper method to define mock.On call
+// UnsetQuestionsSectionIdBySectionId is a helper method to define mock.On call
 //   - ctx context.Context
-//   - examId string
-func (_e *MockDatabaseRepository_Expecter) GetExamById(ctx interface{}, examId interface{}) *MockDatabaseRepository_GetExamById_Call {
-	return &MockDatabaseRepository_GetExamById_Call{Call: _e.mock.On("GetExamById", ctx, examId)}
+//   - sectionId string
+func (_e *MockDatabaseRepository_Expecter) UnsetQuestionsSectionIdBySectionId(ctx interface{}, sectionId interface{}) *MockDatabaseRepository_UnsetQuestionsSectionIdBySectionId_Call {
+	return &MockDatabaseRepository_UnsetQuestionsSectionIdBySectionId_Call{Call: _e.mock.On("UnsetQuestionsSectionIdBySectionId", ctx, sectionId)}
 }
 
-func (_c *MockDatabaseRepository_GetExamById_Call) Run(run func(ctx context.Context, examId string)) *MockDatabaseRepository_GetExamById_Call {
+func (_c *MockDatabaseRepository_UnsetQuestionsSectionIdBySectionId_Call) Run(run func(ctx context.Context, sectionId string)) *MockDatabaseRepository_UnsetQuestionsSectionIdBySectionId_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockDatabaseRepository_GetExamById_Call) Return(exam *model.Exam, err error) *MockDatabaseRepository_GetExamById_Call {
-	_c.Call.Return(exam, err)
+func (_c *MockDatabaseRepository_UnsetQuestionsSectionIdBySectionId_Call) Return(modifiedCount int32, err error) *MockDatabaseRepository_UnsetQuestionsSectionIdBySectionId_Call {
+	_c.Call.Return(modifiedCount, err)
 	return _c
 }
 
-func (_c *MockDatabaseRepository_GetExamById_Call) RunAndReturn(run func(context.Context, string) (*model.Exam, error)) *MockDatabaseRepository_GetExamById_Call {
+func (_c *MockDatabaseRepository_UnsetQuestionsSectionIdBySectionId_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_UnsetQuestionsSectionIdBySectionId_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetQuestionById provides a mock function with given fields: ctx, questionId
-func (_m *MockDatabaseRepository) GetQuestionById(ctx context.Context, questionId string) (*model.Question, error) {
-	ret := _m.Called(ctx, questionId)
+// UpdateBankQuestion provides a mock function with given fields: ctx, bankQuestion
+func (_m *MockDatabaseRepository) UpdateBankQuestion(ctx context.Context, bankQuestion model.BankQuestion) error {
+	ret := _m.Called(ctx, bankQuestion)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetQuestionById")
-	}
-
-	var r0 *model.Question
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Question, error)); ok {
-		return rf(ctx, questionId)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Question); ok {
-		r0 = rf(ctx, questionId)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Question)
-		}
+		panic("no return value specified for UpdateBankQuestion")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, questionId)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.BankQuestion) error); ok {
+		r0 = rf(ctx, bankQuestion)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockDatabaseRepository_GetQuestionById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQuestionById'
-type MockDatabaseRepository_GetQuestionById_Call struct {
+// MockDatabaseRepository_UpdateBankQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBankQuestion'
+type MockDatabaseRepository_UpdateBankQuestion_Call struct {
 	*mock.Call
 }
 
-// GetQuestionById is a helper method to define mock.On call
+// UpdateBankQuestion is a helper method to define mock.On call
 //   - ctx context.Context
-//   - questionId string
-func (_e *MockDatabaseRepository_Expecter) GetQuestionById(ctx interface{}, questionId interface{}) *MockDatabaseRepository_GetQuestionById_Call {
-	return &MockDatabaseRepository_GetQuestionById_Call{Call: _e.mock.On("GetQuestionById", ctx, questionId)}
+//   - bankQuestion model.BankQuestion
+func (_e *MockDatabaseRepository_Expecter) UpdateBankQuestion(ctx interface{}, bankQuestion interface{}) *MockDatabaseRepository_UpdateBankQuestion_Call {
+	return &MockDatabaseRepository_UpdateBankQuestion_Call{Call: _e.mock.On("UpdateBankQuestion", ctx, bankQuestion)}
 }
 
-func (_c *MockDatabaseRepository_GetQuestionById_Call) Run(run func(ctx context.Context, questionId string)) *MockDatabaseRepository_GetQuestionById_Call {
+func (_c *MockDatabaseRepository_UpdateBankQuestion_Call) Run(run func(ctx context.Context, bankQuestion model.BankQuestion)) *MockDatabaseRepository_UpdateBankQuestion_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(model.BankQuestion))
 	})
 	return _c
 }
 
-func (_c *MockDatabaseRepository_GetQuestionById_Call) Return(question *model.Question, err error) *MockDatabaseRepository_GetQuestionById_Call {
-	_c.Call.Return(question, err)
+func (_c *MockDatabaseRepository_UpdateBankQuestion_Call) Return(_a0 error) *MockDatabaseRepository_UpdateBankQuestion_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDatabaseRepository_GetQuestionById_Call) RunAndReturn(run func(context.Context, string) (*model.Question, error)) *MockDatabaseRepository_GetQuestionById_Call {
+func (_c *MockDatabaseRepository_UpdateBankQuestion_Call) RunAndReturn(run func(context.Context, model.BankQuestion) error) *MockDatabaseRepository_UpdateBankQuestion_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1391,6 +4767,53 @@ func (_c *MockDatabaseRepository_UpdateExam_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// UpdateExamSection provides a mock function with given fields: ctx, examSection
+func (_m *MockDatabaseRepository) UpdateExamSection(ctx context.Context, examSection model.ExamSection) error {
+	ret := _m.Called(ctx, examSection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateExamSection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ExamSection) error); ok {
+		r0 = rf(ctx, examSection)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatabaseRepository_UpdateExamSection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateExamSection'
+type MockDatabaseRepository_UpdateExamSection_Call struct {
+	*mock.Call
+}
+
+// UpdateExamSection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - examSection model.ExamSection
+func (_e *MockDatabaseRepository_Expecter) UpdateExamSection(ctx interface{}, examSection interface{}) *MockDatabaseRepository_UpdateExamSection_Call {
+	return &MockDatabaseRepository_UpdateExamSection_Call{Call: _e.mock.On("UpdateExamSection", ctx, examSection)}
+}
+
+func (_c *MockDatabaseRepository_UpdateExamSection_Call) Run(run func(ctx context.Context, examSection model.ExamSection)) *MockDatabaseRepository_UpdateExamSection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.ExamSection))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_UpdateExamSection_Call) Return(_a0 error) *MockDatabaseRepository_UpdateExamSection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_UpdateExamSection_Call) RunAndReturn(run func(context.Context, model.ExamSection) error) *MockDatabaseRepository_UpdateExamSection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateQuestion provides a mock function with given fields: ctx, question
 func (_m *MockDatabaseRepository) UpdateQuestion(ctx context.Context, question model.Question) error {
 	ret := _m.Called(ctx, question)
@@ -1438,9 +4861,9 @@ func (_c *MockDatabaseRepository_UpdateQuestion_Call) RunAndReturn(run func(cont
 	return _c
 }
 
-// UpsertAnswerWrongByTimesPlusOne provides a mock function with given fields: ctx, examId, questionId, userId
-func (_m *MockDatabaseRepository) UpsertAnswerWrongByTimesPlusOne(ctx context.Context, examId string, questionId string, userId string) (int32, int32, error) {
-	ret := _m.Called(ctx, examId, questionId, userId)
+// UpsertAnswerWrongByTimesPlusOne provides a mock function with given fields: ctx, examId, examVersion, questionId, userId
+func (_m *MockDatabaseRepository) UpsertAnswerWrongByTimesPlusOne(ctx context.Context, examId string, examVersion int32, questionId string, userId string) (int32, int32, error) {
+	ret := _m.Called(ctx, examId, examVersion, questionId, userId)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpsertAnswerWrongByTimesPlusOne")
@@ -1449,23 +4872,23 @@ func (_m *MockDatabaseRepository) UpsertAnswerWrongByTimesPlusOne(ctx context.Co
 	var r0 int32
 	var r1 int32
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (int32, int32, error)); ok {
-		return rf(ctx, examId, questionId, userId)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32, string, string) (int32, int32, error)); ok {
+		return rf(ctx, examId, examVersion, questionId, userId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) int32); ok {
-		r0 = rf(ctx, examId, questionId, userId)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32, string, string) int32); ok {
+		r0 = rf(ctx, examId, examVersion, questionId, userId)
 	} else {
 		r0 = ret.Get(0).(int32)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) int32); ok {
-		r1 = rf(ctx, examId, questionId, userId)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32, string, string) int32); ok {
+		r1 = rf(ctx, examId, examVersion, questionId, userId)
 	} else {
 		r1 = ret.Get(1).(int32)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, string, string, string) error); ok {
-		r2 = rf(ctx, examId, questionId, userId)
+	if rf, ok := ret.Get(2).(func(context.Context, string, int32, string, string) error); ok {
+		r2 = rf(ctx, examId, examVersion, questionId, userId)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -1481,15 +4904,16 @@ type MockDatabaseRepository_UpsertAnswerWrongByTimesPlusOne_Call struct {
 // UpsertAnswerWrongByTimesPlusOne is a helper method to define mock.On call
 //   - ctx context.Context
 //   - examId string
+//   - examVersion int32
 //   - questionId string
 //   - userId string
-func (_e *MockDatabaseRepository_Expecter) UpsertAnswerWrongByTimesPlusOne(ctx interface{}, examId interface{}, questionId interface{}, userId interface{}) *MockDatabaseRepository_UpsertAnswerWrongByTimesPlusOne_Call {
-	return &MockDatabaseRepository_UpsertAnswerWrongByTimesPlusOne_Call{Call: _e.mock.On("UpsertAnswerWrongByTimesPlusOne", ctx, examId, questionId, userId)}
+func (_e *MockDatabaseRepository_Expecter) UpsertAnswerWrongByTimesPlusOne(ctx interface{}, examId interface{}, examVersion interface{}, questionId interface{}, userId interface{}) *MockDatabaseRepository_UpsertAnswerWrongByTimesPlusOne_Call {
+	return &MockDatabaseRepository_UpsertAnswerWrongByTimesPlusOne_Call{Call: _e.mock.On("UpsertAnswerWrongByTimesPlusOne", ctx, examId, examVersion, questionId, userId)}
 }
 
-func (_c *MockDatabaseRepository_UpsertAnswerWrongByTimesPlusOne_Call) Run(run func(ctx context.Context, examId string, questionId string, userId string)) *MockDatabaseRepository_UpsertAnswerWrongByTimesPlusOne_Call {
+func (_c *MockDatabaseRepository_UpsertAnswerWrongByTimesPlusOne_Call) Run(run func(ctx context.Context, examId string, examVersion int32, questionId string, userId string)) *MockDatabaseRepository_UpsertAnswerWrongByTimesPlusOne_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(int32), args[3].(string), args[4].(string))
 	})
 	return _c
 }
@@ -1499,7 +4923,7 @@ func (_c *MockDatabaseRepository_UpsertAnswerWrongByTimesPlusOne_Call) Return(mo
 	return _c
 }
 
-func (_c *MockDatabaseRepository_UpsertAnswerWrongByTimesPlusOne_Call) RunAndReturn(run func(context.Context, string, string, string) (int32, int32, error)) *MockDatabaseRepository_UpsertAnswerWrongByTimesPlusOne_Call {
+func (_c *MockDatabaseRepository_UpsertAnswerWrongByTimesPlusOne_Call) RunAndReturn(run func(context.Context, string, int32, string, string) (int32, int32, error)) *MockDatabaseRepository_UpsertAnswerWrongByTimesPlusOne_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"math"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -16,10 +17,17 @@ import (
 )
 
 const (
-	EXAM_COLLECTION         = "exams"
-	QUESTION_COLLECTION     = "questions"
-	ANSWER_WRONG_COLLECTION = "answerwrongs"
-	EXAM_RECORD_COLLECTION  = "examrecords"
+	EXAM_COLLECTION               = "exams"
+	QUESTION_COLLECTION           = "questions"
+	ANSWER_WRONG_COLLECTION       = "answerwrongs"
+	EXAM_RECORD_COLLECTION        = "examrecords"
+	EXAM_VERSION_COLLECTION       = "examversions"
+	CLASS_COLLECTION              = "classes"
+	ASSIGNMENT_COLLECTION         = "assignments"
+	EXAM_SECTION_COLLECTION       = "examsections"
+	BANK_QUESTION_COLLECTION      = "bankquestions"
+	EXAM_ATTEMPT_COUNT_COLLECTION = "examattemptcounts"
+	EXAM_ATTEMPT_COLLECTION       = "examattempts"
 )
 
 type MongoDBRepository struct {
@@ -53,9 +61,124 @@ func (repo *MongoDBRepository) ConnectDB(ctx context.Context, uri string) error
 	}
 
 	fmt.Println("Connected to MongoDB")
+
+	err = repo.createIndexes(ctx)
+	if err != nil {
+		return fmt.Errorf("ConnectDB create indexes failed! error: %w", err)
+	}
+
+	err = repo.migrateExamStats(ctx)
+	if err != nil {
+		return fmt.Errorf("ConnectDB migrate exam stats failed! error: %w", err)
+	}
+
 	return nil
 }
 
+// 建立查詢需要的索引，索引已存在時 MongoDB 不會重複建立
+func (repo *MongoDBRepository) createIndexes(ctx context.Context) error {
+	collection := repo.getCollection(EXAM_COLLECTION)
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{"tags", 1}}},
+		{Keys: bson.D{{"isPublic", 1}, {"tags", 1}}},
+		// 測驗目錄的排序
+		{Keys: bson.D{{"isPublic", 1}, {"updatedAt", -1}, {"_id", -1}}},
+		{Keys: bson.D{{"isPublic", 1}, {"recordCount", -1}, {"updatedAt", -1}, {"_id", -1}}},
+		{Keys: bson.D{
+			{"isPublic", 1},
+			{"averagePercentage", -1},
+			{"recordCount", -1},
+			{"_id", -1},
+		}},
+		// 測驗目錄的關鍵字搜尋，不使用語言的詞幹分析
+		{
+			Keys:    bson.D{{"topic", "text"}, {"description", "text"}},
+			Options: options.Index().SetDefaultLanguage("none"),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	collection = repo.getCollection(QUESTION_COLLECTION)
+	_, err = collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{"examId", 1}},
+	})
+	if err != nil {
+		return err
+	}
+
+	collection = repo.getCollection(EXAM_VERSION_COLLECTION)
+	_, err = collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{"examId", 1}, {"version", -1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	collection = repo.getCollection(EXAM_RECORD_COLLECTION)
+	_, err = collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{"examId", 1}, {"createdAt", 1}},
+	})
+	if err != nil {
+		return err
+	}
+
+	collection = repo.getCollection(CLASS_COLLECTION)
+	_, err = collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{"joinCode", 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{{"students.userId", 1}}},
+	})
+	if err != nil {
+		return err
+	}
+
+	collection = repo.getCollection(ASSIGNMENT_COLLECTION)
+	_, err = collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{"classId", 1}, {"dueAt", 1}}},
+		{Keys: bson.D{{"examId", 1}}},
+	})
+	if err != nil {
+		return err
+	}
+
+	collection = repo.getCollection(EXAM_SECTION_COLLECTION)
+	_, err = collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{"examId", 1}, {"order", 1}},
+	})
+	if err != nil {
+		return err
+	}
+
+	collection = repo.getCollection(BANK_QUESTION_COLLECTION)
+	_, err = collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{"userId", 1}, {"tags", 1}},
+	})
+	if err != nil {
+		return err
+	}
+
+	collection = repo.getCollection(ANSWER_WRONG_COLLECTION)
+	_, err = collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{"userId", 1}, {"updatedAt", -1}},
+	})
+	if err != nil {
+		return err
+	}
+
+	// 每個使用者對每個測驗只有一個作答次數計數器
+	collection = repo.getCollection(EXAM_ATTEMPT_COUNT_COLLECTION)
+	_, err = collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{"examId", 1}, {"userId", 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return err
+}
+
 func (repo *MongoDBRepository) DisconnectDB(ctx context.Context) error {
 	if err := repo.client.Disconnect(ctx); err != nil {
 		return fmt.Errorf("DisconnectDB failed! error: %w", err)
@@ -72,6 +195,9 @@ func (repo *MongoDBRepository) CreateExam(
 	exam.CreatedAt = now
 	exam.UpdatedAt = now
 
+	// 新資料的版本號從 1 開始，0 表示還沒有版本號的舊資料
+	exam.Version = 1
+
 	collection := repo.getCollection(EXAM_COLLECTION)
 	result, err := collection.InsertOne(ctx, exam)
 	if err != nil {
@@ -89,20 +215,30 @@ func (repo *MongoDBRepository) UpdateExam(
 	update := bson.D{{"$set", bson.D{
 		{"topic", exam.Topic},
 		{"description", exam.Description},
+		{"tags", exam.Tags},
 		{"isPublic", exam.IsPublic},
 		{"userId", exam.UserId},
+		{"latestVersion", exam.LatestVersion},
+		{"shares", exam.Shares},
+		{"settings", exam.Settings},
+		{"bankSource", exam.BankSource},
+		{"version", exam.Version + 1},
 		{"updatedAt", time.Now()},
 	}}}
 	collection := repo.getCollection(EXAM_COLLECTION)
-	result, err := collection.UpdateByID(ctx, exam.Id, update)
+	result, err := collection.UpdateOne(ctx, versionFilter(exam.Id, exam.Version), update)
 	if err != nil {
 		return err
 	}
 
 	// 查無符合條件的資料可供修改
 	if result.MatchedCount == 0 {
-		err = fmt.Errorf("Exam not found by examId: %s", exam.Id.Hex())
-		return err
+		return versionMismatchError(
+			ctx,
+			collection,
+			exam.Id,
+			fmt.Errorf("Exam not found by examId: %s", exam.Id.Hex()),
+		)
 	}
 
 	return nil
@@ -143,8 +279,7 @@ func (repo *MongoDBRepository) FindExamsByUserIdOrderByUpdateAtDesc(
 ) (exams []model.Exam, err error) {
 	collection := repo.getCollection(EXAM_COLLECTION)
 	filter := bson.D{{"userId", userId}}
-	sort := bson.D{{"updatedAt", -1}} // descending
-	opts := options.Find().SetSort(sort).SetSkip(int64(skip)).SetLimit(int64(limit))
+	opts := options.Find().SetSort(pageSort).SetSkip(int64(skip)).SetLimit(int64(limit))
 	cursor, err := collection.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err
@@ -157,16 +292,42 @@ func (repo *MongoDBRepository) FindExamsByUserIdOrderByUpdateAtDesc(
 	return exams, nil
 }
 
-func (repo *MongoDBRepository) FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc(
+func (repo *MongoDBRepository) FindExamsByUserIdAndCursorOrderByUpdateAtDesc(
+	ctx context.Context,
+	userId, cursor string,
+	limit int32,
+) (exams []model.Exam, nextCursor string, err error) {
+	collection := repo.getCollection(EXAM_COLLECTION)
+	filter := bson.D{{"userId", userId}}
+	return findPageByCursor(
+		ctx,
+		collection,
+		filter,
+		cursor,
+		limit,
+		func(exam model.Exam) (time.Time, primitive.ObjectID) {
+			return exam.UpdatedAt, exam.Id
+		},
+	)
+}
+
+func (repo *MongoDBRepository) FindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc(
 	ctx context.Context,
 	userId string,
 	isPublic bool,
+	tags []string,
 ) (exams []model.Exam, err error) {
 	collection := repo.getCollection(EXAM_COLLECTION)
 	filter := bson.D{
 		{"userId", userId},
 		{"isPublic", isPublic},
 	}
+
+	// 有指定標籤時，只查詢包含全部標籤的測驗
+	if len(tags) > 0 {
+		filter = append(filter, bson.E{"tags", bson.D{{"$all", tags}}})
+	}
+
 	sort := bson.D{{"updatedAt", -1}} // descending
 	opts := options.Find().SetSort(sort)
 	cursor, err := collection.Find(ctx, filter, opts)
@@ -181,6 +342,264 @@ func (repo *MongoDBRepository) FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc(
 	return exams, nil
 }
 
+/*
+查詢測驗的題目數量與作答紀錄的彙總階段，作答紀錄只統計 recordUserId 的紀錄，
+recordUserId 為空字串時統計全部使用者的紀錄
+*/
+func examSummaryStages(recordUserId string) mongo.Pipeline {
+	recordMatch := bson.D{{"$expr", bson.D{{"$eq", bson.A{"$examId", "$$examId"}}}}}
+
+	if recordUserId != "" {
+		recordMatch = append(recordMatch, bson.E{"userId", recordUserId})
+	}
+
+	return mongo.Pipeline{
+		// 題目和作答紀錄的 examId 是字串，所以要先將測驗的 _id 轉成字串
+		{{"$lookup", bson.D{
+			{"from", QUESTION_COLLECTION},
+			{"let", bson.D{{"examId", bson.D{{"$toString", "$_id"}}}}},
+			{"pipeline", bson.A{
+				bson.D{{"$match", bson.D{
+					{"$expr", bson.D{{"$eq", bson.A{"$examId", "$$examId"}}}},
+				}}},
+				bson.D{{"$count", "count"}},
+			}},
+			{"as", "questionStats"},
+		}}},
+		{{"$lookup", bson.D{
+			{"from", EXAM_RECORD_COLLECTION},
+			{"let", bson.D{{"examId", bson.D{{"$toString", "$_id"}}}}},
+			{"pipeline", bson.A{
+				bson.D{{"$match", recordMatch}},
+				bson.D{{"$count", "count"}},
+			}},
+			{"as", "recordStats"},
+		}}},
+		{{"$set", bson.D{
+			{"questionCount", bson.D{{"$ifNull", bson.A{
+				bson.D{{"$first", "$questionStats.count"}}, 0,
+			}}}},
+			{"recordCount", bson.D{{"$ifNull", bson.A{
+				bson.D{{"$first", "$recordStats.count"}}, 0,
+			}}}},
+		}}},
+		{{"$unset", bson.A{"questionStats", "recordStats"}}},
+		// 沒有題目的測驗無法作答，所以不顯示
+		{{"$match", bson.D{{"questionCount", bson.D{{"$gt", 0}}}}}},
+	}
+}
+
+func (repo *MongoDBRepository) FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc(
+	ctx context.Context,
+	userId string,
+	isPublic bool,
+	tags []string,
+) (examSummaries []ExamSummary, err error) {
+	filter := bson.D{
+		{"userId", userId},
+		{"isPublic", isPublic},
+	}
+
+	// 有指定標籤時，只查詢包含全部標籤的測驗
+	if len(tags) > 0 {
+		filter = append(filter, bson.E{"tags", bson.D{{"$all", tags}}})
+	}
+
+	pipeline := mongo.Pipeline{{{"$match", filter}}}
+	pipeline = append(pipeline, examSummaryStages(userId)...)
+	pipeline = append(pipeline, bson.D{{"$sort", pageSort}})
+
+	collection := repo.getCollection(EXAM_COLLECTION)
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	examSummaries = []ExamSummary{}
+	if err = cursor.All(ctx, &examSummaries); err != nil {
+		return nil, err
+	}
+
+	return examSummaries, nil
+}
+
+/*
+查詢公開的測驗目錄，可用關鍵字搜尋主題與說明，並依指定的方式排序，
+題目數量、作答次數與平均得分百分比是測驗上的統計欄位，所以只需要查詢測驗，
+總數和該頁資料使用 $facet 在同一個彙總查詢中取得
+*/
+func (repo *MongoDBRepository) FindPublicExamSummaries(
+	ctx context.Context,
+	keyword string,
+	tags []string,
+	sortBy string,
+	skip, limit int32,
+) (total int32, examSummaries []ExamSummary, err error) {
+	filter := bson.D{}
+
+	// 使用 text index 搜尋，只能比對完整的單字，沒有空白分隔的中文要整段相同才會找到
+	if keyword != "" {
+		filter = append(filter, bson.E{"$text", bson.D{{"$search", keyword}}})
+	}
+
+	filter = append(filter,
+		bson.E{"isPublic", true},
+		// 沒有題目的測驗無法作答，所以不顯示
+		bson.E{"questionCount", bson.D{{"$gt", 0}}},
+	)
+
+	if len(tags) > 0 {
+		filter = append(filter, bson.E{"tags", bson.D{{"$all", tags}}})
+	}
+
+	var sort bson.D
+
+	switch sortBy {
+	case EXAM_CATALOG_SORT_MOST_ATTEMPTED:
+		sort = bson.D{{"recordCount", -1}, {"updatedAt", -1}, {"_id", -1}}
+	case EXAM_CATALOG_SORT_HIGHEST_SCORING:
+		sort = bson.D{{"averagePercentage", -1}, {"recordCount", -1}, {"_id", -1}}
+	default:
+		sort = pageSort
+	}
+
+	pipeline := mongo.Pipeline{
+		{{"$match", filter}},
+		{{"$sort", sort}},
+		{{"$facet", bson.D{
+			{"total", bson.A{bson.D{{"$count", "count"}}}},
+			{"examSummaries", bson.A{
+				bson.D{{"$skip", skip}},
+				bson.D{{"$limit", limit}},
+			}},
+		}}},
+	}
+
+	collection := repo.getCollection(EXAM_COLLECTION)
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	results := []struct {
+		Total []struct {
+			Count int32 `bson:"count"`
+		} `bson:"total"`
+		ExamSummaries []ExamSummary `bson:"examSummaries"`
+	}{}
+	if err = cursor.All(ctx, &results); err != nil {
+		return 0, nil, err
+	}
+
+	examSummaries = []ExamSummary{}
+
+	if len(results) == 0 {
+		return 0, examSummaries, nil
+	}
+
+	if len(results[0].Total) > 0 {
+		total = results[0].Total[0].Count
+	}
+
+	examSummaries = append(examSummaries, results[0].ExamSummaries...)
+	return total, examSummaries, nil
+}
+
+// 查詢包含全部標籤，且使用者可以看到的測驗（公開的測驗或自己的測驗）
+func examTagsFilter(tags []string, userId string) bson.D {
+	filter := bson.D{{"tags", bson.D{{"$all", tags}}}}
+
+	if userId == "" {
+		return append(filter, bson.E{"isPublic", true})
+	}
+
+	return append(filter, bson.E{"$or", bson.A{
+		bson.D{{"isPublic", true}},
+		bson.D{{"userId", userId}},
+	}})
+}
+
+func (repo *MongoDBRepository) FindExamsByTagsOrderByUpdateAtDesc(
+	ctx context.Context,
+	tags []string,
+	userId string,
+	skip, limit int32,
+) (exams []model.Exam, err error) {
+	collection := repo.getCollection(EXAM_COLLECTION)
+	filter := examTagsFilter(tags, userId)
+	opts := options.Find().SetSort(pageSort).SetSkip(int64(skip)).SetLimit(int64(limit))
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	exams = []model.Exam{}
+	if err = cursor.All(ctx, &exams); err != nil {
+		return nil, err
+	}
+
+	return exams, nil
+}
+
+func (repo *MongoDBRepository) CountExamsByTags(
+	ctx context.Context,
+	tags []string,
+	userId string,
+) (count int32, err error) {
+	collection := repo.getCollection(EXAM_COLLECTION)
+	filter := examTagsFilter(tags, userId)
+	result, err := collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(result), nil
+}
+
+// 統計使用者可以看到的測驗中，各標籤的使用次數，依次數由多到少排序
+func (repo *MongoDBRepository) FindExamTagCounts(
+	ctx context.Context,
+	userId string,
+	limit int32,
+) (tagCounts []TagCount, err error) {
+	visibleFilter := bson.D{{"isPublic", true}}
+
+	if userId != "" {
+		visibleFilter = bson.D{{"$or", bson.A{
+			bson.D{{"isPublic", true}},
+			bson.D{{"userId", userId}},
+		}}}
+	}
+
+	matchStage := bson.D{{"$match", visibleFilter}}
+	unwindStage := bson.D{{"$unwind", "$tags"}}
+	groupStage := bson.D{{"$group", bson.D{
+		{"_id", "$tags"},
+		{"count", bson.D{{"$sum", 1}}},
+	}}}
+	sortStage := bson.D{{"$sort", bson.D{{"count", -1}, {"_id", 1}}}}
+	limitStage := bson.D{{"$limit", limit}}
+
+	collection := repo.getCollection(EXAM_COLLECTION)
+	cursor, err := collection.Aggregate(ctx, mongo.Pipeline{
+		matchStage,
+		unwindStage,
+		groupStage,
+		sortStage,
+		limitStage,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tagCounts = []TagCount{}
+	if err = cursor.All(ctx, &tagCounts); err != nil {
+		return nil, err
+	}
+
+	return tagCounts, nil
+}
+
 func (repo *MongoDBRepository) DeleteExamById(
 	ctx context.Context,
 	examId string,
@@ -224,16 +643,60 @@ func (repo *MongoDBRepository) CreateQuestion(
 	question.CreatedAt = now
 	question.UpdatedAt = now
 
+	// 新資料的版本號從 1 開始，0 表示還沒有版本號的舊資料
+	question.Version = 1
+
 	collection := repo.getCollection(QUESTION_COLLECTION)
 	result, err := collection.InsertOne(ctx, question)
 	if err != nil {
 		return "", err
 	}
 
+	err = repo.refreshExamQuestionCounts(ctx, []string{question.ExamId})
+	if err != nil {
+		return "", err
+	}
+
 	questionId = result.InsertedID.(primitive.ObjectID).Hex()
 	return questionId, nil
 }
 
+func (repo *MongoDBRepository) CreateQuestions(
+	ctx context.Context,
+	questions []model.Question,
+) (questionIds []string, err error) {
+	now := time.Now()
+	documents := []interface{}{}
+	examIds := []string{}
+
+	for _, question := range questions {
+		question.CreatedAt = now
+		question.UpdatedAt = now
+		question.Version = 1
+		documents = append(documents, question)
+		examIds = append(examIds, question.ExamId)
+	}
+
+	collection := repo.getCollection(QUESTION_COLLECTION)
+	result, err := collection.InsertMany(ctx, documents)
+	if err != nil {
+		return nil, err
+	}
+
+	err = repo.refreshExamQuestionCounts(ctx, examIds)
+	if err != nil {
+		return nil, err
+	}
+
+	questionIds = []string{}
+
+	for _, insertedId := range result.InsertedIDs {
+		questionIds = append(questionIds, insertedId.(primitive.ObjectID).Hex())
+	}
+
+	return questionIds, nil
+}
+
 func (repo *MongoDBRepository) UpdateQuestion(
 	ctx context.Context,
 	question model.Question,
@@ -242,19 +705,34 @@ func (repo *MongoDBRepository) UpdateQuestion(
 		{"examId", question.ExamId},
 		{"ask", question.Ask},
 		{"answers", question.Answers},
+		{"acceptedAnswers", question.AcceptedAnswers},
 		{"userId", question.UserId},
+		{"points", question.Points},
+		{"partialCredit", question.PartialCredit},
+		{"hint", question.Hint},
+		{"explanation", question.Explanation},
+		{"sectionId", question.SectionId},
+		{"version", question.Version + 1},
 		{"updatedAt", time.Now()},
 	}}}
 	collection := repo.getCollection(QUESTION_COLLECTION)
-	result, err := collection.UpdateByID(ctx, question.Id, update)
+	result, err := collection.UpdateOne(
+		ctx,
+		versionFilter(question.Id, question.Version),
+		update,
+	)
 	if err != nil {
 		return err
 	}
 
 	// 查無符合條件的資料可供修改
 	if result.MatchedCount == 0 {
-		err = fmt.Errorf("Question not found by questionId: %s", question.Id.Hex())
-		return err
+		return versionMismatchError(
+			ctx,
+			collection,
+			question.Id,
+			fmt.Errorf("Question not found by questionId: %s", question.Id.Hex()),
+		)
 	}
 
 	return nil
@@ -319,6 +797,29 @@ func (repo *MongoDBRepository) FindQuestionsByQuestionIds(
 	return questions, nil
 }
 
+// 查詢測驗的全部題目，依新增的順序排序
+func (repo *MongoDBRepository) FindQuestionsByExamId(
+	ctx context.Context,
+	examId string,
+) (questions []model.Question, err error) {
+	collection := repo.getCollection(QUESTION_COLLECTION)
+	filter := bson.D{
+		{"examId", examId},
+	}
+	opts := options.Find().SetSort(bson.D{{"_id", 1}})
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	questions = []model.Question{}
+	if err = cursor.All(ctx, &questions); err != nil {
+		return nil, err
+	}
+
+	return questions, nil
+}
+
 func (repo *MongoDBRepository) FindQuestionsByExamIdOrderByUpdateAtDesc(
 	ctx context.Context,
 	examId string,
@@ -328,8 +829,7 @@ func (repo *MongoDBRepository) FindQuestionsByExamIdOrderByUpdateAtDesc(
 	filter := bson.D{
 		{"examId", examId},
 	}
-	sort := bson.D{{"updatedAt", -1}} // descending
-	opts := options.Find().SetSort(sort).SetSkip(int64(skip)).SetLimit(int64(limit))
+	opts := options.Find().SetSort(pageSort).SetSkip(int64(skip)).SetLimit(int64(limit))
 	cursor, err := collection.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err
@@ -342,6 +842,27 @@ func (repo *MongoDBRepository) FindQuestionsByExamIdOrderByUpdateAtDesc(
 	return questions, nil
 }
 
+func (repo *MongoDBRepository) FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc(
+	ctx context.Context,
+	examId, cursor string,
+	limit int32,
+) (questions []model.Question, nextCursor string, err error) {
+	collection := repo.getCollection(QUESTION_COLLECTION)
+	filter := bson.D{
+		{"examId", examId},
+	}
+	return findPageByCursor(
+		ctx,
+		collection,
+		filter,
+		cursor,
+		limit,
+		func(question model.Question) (time.Time, primitive.ObjectID) {
+			return question.UpdatedAt, question.Id
+		},
+	)
+}
+
 func (repo *MongoDBRepository) DeleteQuestionById(
 	ctx context.Context,
 	questionId string,
@@ -355,23 +876,1045 @@ func (repo *MongoDBRepository) DeleteQuestionById(
 		{"_id", id},
 	}
 	collection := repo.getCollection(QUESTION_COLLECTION)
-	result, err := collection.DeleteOne(ctx, filter)
+	opts := options.FindOneAndDelete().SetProjection(bson.D{{"examId", 1}})
+
+	var question model.Question
+	err = collection.FindOneAndDelete(ctx, filter, opts).Decode(&question)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	err = repo.refreshExamQuestionCounts(ctx, []string{question.ExamId})
 	if err != nil {
 		return 0, err
 	}
 
-	return int32(result.DeletedCount), nil
+	return 1, nil
 }
 
-func (repo *MongoDBRepository) DeleteQuestionsByExamId(
+func (repo *MongoDBRepository) DeleteQuestionsByIds(
 	ctx context.Context,
-	examId string,
+	questionIds []string,
 ) (deletedCount int32, err error) {
+	ids := []primitive.ObjectID{}
+
+	for _, questionId := range questionIds {
+		id, err := primitive.ObjectIDFromHex(questionId)
+		if err != nil {
+			return 0, err
+		}
+
+		ids = append(ids, id)
+	}
+
 	filter := bson.D{
-		{"examId", examId},
+		{"_id", bson.D{{"$in", ids}}},
 	}
 	collection := repo.getCollection(QUESTION_COLLECTION)
-	result, err := collection.DeleteMany(ctx, filter)
+
+	// 刪除之前先查詢題目所屬的測驗，刪除之後重新計算題目數量
+	examIds, err := collection.Distinct(ctx, "examId", filter)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	refreshExamIds := []string{}
+
+	for _, examId := range examIds {
+		if examId, ok := examId.(string); ok {
+			refreshExamIds = append(refreshExamIds, examId)
+		}
+	}
+
+	err = repo.refreshExamQuestionCounts(ctx, refreshExamIds)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(result.DeletedCount), nil
+}
+
+func (repo *MongoDBRepository) DeleteQuestionsByExamId(
+	ctx context.Context,
+	examId string,
+) (deletedCount int32, err error) {
+	filter := bson.D{
+		{"examId", examId},
+	}
+	collection := repo.getCollection(QUESTION_COLLECTION)
+	result, err := collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	err = repo.refreshExamQuestionCounts(ctx, []string{examId})
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(result.DeletedCount), nil
+}
+
+func (repo *MongoDBRepository) CountQuestionsByExamId(
+	ctx context.Context,
+	examId string,
+) (count int32, err error) {
+	collection := repo.getCollection(QUESTION_COLLECTION)
+	filter := bson.D{
+		{"examId", examId},
+	}
+	result, err := collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(result), nil
+}
+
+func (repo *MongoDBRepository) CreateExamVersion(
+	ctx context.Context,
+	examVersion model.ExamVersion,
+) (examVersionId string, err error) {
+	now := time.Now()
+	examVersion.CreatedAt = now
+	examVersion.UpdatedAt = now
+
+	collection := repo.getCollection(EXAM_VERSION_COLLECTION)
+	result, err := collection.InsertOne(ctx, examVersion)
+	if err != nil {
+		return "", err
+	}
+
+	examVersionId = result.InsertedID.(primitive.ObjectID).Hex()
+	return examVersionId, nil
+}
+
+func (repo *MongoDBRepository) GetExamVersionByExamIdAndVersion(
+	ctx context.Context,
+	examId string,
+	version int32,
+) (examVersion *model.ExamVersion, err error) {
+	filter := bson.D{
+		{"examId", examId},
+		{"version", version},
+	}
+	var result model.ExamVersion
+	collection := repo.getCollection(EXAM_VERSION_COLLECTION)
+	err = collection.FindOne(ctx, filter).Decode(&result)
+
+	if err != nil {
+		// 查無資料不視為錯誤
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (repo *MongoDBRepository) FindExamVersionSummariesByExamIdOrderByVersionDesc(
+	ctx context.Context,
+	examId string,
+) (examVersionSummaries []ExamVersionSummary, err error) {
+	pipeline := mongo.Pipeline{
+		{{"$match", bson.D{{"examId", examId}}}},
+		{{"$sort", bson.D{{"version", -1}}}},
+		{{"$project", bson.D{
+			{"version", 1},
+			{"questionCount", bson.D{{"$size", "$questions"}}},
+			{"createdAt", 1},
+		}}},
+	}
+	collection := repo.getCollection(EXAM_VERSION_COLLECTION)
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	examVersionSummaries = []ExamVersionSummary{}
+
+	if err = cursor.All(ctx, &examVersionSummaries); err != nil {
+		return nil, err
+	}
+
+	return examVersionSummaries, nil
+}
+
+func (repo *MongoDBRepository) DeleteExamVersionsByExamId(
+	ctx context.Context,
+	examId string,
+) (deletedCount int32, err error) {
+	filter := bson.D{
+		{"examId", examId},
+	}
+	collection := repo.getCollection(EXAM_VERSION_COLLECTION)
+	result, err := collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(result.DeletedCount), nil
+}
+
+func (repo *MongoDBRepository) CreateExamSection(
+	ctx context.Context,
+	examSection model.ExamSection,
+) (sectionId string, err error) {
+	now := time.Now()
+	examSection.CreatedAt = now
+	examSection.UpdatedAt = now
+
+	collection := repo.getCollection(EXAM_SECTION_COLLECTION)
+	result, err := collection.InsertOne(ctx, examSection)
+	if err != nil {
+		return "", err
+	}
+
+	sectionId = result.InsertedID.(primitive.ObjectID).Hex()
+	return sectionId, nil
+}
+
+func (repo *MongoDBRepository) UpdateExamSection(
+	ctx context.Context,
+	examSection model.ExamSection,
+) error {
+	update := bson.D{{"$set", bson.D{
+		{"type", examSection.Type},
+		{"title", examSection.Title},
+		{"passage", examSection.Passage},
+		{"audioUrl", examSection.AudioUrl},
+		{"order", examSection.Order},
+		{"updatedAt", time.Now()},
+	}}}
+	collection := repo.getCollection(EXAM_SECTION_COLLECTION)
+	result, err := collection.UpdateByID(ctx, examSection.Id, update)
+	if err != nil {
+		return err
+	}
+
+	// 查無符合條件的資料可供修改
+	if result.MatchedCount == 0 {
+		err = fmt.Errorf("ExamSection not found by sectionId: %s", examSection.Id.Hex())
+		return err
+	}
+
+	return nil
+}
+
+func (repo *MongoDBRepository) GetExamSectionById(
+	ctx context.Context,
+	sectionId string,
+) (examSection *model.ExamSection, err error) {
+	id, err := primitive.ObjectIDFromHex(sectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.D{
+		{"_id", id},
+	}
+	var result model.ExamSection
+	collection := repo.getCollection(EXAM_SECTION_COLLECTION)
+	err = collection.FindOne(ctx, filter).Decode(&result)
+
+	if err != nil {
+		// 查無資料不視為錯誤
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (repo *MongoDBRepository) FindExamSectionsByExamIdOrderByOrderAsc(
+	ctx context.Context,
+	examId string,
+) (examSections []model.ExamSection, err error) {
+	filter := bson.D{
+		{"examId", examId},
+	}
+	sort := bson.D{{"order", 1}, {"_id", 1}} // ascending
+	opts := options.Find().SetSort(sort)
+	collection := repo.getCollection(EXAM_SECTION_COLLECTION)
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	examSections = []model.ExamSection{}
+
+	if err = cursor.All(ctx, &examSections); err != nil {
+		return nil, err
+	}
+
+	return examSections, nil
+}
+
+func (repo *MongoDBRepository) DeleteExamSectionById(
+	ctx context.Context,
+	sectionId string,
+) (deletedCount int32, err error) {
+	id, err := primitive.ObjectIDFromHex(sectionId)
+	if err != nil {
+		return 0, err
+	}
+
+	filter := bson.D{
+		{"_id", id},
+	}
+	collection := repo.getCollection(EXAM_SECTION_COLLECTION)
+	result, err := collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(result.DeletedCount), nil
+}
+
+func (repo *MongoDBRepository) DeleteExamSectionsByExamId(
+	ctx context.Context,
+	examId string,
+) (deletedCount int32, err error) {
+	filter := bson.D{
+		{"examId", examId},
+	}
+	collection := repo.getCollection(EXAM_SECTION_COLLECTION)
+	result, err := collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(result.DeletedCount), nil
+}
+
+// 將題組的題目改為獨立的題目
+func (repo *MongoDBRepository) UnsetQuestionsSectionIdBySectionId(
+	ctx context.Context,
+	sectionId string,
+) (modifiedCount int32, err error) {
+	filter := bson.D{
+		{"sectionId", sectionId},
+	}
+	update := bson.D{
+		{"$unset", bson.D{{"sectionId", ""}}},
+		{"$set", bson.D{{"updatedAt", time.Now()}}},
+	}
+	collection := repo.getCollection(QUESTION_COLLECTION)
+	result, err := collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(result.ModifiedCount), nil
+}
+
+func (repo *MongoDBRepository) CreateBankQuestion(
+	ctx context.Context,
+	bankQuestion model.BankQuestion,
+) (bankQuestionId string, err error) {
+	now := time.Now()
+	bankQuestion.CreatedAt = now
+	bankQuestion.UpdatedAt = now
+
+	collection := repo.getCollection(BANK_QUESTION_COLLECTION)
+	result, err := collection.InsertOne(ctx, bankQuestion)
+	if err != nil {
+		return "", err
+	}
+
+	bankQuestionId = result.InsertedID.(primitive.ObjectID).Hex()
+	return bankQuestionId, nil
+}
+
+func (repo *MongoDBRepository) UpdateBankQuestion(
+	ctx context.Context,
+	bankQuestion model.BankQuestion,
+) error {
+	update := bson.D{{"$set", bson.D{
+		{"ask", bankQuestion.Ask},
+		{"answers", bankQuestion.Answers},
+		{"tags", bankQuestion.Tags},
+		{"points", bankQuestion.Points},
+		{"partialCredit", bankQuestion.PartialCredit},
+		{"hint", bankQuestion.Hint},
+		{"explanation", bankQuestion.Explanation},
+		{"userId", bankQuestion.UserId},
+		{"updatedAt", time.Now()},
+	}}}
+	collection := repo.getCollection(BANK_QUESTION_COLLECTION)
+	result, err := collection.UpdateByID(ctx, bankQuestion.Id, update)
+	if err != nil {
+		return err
+	}
+
+	// 查無符合條件的資料可供修改
+	if result.MatchedCount == 0 {
+		err = fmt.Errorf("BankQuestion not found by bankQuestionId: %s", bankQuestion.Id.Hex())
+		return err
+	}
+
+	return nil
+}
+
+func (repo *MongoDBRepository) GetBankQuestionById(
+	ctx context.Context,
+	bankQuestionId string,
+) (bankQuestion *model.BankQuestion, err error) {
+	id, err := primitive.ObjectIDFromHex(bankQuestionId)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.D{
+		{"_id", id},
+	}
+	var result model.BankQuestion
+	collection := repo.getCollection(BANK_QUESTION_COLLECTION)
+	err = collection.FindOne(ctx, filter).Decode(&result)
+
+	if err != nil {
+		// 查無資料不視為錯誤
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (repo *MongoDBRepository) FindBankQuestionsByIds(
+	ctx context.Context,
+	bankQuestionIds []string,
+) (bankQuestions []model.BankQuestion, err error) {
+	ids := []primitive.ObjectID{}
+
+	for _, bankQuestionId := range bankQuestionIds {
+		id, err := primitive.ObjectIDFromHex(bankQuestionId)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	collection := repo.getCollection(BANK_QUESTION_COLLECTION)
+	filter := bson.D{
+		{"_id", bson.D{{"$in", ids}}},
+	}
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	bankQuestions = []model.BankQuestion{}
+	if err = cursor.All(ctx, &bankQuestions); err != nil {
+		return nil, err
+	}
+
+	return bankQuestions, nil
+}
+
+// tag 空字串表示不限制標籤
+func bankQuestionFilter(userId, tag string) bson.D {
+	filter := bson.D{
+		{"userId", userId},
+	}
+
+	if tag != "" {
+		filter = append(filter, bson.E{"tags", tag})
+	}
+
+	return filter
+}
+
+func (repo *MongoDBRepository) FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc(
+	ctx context.Context,
+	userId, tag string,
+	skip, limit int32,
+) (bankQuestions []model.BankQuestion, err error) {
+	collection := repo.getCollection(BANK_QUESTION_COLLECTION)
+	opts := options.Find().SetSort(pageSort).SetSkip(int64(skip)).SetLimit(int64(limit))
+	cursor, err := collection.Find(ctx, bankQuestionFilter(userId, tag), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	bankQuestions = []model.BankQuestion{}
+	if err = cursor.All(ctx, &bankQuestions); err != nil {
+		return nil, err
+	}
+
+	return bankQuestions, nil
+}
+
+func (repo *MongoDBRepository) CountBankQuestionsByUserIdAndTag(
+	ctx context.Context,
+	userId, tag string,
+) (count int32, err error) {
+	collection := repo.getCollection(BANK_QUESTION_COLLECTION)
+	result, err := collection.CountDocuments(ctx, bankQuestionFilter(userId, tag))
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(result), nil
+}
+
+func (repo *MongoDBRepository) FindRandomBankQuestionsByUserIdAndTag(
+	ctx context.Context,
+	userId, tag string,
+	size int32,
+) (bankQuestions []model.BankQuestion, err error) {
+	collection := repo.getCollection(BANK_QUESTION_COLLECTION)
+	pipeline := mongo.Pipeline{
+		bson.D{{"$match", bankQuestionFilter(userId, tag)}},
+		bson.D{{"$sample", bson.D{{"size", size}}}},
+	}
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	bankQuestions = []model.BankQuestion{}
+	if err = cursor.All(ctx, &bankQuestions); err != nil {
+		return nil, err
+	}
+
+	return bankQuestions, nil
+}
+
+func (repo *MongoDBRepository) DeleteBankQuestionById(
+	ctx context.Context,
+	bankQuestionId string,
+) (deletedCount int32, err error) {
+	id, err := primitive.ObjectIDFromHex(bankQuestionId)
+	if err != nil {
+		return 0, err
+	}
+
+	filter := bson.D{
+		{"_id", id},
+	}
+	collection := repo.getCollection(BANK_QUESTION_COLLECTION)
+	result, err := collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(result.DeletedCount), nil
+}
+
+// 舊資料沒有 examVersion 欄位，與版本 0（測驗尚未發佈）視為相同
+func examVersionFilter(examVersion int32) interface{} {
+	if examVersion == 0 {
+		return bson.D{{"$in", bson.A{0, nil}}}
+	}
+
+	return examVersion
+}
+
+// 只刪除版本 0 的答錯紀錄，已發佈版本的答錯紀錄對應到題目快照，需要保留
+func (repo *MongoDBRepository) DeleteAnswerWrongsByQuestionId(
+	ctx context.Context,
+	questionId string,
+) (deletedCount int32, err error) {
+	filter := bson.D{
+		{"questionId", questionId},
+		{"examVersion", examVersionFilter(0)},
+	}
+	collection := repo.getCollection(ANSWER_WRONG_COLLECTION)
+	result, err := collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(result.DeletedCount), nil
+}
+
+// 只刪除版本 0 的答錯紀錄，已發佈版本的答錯紀錄對應到題目快照，需要保留
+func (repo *MongoDBRepository) DeleteAnswerWrongsByQuestionIds(
+	ctx context.Context,
+	questionIds []string,
+) (deletedCount int32, err error) {
+	filter := bson.D{
+		{"questionId", bson.D{{"$in", questionIds}}},
+		{"examVersion", examVersionFilter(0)},
+	}
+	collection := repo.getCollection(ANSWER_WRONG_COLLECTION)
+	result, err := collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(result.DeletedCount), nil
+}
+
+func (repo *MongoDBRepository) DeleteAnswerWrongsByExamId(
+	ctx context.Context,
+	examId string,
+) (deletedCount int32, err error) {
+	filter := bson.D{
+		{"examId", examId},
+	}
+	collection := repo.getCollection(ANSWER_WRONG_COLLECTION)
+	result, err := collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(result.DeletedCount), nil
+}
+
+func (repo *MongoDBRepository) UpsertAnswerWrongByTimesPlusOne(
+	ctx context.Context,
+	examId string,
+	examVersion int32,
+	questionId, userId string,
+) (modifiedCount, upsertedCount int32, err error) {
+	filter := bson.D{
+		{"examId", examId},
+		{"examVersion", examVersionFilter(examVersion)},
+		{"questionId", questionId},
+		{"userId", userId},
+	}
+
+	now := time.Now()
+
+	// times 遞增 1，答錯時連續答對的次數歸零
+	update := bson.D{
+		{"$inc", bson.D{
+			{"times", 1},
+		}},
+		{"$set", bson.D{
+			{"examVersion", examVersion},
+			{"correctStreak", 0},
+			{"updatedAt", now},
+		}},
+	}
+
+	// Enable update or insert
+	opts := options.Update().SetUpsert(true)
+
+	collection := repo.getCollection(ANSWER_WRONG_COLLECTION)
+	result, err := collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// 若是新增，補上新增日期時間
+	if result.UpsertedCount == 1 {
+		updateCreatedAt := bson.D{
+			{"$set", bson.D{
+				{"createdAt", now},
+			}},
+		}
+		_, err := collection.UpdateOne(ctx, filter, updateCreatedAt)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return int32(result.ModifiedCount), int32(result.UpsertedCount), nil
+}
+
+func (repo *MongoDBRepository) FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc(
+	ctx context.Context,
+	examId string,
+	examVersion int32,
+	userId string,
+	limit int32,
+) (answerWrongs []model.AnswerWrong, err error) {
+	collection := repo.getCollection(ANSWER_WRONG_COLLECTION)
+	filter := bson.D{
+		{"examId", examId},
+		{"examVersion", examVersionFilter(examVersion)},
+		{"userId", userId},
+	}
+	sort := bson.D{{"times", -1}} // descending
+	opts := options.Find().SetSort(sort).SetLimit(int64(limit))
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = cursor.All(ctx, &answerWrongs); err != nil {
+		return nil, err
+	}
+
+	return answerWrongs, nil
+}
+
+// 錯題本的查詢條件，examId 空字串表示全部測驗，日期為零值表示不限制
+func mistakeNotebookFilter(
+	userId, examId string,
+	startDate, endDate time.Time,
+) bson.D {
+	filter := bson.D{{"userId", userId}}
+
+	if examId != "" {
+		filter = append(filter, bson.E{"examId", examId})
+	}
+
+	updatedAt := bson.D{}
+
+	if !startDate.IsZero() {
+		updatedAt = append(updatedAt, bson.E{"$gte", startDate})
+	}
+
+	if !endDate.IsZero() {
+		updatedAt = append(updatedAt, bson.E{"$lt", endDate})
+	}
+
+	if len(updatedAt) > 0 {
+		filter = append(filter, bson.E{"updatedAt", updatedAt})
+	}
+
+	return filter
+}
+
+func (repo *MongoDBRepository) FindAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetweenOrderByUpdatedAtDesc(
+	ctx context.Context,
+	userId, examId string,
+	startDate, endDate time.Time,
+	skip, limit int32,
+) (answerWrongs []model.AnswerWrong, err error) {
+	collection := repo.getCollection(ANSWER_WRONG_COLLECTION)
+	filter := mistakeNotebookFilter(userId, examId, startDate, endDate)
+	opts := options.Find().SetSort(pageSort).SetSkip(int64(skip)).SetLimit(int64(limit))
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	answerWrongs = []model.AnswerWrong{}
+	if err = cursor.All(ctx, &answerWrongs); err != nil {
+		return nil, err
+	}
+
+	return answerWrongs, nil
+}
+
+func (repo *MongoDBRepository) CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween(
+	ctx context.Context,
+	userId, examId string,
+	startDate, endDate time.Time,
+) (count int64, err error) {
+	collection := repo.getCollection(ANSWER_WRONG_COLLECTION)
+	filter := mistakeNotebookFilter(userId, examId, startDate, endDate)
+	count, err = collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (repo *MongoDBRepository) FindRandomAnswerWrongsByUserIdAndExamId(
+	ctx context.Context,
+	userId, examId string,
+	size int32,
+) (answerWrongs []model.AnswerWrong, err error) {
+	collection := repo.getCollection(ANSWER_WRONG_COLLECTION)
+	pipeline := mongo.Pipeline{
+		bson.D{{"$match", mistakeNotebookFilter(userId, examId, time.Time{}, time.Time{})}},
+		bson.D{{"$sample", bson.D{{"size", size}}}},
+	}
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	answerWrongs = []model.AnswerWrong{}
+	if err = cursor.All(ctx, &answerWrongs); err != nil {
+		return nil, err
+	}
+
+	return answerWrongs, nil
+}
+
+func (repo *MongoDBRepository) GetAnswerWrongById(
+	ctx context.Context,
+	answerWrongId string,
+) (answerWrong *model.AnswerWrong, err error) {
+	id, err := primitive.ObjectIDFromHex(answerWrongId)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.D{{"_id", id}}
+	var result model.AnswerWrong
+	collection := repo.getCollection(ANSWER_WRONG_COLLECTION)
+	err = collection.FindOne(ctx, filter).Decode(&result)
+
+	if err != nil {
+		// 查無資料不視為錯誤
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+/*
+錯題練習答對時，連續答對的次數遞增 1，回傳遞增後的次數，
+不更新 updatedAt，因為 updatedAt 表示最後一次答錯的時間
+*/
+func (repo *MongoDBRepository) IncreaseAnswerWrongCorrectStreak(
+	ctx context.Context,
+	answerWrongId string,
+) (correctStreak int32, err error) {
+	id, err := primitive.ObjectIDFromHex(answerWrongId)
+	if err != nil {
+		return 0, err
+	}
+
+	filter := bson.D{{"_id", id}}
+	update := bson.D{{"$inc", bson.D{{"correctStreak", 1}}}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var result model.AnswerWrong
+	collection := repo.getCollection(ANSWER_WRONG_COLLECTION)
+	err = collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&result)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.CorrectStreak, nil
+}
+
+func (repo *MongoDBRepository) DeleteAnswerWrongById(
+	ctx context.Context,
+	answerWrongId string,
+) (deletedCount int32, err error) {
+	id, err := primitive.ObjectIDFromHex(answerWrongId)
+	if err != nil {
+		return 0, err
+	}
+
+	filter := bson.D{{"_id", id}}
+	collection := repo.getCollection(ANSWER_WRONG_COLLECTION)
+	result, err := collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(result.DeletedCount), nil
+}
+
+func (repo *MongoDBRepository) DeleteExamRecordsByExamId(
+	ctx context.Context,
+	examId string,
+) (deletedCount int32, err error) {
+	filter := bson.D{
+		{"examId", examId},
+	}
+	collection := repo.getCollection(EXAM_RECORD_COLLECTION)
+	result, err := collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(result.DeletedCount), nil
+}
+
+/*
+作答次數小於 maxAttempts 時加 1 並回傳 true，已達上限時回傳 false，
+還沒有計數器時以 initialCount（建立計數器之前的作答紀錄數）建立，
+以單一的條件 upsert 遞增或建立計數器，同時交卷也不會超過上限
+*/
+func (repo *MongoDBRepository) IncreaseExamAttemptCount(
+	ctx context.Context,
+	examId, userId string,
+	initialCount, maxAttempts int32,
+) (increased bool, err error) {
+	collection := repo.getCollection(EXAM_ATTEMPT_COUNT_COLLECTION)
+	filter := bson.D{
+		{"examId", examId},
+		{"userId", userId},
+		{"count", bson.D{{"$lt", maxAttempts}}},
+	}
+	now := time.Now()
+
+	// 建立計數器時 count 從 initialCount 開始遞增，已存在時從原本的 count 遞增
+	count := bson.D{{"$add", bson.A{
+		bson.D{{"$ifNull", bson.A{"$count", initialCount}}},
+		1,
+	}}}
+	createdAt := bson.D{{"$ifNull", bson.A{"$createdAt", now}}}
+	update := mongo.Pipeline{
+		{{"$set", bson.D{
+			{"count", count},
+			{"createdAt", createdAt},
+			{"updatedAt", now},
+		}}},
+	}
+
+	// 之前的作答次數已達上限時不能建立計數器，只遞增已存在的計數器
+	upsert := initialCount < maxAttempts
+	result, err := collection.UpdateOne(
+		ctx,
+		filter,
+		update,
+		options.Update().SetUpsert(upsert),
+	)
+
+	// 計數器已達上限時條件不符合，upsert 會因為唯一索引無法建立計數器
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount == 1 || result.UpsertedCount == 1, nil
+}
+
+func (repo *MongoDBRepository) DeleteExamAttemptCountsByExamId(
+	ctx context.Context,
+	examId string,
+) (deletedCount int32, err error) {
+	filter := bson.D{
+		{"examId", examId},
+	}
+	collection := repo.getCollection(EXAM_ATTEMPT_COUNT_COLLECTION)
+	result, err := collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(result.DeletedCount), nil
+}
+
+func (repo *MongoDBRepository) CreateExamAttempt(
+	ctx context.Context,
+	examAttempt model.ExamAttempt,
+) (examAttemptId string, err error) {
+	now := time.Now()
+	examAttempt.CreatedAt = now
+	examAttempt.UpdatedAt = now
+
+	collection := repo.getCollection(EXAM_ATTEMPT_COLLECTION)
+	result, err := collection.InsertOne(ctx, examAttempt)
+	if err != nil {
+		return "", err
+	}
+
+	examAttemptId = result.InsertedID.(primitive.ObjectID).Hex()
+	return examAttemptId, nil
+}
+
+func (repo *MongoDBRepository) GetExamAttemptById(
+	ctx context.Context,
+	examAttemptId string,
+) (examAttempt *model.ExamAttempt, err error) {
+	id, err := primitive.ObjectIDFromHex(examAttemptId)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.D{
+		{"_id", id},
+	}
+	var result model.ExamAttempt
+	collection := repo.getCollection(EXAM_ATTEMPT_COLLECTION)
+	err = collection.FindOne(ctx, filter).Decode(&result)
+
+	if err != nil {
+		// 查無資料不視為錯誤
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// 還沒有交卷時記錄查看提示的題目並回傳 true，已交卷時回傳 false，同一題重複查看只記錄一次
+func (repo *MongoDBRepository) AddExamAttemptHintQuestionId(
+	ctx context.Context,
+	examAttemptId, questionId string,
+) (added bool, err error) {
+	id, err := primitive.ObjectIDFromHex(examAttemptId)
+	if err != nil {
+		return false, err
+	}
+
+	filter := bson.D{
+		{"_id", id},
+		{"submittedAt", bson.D{{"$exists", false}}},
+	}
+	update := bson.D{
+		{"$addToSet", bson.D{{"hintQuestionIds", questionId}}},
+		{"$set", bson.D{{"updatedAt", time.Now()}}},
+	}
+	collection := repo.getCollection(EXAM_ATTEMPT_COLLECTION)
+	result, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount == 1, nil
+}
+
+// 還沒有交卷時記錄交卷時間並回傳 true，已交卷時回傳 false，以條件更新確保同一次作答只能交卷一次
+func (repo *MongoDBRepository) SubmitExamAttempt(
+	ctx context.Context,
+	examAttemptId string,
+) (submitted bool, err error) {
+	id, err := primitive.ObjectIDFromHex(examAttemptId)
+	if err != nil {
+		return false, err
+	}
+
+	filter := bson.D{
+		{"_id", id},
+		{"submittedAt", bson.D{{"$exists", false}}},
+	}
+	now := time.Now()
+	update := bson.D{{"$set", bson.D{
+		{"submittedAt", now},
+		{"updatedAt", now},
+	}}}
+	collection := repo.getCollection(EXAM_ATTEMPT_COLLECTION)
+	result, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount == 1, nil
+}
+
+func (repo *MongoDBRepository) DeleteExamAttemptsByExamId(
+	ctx context.Context,
+	examId string,
+) (deletedCount int32, err error) {
+	filter := bson.D{
+		{"examId", examId},
+	}
+	collection := repo.getCollection(EXAM_ATTEMPT_COLLECTION)
+	result, err := collection.DeleteMany(ctx, filter)
 	if err != nil {
 		return 0, err
 	}
@@ -379,192 +1922,623 @@ func (repo *MongoDBRepository) DeleteQuestionsByExamId(
 	return int32(result.DeletedCount), nil
 }
 
-func (repo *MongoDBRepository) CountQuestionsByExamId(
+func (repo *MongoDBRepository) CreateExamRecord(
+	ctx context.Context,
+	examRecord model.ExamRecord,
+) (examRecordId string, err error) {
+	now := time.Now()
+	examRecord.CreatedAt = now
+	examRecord.UpdatedAt = now
+
+	collection := repo.getCollection(EXAM_RECORD_COLLECTION)
+	result, err := collection.InsertOne(ctx, examRecord)
+	if err != nil {
+		return "", err
+	}
+
+	err = repo.increaseExamRecordStats(ctx, examRecord)
+	if err != nil {
+		return "", err
+	}
+
+	examRecordId = result.InsertedID.(primitive.ObjectID).Hex()
+	return examRecordId, nil
+}
+
+func (repo *MongoDBRepository) GetExamRecordById(
+	ctx context.Context,
+	examRecordId string,
+) (examRecord *model.ExamRecord, err error) {
+	id, err := primitive.ObjectIDFromHex(examRecordId)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.D{
+		{"_id", id},
+	}
+	var result model.ExamRecord
+	collection := repo.getCollection(EXAM_RECORD_COLLECTION)
+	err = collection.FindOne(ctx, filter).Decode(&result)
+
+	if err != nil {
+		// 查無資料不視為錯誤
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (repo *MongoDBRepository) FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc(
+	ctx context.Context,
+	examId, userId string,
+	skip, limit int32,
+) (examRecords []model.ExamRecord, err error) {
+	collection := repo.getCollection(EXAM_RECORD_COLLECTION)
+	filter := bson.D{
+		{"examId", examId},
+		{"userId", userId},
+	}
+	opts := options.Find().SetSort(pageSort).SetSkip(int64(skip)).SetLimit(int64(limit))
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = cursor.All(ctx, &examRecords); err != nil {
+		return nil, err
+	}
+
+	return examRecords, nil
+}
+
+func (repo *MongoDBRepository) FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc(
+	ctx context.Context,
+	examId, userId, cursor string,
+	limit int32,
+) (examRecords []model.ExamRecord, nextCursor string, err error) {
+	collection := repo.getCollection(EXAM_RECORD_COLLECTION)
+	filter := bson.D{
+		{"examId", examId},
+		{"userId", userId},
+	}
+	return findPageByCursor(
+		ctx,
+		collection,
+		filter,
+		cursor,
+		limit,
+		func(examRecord model.ExamRecord) (time.Time, primitive.ObjectID) {
+			return examRecord.UpdatedAt, examRecord.Id
+		},
+	)
+}
+
+func (repo *MongoDBRepository) CountExamRecordsByExamIdAndUserId(
+	ctx context.Context,
+	examId, userId string,
+) (count int32, err error) {
+	collection := repo.getCollection(EXAM_RECORD_COLLECTION)
+	filter := bson.D{
+		{"examId", examId},
+		{"userId", userId},
+	}
+	result, err := collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(result), nil
+}
+
+// 查詢 startAt（包含）到 endAt（不包含）之間的作答紀錄
+func (repo *MongoDBRepository) FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween(
+	ctx context.Context,
+	examId,
+	userId string,
+	startAt, endAt time.Time,
+) (examRecords []model.ExamRecord, err error) {
+	collection := repo.getCollection(EXAM_RECORD_COLLECTION)
+	filter := bson.D{
+		{"examId", examId},
+		{"userId", userId},
+		{"createdAt", bson.D{
+			{"$gte", primitive.NewDateTimeFromTime(startAt)},
+			{"$lt", primitive.NewDateTimeFromTime(endAt)},
+		}},
+	}
+	sort := bson.D{{"createdAt", 1}} // ascending
+	opts := options.Find().SetSort(sort)
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = cursor.All(ctx, &examRecords); err != nil {
+		return nil, err
+	}
+
+	return examRecords, nil
+}
+
+/*
+查詢測驗全部作答紀錄的摘要，在 MongoDB 彙總，不需要載入全部的作答紀錄，
+無法換算成百分比的舊作答紀錄不列入摘要
+*/
+func (repo *MongoDBRepository) FindExamStatisticsSummaryByExamId(
 	ctx context.Context,
 	examId string,
-) (count int32, err error) {
-	collection := repo.getCollection(QUESTION_COLLECTION)
+) (summary ExamStatisticsSummary, err error) {
+	summary = ExamStatisticsSummary{
+		PercentageDistribution: []PercentageCount{},
+	}
+
 	filter := bson.D{
 		{"examId", examId},
+		hasPercentageFilter(),
 	}
-	result, err := collection.CountDocuments(ctx, filter)
+	collection := repo.getCollection(EXAM_RECORD_COLLECTION)
+	count, err := collection.CountDocuments(ctx, filter)
 	if err != nil {
-		return 0, err
+		return ExamStatisticsSummary{}, err
 	}
 
-	return int32(result), nil
+	if count == 0 {
+		return summary, nil
+	}
+
+	// 中位數是依百分比排序後中間的一筆，偶數筆時是中間兩筆的平均
+	medianSkip := (count - 1) / 2
+	medianLimit := 2 - count%2
+
+	pipeline := mongo.Pipeline{
+		{{"$match", filter}},
+		{{"$set", bson.D{{"percentage", examRecordPercentageExpression()}}}},
+		{{"$facet", bson.D{
+			{"stats", bson.A{
+				bson.D{{"$group", bson.D{
+					{"_id", nil},
+					{"meanPercentage", bson.D{{"$avg", "$percentage"}}},
+				}}},
+			}},
+			{"users", bson.A{
+				bson.D{{"$group", bson.D{{"_id", "$userId"}}}},
+				bson.D{{"$count", "count"}},
+			}},
+			{"distribution", bson.A{
+				bson.D{{"$group", bson.D{
+					{"_id", bson.D{{"$toInt", bson.D{{"$multiply", bson.A{
+						bson.D{{"$floor", bson.D{{"$divide", bson.A{"$percentage", 10}}}}},
+						10,
+					}}}}}},
+					{"count", bson.D{{"$sum", 1}}},
+				}}},
+				bson.D{{"$sort", bson.D{{"_id", 1}}}},
+			}},
+			{"median", bson.A{
+				bson.D{{"$sort", bson.D{{"percentage", 1}, {"_id", 1}}}},
+				bson.D{{"$skip", medianSkip}},
+				bson.D{{"$limit", medianLimit}},
+				bson.D{{"$group", bson.D{
+					{"_id", nil},
+					{"medianPercentage", bson.D{{"$avg", "$percentage"}}},
+				}}},
+			}},
+		}}},
+	}
+	opts := options.Aggregate().SetAllowDiskUse(true)
+	cursor, err := collection.Aggregate(ctx, pipeline, opts)
+	if err != nil {
+		return ExamStatisticsSummary{}, err
+	}
+
+	results := []struct {
+		Stats []struct {
+			MeanPercentage float64 `bson:"meanPercentage"`
+		} `bson:"stats"`
+		Users []struct {
+			Count int32 `bson:"count"`
+		} `bson:"users"`
+		Distribution []PercentageCount `bson:"distribution"`
+		Median       []struct {
+			MedianPercentage float64 `bson:"medianPercentage"`
+		} `bson:"median"`
+	}{}
+
+	if err = cursor.All(ctx, &results); err != nil {
+		return ExamStatisticsSummary{}, err
+	}
+
+	result := results[0]
+	summary.AttemptCount = int32(count)
+
+	if len(result.Stats) > 0 {
+		summary.MeanPercentage = result.Stats[0].MeanPercentage
+	}
+
+	if len(result.Users) > 0 {
+		summary.UserCount = result.Users[0].Count
+	}
+
+	if len(result.Median) > 0 {
+		summary.MedianPercentage = result.Median[0].MedianPercentage
+	}
+
+	summary.PercentageDistribution = append(
+		summary.PercentageDistribution,
+		result.Distribution...,
+	)
+	return summary, nil
 }
 
-func (repo *MongoDBRepository) DeleteAnswerWrongsByQuestionId(
+/*
+查詢每個題目的作答次數與答錯次數，只使用有記錄出現題目的作答紀錄，在 MongoDB 彙總，
+作答紀錄依百分比由高到低排序，前後各 groupRatio 比例的作答紀錄分成高分組與低分組，兩組不能重疊
+*/
+func (repo *MongoDBRepository) FindQuestionAttemptCountsByExamId(
 	ctx context.Context,
-	questionId string,
-) (deletedCount int32, err error) {
+	examId string,
+	groupRatio float64,
+) (questionAttemptCounts []QuestionAttemptCount, err error) {
 	filter := bson.D{
-		{"questionId", questionId},
+		{"examId", examId},
+		{"questionIds.0", bson.D{{"$exists", true}}},
 	}
-	collection := repo.getCollection(ANSWER_WRONG_COLLECTION)
-	result, err := collection.DeleteMany(ctx, filter)
+	collection := repo.getCollection(EXAM_RECORD_COLLECTION)
+	count, err := collection.CountDocuments(ctx, filter)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	return int32(result.DeletedCount), nil
+	questionAttemptCounts = []QuestionAttemptCount{}
+
+	if count == 0 {
+		return questionAttemptCounts, nil
+	}
+
+	groupSize := int64(math.Ceil(float64(count) * groupRatio))
+	groupSize = min(groupSize, count/2)
+
+	countIf := func(condition interface{}) bson.D {
+		return bson.D{{"$sum", bson.D{{"$cond", bson.A{condition, 1, 0}}}}}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{"$match", filter}},
+		{{"$set", bson.D{{"percentage", examRecordPercentageExpression()}}}},
+		{{"$setWindowFields", bson.D{
+			{"sortBy", bson.D{{"percentage", -1}, {"createdAt", 1}, {"_id", 1}}},
+			{"output", bson.D{{"rank", bson.D{{"$documentNumber", bson.D{}}}}}},
+		}}},
+		{{"$project", bson.D{
+			{"questionIds", 1},
+			{"wrongQuestionIds", bson.D{{"$ifNull", bson.A{"$wrongQuestionIds", bson.A{}}}}},
+			{"isTop", bson.D{{"$lte", bson.A{"$rank", groupSize}}}},
+			{"isBottom", bson.D{{"$gt", bson.A{"$rank", count - groupSize}}}},
+		}}},
+		{{"$unwind", "$questionIds"}},
+		{{"$set", bson.D{
+			{"isWrong", bson.D{{"$in", bson.A{"$questionIds", "$wrongQuestionIds"}}}},
+		}}},
+		{{"$group", bson.D{
+			{"_id", "$questionIds"},
+			{"attemptCount", bson.D{{"$sum", 1}}},
+			{"wrongCount", countIf("$isWrong")},
+			{"topAttemptCount", countIf("$isTop")},
+			{"topWrongCount", countIf(bson.D{{"$and", bson.A{"$isTop", "$isWrong"}}})},
+			{"bottomAttemptCount", countIf("$isBottom")},
+			{"bottomWrongCount", countIf(bson.D{{"$and", bson.A{"$isBottom", "$isWrong"}}})},
+		}}},
+		{{"$sort", bson.D{{"_id", 1}}}},
+	}
+	opts := options.Aggregate().SetAllowDiskUse(true)
+	cursor, err := collection.Aggregate(ctx, pipeline, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = cursor.All(ctx, &questionAttemptCounts); err != nil {
+		return nil, err
+	}
+
+	return questionAttemptCounts, nil
 }
 
-func (repo *MongoDBRepository) DeleteAnswerWrongsByExamId(
+func (repo *MongoDBRepository) FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween(
 	ctx context.Context,
 	examId string,
-) (deletedCount int32, err error) {
-	filter := bson.D{
-		{"examId", examId},
+	userIds []string,
+	startAt, endAt time.Time,
+) (examRecordScores []ExamRecordScore, err error) {
+	pipeline := mongo.Pipeline{
+		{{"$match", bson.D{
+			{"examId", examId},
+			{"userId", bson.D{{"$in", userIds}}},
+			{"createdAt", bson.D{
+				{"$gte", primitive.NewDateTimeFromTime(startAt)},
+				{"$lte", primitive.NewDateTimeFromTime(endAt)},
+			}},
+		}}},
+		{{"$sort", bson.D{{"createdAt", 1}}}},
+		{{"$set", bson.D{{"percentage", examRecordPercentageExpression()}}}},
+		{{"$group", bson.D{
+			{"_id", "$userId"},
+			// $max 會略過 null，所以無法換算成百分比的舊作答紀錄不影響最高分
+			{"bestPercentage", bson.D{{"$max", "$percentage"}}},
+			{"percentages", bson.D{{"$push", "$percentage"}}},
+			{"recordCount", bson.D{{"$sum", 1}}},
+			{"latestCreatedAt", bson.D{{"$last", "$createdAt"}}},
+		}}},
+		// 最近一次分數取最後一筆可以換算成百分比的作答紀錄
+		{{"$set", bson.D{
+			{"latestPercentage", bson.D{{"$last", bson.D{{"$filter", bson.D{
+				{"input", "$percentages"},
+				{"cond", bson.D{{"$ne", bson.A{"$$this", nil}}}},
+			}}}}}},
+		}}},
+		{{"$sort", bson.D{{"_id", 1}}}},
 	}
-	collection := repo.getCollection(ANSWER_WRONG_COLLECTION)
-	result, err := collection.DeleteMany(ctx, filter)
+	collection := repo.getCollection(EXAM_RECORD_COLLECTION)
+	cursor, err := collection.Aggregate(ctx, pipeline)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	return int32(result.DeletedCount), nil
+	examRecordScores = []ExamRecordScore{}
+
+	if err = cursor.All(ctx, &examRecordScores); err != nil {
+		return nil, err
+	}
+
+	return examRecordScores, nil
 }
 
-func (repo *MongoDBRepository) UpsertAnswerWrongByTimesPlusOne(
+/*
+查詢排行榜，每個使用者只取期間內最佳的一筆紀錄，excludedUserIds 是不顯示在排行榜的使用者，
+依百分比分數排序，同分時作答時間較短的在前面，沒有記錄作答時間的排在最後，再相同時較早作答的在前面
+*/
+func (repo *MongoDBRepository) FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn(
 	ctx context.Context,
-	examId, questionId, userId string,
-) (modifiedCount, upsertedCount int32, err error) {
-	filter := bson.D{
+	examId string,
+	createdAt time.Time,
+	excludedUserIds []string,
+	limit int32,
+) (leaderboardEntries []LeaderboardEntry, err error) {
+	// 和作答紀錄的分析相同，無法換算成百分比的舊作答紀錄不列入排行榜
+	match := bson.D{
 		{"examId", examId},
-		{"questionId", questionId},
-		{"userId", userId},
+		{"createdAt", bson.D{{"$gte", primitive.NewDateTimeFromTime(createdAt)}}},
+		hasPercentageFilter(),
+	}
+
+	if len(excludedUserIds) > 0 {
+		match = append(match, bson.E{"userId", bson.D{{"$nin", excludedUserIds}}})
+	}
+
+	sort := bson.D{
+		{"percentage", -1},
+		{"score", -1},
+		{"sortDurationSeconds", 1},
+		{"createdAt", 1},
+	}
+	pipeline := mongo.Pipeline{
+		{{"$match", match}},
+		{{"$addFields", bson.D{
+			{"percentage", examRecordPercentageExpression()},
+			{"sortDurationSeconds", bson.D{{"$cond", bson.A{
+				bson.D{{"$gt", bson.A{"$durationSeconds", 0}}},
+				"$durationSeconds",
+				math.MaxInt32,
+			}}}},
+		}}},
+		{{"$sort", sort}},
+		{{"$group", bson.D{
+			{"_id", "$userId"},
+			{"score", bson.D{{"$first", "$score"}}},
+			{"percentage", bson.D{{"$first", "$percentage"}}},
+			{"durationSeconds", bson.D{{"$first", bson.D{
+				{"$ifNull", bson.A{"$durationSeconds", 0}},
+			}}}},
+			{"sortDurationSeconds", bson.D{{"$first", "$sortDurationSeconds"}}},
+			{"createdAt", bson.D{{"$first", "$createdAt"}}},
+		}}},
+		{{"$sort", append(sort, bson.E{"_id", 1})}},
+		{{"$limit", limit}},
+	}
+	collection := repo.getCollection(EXAM_RECORD_COLLECTION)
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	leaderboardEntries = []LeaderboardEntry{}
+
+	if err = cursor.All(ctx, &leaderboardEntries); err != nil {
+		return nil, err
 	}
 
+	return leaderboardEntries, nil
+}
+
+func (repo *MongoDBRepository) CreateClass(
+	ctx context.Context,
+	class model.Class,
+) (classId string, err error) {
 	now := time.Now()
+	class.CreatedAt = now
+	class.UpdatedAt = now
 
-	// times 遞增 1
-	update := bson.D{
-		{"$inc", bson.D{
-			{"times", 1},
-		}},
-		{"$set", bson.D{
-			{"updatedAt", now},
-		}},
+	// 避免存成 null，否則之後無法用 $push 加入學生
+	if class.Students == nil {
+		class.Students = []model.ClassStudent{}
 	}
 
-	// Enable update or insert
-	opts := options.Update().SetUpsert(true)
+	collection := repo.getCollection(CLASS_COLLECTION)
+	result, err := collection.InsertOne(ctx, class)
+	if err != nil {
+		return "", err
+	}
 
-	collection := repo.getCollection(ANSWER_WRONG_COLLECTION)
-	result, err := collection.UpdateOne(ctx, filter, update, opts)
+	classId = result.InsertedID.(primitive.ObjectID).Hex()
+	return classId, nil
+}
+
+func (repo *MongoDBRepository) GetClassById(
+	ctx context.Context,
+	classId string,
+) (class *model.Class, err error) {
+	id, err := primitive.ObjectIDFromHex(classId)
 	if err != nil {
-		return 0, 0, err
+		return nil, err
 	}
 
-	// 若是新增，補上新增日期時間
-	if result.UpsertedCount == 1 {
-		updateCreatedAt := bson.D{
-			{"$set", bson.D{
-				{"createdAt", now},
-			}},
-		}
-		_, err := collection.UpdateOne(ctx, filter, updateCreatedAt)
-		if err != nil {
-			return 0, 0, err
+	filter := bson.D{
+		{"_id", id},
+	}
+	return repo.findOneClass(ctx, filter)
+}
+
+func (repo *MongoDBRepository) GetClassByJoinCode(
+	ctx context.Context,
+	joinCode string,
+) (class *model.Class, err error) {
+	filter := bson.D{
+		{"joinCode", joinCode},
+	}
+	return repo.findOneClass(ctx, filter)
+}
+
+func (repo *MongoDBRepository) findOneClass(
+	ctx context.Context,
+	filter bson.D,
+) (class *model.Class, err error) {
+	var result model.Class
+	collection := repo.getCollection(CLASS_COLLECTION)
+	err = collection.FindOne(ctx, filter).Decode(&result)
+
+	if err != nil {
+		// 查無資料不視為錯誤
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
 		}
+
+		return nil, err
 	}
 
-	return int32(result.ModifiedCount), int32(result.UpsertedCount), nil
+	return &result, nil
 }
 
-func (repo *MongoDBRepository) FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc(
+// 查詢使用者是老師或學生的班級
+func (repo *MongoDBRepository) FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc(
 	ctx context.Context,
-	examId, userId string,
-	limit int32,
-) (answerWrongs []model.AnswerWrong, err error) {
-	collection := repo.getCollection(ANSWER_WRONG_COLLECTION)
+	userId string,
+) (classes []model.Class, err error) {
 	filter := bson.D{
-		{"examId", examId},
-		{"userId", userId},
+		{"$or", bson.A{
+			bson.D{{"userId", userId}},
+			bson.D{{"students.userId", userId}},
+		}},
 	}
-	sort := bson.D{{"times", -1}} // descending
-	opts := options.Find().SetSort(sort).SetLimit(int64(limit))
+	collection := repo.getCollection(CLASS_COLLECTION)
+	opts := options.Find().SetSort(pageSort)
 	cursor, err := collection.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = cursor.All(ctx, &answerWrongs); err != nil {
+	classes = []model.Class{}
+
+	if err = cursor.All(ctx, &classes); err != nil {
 		return nil, err
 	}
 
-	return answerWrongs, nil
+	return classes, nil
 }
 
-func (repo *MongoDBRepository) DeleteExamRecordsByExamId(
+// 學生已經在班級中時不會重複加入，modifiedCount 為 0
+func (repo *MongoDBRepository) AddClassStudent(
 	ctx context.Context,
-	examId string,
-) (deletedCount int32, err error) {
+	classId string,
+	student model.ClassStudent,
+) (modifiedCount int32, err error) {
+	id, err := primitive.ObjectIDFromHex(classId)
+	if err != nil {
+		return 0, err
+	}
+
 	filter := bson.D{
-		{"examId", examId},
+		{"_id", id},
+		{"students.userId", bson.D{{"$ne", student.UserId}}},
 	}
-	collection := repo.getCollection(EXAM_RECORD_COLLECTION)
-	result, err := collection.DeleteMany(ctx, filter)
+	update := bson.D{
+		{"$push", bson.D{{"students", student}}},
+		{"$set", bson.D{{"updatedAt", time.Now()}}},
+	}
+	collection := repo.getCollection(CLASS_COLLECTION)
+	result, err := collection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return 0, err
 	}
 
-	return int32(result.DeletedCount), nil
+	return int32(result.ModifiedCount), nil
 }
 
-func (repo *MongoDBRepository) CreateExamRecord(
+func (repo *MongoDBRepository) CreateAssignment(
 	ctx context.Context,
-	examRecord model.ExamRecord,
-) (examRecordId string, err error) {
+	assignment model.Assignment,
+) (assignmentId string, err error) {
 	now := time.Now()
-	examRecord.CreatedAt = now
-	examRecord.UpdatedAt = now
+	assignment.CreatedAt = now
+	assignment.UpdatedAt = now
 
-	collection := repo.getCollection(EXAM_RECORD_COLLECTION)
-	result, err := collection.InsertOne(ctx, examRecord)
+	collection := repo.getCollection(ASSIGNMENT_COLLECTION)
+	result, err := collection.InsertOne(ctx, assignment)
 	if err != nil {
 		return "", err
 	}
 
-	examRecordId = result.InsertedID.(primitive.ObjectID).Hex()
-	return examRecordId, nil
+	assignmentId = result.InsertedID.(primitive.ObjectID).Hex()
+	return assignmentId, nil
 }
 
-func (repo *MongoDBRepository) FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc(
+func (repo *MongoDBRepository) FindAssignmentsByClassIdOrderByDueAtAsc(
 	ctx context.Context,
-	examId, userId string,
-	skip, limit int32,
-) (examRecords []model.ExamRecord, err error) {
-	collection := repo.getCollection(EXAM_RECORD_COLLECTION)
+	classId string,
+) (assignments []model.Assignment, err error) {
 	filter := bson.D{
-		{"examId", examId},
-		{"userId", userId},
+		{"classId", classId},
 	}
-	sort := bson.D{{"updatedAt", -1}} // descending
-	opts := options.Find().SetSort(sort).SetSkip(int64(skip)).SetLimit(int64(limit))
+	sort := bson.D{{"dueAt", 1}, {"_id", 1}} // ascending
+	opts := options.Find().SetSort(sort)
+	collection := repo.getCollection(ASSIGNMENT_COLLECTION)
 	cursor, err := collection.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = cursor.All(ctx, &examRecords); err != nil {
+	assignments = []model.Assignment{}
+
+	if err = cursor.All(ctx, &assignments); err != nil {
 		return nil, err
 	}
 
-	return examRecords, nil
+	return assignments, nil
 }
 
-func (repo *MongoDBRepository) CountExamRecordsByExamIdAndUserId(
+func (repo *MongoDBRepository) CountAssignmentsByClassIdsAndExamId(
 	ctx context.Context,
-	examId, userId string,
+	classIds []string,
+	examId string,
 ) (count int32, err error) {
-	collection := repo.getCollection(EXAM_RECORD_COLLECTION)
 	filter := bson.D{
+		{"classId", bson.D{{"$in", classIds}}},
 		{"examId", examId},
-		{"userId", userId},
 	}
+	collection := repo.getCollection(ASSIGNMENT_COLLECTION)
 	result, err := collection.CountDocuments(ctx, filter)
 	if err != nil {
 		return 0, err
@@ -573,30 +2547,20 @@ func (repo *MongoDBRepository) CountExamRecordsByExamIdAndUserId(
 	return int32(result), nil
 }
 
-func (repo *MongoDBRepository) FindExamRecordsByExamIdAndUserIdAndCreatedAt(
+func (repo *MongoDBRepository) DeleteAssignmentsByExamId(
 	ctx context.Context,
-	examId,
-	userId string,
-	createdAt time.Time,
-) (examRecords []model.ExamRecord, err error) {
-	collection := repo.getCollection(EXAM_RECORD_COLLECTION)
+	examId string,
+) (deletedCount int32, err error) {
 	filter := bson.D{
 		{"examId", examId},
-		{"userId", userId},
-		{"createdAt", bson.D{{"$gte", primitive.NewDateTimeFromTime(createdAt)}}},
 	}
-	sort := bson.D{{"createdAt", 1}} // ascending
-	opts := options.Find().SetSort(sort)
-	cursor, err := collection.Find(ctx, filter, opts)
+	collection := repo.getCollection(ASSIGNMENT_COLLECTION)
+	result, err := collection.DeleteMany(ctx, filter)
 	if err != nil {
-		return nil, err
-	}
-
-	if err = cursor.All(ctx, &examRecords); err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	return examRecords, nil
+	return int32(result.DeletedCount), nil
 }
 
 func (repo *MongoDBRepository) WithTransaction(
@@ -635,3 +2599,40 @@ func (repo *MongoDBRepository) WithTransaction(
 func (repo *MongoDBRepository) getCollection(collectionName string) *mongo.Collection {
 	return repo.client.Database(repo.database).Collection(collectionName)
 }
+
+/*
+只修改版本號與讀取時相同的資料，
+版本號 0 也要符合還沒有版本號欄位的舊資料
+*/
+func versionFilter(id primitive.ObjectID, version int32) bson.D {
+	if version == 0 {
+		return bson.D{
+			{"_id", id},
+			{"version", bson.D{{"$in", bson.A{0, nil}}}},
+		}
+	}
+
+	return bson.D{
+		{"_id", id},
+		{"version", version},
+	}
+}
+
+// 條件式修改沒有符合的資料時，資料仍存在表示版本號已被修改，否則回傳 notFoundError
+func versionMismatchError(
+	ctx context.Context,
+	collection *mongo.Collection,
+	id primitive.ObjectID,
+	notFoundError error,
+) error {
+	count, err := collection.CountDocuments(ctx, bson.D{{"_id", id}})
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return VersionConflictError
+	}
+
+	return notFoundError
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -30,6 +33,7 @@ type MyTestSuite struct {
 	questionCollection    *mongo.Collection
 	answerWrongCollection *mongo.Collection
 	examRecordCollection  *mongo.Collection
+	examVersionCollection *mongo.Collection
 }
 
 func TestMyTestSuite(t *testing.T) {
@@ -75,6 +79,7 @@ func (s *MyTestSuite) SetupSuite() {
 	s.questionCollection = client.Database(DATABASE).Collection("questions")
 	s.answerWrongCollection = s.client.Database(DATABASE).Collection("answerwrongs")
 	s.examRecordCollection = s.client.Database(DATABASE).Collection("examrecords")
+	s.examVersionCollection = s.client.Database(DATABASE).Collection("examversions")
 }
 
 // run once, after test suite methods
@@ -271,6 +276,73 @@ func (s *MyTestSuite) TestUpdateExam() {
 				}
 			},
 		},
+		{
+			name: "Update exam shares",
+			setupDB: func(s *MyTestSuite) *setupDBResult {
+				exam := model.Exam{
+					Topic:       "topic03",
+					Description: "jsut for test",
+					Tags:        []string{},
+					IsPublic:    false,
+					UserId:      "user05",
+				}
+				result, err := s.examCollection.InsertOne(ctx, exam)
+				s.Nil(err)
+
+				exam.Id = result.InsertedID.(primitive.ObjectID)
+
+				return &setupDBResult{
+					exam: &exam,
+				}
+			},
+			newArgs: func(dbResult setupDBResult) *args {
+				exam := dbResult.exam
+				exam.Shares = []model.ExamShare{
+					{
+						UserId: "user06",
+						Role:   "editor",
+					},
+				}
+				return &args{
+					ctx:  ctx,
+					exam: *exam,
+				}
+			},
+		},
+		{
+			name: "Update exam settings",
+			setupDB: func(s *MyTestSuite) *setupDBResult {
+				exam := model.Exam{
+					Topic:       "topic04",
+					Description: "jsut for test",
+					Tags:        []string{},
+					IsPublic:    true,
+					UserId:      "user07",
+				}
+				result, err := s.examCollection.InsertOne(ctx, exam)
+				s.Nil(err)
+
+				exam.Id = result.InsertedID.(primitive.ObjectID)
+
+				return &setupDBResult{
+					exam: &exam,
+				}
+			},
+			newArgs: func(dbResult setupDBResult) *args {
+				exam := dbResult.exam
+				exam.Settings = &model.ExamSettings{
+					QuestionsPerAttempt:    20,
+					ShuffleQuestions:       true,
+					PassingScorePercent:    60,
+					MaxAttempts:            3,
+					ShowAnswersAfterSubmit: true,
+				}
+				return &args{
+					ctx:  ctx,
+					exam: *exam,
+				}
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -284,10 +356,51 @@ func (s *MyTestSuite) TestUpdateExam() {
 				args.exam,
 			)
 			s.Nil(err)
+
+			exam, err := s.repo.GetExamById(args.ctx, args.exam.Id.Hex())
+			s.Nil(err)
+			s.Equal(args.exam.IsPublic, exam.IsPublic)
+			s.Equal(len(args.exam.Shares), len(exam.Shares))
+			s.Equal(args.exam.Settings, exam.Settings)
+			s.Equal(args.exam.Version+1, exam.Version)
 		})
 	}
 }
 
+func (s *MyTestSuite) TestUpdateExam_WhenVersionConflicts() {
+	ctx := context.Background()
+	exam := model.Exam{
+		Topic:       "topic01",
+		Description: "jsut for test",
+		Tags:        []string{},
+		UserId:      "user01",
+	}
+	result, err := s.examCollection.InsertOne(ctx, exam)
+	s.Nil(err)
+
+	exam.Id = result.InsertedID.(primitive.ObjectID)
+
+	// 第一次修改成功，版本號變成 1
+	exam.Topic = "topic01-updated01"
+	err = s.repo.UpdateExam(ctx, exam)
+	s.Nil(err)
+
+	// 使用舊的版本號修改
+	exam.Topic = "topic01-updated02"
+	err = s.repo.UpdateExam(ctx, exam)
+	s.Equal(VersionConflictError, err)
+
+	// 使用最新的版本號修改
+	exam.Version = 1
+	err = s.repo.UpdateExam(ctx, exam)
+	s.Nil(err)
+
+	findExam, err := s.repo.GetExamById(ctx, exam.Id.Hex())
+	s.Nil(err)
+	s.Equal("topic01-updated02", findExam.Topic)
+	s.Equal(int32(2), findExam.Version)
+}
+
 func (s *MyTestSuite) TestGetExamById() {
 	type args struct {
 		ctx    context.Context
@@ -477,7 +590,7 @@ func (s *MyTestSuite) TestFindExamsByUserIdOrderByUpdateAtDesc() {
 	}
 }
 
-func (s *MyTestSuite) TestFindExamsByUserIdAndIsPublicOrderByUpdateAtDesc() {
+func (s *MyTestSuite) TestFindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc() {
 	type args struct {
 		ctx      context.Context
 		userId   string
@@ -573,10 +686,11 @@ func (s *MyTestSuite) TestFindExamsByUserIdAndIsPublicOrderByUpdateAtDesc() {
 			args := tc.newArgs(*tc.setupDB(s))
 
 			// Test
-			exams, err := s.repo.FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc(
+			exams, err := s.repo.FindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc(
 				args.ctx,
 				args.userId,
 				args.isPublic,
+				nil,
 			)
 			s.Nil(err)
 			s.Len(exams, tc.expectedLength)
@@ -584,6 +698,467 @@ func (s *MyTestSuite) TestFindExamsByUserIdAndIsPublicOrderByUpdateAtDesc() {
 	}
 }
 
+func (s *MyTestSuite) TestFindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc_WhenTagsIsSet() {
+	ctx := context.Background()
+	userId := "tagUser01"
+	_, err := s.examCollection.InsertMany(ctx, []interface{}{
+		model.Exam{Topic: "topic01", Tags: []string{"toeic", "grammar"}, IsPublic: true, UserId: userId},
+		model.Exam{Topic: "topic02", Tags: []string{"toeic"}, IsPublic: true, UserId: userId},
+		model.Exam{Topic: "topic03", Tags: []string{}, IsPublic: true, UserId: userId},
+	})
+	s.Nil(err)
+
+	// Test
+	exams, err := s.repo.FindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc(
+		ctx,
+		userId,
+		true,
+		[]string{"toeic", "grammar"},
+	)
+	s.Nil(err)
+	s.Len(exams, 1)
+	s.Equal("topic01", exams[0].Topic)
+}
+
+func (s *MyTestSuite) TestFindExamsByTagsOrderByUpdateAtDesc() {
+	ctx := context.Background()
+	tag := "tag-TestFindExamsByTags"
+	_, err := s.examCollection.InsertMany(ctx, []interface{}{
+		model.Exam{Topic: "topic01", Tags: []string{tag}, IsPublic: true, UserId: "tagUser02"},
+		model.Exam{Topic: "topic02", Tags: []string{tag}, IsPublic: false, UserId: "tagUser02"},
+		model.Exam{Topic: "topic03", Tags: []string{tag}, IsPublic: false, UserId: "tagUser03"},
+	})
+	s.Nil(err)
+
+	// Test
+	exams, err := s.repo.FindExamsByTagsOrderByUpdateAtDesc(ctx, []string{tag}, "", 0, 10)
+	s.Nil(err)
+	s.Len(exams, 1)
+
+	count, err := s.repo.CountExamsByTags(ctx, []string{tag}, "")
+	s.Nil(err)
+	s.EqualValues(1, count)
+
+	// 登入者可以看到公開的測驗和自己的測驗
+	exams, err = s.repo.FindExamsByTagsOrderByUpdateAtDesc(ctx, []string{tag}, "tagUser02", 0, 10)
+	s.Nil(err)
+	s.Len(exams, 2)
+
+	count, err = s.repo.CountExamsByTags(ctx, []string{tag}, "tagUser02")
+	s.Nil(err)
+	s.EqualValues(2, count)
+}
+
+func (s *MyTestSuite) TestFindExamTagCounts() {
+	ctx := context.Background()
+	userId := "tagUser04"
+	_, err := s.examCollection.InsertMany(ctx, []interface{}{
+		model.Exam{Topic: "topic01", Tags: []string{"zz-tag01", "zz-tag02"}, IsPublic: false, UserId: userId},
+		model.Exam{Topic: "topic02", Tags: []string{"zz-tag01"}, IsPublic: false, UserId: userId},
+	})
+	s.Nil(err)
+
+	// Test
+	tagCounts, err := s.repo.FindExamTagCounts(ctx, userId, 1000)
+	s.Nil(err)
+	s.Contains(tagCounts, TagCount{Tag: "zz-tag01", Count: 2})
+	s.Contains(tagCounts, TagCount{Tag: "zz-tag02", Count: 1})
+
+	// 未登入時只統計公開的測驗
+	tagCounts, err = s.repo.FindExamTagCounts(ctx, "", 1000)
+	s.Nil(err)
+	s.NotContains(tagCounts, TagCount{Tag: "zz-tag02", Count: 1})
+}
+
+/*
+新增測驗和指定數量的題目與作答紀錄，回傳測驗 id，
+題目和作答紀錄透過 repo 新增，才會更新測驗的統計欄位，每筆作答紀錄的滿分都是 10 分
+*/
+func (s *MyTestSuite) insertExamWithStats(
+	ctx context.Context,
+	exam model.Exam,
+	questionCount int,
+	scores []int32,
+	recordUserId string,
+) string {
+	result, err := s.examCollection.InsertOne(ctx, exam)
+	s.Nil(err)
+
+	examId := result.InsertedID.(primitive.ObjectID).Hex()
+
+	for i := 0; i < questionCount; i++ {
+		_, err = s.repo.CreateQuestion(ctx, model.Question{
+			ExamId: examId,
+			Ask:    fmt.Sprintf("ask%d", i),
+			UserId: exam.UserId,
+		})
+		s.Nil(err)
+	}
+
+	for _, score := range scores {
+		_, err = s.repo.CreateExamRecord(ctx, model.ExamRecord{
+			ExamId:       examId,
+			Score:        score,
+			EarnedPoints: float64(score),
+			MaxPoints:    10,
+			Percentage:   float64(score) * 10,
+			UserId:       recordUserId,
+		})
+		s.Nil(err)
+	}
+
+	return examId
+}
+
+// 查詢測驗上的統計欄位
+func (s *MyTestSuite) findExamStats(ctx context.Context, examId string) bson.M {
+	id, err := primitive.ObjectIDFromHex(examId)
+	s.Nil(err)
+
+	var exam bson.M
+	err = s.examCollection.FindOne(ctx, bson.D{{"_id", id}}).Decode(&exam)
+	s.Nil(err)
+	return exam
+}
+
+func (s *MyTestSuite) TestFindQuestionsByExamId() {
+	ctx := context.Background()
+	examId := primitive.NewObjectID().Hex()
+	_, err := s.questionCollection.InsertMany(ctx, []interface{}{
+		model.Question{ExamId: examId, Ask: "ask01", Answers: []string{"a01"}, UserId: "user01"},
+		model.Question{ExamId: examId, Ask: "ask02", Answers: []string{"a02"}, UserId: "user01"},
+		model.Question{ExamId: "otherExam", Ask: "ask03", Answers: []string{"a03"}, UserId: "user01"},
+	})
+	s.Nil(err)
+
+	// Test
+	questions, err := s.repo.FindQuestionsByExamId(ctx, examId)
+	s.Nil(err)
+	s.Len(questions, 2)
+	s.Equal("ask01", questions[0].Ask)
+	s.Equal("ask02", questions[1].Ask)
+}
+
+func (s *MyTestSuite) TestFindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc() {
+	ctx := context.Background()
+	userId := "summaryUser01"
+	now := time.Now()
+	examId01 := s.insertExamWithStats(ctx, model.Exam{
+		Topic:     "topic01",
+		Tags:      []string{},
+		IsPublic:  true,
+		UserId:    userId,
+		UpdatedAt: now,
+	}, 3, []int32{1, 2}, userId)
+
+	// 其他使用者的作答紀錄不列入計算
+	_, err := s.examRecordCollection.InsertOne(ctx, model.ExamRecord{
+		ExamId: examId01,
+		Score:  5,
+		UserId: "summaryUser02",
+	})
+	s.Nil(err)
+
+	// 沒有題目的測驗不回傳
+	s.insertExamWithStats(ctx, model.Exam{
+		Topic:     "topic02",
+		Tags:      []string{},
+		IsPublic:  true,
+		UserId:    userId,
+		UpdatedAt: now.Add(time.Minute),
+	}, 0, nil, userId)
+
+	// Test
+	examSummaries, err := s.repo.FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc(
+		ctx,
+		userId,
+		true,
+		nil,
+	)
+	s.Nil(err)
+	s.Len(examSummaries, 1)
+	s.Equal(examId01, examSummaries[0].Id.Hex())
+	s.EqualValues(3, examSummaries[0].QuestionCount)
+	s.EqualValues(2, examSummaries[0].RecordCount)
+}
+
+func (s *MyTestSuite) TestFindPublicExamSummaries() {
+	ctx := context.Background()
+	tag := "tag-TestFindPublicExamSummaries"
+	now := time.Now()
+	examId01 := s.insertExamWithStats(ctx, model.Exam{
+		Topic:       "Catalog Topic01",
+		Description: "desc01",
+		Tags:        []string{tag},
+		IsPublic:    true,
+		UserId:      "catalogUser01",
+		UpdatedAt:   now,
+	}, 2, []int32{2, 2, 2}, "catalogUser02")
+	examId02 := s.insertExamWithStats(ctx, model.Exam{
+		Topic:       "topic02",
+		Description: "多益 catalog 練習",
+		Tags:        []string{tag},
+		IsPublic:    true,
+		UserId:      "catalogUser01",
+		UpdatedAt:   now.Add(time.Minute),
+	}, 2, []int32{9}, "catalogUser02")
+
+	// 非公開的測驗不回傳
+	s.insertExamWithStats(ctx, model.Exam{
+		Topic:     "catalog topic03",
+		Tags:      []string{tag},
+		IsPublic:  false,
+		UserId:    "catalogUser01",
+		UpdatedAt: now,
+	}, 2, nil, "")
+
+	testCases := []struct {
+		name            string
+		keyword         string
+		sortBy          string
+		skip            int32
+		limit           int32
+		expectedTotal   int32
+		expectedExamIds []string
+	}{
+		{
+			name:            "Sort by newest",
+			sortBy:          EXAM_CATALOG_SORT_NEWEST,
+			limit:           10,
+			expectedTotal:   2,
+			expectedExamIds: []string{examId02, examId01},
+		},
+		{
+			name:            "Sort by most attempted",
+			sortBy:          EXAM_CATALOG_SORT_MOST_ATTEMPTED,
+			limit:           10,
+			expectedTotal:   2,
+			expectedExamIds: []string{examId01, examId02},
+		},
+		{
+			name:            "Sort by highest scoring",
+			sortBy:          EXAM_CATALOG_SORT_HIGHEST_SCORING,
+			limit:           10,
+			expectedTotal:   2,
+			expectedExamIds: []string{examId02, examId01},
+		},
+		{
+			name:            "Search topic and description",
+			keyword:         "CATALOG",
+			sortBy:          EXAM_CATALOG_SORT_NEWEST,
+			limit:           10,
+			expectedTotal:   2,
+			expectedExamIds: []string{examId02, examId01},
+		},
+		{
+			name:            "Search chinese",
+			keyword:         "多益",
+			sortBy:          EXAM_CATALOG_SORT_NEWEST,
+			limit:           10,
+			expectedTotal:   1,
+			expectedExamIds: []string{examId02},
+		},
+		{
+			name:            "Paginate",
+			sortBy:          EXAM_CATALOG_SORT_NEWEST,
+			skip:            1,
+			limit:           1,
+			expectedTotal:   2,
+			expectedExamIds: []string{examId01},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Test
+			total, examSummaries, err := s.repo.FindPublicExamSummaries(
+				ctx,
+				tc.keyword,
+				[]string{tag},
+				tc.sortBy,
+				tc.skip,
+				tc.limit,
+			)
+			s.Nil(err)
+			s.Equal(tc.expectedTotal, total)
+
+			examIds := []string{}
+			for _, examSummary := range examSummaries {
+				examIds = append(examIds, examSummary.Id.Hex())
+			}
+			s.Equal(tc.expectedExamIds, examIds)
+		})
+	}
+
+	// 統計欄位
+	_, examSummaries, err := s.repo.FindPublicExamSummaries(
+		ctx,
+		"",
+		[]string{tag},
+		EXAM_CATALOG_SORT_NEWEST,
+		0,
+		10,
+	)
+	s.Nil(err)
+	s.Len(examSummaries, 2)
+	s.EqualValues(2, examSummaries[1].QuestionCount)
+	s.EqualValues(3, examSummaries[1].RecordCount)
+	s.EqualValues(20, examSummaries[1].AveragePercentage)
+}
+
+func (s *MyTestSuite) TestRefreshExamQuestionCounts() {
+	ctx := context.Background()
+	examId := s.insertExamWithStats(ctx, model.Exam{
+		Topic:  "topic01",
+		Tags:   []string{},
+		UserId: "statsUser01",
+	}, 0, nil, "")
+
+	questionIds, err := s.repo.CreateQuestions(ctx, []model.Question{
+		{ExamId: examId, Ask: "ask01", UserId: "statsUser01"},
+		{ExamId: examId, Ask: "ask02", UserId: "statsUser01"},
+		{ExamId: examId, Ask: "ask03", UserId: "statsUser01"},
+	})
+	s.Nil(err)
+	s.EqualValues(3, s.findExamStats(ctx, examId)["questionCount"])
+
+	deletedCount, err := s.repo.DeleteQuestionById(ctx, questionIds[0])
+	s.Nil(err)
+	s.EqualValues(1, deletedCount)
+	s.EqualValues(2, s.findExamStats(ctx, examId)["questionCount"])
+
+	// 已刪除的題目
+	deletedCount, err = s.repo.DeleteQuestionById(ctx, questionIds[0])
+	s.Nil(err)
+	s.EqualValues(0, deletedCount)
+
+	deletedCount, err = s.repo.DeleteQuestionsByIds(ctx, questionIds[1:2])
+	s.Nil(err)
+	s.EqualValues(1, deletedCount)
+	s.EqualValues(1, s.findExamStats(ctx, examId)["questionCount"])
+
+	deletedCount, err = s.repo.DeleteQuestionsByExamId(ctx, examId)
+	s.Nil(err)
+	s.EqualValues(1, deletedCount)
+	s.EqualValues(0, s.findExamStats(ctx, examId)["questionCount"])
+}
+
+func (s *MyTestSuite) TestMigrateExamStats() {
+	ctx := context.Background()
+
+	// 沒有統計欄位的舊測驗
+	result, err := s.examCollection.InsertOne(ctx, model.Exam{
+		Topic:  "topic01",
+		Tags:   []string{},
+		UserId: "migrateUser01",
+	})
+	s.Nil(err)
+
+	examId := result.InsertedID.(primitive.ObjectID).Hex()
+	_, err = s.questionCollection.InsertMany(ctx, []interface{}{
+		model.Question{ExamId: examId, Ask: "ask01", UserId: "migrateUser01"},
+		model.Question{ExamId: examId, Ask: "ask02", UserId: "migrateUser01"},
+	})
+	s.Nil(err)
+
+	_, err = s.examRecordCollection.InsertMany(ctx, []interface{}{
+		// 有配分資料的作答紀錄
+		model.ExamRecord{
+			ExamId:     examId,
+			Score:      1,
+			MaxPoints:  4,
+			Percentage: 50,
+			UserId:     "migrateUser02",
+		},
+		// 沒有配分資料，以答對題數佔題數的比例換算
+		model.ExamRecord{
+			ExamId:      examId,
+			Score:       1,
+			QuestionIds: []string{"q01", "q02", "q03", "q04"},
+			UserId:      "migrateUser02",
+		},
+		// 連題目都沒有記錄，不列入平均
+		model.ExamRecord{
+			ExamId: examId,
+			Score:  10,
+			UserId: "migrateUser02",
+		},
+	})
+	s.Nil(err)
+
+	// Test
+	err = s.repo.(*MongoDBRepository).migrateExamStats(ctx)
+	s.Nil(err)
+
+	exam := s.findExamStats(ctx, examId)
+	s.EqualValues(2, exam["questionCount"])
+	s.EqualValues(3, exam["recordCount"])
+	s.EqualValues(2, exam["percentageRecordCount"])
+	s.EqualValues(37.5, exam["averagePercentage"])
+}
+
+func (s *MyTestSuite) TestFindExamsByUserIdAndCursorOrderByUpdateAtDesc() {
+	ctx := context.Background()
+	userId := "cursorUser01"
+	size := 13
+	now := time.Now()
+	documents := []interface{}{}
+
+	// updatedAt 都相同，由 _id 決定順序
+	for i := 0; i < size; i++ {
+		documents = append(documents, model.Exam{
+			Topic:       fmt.Sprintf("topic_%d", i),
+			Description: "jsut for test",
+			Tags:        []string{"tag01", "tag02"},
+			IsPublic:    true,
+			UserId:      userId,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		})
+	}
+	_, err := s.examCollection.InsertMany(ctx, documents)
+	s.Nil(err)
+
+	// 依 cursor 逐頁查詢，資料不可重複或遺漏
+	ids := map[primitive.ObjectID]bool{}
+	cursor := ""
+	pageCount := 0
+
+	for pageCount < size {
+		exams, nextCursor, err := s.repo.FindExamsByUserIdAndCursorOrderByUpdateAtDesc(
+			ctx,
+			userId,
+			cursor,
+			5,
+		)
+		s.Nil(err)
+		pageCount++
+
+		for _, item := range exams {
+			s.False(ids[item.Id])
+			ids[item.Id] = true
+		}
+
+		if nextCursor == "" {
+			break
+		}
+
+		cursor = nextCursor
+	}
+
+	s.Len(ids, size)
+	s.Equal(3, pageCount)
+
+	// 不合法的 cursor
+	_, _, err = s.repo.FindExamsByUserIdAndCursorOrderByUpdateAtDesc(
+		ctx,
+		userId,
+		"invalid cursor",
+		5,
+	)
+	s.NotNil(err)
+}
+
 func (s *MyTestSuite) TestDeleteExamById() {
 	type args struct {
 		ctx    context.Context
@@ -832,6 +1407,36 @@ func (s *MyTestSuite) TestCreateQuestion() {
 	}
 }
 
+func (s *MyTestSuite) TestCreateQuestions() {
+	ctx := context.Background()
+	examId := "TestCreateQuestionsExam01"
+
+	// Test
+	questionIds, err := s.repo.CreateQuestions(ctx, []model.Question{
+		{
+			ExamId:        examId,
+			Ask:           "q01",
+			Answers:       []string{"a01"},
+			UserId:        "TestCreateQuestions01",
+			WordMeaningId: "wm01",
+		},
+		{
+			ExamId:        examId,
+			Ask:           "q02",
+			Answers:       []string{"a02"},
+			UserId:        "TestCreateQuestions01",
+			WordMeaningId: "wm02",
+		},
+	})
+	s.Nil(err)
+	s.Len(questionIds, 2)
+
+	question, err := s.repo.GetQuestionById(ctx, questionIds[1])
+	s.Nil(err)
+	s.Equal("wm02", question.WordMeaningId)
+	s.False(question.CreatedAt.IsZero())
+}
+
 func (s *MyTestSuite) TestUpdateQuestion() {
 	type args struct {
 		ctx      context.Context
@@ -903,6 +1508,36 @@ func (s *MyTestSuite) TestUpdateQuestion() {
 				}
 			},
 		},
+		{
+			name: "Update question points and hint",
+			setupDB: func(s *MyTestSuite) *setupDBResult {
+				question := model.Question{
+					ExamId:  "exam01",
+					Ask:     "TestUpdateQuestion03",
+					Answers: []string{"a01", "a02"},
+					UserId:  "user01",
+				}
+				result, err := s.questionCollection.InsertOne(ctx, question)
+				s.Nil(err)
+
+				question.Id = result.InsertedID.(primitive.ObjectID)
+
+				return &setupDBResult{
+					question: &question,
+				}
+			},
+			newArgs: func(dbResult setupDBResult) *args {
+				question := dbResult.question
+				question.Points = 3
+				question.PartialCredit = true
+				question.Hint = "hint03"
+				question.Explanation = "explanation03"
+				return &args{
+					ctx:      ctx,
+					question: *question,
+				}
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -920,6 +1555,33 @@ func (s *MyTestSuite) TestUpdateQuestion() {
 	}
 }
 
+func (s *MyTestSuite) TestUpdateQuestion_WhenVersionConflicts() {
+	ctx := context.Background()
+	question := model.Question{
+		ExamId:  "exam01",
+		Ask:     "TestUpdateQuestion01",
+		Answers: []string{"a01"},
+		UserId:  "user01",
+	}
+	result, err := s.questionCollection.InsertOne(ctx, question)
+	s.Nil(err)
+
+	question.Id = result.InsertedID.(primitive.ObjectID)
+
+	err = s.repo.UpdateQuestion(ctx, question)
+	s.Nil(err)
+
+	// 使用舊的版本號修改
+	err = s.repo.UpdateQuestion(ctx, question)
+	s.Equal(VersionConflictError, err)
+
+	// 題目不存在時不是版本衝突
+	question.Id = primitive.NewObjectID()
+	err = s.repo.UpdateQuestion(ctx, question)
+	s.NotNil(err)
+	s.NotEqual(VersionConflictError, err)
+}
+
 func (s *MyTestSuite) TestGetQuestionById() {
 	type args struct {
 		ctx        context.Context
@@ -1215,6 +1877,67 @@ func (s *MyTestSuite) TestFindQuestionsByExamIdOrderByUpdateAtDesc() {
 	}
 }
 
+func (s *MyTestSuite) TestFindQuestionsByExamIdAndCursorOrderByUpdateAtDesc() {
+	ctx := context.Background()
+	examId := primitive.NewObjectID().Hex()
+	size := 13
+	now := time.Now()
+	documents := []interface{}{}
+
+	// updatedAt 都相同，由 _id 決定順序
+	for i := 0; i < size; i++ {
+		documents = append(documents, model.Question{
+			ExamId:    examId,
+			Ask:       fmt.Sprintf("ask_%d", i),
+			Answers:   []string{"a01"},
+			UserId:    "cursorUser01",
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+	}
+	_, err := s.questionCollection.InsertMany(ctx, documents)
+	s.Nil(err)
+
+	// 依 cursor 逐頁查詢，資料不可重複或遺漏
+	ids := map[primitive.ObjectID]bool{}
+	cursor := ""
+	pageCount := 0
+
+	for pageCount < size {
+		questions, nextCursor, err := s.repo.FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc(
+			ctx,
+			examId,
+			cursor,
+			5,
+		)
+		s.Nil(err)
+		pageCount++
+
+		for _, item := range questions {
+			s.False(ids[item.Id])
+			ids[item.Id] = true
+		}
+
+		if nextCursor == "" {
+			break
+		}
+
+		cursor = nextCursor
+	}
+
+	s.Len(ids, size)
+	s.Equal(3, pageCount)
+
+	// 不合法的 cursor
+	_, _, err = s.repo.FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc(
+		ctx,
+		examId,
+		"invalid cursor",
+		5,
+	)
+	s.NotNil(err)
+}
+
 func (s *MyTestSuite) TestDeleteQuestionById() {
 	type args struct {
 		ctx        context.Context
@@ -1390,9 +2113,42 @@ func (s *MyTestSuite) TestDeleteQuestionsByExamId() {
 	}
 }
 
-func (s *MyTestSuite) TestCountQuestionsByExamId() {
-	type args struct {
-		ctx    context.Context
+func (s *MyTestSuite) TestDeleteQuestionsByIds() {
+	ctx := context.Background()
+	examId := "TestDeleteQuestionsByIds"
+	questions := []interface{}{}
+
+	for i := 0; i < 5; i++ {
+		questions = append(questions, model.Question{
+			ExamId:  examId,
+			Ask:     fmt.Sprintf("Question_%d", i),
+			Answers: []string{"a01", "a02"},
+			UserId:  "user01",
+		})
+	}
+
+	result, err := s.questionCollection.InsertMany(ctx, questions)
+	s.Nil(err)
+
+	questionIds := []string{}
+
+	for _, insertedId := range result.InsertedIDs[:3] {
+		questionIds = append(questionIds, insertedId.(primitive.ObjectID).Hex())
+	}
+
+	// Test
+	deletedCount, err := s.repo.DeleteQuestionsByIds(ctx, questionIds)
+	s.Nil(err)
+	s.Equal(int32(3), deletedCount)
+
+	count, err := s.questionCollection.CountDocuments(ctx, bson.D{{"examId", examId}})
+	s.Nil(err)
+	s.Equal(int64(2), count)
+}
+
+func (s *MyTestSuite) TestCountQuestionsByExamId() {
+	type args struct {
+		ctx    context.Context
 		examId string
 	}
 
@@ -1488,6 +2244,90 @@ func (s *MyTestSuite) TestCountQuestionsByExamId() {
 	}
 }
 
+func (s *MyTestSuite) TestCreateExamVersionAndGetExamVersionByExamIdAndVersion() {
+	ctx := context.Background()
+	examId := "TestCreateExamVersionAndGetExamVersionByExamIdAndVersion"
+	examVersion := model.ExamVersion{
+		ExamId:      examId,
+		Version:     1,
+		Topic:       "topic01",
+		Description: "desc01",
+		Questions: []model.ExamVersionQuestion{
+			{
+				QuestionId: "question01",
+				Ask:        "ask01",
+				Answers:    []string{"a01"},
+			},
+		},
+		UserId: "user01",
+	}
+
+	// Test
+	examVersionId, err := s.repo.CreateExamVersion(ctx, examVersion)
+	s.Nil(err)
+	s.NotEmpty(examVersionId)
+
+	// 同一個測驗不能有重複的版本
+	_, err = s.repo.CreateExamVersion(ctx, examVersion)
+	s.NotNil(err)
+
+	result, err := s.repo.GetExamVersionByExamIdAndVersion(ctx, examId, 1)
+	s.Nil(err)
+	s.NotNil(result)
+	s.Equal(examVersionId, result.Id.Hex())
+	s.Equal(examVersion.Questions, result.Questions)
+
+	result, err = s.repo.GetExamVersionByExamIdAndVersion(ctx, examId, 2)
+	s.Nil(err)
+	s.Nil(result)
+}
+
+func (s *MyTestSuite) TestFindExamVersionSummariesByExamIdOrderByVersionDesc() {
+	ctx := context.Background()
+	examId := "TestFindExamVersionSummariesByExamIdOrderByVersionDesc"
+
+	for i := 1; i <= 3; i++ {
+		questions := []model.ExamVersionQuestion{}
+
+		for j := 0; j < i; j++ {
+			questions = append(questions, model.ExamVersionQuestion{
+				QuestionId: fmt.Sprintf("question%d", j+1),
+				Ask:        fmt.Sprintf("ask%d", j+1),
+				Answers:    []string{"a01"},
+			})
+		}
+
+		_, err := s.repo.CreateExamVersion(ctx, model.ExamVersion{
+			ExamId:    examId,
+			Version:   int32(i),
+			Questions: questions,
+			UserId:    "user01",
+		})
+		s.Nil(err)
+	}
+
+	// Test
+	examVersionSummaries, err := s.repo.FindExamVersionSummariesByExamIdOrderByVersionDesc(
+		ctx,
+		examId,
+	)
+	s.Nil(err)
+	s.Len(examVersionSummaries, 3)
+
+	for i, examVersionSummary := range examVersionSummaries {
+		s.Equal(int32(3-i), examVersionSummary.Version)
+		s.Equal(int32(3-i), examVersionSummary.QuestionCount)
+	}
+
+	deletedCount, err := s.repo.DeleteExamVersionsByExamId(ctx, examId)
+	s.Nil(err)
+	s.Equal(int32(3), deletedCount)
+
+	count, err := s.examVersionCollection.CountDocuments(ctx, bson.D{{"examId", examId}})
+	s.Nil(err)
+	s.Equal(int64(0), count)
+}
+
 func (s *MyTestSuite) TestDeleteAnswerWrongsByQuestionId() {
 	type args struct {
 		ctx        context.Context
@@ -1586,6 +2426,43 @@ func (s *MyTestSuite) TestDeleteAnswerWrongsByQuestionId() {
 	}
 }
 
+func (s *MyTestSuite) TestDeleteAnswerWrongsByQuestionIds() {
+	ctx := context.Background()
+	examId := "TestDeleteAnswerWrongsByQuestionIds"
+	questionIds := []string{"question01", "question02", "question03"}
+	documents := []interface{}{}
+
+	for _, questionId := range questionIds {
+		documents = append(documents, model.AnswerWrong{
+			ExamId:     examId,
+			QuestionId: questionId,
+			Times:      1,
+			UserId:     "user01",
+		})
+	}
+
+	// 已發佈版本的答錯紀錄不會被刪除
+	documents = append(documents, model.AnswerWrong{
+		ExamId:      examId,
+		ExamVersion: 1,
+		QuestionId:  questionIds[0],
+		Times:       1,
+		UserId:      "user01",
+	})
+
+	_, err := s.answerWrongCollection.InsertMany(ctx, documents)
+	s.Nil(err)
+
+	// Test
+	deletedCount, err := s.repo.DeleteAnswerWrongsByQuestionIds(ctx, questionIds[:2])
+	s.Nil(err)
+	s.Equal(int32(2), deletedCount)
+
+	count, err := s.answerWrongCollection.CountDocuments(ctx, bson.D{{"examId", examId}})
+	s.Nil(err)
+	s.Equal(int64(2), count)
+}
+
 func (s *MyTestSuite) TestDeleteAnswerWrongsByExamId() {
 	type args struct {
 		ctx    context.Context
@@ -1682,10 +2559,11 @@ func (s *MyTestSuite) TestDeleteAnswerWrongsByExamId() {
 
 func (s *MyTestSuite) TestUpsertAnswerWrongByTimesPlusOne() {
 	type args struct {
-		ctx        context.Context
-		examId     string
-		questionId string
-		userId     string
+		ctx         context.Context
+		examId      string
+		examVersion int32
+		questionId  string
+		userId      string
 	}
 
 	type setupDBResult struct{}
@@ -1739,6 +2617,25 @@ func (s *MyTestSuite) TestUpsertAnswerWrongByTimesPlusOne() {
 				upsertedCount: 0,
 			},
 		},
+		{
+			name: "Upsert(create) answerWrong of other exam version by times plus one",
+			setupDB: func(s *MyTestSuite) *setupDBResult {
+				return &setupDBResult{}
+			},
+			newArgs: func(dbResult setupDBResult) *args {
+				return &args{
+					ctx:         ctx,
+					examId:      "TestUpsertAnswerWrongByTimesPlusOne",
+					examVersion: 1,
+					questionId:  "TestUpsertAnswerWrongByTimesPlusOne_q01",
+					userId:      "TestUpsertAnswerWrongByTimesPlusOne_u01",
+				}
+			},
+			expected: result{
+				modifiedCount: 0,
+				upsertedCount: 1,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1750,6 +2647,7 @@ func (s *MyTestSuite) TestUpsertAnswerWrongByTimesPlusOne() {
 			modifiedCount, upsertedCount, err := s.repo.UpsertAnswerWrongByTimesPlusOne(
 				args.ctx,
 				args.examId,
+				args.examVersion,
 				args.questionId,
 				args.userId,
 			)
@@ -1760,12 +2658,13 @@ func (s *MyTestSuite) TestUpsertAnswerWrongByTimesPlusOne() {
 	}
 }
 
-func (s *MyTestSuite) TestFindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc() {
+func (s *MyTestSuite) TestFindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc() {
 	type args struct {
-		ctx    context.Context
-		examId string
-		userId string
-		size   int32
+		ctx         context.Context
+		examId      string
+		examVersion int32
+		userId      string
+		size        int32
 	}
 
 	type setupDBResult struct {
@@ -1831,6 +2730,15 @@ func (s *MyTestSuite) TestFindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc() {
 						UserId:     userId,
 					})
 				}
+
+				// 其他版本的答錯紀錄不會被查出
+				documents = append(documents, model.AnswerWrong{
+					ExamId:      examId,
+					ExamVersion: 1,
+					QuestionId:  "question1",
+					Times:       10,
+					UserId:      userId,
+				})
 				_, err := s.answerWrongCollection.InsertMany(ctx, documents)
 				s.Nil(err)
 
@@ -1857,9 +2765,10 @@ func (s *MyTestSuite) TestFindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc() {
 			args := tc.newArgs(*tc.setupDB(s))
 
 			// Test
-			answerWrongs, err := s.repo.FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc(
+			answerWrongs, err := s.repo.FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc(
 				args.ctx,
 				args.examId,
+				args.examVersion,
 				args.userId,
 				args.size,
 			)
@@ -2139,6 +3048,69 @@ func (s *MyTestSuite) TestFindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc()
 	}
 }
 
+func (s *MyTestSuite) TestFindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc() {
+	ctx := context.Background()
+	examId := primitive.NewObjectID().Hex()
+	userId := "cursorUser01"
+	size := 13
+	now := time.Now()
+	documents := []interface{}{}
+
+	// updatedAt 都相同，由 _id 決定順序
+	for i := 0; i < size; i++ {
+		documents = append(documents, model.ExamRecord{
+			ExamId:    examId,
+			Score:     int32(i),
+			UserId:    userId,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+	}
+	_, err := s.examRecordCollection.InsertMany(ctx, documents)
+	s.Nil(err)
+
+	// 依 cursor 逐頁查詢，資料不可重複或遺漏
+	ids := map[primitive.ObjectID]bool{}
+	cursor := ""
+	pageCount := 0
+
+	for pageCount < size {
+		examRecords, nextCursor, err := s.repo.FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc(
+			ctx,
+			examId,
+			userId,
+			cursor,
+			5,
+		)
+		s.Nil(err)
+		pageCount++
+
+		for _, item := range examRecords {
+			s.False(ids[item.Id])
+			ids[item.Id] = true
+		}
+
+		if nextCursor == "" {
+			break
+		}
+
+		cursor = nextCursor
+	}
+
+	s.Len(ids, size)
+	s.Equal(3, pageCount)
+
+	// 不合法的 cursor
+	_, _, err = s.repo.FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc(
+		ctx,
+		examId,
+		userId,
+		"invalid cursor",
+		5,
+	)
+	s.NotNil(err)
+}
+
 func (s *MyTestSuite) TestCountExamRecordsByExamIdAndUserId() {
 	type args struct {
 		ctx    context.Context
@@ -2242,12 +3214,13 @@ func (s *MyTestSuite) TestCountExamRecordsByExamIdAndUserId() {
 	}
 }
 
-func (s *MyTestSuite) TestFindExamRecordsByExamIdAndUserIdAndCreatedAt() {
+func (s *MyTestSuite) TestFindExamRecordsByExamIdAndUserIdAndCreatedAtBetween() {
 	type args struct {
-		ctx       context.Context
-		examId    string
-		userId    string
-		createdAt time.Time
+		ctx     context.Context
+		examId  string
+		userId  string
+		startAt time.Time
+		endAt   time.Time
 	}
 
 	type setupDBResult struct {
@@ -2266,7 +3239,7 @@ func (s *MyTestSuite) TestFindExamRecordsByExamIdAndUserIdAndCreatedAt() {
 		{
 			name: "Find examRecords01",
 			setupDB: func(s *MyTestSuite) *setupDBResult {
-				examId := "TestFindExamRecordsByExamIdAndUserIdAndCreatedAt01"
+				examId := "TestFindExamRecordsByExamIdAndUserIdAndCreatedAtBetween01"
 				userId := "user01"
 				documents := []interface{}{}
 				size := 10
@@ -2291,9 +3264,10 @@ func (s *MyTestSuite) TestFindExamRecordsByExamIdAndUserIdAndCreatedAt() {
 			},
 			newArgs: func(dbResult setupDBResult) *args {
 				return &args{
-					examId:    dbResult.examId,
-					userId:    dbResult.userId,
-					createdAt: time.Now().AddDate(0, 0, -29),
+					examId:  dbResult.examId,
+					userId:  dbResult.userId,
+					startAt: time.Now().AddDate(0, 0, -29),
+					endAt:   time.Now().AddDate(0, 0, 1),
 				}
 			},
 			expectedLength: 10,
@@ -2301,7 +3275,7 @@ func (s *MyTestSuite) TestFindExamRecordsByExamIdAndUserIdAndCreatedAt() {
 		{
 			name: "Find examRecords02",
 			setupDB: func(s *MyTestSuite) *setupDBResult {
-				examId := "TestFindExamRecordsByExamIdAndUserIdAndCreatedAt02"
+				examId := "TestFindExamRecordsByExamIdAndUserIdAndCreatedAtBetween02"
 				userId := "user02"
 				documents := []interface{}{}
 				size := 3
@@ -2316,6 +3290,18 @@ func (s *MyTestSuite) TestFindExamRecordsByExamIdAndUserIdAndCreatedAt() {
 						UpdatedAt: now,
 					})
 				}
+
+				// 期間之外的作答紀錄
+				for _, createdAt := range []time.Time{now.AddDate(0, 0, -30), now.AddDate(0, 0, 2)} {
+					documents = append(documents, model.ExamRecord{
+						ExamId:    examId,
+						Score:     10,
+						UserId:    userId,
+						CreatedAt: createdAt,
+						UpdatedAt: createdAt,
+					})
+				}
+
 				_, err := s.examRecordCollection.InsertMany(ctx, documents)
 				s.Nil(err)
 
@@ -2326,9 +3312,10 @@ func (s *MyTestSuite) TestFindExamRecordsByExamIdAndUserIdAndCreatedAt() {
 			},
 			newArgs: func(dbResult setupDBResult) *args {
 				return &args{
-					examId:    dbResult.examId,
-					userId:    dbResult.userId,
-					createdAt: time.Now().AddDate(0, 0, -29),
+					examId:  dbResult.examId,
+					userId:  dbResult.userId,
+					startAt: time.Now().AddDate(0, 0, -29),
+					endAt:   time.Now().AddDate(0, 0, 1),
 				}
 			},
 			expectedLength: 3,
@@ -2341,14 +3328,824 @@ func (s *MyTestSuite) TestFindExamRecordsByExamIdAndUserIdAndCreatedAt() {
 			args := tc.newArgs(*tc.setupDB(s))
 
 			// Test
-			examRecords, err := s.repo.FindExamRecordsByExamIdAndUserIdAndCreatedAt(
+			examRecords, err := s.repo.FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween(
 				args.ctx,
 				args.examId,
 				args.userId,
-				args.createdAt,
+				args.startAt,
+				args.endAt,
 			)
 			s.Nil(err)
 			s.Len(examRecords, tc.expectedLength)
 		})
 	}
 }
+
+func (s *MyTestSuite) TestFindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween() {
+	ctx := context.Background()
+	examId := "TestFindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween"
+	now := time.Now()
+	documents := []interface{}{
+		// 題數較多，分數較高但百分比較低
+		model.ExamRecord{
+			ExamId:     examId,
+			Score:      12,
+			MaxPoints:  20,
+			Percentage: 60,
+			UserId:     "user01",
+			CreatedAt:  now.Add(-4 * time.Hour),
+			UpdatedAt:  now.Add(-4 * time.Hour),
+		},
+		// 沒有配分資料的舊作答紀錄，以答對題數佔題數的比例換算
+		model.ExamRecord{
+			ExamId:      examId,
+			Score:       9,
+			QuestionIds: []string{"q01", "q02", "q03", "q04", "q05", "q06", "q07", "q08", "q09", "q10"},
+			UserId:      "user01",
+			CreatedAt:   now.Add(-3 * time.Hour),
+			UpdatedAt:   now.Add(-3 * time.Hour),
+		},
+		model.ExamRecord{
+			ExamId:     examId,
+			Score:      7,
+			MaxPoints:  10,
+			Percentage: 70,
+			UserId:     "user01",
+			CreatedAt:  now.Add(-2 * time.Hour),
+			UpdatedAt:  now.Add(-2 * time.Hour),
+		},
+		// 無法換算成百分比的舊作答紀錄，只計入作答次數
+		model.ExamRecord{
+			ExamId:    examId,
+			Score:     10,
+			UserId:    "user01",
+			CreatedAt: now.Add(-time.Hour),
+			UpdatedAt: now.Add(-time.Hour),
+		},
+		// 超過截止時間，不計算
+		model.ExamRecord{
+			ExamId:    examId,
+			Score:     10,
+			UserId:    "user01",
+			CreatedAt: now.Add(time.Hour),
+			UpdatedAt: now.Add(time.Hour),
+		},
+		model.ExamRecord{
+			ExamId:     examId,
+			Score:      5,
+			MaxPoints:  10,
+			Percentage: 50,
+			UserId:     "user02",
+			CreatedAt:  now.Add(-time.Hour),
+			UpdatedAt:  now.Add(-time.Hour),
+		},
+		// 不在查詢的使用者之中
+		model.ExamRecord{
+			ExamId:    examId,
+			Score:     8,
+			UserId:    "user03",
+			CreatedAt: now.Add(-time.Hour),
+			UpdatedAt: now.Add(-time.Hour),
+		},
+	}
+	_, err := s.examRecordCollection.InsertMany(ctx, documents)
+	s.Nil(err)
+
+	// Test
+	examRecordScores, err := s.repo.FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween(
+		ctx,
+		examId,
+		[]string{"user01", "user02"},
+		now.Add(-5*time.Hour),
+		now,
+	)
+	s.Nil(err)
+	s.Len(examRecordScores, 2)
+
+	s.Equal("user01", examRecordScores[0].UserId)
+	s.Equal(90.0, examRecordScores[0].BestPercentage)
+	s.Equal(70.0, examRecordScores[0].LatestPercentage)
+	s.Equal(int32(4), examRecordScores[0].RecordCount)
+
+	s.Equal("user02", examRecordScores[1].UserId)
+	s.Equal(50.0, examRecordScores[1].BestPercentage)
+	s.Equal(50.0, examRecordScores[1].LatestPercentage)
+	s.Equal(int32(1), examRecordScores[1].RecordCount)
+
+	_, err = s.repo.DeleteExamRecordsByExamId(ctx, examId)
+	s.Nil(err)
+}
+
+func (s *MyTestSuite) TestClass() {
+	ctx := context.Background()
+	teacherId := "TestClassTeacher"
+	joinCode := "TESTCLS1"
+
+	classId, err := s.repo.CreateClass(ctx, model.Class{
+		Name:     "Class A",
+		JoinCode: joinCode,
+		UserId:   teacherId,
+	})
+	s.Nil(err)
+
+	// 加入代碼不能重複
+	_, err = s.repo.CreateClass(ctx, model.Class{
+		Name:     "Class B",
+		JoinCode: joinCode,
+		UserId:   teacherId,
+	})
+	s.NotNil(err)
+
+	class, err := s.repo.GetClassByJoinCode(ctx, joinCode)
+	s.Nil(err)
+	s.Equal(classId, class.Id.Hex())
+	s.Empty(class.Students)
+
+	modifiedCount, err := s.repo.AddClassStudent(ctx, classId, model.ClassStudent{
+		UserId:   "TestClassStudent",
+		JoinedAt: time.Now(),
+	})
+	s.Nil(err)
+	s.Equal(int32(1), modifiedCount)
+
+	// 已經加入的學生不會重複加入
+	modifiedCount, err = s.repo.AddClassStudent(ctx, classId, model.ClassStudent{
+		UserId:   "TestClassStudent",
+		JoinedAt: time.Now(),
+	})
+	s.Nil(err)
+	s.Equal(int32(0), modifiedCount)
+
+	class, err = s.repo.GetClassById(ctx, classId)
+	s.Nil(err)
+	s.Len(class.Students, 1)
+
+	// 老師和學生都查得到班級
+	for _, userId := range []string{teacherId, "TestClassStudent"} {
+		classes, err := s.repo.FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc(ctx, userId)
+		s.Nil(err)
+		s.Len(classes, 1)
+	}
+
+	class, err = s.repo.GetClassByJoinCode(ctx, "NOTFOUND")
+	s.Nil(err)
+	s.Nil(class)
+}
+
+func (s *MyTestSuite) TestAssignment() {
+	ctx := context.Background()
+	classId := "TestAssignmentClass"
+	examId := "TestAssignmentExam"
+	now := time.Now()
+
+	for i := 2; i >= 1; i-- {
+		_, err := s.repo.CreateAssignment(ctx, model.Assignment{
+			ClassId: classId,
+			ExamId:  examId,
+			Title:   fmt.Sprintf("homework%d", i),
+			DueAt:   now.AddDate(0, 0, i),
+			UserId:  "teacher01",
+		})
+		s.Nil(err)
+	}
+
+	// Test
+	assignments, err := s.repo.FindAssignmentsByClassIdOrderByDueAtAsc(ctx, classId)
+	s.Nil(err)
+	s.Len(assignments, 2)
+	s.Equal("homework1", assignments[0].Title)
+	s.Equal("homework2", assignments[1].Title)
+
+	count, err := s.repo.CountAssignmentsByClassIdsAndExamId(ctx, []string{classId}, examId)
+	s.Nil(err)
+	s.Equal(int32(2), count)
+
+	count, err = s.repo.CountAssignmentsByClassIdsAndExamId(ctx, []string{"other"}, examId)
+	s.Nil(err)
+	s.Equal(int32(0), count)
+
+	deletedCount, err := s.repo.DeleteAssignmentsByExamId(ctx, examId)
+	s.Nil(err)
+	s.Equal(int32(2), deletedCount)
+}
+
+func (s *MyTestSuite) TestExamSection() {
+	ctx := context.Background()
+	examId := "TestExamSectionExam"
+
+	for i := 2; i >= 1; i-- {
+		_, err := s.repo.CreateExamSection(ctx, model.ExamSection{
+			ExamId:  examId,
+			Type:    "reading",
+			Title:   fmt.Sprintf("section%d", i),
+			Passage: fmt.Sprintf("passage%d", i),
+			Order:   int32(i),
+			UserId:  "user01",
+		})
+		s.Nil(err)
+	}
+
+	// Test
+	sections, err := s.repo.FindExamSectionsByExamIdOrderByOrderAsc(ctx, examId)
+	s.Nil(err)
+	s.Len(sections, 2)
+	s.Equal("section1", sections[0].Title)
+	s.Equal("section2", sections[1].Title)
+
+	section := sections[0]
+	section.Type = "listening"
+	section.Passage = ""
+	section.AudioUrl = "https://example.com/audio01.mp3"
+	err = s.repo.UpdateExamSection(ctx, section)
+	s.Nil(err)
+
+	result, err := s.repo.GetExamSectionById(ctx, section.Id.Hex())
+	s.Nil(err)
+	s.Equal("listening", result.Type)
+	s.Equal("", result.Passage)
+	s.Equal(section.AudioUrl, result.AudioUrl)
+
+	// 刪除題組後，題組的題目改為獨立的題目
+	questionId, err := s.repo.CreateQuestion(ctx, model.Question{
+		ExamId:    examId,
+		Ask:       "ask01",
+		Answers:   []string{"a01"},
+		UserId:    "user01",
+		SectionId: section.Id.Hex(),
+	})
+	s.Nil(err)
+
+	deletedCount, err := s.repo.DeleteExamSectionById(ctx, section.Id.Hex())
+	s.Nil(err)
+	s.Equal(int32(1), deletedCount)
+
+	modifiedCount, err := s.repo.UnsetQuestionsSectionIdBySectionId(ctx, section.Id.Hex())
+	s.Nil(err)
+	s.Equal(int32(1), modifiedCount)
+
+	question, err := s.repo.GetQuestionById(ctx, questionId)
+	s.Nil(err)
+	s.Equal("", question.SectionId)
+
+	result, err = s.repo.GetExamSectionById(ctx, section.Id.Hex())
+	s.Nil(err)
+	s.Nil(result)
+
+	deletedCount, err = s.repo.DeleteExamSectionsByExamId(ctx, examId)
+	s.Nil(err)
+	s.Equal(int32(1), deletedCount)
+}
+
+func (s *MyTestSuite) TestBankQuestion() {
+	ctx := context.Background()
+	userId := "TestBankQuestionUser"
+	bankQuestionIds := []string{}
+
+	for i := 1; i <= 3; i++ {
+		tags := []string{"grammar"}
+
+		if i == 3 {
+			tags = []string{"vocabulary"}
+		}
+
+		bankQuestionId, err := s.repo.CreateBankQuestion(ctx, model.BankQuestion{
+			Ask:     fmt.Sprintf("ask%d", i),
+			Answers: []string{fmt.Sprintf("a%d", i)},
+			Tags:    tags,
+			UserId:  userId,
+		})
+		s.Nil(err)
+		bankQuestionIds = append(bankQuestionIds, bankQuestionId)
+	}
+
+	// Test
+	bankQuestions, err := s.repo.FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc(
+		ctx,
+		userId,
+		"grammar",
+		0,
+		10,
+	)
+	s.Nil(err)
+	s.Len(bankQuestions, 2)
+	s.Equal("ask2", bankQuestions[0].Ask)
+
+	count, err := s.repo.CountBankQuestionsByUserIdAndTag(ctx, userId, "")
+	s.Nil(err)
+	s.Equal(int32(3), count)
+
+	bankQuestions, err = s.repo.FindRandomBankQuestionsByUserIdAndTag(ctx, userId, "grammar", 1)
+	s.Nil(err)
+	s.Len(bankQuestions, 1)
+	s.Equal([]string{"grammar"}, bankQuestions[0].Tags)
+
+	bankQuestion, err := s.repo.GetBankQuestionById(ctx, bankQuestionIds[0])
+	s.Nil(err)
+	bankQuestion.Ask = "ask01"
+	bankQuestion.Tags = []string{"vocabulary"}
+	err = s.repo.UpdateBankQuestion(ctx, *bankQuestion)
+	s.Nil(err)
+
+	bankQuestions, err = s.repo.FindBankQuestionsByIds(ctx, bankQuestionIds[:1])
+	s.Nil(err)
+	s.Len(bankQuestions, 1)
+	s.Equal("ask01", bankQuestions[0].Ask)
+	s.Equal([]string{"vocabulary"}, bankQuestions[0].Tags)
+
+	for _, bankQuestionId := range bankQuestionIds {
+		deletedCount, err := s.repo.DeleteBankQuestionById(ctx, bankQuestionId)
+		s.Nil(err)
+		s.Equal(int32(1), deletedCount)
+	}
+
+	bankQuestion, err = s.repo.GetBankQuestionById(ctx, bankQuestionIds[0])
+	s.Nil(err)
+	s.Nil(bankQuestion)
+}
+
+func (s *MyTestSuite) TestFindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn() {
+	ctx := context.Background()
+	examId := "TestFindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn"
+	now := time.Now()
+	newExamRecord := func(
+		userId string,
+		score, durationSeconds int32,
+		createdAt time.Time,
+	) model.ExamRecord {
+		return model.ExamRecord{
+			ExamId:          examId,
+			Score:           score,
+			EarnedPoints:    float64(score),
+			MaxPoints:       10,
+			Percentage:      float64(score) * 10,
+			DurationSeconds: durationSeconds,
+			UserId:          userId,
+			CreatedAt:       createdAt,
+			UpdatedAt:       createdAt,
+		}
+	}
+	documents := []interface{}{
+		// user01 最佳紀錄是 9 分 80 秒
+		newExamRecord("user01", 8, 30, now.Add(-4*time.Hour)),
+		newExamRecord("user01", 9, 100, now.Add(-3*time.Hour)),
+		newExamRecord("user01", 9, 80, now.Add(-2*time.Hour)),
+		// 同分時作答時間較短的排在前面
+		newExamRecord("user02", 9, 60, now.Add(-time.Hour)),
+		// 沒有記錄作答時間的排在最後
+		newExamRecord("user03", 9, 0, now.Add(-5*time.Hour)),
+		newExamRecord("user04", 10, 200, now.AddDate(0, 0, -30)),
+		// 沒有配分資料的舊作答紀錄，以答對題數佔題數的比例換算成 95%
+		model.ExamRecord{
+			ExamId:          examId,
+			Score:           19,
+			QuestionIds:     make([]string, 20),
+			DurationSeconds: 300,
+			UserId:          "user05",
+			CreatedAt:       now.Add(-30 * time.Minute),
+			UpdatedAt:       now.Add(-30 * time.Minute),
+		},
+		// 無法換算成百分比的舊作答紀錄，不列入排行榜
+		model.ExamRecord{
+			ExamId:    examId,
+			Score:     100,
+			UserId:    "user06",
+			CreatedAt: now.Add(-time.Hour),
+			UpdatedAt: now.Add(-time.Hour),
+		},
+	}
+	_, err := s.examRecordCollection.InsertMany(ctx, documents)
+	s.Nil(err)
+
+	// Test
+	leaderboardEntries, err := s.repo.FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn(
+		ctx,
+		examId,
+		time.Time{},
+		nil,
+		10,
+	)
+	s.Nil(err)
+	s.Len(leaderboardEntries, 5)
+
+	userIds := []string{}
+
+	for _, leaderboardEntry := range leaderboardEntries {
+		userIds = append(userIds, leaderboardEntry.UserId)
+	}
+
+	s.Equal([]string{"user04", "user05", "user02", "user01", "user03"}, userIds)
+	s.Equal(95.0, leaderboardEntries[1].Percentage)
+	s.Equal(int32(80), leaderboardEntries[3].DurationSeconds)
+
+	// 只查詢最近一天的紀錄
+	leaderboardEntries, err = s.repo.FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn(
+		ctx,
+		examId,
+		now.AddDate(0, 0, -1),
+		nil,
+		2,
+	)
+	s.Nil(err)
+	s.Len(leaderboardEntries, 2)
+	s.Equal("user05", leaderboardEntries[0].UserId)
+	s.Equal("user02", leaderboardEntries[1].UserId)
+
+	// 排除的使用者不佔排行榜的名額，由後面的使用者遞補
+	leaderboardEntries, err = s.repo.FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn(
+		ctx,
+		examId,
+		time.Time{},
+		[]string{"user05", "user02"},
+		2,
+	)
+	s.Nil(err)
+	s.Len(leaderboardEntries, 2)
+	s.Equal("user04", leaderboardEntries[0].UserId)
+	s.Equal("user01", leaderboardEntries[1].UserId)
+
+	_, err = s.repo.DeleteExamRecordsByExamId(ctx, examId)
+	s.Nil(err)
+}
+
+func (s *MyTestSuite) TestFindExamStatisticsSummaryByExamId() {
+	ctx := context.Background()
+	examId := "TestFindExamStatisticsSummaryByExamId"
+	documents := []interface{}{
+		model.ExamRecord{
+			ExamId:     examId,
+			Score:      5,
+			MaxPoints:  5,
+			Percentage: 100,
+			UserId:     "user01",
+		},
+		model.ExamRecord{
+			ExamId:     examId,
+			Score:      3,
+			MaxPoints:  5,
+			Percentage: 60,
+			UserId:     "user01",
+		},
+		// 沒有配分資料的舊作答紀錄，以答對題數佔題數的比例換算
+		model.ExamRecord{
+			ExamId:      examId,
+			Score:       1,
+			QuestionIds: []string{"question01", "question02", "question03", "question04"},
+			UserId:      "user02",
+		},
+		model.ExamRecord{
+			ExamId:     examId,
+			Score:      13,
+			MaxPoints:  20,
+			Percentage: 65,
+			UserId:     "user03",
+		},
+		// 無法換算成百分比的舊作答紀錄，不列入摘要
+		model.ExamRecord{
+			ExamId: examId,
+			Score:  10,
+			UserId: "user04",
+		},
+	}
+	_, err := s.examRecordCollection.InsertMany(ctx, documents)
+	s.Nil(err)
+
+	// Test
+	summary, err := s.repo.FindExamStatisticsSummaryByExamId(ctx, examId)
+	s.Nil(err)
+	s.Equal(ExamStatisticsSummary{
+		AttemptCount:     4,
+		UserCount:        3,
+		MeanPercentage:   62.5,
+		MedianPercentage: 62.5,
+		PercentageDistribution: []PercentageCount{
+			{Percentage: 20, Count: 1},
+			{Percentage: 60, Count: 2},
+			{Percentage: 100, Count: 1},
+		},
+	}, summary)
+
+	// 沒有作答紀錄
+	summary, err = s.repo.FindExamStatisticsSummaryByExamId(ctx, "notExistExamId")
+	s.Nil(err)
+	s.Equal(ExamStatisticsSummary{
+		PercentageDistribution: []PercentageCount{},
+	}, summary)
+
+	_, err = s.repo.DeleteExamRecordsByExamId(ctx, examId)
+	s.Nil(err)
+}
+
+func (s *MyTestSuite) TestFindQuestionAttemptCountsByExamId() {
+	ctx := context.Background()
+	examId := "TestFindQuestionAttemptCountsByExamId"
+	questionIds := []string{"question01", "question02"}
+	documents := []interface{}{
+		// 題數較多，分數較高但百分比最低，屬於低分組
+		model.ExamRecord{
+			ExamId:           examId,
+			Score:            2,
+			MaxPoints:        10,
+			Percentage:       20,
+			QuestionIds:      questionIds,
+			WrongQuestionIds: questionIds,
+			UserId:           "user01",
+		},
+		model.ExamRecord{
+			ExamId:           examId,
+			Score:            1,
+			MaxPoints:        2,
+			Percentage:       50,
+			QuestionIds:      questionIds,
+			WrongQuestionIds: []string{"question02"},
+			UserId:           "user02",
+		},
+		// 高分組
+		model.ExamRecord{
+			ExamId:      examId,
+			Score:       2,
+			MaxPoints:   2,
+			Percentage:  100,
+			QuestionIds: questionIds,
+			UserId:      "user03",
+		},
+		// 舊的作答紀錄沒有記錄出現的題目，不列入題目分析
+		model.ExamRecord{
+			ExamId: examId,
+			Score:  1,
+			UserId: "user04",
+		},
+	}
+	_, err := s.examRecordCollection.InsertMany(ctx, documents)
+	s.Nil(err)
+
+	// Test
+	questionAttemptCounts, err := s.repo.FindQuestionAttemptCountsByExamId(ctx, examId, 0.27)
+	s.Nil(err)
+	s.Equal([]QuestionAttemptCount{
+		{
+			QuestionId:         "question01",
+			AttemptCount:       3,
+			WrongCount:         1,
+			TopAttemptCount:    1,
+			TopWrongCount:      0,
+			BottomAttemptCount: 1,
+			BottomWrongCount:   1,
+		},
+		{
+			QuestionId:         "question02",
+			AttemptCount:       3,
+			WrongCount:         2,
+			TopAttemptCount:    1,
+			TopWrongCount:      0,
+			BottomAttemptCount: 1,
+			BottomWrongCount:   1,
+		},
+	}, questionAttemptCounts)
+
+	_, err = s.repo.DeleteExamRecordsByExamId(ctx, examId)
+	s.Nil(err)
+}
+
+func (s *MyTestSuite) TestGetExamRecordById() {
+	ctx := context.Background()
+	examId := "TestGetExamRecordById"
+	answers := []model.ExamAttemptAnswer{
+		{
+			QuestionId:       "question01",
+			Answer:           "apple",
+			IsCorrect:        true,
+			TimeSpentSeconds: 10,
+		},
+	}
+	examRecordId, err := s.repo.CreateExamRecord(ctx, model.ExamRecord{
+		ExamId:      examId,
+		Score:       1,
+		QuestionIds: []string{"question01"},
+		Answers:     answers,
+		UserId:      "user01",
+	})
+	s.Nil(err)
+
+	// Test
+	examRecord, err := s.repo.GetExamRecordById(ctx, examRecordId)
+	s.Nil(err)
+	s.NotNil(examRecord)
+	s.Equal(answers, examRecord.Answers)
+
+	_, err = s.repo.DeleteExamRecordsByExamId(ctx, examId)
+	s.Nil(err)
+}
+
+func (s *MyTestSuite) TestMistakeNotebook() {
+	ctx := context.Background()
+	userId := "TestMistakeNotebookUser"
+	examId01 := "TestMistakeNotebookExam01"
+	examId02 := "TestMistakeNotebookExam02"
+
+	for _, examId := range []string{examId01, examId02} {
+		_, _, err := s.repo.UpsertAnswerWrongByTimesPlusOne(ctx, examId, 0, "question01", userId)
+		s.Nil(err)
+
+		// 間隔一下避免 updatedAt 相同
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Test
+	answerWrongs, err := s.repo.FindAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetweenOrderByUpdatedAtDesc(
+		ctx, userId, "", time.Time{}, time.Time{}, 0, 10)
+	s.Nil(err)
+	s.Len(answerWrongs, 2)
+	s.Equal(examId02, answerWrongs[0].ExamId)
+	s.Equal(examId01, answerWrongs[1].ExamId)
+
+	count, err := s.repo.CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween(
+		ctx, userId, examId01, time.Time{}, time.Time{})
+	s.Nil(err)
+	s.EqualValues(1, count)
+
+	count, err = s.repo.CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween(
+		ctx, userId, "", time.Now(), time.Time{})
+	s.Nil(err)
+	s.EqualValues(0, count)
+
+	answerWrongs, err = s.repo.FindRandomAnswerWrongsByUserIdAndExamId(ctx, userId, examId01, 10)
+	s.Nil(err)
+	s.Len(answerWrongs, 1)
+
+	// 連續答對的次數遞增，再次答錯時歸零
+	answerWrongId := answerWrongs[0].Id.Hex()
+	correctStreak, err := s.repo.IncreaseAnswerWrongCorrectStreak(ctx, answerWrongId)
+	s.Nil(err)
+	s.EqualValues(1, correctStreak)
+
+	_, _, err = s.repo.UpsertAnswerWrongByTimesPlusOne(ctx, examId01, 0, "question01", userId)
+	s.Nil(err)
+
+	answerWrong, err := s.repo.GetAnswerWrongById(ctx, answerWrongId)
+	s.Nil(err)
+	s.EqualValues(0, answerWrong.CorrectStreak)
+	s.EqualValues(2, answerWrong.Times)
+
+	deletedCount, err := s.repo.DeleteAnswerWrongById(ctx, answerWrongId)
+	s.Nil(err)
+	s.EqualValues(1, deletedCount)
+}
+
+func (s *MyTestSuite) TestExamAttemptCount() {
+	ctx := context.Background()
+	examId := "TestExamAttemptCountExam"
+	userId := "TestExamAttemptCountUser"
+
+	// 沒有計數器時以之前的作答次數建立計數器
+	increased, err := s.repo.IncreaseExamAttemptCount(ctx, examId, userId, 1, 3)
+	s.Nil(err)
+	s.True(increased)
+
+	increased, err = s.repo.IncreaseExamAttemptCount(ctx, examId, userId, 1, 3)
+	s.Nil(err)
+	s.True(increased)
+
+	// 已達上限時不再遞增
+	increased, err = s.repo.IncreaseExamAttemptCount(ctx, examId, userId, 1, 3)
+	s.Nil(err)
+	s.False(increased)
+
+	// 之前的作答次數已達上限時不會建立計數器
+	increased, err = s.repo.IncreaseExamAttemptCount(ctx, examId, "user02", 3, 3)
+	s.Nil(err)
+	s.False(increased)
+
+	deletedCount, err := s.repo.DeleteExamAttemptCountsByExamId(ctx, examId)
+	s.Nil(err)
+	s.EqualValues(1, deletedCount)
+}
+
+func (s *MyTestSuite) TestIncreaseExamAttemptCountConcurrently() {
+	ctx := context.Background()
+	examId := "TestIncreaseExamAttemptCountConcurrentlyExam"
+	userId := "TestIncreaseExamAttemptCountConcurrentlyUser"
+	collection := s.client.Database(DATABASE).Collection("examattemptcounts")
+
+	// 同時交卷時，遞增成功的次數和計數器都不會超過上限
+	var waitGroup sync.WaitGroup
+	var increasedCount atomic.Int32
+
+	for i := 0; i < 10; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+
+			increased, err := s.repo.IncreaseExamAttemptCount(ctx, examId, userId, 0, 3)
+			s.Nil(err)
+
+			if increased {
+				increasedCount.Add(1)
+			}
+		}()
+	}
+
+	waitGroup.Wait()
+
+	var examAttemptCount model.ExamAttemptCount
+	err := collection.FindOne(
+		ctx,
+		bson.D{{"examId", examId}, {"userId", userId}},
+	).Decode(&examAttemptCount)
+	s.Nil(err)
+	s.LessOrEqual(increasedCount.Load(), int32(3))
+	s.Equal(increasedCount.Load(), examAttemptCount.Count)
+
+	// 計數器已存在時，同時交卷只有未達上限的次數會遞增成功
+	_, err = collection.UpdateOne(
+		ctx,
+		bson.D{{"examId", examId}, {"userId", userId}},
+		bson.D{{"$set", bson.D{{"count", 1}}}},
+	)
+	s.Nil(err)
+	increasedCount.Store(0)
+
+	for i := 0; i < 10; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+
+			increased, err := s.repo.IncreaseExamAttemptCount(ctx, examId, userId, 0, 5)
+			s.Nil(err)
+
+			if increased {
+				increasedCount.Add(1)
+			}
+		}()
+	}
+
+	waitGroup.Wait()
+	s.Equal(int32(4), increasedCount.Load())
+
+	err = collection.FindOne(
+		ctx,
+		bson.D{{"examId", examId}, {"userId", userId}},
+	).Decode(&examAttemptCount)
+	s.Nil(err)
+	s.Equal(int32(5), examAttemptCount.Count)
+
+	deletedCount, err := s.repo.DeleteExamAttemptCountsByExamId(ctx, examId)
+	s.Nil(err)
+	s.EqualValues(1, deletedCount)
+}
+
+func (s *MyTestSuite) TestExamAttempt() {
+	ctx := context.Background()
+	examId := "TestExamAttemptExam"
+	userId := "TestExamAttemptUser"
+
+	examAttemptId, err := s.repo.CreateExamAttempt(ctx, model.ExamAttempt{
+		ExamId:      examId,
+		ExamVersion: 1,
+		QuestionIds: []string{"question02", "question01"},
+		UserId:      userId,
+	})
+	s.Nil(err)
+	s.NotEmpty(examAttemptId)
+
+	examAttempt, err := s.repo.GetExamAttemptById(ctx, examAttemptId)
+	s.Nil(err)
+	s.Equal(examId, examAttempt.ExamId)
+	s.EqualValues(1, examAttempt.ExamVersion)
+	s.Equal([]string{"question02", "question01"}, examAttempt.QuestionIds)
+	s.Equal(userId, examAttempt.UserId)
+	s.Nil(examAttempt.SubmittedAt)
+
+	// 同一題重複查看提示只記錄一次
+	for _, questionId := range []string{"question01", "question01"} {
+		added, err := s.repo.AddExamAttemptHintQuestionId(ctx, examAttemptId, questionId)
+		s.Nil(err)
+		s.True(added)
+	}
+
+	// 同一次作答只能交卷一次
+	submitted, err := s.repo.SubmitExamAttempt(ctx, examAttemptId)
+	s.Nil(err)
+	s.True(submitted)
+
+	submitted, err = s.repo.SubmitExamAttempt(ctx, examAttemptId)
+	s.Nil(err)
+	s.False(submitted)
+
+	// 交卷後不能再查看提示
+	added, err := s.repo.AddExamAttemptHintQuestionId(ctx, examAttemptId, "question02")
+	s.Nil(err)
+	s.False(added)
+
+	examAttempt, err = s.repo.GetExamAttemptById(ctx, examAttemptId)
+	s.Nil(err)
+	s.NotNil(examAttempt.SubmittedAt)
+	s.Equal([]string{"question01"}, examAttempt.HintQuestionIds)
+
+	deletedCount, err := s.repo.DeleteExamAttemptsByExamId(ctx, examId)
+	s.Nil(err)
+	s.EqualValues(1, deletedCount)
+
+	examAttempt, err = s.repo.GetExamAttemptById(ctx, examAttemptId)
+	s.Nil(err)
+	s.Nil(examAttempt)
+}
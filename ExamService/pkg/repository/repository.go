@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/model"
@@ -9,6 +10,93 @@ import (
 
 type transactionFunc func(ctx context.Context) (interface{}, error)
 
+// 修改時資料的版本號與讀取時不同，表示資料在讀取後已被其他請求修改
+var VersionConflictError = fmt.Errorf("Version conflict")
+
+// 標籤與使用該標籤的測驗數量，這是彙總查詢的結果，不是 MongoDB 的 document
+type TagCount struct {
+	Tag   string `bson:"_id"`
+	Count int32  `bson:"count"`
+}
+
+// 測驗目錄的排序方式
+const (
+	EXAM_CATALOG_SORT_NEWEST          = "newest"          // 最新
+	EXAM_CATALOG_SORT_MOST_ATTEMPTED  = "most_attempted"  // 作答次數最多
+	EXAM_CATALOG_SORT_HIGHEST_SCORING = "highest_scoring" // 全部作答的平均得分百分比最高
+)
+
+/*
+測驗與其題目數量、作答次數和全部作答的平均得分百分比，這是彙總查詢的結果，不是 MongoDB 的 document，
+只統計某個使用者的作答紀錄時，RecordCount 是該使用者的作答次數
+*/
+type ExamSummary struct {
+	model.Exam        `bson:",inline"`
+	QuestionCount     int32   `bson:"questionCount"`
+	RecordCount       int32   `bson:"recordCount"`
+	AveragePercentage float64 `bson:"averagePercentage"`
+}
+
+// 測驗版本的摘要，不包含題目快照，這是彙總查詢的結果，不是 MongoDB 的 document
+type ExamVersionSummary struct {
+	Version       int32     `bson:"version"`
+	QuestionCount int32     `bson:"questionCount"`
+	CreatedAt     time.Time `bson:"createdAt"`
+}
+
+/*
+使用者在一段期間內的最高得分百分比、最近一次得分百分比與作答次數，這是彙總查詢的結果，不是 MongoDB 的 document，
+無法換算成百分比的舊作答紀錄只計入作答次數，沒有可以換算的作答紀錄時百分比為 0
+*/
+type ExamRecordScore struct {
+	UserId           string    `bson:"_id"`
+	BestPercentage   float64   `bson:"bestPercentage"`
+	LatestPercentage float64   `bson:"latestPercentage"`
+	RecordCount      int32     `bson:"recordCount"`
+	LatestCreatedAt  time.Time `bson:"latestCreatedAt"`
+}
+
+// 百分比級距與該級距的作答次數，Percentage 是級距的下限
+type PercentageCount struct {
+	Percentage int32 `bson:"_id"`
+	Count      int32 `bson:"count"`
+}
+
+/*
+測驗全部作答紀錄的摘要，只包含可以換算成百分比的作答紀錄，這是彙總查詢的結果，不是 MongoDB 的 document，
+PercentageDistribution 以 10 為級距，100 分自成一個級距
+*/
+type ExamStatisticsSummary struct {
+	AttemptCount           int32
+	UserCount              int32
+	MeanPercentage         float64
+	MedianPercentage       float64
+	PercentageDistribution []PercentageCount
+}
+
+/*
+題目的作答次數與答錯次數，這是彙總查詢的結果，不是 MongoDB 的 document，
+Top 和 Bottom 開頭的欄位只計算百分比最高與最低兩組作答紀錄，用來計算鑑別度
+*/
+type QuestionAttemptCount struct {
+	QuestionId         string `bson:"_id"`
+	AttemptCount       int32  `bson:"attemptCount"`
+	WrongCount         int32  `bson:"wrongCount"`
+	TopAttemptCount    int32  `bson:"topAttemptCount"`
+	TopWrongCount      int32  `bson:"topWrongCount"`
+	BottomAttemptCount int32  `bson:"bottomAttemptCount"`
+	BottomWrongCount   int32  `bson:"bottomWrongCount"`
+}
+
+// 使用者在排行榜期間內的最佳紀錄，這是彙總查詢的結果，不是 MongoDB 的 document
+type LeaderboardEntry struct {
+	UserId          string    `bson:"_id"`
+	Score           int32     `bson:"score"`
+	Percentage      float64   `bson:"percentage"`
+	DurationSeconds int32     `bson:"durationSeconds"`
+	CreatedAt       time.Time `bson:"createdAt"`
+}
+
 //go:generate mockery --name DatabaseRepository
 type DatabaseRepository interface {
 	ConnectDB(ctx context.Context, uri string) error
@@ -19,6 +107,7 @@ type DatabaseRepository interface {
 
 	// Exam
 	CreateExam(ctx context.Context, exam model.Exam) (examId string, err error)
+	// exam.Version 與資料庫中的版本號不同時回傳 VersionConflictError，修改成功後版本號加 1
 	UpdateExam(ctx context.Context, exam model.Exam) error
 	GetExamById(ctx context.Context, examId string) (exam *model.Exam, err error)
 	FindExamsByUserIdOrderByUpdateAtDesc(
@@ -26,69 +115,317 @@ type DatabaseRepository interface {
 		userId string,
 		skip, limit int32,
 	) (exams []model.Exam, err error)
-	FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc(
+	FindExamsByUserIdAndCursorOrderByUpdateAtDesc(
+		ctx context.Context,
+		userId, cursor string,
+		limit int32,
+	) (exams []model.Exam, nextCursor string, err error)
+	FindExamsByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc(
 		ctx context.Context,
 		userId string,
 		isPublic bool,
+		tags []string,
+	) (exams []model.Exam, err error)
+	FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc(
+		ctx context.Context,
+		userId string,
+		isPublic bool,
+		tags []string,
+	) (examSummaries []ExamSummary, err error)
+	FindPublicExamSummaries(
+		ctx context.Context,
+		keyword string,
+		tags []string,
+		sortBy string,
+		skip, limit int32,
+	) (total int32, examSummaries []ExamSummary, err error)
+	FindExamsByTagsOrderByUpdateAtDesc(
+		ctx context.Context,
+		tags []string,
+		userId string,
+		skip, limit int32,
 	) (exams []model.Exam, err error)
+	CountExamsByTags(ctx context.Context, tags []string, userId string) (count int32, err error)
+	FindExamTagCounts(
+		ctx context.Context,
+		userId string,
+		limit int32,
+	) (tagCounts []TagCount, err error)
 	DeleteExamById(ctx context.Context, examId string) (deletedCount int32, err error)
 	CountExamsByUserId(ctx context.Context, userId string) (count int32, err error)
 
 	// Question
 	CreateQuestion(ctx context.Context, question model.Question) (questionId string, err error)
+	CreateQuestions(
+		ctx context.Context,
+		questions []model.Question,
+	) (questionIds []string, err error)
+	// question.Version 與資料庫中的版本號不同時回傳 VersionConflictError，修改成功後版本號加 1
 	UpdateQuestion(ctx context.Context, question model.Question) error
 	GetQuestionById(ctx context.Context, questionId string) (question *model.Question, err error)
 	FindQuestionsByQuestionIds(
 		ctx context.Context,
 		questionIds []string,
 	) (questions []model.Question, err error)
+	FindQuestionsByExamId(
+		ctx context.Context,
+		examId string,
+	) (questions []model.Question, err error)
 	FindQuestionsByExamIdOrderByUpdateAtDesc(
 		ctx context.Context,
 		examId string,
 		skip, limit int32,
 	) (questions []model.Question, err error)
+	FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc(
+		ctx context.Context,
+		examId, cursor string,
+		limit int32,
+	) (questions []model.Question, nextCursor string, err error)
 	DeleteQuestionById(ctx context.Context, questionId string) (deletedCount int32, err error)
 	DeleteQuestionsByExamId(ctx context.Context, examId string) (deletedCount int32, err error)
+	DeleteQuestionsByIds(
+		ctx context.Context,
+		questionIds []string,
+	) (deletedCount int32, err error)
 	CountQuestionsByExamId(
 		ctx context.Context,
 		examId string,
 	) (count int32, err error)
 
+	// ExamVersion
+	CreateExamVersion(
+		ctx context.Context,
+		examVersion model.ExamVersion,
+	) (examVersionId string, err error)
+	GetExamVersionByExamIdAndVersion(
+		ctx context.Context,
+		examId string,
+		version int32,
+	) (examVersion *model.ExamVersion, err error)
+	FindExamVersionSummariesByExamIdOrderByVersionDesc(
+		ctx context.Context,
+		examId string,
+	) (examVersionSummaries []ExamVersionSummary, err error)
+	DeleteExamVersionsByExamId(ctx context.Context, examId string) (deletedCount int32, err error)
+
+	// ExamSection
+	CreateExamSection(
+		ctx context.Context,
+		examSection model.ExamSection,
+	) (sectionId string, err error)
+	UpdateExamSection(ctx context.Context, examSection model.ExamSection) error
+	GetExamSectionById(
+		ctx context.Context,
+		sectionId string,
+	) (examSection *model.ExamSection, err error)
+	FindExamSectionsByExamIdOrderByOrderAsc(
+		ctx context.Context,
+		examId string,
+	) (examSections []model.ExamSection, err error)
+	DeleteExamSectionById(ctx context.Context, sectionId string) (deletedCount int32, err error)
+	DeleteExamSectionsByExamId(ctx context.Context, examId string) (deletedCount int32, err error)
+	UnsetQuestionsSectionIdBySectionId(
+		ctx context.Context,
+		sectionId string,
+	) (modifiedCount int32, err error)
+
+	// BankQuestion
+	CreateBankQuestion(
+		ctx context.Context,
+		bankQuestion model.BankQuestion,
+	) (bankQuestionId string, err error)
+	UpdateBankQuestion(ctx context.Context, bankQuestion model.BankQuestion) error
+	GetBankQuestionById(
+		ctx context.Context,
+		bankQuestionId string,
+	) (bankQuestion *model.BankQuestion, err error)
+	FindBankQuestionsByIds(
+		ctx context.Context,
+		bankQuestionIds []string,
+	) (bankQuestions []model.BankQuestion, err error)
+	FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc(
+		ctx context.Context,
+		userId, tag string,
+		skip, limit int32,
+	) (bankQuestions []model.BankQuestion, err error)
+	CountBankQuestionsByUserIdAndTag(
+		ctx context.Context,
+		userId, tag string,
+	) (count int32, err error)
+	FindRandomBankQuestionsByUserIdAndTag(
+		ctx context.Context,
+		userId, tag string,
+		size int32,
+	) (bankQuestions []model.BankQuestion, err error)
+	DeleteBankQuestionById(
+		ctx context.Context,
+		bankQuestionId string,
+	) (deletedCount int32, err error)
+
 	// AnswerWrong
 	DeleteAnswerWrongsByQuestionId(
 		ctx context.Context,
 		questionId string,
 	) (deletedCount int32, err error)
 	DeleteAnswerWrongsByExamId(ctx context.Context, examId string) (deletedCount int32, err error)
+	DeleteAnswerWrongsByQuestionIds(
+		ctx context.Context,
+		questionIds []string,
+	) (deletedCount int32, err error)
 	UpsertAnswerWrongByTimesPlusOne(
 		ctx context.Context,
-		examId, questionId, userId string,
+		examId string,
+		examVersion int32,
+		questionId, userId string,
 	) (modifiedCount, upsertedCount int32, err error)
-	FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc(
+	FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc(
 		ctx context.Context,
-		examId, userId string,
+		examId string,
+		examVersion int32,
+		userId string,
 		limit int32,
 	) (answerWrongs []model.AnswerWrong, err error)
+	FindAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetweenOrderByUpdatedAtDesc(
+		ctx context.Context,
+		userId, examId string,
+		startDate, endDate time.Time,
+		skip, limit int32,
+	) (answerWrongs []model.AnswerWrong, err error)
+	CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween(
+		ctx context.Context,
+		userId, examId string,
+		startDate, endDate time.Time,
+	) (count int64, err error)
+	FindRandomAnswerWrongsByUserIdAndExamId(
+		ctx context.Context,
+		userId, examId string,
+		size int32,
+	) (answerWrongs []model.AnswerWrong, err error)
+	GetAnswerWrongById(
+		ctx context.Context,
+		answerWrongId string,
+	) (answerWrong *model.AnswerWrong, err error)
+	IncreaseAnswerWrongCorrectStreak(
+		ctx context.Context,
+		answerWrongId string,
+	) (correctStreak int32, err error)
+	DeleteAnswerWrongById(
+		ctx context.Context,
+		answerWrongId string,
+	) (deletedCount int32, err error)
 
 	// ExamRecord
 	CreateExamRecord(
 		ctx context.Context,
 		examRecord model.ExamRecord,
 	) (examRecordId string, err error)
+	GetExamRecordById(
+		ctx context.Context,
+		examRecordId string,
+	) (examRecord *model.ExamRecord, err error)
 	DeleteExamRecordsByExamId(ctx context.Context, examId string) (deletedCount int32, err error)
 	FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc(
 		ctx context.Context,
 		examId, userId string,
 		skip, limit int32,
 	) (examRecords []model.ExamRecord, err error)
+	FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc(
+		ctx context.Context,
+		examId, userId, cursor string,
+		limit int32,
+	) (examRecords []model.ExamRecord, nextCursor string, err error)
 	CountExamRecordsByExamIdAndUserId(
 		ctx context.Context,
 		examId, userId string,
 	) (count int32, err error)
-	FindExamRecordsByExamIdAndUserIdAndCreatedAt(
+	FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween(
 		ctx context.Context,
 		examId,
 		userId string,
-		createdAt time.Time,
+		startAt, endAt time.Time,
 	) (examRecords []model.ExamRecord, err error)
+	FindExamStatisticsSummaryByExamId(
+		ctx context.Context,
+		examId string,
+	) (summary ExamStatisticsSummary, err error)
+	FindQuestionAttemptCountsByExamId(
+		ctx context.Context,
+		examId string,
+		groupRatio float64,
+	) (questionAttemptCounts []QuestionAttemptCount, err error)
+	FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween(
+		ctx context.Context,
+		examId string,
+		userIds []string,
+		startAt, endAt time.Time,
+	) (examRecordScores []ExamRecordScore, err error)
+	FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn(
+		ctx context.Context,
+		examId string,
+		createdAt time.Time,
+		excludedUserIds []string,
+		limit int32,
+	) (leaderboardEntries []LeaderboardEntry, err error)
+
+	// ExamAttemptCount
+	// 作答次數小於 maxAttempts 時加 1 並回傳 true，還沒有計數器時以 initialCount 建立
+	IncreaseExamAttemptCount(
+		ctx context.Context,
+		examId, userId string,
+		initialCount, maxAttempts int32,
+	) (increased bool, err error)
+	DeleteExamAttemptCountsByExamId(
+		ctx context.Context,
+		examId string,
+	) (deletedCount int32, err error)
+
+	// ExamAttempt
+	CreateExamAttempt(
+		ctx context.Context,
+		examAttempt model.ExamAttempt,
+	) (examAttemptId string, err error)
+	GetExamAttemptById(
+		ctx context.Context,
+		examAttemptId string,
+	) (examAttempt *model.ExamAttempt, err error)
+	// 還沒有交卷時記錄交卷時間並回傳 true，已交卷時回傳 false
+	AddExamAttemptHintQuestionId(
+		ctx context.Context,
+		examAttemptId, questionId string,
+	) (added bool, err error)
+	SubmitExamAttempt(ctx context.Context, examAttemptId string) (submitted bool, err error)
+	DeleteExamAttemptsByExamId(
+		ctx context.Context,
+		examId string,
+	) (deletedCount int32, err error)
+
+	// Class
+	CreateClass(ctx context.Context, class model.Class) (classId string, err error)
+	GetClassById(ctx context.Context, classId string) (class *model.Class, err error)
+	GetClassByJoinCode(ctx context.Context, joinCode string) (class *model.Class, err error)
+	FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc(
+		ctx context.Context,
+		userId string,
+	) (classes []model.Class, err error)
+	AddClassStudent(
+		ctx context.Context,
+		classId string,
+		student model.ClassStudent,
+	) (modifiedCount int32, err error)
+
+	// Assignment
+	CreateAssignment(
+		ctx context.Context,
+		assignment model.Assignment,
+	) (assignmentId string, err error)
+	FindAssignmentsByClassIdOrderByDueAtAsc(
+		ctx context.Context,
+		classId string,
+	) (assignments []model.Assignment, err error)
+	CountAssignmentsByClassIdsAndExamId(
+		ctx context.Context,
+		classIds []string,
+		examId string,
+	) (count int32, err error)
+	DeleteAssignmentsByExamId(ctx context.Context, examId string) (deletedCount int32, err error)
 }
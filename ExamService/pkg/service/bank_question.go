@@ -0,0 +1,757 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/model"
+)
+
+const (
+	// 測驗最多引用的題庫題目數與隨機抽題數
+	maxExamBankQuestionIdCount = 200
+	maxExamBankPoolCount       = 10
+
+	// 發佈測驗時每個隨機抽題的題庫最多保存的候選題目數
+	maxBankPoolSize int32 = 1000
+)
+
+func validateBankQuestion(ask string, answers []string, points int32, hint, explanation string) error {
+	if strings.TrimSpace(ask) == "" {
+		return fmt.Errorf("Ask is empty")
+	}
+
+	hasAnswer := false
+
+	for _, answer := range answers {
+		if strings.TrimSpace(answer) != "" {
+			hasAnswer = true
+			break
+		}
+	}
+
+	if !hasAnswer {
+		return fmt.Errorf("Answers is empty")
+	}
+
+	if err := validateQuestionPoints(points); err != nil {
+		return err
+	}
+
+	return validateQuestionHint(hint, explanation)
+}
+
+// 檢查並正規化測驗引用的題庫題目與隨機抽題設定，重複的題目 id 與標籤只保留第一個
+func normalizeExamBankSource(
+	questionIds []string,
+	pools []model.ExamBankPool,
+) (*model.ExamBankSource, error) {
+	bankSource := model.ExamBankSource{
+		QuestionIds: []string{},
+		Pools:       []model.ExamBankPool{},
+	}
+
+	for _, questionId := range questionIds {
+		if _, err := primitive.ObjectIDFromHex(questionId); err != nil {
+			return nil, fmt.Errorf("Invalid bankQuestionId: %s", questionId)
+		}
+
+		if !slices.Contains(bankSource.QuestionIds, questionId) {
+			bankSource.QuestionIds = append(bankSource.QuestionIds, questionId)
+		}
+	}
+
+	if len(bankSource.QuestionIds) > maxExamBankQuestionIdCount {
+		return nil, fmt.Errorf("Too many bankQuestionIds: %d", len(bankSource.QuestionIds))
+	}
+
+	for _, pool := range pools {
+		tag := normalizeTag(pool.Tag)
+
+		if tag == "" {
+			return nil, fmt.Errorf("Pool tag is empty")
+		}
+
+		if len([]rune(tag)) > maxTagLength {
+			return nil, fmt.Errorf("Tag is too long: %s", tag)
+		}
+
+		if pool.Size <= 0 || pool.Size > maxBankPoolSize {
+			return nil, fmt.Errorf("Invalid pool size: %d", pool.Size)
+		}
+
+		if slices.ContainsFunc(bankSource.Pools, func(existing model.ExamBankPool) bool {
+			return existing.Tag == tag
+		}) {
+			continue
+		}
+
+		bankSource.Pools = append(bankSource.Pools, model.ExamBankPool{
+			Tag:  tag,
+			Size: pool.Size,
+		})
+	}
+
+	if len(bankSource.Pools) > maxExamBankPoolCount {
+		return nil, fmt.Errorf("Too many pools: %d", len(bankSource.Pools))
+	}
+
+	// 沒有引用任何題庫題目時，與從未引用過的測驗相同
+	if len(bankSource.QuestionIds) == 0 && len(bankSource.Pools) == 0 {
+		return nil, nil
+	}
+
+	return &bankSource, nil
+}
+
+// 將題庫題目轉成測驗的 Question，題目 id 與題庫題目相同
+func bankQuestionToQuestion(bankQuestion model.BankQuestion, examId string) model.Question {
+	return model.Question{
+		Id:            bankQuestion.Id,
+		ExamId:        examId,
+		Ask:           bankQuestion.Ask,
+		Answers:       bankQuestion.Answers,
+		UserId:        bankQuestion.UserId,
+		Points:        bankQuestion.Points,
+		PartialCredit: bankQuestion.PartialCredit,
+		Hint:          bankQuestion.Hint,
+		Explanation:   bankQuestion.Explanation,
+		CreatedAt:     bankQuestion.CreatedAt,
+		UpdatedAt:     bankQuestion.UpdatedAt,
+	}
+}
+
+func (examService examService) CreateBankQuestion(
+	ctx context.Context,
+	ask string,
+	answers, tags []string,
+	points int32,
+	partialCredit bool,
+	hint, explanation string,
+	userId string,
+) (bankQuestionId string, err error) {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "CreateBankQuestion failed: %w"
+
+	err = validateBankQuestion(ask, answers, points, hint, explanation)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	tags, err = normalizeTags(tags)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	bankQuestionId, err = examService.databaseRepository.CreateBankQuestion(
+		ctx,
+		model.BankQuestion{
+			Ask:           ask,
+			Answers:       answers,
+			Tags:          tags,
+			Points:        points,
+			PartialCredit: partialCredit,
+			Hint:          hint,
+			Explanation:   explanation,
+			UserId:        userId,
+		},
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	logger.Log("bankQuestionId", bankQuestionId)
+	return bankQuestionId, nil
+}
+
+/*
+修改題庫題目，只有擁有者可以修改，
+已發佈的測驗使用發佈時的題目快照，修改後要再發佈測驗才會生效
+*/
+func (examService examService) UpdateBankQuestion(
+	ctx context.Context,
+	bankQuestionId, ask string,
+	answers, tags []string,
+	points int32,
+	partialCredit bool,
+	hint, explanation string,
+	userId string,
+) (string, error) {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "UpdateBankQuestion failed: %w"
+
+	err := validateBankQuestion(ask, answers, points, hint, explanation)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	tags, err = normalizeTags(tags)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	databaseRepository := examService.databaseRepository
+	bankQuestion, err := databaseRepository.GetBankQuestionById(ctx, bankQuestionId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	if bankQuestion == nil {
+		err = fmt.Errorf("BankQuestion not found by id: %s", bankQuestionId)
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	// 檢查不能修改別人的題庫題目
+	if bankQuestion.UserId != userId {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	bankQuestion.Ask = ask
+	bankQuestion.Answers = answers
+	bankQuestion.Tags = tags
+	bankQuestion.Points = points
+	bankQuestion.PartialCredit = partialCredit
+	bankQuestion.Hint = hint
+	bankQuestion.Explanation = explanation
+	err = databaseRepository.UpdateBankQuestion(ctx, *bankQuestion)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	logger.Log("bankQuestionId", bankQuestionId)
+	return bankQuestionId, nil
+}
+
+// 刪除題庫題目，引用該題目的測驗之後作答時會略過該題目
+func (examService examService) DeleteBankQuestion(
+	ctx context.Context,
+	bankQuestionId, userId string,
+) error {
+	errorLogger := examService.errorLogger
+	errorMessage := "DeleteBankQuestion failed: %w"
+
+	databaseRepository := examService.databaseRepository
+	bankQuestion, err := databaseRepository.GetBankQuestionById(ctx, bankQuestionId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
+	}
+
+	if bankQuestion == nil {
+		err = fmt.Errorf("BankQuestion not found by id: %s", bankQuestionId)
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
+	}
+
+	// 檢查不能刪除別人的題庫題目
+	if bankQuestion.UserId != userId {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
+	}
+
+	_, err = databaseRepository.WithTransaction(
+		ctx,
+		func(ctx context.Context) (interface{}, error) {
+			// 刪除尚未發佈的測驗作答時的 AnswerWrong
+			_, err = databaseRepository.DeleteAnswerWrongsByQuestionId(ctx, bankQuestionId)
+			if err != nil {
+				return nil, err
+			}
+
+			_, err = databaseRepository.DeleteBankQuestionById(ctx, bankQuestionId)
+			if err != nil {
+				return nil, err
+			}
+
+			return nil, nil
+		},
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
+	}
+
+	return nil
+}
+
+// 查詢使用者的題庫題目，tag 空字串表示全部的題目
+func (examService examService) FindBankQuestions(
+	ctx context.Context,
+	pageIndex, pageSize int32,
+	tag, userId string,
+) (total, pageCount int32, bankQuestions []model.BankQuestion, err error) {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "FindBankQuestions failed: %w"
+
+	if pageIndex < 0 || pageSize <= 0 {
+		err = fmt.Errorf("Invalid page: %d, %d", pageIndex, pageSize)
+		errorLogger.Log("err", err)
+		return 0, 0, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	tag = normalizeTag(tag)
+	databaseRepository := examService.databaseRepository
+	skip := pageSize * pageIndex
+	bankQuestions, err = databaseRepository.FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc(
+		ctx, userId, tag, skip, pageSize)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return 0, 0, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	// Total
+	total, err = databaseRepository.CountBankQuestionsByUserIdAndTag(ctx, userId, tag)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return 0, 0, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	// PageCount
+	pageCount = int32(math.Ceil(float64(total) / float64(pageSize)))
+
+	logger.Log("total", total, "pageCount", pageCount, "bankQuestions size", len(bankQuestions))
+	return total, pageCount, bankQuestions, nil
+}
+
+/*
+設定測驗引用的題庫題目與隨機抽題，會取代原本的設定，都沒有值時表示不再引用題庫，
+只能引用測驗擁有者的題庫，所以只有擁有者可以設定
+*/
+func (examService examService) SetExamBankSource(
+	ctx context.Context,
+	examId string,
+	questionIds []string,
+	pools []model.ExamBankPool,
+	userId string,
+) (exam *model.Exam, err error) {
+	errorLogger := examService.errorLogger
+	errorMessage := "SetExamBankSource failed: %w"
+
+	bankSource, err := normalizeExamBankSource(questionIds, pools)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	databaseRepository := examService.databaseRepository
+	exam, err = databaseRepository.GetExamById(ctx, examId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if exam == nil {
+		err = fmt.Errorf("Exam not found by id: %s", examId)
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if exam.UserId != userId {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	// 引用的題目必須存在且屬於使用者的題庫
+	if bankSource != nil && len(bankSource.QuestionIds) > 0 {
+		bankQuestions, err := databaseRepository.FindBankQuestionsByIds(
+			ctx,
+			bankSource.QuestionIds,
+		)
+		if err != nil {
+			errorLogger.Log("err", err)
+			return nil, fmt.Errorf(errorMessage, err)
+		}
+
+		for _, questionId := range bankSource.QuestionIds {
+			if !slices.ContainsFunc(bankQuestions, func(bankQuestion model.BankQuestion) bool {
+				return bankQuestion.Id.Hex() == questionId && bankQuestion.UserId == userId
+			}) {
+				err = fmt.Errorf("BankQuestion not found by id: %s", questionId)
+				errorLogger.Log("err", err)
+				return nil, fmt.Errorf(errorMessage, err)
+			}
+		}
+	}
+
+	exam.BankSource = bankSource
+	err = databaseRepository.UpdateExam(ctx, *exam)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	return exam, nil
+}
+
+// 查詢測驗固定引用的題庫題目，依照引用的順序排序，已刪除或不屬於測驗擁有者的題目會略過
+func (examService examService) findExamBankFixedQuestions(
+	ctx context.Context,
+	exam *model.Exam,
+) ([]model.Question, error) {
+	questions := []model.Question{}
+
+	if exam.BankSource == nil || len(exam.BankSource.QuestionIds) == 0 {
+		return questions, nil
+	}
+
+	bankQuestions, err := examService.databaseRepository.FindBankQuestionsByIds(
+		ctx,
+		exam.BankSource.QuestionIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, questionId := range exam.BankSource.QuestionIds {
+		for _, bankQuestion := range bankQuestions {
+			if bankQuestion.Id.Hex() == questionId && bankQuestion.UserId == exam.UserId {
+				questions = append(questions, bankQuestionToQuestion(bankQuestion, exam.Id.Hex()))
+				break
+			}
+		}
+	}
+
+	return questions, nil
+}
+
+/*
+尚未發佈的測驗每次作答時從題庫取出題目，包含固定引用的題目與每個標籤隨機抽出的題目，
+同一題只會出現一次
+*/
+func (examService examService) drawExamBankQuestions(
+	ctx context.Context,
+	exam *model.Exam,
+) ([]model.Question, error) {
+	questions, err := examService.findExamBankFixedQuestions(ctx, exam)
+	if err != nil {
+		return nil, err
+	}
+
+	if exam.BankSource == nil {
+		return questions, nil
+	}
+
+	for _, pool := range exam.BankSource.Pools {
+		bankQuestions, err := examService.databaseRepository.FindRandomBankQuestionsByUserIdAndTag(
+			ctx,
+			exam.UserId,
+			pool.Tag,
+			pool.Size,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, bankQuestion := range bankQuestions {
+			if !containsQuestion(questions, bankQuestion.Id) {
+				questions = append(questions, bankQuestionToQuestion(bankQuestion, exam.Id.Hex()))
+			}
+		}
+	}
+
+	return questions, nil
+}
+
+/*
+發佈測驗時建立題庫的快照，回傳固定引用的題目、每個標籤可以抽出的題目和抽題設定，
+excludedIds 是測驗本身的題目 id，不會重複出現在快照中
+*/
+func (examService examService) snapshotExamBankQuestions(
+	ctx context.Context,
+	exam *model.Exam,
+	excludedIds []primitive.ObjectID,
+) ([]model.Question, []model.ExamVersionBankPool, error) {
+	fixedQuestions, err := examService.findExamBankFixedQuestions(ctx, exam)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	questions := []model.Question{}
+
+	for _, question := range fixedQuestions {
+		if !slices.Contains(excludedIds, question.Id) {
+			questions = append(questions, question)
+			excludedIds = append(excludedIds, question.Id)
+		}
+	}
+
+	bankPools := []model.ExamVersionBankPool{}
+
+	if exam.BankSource == nil {
+		return questions, bankPools, nil
+	}
+
+	for _, pool := range exam.BankSource.Pools {
+		bankQuestions, err := examService.databaseRepository.FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc(
+			ctx,
+			exam.UserId,
+			pool.Tag,
+			0,
+			maxBankPoolSize,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		bankPool := model.ExamVersionBankPool{
+			Tag:         pool.Tag,
+			Size:        pool.Size,
+			QuestionIds: []string{},
+		}
+
+		for _, bankQuestion := range bankQuestions {
+			// 固定出現的題目不會再被抽出
+			if slices.Contains(excludedIds, bankQuestion.Id) {
+				continue
+			}
+
+			bankPool.QuestionIds = append(bankPool.QuestionIds, bankQuestion.Id.Hex())
+
+			if !containsQuestion(questions, bankQuestion.Id) {
+				questions = append(questions, bankQuestionToQuestion(bankQuestion, exam.Id.Hex()))
+			}
+		}
+
+		bankPools = append(bankPools, bankPool)
+	}
+
+	return questions, bankPools, nil
+}
+
+/*
+查詢測驗目前的全部題目與題組，已發佈的測驗使用最新版本的題目快照，
+尚未發佈的測驗使用測驗本身的題目，加上題庫中固定引用與可被抽出的題目
+*/
+func (examService examService) findExamContent(
+	ctx context.Context,
+	exam *model.Exam,
+) ([]model.Question, []model.ExamSection, error) {
+	databaseRepository := examService.databaseRepository
+	examId := exam.Id.Hex()
+
+	if exam.LatestVersion > 0 {
+		examVersion, err := databaseRepository.GetExamVersionByExamIdAndVersion(
+			ctx,
+			examId,
+			exam.LatestVersion,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if examVersion == nil {
+			return nil, nil, fmt.Errorf("Exam version not found: %d", exam.LatestVersion)
+		}
+
+		return examVersionQuestions(examVersion), examVersionSections(examVersion), nil
+	}
+
+	questions, err := databaseRepository.FindQuestionsByExamId(ctx, examId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	questionIds := []primitive.ObjectID{}
+
+	for _, question := range questions {
+		questionIds = append(questionIds, question.Id)
+	}
+
+	bankQuestions, _, err := examService.snapshotExamBankQuestions(ctx, exam, questionIds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sections, err := databaseRepository.FindExamSectionsByExamIdOrderByOrderAsc(ctx, examId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return append(questions, bankQuestions...), sections, nil
+}
+
+/*
+已發佈的測驗每次作答時的題目，隨機抽題的候選題目只出現從每個標籤抽出的題數，
+沒有隨機抽題的版本與全部的題目快照相同
+*/
+func examVersionAttemptQuestions(examVersion *model.ExamVersion, rng *rand.Rand) []model.Question {
+	questions := examVersionQuestions(examVersion)
+
+	if len(examVersion.BankPools) == 0 {
+		return questions
+	}
+
+	candidateIds := map[string]bool{}
+
+	for _, bankPool := range examVersion.BankPools {
+		for _, questionId := range bankPool.QuestionIds {
+			candidateIds[questionId] = true
+		}
+	}
+
+	drawnIds := map[string]bool{}
+
+	for _, bankPool := range examVersion.BankPools {
+		questionIds := append([]string{}, bankPool.QuestionIds...)
+		rng.Shuffle(len(questionIds), func(i, j int) {
+			questionIds[i], questionIds[j] = questionIds[j], questionIds[i]
+		})
+
+		var drawnCount int32 = 0
+
+		for _, questionId := range questionIds {
+			if drawnCount >= bankPool.Size {
+				break
+			}
+
+			if !drawnIds[questionId] {
+				drawnIds[questionId] = true
+				drawnCount++
+			}
+		}
+	}
+
+	result := []model.Question{}
+
+	for _, question := range questions {
+		questionId := question.Id.Hex()
+
+		if !candidateIds[questionId] || drawnIds[questionId] {
+			result = append(result, question)
+		}
+	}
+
+	return result
+}
+
+// 每次作答的題數，隨機抽題的題庫只計算抽出的題數
+func examVersionAttemptQuestionCount(examVersion model.ExamVersion) int32 {
+	candidateIds := map[string]bool{}
+	var count int32 = 0
+
+	for _, bankPool := range examVersion.BankPools {
+		count += min(bankPool.Size, int32(len(bankPool.QuestionIds)))
+
+		for _, questionId := range bankPool.QuestionIds {
+			candidateIds[questionId] = true
+		}
+	}
+
+	for _, question := range examVersion.Questions {
+		if !candidateIds[question.QuestionId] {
+			count++
+		}
+	}
+
+	return count
+}
+
+/*
+以題目 id 查詢尚未發佈的測驗作答時出現過的題目，只回傳測驗本身的題目，
+以及測驗擁有者題庫中被測驗固定引用或符合抽題標籤的題目，其他題目 id 會略過
+*/
+func (examService examService) findQuestionsByQuestionIds(
+	ctx context.Context,
+	exam *model.Exam,
+	questionIds []string,
+) ([]model.Question, error) {
+	questions := []model.Question{}
+
+	if exam == nil || len(questionIds) == 0 {
+		return questions, nil
+	}
+
+	examId := exam.Id.Hex()
+	databaseRepository := examService.databaseRepository
+	foundQuestions, err := databaseRepository.FindQuestionsByQuestionIds(ctx, questionIds)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, question := range foundQuestions {
+		if question.ExamId == examId {
+			questions = append(questions, question)
+		}
+	}
+
+	if exam.BankSource == nil {
+		return questions, nil
+	}
+
+	missingIds := []string{}
+
+	for _, questionId := range questionIds {
+		if !primitive.IsValidObjectID(questionId) {
+			continue
+		}
+
+		if !slices.ContainsFunc(questions, func(question model.Question) bool {
+			return question.Id.Hex() == questionId
+		}) {
+			missingIds = append(missingIds, questionId)
+		}
+	}
+
+	if len(missingIds) == 0 {
+		return questions, nil
+	}
+
+	bankQuestions, err := databaseRepository.FindBankQuestionsByIds(ctx, missingIds)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, bankQuestion := range bankQuestions {
+		if isExamBankQuestion(exam, bankQuestion) {
+			questions = append(questions, bankQuestionToQuestion(bankQuestion, examId))
+		}
+	}
+
+	return questions, nil
+}
+
+// 題庫的題目屬於測驗擁有者，並且被測驗固定引用或含有抽題的標籤
+func isExamBankQuestion(exam *model.Exam, bankQuestion model.BankQuestion) bool {
+	if exam.BankSource == nil || bankQuestion.UserId != exam.UserId {
+		return false
+	}
+
+	if slices.Contains(exam.BankSource.QuestionIds, bankQuestion.Id.Hex()) {
+		return true
+	}
+
+	for _, pool := range exam.BankSource.Pools {
+		if slices.Contains(bankQuestion.Tags, pool.Tag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsQuestion(questions []model.Question, questionId primitive.ObjectID) bool {
+	return slices.ContainsFunc(questions, func(question model.Question) bool {
+		return question.Id == questionId
+	})
+}
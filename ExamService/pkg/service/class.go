@@ -0,0 +1,65 @@
+package service
+
+import (
+	"crypto/rand"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/model"
+)
+
+// 加入代碼的長度與字元，排除容易混淆的 0、O、1、I、L
+const (
+	classJoinCodeLength     = 8
+	classJoinCodeCharacters = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+)
+
+// 班級名稱最大長度
+const maxClassNameLength = 50
+
+// 學生在作業期間的成績，這不是 MongoDB 的 document，所以不放在 model 目錄之下
+type ClassGrade struct {
+	AssignmentId     string
+	UserId           string
+	BestPercentage   float64
+	LatestPercentage float64
+	RecordCount      int32
+	LatestCreatedAt  time.Time
+}
+
+// 產生隨機的加入代碼，加入代碼會讓學生看到，所以使用 crypto/rand 避免被猜出其他班級的代碼
+func newClassJoinCode() (string, error) {
+	var builder strings.Builder
+	max := big.NewInt(int64(len(classJoinCodeCharacters)))
+
+	for i := 0; i < classJoinCodeLength; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+
+		builder.WriteByte(classJoinCodeCharacters[n.Int64()])
+	}
+
+	return builder.String(), nil
+}
+
+// 加入代碼不分大小寫，例如 " ab3dk9mz " => "AB3DK9MZ"
+func normalizeClassJoinCode(joinCode string) string {
+	return strings.ToUpper(strings.TrimSpace(joinCode))
+}
+
+func isClassTeacher(class *model.Class, userId string) bool {
+	return class.UserId == userId
+}
+
+func isClassStudent(class *model.Class, userId string) bool {
+	for _, student := range class.Students {
+		if student.UserId == userId {
+			return true
+		}
+	}
+
+	return false
+}
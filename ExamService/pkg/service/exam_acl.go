@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+
+	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/model"
+)
+
+// 使用者對測驗的權限
+const (
+	EXAM_ROLE_OWNER  = "owner"  // 擁有者，可以刪除測驗和管理分享
+	EXAM_ROLE_EDITOR = "editor" // 共同編輯者，可以修改測驗和題目
+	EXAM_ROLE_TAKER  = "taker"  // 作答者，可以作答、匯出和複製測驗
+)
+
+// 回傳使用者對測驗的權限，公開的測驗每個人都是作答者，沒有任何權限時回傳空字串
+func examRole(exam *model.Exam, userId string) string {
+	if exam.UserId == userId {
+		return EXAM_ROLE_OWNER
+	}
+
+	for _, share := range exam.Shares {
+		if share.UserId == userId {
+			return share.Role
+		}
+	}
+
+	if exam.IsPublic {
+		return EXAM_ROLE_TAKER
+	}
+
+	return ""
+}
+
+func isExamOwner(exam *model.Exam, userId string) bool {
+	return examRole(exam, userId) == EXAM_ROLE_OWNER
+}
+
+func canEditExam(exam *model.Exam, userId string) bool {
+	role := examRole(exam, userId)
+	return role == EXAM_ROLE_OWNER || role == EXAM_ROLE_EDITOR
+}
+
+func canTakeExam(exam *model.Exam, userId string) bool {
+	return examRole(exam, userId) != ""
+}
+
+// 只能分享為共同編輯者或作答者
+func isValidShareRole(role string) bool {
+	return role == EXAM_ROLE_EDITOR || role == EXAM_ROLE_TAKER
+}
+
+/*
+檢查使用者是否可以修改題目，題目的擁有者就是測驗的擁有者，
+其他使用者依照所屬測驗的權限判斷
+*/
+func (examService examService) canEditQuestion(
+	ctx context.Context,
+	question *model.Question,
+	userId string,
+) (bool, error) {
+	if question.UserId == userId {
+		return true, nil
+	}
+
+	exam, err := examService.databaseRepository.GetExamById(ctx, question.ExamId)
+	if err != nil {
+		return false, err
+	}
+
+	if exam == nil {
+		return false, nil
+	}
+
+	return canEditExam(exam, userId), nil
+}
+
+// 檢查使用者是否可以作答，測驗指派給使用者所在的班級時，即使是不公開的測驗也可以作答
+func (examService examService) canTakeExamOrAssignment(
+	ctx context.Context,
+	exam *model.Exam,
+	userId string,
+) (bool, error) {
+	if canTakeExam(exam, userId) {
+		return true, nil
+	}
+
+	databaseRepository := examService.databaseRepository
+	classes, err := databaseRepository.FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc(
+		ctx,
+		userId,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	classIds := []string{}
+
+	for _, class := range classes {
+		if isClassStudent(&class, userId) {
+			classIds = append(classIds, class.Id.Hex())
+		}
+	}
+
+	if len(classIds) == 0 {
+		return false, nil
+	}
+
+	count, err := databaseRepository.CountAssignmentsByClassIdsAndExamId(
+		ctx,
+		classIds,
+		exam.Id.Hex(),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/model"
+)
+
+// 從每一題的作答內容產生出現的題目，同一題不能作答兩次
+func examAttemptQuestionIds(answers []model.ExamAttemptAnswer) (questionIds []string, err error) {
+	questionIds = []string{}
+	answered := map[string]bool{}
+
+	for _, answer := range answers {
+		if answer.TimeSpentSeconds < 0 {
+			return nil, fmt.Errorf("Invalid timeSpentSeconds: %d", answer.TimeSpentSeconds)
+		}
+
+		if answered[answer.QuestionId] {
+			return nil, fmt.Errorf("Duplicate answer of question: %s", answer.QuestionId)
+		}
+
+		answered[answer.QuestionId] = true
+		questionIds = append(questionIds, answer.QuestionId)
+	}
+
+	return questionIds, nil
+}
+
+// 同一次作答已經交卷，呼叫端可以用 errors.Is 判斷
+var ExamAttemptSubmittedError = fmt.Errorf("Exam attempt already submitted")
+
+func examAttemptSubmittedError(examAttemptId string) error {
+	return fmt.Errorf("%w: %s", ExamAttemptSubmittedError, examAttemptId)
+}
+
+/*
+依照出題的順序排列作答內容，作答的題目必須是出給使用者的題目，
+沒有作答的題目補上空白的作答，評分時以 0 分計入滿分，
+HintUsed 依照伺服器記錄的 hintQuestionIds 設定
+*/
+func examAttemptAnswers(
+	questionIds, hintQuestionIds []string,
+	answers []model.ExamAttemptAnswer,
+) ([]model.ExamAttemptAnswer, error) {
+	answeredQuestionIds, err := examAttemptQuestionIds(answers)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, questionId := range answeredQuestionIds {
+		if !slices.Contains(questionIds, questionId) {
+			return nil, fmt.Errorf("Invalid questionId: %s", questionId)
+		}
+	}
+
+	answerMap := map[string]model.ExamAttemptAnswer{}
+
+	for _, answer := range answers {
+		answerMap[answer.QuestionId] = answer
+	}
+
+	result := []model.ExamAttemptAnswer{}
+
+	for _, questionId := range questionIds {
+		answer, ok := answerMap[questionId]
+
+		if !ok {
+			answer = model.ExamAttemptAnswer{QuestionId: questionId}
+		}
+
+		answer.HintUsed = slices.Contains(hintQuestionIds, questionId)
+		result = append(result, answer)
+	}
+
+	return result, nil
+}
+
+// 依照作答的順序排列題目，查無的題目（已刪除）不會出現
+func sortQuestionsByQuestionIds(
+	questions []model.Question,
+	questionIds []string,
+) []model.Question {
+	questionMap := map[string]model.Question{}
+
+	for _, question := range questions {
+		questionMap[question.Id.Hex()] = question
+	}
+
+	result := []model.Question{}
+
+	for _, questionId := range questionIds {
+		if question, ok := questionMap[questionId]; ok {
+			result = append(result, question)
+		}
+	}
+
+	return result
+}
+
+// 查詢使用者還沒有交卷的該測驗作答，不是自己開始作答的該測驗時視為查無資料
+func (examService examService) getUnsubmittedExamAttempt(
+	ctx context.Context,
+	examId, examAttemptId, userId string,
+) (*model.ExamAttempt, error) {
+	examAttempt, err := examService.databaseRepository.GetExamAttemptById(ctx, examAttemptId)
+	if err != nil {
+		return nil, err
+	}
+
+	if examAttempt == nil || examAttempt.ExamId != examId || examAttempt.UserId != userId {
+		return nil, fmt.Errorf("Exam attempt not found by id: %s", examAttemptId)
+	}
+
+	if examAttempt.SubmittedAt != nil {
+		return nil, examAttemptSubmittedError(examAttemptId)
+	}
+
+	return examAttempt, nil
+}
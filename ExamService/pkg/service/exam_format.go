@@ -0,0 +1,782 @@
+package service
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/model"
+)
+
+// 匯入、匯出測驗的格式
+const (
+	EXAM_FORMAT_JSON = "json"
+	EXAM_FORMAT_CSV  = "csv"
+	EXAM_FORMAT_GIFT = "gift" // Moodle GIFT
+)
+
+// 匯入時最多的題目數
+const maxImportQuestionCount = 1000
+
+// 匯出時每次查詢的題目數，題目很多時分批查詢並分批傳送
+const exportBatchSize = 100
+
+// CSV 的標題列，答案以 | 分隔，ask 與 answers 以外的欄位可以省略
+var csvHeader = []string{"ask", "answers", "points", "partialCredit", "hint", "explanation"}
+
+// CSV 必要的欄位數
+const csvRequiredColumnCount = 2
+
+const csvAnswerSeparator = "|"
+
+// 匯入的內容有錯誤時，記錄錯誤的行數與原因，這不是 MongoDB 的 document
+type ExamImportError struct {
+	Line    int32
+	Message string
+}
+
+// JSON 格式的測驗，也是各種格式解析後的結果
+type examDocument struct {
+	examDocumentHeader
+	Questions []questionDocument `json:"questions"`
+}
+
+// 匯出 JSON 時先寫入的測驗欄位與題組，題目之後再逐批寫入
+type examDocumentHeader struct {
+	Topic       string            `json:"topic"`
+	Description string            `json:"description"`
+	Tags        []string          `json:"tags"`
+	Sections    []sectionDocument `json:"sections,omitempty"`
+}
+
+// 題組只有 JSON 格式支援，題目以 section 記錄所屬題組在 sections 中的順序，從 1 開始
+type sectionDocument struct {
+	Type     string `json:"type"`
+	Title    string `json:"title,omitempty"`
+	Passage  string `json:"passage,omitempty"`
+	AudioUrl string `json:"audioUrl,omitempty"`
+	line     int32
+}
+
+type questionDocument struct {
+	Ask           string   `json:"ask"`
+	Answers       []string `json:"answers"`
+	Points        int32    `json:"points,omitempty"`
+	PartialCredit bool     `json:"partialCredit,omitempty"`
+	Hint          string   `json:"hint,omitempty"`
+	Explanation   string   `json:"explanation,omitempty"`
+	Section       int32    `json:"section,omitempty"`
+	line          int32
+}
+
+func validateExamFormat(format string) error {
+	switch format {
+	case EXAM_FORMAT_JSON, EXAM_FORMAT_CSV, EXAM_FORMAT_GIFT:
+		return nil
+	default:
+		return fmt.Errorf("Invalid format: %s", format)
+	}
+}
+
+/*
+解析匯入的內容，每個題目的錯誤都會記錄行數，
+有任何錯誤時呼叫端不應該匯入任何題目
+*/
+func parseExamContent(
+	format string,
+	content []byte,
+) (exam examDocument, importErrors []ExamImportError) {
+	switch format {
+	case EXAM_FORMAT_JSON:
+		exam, importErrors = parseExamJSON(content)
+	case EXAM_FORMAT_CSV:
+		exam, importErrors = parseExamCSV(content)
+	case EXAM_FORMAT_GIFT:
+		exam, importErrors = parseExamGIFT(content)
+	}
+
+	for i, section := range exam.Sections {
+		section.Title = strings.TrimSpace(section.Title)
+		section.AudioUrl = strings.TrimSpace(section.AudioUrl)
+		exam.Sections[i] = section
+
+		err := validateExamSection(section.Type, section.Title, section.Passage, section.AudioUrl)
+		if err != nil {
+			importErrors = append(importErrors, ExamImportError{
+				Line:    section.line,
+				Message: err.Error(),
+			})
+		}
+	}
+
+	for i, question := range exam.Questions {
+		question.Ask = strings.TrimSpace(question.Ask)
+		answers := []string{}
+
+		for _, answer := range question.Answers {
+			answer = strings.TrimSpace(answer)
+
+			if answer != "" {
+				answers = append(answers, answer)
+			}
+		}
+
+		question.Answers = answers
+		question.Hint = strings.TrimSpace(question.Hint)
+		question.Explanation = strings.TrimSpace(question.Explanation)
+		exam.Questions[i] = question
+
+		if question.Ask == "" {
+			importErrors = append(importErrors, ExamImportError{
+				Line:    question.line,
+				Message: "Ask is empty",
+			})
+		} else if len(question.Answers) == 0 {
+			importErrors = append(importErrors, ExamImportError{
+				Line:    question.line,
+				Message: "Answers is empty",
+			})
+		} else if err := validateQuestionPoints(question.Points); err != nil {
+			importErrors = append(importErrors, ExamImportError{
+				Line:    question.line,
+				Message: err.Error(),
+			})
+		} else if err := validateQuestionHint(question.Hint, question.Explanation); err != nil {
+			importErrors = append(importErrors, ExamImportError{
+				Line:    question.line,
+				Message: err.Error(),
+			})
+		} else if question.Section < 0 || int(question.Section) > len(exam.Sections) {
+			importErrors = append(importErrors, ExamImportError{
+				Line:    question.line,
+				Message: fmt.Sprintf("Section not found: %d", question.Section),
+			})
+		}
+	}
+
+	// 依行數排序，方便使用者逐行修正
+	slices.SortStableFunc(importErrors, func(a, b ExamImportError) int {
+		return cmp.Compare(a.Line, b.Line)
+	})
+
+	return exam, importErrors
+}
+
+// offset 所在的行數，從 1 開始
+func lineAt(content []byte, offset int64) int32 {
+	offset = min(max(offset, 0), int64(len(content)))
+	return int32(bytes.Count(content[:offset], []byte("\n")) + 1)
+}
+
+// 跳過空白與逗號，找出下一個 JSON 值開始的位置
+func nextJSONValueOffset(content []byte, offset int64) int64 {
+	for offset < int64(len(content)) && strings.ContainsRune(" \t\r\n,", rune(content[offset])) {
+		offset++
+	}
+
+	return offset
+}
+
+func parseExamJSON(content []byte) (exam examDocument, importErrors []ExamImportError) {
+	// 先檢查語法，語法錯誤時無法繼續解析，只回報錯誤的位置
+	var syntaxError *json.SyntaxError
+	if err := json.Unmarshal(content, new(interface{})); errors.As(err, &syntaxError) {
+		return exam, []ExamImportError{{
+			Line:    lineAt(content, syntaxError.Offset),
+			Message: syntaxError.Error(),
+		}}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	addError := func(offset int64, err error) {
+		importErrors = append(importErrors, ExamImportError{
+			Line:    lineAt(content, offset),
+			Message: err.Error(),
+		})
+	}
+
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		addError(0, fmt.Errorf("Content must be a JSON object"))
+		return exam, importErrors
+	}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			addError(decoder.InputOffset(), err)
+			return exam, importErrors
+		}
+
+		offset := nextJSONValueOffset(content, decoder.InputOffset())
+
+		switch token {
+		case "topic":
+			err = decoder.Decode(&exam.Topic)
+		case "description":
+			err = decoder.Decode(&exam.Description)
+		case "tags":
+			err = decoder.Decode(&exam.Tags)
+		case "sections":
+			err = parseJSONSections(content, decoder, &exam, addError)
+		case "questions":
+			err = parseJSONQuestions(content, decoder, &exam, addError)
+		default:
+			// 忽略不認得的欄位
+			err = decoder.Decode(new(json.RawMessage))
+		}
+
+		if err != nil {
+			addError(offset, err)
+		}
+	}
+
+	return exam, importErrors
+}
+
+// 逐題解析 questions 陣列，讓每個題目的錯誤都能對應到所在的行數
+func parseJSONQuestions(
+	content []byte,
+	decoder *json.Decoder,
+	exam *examDocument,
+	addError func(offset int64, err error),
+) error {
+	if token, err := decoder.Token(); err != nil || token != json.Delim('[') {
+		return fmt.Errorf("questions must be an array")
+	}
+
+	for decoder.More() {
+		offset := nextJSONValueOffset(content, decoder.InputOffset())
+
+		var question questionDocument
+		if err := decoder.Decode(&question); err != nil {
+			addError(offset, err)
+			continue
+		}
+
+		question.line = lineAt(content, offset)
+		exam.Questions = append(exam.Questions, question)
+	}
+
+	// 讀取結尾的 ]
+	_, err := decoder.Token()
+	return err
+}
+
+// 逐個解析 sections 陣列，讓每個題組的錯誤都能對應到所在的行數
+func parseJSONSections(
+	content []byte,
+	decoder *json.Decoder,
+	exam *examDocument,
+	addError func(offset int64, err error),
+) error {
+	if token, err := decoder.Token(); err != nil || token != json.Delim('[') {
+		return fmt.Errorf("sections must be an array")
+	}
+
+	for decoder.More() {
+		offset := nextJSONValueOffset(content, decoder.InputOffset())
+
+		var section sectionDocument
+		if err := decoder.Decode(&section); err != nil {
+			addError(offset, err)
+			continue
+		}
+
+		section.line = lineAt(content, offset)
+		exam.Sections = append(exam.Sections, section)
+	}
+
+	// 讀取結尾的 ]
+	_, err := decoder.Token()
+	return err
+}
+
+func parseExamCSV(content []byte) (exam examDocument, importErrors []ExamImportError) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	isFirstRecord := true
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		var parseError *csv.ParseError
+		if errors.As(err, &parseError) {
+			importErrors = append(importErrors, ExamImportError{
+				Line:    int32(parseError.Line),
+				Message: parseError.Err.Error(),
+			})
+			return exam, importErrors
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		// 第一列是標題列時略過
+		if isFirstRecord {
+			isFirstRecord = false
+
+			if strings.EqualFold(strings.TrimSpace(record[0]), csvHeader[0]) {
+				continue
+			}
+		}
+
+		if len(record) < csvRequiredColumnCount || len(record) > len(csvHeader) {
+			importErrors = append(importErrors, ExamImportError{
+				Line: int32(line),
+				Message: fmt.Sprintf(
+					"Expected %d to %d columns but got %d",
+					csvRequiredColumnCount,
+					len(csvHeader),
+					len(record),
+				),
+			})
+			continue
+		}
+
+		question, err := parseCSVQuestion(record)
+		if err != nil {
+			importErrors = append(importErrors, ExamImportError{
+				Line:    int32(line),
+				Message: err.Error(),
+			})
+			continue
+		}
+
+		question.line = int32(line)
+		exam.Questions = append(exam.Questions, question)
+	}
+
+	return exam, importErrors
+}
+
+// 依照 csvHeader 的順序解析一列，省略或空白的欄位使用預設值
+func parseCSVQuestion(record []string) (questionDocument, error) {
+	record = append(record, make([]string, len(csvHeader)-len(record))...)
+	question := questionDocument{
+		Ask:     record[0],
+		Answers: strings.Split(record[1], csvAnswerSeparator),
+	}
+
+	if points := strings.TrimSpace(record[2]); points != "" {
+		value, err := strconv.ParseInt(points, 10, 32)
+		if err != nil {
+			return questionDocument{}, fmt.Errorf("Invalid points: %s", points)
+		}
+
+		question.Points = int32(value)
+	}
+
+	if partialCredit := strings.TrimSpace(record[3]); partialCredit != "" {
+		value, err := strconv.ParseBool(partialCredit)
+		if err != nil {
+			return questionDocument{}, fmt.Errorf("Invalid partialCredit: %s", partialCredit)
+		}
+
+		question.PartialCredit = value
+	}
+
+	question.Hint = record[4]
+	question.Explanation = record[5]
+	return question, nil
+}
+
+// GIFT 中需要跳脫的特殊字元
+const giftSpecialCharacters = `~=#{}:`
+
+func escapeGIFT(s string) string {
+	var builder strings.Builder
+
+	for _, r := range s {
+		if r == '\\' || strings.ContainsRune(giftSpecialCharacters, r) {
+			builder.WriteRune('\\')
+		}
+
+		if r == '\n' {
+			builder.WriteString(`\n`)
+			continue
+		}
+
+		builder.WriteRune(r)
+	}
+
+	return builder.String()
+}
+
+func unescapeGIFT(s string) string {
+	var builder strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+
+			if s[i] == 'n' {
+				builder.WriteByte('\n')
+			} else {
+				builder.WriteByte(s[i])
+			}
+
+			continue
+		}
+
+		builder.WriteByte(s[i])
+	}
+
+	return builder.String()
+}
+
+// 從 from 開始找出沒有被跳脫的 sub 的位置，找不到時回傳 -1
+func indexUnescaped(s, sub string, from int) int {
+	for i := from; i < len(s); i++ {
+		if s[i] == '\\' {
+			i++
+			continue
+		}
+
+		if strings.HasPrefix(s[i:], sub) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+/*
+解析 Moodle GIFT 格式，題目之間以空行分隔，答案區塊中的整體回饋 ####feedback 作為解析，
+GIFT 沒有配分、部分給分與提示的欄位，匯入時使用預設值，只支援可以轉換成簡答題的題型：
+簡答題 {=a =b}、選擇題 {=a ~b}（只取正確答案）、是非題 {T}、數字題 {#1.5:0.1}，
+答案區塊在題目中間時視為填空題，以空格取代答案區塊
+*/
+func parseExamGIFT(content []byte) (exam examDocument, importErrors []ExamImportError) {
+	lines := strings.Split(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n")
+	blockLines := []string{}
+	blockStartLine := 0
+
+	flush := func() {
+		if len(blockLines) == 0 {
+			return
+		}
+
+		question, err := parseGIFTQuestion(strings.Join(blockLines, "\n"))
+		if err != nil {
+			importErrors = append(importErrors, ExamImportError{
+				Line:    int32(blockStartLine),
+				Message: err.Error(),
+			})
+		} else {
+			question.line = int32(blockStartLine)
+			exam.Questions = append(exam.Questions, question)
+		}
+
+		blockLines = []string{}
+	}
+
+	for i, line := range lines {
+		trimmedLine := strings.TrimSpace(line)
+
+		switch {
+		case trimmedLine == "":
+			flush()
+		case strings.HasPrefix(trimmedLine, "//"), strings.HasPrefix(trimmedLine, "$CATEGORY:"):
+			// 註解與分類不影響題目
+		default:
+			if len(blockLines) == 0 {
+				blockStartLine = i + 1
+			}
+
+			blockLines = append(blockLines, line)
+		}
+	}
+
+	flush()
+	return exam, importErrors
+}
+
+func parseGIFTQuestion(text string) (questionDocument, error) {
+	text = strings.TrimSpace(text)
+
+	// 略過題目名稱 ::title::
+	if strings.HasPrefix(text, "::") {
+		end := indexUnescaped(text, "::", 2)
+		if end == -1 {
+			return questionDocument{}, fmt.Errorf("Question title is not closed")
+		}
+
+		text = strings.TrimSpace(text[end+2:])
+	}
+
+	openIndex := indexUnescaped(text, "{", 0)
+	if openIndex == -1 {
+		return questionDocument{}, fmt.Errorf("Answers block {} not found")
+	}
+
+	closeIndex := indexUnescaped(text, "}", openIndex+1)
+	if closeIndex == -1 {
+		return questionDocument{}, fmt.Errorf("Answers block is not closed")
+	}
+
+	before := strings.TrimSpace(text[:openIndex])
+	after := strings.TrimSpace(text[closeIndex+1:])
+	ask := before
+
+	if after != "" {
+		ask = strings.TrimSpace(before + " " + clozeBlank + " " + after)
+	}
+
+	answerText := text[openIndex+1 : closeIndex]
+	explanation := ""
+
+	if feedback := indexUnescaped(answerText, "####", 0); feedback != -1 {
+		explanation = unescapeGIFT(strings.TrimSpace(answerText[feedback+4:]))
+		answerText = answerText[:feedback]
+	}
+
+	answers, err := parseGIFTAnswers(strings.TrimSpace(answerText))
+	if err != nil {
+		return questionDocument{}, err
+	}
+
+	return questionDocument{
+		Ask:         unescapeGIFT(ask),
+		Answers:     answers,
+		Explanation: explanation,
+	}, nil
+}
+
+func parseGIFTAnswers(answerText string) ([]string, error) {
+	switch strings.ToUpper(answerText) {
+	case "T", "TRUE":
+		return []string{"true"}, nil
+	case "F", "FALSE":
+		return []string{"false"}, nil
+	}
+
+	// 數字題只取答案，忽略誤差範圍
+	if strings.HasPrefix(answerText, "#") {
+		answer := answerText[1:]
+
+		if end := indexUnescaped(answer, ":", 0); end != -1 {
+			answer = answer[:end]
+		}
+
+		return []string{unescapeGIFT(strings.TrimSpace(answer))}, nil
+	}
+
+	answers := []string{}
+	start := -1
+
+	addAnswer := func(end int) {
+		if start == -1 || answerText[start] != '=' {
+			return
+		}
+
+		answer := answerText[start+1 : end]
+
+		// 移除回饋 #feedback
+		if feedback := indexUnescaped(answer, "#", 0); feedback != -1 {
+			answer = answer[:feedback]
+		}
+
+		answers = append(answers, unescapeGIFT(strings.TrimSpace(answer)))
+	}
+
+	for i := 0; i < len(answerText); i++ {
+		if answerText[i] == '\\' {
+			i++
+			continue
+		}
+
+		if answerText[i] == '=' || answerText[i] == '~' {
+			addAnswer(i)
+			start = i
+		}
+	}
+
+	addAnswer(len(answerText))
+
+	if len(answers) == 0 {
+		return nil, fmt.Errorf("No correct answer found")
+	}
+
+	return answers, nil
+}
+
+// 將測驗分批寫成指定的格式，讓題目很多時也能一邊查詢一邊傳送，只有 JSON 格式會寫入題組
+type examExporter interface {
+	writeHeader(exam *model.Exam, sections []model.ExamSection) []byte
+	writeQuestions(questions []model.Question) []byte
+	writeFooter() []byte
+}
+
+func newExamExporter(format string) examExporter {
+	switch format {
+	case EXAM_FORMAT_CSV:
+		return &csvExamExporter{}
+	case EXAM_FORMAT_GIFT:
+		return &giftExamExporter{}
+	default:
+		return &jsonExamExporter{}
+	}
+}
+
+type jsonExamExporter struct {
+	questionCount int
+
+	// 題組 id 對應到 sections 中的順序
+	sectionNumbers map[string]int32
+}
+
+func (exporter *jsonExamExporter) writeHeader(exam *model.Exam, sections []model.ExamSection) []byte {
+	sectionDocuments := []sectionDocument{}
+	exporter.sectionNumbers = map[string]int32{}
+
+	for i, section := range sections {
+		sectionDocuments = append(sectionDocuments, sectionDocument{
+			Type:     section.Type,
+			Title:    section.Title,
+			Passage:  section.Passage,
+			AudioUrl: section.AudioUrl,
+		})
+		exporter.sectionNumbers[section.Id.Hex()] = int32(i + 1)
+	}
+
+	header, _ := json.Marshal(examDocumentHeader{
+		Topic:       exam.Topic,
+		Description: exam.Description,
+		Tags:        exam.Tags,
+		Sections:    sectionDocuments,
+	})
+
+	// 物件的結尾 } 改成題目陣列的開頭，之後再逐批寫入題目
+	header = header[:len(header)-1]
+	return append(header, []byte(",\"questions\":[\n")...)
+}
+
+func (exporter *jsonExamExporter) writeQuestions(questions []model.Question) []byte {
+	var buffer bytes.Buffer
+
+	for _, question := range questions {
+		if exporter.questionCount > 0 {
+			buffer.WriteString(",\n")
+		}
+
+		data, _ := json.Marshal(questionDocument{
+			Ask:           question.Ask,
+			Answers:       question.Answers,
+			Points:        question.Points,
+			PartialCredit: question.PartialCredit,
+			Hint:          question.Hint,
+			Explanation:   question.Explanation,
+			Section:       exporter.sectionNumbers[question.SectionId],
+		})
+		buffer.WriteString("  ")
+		buffer.Write(data)
+		exporter.questionCount++
+	}
+
+	return buffer.Bytes()
+}
+
+func (exporter *jsonExamExporter) writeFooter() []byte {
+	return []byte("\n]}\n")
+}
+
+type csvExamExporter struct{}
+
+func (exporter *csvExamExporter) writeHeader(
+	exam *model.Exam,
+	sections []model.ExamSection,
+) []byte {
+	return writeCSVRecords([][]string{csvHeader})
+}
+
+func (exporter *csvExamExporter) writeQuestions(questions []model.Question) []byte {
+	records := [][]string{}
+
+	for _, question := range questions {
+		points := ""
+
+		if question.Points > 0 {
+			points = strconv.Itoa(int(question.Points))
+		}
+
+		records = append(records, []string{
+			question.Ask,
+			strings.Join(question.Answers, csvAnswerSeparator),
+			points,
+			strconv.FormatBool(question.PartialCredit),
+			question.Hint,
+			question.Explanation,
+		})
+	}
+
+	return writeCSVRecords(records)
+}
+
+func (exporter *csvExamExporter) writeFooter() []byte {
+	return nil
+}
+
+func writeCSVRecords(records [][]string) []byte {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	writer.WriteAll(records)
+	return buffer.Bytes()
+}
+
+type giftExamExporter struct {
+	questionCount int
+}
+
+func (exporter *giftExamExporter) writeHeader(
+	exam *model.Exam,
+	sections []model.ExamSection,
+) []byte {
+	var buffer bytes.Buffer
+
+	// GIFT 沒有測驗的欄位，以註解記錄主題與說明
+	for _, text := range []string{exam.Topic, exam.Description} {
+		for _, line := range strings.Split(text, "\n") {
+			if strings.TrimSpace(line) != "" {
+				buffer.WriteString("// " + line + "\n")
+			}
+		}
+	}
+
+	buffer.WriteString("\n")
+	return buffer.Bytes()
+}
+
+func (exporter *giftExamExporter) writeQuestions(questions []model.Question) []byte {
+	var buffer bytes.Buffer
+
+	for _, question := range questions {
+		exporter.questionCount++
+		fmt.Fprintf(&buffer, "::Q%d:: %s {", exporter.questionCount, escapeGIFT(question.Ask))
+
+		for _, answer := range question.Answers {
+			buffer.WriteString(" =" + escapeGIFT(answer))
+		}
+
+		if question.Explanation != "" {
+			buffer.WriteString(" ####" + escapeGIFT(question.Explanation))
+		}
+
+		buffer.WriteString(" }\n\n")
+	}
+
+	return buffer.Bytes()
+}
+
+func (exporter *giftExamExporter) writeFooter() []byte {
+	return nil
+}
@@ -0,0 +1,377 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"time"
+	_ "time/tzdata" // 內嵌時區資料，執行環境沒有安裝時區資料也能使用 IANA 時區
+
+	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/model"
+)
+
+// 作答紀錄總覽的期間
+const (
+	OVERVIEW_RANGE_7_DAYS  = "7d"
+	OVERVIEW_RANGE_30_DAYS = "30d"
+	OVERVIEW_RANGE_90_DAYS = "90d"
+	OVERVIEW_RANGE_CUSTOM  = "custom"
+)
+
+// 作答分數的趨勢
+const (
+	TREND_IMPROVING         = "improving"
+	TREND_DECLINING         = "declining"
+	TREND_STABLE            = "stable"
+	TREND_INSUFFICIENT_DATA = "insufficient_data" // 作答的天數不足兩天，無法判斷趨勢
+)
+
+const (
+	maxOverviewDays            = 366 // 自訂期間最多的天數
+	defaultMovingAverageWindow = 7
+	maxMovingAverageWindow     = 30
+
+	// 期間內依趨勢線預估的分數變化小於此值時視為持平
+	trendStableThreshold = 0.5
+)
+
+var overviewRangeDays = map[string]int{
+	OVERVIEW_RANGE_7_DAYS:  7,
+	OVERVIEW_RANGE_30_DAYS: 30,
+	OVERVIEW_RANGE_90_DAYS: 90,
+}
+
+// 使用者時區中一天的作答統計，這不是 MongoDB 的 document，所以不放在 model 目錄之下
+type DailyExamRecordStat struct {
+	Date         string // 格式為 2006-01-02
+	RecordCount  int32
+	AverageScore float64
+	BestScore    float64
+	WorstScore   float64
+
+	// 包含當天在內最近 N 天全部作答的平均分數，這 N 天都沒有作答時為 0
+	MovingAverage float64
+}
+
+// 使用者時區中每個小時的作答統計
+type HourOfDayStat struct {
+	Hour         int32
+	RecordCount  int32
+	AverageScore float64
+}
+
+// 期間內作答紀錄的分析結果，分數都是作答紀錄的百分比分數
+type ExamRecordAnalytics struct {
+	Timezone            string
+	StartDate           string
+	EndDate             string
+	RecordCount         int32
+	AverageScore        float64
+	BestScore           float64
+	BestScoreAt         time.Time
+	WorstScore          float64
+	WorstScoreAt        time.Time
+	TrendSlope          float64 // 趨勢線每天的分數變化
+	Trend               string
+	CurrentStreakDays   int32
+	LongestStreakDays   int32
+	MovingAverageWindow int32
+	DailyStats          []DailyExamRecordStat
+	HourOfDayStats      []HourOfDayStat
+}
+
+func loadOverviewLocation(timezone string) (*time.Location, error) {
+	if timezone == "" {
+		return time.UTC, nil
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid timezone: %s", timezone)
+	}
+
+	return location, nil
+}
+
+/*
+計算總覽期間，回傳使用者時區中開始日期的 00:00 與結束日期隔天的 00:00（不包含），
+自訂期間只使用 startDate、endDate 的日期部分，endDate 沒有值時為今天，
+沒有指定期間時，有 startDate 就視為自訂期間，否則為最近 30 天
+*/
+func overviewPeriod(
+	dateRange string,
+	startDate, endDate, now time.Time,
+	location *time.Location,
+) (startAt, endAt time.Time, err error) {
+	today := startOfDay(now.In(location), location)
+
+	if dateRange == "" {
+		dateRange = OVERVIEW_RANGE_30_DAYS
+
+		if !startDate.IsZero() {
+			dateRange = OVERVIEW_RANGE_CUSTOM
+		}
+	}
+
+	if days, ok := overviewRangeDays[dateRange]; ok {
+		return today.AddDate(0, 0, -(days - 1)), today.AddDate(0, 0, 1), nil
+	}
+
+	if dateRange != OVERVIEW_RANGE_CUSTOM {
+		return time.Time{}, time.Time{}, fmt.Errorf("Invalid range: %s", dateRange)
+	}
+
+	if startDate.IsZero() {
+		return time.Time{}, time.Time{}, fmt.Errorf("Invalid startDate: %s", "empty")
+	}
+
+	startAt = startOfDay(startDate.UTC(), location)
+	endAt = today.AddDate(0, 0, 1)
+
+	if !endDate.IsZero() {
+		endAt = startOfDay(endDate.UTC(), location).AddDate(0, 0, 1)
+	}
+
+	if !endAt.After(startAt) {
+		return time.Time{}, time.Time{}, fmt.Errorf(
+			"Invalid endDate: %s",
+			endDate.UTC().Format(time.DateOnly),
+		)
+	}
+
+	if startAt.AddDate(0, 0, maxOverviewDays).Before(endAt) {
+		return time.Time{}, time.Time{}, fmt.Errorf(
+			"Invalid range: more than %d days",
+			maxOverviewDays,
+		)
+	}
+
+	return startAt, endAt, nil
+}
+
+// 以 t 的年月日在 location 中的 00:00
+func startOfDay(t time.Time, location *time.Location) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, location)
+}
+
+/*
+計算期間內的每日統計、移動平均、最佳與最差分數、趨勢、每小時統計與連續作答天數，
+無法換算成百分比的舊作答紀錄不列入分析，避免答對題數和百分比混在一起計算
+*/
+func newExamRecordAnalytics(
+	examRecords []model.ExamRecord,
+	startAt, endAt time.Time,
+	location *time.Location,
+	movingAverageWindow int32,
+) ExamRecordAnalytics {
+	examRecords = percentageExamRecords(examRecords)
+	analytics := ExamRecordAnalytics{
+		Timezone:            location.String(),
+		StartDate:           startAt.Format(time.DateOnly),
+		EndDate:             endAt.AddDate(0, 0, -1).Format(time.DateOnly),
+		RecordCount:         int32(len(examRecords)),
+		Trend:               TREND_INSUFFICIENT_DATA,
+		MovingAverageWindow: movingAverageWindow,
+		DailyStats:          []DailyExamRecordStat{},
+		HourOfDayStats:      []HourOfDayStat{},
+	}
+
+	// 依照使用者時區的日期與小時分組
+	dateScores := map[string][]float64{}
+	hourScores := make([][]float64, 24)
+
+	for i, examRecord := range examRecords {
+		score, _ := examRecordPercentage(examRecord)
+		createdAt := examRecord.CreatedAt.In(location)
+		date := createdAt.Format(time.DateOnly)
+		dateScores[date] = append(dateScores[date], score)
+		hourScores[createdAt.Hour()] = append(hourScores[createdAt.Hour()], score)
+
+		if i == 0 || score > analytics.BestScore {
+			analytics.BestScore = score
+			analytics.BestScoreAt = examRecord.CreatedAt
+		}
+
+		if i == 0 || score < analytics.WorstScore {
+			analytics.WorstScore = score
+			analytics.WorstScoreAt = examRecord.CreatedAt
+		}
+	}
+
+	analytics.AverageScore = averageScore(allScores(examRecords))
+
+	// 每日統計，沒有作答的日期也列出，方便前端畫圖
+	dates := []string{}
+
+	for day := startAt; day.Before(endAt); day = day.AddDate(0, 0, 1) {
+		dates = append(dates, day.Format(time.DateOnly))
+	}
+
+	for i, date := range dates {
+		scores := dateScores[date]
+		dailyStat := DailyExamRecordStat{
+			Date:         date,
+			RecordCount:  int32(len(scores)),
+			AverageScore: averageScore(scores),
+		}
+
+		if len(scores) > 0 {
+			dailyStat.BestScore = maxScore(scores)
+			dailyStat.WorstScore = minScore(scores)
+		}
+
+		windowScores := []float64{}
+
+		for j := max(0, i-int(movingAverageWindow)+1); j <= i; j++ {
+			windowScores = append(windowScores, dateScores[dates[j]]...)
+		}
+
+		dailyStat.MovingAverage = averageScore(windowScores)
+		analytics.DailyStats = append(analytics.DailyStats, dailyStat)
+	}
+
+	for hour, scores := range hourScores {
+		analytics.HourOfDayStats = append(analytics.HourOfDayStats, HourOfDayStat{
+			Hour:         int32(hour),
+			RecordCount:  int32(len(scores)),
+			AverageScore: averageScore(scores),
+		})
+	}
+
+	analytics.TrendSlope, analytics.Trend = scoreTrend(examRecords, startAt)
+	analytics.CurrentStreakDays, analytics.LongestStreakDays = streakDays(dates, dateScores)
+	return analytics
+}
+
+/*
+以最小平方法計算分數對天數的趨勢線斜率，
+作答的期間乘上斜率就是預估的分數變化，依此判斷進步、退步或持平
+*/
+func scoreTrend(examRecords []model.ExamRecord, startAt time.Time) (slope float64, trend string) {
+	if len(examRecords) < 2 {
+		return 0, TREND_INSUFFICIENT_DATA
+	}
+
+	n := float64(len(examRecords))
+	var sumX, sumY, sumXY, sumXX float64
+	minX := math.MaxFloat64
+	maxX := -math.MaxFloat64
+
+	for _, examRecord := range examRecords {
+		x := examRecord.CreatedAt.Sub(startAt).Hours() / 24
+		y, _ := examRecordPercentage(examRecord)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+		minX = math.Min(minX, x)
+		maxX = math.Max(maxX, x)
+	}
+
+	// 作答的期間不足一天無法判斷趨勢
+	denominator := n*sumXX - sumX*sumX
+
+	if maxX-minX < 1 || denominator == 0 {
+		return 0, TREND_INSUFFICIENT_DATA
+	}
+
+	slope = (n*sumXY - sumX*sumY) / denominator
+	change := slope * (maxX - minX)
+
+	switch {
+	case math.Abs(change) < trendStableThreshold:
+		return slope, TREND_STABLE
+	case change > 0:
+		return slope, TREND_IMPROVING
+	default:
+		return slope, TREND_DECLINING
+	}
+}
+
+/*
+計算期間內最長的連續作答天數，以及到期間最後一天為止的連續作答天數，
+最後一天（通常是今天）還沒作答時，從前一天開始計算
+*/
+func streakDays(dates []string, dateScores map[string][]float64) (current, longest int32) {
+	var streak int32 = 0
+
+	for _, date := range dates {
+		if len(dateScores[date]) == 0 {
+			streak = 0
+			continue
+		}
+
+		streak++
+		longest = max(longest, streak)
+	}
+
+	last := len(dates) - 1
+
+	if last >= 0 && len(dateScores[dates[last]]) == 0 {
+		last--
+	}
+
+	for i := last; i >= 0 && len(dateScores[dates[i]]) > 0; i-- {
+		current++
+	}
+
+	return current, longest
+}
+
+// 只保留可以換算成百分比的作答紀錄
+func percentageExamRecords(examRecords []model.ExamRecord) []model.ExamRecord {
+	result := []model.ExamRecord{}
+
+	for _, examRecord := range examRecords {
+		if _, ok := examRecordPercentage(examRecord); ok {
+			result = append(result, examRecord)
+		}
+	}
+
+	return result
+}
+
+func allScores(examRecords []model.ExamRecord) []float64 {
+	scores := []float64{}
+
+	for _, examRecord := range examRecords {
+		score, _ := examRecordPercentage(examRecord)
+		scores = append(scores, score)
+	}
+
+	return scores
+}
+
+func averageScore(scores []float64) float64 {
+	if len(scores) == 0 {
+		return 0
+	}
+
+	var total float64 = 0
+
+	for _, score := range scores {
+		total += score
+	}
+
+	return total / float64(len(scores))
+}
+
+func maxScore(scores []float64) float64 {
+	result := scores[0]
+
+	for _, score := range scores {
+		result = max(result, score)
+	}
+
+	return result
+}
+
+func minScore(scores []float64) float64 {
+	result := scores[0]
+
+	for _, score := range scores {
+		result = min(result, score)
+	}
+
+	return result
+}
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/model"
+)
+
+const (
+	defaultQuestionPoints = 1
+	maxQuestionPoints     = 100
+)
+
+// 題目的配分，沒有設定配分的題目為 1 分
+func questionPoints(question model.Question) int32 {
+	if question.Points <= 0 {
+		return defaultQuestionPoints
+	}
+
+	return question.Points
+}
+
+// 配分為 0 表示使用預設的配分
+func validateQuestionPoints(points int32) error {
+	if points < 0 || points > maxQuestionPoints {
+		return fmt.Errorf("Invalid points: %d", points)
+	}
+
+	return nil
+}
+
+// 每個填空可以接受的其他答案不能多於填空的數量
+func validateQuestionAcceptedAnswers(answers []string, acceptedAnswers [][]string) error {
+	if len(acceptedAnswers) > len(answers) {
+		return fmt.Errorf("Invalid acceptedAnswers size: %d", len(acceptedAnswers))
+	}
+
+	return nil
+}
+
+// 填空去除前後空白後與答案或該填空可以接受的其他答案之一完全相同即為答對
+func isBlankAnswerAccepted(question model.Question, index int, blankAnswer string) bool {
+	if blankAnswer == strings.TrimSpace(question.Answers[index]) {
+		return true
+	}
+
+	if index >= len(question.AcceptedAnswers) {
+		return false
+	}
+
+	for _, acceptedAnswer := range question.AcceptedAnswers[index] {
+		if blankAnswer == strings.TrimSpace(acceptedAnswer) {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+比對作答內容與題目的答案，每個填空去除前後空白後必須與答案或可以接受的其他答案完全相同，
+全部填空都答對才算答對，可以部分給分的題目依照答對的填空數給分，
+查看提示的題目再依照測驗設定扣分
+*/
+func gradeAttemptAnswer(
+	question model.Question,
+	answer model.ExamAttemptAnswer,
+	hintPenaltyPercent int32,
+) model.ExamAttemptAnswer {
+	blankAnswers := answer.BlankAnswers
+
+	// 只有一個填空時，可以只傳送 Answer
+	if len(blankAnswers) == 0 && answer.Answer != "" {
+		blankAnswers = []string{answer.Answer}
+	}
+
+	gradedBlankAnswers := []string{}
+	matchedCount := 0
+
+	for i := range question.Answers {
+		blankAnswer := ""
+
+		if i < len(blankAnswers) {
+			blankAnswer = strings.TrimSpace(blankAnswers[i])
+		}
+
+		if isBlankAnswerAccepted(question, i, blankAnswer) {
+			matchedCount++
+		}
+
+		gradedBlankAnswers = append(gradedBlankAnswers, blankAnswer)
+	}
+
+	answer.BlankAnswers = gradedBlankAnswers
+	answer.Answer = strings.Join(gradedBlankAnswers, ", ")
+	answer.IsCorrect = len(question.Answers) > 0 && matchedCount == len(question.Answers)
+	credit := 0.0
+
+	if answer.IsCorrect {
+		credit = 1
+	} else if question.PartialCredit && len(question.Answers) > 0 {
+		credit = float64(matchedCount) / float64(len(question.Answers))
+	}
+
+	answer.Credit = applyHintPenalty(credit, answer.HintUsed, hintPenaltyPercent)
+	return answer
+}
+
+/*
+在後端評分整次作答，作答的題目都必須是 questions 之一，
+回傳的作答紀錄只包含評分的結果：出現的題目、答錯的題目、答對題數、
+評分後的作答內容，以及依照題目配分計算的得分、滿分與百分比
+*/
+func gradeExamAttempt(
+	questions []model.Question,
+	answers []model.ExamAttemptAnswer,
+	hintPenaltyPercent int32,
+) (model.ExamRecord, error) {
+	questionMap := questionMapById(questions)
+	examRecord := model.ExamRecord{
+		QuestionIds:      []string{},
+		WrongQuestionIds: []string{},
+		Answers:          []model.ExamAttemptAnswer{},
+	}
+	earnedPoints := 0.0
+
+	for _, answer := range answers {
+		question, ok := questionMap[answer.QuestionId]
+
+		if !ok {
+			return model.ExamRecord{}, fmt.Errorf("Invalid questionId: %s", answer.QuestionId)
+		}
+
+		answer = gradeAttemptAnswer(question, answer, hintPenaltyPercent)
+		points := questionPoints(question)
+		earnedPoints += float64(points) * answer.Credit
+		examRecord.MaxPoints += points
+		examRecord.QuestionIds = append(examRecord.QuestionIds, answer.QuestionId)
+		examRecord.Answers = append(examRecord.Answers, answer)
+
+		if answer.IsCorrect {
+			examRecord.Score++
+		} else {
+			examRecord.WrongQuestionIds = append(examRecord.WrongQuestionIds, answer.QuestionId)
+		}
+	}
+
+	examRecord.EarnedPoints = roundPoints(earnedPoints)
+	examRecord.Percentage = pointsPercentage(examRecord.EarnedPoints, examRecord.MaxPoints)
+	return examRecord, nil
+}
+
+// 得分佔滿分的百分比
+func pointsPercentage(earnedPoints float64, maxPoints int32) float64 {
+	if maxPoints == 0 {
+		return 0
+	}
+
+	return roundPoints(earnedPoints * 100 / float64(maxPoints))
+}
+
+// 四捨五入到小數點後兩位
+func roundPoints(points float64) float64 {
+	return math.Round(points*100) / 100
+}
+
+/*
+作答紀錄的百分比分數，
+舊的作答紀錄沒有配分資料時，以答對題數佔題數的比例計算，
+更舊的作答紀錄連題目都沒有記錄，無法換算成百分比，ok 為 false
+*/
+func examRecordPercentage(examRecord model.ExamRecord) (percentage float64, ok bool) {
+	if examRecord.MaxPoints > 0 {
+		return examRecord.Percentage, true
+	}
+
+	if len(examRecord.QuestionIds) > 0 {
+		return roundPoints(
+			float64(examRecord.Score) * 100 / float64(len(examRecord.QuestionIds)),
+		), true
+	}
+
+	return 0, false
+}
+
+// 查詢作答的題目，已發佈的測驗使用該版本的題目快照，找不到快照時使用目前的題目
+func (examService examService) findExamRecordQuestions(
+	ctx context.Context,
+	exam *model.Exam,
+	examVersion int32,
+	questionIds []string,
+) ([]model.Question, error) {
+	databaseRepository := examService.databaseRepository
+
+	if examVersion > 0 {
+		snapshot, err := databaseRepository.GetExamVersionByExamIdAndVersion(
+			ctx,
+			exam.Id.Hex(),
+			examVersion,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if snapshot != nil {
+			return examVersionQuestions(snapshot), nil
+		}
+	}
+
+	return examService.findQuestionsByQuestionIds(ctx, exam, questionIds)
+}
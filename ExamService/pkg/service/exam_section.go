@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/model"
+)
+
+// 題組的類型
+const (
+	EXAM_SECTION_TYPE_READING   = "reading"
+	EXAM_SECTION_TYPE_LISTENING = "listening"
+)
+
+const (
+	maxSectionTitleLength    = 200
+	maxSectionPassageLength  = 10000
+	maxSectionAudioUrlLength = 2000
+)
+
+// 閱讀題組必須有文章，聽力題組必須有 http 或 https 的音檔網址
+func validateExamSection(sectionType, title, passage, audioUrl string) error {
+	if utf8.RuneCountInString(title) > maxSectionTitleLength {
+		return fmt.Errorf("Title is too long: %d", utf8.RuneCountInString(title))
+	}
+
+	switch sectionType {
+	case EXAM_SECTION_TYPE_READING:
+		if strings.TrimSpace(passage) == "" {
+			return fmt.Errorf("Passage is empty")
+		}
+
+		if utf8.RuneCountInString(passage) > maxSectionPassageLength {
+			return fmt.Errorf("Passage is too long: %d", utf8.RuneCountInString(passage))
+		}
+	case EXAM_SECTION_TYPE_LISTENING:
+		if len(audioUrl) > maxSectionAudioUrlLength {
+			return fmt.Errorf("AudioUrl is too long: %d", len(audioUrl))
+		}
+
+		parsedUrl, err := url.ParseRequestURI(audioUrl)
+		if err != nil || (parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") ||
+			parsedUrl.Host == "" {
+			return fmt.Errorf("Invalid audioUrl: %s", audioUrl)
+		}
+	default:
+		return fmt.Errorf("Invalid section type: %s", sectionType)
+	}
+
+	return nil
+}
+
+// 將測驗版本的題組快照轉成 ExamSection，Id 使用原本題組的 id
+func examVersionSections(examVersion *model.ExamVersion) []model.ExamSection {
+	sections := []model.ExamSection{}
+
+	for _, versionSection := range examVersion.Sections {
+		id, _ := primitive.ObjectIDFromHex(versionSection.SectionId)
+		sections = append(sections, model.ExamSection{
+			Id:        id,
+			ExamId:    examVersion.ExamId,
+			Type:      versionSection.Type,
+			Title:     versionSection.Title,
+			Passage:   versionSection.Passage,
+			AudioUrl:  versionSection.AudioUrl,
+			Order:     versionSection.Order,
+			UserId:    examVersion.UserId,
+			CreatedAt: examVersion.CreatedAt,
+			UpdatedAt: examVersion.CreatedAt,
+		})
+	}
+
+	return sections
+}
+
+/*
+將題目依照題組分組，同一題組的題目依照出現的順序放在同一組，
+組的順序是該組第一題出現的順序，獨立的題目自成一組
+*/
+func groupQuestionsBySection(questions []model.Question) [][]model.Question {
+	groups := [][]model.Question{}
+	groupIndexBySectionId := map[string]int{}
+
+	for _, question := range questions {
+		if question.SectionId == "" {
+			groups = append(groups, []model.Question{question})
+			continue
+		}
+
+		if index, ok := groupIndexBySectionId[question.SectionId]; ok {
+			groups[index] = append(groups[index], question)
+			continue
+		}
+
+		groupIndexBySectionId[question.SectionId] = len(groups)
+		groups = append(groups, []model.Question{question})
+	}
+
+	return groups
+}
+
+func shuffleQuestionGroups(groups [][]model.Question, rng *rand.Rand) {
+	rng.Shuffle(len(groups), func(i, j int) {
+		groups[i], groups[j] = groups[j], groups[i]
+	})
+}
+
+/*
+依照順序選出題數不超過 size 的整組題目，題組不會被拆開，
+若第一組的題數就超過 size，仍然選出第一組，避免沒有題目可以作答
+*/
+func selectQuestionGroups(groups [][]model.Question, size int32) []model.Question {
+	questions := []model.Question{}
+
+	for _, group := range groups {
+		if int32(len(questions)+len(group)) > size {
+			continue
+		}
+
+		questions = append(questions, group...)
+	}
+
+	if len(questions) == 0 && len(groups) > 0 {
+		questions = append(questions, groups[0]...)
+	}
+
+	return questions
+}
+
+// 找出題目用到的題組，依照題目出現的順序
+func sectionsOfQuestions(
+	sections []model.ExamSection,
+	questions []model.Question,
+) []model.ExamSection {
+	sectionsById := map[string]model.ExamSection{}
+
+	for _, section := range sections {
+		sectionsById[section.Id.Hex()] = section
+	}
+
+	result := []model.ExamSection{}
+	added := map[string]bool{}
+
+	for _, question := range questions {
+		section, ok := sectionsById[question.SectionId]
+		if !ok || added[question.SectionId] {
+			continue
+		}
+
+		added[question.SectionId] = true
+		result = append(result, section)
+	}
+
+	return result
+}
+
+// 題目指定的題組必須存在且屬於同一個測驗，空字串表示獨立的題目
+func (examService examService) checkQuestionSection(
+	ctx context.Context,
+	examId, sectionId string,
+) error {
+	if sectionId == "" {
+		return nil
+	}
+
+	section, err := examService.databaseRepository.GetExamSectionById(ctx, sectionId)
+	if err != nil {
+		return err
+	}
+
+	if section == nil || section.ExamId != examId {
+		return fmt.Errorf("Exam section not found by id: %s", sectionId)
+	}
+
+	return nil
+}
+
+// 查詢題組並檢查使用者是否是題組所屬測驗的擁有者或共同編輯者
+func (examService examService) findEditableExamSection(
+	ctx context.Context,
+	sectionId, userId string,
+) (*model.ExamSection, error) {
+	databaseRepository := examService.databaseRepository
+	section, err := databaseRepository.GetExamSectionById(ctx, sectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if section == nil {
+		return nil, fmt.Errorf("Exam section not found by id: %s", sectionId)
+	}
+
+	exam, err := databaseRepository.GetExamById(ctx, section.ExamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if exam == nil || !canEditExam(exam, userId) {
+		return nil, unauthorizedOperationError
+	}
+
+	return section, nil
+}
@@ -7,10 +7,12 @@ import (
 	"math"
 	"math/rand"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/model"
 	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/repository"
@@ -23,6 +25,7 @@ type ExamInfo struct {
 	ExamId        string
 	Topic         string
 	Description   string
+	Tags          []string
 	IsPublic      bool
 	QuestionCount int32
 	RecordCount   int32
@@ -31,66 +34,283 @@ type ExamInfo struct {
 type ExamService interface {
 	// Exam
 	CreateExam(
-		ctx context.Context, topic, description string, isPublic bool, userId string) (string, error)
+		ctx context.Context,
+		topic, description string,
+		tags []string,
+		isPublic bool,
+		userId string,
+	) (string, error)
 	UpdateExam(
-		ctx context.Context, examId, topic, description string, isPublic bool, userId string) (string, error)
+		ctx context.Context,
+		examId, topic, description string,
+		tags []string,
+		isPublic bool,
+		settings *model.ExamSettings,
+		expectedVersion *int32,
+		userId string,
+	) (updatedExamId string, version int32, err error)
+	GetExam(ctx context.Context, examId, userId string) (exam *model.Exam, err error)
 	FindExams(
 		ctx context.Context,
 		pageIndex, pageSize int32,
-		userId string,
-	) (total, pageCount int32, exams []model.Exam, err error)
+		userId, cursor string,
+	) (total, pageCount int32, exams []model.Exam, nextCursor string, err error)
 	DeleteExam(
 		ctx context.Context, examId, userId string) error
+	CreatePracticeExam(
+		ctx context.Context,
+		topic, description string,
+		practiceWords []PracticeWord,
+		questionTypes []string,
+		userId string,
+	) (examId string, questionCount int32, err error)
+	FindExamsByTags(
+		ctx context.Context,
+		tags []string,
+		userId string,
+		pageIndex, pageSize int32,
+	) (total, pageCount int32, exams []model.Exam, err error)
+	ForkExam(
+		ctx context.Context,
+		examId, userId string,
+	) (forkedExamId string, questionCount int32, err error)
+	PublishExam(
+		ctx context.Context,
+		examId, userId string,
+	) (version, questionCount int32, err error)
+	ShareExam(ctx context.Context, examId, sharedUserId, role, userId string) error
+	UnshareExam(ctx context.Context, examId, sharedUserId, userId string) error
+	FindExamShares(
+		ctx context.Context,
+		examId, userId string,
+	) (shares []model.ExamShare, err error)
+	ImportExam(
+		ctx context.Context,
+		topic, description string,
+		tags []string,
+		format string,
+		content []byte,
+		userId string,
+	) (examId string, questionCount int32, importErrors []ExamImportError, err error)
+	ExportExam(
+		ctx context.Context,
+		examId, userId, format string,
+		send func(chunk []byte) error,
+	) error
+	FindExamTagCounts(
+		ctx context.Context,
+		userId string,
+		size int32,
+	) (tagCounts []repository.TagCount, err error)
 
 	// Question
 	CreateQuestion(
-		ctx context.Context, examId, ask string, answers []string, userId string) (string, error)
+		ctx context.Context,
+		examId, ask string,
+		answers []string,
+		acceptedAnswers [][]string,
+		points int32,
+		partialCredit bool,
+		hint, explanation, sectionId string,
+		userId string,
+	) (string, error)
 	UpdateQuestion(
 		ctx context.Context,
 		questionId, ask string,
 		answers []string,
+		acceptedAnswers [][]string,
+		points int32,
+		partialCredit bool,
+		hint, explanation, sectionId string,
+		expectedVersion *int32,
 		userId string,
-	) (string, error)
+	) (updatedQuestionId string, version int32, err error)
+	GetQuestion(
+		ctx context.Context,
+		questionId, userId string,
+	) (question *model.Question, err error)
 	FindQuestions(
 		ctx context.Context,
 		pageIndex, pageSize int32,
-		examId, userId string,
-	) (total, pageCount int32, questions []model.Question, err error)
+		examId, userId, cursor string,
+	) (total, pageCount int32, questions []model.Question, nextCursor string, err error)
 	DeleteQuestion(ctx context.Context, questionId, userId string) error
+	BatchMutateQuestions(
+		ctx context.Context,
+		examId string,
+		operations []QuestionOperation,
+		userId string,
+	) (applied bool, results []QuestionOperationResult, err error)
 	FindRandomQuestions(
 		ctx context.Context, examId, userId string, size int32,
-	) (exam *model.Exam, questions []model.Question, err error)
+	) (
+		exam *model.Exam,
+		questions []model.Question,
+		sections []model.ExamSection,
+		examAttemptId string,
+		err error,
+	)
+	RevealQuestionHint(
+		ctx context.Context,
+		examId, examAttemptId, questionId, userId string,
+	) (hint string, err error)
+
+	// BankQuestion
+	CreateBankQuestion(
+		ctx context.Context,
+		ask string,
+		answers, tags []string,
+		points int32,
+		partialCredit bool,
+		hint, explanation string,
+		userId string,
+	) (bankQuestionId string, err error)
+	UpdateBankQuestion(
+		ctx context.Context,
+		bankQuestionId, ask string,
+		answers, tags []string,
+		points int32,
+		partialCredit bool,
+		hint, explanation string,
+		userId string,
+	) (string, error)
+	DeleteBankQuestion(ctx context.Context, bankQuestionId, userId string) error
+	FindBankQuestions(
+		ctx context.Context,
+		pageIndex, pageSize int32,
+		tag, userId string,
+	) (total, pageCount int32, bankQuestions []model.BankQuestion, err error)
+	SetExamBankSource(
+		ctx context.Context,
+		examId string,
+		questionIds []string,
+		pools []model.ExamBankPool,
+		userId string,
+	) (exam *model.Exam, err error)
+
+	// ExamSection
+	CreateExamSection(
+		ctx context.Context,
+		examId, sectionType, title, passage, audioUrl string,
+		order int32,
+		userId string,
+	) (sectionId string, err error)
+	UpdateExamSection(
+		ctx context.Context,
+		sectionId, sectionType, title, passage, audioUrl string,
+		order int32,
+		userId string,
+	) (string, error)
+	DeleteExamSection(ctx context.Context, sectionId, userId string) error
+	FindExamSections(
+		ctx context.Context,
+		examId, userId string,
+	) (sections []model.ExamSection, err error)
 
 	// ExamRecord
 	CreateExamRecord(
 		ctx context.Context,
-		examId string,
-		score int32,
-		wrongQuestionIds []string,
+		examId, examAttemptId string,
+		durationSeconds int32,
+		answers []model.ExamAttemptAnswer,
 		userId string,
-	) error
+	) (examRecord *model.ExamRecord, questions []model.Question, err error)
 	FindExamRecords(
 		ctx context.Context,
 		pageIndex, pageSize int32,
-		examId, userId string,
-	) (total, pageCount int32, examRecords []model.ExamRecord, err error)
+		examId, userId, cursor string,
+	) (total, pageCount int32, examRecords []model.ExamRecord, nextCursor string, err error)
+	GetExamRecordDetail(
+		ctx context.Context,
+		examId, examRecordId, userId string,
+	) (examRecord *model.ExamRecord, questions []model.Question, err error)
 	FindExamRecordOverview(
-		ctx context.Context, examId, userId string, startDate time.Time,
+		ctx context.Context,
+		examId, userId string,
+		startDate, endDate time.Time,
+		version int32,
+		dateRange, timezone string,
+		movingAverageWindow int32,
 	) (
 		strStartDate string,
 		exam *model.Exam,
+		examVersion int32,
+		examVersionSummaries []repository.ExamVersionSummary,
 		questions []model.Question,
 		answerWrongs []model.AnswerWrong,
 		examRecords []model.ExamRecord,
+		analytics ExamRecordAnalytics,
+		err error,
+	)
+	FindExamLeaderboard(
+		ctx context.Context,
+		examId, window string,
+		size int32,
+		excludedUserIds []string,
+	) (leaderboardEntries []repository.LeaderboardEntry, err error)
+	FindQuestionStatistics(
+		ctx context.Context,
+		examId, userId string,
+	) (
+		summary repository.ExamStatisticsSummary,
+		questionStatistics []QuestionStatistic,
 		err error,
 	)
 
+	// MistakeNotebook
+	FindMistakeNotebook(
+		ctx context.Context,
+		userId, examId string,
+		startDate, endDate time.Time,
+		pageIndex, pageSize int32,
+	) (total, pageCount int32, entries []MistakeNotebookEntry, err error)
+	CreateMistakeDrill(
+		ctx context.Context,
+		userId, examId string,
+		size int32,
+	) (entries []MistakeNotebookEntry, requiredCorrectStreak int32, err error)
+	AnswerMistakeDrill(
+		ctx context.Context,
+		answerWrongId, userId string,
+		blankAnswers []string,
+	) (isCorrect bool, correctAnswers []string, correctStreak int32, removed bool, err error)
+
 	// ExamInfo
 	FindExamInfos(
 		ctx context.Context,
 		userId string,
 		isPublic bool,
+		tags []string,
 	) (examInfos []ExamInfo, err error)
+	FindExamCatalog(
+		ctx context.Context,
+		keyword string,
+		tags []string,
+		sortBy string,
+		pageIndex, pageSize int32,
+	) (total, pageCount int32, examSummaries []repository.ExamSummary, err error)
+
+	// Class
+	CreateClass(
+		ctx context.Context,
+		name, description, userId string,
+	) (classId, joinCode string, err error)
+	JoinClass(ctx context.Context, joinCode, userId string) (class *model.Class, err error)
+	FindClasses(ctx context.Context, userId string) (classes []model.Class, err error)
+	CreateAssignment(
+		ctx context.Context,
+		classId, examId, title string,
+		dueAt time.Time,
+		userId string,
+	) (assignmentId string, err error)
+	FindAssignments(
+		ctx context.Context,
+		classId, userId string,
+	) (assignments []model.Assignment, err error)
+	FindClassGradebook(
+		ctx context.Context,
+		classId, userId string,
+	) (class *model.Class, assignments []model.Assignment, grades []ClassGrade, err error)
 }
 
 type examService struct {
@@ -110,17 +330,27 @@ func New(logger log.Logger, databaseRepository repository.DatabaseRepository) Ex
 }
 
 func (examService examService) CreateExam(
-	ctx context.Context, topic, description string, isPublic bool, userId string,
+	ctx context.Context,
+	topic, description string,
+	tags []string,
+	isPublic bool,
+	userId string,
 ) (string, error) {
 	logger := examService.logger
 	errorLogger := examService.errorLogger
 	errorMessage := "CreateExam failed: %w"
 
+	tags, err := normalizeTags(tags)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
 	exam := model.Exam{
 		Topic:       topic,
 		Description: description,
 		IsPublic:    isPublic,
-		Tags:        []string{},
+		Tags:        tags,
 		UserId:      userId,
 	}
 	examId, err := examService.databaseRepository.CreateExam(ctx, exam)
@@ -133,72 +363,161 @@ func (examService examService) CreateExam(
 	return examId, nil
 }
 
+/*
+settings 為 nil 時不修改原本的設定，
+expectedVersion 是讀取時的版本號，與目前的版本號不同時回傳 repository.VersionConflictError，
+nil 表示不檢查，0 表示還沒有版本號的舊資料
+*/
 func (examService examService) UpdateExam(
-	ctx context.Context, examId, topic, description string, isPublic bool, userId string,
-) (string, error) {
+	ctx context.Context,
+	examId, topic, description string,
+	tags []string,
+	isPublic bool,
+	settings *model.ExamSettings,
+	expectedVersion *int32,
+	userId string,
+) (string, int32, error) {
 	logger := examService.logger
 	errorLogger := examService.errorLogger
 	errorMessage := "UpdateExam failed: %w"
 
+	tags, err := normalizeTags(tags)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", 0, fmt.Errorf(errorMessage, err)
+	}
+
+	if settings != nil {
+		err = validateExamSettings(*settings)
+		if err != nil {
+			errorLogger.Log("err", err)
+			return "", 0, fmt.Errorf(errorMessage, err)
+		}
+	}
+
 	databaseRepository := examService.databaseRepository
 	exam, err := databaseRepository.GetExamById(ctx, examId)
 	if err != nil {
 		errorLogger.Log("err", err)
-		return "", fmt.Errorf(errorMessage, err)
+		return "", 0, fmt.Errorf(errorMessage, err)
 	}
 
 	if exam == nil {
 		err = fmt.Errorf("Exam not found by id: %s", examId)
 		errorLogger.Log("err", err)
-		return "", fmt.Errorf(errorMessage, err)
+		return "", 0, fmt.Errorf(errorMessage, err)
 	}
 
-	// 檢查使用者是否是該測驗的擁有者
-	if exam.UserId != userId {
+	// 檢查使用者是否是該測驗的擁有者或共同編輯者
+	if !canEditExam(exam, userId) {
 		err = unauthorizedOperationError
 		errorLogger.Log("err", err)
-		return "", fmt.Errorf(errorMessage, err)
+		return "", 0, fmt.Errorf(errorMessage, err)
+	}
+
+	if expectedVersion != nil && exam.Version != *expectedVersion {
+		err = repository.VersionConflictError
+		errorLogger.Log("err", err)
+		return "", 0, fmt.Errorf(errorMessage, err)
 	}
 
 	exam.Topic = topic
 	exam.Description = description
+	exam.Tags = tags
 	exam.IsPublic = isPublic
+
+	if settings != nil {
+		exam.Settings = settings
+	}
+
 	err = databaseRepository.UpdateExam(ctx, *exam)
 	if err != nil {
 		errorLogger.Log("err", err)
-		return "", fmt.Errorf(errorMessage, err)
+		return "", 0, fmt.Errorf(errorMessage, err)
 	}
 
-	logger.Log("examId", examId)
-	return examId, nil
+	version := exam.Version + 1
+	logger.Log("examId", examId, "version", version)
+	return examId, version, nil
+}
+
+// 查詢要修改的測驗，擁有者和共同編輯者可以查詢，回傳的版本號用來檢查修改時是否已被其他人修改
+func (examService examService) GetExam(
+	ctx context.Context,
+	examId, userId string,
+) (*model.Exam, error) {
+	errorLogger := examService.errorLogger
+	errorMessage := "GetExam failed: %w"
+
+	exam, err := examService.databaseRepository.GetExamById(ctx, examId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if exam == nil {
+		err = fmt.Errorf("Exam not found by id: %s", examId)
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if !canEditExam(exam, userId) {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	return exam, nil
 }
 
 func (examService examService) FindExams(
 	ctx context.Context,
 	pageIndex, pageSize int32,
-	userId string,
-) (total, pageCount int32, exams []model.Exam, err error) {
+	userId, cursor string,
+) (total, pageCount int32, exams []model.Exam, nextCursor string, err error) {
 	logger := examService.logger
 	errorLogger := examService.errorLogger
 	errorMessage := "FindExams failed: %w"
 
+	databaseRepository := examService.databaseRepository
+
+	// 有 cursor 時改用 cursor 分頁，不需要再查詢總數
+	if cursor != "" {
+		exams, nextCursor, err = databaseRepository.FindExamsByUserIdAndCursorOrderByUpdateAtDesc(
+			ctx, userId, cursor, pageSize)
+		if err != nil {
+			errorLogger.Log("err", err)
+			return 0, 0, nil, "", fmt.Errorf(errorMessage, err)
+		}
+
+		logger.Log("nextCursor", nextCursor, "exams size", len(exams))
+		return
+	}
+
 	skip := pageSize * pageIndex
-	exams, err = examService.databaseRepository.FindExamsByUserIdOrderByUpdateAtDesc(
+	exams, err = databaseRepository.FindExamsByUserIdOrderByUpdateAtDesc(
 		ctx, userId, skip, pageSize)
 	if err != nil {
 		errorLogger.Log("err", err)
-		return 0, 0, nil, fmt.Errorf(errorMessage, err)
+		return 0, 0, nil, "", fmt.Errorf(errorMessage, err)
 	}
 
 	// Total
-	total, err = examService.databaseRepository.CountExamsByUserId(ctx, userId)
+	total, err = databaseRepository.CountExamsByUserId(ctx, userId)
 	if err != nil {
 		errorLogger.Log("err", err)
-		return 0, 0, nil, fmt.Errorf(errorMessage, err)
+		return 0, 0, nil, "", fmt.Errorf(errorMessage, err)
 	}
 
 	// PageCount
 	pageCount = int32(math.Ceil(float64(total) / float64(pageSize)))
+
+	// 還有下一頁時也回傳 cursor，讓呼叫端之後可以改用 cursor 分頁
+	if len(exams) > 0 && skip+int32(len(exams)) < total {
+		lastExam := exams[len(exams)-1]
+		nextCursor = repository.EncodeCursor(lastExam.UpdatedAt, lastExam.Id)
+	}
+
 	logger.Log("total", total, "pageCount", pageCount, "exams size", len(exams))
 	return
 }
@@ -223,7 +542,7 @@ func (examService examService) DeleteExam(ctx context.Context, examId, userId st
 	}
 
 	// 使用者不是該測驗的擁有者
-	if exam.UserId != userId {
+	if !isExamOwner(exam, userId) {
 		err = unauthorizedOperationError
 		errorLogger.Log("err", err)
 		return fmt.Errorf(errorMessage, err)
@@ -256,6 +575,36 @@ func (examService examService) DeleteExam(ctx context.Context, examId, userId st
 				return nil, err
 			}
 
+			// Delete ExamAttemptCount
+			_, err = databaseRepository.DeleteExamAttemptCountsByExamId(ctx, examId)
+			if err != nil {
+				return nil, err
+			}
+
+			// Delete ExamAttempt
+			_, err = databaseRepository.DeleteExamAttemptsByExamId(ctx, examId)
+			if err != nil {
+				return nil, err
+			}
+
+			// Delete ExamVersion
+			_, err = databaseRepository.DeleteExamVersionsByExamId(ctx, examId)
+			if err != nil {
+				return nil, err
+			}
+
+			// Delete Assignment
+			_, err = databaseRepository.DeleteAssignmentsByExamId(ctx, examId)
+			if err != nil {
+				return nil, err
+			}
+
+			// Delete ExamSection
+			_, err = databaseRepository.DeleteExamSectionsByExamId(ctx, examId)
+			if err != nil {
+				return nil, err
+			}
+
 			return nil, nil
 		},
 	)
@@ -267,144 +616,112 @@ func (examService examService) DeleteExam(ctx context.Context, examId, userId st
 	return nil
 }
 
-func (examService examService) CreateQuestion(
-	ctx context.Context, examId, ask string, answers []string, userId string,
-) (string, error) {
+func (examService examService) CreatePracticeExam(
+	ctx context.Context,
+	topic, description string,
+	practiceWords []PracticeWord,
+	questionTypes []string,
+	userId string,
+) (examId string, questionCount int32, err error) {
 	logger := examService.logger
 	errorLogger := examService.errorLogger
-	errorMessage := "CreateQuestion failed: %w"
-
-	databaseRepository := examService.databaseRepository
-	exam, err := databaseRepository.GetExamById(ctx, examId)
-	if err != nil {
-		errorLogger.Log("err", err)
-		return "", fmt.Errorf(errorMessage, err)
-	}
+	errorMessage := "CreatePracticeExam failed: %w"
 
-	if exam == nil {
-		err = fmt.Errorf("Exam not found by id: %s", examId)
-		errorLogger.Log("err", err)
-		return "", fmt.Errorf(errorMessage, err)
-	}
-
-	// 檢查使用者是否是該測驗的擁有者
-	if exam.UserId != userId {
-		err = unauthorizedOperationError
-		errorLogger.Log("err", err)
-		return "", fmt.Errorf(errorMessage, err)
-	}
-
-	questionId, err := databaseRepository.CreateQuestion(ctx, model.Question{
-		ExamId:  examId,
-		Ask:     ask,
-		Answers: answers,
-		UserId:  userId,
-	})
-	if err != nil {
-		errorLogger.Log("err", err)
-		return "", fmt.Errorf(errorMessage, err)
+	// 未指定題型時使用全部的題型
+	if len(questionTypes) == 0 {
+		questionTypes = practiceQuestionTypes
 	}
 
-	logger.Log("questionId", questionId)
-	return questionId, nil
-}
-
-func (examService examService) UpdateQuestion(
-	ctx context.Context, questionId, ask string, answers []string, userId string,
-) (string, error) {
-	logger := examService.logger
-	errorLogger := examService.errorLogger
-	errorMessage := "UpdateQuestion failed: %w"
-
-	databaseRepository := examService.databaseRepository
-	question, err := databaseRepository.GetQuestionById(ctx, questionId)
+	err = validatePracticeQuestionTypes(questionTypes)
 	if err != nil {
 		errorLogger.Log("err", err)
-		return "", fmt.Errorf(errorMessage, err)
+		return "", 0, fmt.Errorf(errorMessage, err)
 	}
 
-	if question == nil {
-		err = fmt.Errorf("Question not found by id: %s", questionId)
-		errorLogger.Log("err", err)
-		return "", fmt.Errorf(errorMessage, err)
-	}
+	questions := buildPracticeQuestions(practiceWords, questionTypes, userId)
 
-	// 檢查使用者是否是該 question 的擁有者
-	if question.UserId != userId {
-		err = unauthorizedOperationError
+	if len(questions) == 0 {
+		err = fmt.Errorf(
+			"%w: no question can be generated from practice words",
+			InvalidPracticeExamError,
+		)
 		errorLogger.Log("err", err)
-		return "", fmt.Errorf(errorMessage, err)
+		return "", 0, fmt.Errorf(errorMessage, err)
 	}
 
-	_, err = databaseRepository.WithTransaction(
+	databaseRepository := examService.databaseRepository
+	result, err := databaseRepository.WithTransaction(
 		ctx,
 		func(ctx context.Context) (interface{}, error) {
-			// 修改 Question
-			question.Ask = ask
-			question.Answers = answers
-			err = databaseRepository.UpdateQuestion(ctx, *question)
+			// 新增 Exam
+			examId, err := databaseRepository.CreateExam(ctx, model.Exam{
+				Topic:       topic,
+				Description: description,
+				IsPublic:    false,
+				Tags:        []string{},
+				UserId:      userId,
+			})
 			if err != nil {
 				return nil, err
 			}
 
-			// 刪除相關的 AnswerWrong
-			_, err = databaseRepository.DeleteAnswerWrongsByQuestionId(ctx, questionId)
+			// 新增 Question
+			for i := range questions {
+				questions[i].ExamId = examId
+			}
+
+			_, err = databaseRepository.CreateQuestions(ctx, questions)
 			if err != nil {
 				return nil, err
 			}
 
-			return nil, nil
+			return examId, nil
 		},
 	)
 	if err != nil {
 		errorLogger.Log("err", err)
-		return "", fmt.Errorf(errorMessage, err)
+		return "", 0, fmt.Errorf(errorMessage, err)
 	}
 
-	logger.Log("questionId", questionId)
-	return questionId, nil
+	examId = result.(string)
+	questionCount = int32(len(questions))
+	logger.Log("examId", examId, "questionCount", questionCount)
+	return examId, questionCount, nil
 }
 
-func (examService examService) FindQuestions(
+func (examService examService) FindExamsByTags(
 	ctx context.Context,
+	tags []string,
+	userId string,
 	pageIndex, pageSize int32,
-	examId, userId string,
-) (total, pageCount int32, questions []model.Question, err error) {
+) (total, pageCount int32, exams []model.Exam, err error) {
 	logger := examService.logger
 	errorLogger := examService.errorLogger
-	errorMessage := "FindQuestions failed: %w"
-
-	databaseRepository := examService.databaseRepository
+	errorMessage := "FindExamsByTags failed: %w"
 
-	exam, err := databaseRepository.GetExamById(ctx, examId)
+	tags, err = normalizeTags(tags)
 	if err != nil {
 		errorLogger.Log("err", err)
 		return 0, 0, nil, fmt.Errorf(errorMessage, err)
 	}
 
-	if exam == nil {
-		err := fmt.Errorf("Exam not found by id: %s", examId)
-		errorLogger.Log("err", err)
-		return 0, 0, nil, fmt.Errorf(errorMessage, err)
-	}
-
-	// 檢查不能查詢別人的 question
-	if exam.UserId != userId {
-		err = unauthorizedOperationError
+	if len(tags) == 0 {
+		err = fmt.Errorf("Tags is empty")
 		errorLogger.Log("err", err)
 		return 0, 0, nil, fmt.Errorf(errorMessage, err)
 	}
 
+	databaseRepository := examService.databaseRepository
 	skip := pageSize * pageIndex
-	questions, err = databaseRepository.FindQuestionsByExamIdOrderByUpdateAtDesc(
-		ctx, examId, skip, pageSize)
+	exams, err = databaseRepository.FindExamsByTagsOrderByUpdateAtDesc(
+		ctx, tags, userId, skip, pageSize)
 	if err != nil {
 		errorLogger.Log("err", err)
 		return 0, 0, nil, fmt.Errorf(errorMessage, err)
 	}
 
 	// Total
-	total, err = databaseRepository.CountQuestionsByExamId(ctx, examId)
+	total, err = databaseRepository.CountExamsByTags(ctx, tags, userId)
 	if err != nil {
 		errorLogger.Log("err", err)
 		return 0, 0, nil, fmt.Errorf(errorMessage, err)
@@ -412,350 +729,2718 @@ func (examService examService) FindQuestions(
 
 	// PageCount
 	pageCount = int32(math.Ceil(float64(total) / float64(pageSize)))
-	logger.Log("total", total, "pageCount", pageCount, "questions size", len(questions))
-	return
+
+	logger.Log("total", total, "pageCount", pageCount, "exams size", len(exams))
+	return total, pageCount, exams, nil
 }
 
-func (examService examService) DeleteQuestion(
+/*
+將公開的測驗（或自己的測驗）與全部題組、題目複製到使用者的帳號之下，
+題目包含從題庫固定引用與可被抽出的題目，已發佈的測驗複製最新版本的快照，
+複製出來的測驗預設不公開，並記錄原本的測驗與作者
+*/
+func (examService examService) ForkExam(
 	ctx context.Context,
-	questionId, userId string,
-) error {
+	examId, userId string,
+) (forkedExamId string, questionCount int32, err error) {
+	logger := examService.logger
 	errorLogger := examService.errorLogger
-	errorMessage := "DeleteQuestion failed: %w"
+	errorMessage := "ForkExam failed: %w"
 
 	databaseRepository := examService.databaseRepository
-
-	// 檢查不能刪除別人的 question
-	question, err := databaseRepository.GetQuestionById(ctx, questionId)
+	exam, err := databaseRepository.GetExamById(ctx, examId)
 	if err != nil {
 		errorLogger.Log("err", err)
-		return fmt.Errorf(errorMessage, err)
+		return "", 0, fmt.Errorf(errorMessage, err)
 	}
 
-	if question == nil {
-		err = fmt.Errorf("Question not found by id: %s", questionId)
+	if exam == nil {
+		err = fmt.Errorf("Exam not found by id: %s", examId)
 		errorLogger.Log("err", err)
-		return fmt.Errorf(errorMessage, err)
+		return "", 0, fmt.Errorf(errorMessage, err)
 	}
 
-	if question.UserId != userId {
+	// 不能複製沒有分享給使用者的不公開測驗，複製的題目包含答案，不公布答案的測驗只有擁有者和共同編輯者可以複製
+	if !canTakeExam(exam, userId) || !canViewExamAnswers(exam, userId) {
 		err = unauthorizedOperationError
 		errorLogger.Log("err", err)
-		return fmt.Errorf(errorMessage, err)
+		return "", 0, fmt.Errorf(errorMessage, err)
 	}
 
-	_, err = databaseRepository.WithTransaction(
+	// 題庫的題目屬於原本的作者，所以複製成新測驗本身的題目
+	questions, sections, err := examService.findExamContent(ctx, exam)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", 0, fmt.Errorf(errorMessage, err)
+	}
+
+	result, err := databaseRepository.WithTransaction(
 		ctx,
 		func(ctx context.Context) (interface{}, error) {
-			// Delete AnswerWrong
-			_, err = databaseRepository.DeleteAnswerWrongsByQuestionId(ctx, questionId)
+			forkedExamId, err := databaseRepository.CreateExam(ctx, model.Exam{
+				Topic:            exam.Topic,
+				Description:      exam.Description,
+				Tags:             exam.Tags,
+				IsPublic:         false,
+				UserId:           userId,
+				Settings:         exam.Settings,
+				ForkedFromExamId: examId,
+				ForkedFromUserId: exam.UserId,
+			})
 			if err != nil {
 				return nil, err
 			}
 
-			// Delete Question
-			_, err = databaseRepository.DeleteQuestionById(ctx, questionId)
-			if err != nil {
-				return nil, err
+			// 複製題組，並記錄原本題組 id 對應的新題組 id
+			forkedSectionIds := map[string]string{}
+
+			for _, section := range sections {
+				forkedSectionId, err := databaseRepository.CreateExamSection(ctx, model.ExamSection{
+					ExamId:   forkedExamId,
+					Type:     section.Type,
+					Title:    section.Title,
+					Passage:  section.Passage,
+					AudioUrl: section.AudioUrl,
+					Order:    section.Order,
+					UserId:   userId,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				forkedSectionIds[section.Id.Hex()] = forkedSectionId
 			}
 
-			return nil, nil
+			forkedQuestions := []model.Question{}
+
+			for _, question := range questions {
+				forkedQuestions = append(forkedQuestions, model.Question{
+					ExamId:          forkedExamId,
+					Ask:             question.Ask,
+					Answers:         question.Answers,
+					AcceptedAnswers: question.AcceptedAnswers,
+					UserId:          userId,
+					WordMeaningId:   question.WordMeaningId,
+					Points:          question.Points,
+					PartialCredit:   question.PartialCredit,
+					Hint:            question.Hint,
+					Explanation:     question.Explanation,
+					SectionId:       forkedSectionIds[question.SectionId],
+					Choices:         question.Choices,
+				})
+			}
+
+			// 只有題組沒有題目的測驗仍要複製題組
+			if len(forkedQuestions) > 0 {
+				_, err = databaseRepository.CreateQuestions(ctx, forkedQuestions)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			return forkResult{forkedExamId, int32(len(forkedQuestions))}, nil
 		},
 	)
 	if err != nil {
 		errorLogger.Log("err", err)
-		return fmt.Errorf(errorMessage, err)
+		return "", 0, fmt.Errorf(errorMessage, err)
 	}
 
-	return nil
+	forked := result.(forkResult)
+	logger.Log("forkedExamId", forked.examId, "questionCount", forked.questionCount)
+	return forked.examId, forked.questionCount, nil
 }
 
-func (examService examService) CreateExamRecord(
-	ctx context.Context, examId string, score int32, wrongQuestionIds []string, userId string,
-) error {
+type publishResult struct {
+	version       int32
+	questionCount int32
+}
+
+/*
+發佈測驗，將目前的題目與引用的題庫題目建立成新版本的快照並公開測驗，
+之後作答都使用最新版本的題目，修改題目後要再發佈才會生效，
+回傳的題數是每次作答的題數
+*/
+func (examService examService) PublishExam(
+	ctx context.Context,
+	examId, userId string,
+) (version, questionCount int32, err error) {
+	logger := examService.logger
 	errorLogger := examService.errorLogger
-	errorMessage := "CreateExamRecord failed: %w"
+	errorMessage := "PublishExam failed: %w"
 
 	databaseRepository := examService.databaseRepository
 	exam, err := databaseRepository.GetExamById(ctx, examId)
 	if err != nil {
 		errorLogger.Log("err", err)
-		return fmt.Errorf(errorMessage, err)
+		return 0, 0, fmt.Errorf(errorMessage, err)
 	}
 
 	if exam == nil {
-		err := fmt.Errorf("Exam not found by id: %s", examId)
+		err = fmt.Errorf("Exam not found by id: %s", examId)
 		errorLogger.Log("err", err)
-		return fmt.Errorf(errorMessage, err)
+		return 0, 0, fmt.Errorf(errorMessage, err)
 	}
 
-	// 檢查不能新增別人的測驗紀錄
-	if !exam.IsPublic && exam.UserId != userId {
+	// 檢查使用者是否是該測驗的擁有者或共同編輯者
+	if !canEditExam(exam, userId) {
 		err = unauthorizedOperationError
 		errorLogger.Log("err", err)
-		return fmt.Errorf(errorMessage, err)
+		return 0, 0, fmt.Errorf(errorMessage, err)
 	}
 
-	_, err = databaseRepository.WithTransaction(
+	result, err := databaseRepository.WithTransaction(
 		ctx,
 		func(ctx context.Context) (interface{}, error) {
-			// 新增測驗紀錄
-			_, err = databaseRepository.CreateExamRecord(ctx, model.ExamRecord{
-				ExamId: examId,
-				Score:  score,
-				UserId: userId,
-			})
+			questions, err := databaseRepository.FindQuestionsByExamId(ctx, examId)
 			if err != nil {
 				return nil, err
 			}
 
-			// 更新問題的答錯次數
-			for _, questionId := range wrongQuestionIds {
-				_, _, err := databaseRepository.UpsertAnswerWrongByTimesPlusOne(
-					ctx,
-					examId,
-					questionId,
-					userId,
-				)
-				if err != nil {
-					return nil, err
-				}
+			questionIds := []primitive.ObjectID{}
+
+			for _, question := range questions {
+				questionIds = append(questionIds, question.Id)
 			}
 
-			return nil, nil
+			bankQuestions, bankPools, err := examService.snapshotExamBankQuestions(
+				ctx,
+				exam,
+				questionIds,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			questions = append(questions, bankQuestions...)
+
+			if len(questions) == 0 {
+				return nil, fmt.Errorf("Exam has no questions: %s", examId)
+			}
+
+			versionQuestions := []model.ExamVersionQuestion{}
+
+			for _, question := range questions {
+				versionQuestions = append(versionQuestions, model.ExamVersionQuestion{
+					QuestionId:      question.Id.Hex(),
+					Ask:             question.Ask,
+					Answers:         question.Answers,
+					AcceptedAnswers: question.AcceptedAnswers,
+					Points:          question.Points,
+					PartialCredit:   question.PartialCredit,
+					Hint:            question.Hint,
+					Explanation:     question.Explanation,
+					SectionId:       question.SectionId,
+					Choices:         question.Choices,
+				})
+			}
+
+			sections, err := databaseRepository.FindExamSectionsByExamIdOrderByOrderAsc(ctx, examId)
+			if err != nil {
+				return nil, err
+			}
+
+			versionSections := []model.ExamVersionSection{}
+
+			for _, section := range sections {
+				versionSections = append(versionSections, model.ExamVersionSection{
+					SectionId: section.Id.Hex(),
+					Type:      section.Type,
+					Title:     section.Title,
+					Passage:   section.Passage,
+					AudioUrl:  section.AudioUrl,
+					Order:     section.Order,
+				})
+			}
+
+			version := exam.LatestVersion + 1
+			examVersion := model.ExamVersion{
+				ExamId:      examId,
+				Version:     version,
+				Topic:       exam.Topic,
+				Description: exam.Description,
+				Questions:   versionQuestions,
+				Sections:    versionSections,
+				UserId:      userId,
+			}
+
+			if len(bankPools) > 0 {
+				examVersion.BankPools = bankPools
+			}
+
+			_, err = databaseRepository.CreateExamVersion(ctx, examVersion)
+			if err != nil {
+				return nil, err
+			}
+
+			exam.LatestVersion = version
+			exam.IsPublic = true
+			err = databaseRepository.UpdateExam(ctx, *exam)
+			if err != nil {
+				return nil, err
+			}
+
+			return publishResult{version, examVersionAttemptQuestionCount(examVersion)}, nil
 		},
 	)
-
 	if err != nil {
 		errorLogger.Log("err", err)
-		return fmt.Errorf(errorMessage, err)
+		return 0, 0, fmt.Errorf(errorMessage, err)
 	}
 
-	return nil
+	published := result.(publishResult)
+	logger.Log("version", published.version, "questionCount", published.questionCount)
+	return published.version, published.questionCount, nil
 }
 
-func (examService examService) FindExamRecords(
+// 將測驗分享給其他使用者，已分享過時修改其權限，只有擁有者可以管理分享
+func (examService examService) ShareExam(
 	ctx context.Context,
-	pageIndex, pageSize int32,
-	examId, userId string,
-) (total, pageCount int32, examRecords []model.ExamRecord, err error) {
+	examId, sharedUserId, role, userId string,
+) error {
 	logger := examService.logger
 	errorLogger := examService.errorLogger
-	errorMessage := "FindExamRecords failed: %w"
+	errorMessage := "ShareExam failed: %w"
+
+	if !isValidShareRole(role) {
+		err := fmt.Errorf("Invalid role: %s", role)
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
+	}
 
 	databaseRepository := examService.databaseRepository
-	skip := pageSize * pageIndex
-	limit := pageSize
-	examRecords, err = databaseRepository.FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc(
-		ctx, examId, userId, skip, limit)
+	exam, err := databaseRepository.GetExamById(ctx, examId)
 	if err != nil {
 		errorLogger.Log("err", err)
-		return 0, 0, nil, fmt.Errorf(errorMessage, err)
+		return fmt.Errorf(errorMessage, err)
 	}
 
-	// Total
-	count, err := databaseRepository.CountExamRecordsByExamIdAndUserId(
-		ctx,
-		examId,
-		userId,
-	)
+	if exam == nil {
+		err = fmt.Errorf("Exam not found by id: %s", examId)
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
+	}
+
+	// 使用者不是該測驗的擁有者
+	if !isExamOwner(exam, userId) {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
+	}
+
+	if sharedUserId == "" || sharedUserId == exam.UserId {
+		err = fmt.Errorf("Invalid sharedUserId: %s", sharedUserId)
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
+	}
+
+	index := slices.IndexFunc(exam.Shares, func(share model.ExamShare) bool {
+		return share.UserId == sharedUserId
+	})
+
+	if index == -1 {
+		exam.Shares = append(exam.Shares, model.ExamShare{
+			UserId:    sharedUserId,
+			Role:      role,
+			CreatedAt: time.Now(),
+		})
+	} else {
+		exam.Shares[index].Role = role
+	}
+
+	err = databaseRepository.UpdateExam(ctx, *exam)
 	if err != nil {
 		errorLogger.Log("err", err)
-		return 0, 0, nil, fmt.Errorf(errorMessage, err)
+		return fmt.Errorf(errorMessage, err)
 	}
-	total = int32(count)
 
-	// PageCount
-	pageCount = int32(math.Ceil(float64(total) / float64(pageSize)))
-	logger.Log("total", total, "pageCount", pageCount, "examRecords size", len(examRecords))
-	return
+	logger.Log("examId", examId, "sharedUserId", sharedUserId, "role", role)
+	return nil
 }
 
-func (examService examService) FindExamRecordOverview(
-	ctx context.Context, examId, userId string, startDate time.Time,
-) (
-	strStartDate string,
-	exam *model.Exam,
-	questions []model.Question,
-	answerWrongs []model.AnswerWrong,
-	examRecords []model.ExamRecord,
-	err error,
-) {
+// 取消分享測驗，沒有分享給該使用者時不做任何事
+func (examService examService) UnshareExam(
+	ctx context.Context,
+	examId, sharedUserId, userId string,
+) error {
+	logger := examService.logger
 	errorLogger := examService.errorLogger
-	errorMessage := "FindExamRecordOverview failed: %w"
+	errorMessage := "UnshareExam failed: %w"
 
 	databaseRepository := examService.databaseRepository
-
-	// Exam
-	exam, err = databaseRepository.GetExamById(ctx, examId)
+	exam, err := databaseRepository.GetExamById(ctx, examId)
 	if err != nil {
 		errorLogger.Log("err", err)
-		return "", nil, nil, nil, nil, fmt.Errorf(errorMessage, err)
+		return fmt.Errorf(errorMessage, err)
 	}
 
 	if exam == nil {
-		err := fmt.Errorf("Exam not found by id: %s", examId)
+		err = fmt.Errorf("Exam not found by id: %s", examId)
 		errorLogger.Log("err", err)
-		return "", nil, nil, nil, nil, fmt.Errorf(errorMessage, err)
+		return fmt.Errorf(errorMessage, err)
 	}
 
-	// AnswerWrong
-	// 查詢此測驗中，該名使用者答錯次數最多的 10 個問題
-	answerWrongs, err = databaseRepository.FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc(
-		ctx,
-		examId,
-		userId,
-		10,
-	)
-	questionIds := []string{}
-
-	for _, answerWrong := range answerWrongs {
-		questionIds = append(questionIds, answerWrong.QuestionId)
+	// 使用者不是該測驗的擁有者
+	if !isExamOwner(exam, userId) {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
 	}
 
-	// Question
-	questions, err = databaseRepository.FindQuestionsByQuestionIds(ctx, questionIds)
-
-	// 依照 questionIds 原本的順序排序
-	slices.SortFunc(questions, func(q1, q2 model.Question) int {
-		index1 := slices.Index(questionIds, q1.Id.Hex())
-		index2 := slices.Index(questionIds, q2.Id.Hex())
-		return cmp.Compare(index1, index2)
+	shares := slices.DeleteFunc(slices.Clone(exam.Shares), func(share model.ExamShare) bool {
+		return share.UserId == sharedUserId
 	})
 
-	// ExamRecord
-	examRecords, err = databaseRepository.FindExamRecordsByExamIdAndUserIdAndCreatedAt(
-		ctx,
-		examId,
-		userId,
-		startDate,
-	)
+	if len(shares) == len(exam.Shares) {
+		logger.Log("examId", examId, "sharedUserId", sharedUserId, "unshared", false)
+		return nil
+	}
 
-	return startDate.Format("2006/01/02"), exam, questions, answerWrongs, examRecords, err
+	exam.Shares = shares
+	err = databaseRepository.UpdateExam(ctx, *exam)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
+	}
+
+	logger.Log("examId", examId, "sharedUserId", sharedUserId, "unshared", true)
+	return nil
 }
 
-func (examService examService) FindExamInfos(
+// 查詢測驗的分享對象，擁有者和共同編輯者可以查詢
+func (examService examService) FindExamShares(
 	ctx context.Context,
-	userId string,
-	isPublic bool,
-) (examInfos []ExamInfo, err error) {
+	examId, userId string,
+) (shares []model.ExamShare, err error) {
+	logger := examService.logger
 	errorLogger := examService.errorLogger
-	errorMessage := "FindExamInfos failed: %w"
+	errorMessage := "FindExamShares failed: %w"
 
 	databaseRepository := examService.databaseRepository
-
-	exams, err := databaseRepository.FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc(
-		ctx,
-		userId,
-		isPublic,
-	)
+	exam, err := databaseRepository.GetExamById(ctx, examId)
 	if err != nil {
 		errorLogger.Log("err", err)
 		return nil, fmt.Errorf(errorMessage, err)
 	}
 
-	for _, exam := range exams {
-		examId := exam.Id.Hex()
-		questionCount, err := databaseRepository.CountQuestionsByExamId(ctx, examId)
-		if err != nil {
-			errorLogger.Log("err", err)
-			return nil, fmt.Errorf(errorMessage, err)
-		}
+	if exam == nil {
+		err = fmt.Errorf("Exam not found by id: %s", examId)
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
 
-		if questionCount == 0 {
-			continue
-		}
+	if !canEditExam(exam, userId) {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
 
-		examRecordCount, err := databaseRepository.CountExamRecordsByExamIdAndUserId(
-			ctx,
-			examId,
-			userId,
-		)
-		if err != nil {
-			errorLogger.Log("err", err)
-			return nil, fmt.Errorf(errorMessage, err)
-		}
+	shares = exam.Shares
 
-		examInfos = append(examInfos, ExamInfo{
-			ExamId:        examId,
-			Topic:         exam.Topic,
-			Description:   exam.Description,
-			IsPublic:      exam.IsPublic,
-			QuestionCount: questionCount,
-			RecordCount:   examRecordCount,
+	if shares == nil {
+		shares = []model.ExamShare{}
+	}
+
+	logger.Log("shares size", len(shares))
+	return shares, nil
+}
+
+// 將版本快照中的題目轉成 Question，題目 id 與發佈時的題目相同
+func examVersionQuestions(examVersion *model.ExamVersion) []model.Question {
+	questions := []model.Question{}
+
+	for _, versionQuestion := range examVersion.Questions {
+		id, _ := primitive.ObjectIDFromHex(versionQuestion.QuestionId)
+		questions = append(questions, model.Question{
+			Id:              id,
+			ExamId:          examVersion.ExamId,
+			Ask:             versionQuestion.Ask,
+			Answers:         versionQuestion.Answers,
+			AcceptedAnswers: versionQuestion.AcceptedAnswers,
+			UserId:          examVersion.UserId,
+			Points:          versionQuestion.Points,
+			PartialCredit:   versionQuestion.PartialCredit,
+			Hint:            versionQuestion.Hint,
+			Explanation:     versionQuestion.Explanation,
+			SectionId:       versionQuestion.SectionId,
+			Choices:         versionQuestion.Choices,
+			CreatedAt:       examVersion.CreatedAt,
+			UpdatedAt:       examVersion.CreatedAt,
 		})
 	}
 
-	return examInfos, nil
+	return questions
+}
+
+/*
+匯入測驗與題目，內容有任何錯誤時不會匯入，並回傳每個錯誤所在的行數，
+參數的主題、說明和標籤有值時優先使用，否則使用 JSON 內容中的值
+*/
+func (examService examService) ImportExam(
+	ctx context.Context,
+	topic, description string,
+	tags []string,
+	format string,
+	content []byte,
+	userId string,
+) (examId string, questionCount int32, importErrors []ExamImportError, err error) {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "ImportExam failed: %w"
+
+	err = validateExamFormat(format)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", 0, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	examDocument, importErrors := parseExamContent(format, content)
+	if len(importErrors) > 0 {
+		logger.Log("importErrors size", len(importErrors))
+		return "", 0, importErrors, nil
+	}
+
+	if topic == "" {
+		topic = examDocument.Topic
+	}
+
+	if description == "" {
+		description = examDocument.Description
+	}
+
+	if len(tags) == 0 {
+		tags = examDocument.Tags
+	}
+
+	if strings.TrimSpace(topic) == "" {
+		err = fmt.Errorf("Topic is empty")
+		errorLogger.Log("err", err)
+		return "", 0, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	tags, err = normalizeTags(tags)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", 0, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if len(examDocument.Questions) == 0 ||
+		len(examDocument.Questions) > maxImportQuestionCount {
+		err = fmt.Errorf("Invalid question count: %d", len(examDocument.Questions))
+		errorLogger.Log("err", err)
+		return "", 0, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	databaseRepository := examService.databaseRepository
+	result, err := databaseRepository.WithTransaction(
+		ctx,
+		func(ctx context.Context) (interface{}, error) {
+			examId, err := databaseRepository.CreateExam(ctx, model.Exam{
+				Topic:       topic,
+				Description: description,
+				Tags:        tags,
+				IsPublic:    false,
+				UserId:      userId,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			// 依照順序建立題組，題目的 section 是題組的順序，從 1 開始
+			sectionIds := []string{""}
+
+			for i, section := range examDocument.Sections {
+				sectionId, err := databaseRepository.CreateExamSection(ctx, model.ExamSection{
+					ExamId:   examId,
+					Type:     section.Type,
+					Title:    section.Title,
+					Passage:  section.Passage,
+					AudioUrl: section.AudioUrl,
+					Order:    int32(i + 1),
+					UserId:   userId,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				sectionIds = append(sectionIds, sectionId)
+			}
+
+			questions := []model.Question{}
+
+			for _, question := range examDocument.Questions {
+				questions = append(questions, model.Question{
+					ExamId:        examId,
+					Ask:           question.Ask,
+					Answers:       question.Answers,
+					UserId:        userId,
+					Points:        question.Points,
+					PartialCredit: question.PartialCredit,
+					Hint:          question.Hint,
+					Explanation:   question.Explanation,
+					SectionId:     sectionIds[question.Section],
+				})
+			}
+
+			_, err = databaseRepository.CreateQuestions(ctx, questions)
+			if err != nil {
+				return nil, err
+			}
+
+			return examId, nil
+		},
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", 0, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	examId = result.(string)
+	questionCount = int32(len(examDocument.Questions))
+	logger.Log("examId", examId, "questionCount", questionCount)
+	return examId, questionCount, nil, nil
+}
+
+/*
+匯出測驗與題目，題目分批查詢並透過 send 分批傳送，避免題目很多時佔用大量記憶體
+*/
+func (examService examService) ExportExam(
+	ctx context.Context,
+	examId, userId, format string,
+	send func(chunk []byte) error,
+) error {
+	errorLogger := examService.errorLogger
+	errorMessage := "ExportExam failed: %w"
+
+	err := validateExamFormat(format)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
+	}
+
+	databaseRepository := examService.databaseRepository
+	exam, err := databaseRepository.GetExamById(ctx, examId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
+	}
+
+	if exam == nil {
+		err = fmt.Errorf("Exam not found by id: %s", examId)
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
+	}
+
+	// 匯出的內容包含答案，只有擁有者和共同編輯者可以匯出，其他人可以複製測驗
+	if !canEditExam(exam, userId) {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
+	}
+
+	questions, sections, err := examService.findExamContent(ctx, exam)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
+	}
+
+	exporter := newExamExporter(format)
+
+	if err = send(exporter.writeHeader(exam, sections)); err != nil {
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
+	}
+
+	for start := 0; start < len(questions); start += exportBatchSize {
+		end := min(start+exportBatchSize, len(questions))
+
+		if err = send(exporter.writeQuestions(questions[start:end])); err != nil {
+			errorLogger.Log("err", err)
+			return fmt.Errorf(errorMessage, err)
+		}
+	}
+
+	if footer := exporter.writeFooter(); len(footer) > 0 {
+		if err = send(footer); err != nil {
+			errorLogger.Log("err", err)
+			return fmt.Errorf(errorMessage, err)
+		}
+	}
+
+	return nil
+}
+
+// 複製測驗的交易結果
+type forkResult struct {
+	examId        string
+	questionCount int32
+}
+
+func (examService examService) FindExamTagCounts(
+	ctx context.Context,
+	userId string,
+	size int32,
+) (tagCounts []repository.TagCount, err error) {
+	errorLogger := examService.errorLogger
+	errorMessage := "FindExamTagCounts failed: %w"
+
+	if size <= 0 || size > maxTagCloudSize {
+		size = maxTagCloudSize
+	}
+
+	tagCounts, err = examService.databaseRepository.FindExamTagCounts(ctx, userId, size)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	return tagCounts, nil
+}
+
+func (examService examService) CreateQuestion(
+	ctx context.Context,
+	examId, ask string,
+	answers []string,
+	acceptedAnswers [][]string,
+	points int32,
+	partialCredit bool,
+	hint, explanation, sectionId string,
+	userId string,
+) (string, error) {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "CreateQuestion failed: %w"
+
+	err := validateQuestionPoints(points)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	err = validateQuestionAcceptedAnswers(answers, acceptedAnswers)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	err = validateQuestionHint(hint, explanation)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	databaseRepository := examService.databaseRepository
+	exam, err := databaseRepository.GetExamById(ctx, examId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	if exam == nil {
+		err = fmt.Errorf("Exam not found by id: %s", examId)
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	// 檢查使用者是否是該測驗的擁有者或共同編輯者
+	if !canEditExam(exam, userId) {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	err = examService.checkQuestionSection(ctx, examId, sectionId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	// 共同編輯者新增的題目也屬於測驗的擁有者
+	questionId, err := databaseRepository.CreateQuestion(ctx, model.Question{
+		ExamId:          examId,
+		Ask:             ask,
+		Answers:         answers,
+		AcceptedAnswers: acceptedAnswers,
+		UserId:          exam.UserId,
+		Points:          points,
+		PartialCredit:   partialCredit,
+		Hint:            hint,
+		Explanation:     explanation,
+		SectionId:       sectionId,
+	})
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	logger.Log("questionId", questionId)
+	return questionId, nil
+}
+
+/*
+expectedVersion 是讀取時的版本號，與目前的版本號不同時回傳 repository.VersionConflictError，
+nil 表示不檢查，0 表示還沒有版本號的舊資料
+*/
+func (examService examService) UpdateQuestion(
+	ctx context.Context,
+	questionId, ask string,
+	answers []string,
+	acceptedAnswers [][]string,
+	points int32,
+	partialCredit bool,
+	hint, explanation, sectionId string,
+	expectedVersion *int32,
+	userId string,
+) (string, int32, error) {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "UpdateQuestion failed: %w"
+
+	err := validateQuestionPoints(points)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", 0, fmt.Errorf(errorMessage, err)
+	}
+
+	err = validateQuestionAcceptedAnswers(answers, acceptedAnswers)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", 0, fmt.Errorf(errorMessage, err)
+	}
+
+	err = validateQuestionHint(hint, explanation)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", 0, fmt.Errorf(errorMessage, err)
+	}
+
+	databaseRepository := examService.databaseRepository
+	question, err := databaseRepository.GetQuestionById(ctx, questionId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", 0, fmt.Errorf(errorMessage, err)
+	}
+
+	if question == nil {
+		err = fmt.Errorf("Question not found by id: %s", questionId)
+		errorLogger.Log("err", err)
+		return "", 0, fmt.Errorf(errorMessage, err)
+	}
+
+	// 檢查使用者是否是該 question 的擁有者或共同編輯者
+	canEdit, err := examService.canEditQuestion(ctx, question, userId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", 0, fmt.Errorf(errorMessage, err)
+	}
+
+	if !canEdit {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return "", 0, fmt.Errorf(errorMessage, err)
+	}
+
+	if expectedVersion != nil && question.Version != *expectedVersion {
+		err = repository.VersionConflictError
+		errorLogger.Log("err", err)
+		return "", 0, fmt.Errorf(errorMessage, err)
+	}
+
+	err = examService.checkQuestionSection(ctx, question.ExamId, sectionId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", 0, fmt.Errorf(errorMessage, err)
+	}
+
+	_, err = databaseRepository.WithTransaction(
+		ctx,
+		func(ctx context.Context) (interface{}, error) {
+			// 修改 Question
+			question.Ask = ask
+			question.Answers = answers
+			question.AcceptedAnswers = acceptedAnswers
+			question.Points = points
+			question.PartialCredit = partialCredit
+			question.Hint = hint
+			question.Explanation = explanation
+			question.SectionId = sectionId
+			err = databaseRepository.UpdateQuestion(ctx, *question)
+			if err != nil {
+				return nil, err
+			}
+
+			// 刪除相關的 AnswerWrong
+			_, err = databaseRepository.DeleteAnswerWrongsByQuestionId(ctx, questionId)
+			if err != nil {
+				return nil, err
+			}
+
+			return nil, nil
+		},
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", 0, fmt.Errorf(errorMessage, err)
+	}
+
+	version := question.Version + 1
+	logger.Log("questionId", questionId, "version", version)
+	return questionId, version, nil
+}
+
+// 查詢要修改的題目，擁有者和共同編輯者可以查詢，回傳的版本號用來檢查修改時是否已被其他人修改
+func (examService examService) GetQuestion(
+	ctx context.Context,
+	questionId, userId string,
+) (*model.Question, error) {
+	errorLogger := examService.errorLogger
+	errorMessage := "GetQuestion failed: %w"
+
+	question, err := examService.databaseRepository.GetQuestionById(ctx, questionId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if question == nil {
+		err = fmt.Errorf("Question not found by id: %s", questionId)
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	canEdit, err := examService.canEditQuestion(ctx, question, userId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if !canEdit {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	return question, nil
+}
+
+func (examService examService) FindQuestions(
+	ctx context.Context,
+	pageIndex, pageSize int32,
+	examId, userId, cursor string,
+) (total, pageCount int32, questions []model.Question, nextCursor string, err error) {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "FindQuestions failed: %w"
+
+	databaseRepository := examService.databaseRepository
+
+	exam, err := databaseRepository.GetExamById(ctx, examId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return 0, 0, nil, "", fmt.Errorf(errorMessage, err)
+	}
+
+	if exam == nil {
+		err := fmt.Errorf("Exam not found by id: %s", examId)
+		errorLogger.Log("err", err)
+		return 0, 0, nil, "", fmt.Errorf(errorMessage, err)
+	}
+
+	// 檢查不能查詢別人的 question，共同編輯者可以查詢
+	if !canEditExam(exam, userId) {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return 0, 0, nil, "", fmt.Errorf(errorMessage, err)
+	}
+
+	// 有 cursor 時改用 cursor 分頁，不需要再查詢總數
+	if cursor != "" {
+		questions, nextCursor, err = databaseRepository.FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc(
+			ctx, examId, cursor, pageSize)
+		if err != nil {
+			errorLogger.Log("err", err)
+			return 0, 0, nil, "", fmt.Errorf(errorMessage, err)
+		}
+
+		logger.Log("nextCursor", nextCursor, "questions size", len(questions))
+		return
+	}
+
+	skip := pageSize * pageIndex
+	questions, err = databaseRepository.FindQuestionsByExamIdOrderByUpdateAtDesc(
+		ctx, examId, skip, pageSize)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return 0, 0, nil, "", fmt.Errorf(errorMessage, err)
+	}
+
+	// Total
+	total, err = databaseRepository.CountQuestionsByExamId(ctx, examId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return 0, 0, nil, "", fmt.Errorf(errorMessage, err)
+	}
+
+	// PageCount
+	pageCount = int32(math.Ceil(float64(total) / float64(pageSize)))
+
+	// 還有下一頁時也回傳 cursor，讓呼叫端之後可以改用 cursor 分頁
+	if len(questions) > 0 && skip+int32(len(questions)) < total {
+		lastQuestion := questions[len(questions)-1]
+		nextCursor = repository.EncodeCursor(lastQuestion.UpdatedAt, lastQuestion.Id)
+	}
+
+	logger.Log("total", total, "pageCount", pageCount, "questions size", len(questions))
+	return
+}
+
+func (examService examService) DeleteQuestion(
+	ctx context.Context,
+	questionId, userId string,
+) error {
+	errorLogger := examService.errorLogger
+	errorMessage := "DeleteQuestion failed: %w"
+
+	databaseRepository := examService.databaseRepository
+
+	// 檢查不能刪除別人的 question
+	question, err := databaseRepository.GetQuestionById(ctx, questionId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
+	}
+
+	if question == nil {
+		err = fmt.Errorf("Question not found by id: %s", questionId)
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
+	}
+
+	canEdit, err := examService.canEditQuestion(ctx, question, userId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
+	}
+
+	if !canEdit {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
+	}
+
+	_, err = databaseRepository.WithTransaction(
+		ctx,
+		func(ctx context.Context) (interface{}, error) {
+			// Delete AnswerWrong
+			_, err = databaseRepository.DeleteAnswerWrongsByQuestionId(ctx, questionId)
+			if err != nil {
+				return nil, err
+			}
+
+			// Delete Question
+			_, err = databaseRepository.DeleteQuestionById(ctx, questionId)
+			if err != nil {
+				return nil, err
+			}
+
+			return nil, nil
+		},
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
+	}
+
+	return nil
+}
+
+/*
+在同一個交易中批次新增、修改和刪除同一個測驗的題目，只檢查一次測驗的擁有者，
+有任何操作不合法時全部都不執行，並在結果中說明每個不合法的原因
+*/
+func (examService examService) BatchMutateQuestions(
+	ctx context.Context,
+	examId string,
+	operations []QuestionOperation,
+	userId string,
+) (applied bool, results []QuestionOperationResult, err error) {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "BatchMutateQuestions failed: %w"
+
+	if len(operations) == 0 || len(operations) > maxQuestionOperationCount {
+		err = fmt.Errorf("Invalid operation count: %d", len(operations))
+		errorLogger.Log("err", err)
+		return false, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	databaseRepository := examService.databaseRepository
+	exam, err := databaseRepository.GetExamById(ctx, examId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return false, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if exam == nil {
+		err = fmt.Errorf("Exam not found by id: %s", examId)
+		errorLogger.Log("err", err)
+		return false, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	// 檢查使用者是否是該測驗的擁有者或共同編輯者
+	if !canEditExam(exam, userId) {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return false, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	// 一次查詢全部要修改和刪除的題目
+	questions := []model.Question{}
+	questionIds := operationQuestionIds(operations)
+
+	if len(questionIds) > 0 {
+		questions, err = databaseRepository.FindQuestionsByQuestionIds(ctx, questionIds)
+		if err != nil {
+			errorLogger.Log("err", err)
+			return false, nil, fmt.Errorf(errorMessage, err)
+		}
+	}
+
+	// 有操作指定題組時，才查詢測驗的題組
+	sections := []model.ExamSection{}
+
+	if hasOperationSectionId(operations) {
+		sections, err = databaseRepository.FindExamSectionsByExamIdOrderByOrderAsc(ctx, examId)
+		if err != nil {
+			errorLogger.Log("err", err)
+			return false, nil, fmt.Errorf(errorMessage, err)
+		}
+	}
+
+	results, isValid := validateQuestionOperations(examId, operations, questions, sections)
+	if !isValid {
+		logger.Log("applied", false, "results size", len(results))
+		return false, results, nil
+	}
+
+	questionsById := map[string]model.Question{}
+
+	for _, question := range questions {
+		questionsById[question.Id.Hex()] = question
+	}
+
+	result, err := databaseRepository.WithTransaction(
+		ctx,
+		func(ctx context.Context) (interface{}, error) {
+			newQuestions := []model.Question{}
+			changedQuestionIds := []string{}
+			deletedQuestionIds := []string{}
+
+			for _, operation := range operations {
+				switch operation.Type {
+				case QUESTION_OPERATION_CREATE:
+					newQuestions = append(newQuestions, model.Question{
+						ExamId:        examId,
+						Ask:           operation.Ask,
+						Answers:       operation.Answers,
+						UserId:        exam.UserId,
+						Points:        operation.Points,
+						PartialCredit: operation.PartialCredit,
+						Hint:          operation.Hint,
+						Explanation:   operation.Explanation,
+						SectionId:     operation.SectionId,
+					})
+				case QUESTION_OPERATION_UPDATE:
+					question := questionsById[operation.QuestionId]
+					question.Ask = operation.Ask
+					question.Answers = operation.Answers
+					question.Points = operation.Points
+					question.PartialCredit = operation.PartialCredit
+					question.Hint = operation.Hint
+					question.Explanation = operation.Explanation
+					question.SectionId = operation.SectionId
+
+					if err := databaseRepository.UpdateQuestion(ctx, question); err != nil {
+						return nil, err
+					}
+
+					changedQuestionIds = append(changedQuestionIds, operation.QuestionId)
+				case QUESTION_OPERATION_DELETE:
+					changedQuestionIds = append(changedQuestionIds, operation.QuestionId)
+					deletedQuestionIds = append(deletedQuestionIds, operation.QuestionId)
+				}
+			}
+
+			// 修改或刪除的題目，原本的答錯紀錄已不適用
+			if len(changedQuestionIds) > 0 {
+				_, err := databaseRepository.DeleteAnswerWrongsByQuestionIds(ctx, changedQuestionIds)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			if len(deletedQuestionIds) > 0 {
+				_, err := databaseRepository.DeleteQuestionsByIds(ctx, deletedQuestionIds)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			createdQuestionIds := []string{}
+
+			if len(newQuestions) > 0 {
+				createdQuestionIds, err = databaseRepository.CreateQuestions(ctx, newQuestions)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			return createdQuestionIds, nil
+		},
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return false, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	// 依順序將新增的題目 id 填入結果
+	createdQuestionIds := result.([]string)
+
+	for i := range results {
+		if results[i].Type == QUESTION_OPERATION_CREATE && len(createdQuestionIds) > 0 {
+			results[i].QuestionId = createdQuestionIds[0]
+			createdQuestionIds = createdQuestionIds[1:]
+		}
+	}
+
+	logger.Log("applied", true, "results size", len(results))
+	return true, results, nil
+}
+
+/*
+新增作答紀錄，依照開始作答時出題的版本與題目在後端評分，作答的題目必須是出給使用者的題目，
+沒有作答的題目以 0 分計入滿分，每次作答只能交卷一次，
+回傳評分後的作答紀錄與作答的題目，測驗設定交卷後不能查看答案時，題目不包含答案
+*/
+func (examService examService) CreateExamRecord(
+	ctx context.Context,
+	examId, examAttemptId string,
+	durationSeconds int32,
+	answers []model.ExamAttemptAnswer,
+	userId string,
+) (examRecord *model.ExamRecord, questions []model.Question, err error) {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "CreateExamRecord failed: %w"
+
+	if durationSeconds < 0 {
+		err = fmt.Errorf("Invalid durationSeconds: %d", durationSeconds)
+		errorLogger.Log("err", err)
+		return nil, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if len(answers) == 0 {
+		err = fmt.Errorf("Answers is empty")
+		errorLogger.Log("err", err)
+		return nil, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	// 只能交卷自己開始作答的該測驗
+	examAttempt, err := examService.getUnsubmittedExamAttempt(ctx, examId, examAttemptId, userId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	// 作答的題目必須是出給使用者的題目，沒有作答的題目視為空白作答，是否查看提示以伺服器的紀錄為準
+	attemptAnswers, err := examAttemptAnswers(
+		examAttempt.QuestionIds,
+		examAttempt.HintQuestionIds,
+		answers,
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	databaseRepository := examService.databaseRepository
+
+	exam, err := databaseRepository.GetExamById(ctx, examId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if exam == nil {
+		err = fmt.Errorf("Exam not found by id: %s", examId)
+		errorLogger.Log("err", err)
+		return nil, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	// 檢查不能新增沒有分享或指派給使用者的不公開測驗紀錄
+	canTake, err := examService.canTakeExamOrAssignment(ctx, exam, userId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if !canTake {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return nil, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	settings := ExamSettingsOf(exam)
+
+	// 以開始作答時版本的題目評分，之後發佈新版本也不影響
+	questions, err = examService.findExamRecordQuestions(
+		ctx,
+		exam,
+		examAttempt.ExamVersion,
+		examAttempt.QuestionIds,
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	gradedExamRecord, err := gradeExamAttempt(questions, attemptAnswers, settings.HintPenaltyPercent)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	// 記錄作答時的測驗版本與及格分數，之後修改設定也不影響
+	examRecord = &gradedExamRecord
+	examRecord.ExamId = examId
+	examRecord.ExamVersion = examAttempt.ExamVersion
+	examRecord.DurationSeconds = durationSeconds
+	examRecord.UserId = userId
+
+	if settings.PassingScorePercent > 0 && examRecord.MaxPoints > 0 {
+		examRecord.PassingScorePercent = settings.PassingScorePercent
+		examRecord.Passed = isPassed(examRecord.Percentage, settings.PassingScorePercent)
+	}
+
+	result, err := databaseRepository.WithTransaction(
+		ctx,
+		func(ctx context.Context) (interface{}, error) {
+			// 在交易中記錄交卷，同一次作答同時交卷也只會新增一筆作答紀錄
+			submitted, err := databaseRepository.SubmitExamAttempt(ctx, examAttemptId)
+			if err != nil {
+				return nil, err
+			}
+
+			if !submitted {
+				return nil, examAttemptSubmittedError(examAttemptId)
+			}
+
+			// 在交易中遞增作答次數，同時交卷也不會超過作答次數上限
+			err = examService.increaseExamAttemptCount(ctx, exam, settings, userId)
+			if err != nil {
+				return nil, err
+			}
+
+			examRecordId, err := databaseRepository.CreateExamRecord(ctx, *examRecord)
+			if err != nil {
+				return nil, err
+			}
+
+			// 更新問題的答錯次數
+			for _, questionId := range examRecord.WrongQuestionIds {
+				_, _, err := databaseRepository.UpsertAnswerWrongByTimesPlusOne(
+					ctx,
+					examId,
+					examAttempt.ExamVersion,
+					questionId,
+					userId,
+				)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			return examRecordId, nil
+		},
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	examRecord.Id, _ = primitive.ObjectIDFromHex(result.(string))
+	questions = sortQuestionsByQuestionIds(questions, examAttempt.QuestionIds)
+
+	// 測驗設定交卷後不能查看答案時，除了擁有者與共同編輯者，都不回傳題目的答案
+	if !canViewExamAnswers(exam, userId) {
+		questions = hideQuestionAnswers(questions)
+	}
+
+	logger.Log("score", examRecord.Score, "percentage", examRecord.Percentage)
+	return examRecord, questions, nil
+}
+
+func (examService examService) FindExamRecords(
+	ctx context.Context,
+	pageIndex, pageSize int32,
+	examId, userId, cursor string,
+) (total, pageCount int32, examRecords []model.ExamRecord, nextCursor string, err error) {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "FindExamRecords failed: %w"
+
+	databaseRepository := examService.databaseRepository
+
+	// 有 cursor 時改用 cursor 分頁，不需要再查詢總數
+	if cursor != "" {
+		examRecords, nextCursor, err = databaseRepository.FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc(
+			ctx, examId, userId, cursor, pageSize)
+		if err != nil {
+			errorLogger.Log("err", err)
+			return 0, 0, nil, "", fmt.Errorf(errorMessage, err)
+		}
+
+		logger.Log("nextCursor", nextCursor, "examRecords size", len(examRecords))
+		return
+	}
+
+	skip := pageSize * pageIndex
+	limit := pageSize
+	examRecords, err = databaseRepository.FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc(
+		ctx, examId, userId, skip, limit)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return 0, 0, nil, "", fmt.Errorf(errorMessage, err)
+	}
+
+	// Total
+	count, err := databaseRepository.CountExamRecordsByExamIdAndUserId(
+		ctx,
+		examId,
+		userId,
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return 0, 0, nil, "", fmt.Errorf(errorMessage, err)
+	}
+	total = int32(count)
+
+	// PageCount
+	pageCount = int32(math.Ceil(float64(total) / float64(pageSize)))
+
+	// 還有下一頁時也回傳 cursor，讓呼叫端之後可以改用 cursor 分頁
+	if len(examRecords) > 0 && skip+int32(len(examRecords)) < total {
+		lastExamRecord := examRecords[len(examRecords)-1]
+		nextCursor = repository.EncodeCursor(lastExamRecord.UpdatedAt, lastExamRecord.Id)
+	}
+
+	logger.Log("total", total, "pageCount", pageCount, "examRecords size", len(examRecords))
+	return
+}
+
+/*
+查詢作答紀錄總覽，version 是要查看的測驗版本，0 表示最新版本，
+答錯次數最多的題目依照該版本當時的題目內容回傳，作答紀錄則包含所有版本，
+期間與每日統計都依照使用者的時區計算
+*/
+func (examService examService) FindExamRecordOverview(
+	ctx context.Context,
+	examId, userId string,
+	startDate, endDate time.Time,
+	version int32,
+	dateRange, timezone string,
+	movingAverageWindow int32,
+) (
+	strStartDate string,
+	exam *model.Exam,
+	examVersion int32,
+	examVersionSummaries []repository.ExamVersionSummary,
+	questions []model.Question,
+	answerWrongs []model.AnswerWrong,
+	examRecords []model.ExamRecord,
+	analytics ExamRecordAnalytics,
+	err error,
+) {
+	errorLogger := examService.errorLogger
+	errorMessage := "FindExamRecordOverview failed: %w"
+
+	databaseRepository := examService.databaseRepository
+
+	location, err := loadOverviewLocation(timezone)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", nil, 0, nil, nil, nil, nil, ExamRecordAnalytics{}, fmt.Errorf(errorMessage, err)
+	}
+
+	if movingAverageWindow == 0 {
+		movingAverageWindow = defaultMovingAverageWindow
+	}
+
+	if movingAverageWindow < 0 || movingAverageWindow > maxMovingAverageWindow {
+		err := fmt.Errorf("Invalid movingAverageWindow: %d", movingAverageWindow)
+		errorLogger.Log("err", err)
+		return "", nil, 0, nil, nil, nil, nil, ExamRecordAnalytics{}, fmt.Errorf(errorMessage, err)
+	}
+
+	startAt, endAt, err := overviewPeriod(dateRange, startDate, endDate, time.Now(), location)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", nil, 0, nil, nil, nil, nil, ExamRecordAnalytics{}, fmt.Errorf(errorMessage, err)
+	}
+
+	// Exam
+	exam, err = databaseRepository.GetExamById(ctx, examId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", nil, 0, nil, nil, nil, nil, ExamRecordAnalytics{}, fmt.Errorf(errorMessage, err)
+	}
+
+	if exam == nil {
+		err := fmt.Errorf("Exam not found by id: %s", examId)
+		errorLogger.Log("err", err)
+		return "", nil, 0, nil, nil, nil, nil, ExamRecordAnalytics{}, fmt.Errorf(errorMessage, err)
+	}
+
+	// 不能查看沒有分享或指派給使用者的不公開測驗的作答總覽
+	canTake, err := examService.canTakeExamOrAssignment(ctx, exam, userId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", nil, 0, nil, nil, nil, nil, ExamRecordAnalytics{}, fmt.Errorf(errorMessage, err)
+	}
+
+	if !canTake {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return "", nil, 0, nil, nil, nil, nil, ExamRecordAnalytics{}, fmt.Errorf(errorMessage, err)
+	}
+
+	examVersion = version
+
+	if examVersion == 0 {
+		examVersion = exam.LatestVersion
+	}
+
+	if examVersion < 0 || examVersion > exam.LatestVersion {
+		err := fmt.Errorf("Invalid examVersion: %d", version)
+		errorLogger.Log("err", err)
+		return "", nil, 0, nil, nil, nil, nil, ExamRecordAnalytics{}, fmt.Errorf(errorMessage, err)
+	}
+
+	// ExamVersion
+	examVersionSummaries, err = databaseRepository.FindExamVersionSummariesByExamIdOrderByVersionDesc(
+		ctx,
+		examId,
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", nil, 0, nil, nil, nil, nil, ExamRecordAnalytics{}, fmt.Errorf(errorMessage, err)
+	}
+
+	// AnswerWrong
+	// 查詢此測驗版本中，該名使用者答錯次數最多的 10 個問題
+	answerWrongs, err = databaseRepository.FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc(
+		ctx,
+		examId,
+		examVersion,
+		userId,
+		10,
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", nil, 0, nil, nil, nil, nil, ExamRecordAnalytics{}, fmt.Errorf(errorMessage, err)
+	}
+
+	questionIds := []string{}
+
+	for _, answerWrong := range answerWrongs {
+		questionIds = append(questionIds, answerWrong.QuestionId)
+	}
+
+	// Question
+	if examVersion == 0 {
+		questions, err = examService.findQuestionsByQuestionIds(ctx, exam, questionIds)
+		if err != nil {
+			errorLogger.Log("err", err)
+			return "", nil, 0, nil, nil, nil, nil, ExamRecordAnalytics{}, fmt.Errorf(errorMessage, err)
+		}
+	} else {
+		// 已發佈的版本使用當時的題目快照，題目之後被修改或刪除也不受影響
+		snapshot, err := databaseRepository.GetExamVersionByExamIdAndVersion(
+			ctx,
+			examId,
+			examVersion,
+		)
+		if err != nil {
+			errorLogger.Log("err", err)
+			return "", nil, 0, nil, nil, nil, nil, ExamRecordAnalytics{}, fmt.Errorf(errorMessage, err)
+		}
+
+		if snapshot == nil {
+			err = fmt.Errorf("Exam version not found: %d", examVersion)
+			errorLogger.Log("err", err)
+			return "", nil, 0, nil, nil, nil, nil, ExamRecordAnalytics{}, fmt.Errorf(errorMessage, err)
+		}
+
+		questions = []model.Question{}
+
+		for _, question := range examVersionQuestions(snapshot) {
+			if slices.Contains(questionIds, question.Id.Hex()) {
+				questions = append(questions, question)
+			}
+		}
+	}
+
+	// 依照 questionIds 原本的順序排序
+	slices.SortFunc(questions, func(q1, q2 model.Question) int {
+		index1 := slices.Index(questionIds, q1.Id.Hex())
+		index2 := slices.Index(questionIds, q2.Id.Hex())
+		return cmp.Compare(index1, index2)
+	})
+
+	// 測驗設定交卷後不能查看答案時，除了擁有者與共同編輯者，都不回傳題目的答案
+	if !canViewExamAnswers(exam, userId) {
+		questions = hideQuestionAnswers(questions)
+	}
+
+	// ExamRecord
+	examRecords, err = databaseRepository.FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween(
+		ctx,
+		examId,
+		userId,
+		startAt,
+		endAt,
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", nil, 0, nil, nil, nil, nil, ExamRecordAnalytics{}, fmt.Errorf(errorMessage, err)
+	}
+
+	analytics = newExamRecordAnalytics(examRecords, startAt, endAt, location, movingAverageWindow)
+
+	return startAt.Format("2006/01/02"),
+		exam,
+		examVersion,
+		examVersionSummaries,
+		questions,
+		answerWrongs,
+		examRecords,
+		analytics,
+		nil
+}
+
+func (examService examService) FindExamInfos(
+	ctx context.Context,
+	userId string,
+	isPublic bool,
+	tags []string,
+) (examInfos []ExamInfo, err error) {
+	errorLogger := examService.errorLogger
+	errorMessage := "FindExamInfos failed: %w"
+
+	// 查詢條件的標籤也要正規化，才能比對到儲存的標籤
+	tags, err = normalizeTags(tags)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	databaseRepository := examService.databaseRepository
+
+	// 題目數量和作答次數在同一個彙總查詢中取得，不需要每個測驗分別查詢
+	examSummaries, err := databaseRepository.FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc(
+		ctx,
+		userId,
+		isPublic,
+		tags,
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	for _, examSummary := range examSummaries {
+		examInfos = append(examInfos, ExamInfo{
+			ExamId:        examSummary.Id.Hex(),
+			Topic:         examSummary.Topic,
+			Description:   examSummary.Description,
+			Tags:          examSummary.Tags,
+			IsPublic:      examSummary.IsPublic,
+			QuestionCount: examSummary.QuestionCount,
+			RecordCount:   examSummary.RecordCount,
+		})
+	}
+
+	return examInfos, nil
+}
+
+func (examService examService) FindExamCatalog(
+	ctx context.Context,
+	keyword string,
+	tags []string,
+	sortBy string,
+	pageIndex, pageSize int32,
+) (total, pageCount int32, examSummaries []repository.ExamSummary, err error) {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "FindExamCatalog failed: %w"
+
+	switch sortBy {
+	case "":
+		sortBy = repository.EXAM_CATALOG_SORT_NEWEST
+	case repository.EXAM_CATALOG_SORT_NEWEST,
+		repository.EXAM_CATALOG_SORT_MOST_ATTEMPTED,
+		repository.EXAM_CATALOG_SORT_HIGHEST_SCORING:
+	default:
+		err = fmt.Errorf("Invalid sortBy: %s", sortBy)
+		errorLogger.Log("err", err)
+		return 0, 0, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if pageIndex < 0 || pageSize <= 0 {
+		err = fmt.Errorf("Invalid pageIndex: %d or pageSize: %d", pageIndex, pageSize)
+		errorLogger.Log("err", err)
+		return 0, 0, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	tags, err = normalizeTags(tags)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return 0, 0, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	skip := pageSize * pageIndex
+	total, examSummaries, err = examService.databaseRepository.FindPublicExamSummaries(
+		ctx,
+		strings.TrimSpace(keyword),
+		tags,
+		sortBy,
+		skip,
+		pageSize,
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return 0, 0, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	// PageCount
+	pageCount = int32(math.Ceil(float64(total) / float64(pageSize)))
+
+	logger.Log("total", total, "pageCount", pageCount, "examSummaries size", len(examSummaries))
+	return total, pageCount, examSummaries, nil
+}
+
+func (examService examService) FindRandomQuestions(
+	ctx context.Context, examId, userId string, size int32,
+) (
+	exam *model.Exam,
+	questions []model.Question,
+	sections []model.ExamSection,
+	examAttemptId string,
+	err error,
+) {
+	errorLogger := examService.errorLogger
+	errorMessage := "FindRandomQuestions failed! error: %w"
+
+	databaseRepository := examService.databaseRepository
+	exam, err = databaseRepository.GetExamById(ctx, examId)
+
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, nil, nil, "", fmt.Errorf(errorMessage, err)
+	}
+
+	if exam == nil {
+		return nil, []model.Question{}, []model.ExamSection{}, "", nil
+	}
+
+	// 若測驗是不公開，則只有本人、分享的對象和被指派作業的學生可以作測驗
+	canTake, err := examService.canTakeExamOrAssignment(ctx, exam, userId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, nil, nil, "", fmt.Errorf(errorMessage, err)
+	}
+
+	if !canTake {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return nil, nil, nil, "", fmt.Errorf(errorMessage, err)
+	}
+
+	settings := ExamSettingsOf(exam)
+
+	// 測驗有設定每次作答的題數時，以設定為準
+	if settings.QuestionsPerAttempt > 0 {
+		size = settings.QuestionsPerAttempt
+	}
+
+	err = examService.checkMaxAttempts(ctx, exam, settings, userId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, nil, nil, "", fmt.Errorf(errorMessage, err)
+	}
+
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	sections = []model.ExamSection{}
+
+	if exam.LatestVersion > 0 {
+		// 已發佈的測驗使用最新版本的題目快照
+		examVersion, err := databaseRepository.GetExamVersionByExamIdAndVersion(
+			ctx,
+			examId,
+			exam.LatestVersion,
+		)
+		if err != nil {
+			errorLogger.Log("err", err)
+			return nil, nil, nil, "", fmt.Errorf(errorMessage, err)
+		}
+
+		if examVersion == nil {
+			err = fmt.Errorf("Exam version not found: %d", exam.LatestVersion)
+			errorLogger.Log("err", err)
+			return nil, nil, nil, "", fmt.Errorf(errorMessage, err)
+		}
+
+		// 以題組為單位打亂和選取題目，同一題組的題目不會被拆開
+		groups := groupQuestionsBySection(examVersionAttemptQuestions(examVersion, rng))
+
+		if settings.ShuffleQuestions {
+			shuffleQuestionGroups(groups, rng)
+		}
+
+		questions = selectQuestionGroups(groups, size)
+		sections = sectionsOfQuestions(examVersionSections(examVersion), questions)
+	} else {
+		sections, err = databaseRepository.FindExamSectionsByExamIdOrderByOrderAsc(ctx, examId)
+		if err != nil {
+			errorLogger.Log("err", err)
+			return nil, nil, nil, "", fmt.Errorf(errorMessage, err)
+		}
+
+		if len(sections) > 0 || exam.BankSource != nil {
+			questions, sections, err = examService.findRandomSectionQuestions(
+				ctx,
+				exam,
+				sections,
+				settings.ShuffleQuestions,
+				size,
+				rng,
+			)
+		} else {
+			questions, err = examService.findRandomStandaloneQuestions(
+				ctx,
+				examId,
+				settings.ShuffleQuestions,
+				size,
+				rng,
+			)
+		}
+
+		if err != nil {
+			errorLogger.Log("err", err)
+			return nil, nil, nil, "", fmt.Errorf(errorMessage, err)
+		}
+	}
+
+	if settings.ShuffleAnswers {
+		shuffleQuestionChoices(questions, rng)
+	}
+
+	// 記錄出題的版本與題目，交卷時只能作答這些題目
+	questionIds := []string{}
+
+	for _, question := range questions {
+		questionIds = append(questionIds, question.Id.Hex())
+	}
+
+	examAttemptId, err = databaseRepository.CreateExamAttempt(ctx, model.ExamAttempt{
+		ExamId:      examId,
+		ExamVersion: exam.LatestVersion,
+		QuestionIds: questionIds,
+		UserId:      userId,
+	})
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, nil, nil, "", fmt.Errorf(errorMessage, err)
+	}
+
+	// 作答時不回傳答案、提示和解說，交卷後由伺服器評分
+	questions = hideQuestionAnswers(questions)
+	return exam, questions, sections, examAttemptId, nil
+}
+
+/*
+查看作答中題目的提示，只能查看自己還沒有交卷的作答中出給自己的題目，
+查看的題目記錄在這次作答中，交卷時依照測驗設定扣分，沒有提示的題目不記錄
+*/
+func (examService examService) RevealQuestionHint(
+	ctx context.Context,
+	examId, examAttemptId, questionId, userId string,
+) (hint string, err error) {
+	errorLogger := examService.errorLogger
+	errorMessage := "RevealQuestionHint failed: %w"
+
+	examAttempt, err := examService.getUnsubmittedExamAttempt(ctx, examId, examAttemptId, userId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	if !slices.Contains(examAttempt.QuestionIds, questionId) {
+		err = fmt.Errorf("Invalid questionId: %s", questionId)
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	databaseRepository := examService.databaseRepository
+	exam, err := databaseRepository.GetExamById(ctx, examId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	if exam == nil {
+		err = fmt.Errorf("Exam not found by id: %s", examId)
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	// 以開始作答時版本的題目為準
+	questions, err := examService.findExamRecordQuestions(
+		ctx,
+		exam,
+		examAttempt.ExamVersion,
+		[]string{questionId},
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	questions = sortQuestionsByQuestionIds(questions, []string{questionId})
+
+	if len(questions) == 0 {
+		err = fmt.Errorf("Question not found by id: %s", questionId)
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	hint = questions[0].Hint
+
+	if hint == "" {
+		return "", nil
+	}
+
+	// 交卷後不會再記錄，避免交卷的同時查看提示
+	added, err := databaseRepository.AddExamAttemptHintQuestionId(ctx, examAttemptId, questionId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	if !added {
+		err = examAttemptSubmittedError(examAttemptId)
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	return hint, nil
+}
+
+/*
+測驗有題組或引用題庫時，查詢全部的題目後以題組為單位打亂和選取，同一題組的題目不會被拆開，
+題庫的題目都是獨立的題目
+*/
+func (examService examService) findRandomSectionQuestions(
+	ctx context.Context,
+	exam *model.Exam,
+	sections []model.ExamSection,
+	shuffleQuestions bool,
+	size int32,
+	rng *rand.Rand,
+) ([]model.Question, []model.ExamSection, error) {
+	questions, err := examService.databaseRepository.FindQuestionsByExamId(ctx, exam.Id.Hex())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 題組的題目依照題組的順序排在一起
+	sectionIndexes := map[string]int{}
+
+	for i, section := range sections {
+		sectionIndexes[section.Id.Hex()] = i
+	}
+
+	slices.SortStableFunc(questions, func(a, b model.Question) int {
+		return cmp.Compare(
+			questionSectionIndex(sectionIndexes, a),
+			questionSectionIndex(sectionIndexes, b),
+		)
+	})
+
+	if exam.BankSource != nil {
+		bankQuestions, err := examService.drawExamBankQuestions(ctx, exam)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, bankQuestion := range bankQuestions {
+			if !containsQuestion(questions, bankQuestion.Id) {
+				questions = append(questions, bankQuestion)
+			}
+		}
+	}
+
+	groups := groupQuestionsBySection(questions)
+
+	if shuffleQuestions {
+		shuffleQuestionGroups(groups, rng)
+	}
+
+	questions = selectQuestionGroups(groups, size)
+	return questions, sectionsOfQuestions(sections, questions), nil
+}
+
+// 題組的題目排在前面，獨立的題目排在最後
+func questionSectionIndex(sectionIndexes map[string]int, question model.Question) int {
+	if index, ok := sectionIndexes[question.SectionId]; ok {
+		return index
+	}
+
+	return len(sectionIndexes)
+}
+
+// 測驗沒有題組時，只查詢需要的題目
+func (examService examService) findRandomStandaloneQuestions(
+	ctx context.Context,
+	examId string,
+	shuffleQuestions bool,
+	size int32,
+	rng *rand.Rand,
+) ([]model.Question, error) {
+	databaseRepository := examService.databaseRepository
+
+	if !shuffleQuestions {
+		// 不打亂題目時，依照題目的順序取前面的題目
+		return databaseRepository.FindQuestionsByExamIdOrderByUpdateAtDesc(
+			ctx,
+			examId,
+			0,
+			size,
+		)
+	}
+
+	total, err := databaseRepository.CountQuestionsByExamId(
+		ctx,
+		examId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	questions := []model.Question{}
+
+	// 總數是零，表示使用者還沒新增 Question
+	if total == 0 {
+		return questions, nil
+	}
+
+	indexes := []int32{}
+
+	for i := int32(0); i < total; i++ {
+		indexes = append(indexes, i)
+	}
+
+	// 將 indexes 隨機洗牌，然後根據洗牌後的 indexes 順序去查詢，達到隨機排序的效果
+	rng.Shuffle(len(indexes), func(i, j int) {
+		indexes[i], indexes[j] = indexes[j], indexes[i]
+	})
+
+	maxQueryTotal := min(total, size)
+
+	for i := int32(0); i < maxQueryTotal; i++ {
+		findQuestions, err := databaseRepository.FindQuestionsByExamIdOrderByUpdateAtDesc(
+			ctx,
+			examId,
+			indexes[i],
+			1,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		questions = append(questions, findQuestions[0])
+	}
+
+	return questions, nil
+}
+
+func (examService examService) CreateExamSection(
+	ctx context.Context,
+	examId, sectionType, title, passage, audioUrl string,
+	order int32,
+	userId string,
+) (sectionId string, err error) {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "CreateExamSection failed: %w"
+
+	err = validateExamSection(sectionType, title, passage, audioUrl)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	databaseRepository := examService.databaseRepository
+	exam, err := databaseRepository.GetExamById(ctx, examId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	if exam == nil {
+		err = fmt.Errorf("Exam not found by id: %s", examId)
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	// 檢查使用者是否是該測驗的擁有者或共同編輯者
+	if !canEditExam(exam, userId) {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	// 共同編輯者新增的題組也屬於測驗的擁有者
+	sectionId, err = databaseRepository.CreateExamSection(ctx, model.ExamSection{
+		ExamId:   examId,
+		Type:     sectionType,
+		Title:    title,
+		Passage:  passage,
+		AudioUrl: audioUrl,
+		Order:    order,
+		UserId:   exam.UserId,
+	})
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	logger.Log("sectionId", sectionId)
+	return sectionId, nil
+}
+
+func (examService examService) UpdateExamSection(
+	ctx context.Context,
+	sectionId, sectionType, title, passage, audioUrl string,
+	order int32,
+	userId string,
+) (string, error) {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "UpdateExamSection failed: %w"
+
+	err := validateExamSection(sectionType, title, passage, audioUrl)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	section, err := examService.findEditableExamSection(ctx, sectionId, userId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	section.Type = sectionType
+	section.Title = title
+	section.Passage = passage
+	section.AudioUrl = audioUrl
+	section.Order = order
+	err = examService.databaseRepository.UpdateExamSection(ctx, *section)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	logger.Log("sectionId", sectionId)
+	return sectionId, nil
+}
+
+// 刪除題組，題組的題目不會刪除，而是改為獨立的題目
+func (examService examService) DeleteExamSection(
+	ctx context.Context,
+	sectionId, userId string,
+) error {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "DeleteExamSection failed: %w"
+
+	_, err := examService.findEditableExamSection(ctx, sectionId, userId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
+	}
+
+	databaseRepository := examService.databaseRepository
+	result, err := databaseRepository.WithTransaction(
+		ctx,
+		func(ctx context.Context) (interface{}, error) {
+			_, err := databaseRepository.DeleteExamSectionById(ctx, sectionId)
+			if err != nil {
+				return nil, err
+			}
+
+			return databaseRepository.UnsetQuestionsSectionIdBySectionId(ctx, sectionId)
+		},
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return fmt.Errorf(errorMessage, err)
+	}
+
+	logger.Log("sectionId", sectionId, "modifiedQuestionCount", result)
+	return nil
+}
+
+func (examService examService) FindExamSections(
+	ctx context.Context,
+	examId, userId string,
+) (sections []model.ExamSection, err error) {
+	errorLogger := examService.errorLogger
+	errorMessage := "FindExamSections failed: %w"
+
+	databaseRepository := examService.databaseRepository
+	exam, err := databaseRepository.GetExamById(ctx, examId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if exam == nil {
+		err = fmt.Errorf("Exam not found by id: %s", examId)
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	// 檢查使用者是否是該測驗的擁有者或共同編輯者
+	if !canEditExam(exam, userId) {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	sections, err = databaseRepository.FindExamSectionsByExamIdOrderByOrderAsc(ctx, examId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	return sections, nil
+}
+
+func (examService examService) CreateClass(
+	ctx context.Context,
+	name, description, userId string,
+) (classId, joinCode string, err error) {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "CreateClass failed: %w"
+
+	name = strings.TrimSpace(name)
+
+	if name == "" || len([]rune(name)) > maxClassNameLength {
+		err = fmt.Errorf("Invalid class name: %s", name)
+		errorLogger.Log("err", err)
+		return "", "", fmt.Errorf(errorMessage, err)
+	}
+
+	joinCode, err = newClassJoinCode()
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", "", fmt.Errorf(errorMessage, err)
+	}
+
+	classId, err = examService.databaseRepository.CreateClass(ctx, model.Class{
+		Name:        name,
+		Description: description,
+		JoinCode:    joinCode,
+		UserId:      userId,
+		Students:    []model.ClassStudent{},
+	})
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", "", fmt.Errorf(errorMessage, err)
+	}
+
+	logger.Log("classId", classId)
+	return classId, joinCode, nil
+}
+
+// 學生使用加入代碼加入班級，已經加入或是班級的老師時不做任何事
+func (examService examService) JoinClass(
+	ctx context.Context,
+	joinCode, userId string,
+) (class *model.Class, err error) {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "JoinClass failed: %w"
+
+	joinCode = normalizeClassJoinCode(joinCode)
+	databaseRepository := examService.databaseRepository
+	class, err = databaseRepository.GetClassByJoinCode(ctx, joinCode)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if class == nil {
+		err = fmt.Errorf("Class not found by joinCode: %s", joinCode)
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if isClassTeacher(class, userId) || isClassStudent(class, userId) {
+		logger.Log("classId", class.Id.Hex(), "joined", false)
+		return class, nil
+	}
+
+	_, err = databaseRepository.AddClassStudent(ctx, class.Id.Hex(), model.ClassStudent{
+		UserId:   userId,
+		JoinedAt: time.Now(),
+	})
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	logger.Log("classId", class.Id.Hex(), "joined", true)
+	return class, nil
 }
 
-func (examService examService) FindRandomQuestions(
-	ctx context.Context, examId, userId string, size int32,
-) (exam *model.Exam, questions []model.Question, err error) {
+// 查詢使用者是老師或學生的班級，學生看不到加入代碼和其他學生
+func (examService examService) FindClasses(
+	ctx context.Context,
+	userId string,
+) (classes []model.Class, err error) {
+	logger := examService.logger
 	errorLogger := examService.errorLogger
-	errorMessage := "FindRandomQuestions failed! error: %w"
+	errorMessage := "FindClasses failed: %w"
+
+	classes, err = examService.databaseRepository.FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc(
+		ctx,
+		userId,
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	for i := range classes {
+		if !isClassTeacher(&classes[i], userId) {
+			classes[i].JoinCode = ""
+			classes[i].Students = []model.ClassStudent{}
+		}
+	}
+
+	logger.Log("classes size", len(classes))
+	return classes, nil
+}
+
+// 老師指派測驗給班級，只能指派自己可以修改的測驗或公開的測驗
+func (examService examService) CreateAssignment(
+	ctx context.Context,
+	classId, examId, title string,
+	dueAt time.Time,
+	userId string,
+) (assignmentId string, err error) {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "CreateAssignment failed: %w"
+
+	if !dueAt.After(time.Now()) {
+		err = fmt.Errorf("Invalid dueAt: %s", dueAt.Format(time.RFC3339))
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
 
 	databaseRepository := examService.databaseRepository
-	exam, err = databaseRepository.GetExamById(ctx, examId)
+	class, err := databaseRepository.GetClassById(ctx, classId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	if class == nil {
+		err = fmt.Errorf("Class not found by id: %s", classId)
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	// 使用者不是該班級的老師
+	if !isClassTeacher(class, userId) {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
 
+	exam, err := databaseRepository.GetExamById(ctx, examId)
 	if err != nil {
 		errorLogger.Log("err", err)
-		return nil, nil, fmt.Errorf(errorMessage, err)
+		return "", fmt.Errorf(errorMessage, err)
 	}
 
 	if exam == nil {
-		return nil, []model.Question{}, nil
+		err = fmt.Errorf("Exam not found by id: %s", examId)
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
 	}
 
-	// 若測驗是不公開，則只有本人可以作測驗
-	if !exam.IsPublic && exam.UserId != userId {
+	// 只是作答者時不能把不公開的測驗再指派給其他人
+	if !canEditExam(exam, userId) && !exam.IsPublic {
 		err = unauthorizedOperationError
 		errorLogger.Log("err", err)
-		return nil, nil, fmt.Errorf(errorMessage, err)
+		return "", fmt.Errorf(errorMessage, err)
 	}
 
-	total, err := databaseRepository.CountQuestionsByExamId(
+	title = strings.TrimSpace(title)
+
+	if title == "" {
+		title = exam.Topic
+	}
+
+	assignmentId, err = databaseRepository.CreateAssignment(ctx, model.Assignment{
+		ClassId: classId,
+		ExamId:  examId,
+		Title:   title,
+		DueAt:   dueAt,
+		UserId:  userId,
+	})
+	if err != nil {
+		errorLogger.Log("err", err)
+		return "", fmt.Errorf(errorMessage, err)
+	}
+
+	logger.Log("assignmentId", assignmentId)
+	return assignmentId, nil
+}
+
+// 查詢班級的作業，班級的老師和學生可以查詢
+func (examService examService) FindAssignments(
+	ctx context.Context,
+	classId, userId string,
+) (assignments []model.Assignment, err error) {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "FindAssignments failed: %w"
+
+	databaseRepository := examService.databaseRepository
+	class, err := databaseRepository.GetClassById(ctx, classId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if class == nil {
+		err = fmt.Errorf("Class not found by id: %s", classId)
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if !isClassTeacher(class, userId) && !isClassStudent(class, userId) {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	assignments, err = databaseRepository.FindAssignmentsByClassIdOrderByDueAtAsc(ctx, classId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	logger.Log("assignments size", len(assignments))
+	return assignments, nil
+}
+
+/*
+查詢班級的成績簿，只有班級的老師可以查詢。
+每份作業只計算建立作業之後到截止時間之前的測驗紀錄，
+每個學生取最高分數和最近一次的分數，沒有作答的學生不會有成績
+*/
+func (examService examService) FindClassGradebook(
+	ctx context.Context,
+	classId, userId string,
+) (class *model.Class, assignments []model.Assignment, grades []ClassGrade, err error) {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "FindClassGradebook failed: %w"
+
+	databaseRepository := examService.databaseRepository
+	class, err = databaseRepository.GetClassById(ctx, classId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, nil, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if class == nil {
+		err = fmt.Errorf("Class not found by id: %s", classId)
+		errorLogger.Log("err", err)
+		return nil, nil, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	// 使用者不是該班級的老師
+	if !isClassTeacher(class, userId) {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return nil, nil, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	assignments, err = databaseRepository.FindAssignmentsByClassIdOrderByDueAtAsc(ctx, classId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, nil, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	studentUserIds := []string{}
+
+	for _, student := range class.Students {
+		studentUserIds = append(studentUserIds, student.UserId)
+	}
+
+	grades = []ClassGrade{}
+
+	if len(studentUserIds) == 0 {
+		logger.Log("assignments size", len(assignments), "grades size", len(grades))
+		return class, assignments, grades, nil
+	}
+
+	for _, assignment := range assignments {
+		examRecordScores, err := databaseRepository.FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween(
+			ctx,
+			assignment.ExamId,
+			studentUserIds,
+			assignment.CreatedAt,
+			assignment.DueAt,
+		)
+		if err != nil {
+			errorLogger.Log("err", err)
+			return nil, nil, nil, fmt.Errorf(errorMessage, err)
+		}
+
+		for _, examRecordScore := range examRecordScores {
+			grades = append(grades, ClassGrade{
+				AssignmentId:     assignment.Id.Hex(),
+				UserId:           examRecordScore.UserId,
+				BestPercentage:   examRecordScore.BestPercentage,
+				LatestPercentage: examRecordScore.LatestPercentage,
+				RecordCount:      examRecordScore.RecordCount,
+				LatestCreatedAt:  examRecordScore.LatestCreatedAt,
+			})
+		}
+	}
+
+	logger.Log("assignments size", len(assignments), "grades size", len(grades))
+	return class, assignments, grades, nil
+}
+
+// 查詢公開測驗的排行榜，每個使用者只列出期間內最佳的一筆紀錄，不列出 excludedUserIds 的使用者
+func (examService examService) FindExamLeaderboard(
+	ctx context.Context,
+	examId, window string,
+	size int32,
+	excludedUserIds []string,
+) (leaderboardEntries []repository.LeaderboardEntry, err error) {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "FindExamLeaderboard failed: %w"
+
+	if size < 1 || size > maxLeaderboardSize {
+		err = fmt.Errorf("Invalid size: %d", size)
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	startAt, err := leaderboardStartAt(window, time.Now())
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	databaseRepository := examService.databaseRepository
+	exam, err := databaseRepository.GetExamById(ctx, examId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if exam == nil {
+		err = fmt.Errorf("Exam not found by id: %s", examId)
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	// 只有公開的測驗有排行榜，避免洩漏不公開測驗的作答者
+	if !exam.IsPublic {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	leaderboardEntries, err = databaseRepository.FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn(
 		ctx,
 		examId,
+		startAt,
+		excludedUserIds,
+		size,
 	)
 	if err != nil {
 		errorLogger.Log("err", err)
-		return nil, nil, fmt.Errorf(errorMessage, err)
+		return nil, fmt.Errorf(errorMessage, err)
 	}
 
-	// 總數是零，表示使用者還沒新增 Question
-	if total == 0 {
-		return exam, []model.Question{}, nil
+	logger.Log("leaderboardEntries size", len(leaderboardEntries))
+	return leaderboardEntries, nil
+}
+
+// 查詢測驗的題目分析，只有測驗的擁有者可以查詢
+func (examService examService) FindQuestionStatistics(
+	ctx context.Context,
+	examId, userId string,
+) (
+	summary repository.ExamStatisticsSummary,
+	questionStatistics []QuestionStatistic,
+	err error,
+) {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "FindQuestionStatistics failed: %w"
+
+	databaseRepository := examService.databaseRepository
+	exam, err := databaseRepository.GetExamById(ctx, examId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return repository.ExamStatisticsSummary{}, nil, fmt.Errorf(errorMessage, err)
 	}
 
-	indexes := []int32{}
+	if exam == nil {
+		err = fmt.Errorf("Exam not found by id: %s", examId)
+		errorLogger.Log("err", err)
+		return repository.ExamStatisticsSummary{}, nil, fmt.Errorf(errorMessage, err)
+	}
 
-	for i := int32(0); i < total; i++ {
-		indexes = append(indexes, i)
+	// 作答紀錄包含其他使用者的資料，所以只有擁有者可以查詢
+	if exam.UserId != userId {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return repository.ExamStatisticsSummary{}, nil, fmt.Errorf(errorMessage, err)
 	}
 
-	// 將 indexes 隨機洗牌，然後根據洗牌後的 indexes 順序去查詢，達到隨機排序的效果
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
-	rng.Shuffle(len(indexes), func(i, j int) {
-		indexes[i], indexes[j] = indexes[j], indexes[i]
-	})
+	questions, _, err := examService.findExamContent(ctx, exam)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return repository.ExamStatisticsSummary{}, nil, fmt.Errorf(errorMessage, err)
+	}
 
-	maxQueryTotal := min(total, size)
+	summary, err = databaseRepository.FindExamStatisticsSummaryByExamId(ctx, examId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return repository.ExamStatisticsSummary{}, nil, fmt.Errorf(errorMessage, err)
+	}
 
-	for i := int32(0); i < maxQueryTotal; i++ {
-		findQuestions, err := databaseRepository.FindQuestionsByExamIdOrderByUpdateAtDesc(
+	questionAttemptCounts, err := databaseRepository.FindQuestionAttemptCountsByExamId(
+		ctx,
+		examId,
+		discriminationGroupRatio,
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return repository.ExamStatisticsSummary{}, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	questionStatistics = newQuestionStatistics(questions, questionAttemptCounts)
+
+	logger.Log(
+		"attemptCount", summary.AttemptCount,
+		"questionStatistics size", len(questionStatistics),
+	)
+	return summary, questionStatistics, nil
+}
+
+// 查詢作答紀錄與作答時的題目，用來逐題回顧作答內容，只有作答者本人可以查詢
+func (examService examService) GetExamRecordDetail(
+	ctx context.Context,
+	examId, examRecordId, userId string,
+) (examRecord *model.ExamRecord, questions []model.Question, err error) {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "GetExamRecordDetail failed: %w"
+
+	databaseRepository := examService.databaseRepository
+	examRecord, err = databaseRepository.GetExamRecordById(ctx, examRecordId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if examRecord == nil || examRecord.ExamId != examId {
+		err = fmt.Errorf("Exam record not found by id: %s", examRecordId)
+		errorLogger.Log("err", err)
+		return nil, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if examRecord.UserId != userId {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return nil, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	questionIds := examRecord.QuestionIds
+
+	if len(questionIds) == 0 {
+		logger.Log("questions size", 0)
+		return examRecord, []model.Question{}, nil
+	}
+
+	exam, err := databaseRepository.GetExamById(ctx, examId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	// 已發佈的測驗使用作答時版本的題目快照，才能看到當時的題目內容
+	if examRecord.ExamVersion > 0 {
+		examVersion, err := databaseRepository.GetExamVersionByExamIdAndVersion(
 			ctx,
 			examId,
-			indexes[i],
-			1,
+			examRecord.ExamVersion,
 		)
 		if err != nil {
 			errorLogger.Log("err", err)
 			return nil, nil, fmt.Errorf(errorMessage, err)
 		}
 
-		questions = append(questions, findQuestions[0])
+		if examVersion == nil {
+			err = fmt.Errorf("Exam version not found: %d", examRecord.ExamVersion)
+			errorLogger.Log("err", err)
+			return nil, nil, fmt.Errorf(errorMessage, err)
+		}
+
+		questions = examVersionQuestions(examVersion)
+	} else {
+		questions, err = examService.findQuestionsByQuestionIds(ctx, exam, questionIds)
+		if err != nil {
+			errorLogger.Log("err", err)
+			return nil, nil, fmt.Errorf(errorMessage, err)
+		}
+	}
+
+	questions = sortQuestionsByQuestionIds(questions, questionIds)
+
+	// 測驗設定交卷後不能查看答案時，除了擁有者與共同編輯者，都不回傳題目的答案
+	if exam != nil && !canViewExamAnswers(exam, userId) {
+		questions = hideQuestionAnswers(questions)
 	}
 
-	return exam, questions, nil
+	logger.Log("questions size", len(questions))
+	return examRecord, questions, nil
 }
@@ -2,8 +2,11 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"math/rand"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -80,6 +83,7 @@ func (s *MyTestSuite) TestCreateExam() {
 	type args struct {
 		topic       string
 		description string
+		tags        []string
 		isPublic    bool
 		userId      string
 	}
@@ -124,6 +128,7 @@ func (s *MyTestSuite) TestCreateExam() {
 			args: &args{
 				topic:       "topic02",
 				description: "desc02",
+				tags:        []string{" Daily  English ", "daily english", "TOEIC", ""},
 				isPublic:    false,
 				userId:      "user02",
 			},
@@ -136,13 +141,30 @@ func (s *MyTestSuite) TestCreateExam() {
 					CreateExam(mock.Anything, model.Exam{
 						Topic:       args.topic,
 						Description: args.description,
-						Tags:        []string{},
+						Tags:        []string{"daily english", "toeic"},
 						IsPublic:    args.isPublic,
 						UserId:      args.userId,
 					}).
 					Return("exam02", nil)
 			},
 		},
+		{
+			name: "Create exam with too many tags",
+			args: &args{
+				topic:       "topic03",
+				description: "desc03",
+				tags: []string{
+					"t01", "t02", "t03", "t04", "t05", "t06", "t07", "t08", "t09", "t10", "t11",
+				},
+				isPublic: false,
+				userId:   "user03",
+			},
+			expected: &result{
+				examId: "",
+				err:    fmt.Errorf("CreateExam failed: %w", fmt.Errorf("Too many tags: 11")),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
 	}
 
 	ctx := context.Background()
@@ -158,6 +180,7 @@ func (s *MyTestSuite) TestCreateExam() {
 				ctx,
 				args.topic,
 				args.description,
+				args.tags,
 				args.isPublic,
 				args.userId,
 			)
@@ -169,18 +192,26 @@ func (s *MyTestSuite) TestCreateExam() {
 	}
 }
 
+func int32Pointer(value int32) *int32 {
+	return &value
+}
+
 func (s *MyTestSuite) TestUpdateExam() {
 	type args struct {
-		examId      string
-		topic       string
-		description string
-		isPublic    bool
-		userId      string
+		examId          string
+		topic           string
+		description     string
+		tags            []string
+		isPublic        bool
+		settings        *model.ExamSettings
+		expectedVersion *int32
+		userId          string
 	}
 
 	type result struct {
-		examId string
-		err    error
+		examId  string
+		version int32
+		err     error
 	}
 
 	userId := "user01"
@@ -199,12 +230,14 @@ func (s *MyTestSuite) TestUpdateExam() {
 				examId:      examId,
 				topic:       "topic01-u01",
 				description: "desc01-u01",
+				tags:        []string{"Grammar"},
 				isPublic:    true,
 				userId:      userId,
 			},
 			expected: &result{
-				examId: examId,
-				err:    nil,
+				examId:  examId,
+				version: 1,
+				err:     nil,
 			},
 			on: func(s *MyTestSuite, args *args) {
 				s.mockDatabaseRepository.EXPECT().
@@ -222,7 +255,7 @@ func (s *MyTestSuite) TestUpdateExam() {
 						Id:          id,
 						Topic:       args.topic,
 						Description: args.description,
-						Tags:        []string{},
+						Tags:        []string{"grammar"},
 						IsPublic:    args.isPublic,
 						UserId:      args.userId,
 					}).
@@ -239,8 +272,52 @@ func (s *MyTestSuite) TestUpdateExam() {
 				userId:      userId,
 			},
 			expected: &result{
-				examId: examId,
-				err:    nil,
+				examId:  examId,
+				version: 1,
+				err:     nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Id:          id,
+						Topic:       "topic01",
+						Description: "desc01",
+						Tags:        []string{},
+						IsPublic:    true,
+						UserId:      userId,
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					UpdateExam(mock.Anything, model.Exam{
+						Id:          id,
+						Topic:       args.topic,
+						Description: args.description,
+						Tags:        []string{},
+						IsPublic:    args.isPublic,
+						UserId:      args.userId,
+					}).
+					Return(nil)
+			},
+		},
+		{
+			name: "Update exam settings",
+			args: &args{
+				examId:      examId,
+				topic:       "topic01",
+				description: "desc01",
+				isPublic:    true,
+				settings: &model.ExamSettings{
+					QuestionsPerAttempt: 20,
+					ShuffleQuestions:    true,
+					PassingScorePercent: 60,
+					MaxAttempts:         3,
+				},
+				userId: userId,
+			},
+			expected: &result{
+				examId:  examId,
+				version: 1,
+				err:     nil,
 			},
 			on: func(s *MyTestSuite, args *args) {
 				s.mockDatabaseRepository.EXPECT().
@@ -260,11 +337,140 @@ func (s *MyTestSuite) TestUpdateExam() {
 						Description: args.description,
 						Tags:        []string{},
 						IsPublic:    args.isPublic,
+						Settings:    args.settings,
+						UserId:      args.userId,
+					}).
+					Return(nil)
+			},
+		},
+		{
+			name: "Update exam with invalid passingScorePercent",
+			args: &args{
+				examId:      examId,
+				topic:       "topic01",
+				description: "desc01",
+				settings: &model.ExamSettings{
+					PassingScorePercent: 101,
+				},
+				userId: userId,
+			},
+			expected: &result{
+				examId: "",
+				err: fmt.Errorf(
+					"UpdateExam failed: %w",
+					fmt.Errorf("Invalid passingScorePercent: %d", 101),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+		{
+			name: "Update exam with invalid hintPenaltyPercent",
+			args: &args{
+				examId:      examId,
+				topic:       "topic01",
+				description: "desc01",
+				settings: &model.ExamSettings{
+					HintPenaltyPercent: -10,
+				},
+				userId: userId,
+			},
+			expected: &result{
+				examId: "",
+				err: fmt.Errorf(
+					"UpdateExam failed: %w",
+					fmt.Errorf("Invalid hintPenaltyPercent: %d", -10),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+		{
+			name: "Update exam with expected version",
+			args: &args{
+				examId:          examId,
+				topic:           "topic01",
+				description:     "desc01",
+				expectedVersion: int32Pointer(3),
+				userId:          userId,
+			},
+			expected: &result{
+				examId:  examId,
+				version: 4,
+				err:     nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Id:          id,
+						Topic:       "topic01",
+						Description: "desc01",
+						Tags:        []string{},
+						UserId:      userId,
+						Version:     3,
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					UpdateExam(mock.Anything, model.Exam{
+						Id:          id,
+						Topic:       args.topic,
+						Description: args.description,
+						Tags:        []string{},
 						UserId:      args.userId,
+						Version:     3,
 					}).
 					Return(nil)
 			},
 		},
+		{
+			name: "Update exam with stale version",
+			args: &args{
+				examId:          examId,
+				topic:           "topic01",
+				description:     "desc01",
+				expectedVersion: int32Pointer(2),
+				userId:          userId,
+			},
+			expected: &result{
+				examId:  "",
+				version: 0,
+				err:     fmt.Errorf("UpdateExam failed: %w", repository.VersionConflictError),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Id:      id,
+						Tags:    []string{},
+						UserId:  userId,
+						Version: 3,
+					}, nil)
+			},
+		},
+		{
+			name: "Update exam without version read before it was updated by others",
+			args: &args{
+				examId:          examId,
+				topic:           "topic01",
+				description:     "desc01",
+				expectedVersion: int32Pointer(0),
+				userId:          userId,
+			},
+			expected: &result{
+				examId:  "",
+				version: 0,
+				err:     fmt.Errorf("UpdateExam failed: %w", repository.VersionConflictError),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				// 0 表示讀取時還沒有版本號，已被修改過的測驗視為衝突
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Id:      id,
+						Tags:    []string{},
+						UserId:  userId,
+						Version: 1,
+					}, nil)
+			},
+		},
 	}
 
 	ctx := context.Background()
@@ -276,34 +482,163 @@ func (s *MyTestSuite) TestUpdateExam() {
 			tc.on(s, args)
 
 			// Test
-			examId, err := s.examService.UpdateExam(
+			examId, version, err := s.examService.UpdateExam(
 				ctx,
 				args.examId,
 				args.topic,
 				args.description,
+				args.tags,
 				args.isPublic,
+				args.settings,
+				args.expectedVersion,
 				args.userId,
 			)
 
 			expected := tc.expected
 			s.Equal(expected.examId, examId)
+			s.Equal(expected.version, version)
+			s.Equal(expected.err, err)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestGetExam() {
+	type args struct {
+		examId string
+		userId string
+	}
+
+	type result struct {
+		exam *model.Exam
+		err  error
+	}
+
+	id := primitive.NewObjectID()
+	examId := id.Hex()
+	exam := &model.Exam{
+		Id:       id,
+		Topic:    "topic01",
+		IsPublic: true,
+		UserId:   "user01",
+		Shares: []model.ExamShare{
+			{UserId: "user02", Role: EXAM_ROLE_EDITOR},
+			{UserId: "user03", Role: EXAM_ROLE_TAKER},
+		},
+		Version: 2,
+	}
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Get exam by editor",
+			args: &args{
+				examId: examId,
+				userId: "user02",
+			},
+			expected: &result{
+				exam: exam,
+				err:  nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(exam, nil)
+			},
+		},
+		{
+			name: "Get exam by taker",
+			args: &args{
+				examId: examId,
+				userId: "user03",
+			},
+			expected: &result{
+				exam: nil,
+				err:  fmt.Errorf("GetExam failed: %w", unauthorizedOperationError),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(exam, nil)
+			},
+		},
+		{
+			name: "Exam not found",
+			args: &args{
+				examId: examId,
+				userId: "user01",
+			},
+			expected: &result{
+				exam: nil,
+				err: fmt.Errorf(
+					"GetExam failed: %w",
+					fmt.Errorf("Exam not found by id: %s", examId),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(nil, nil)
+			},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			exam, err := s.examService.GetExam(ctx, args.examId, args.userId)
+
+			expected := tc.expected
+			s.Equal(expected.exam, exam)
 			s.Equal(expected.err, err)
 		})
 	}
 }
 
+func (s *MyTestSuite) TestGetQuestion() {
+	questionId := primitive.NewObjectID()
+	question := &model.Question{
+		Id:      questionId,
+		ExamId:  "exam01",
+		Ask:     "ask01",
+		Answers: []string{"a01"},
+		UserId:  "user01",
+		Version: 3,
+	}
+
+	s.mockDatabaseRepository.EXPECT().
+		GetQuestionById(mock.Anything, questionId.Hex()).
+		Return(question, nil)
+
+	// Test
+	result, err := s.examService.GetQuestion(context.Background(), questionId.Hex(), "user01")
+	s.Nil(err)
+	s.Equal(question, result)
+}
+
 func (s *MyTestSuite) TestFindExams() {
 	type args struct {
 		pageIndex int32
 		pageSize  int32
 		userId    string
+		cursor    string
 	}
 
 	type result struct {
-		total     int32
-		pageCount int32
-		exams     []model.Exam
-		err       error
+		total      int32
+		pageCount  int32
+		exams      []model.Exam
+		nextCursor string
+		err        error
 	}
 
 	userId := "user01"
@@ -373,6 +708,59 @@ func (s *MyTestSuite) TestFindExams() {
 					Return(int32(13), nil)
 			},
 		},
+		{
+			name: "Find exams by page1 when has next page",
+			args: &args{
+				pageIndex: 0,
+				pageSize:  3,
+				userId:    userId,
+			},
+			expected: &result{
+				total:      13,
+				pageCount:  5,
+				exams:      mockExams,
+				nextCursor: repository.EncodeCursor(time.Time{}, primitive.NilObjectID),
+				err:        nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					FindExamsByUserIdOrderByUpdateAtDesc(
+						mock.Anything,
+						args.userId,
+						args.pageIndex*args.pageSize,
+						args.pageSize,
+					).
+					Return(mockExams, nil)
+				s.mockDatabaseRepository.EXPECT().
+					CountExamsByUserId(mock.Anything, args.userId).
+					Return(int32(13), nil)
+			},
+		},
+		{
+			name: "Find exams by cursor",
+			args: &args{
+				pageSize: 10,
+				userId:   userId,
+				cursor:   "cursor01",
+			},
+			expected: &result{
+				total:      0,
+				pageCount:  0,
+				exams:      mockExams,
+				nextCursor: "nextCursor01",
+				err:        nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					FindExamsByUserIdAndCursorOrderByUpdateAtDesc(
+						mock.Anything,
+						args.userId,
+						args.cursor,
+						args.pageSize,
+					).
+					Return(mockExams, "nextCursor01", nil)
+			},
+		},
 	}
 
 	ctx := context.Background()
@@ -384,17 +772,19 @@ func (s *MyTestSuite) TestFindExams() {
 			tc.on(s, args)
 
 			// Test
-			total, pageCount, exams, err := s.examService.FindExams(
+			total, pageCount, exams, nextCursor, err := s.examService.FindExams(
 				ctx,
 				args.pageIndex,
 				args.pageSize,
 				args.userId,
+				args.cursor,
 			)
 
 			expected := tc.expected
 			s.Equal(expected.total, total)
 			s.Equal(expected.pageCount, pageCount)
 			s.Equal(expected.exams, exams)
+			s.Equal(expected.nextCursor, nextCursor)
 			s.Equal(expected.err, err)
 		})
 	}
@@ -496,17 +886,282 @@ func (s *MyTestSuite) TestDeleteExam() {
 	}
 }
 
-func (s *MyTestSuite) TestCreateQuestion() {
+func (s *MyTestSuite) TestCreatePracticeExam() {
 	type args struct {
-		examId  string
-		ask     string
-		answers []string
-		userId  string
+		topic         string
+		description   string
+		practiceWords []PracticeWord
+		questionTypes []string
+		userId        string
 	}
 
 	type result struct {
-		questionId string
-		err        error
+		examId        string
+		questionCount int32
+		errString     string
+	}
+
+	userId := "user01"
+	examId := primitive.NewObjectID().Hex()
+	practiceWords := []PracticeWord{
+		{
+			WordMeaningId: "wm01",
+			Word:          "book",
+			Definition:    "a written text",
+			Sentences:     []string{"I read a book."},
+		},
+		{
+			WordMeaningId: "wm02",
+			Word:          "apple",
+			Definition:    "a round fruit",
+			Sentences:     []string{},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Create practice exam with all question types",
+			args: &args{
+				topic:         "practice01",
+				description:   "desc01",
+				practiceWords: practiceWords,
+				questionTypes: []string{},
+				userId:        userId,
+			},
+			expected: &result{
+				examId:        examId,
+				questionCount: 2,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					WithTransaction(mock.Anything, mock.AnythingOfType("transactionFunc")).
+					Return(examId, nil)
+			},
+		},
+		{
+			name: "Create practice exam with invalid question type",
+			args: &args{
+				topic:         "practice02",
+				description:   "desc02",
+				practiceWords: practiceWords,
+				questionTypes: []string{"unknown"},
+				userId:        userId,
+			},
+			expected: &result{
+				errString: "Invalid practice exam: invalid question type: unknown",
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+		{
+			name: "Create practice exam when no question can be generated",
+			args: &args{
+				topic:         "practice03",
+				description:   "desc03",
+				practiceWords: practiceWords[1:],
+				questionTypes: []string{PRACTICE_QUESTION_TYPE_CLOZE},
+				userId:        userId,
+			},
+			expected: &result{
+				errString: "Invalid practice exam: no question can be generated",
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			examId, questionCount, err := s.examService.CreatePracticeExam(
+				ctx,
+				args.topic,
+				args.description,
+				args.practiceWords,
+				args.questionTypes,
+				args.userId,
+			)
+
+			expected := tc.expected
+			s.Equal(expected.examId, examId)
+			s.Equal(expected.questionCount, questionCount)
+
+			if expected.errString == "" {
+				s.Nil(err)
+			} else {
+				s.ErrorContains(err, expected.errString)
+			}
+		})
+	}
+}
+
+func (s *MyTestSuite) TestBuildPracticeQuestions() {
+	practiceWords := []PracticeWord{
+		{
+			WordMeaningId: "wm01",
+			Word:          "book",
+			Definition:    "a written text",
+			Sentences:     []string{"No example here.", "Books are fun. I like this book."},
+		},
+		{
+			WordMeaningId: "wm02",
+			Word:          "cat",
+			Definition:    "a small animal",
+			Sentences:     []string{"This category is empty.", "The Cat sleeps."},
+		},
+		{
+			WordMeaningId: "wm03",
+			Word:          "look forward to",
+			Definition:    "to feel happy about something that is going to happen",
+			Sentences:     []string{},
+		},
+	}
+
+	// Test
+	questions := buildPracticeQuestions(
+		practiceWords,
+		[]string{PRACTICE_QUESTION_TYPE_CLOZE, PRACTICE_QUESTION_TYPE_DEFINITION_TO_WORD},
+		"user01",
+	)
+	s.Equal([]model.Question{
+		{
+			Ask:           "Books are fun. I like this _____.",
+			Answers:       []string{"book"},
+			UserId:        "user01",
+			WordMeaningId: "wm01",
+		},
+		{
+			Ask:           "a small animal",
+			Answers:       []string{"cat"},
+			UserId:        "user01",
+			WordMeaningId: "wm02",
+		},
+		{
+			// 沒有例句，改用下一個題型
+			Ask:           "to feel happy about something that is going to happen",
+			Answers:       []string{"look forward to"},
+			UserId:        "user01",
+			WordMeaningId: "wm03",
+		},
+	}, questions)
+
+	// Test
+	questions = buildPracticeQuestions(
+		practiceWords[1:2],
+		[]string{PRACTICE_QUESTION_TYPE_CLOZE},
+		"user01",
+	)
+	s.Equal([]model.Question{
+		{
+			Ask:           "The _____ sleeps.",
+			Answers:       []string{"Cat"},
+			UserId:        "user01",
+			WordMeaningId: "wm02",
+		},
+	}, questions)
+
+	// Test
+	questions = buildPracticeQuestions(
+		[]PracticeWord{
+			{
+				WordMeaningId: "wm04",
+				Word:          "bark",
+				Definition:    "to make a loud sound",
+				Sentences:     []string{"Dogs bark. The dog will bark at night."},
+			},
+		},
+		[]string{PRACTICE_QUESTION_TYPE_CLOZE},
+		"user01",
+	)
+	s.Equal([]model.Question{
+		{
+			// 每個出現單字的地方各是一個空格
+			Ask:           "Dogs _____. The dog will _____ at night.",
+			Answers:       []string{"bark", "bark"},
+			UserId:        "user01",
+			WordMeaningId: "wm04",
+		},
+	}, questions)
+
+	// Test
+	questions = buildPracticeQuestions(
+		practiceWords,
+		[]string{PRACTICE_QUESTION_TYPE_WORD_TO_DEFINITION},
+		"user01",
+	)
+	s.Equal([]model.Question{
+		{
+			Ask:     "book",
+			Answers: []string{"a written text"},
+			Choices: []string{
+				"a small animal",
+				"a written text",
+				"to feel happy about something that is going to happen",
+			},
+			UserId:        "user01",
+			WordMeaningId: "wm01",
+		},
+		{
+			Ask:     "cat",
+			Answers: []string{"a small animal"},
+			Choices: []string{
+				"a small animal",
+				"a written text",
+				"to feel happy about something that is going to happen",
+			},
+			UserId:        "user01",
+			WordMeaningId: "wm02",
+		},
+		{
+			Ask:     "look forward to",
+			Answers: []string{"to feel happy about something that is going to happen"},
+			Choices: []string{
+				"a small animal",
+				"a written text",
+				"to feel happy about something that is going to happen",
+			},
+			UserId:        "user01",
+			WordMeaningId: "wm03",
+		},
+	}, questions)
+
+	// Test
+	questions = buildPracticeQuestions(
+		practiceWords[2:],
+		[]string{PRACTICE_QUESTION_TYPE_WORD_TO_DEFINITION},
+		"user01",
+	)
+
+	// 沒有其他解釋可以當作錯誤選項
+	s.Equal([]model.Question{}, questions)
+}
+
+func (s *MyTestSuite) TestCreateQuestion() {
+	type args struct {
+		examId          string
+		ask             string
+		answers         []string
+		acceptedAnswers [][]string
+		points          int32
+		partialCredit   bool
+		hint            string
+		explanation     string
+		sectionId       string
+		userId          string
+	}
+
+	type result struct {
+		questionId string
+		err        error
 	}
 
 	id01 := primitive.NewObjectID()
@@ -515,6 +1170,8 @@ func (s *MyTestSuite) TestCreateQuestion() {
 	id02 := primitive.NewObjectID()
 	examId02 := id02.Hex()
 
+	sectionId01 := primitive.NewObjectID().Hex()
+
 	testCases := []struct {
 		name     string
 		args     *args
@@ -557,10 +1214,15 @@ func (s *MyTestSuite) TestCreateQuestion() {
 		{
 			name: "Create question02",
 			args: &args{
-				examId:  examId02,
-				ask:     "q02",
-				answers: []string{"a02"},
-				userId:  "user01",
+				examId:          examId02,
+				ask:             "q02",
+				answers:         []string{"a02"},
+				acceptedAnswers: [][]string{{"b02", "c02"}},
+				points:          3,
+				partialCredit:   true,
+				hint:            "h02",
+				explanation:     "e02",
+				userId:          "user01",
 			},
 			expected: &result{
 				questionId: "question02",
@@ -579,14 +1241,123 @@ func (s *MyTestSuite) TestCreateQuestion() {
 					}, nil)
 				s.mockDatabaseRepository.EXPECT().
 					CreateQuestion(mock.Anything, model.Question{
-						ExamId:  args.examId,
-						Ask:     args.ask,
-						Answers: args.answers,
-						UserId:  args.userId,
+						ExamId:          args.examId,
+						Ask:             args.ask,
+						Answers:         args.answers,
+						AcceptedAnswers: args.acceptedAnswers,
+						UserId:          args.userId,
+						Points:          args.points,
+						PartialCredit:   args.partialCredit,
+						Hint:            args.hint,
+						Explanation:     args.explanation,
 					}).
 					Return("question02", nil)
 			},
 		},
+		{
+			name: "Accepted answers more than blanks",
+			args: &args{
+				examId:          examId01,
+				ask:             "q06",
+				answers:         []string{"a06"},
+				acceptedAnswers: [][]string{{"b06"}, {"c06"}},
+				userId:          "user01",
+			},
+			expected: &result{
+				questionId: "",
+				err: fmt.Errorf(
+					"CreateQuestion failed: %w",
+					fmt.Errorf("Invalid acceptedAnswers size: %d", 2),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+		{
+			name: "Invalid points",
+			args: &args{
+				examId:  examId01,
+				ask:     "q03",
+				answers: []string{"a03"},
+				points:  101,
+				userId:  "user01",
+			},
+			expected: &result{
+				questionId: "",
+				err: fmt.Errorf(
+					"CreateQuestion failed: %w",
+					fmt.Errorf("Invalid points: %d", 101),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+		{
+			name: "Create question in section",
+			args: &args{
+				examId:    examId01,
+				ask:       "q04",
+				answers:   []string{"a04"},
+				sectionId: sectionId01,
+				userId:    "user01",
+			},
+			expected: &result{
+				questionId: "question04",
+				err:        nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Id:     id01,
+						UserId: args.userId,
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamSectionById(mock.Anything, args.sectionId).
+					Return(&model.ExamSection{
+						ExamId: args.examId,
+						Type:   EXAM_SECTION_TYPE_READING,
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					CreateQuestion(mock.Anything, model.Question{
+						ExamId:    args.examId,
+						Ask:       args.ask,
+						Answers:   args.answers,
+						UserId:    args.userId,
+						SectionId: args.sectionId,
+					}).
+					Return("question04", nil)
+			},
+		},
+		{
+			name: "Section of other exam",
+			args: &args{
+				examId:    examId02,
+				ask:       "q05",
+				answers:   []string{"a05"},
+				sectionId: sectionId01,
+				userId:    "user01",
+			},
+			expected: &result{
+				questionId: "",
+				err: fmt.Errorf(
+					"CreateQuestion failed: %w",
+					fmt.Errorf("Exam section not found by id: %s", sectionId01),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Id:     id02,
+						UserId: args.userId,
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamSectionById(mock.Anything, args.sectionId).
+					Return(&model.ExamSection{
+						ExamId: examId01,
+						Type:   EXAM_SECTION_TYPE_READING,
+					}, nil)
+			},
+		},
 	}
 
 	ctx := context.Background()
@@ -603,6 +1374,12 @@ func (s *MyTestSuite) TestCreateQuestion() {
 				args.examId,
 				args.ask,
 				args.answers,
+				args.acceptedAnswers,
+				args.points,
+				args.partialCredit,
+				args.hint,
+				args.explanation,
+				args.sectionId,
 				args.userId,
 			)
 
@@ -615,14 +1392,22 @@ func (s *MyTestSuite) TestCreateQuestion() {
 
 func (s *MyTestSuite) TestUpdaetQuestion() {
 	type args struct {
-		questionId string
-		ask        string
-		answers    []string
-		userId     string
+		questionId      string
+		ask             string
+		answers         []string
+		acceptedAnswers [][]string
+		points          int32
+		partialCredit   bool
+		hint            string
+		explanation     string
+		sectionId       string
+		expectedVersion *int32
+		userId          string
 	}
 
 	type result struct {
 		questionId string
+		version    int32
 		err        error
 	}
 
@@ -648,6 +1433,7 @@ func (s *MyTestSuite) TestUpdaetQuestion() {
 			},
 			expected: &result{
 				questionId: questionId01,
+				version:    1,
 				err:        nil,
 			},
 			on: func(s *MyTestSuite, args *args) {
@@ -668,13 +1454,16 @@ func (s *MyTestSuite) TestUpdaetQuestion() {
 		{
 			name: "Update question02 answers",
 			args: &args{
-				questionId: questionId02,
-				ask:        "q02",
-				answers:    []string{"a01", "a02"},
-				userId:     "user01",
+				questionId:    questionId02,
+				ask:           "q02",
+				answers:       []string{"a01", "a02"},
+				points:        2,
+				partialCredit: true,
+				userId:        "user01",
 			},
 			expected: &result{
 				questionId: questionId02,
+				version:    1,
 				err:        nil,
 			},
 			on: func(s *MyTestSuite, args *args) {
@@ -692,6 +1481,69 @@ func (s *MyTestSuite) TestUpdaetQuestion() {
 					Return(nil, nil)
 			},
 		},
+		{
+			name: "Invalid points",
+			args: &args{
+				questionId: questionId01,
+				ask:        "q01",
+				answers:    []string{"a01"},
+				points:     -1,
+				userId:     "user01",
+			},
+			expected: &result{
+				questionId: "",
+				err: fmt.Errorf(
+					"UpdateQuestion failed: %w",
+					fmt.Errorf("Invalid points: %d", -1),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+		{
+			name: "Hint is too long",
+			args: &args{
+				questionId: questionId01,
+				ask:        "q01",
+				answers:    []string{"a01"},
+				hint:       strings.Repeat("h", maxHintLength+1),
+				userId:     "user01",
+			},
+			expected: &result{
+				questionId: "",
+				err: fmt.Errorf(
+					"UpdateQuestion failed: %w",
+					fmt.Errorf("Hint is too long: %d", maxHintLength+1),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+		{
+			name: "Question has been updated by others",
+			args: &args{
+				questionId:      questionId01,
+				ask:             "q01-updated",
+				answers:         []string{"a01"},
+				expectedVersion: int32Pointer(1),
+				userId:          "user01",
+			},
+			expected: &result{
+				questionId: "",
+				version:    0,
+				err:        fmt.Errorf("UpdateQuestion failed: %w", repository.VersionConflictError),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetQuestionById(mock.Anything, args.questionId).
+					Return(&model.Question{
+						Id:      id01,
+						ExamId:  "exam01",
+						Ask:     "q01",
+						Answers: []string{"a01"},
+						UserId:  args.userId,
+						Version: 2,
+					}, nil)
+			},
+		},
 	}
 
 	ctx := context.Background()
@@ -703,16 +1555,24 @@ func (s *MyTestSuite) TestUpdaetQuestion() {
 			tc.on(s, args)
 
 			// Test
-			questionId, err := s.examService.UpdateQuestion(
+			questionId, version, err := s.examService.UpdateQuestion(
 				ctx,
 				args.questionId,
 				args.ask,
 				args.answers,
+				args.acceptedAnswers,
+				args.points,
+				args.partialCredit,
+				args.hint,
+				args.explanation,
+				args.sectionId,
+				args.expectedVersion,
 				args.userId,
 			)
 
 			expected := tc.expected
 			s.Equal(expected.questionId, questionId)
+			s.Equal(expected.version, version)
 			s.Equal(expected.err, err)
 		})
 	}
@@ -724,13 +1584,15 @@ func (s *MyTestSuite) TestFindQuestions() {
 		pageSize  int32
 		examId    string
 		userId    string
+		cursor    string
 	}
 
 	type result struct {
-		total     int32
-		pageCount int32
-		questions []model.Question
-		err       error
+		total      int32
+		pageCount  int32
+		questions  []model.Question
+		nextCursor string
+		err        error
 	}
 
 	id := primitive.NewObjectID()
@@ -822,6 +1684,71 @@ func (s *MyTestSuite) TestFindQuestions() {
 					Return(int32(13), nil)
 			},
 		},
+		{
+			name: "Find questions by page1 when has next page",
+			args: &args{
+				pageIndex: 0,
+				pageSize:  3,
+				examId:    examId,
+				userId:    userId,
+			},
+			expected: &result{
+				total:      13,
+				pageCount:  5,
+				questions:  mockQuestions,
+				nextCursor: repository.EncodeCursor(time.Time{}, primitive.NilObjectID),
+				err:        nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Id:     id,
+						UserId: args.userId,
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindQuestionsByExamIdOrderByUpdateAtDesc(
+						mock.Anything,
+						args.examId,
+						args.pageIndex*args.pageSize,
+						args.pageSize).
+					Return(mockQuestions, nil)
+				s.mockDatabaseRepository.EXPECT().
+					CountQuestionsByExamId(mock.Anything, args.examId).
+					Return(int32(13), nil)
+			},
+		},
+		{
+			name: "Find questions by cursor",
+			args: &args{
+				pageSize: 10,
+				examId:   examId,
+				userId:   userId,
+				cursor:   "cursor01",
+			},
+			expected: &result{
+				total:      0,
+				pageCount:  0,
+				questions:  mockQuestions,
+				nextCursor: "nextCursor01",
+				err:        nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Id:     id,
+						UserId: args.userId,
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindQuestionsByExamIdAndCursorOrderByUpdateAtDesc(
+						mock.Anything,
+						args.examId,
+						args.cursor,
+						args.pageSize).
+					Return(mockQuestions, "nextCursor01", nil)
+			},
+		},
 	}
 
 	ctx := context.Background()
@@ -833,18 +1760,20 @@ func (s *MyTestSuite) TestFindQuestions() {
 			tc.on(s, args)
 
 			// Test
-			total, pageCount, questions, err := s.examService.FindQuestions(
+			total, pageCount, questions, nextCursor, err := s.examService.FindQuestions(
 				ctx,
 				args.pageIndex,
 				args.pageSize,
 				args.examId,
 				args.userId,
+				args.cursor,
 			)
 
 			expected := tc.expected
 			s.Equal(expected.total, total)
 			s.Equal(expected.pageCount, pageCount)
 			s.Equal(expected.questions, questions)
+			s.Equal(expected.nextCursor, nextCursor)
 			s.Equal(expected.err, err)
 		})
 	}
@@ -944,15 +1873,371 @@ func (s *MyTestSuite) TestDeleteQuestion() {
 	}
 }
 
-func (s *MyTestSuite) TestFindRandomQuestions() {
+func (s *MyTestSuite) TestBatchMutateQuestions() {
 	type args struct {
-		examId string
-		userId string
-		size   int32
+		examId     string
+		operations []QuestionOperation
+		userId     string
 	}
 
 	type result struct {
-		exam      *model.Exam
+		applied bool
+		results []QuestionOperationResult
+		err     error
+	}
+
+	userId := "user01"
+	examId := primitive.NewObjectID().Hex()
+	questionId01 := primitive.NewObjectID()
+	questionId02 := primitive.NewObjectID()
+	createdQuestionId := primitive.NewObjectID().Hex()
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Apply all operations",
+			args: &args{
+				examId: examId,
+				operations: []QuestionOperation{
+					{
+						Type:    QUESTION_OPERATION_CREATE,
+						Ask:     "ask01",
+						Answers: []string{"a01"},
+					},
+					{
+						Type:       QUESTION_OPERATION_UPDATE,
+						QuestionId: questionId01.Hex(),
+						Ask:        "ask02",
+						Answers:    []string{"a02"},
+					},
+					{
+						Type:       QUESTION_OPERATION_DELETE,
+						QuestionId: questionId02.Hex(),
+					},
+				},
+				userId: userId,
+			},
+			expected: &result{
+				applied: true,
+				results: []QuestionOperationResult{
+					{
+						Type:       QUESTION_OPERATION_CREATE,
+						QuestionId: createdQuestionId,
+					},
+					{
+						Type:       QUESTION_OPERATION_UPDATE,
+						QuestionId: questionId01.Hex(),
+					},
+					{
+						Type:       QUESTION_OPERATION_DELETE,
+						QuestionId: questionId02.Hex(),
+					},
+				},
+				err: nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						UserId: args.userId,
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindQuestionsByQuestionIds(
+						mock.Anything,
+						[]string{questionId01.Hex(), questionId02.Hex()},
+					).
+					Return([]model.Question{
+						{
+							Id:     questionId01,
+							ExamId: args.examId,
+							UserId: args.userId,
+						},
+						{
+							Id:     questionId02,
+							ExamId: args.examId,
+							UserId: args.userId,
+						},
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					WithTransaction(mock.Anything, mock.AnythingOfType("transactionFunc")).
+					Return([]string{createdQuestionId}, nil)
+			},
+		},
+		{
+			name: "Invalid operations are not applied",
+			args: &args{
+				examId: examId,
+				operations: []QuestionOperation{
+					{
+						Type: QUESTION_OPERATION_CREATE,
+						Ask:  "ask01",
+					},
+					{
+						Type:       QUESTION_OPERATION_DELETE,
+						QuestionId: questionId01.Hex(),
+					},
+				},
+				userId: userId,
+			},
+			expected: &result{
+				applied: false,
+				results: []QuestionOperationResult{
+					{
+						Type:  QUESTION_OPERATION_CREATE,
+						Error: "Answers is empty",
+					},
+					{
+						Type:       QUESTION_OPERATION_DELETE,
+						QuestionId: questionId01.Hex(),
+						Error:      unauthorizedOperationError.Error(),
+					},
+				},
+				err: nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						UserId: args.userId,
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindQuestionsByQuestionIds(mock.Anything, []string{questionId01.Hex()}).
+					Return([]model.Question{
+						{
+							Id:     questionId01,
+							ExamId: "otherExamId",
+							UserId: args.userId,
+						},
+					}, nil)
+			},
+		},
+		{
+			name: "Exam not found",
+			args: &args{
+				examId: examId,
+				operations: []QuestionOperation{
+					{
+						Type:    QUESTION_OPERATION_CREATE,
+						Ask:     "ask01",
+						Answers: []string{"a01"},
+					},
+				},
+				userId: userId,
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"BatchMutateQuestions failed: %w",
+					fmt.Errorf("Exam not found by id: %s", examId),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(nil, nil)
+			},
+		},
+		{
+			name: "Exam of other user",
+			args: &args{
+				examId: examId,
+				operations: []QuestionOperation{
+					{
+						Type:    QUESTION_OPERATION_CREATE,
+						Ask:     "ask01",
+						Answers: []string{"a01"},
+					},
+				},
+				userId: userId,
+			},
+			expected: &result{
+				err: fmt.Errorf("BatchMutateQuestions failed: %w", unauthorizedOperationError),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						UserId: "user02",
+					}, nil)
+			},
+		},
+		{
+			name: "No operations",
+			args: &args{
+				examId:     examId,
+				operations: []QuestionOperation{},
+				userId:     userId,
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"BatchMutateQuestions failed: %w",
+					fmt.Errorf("Invalid operation count: %d", 0),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			applied, results, err := s.examService.BatchMutateQuestions(
+				ctx,
+				args.examId,
+				args.operations,
+				args.userId,
+			)
+
+			expected := tc.expected
+			s.Equal(expected.applied, applied)
+			s.Equal(expected.results, results)
+			s.Equal(expected.err, err)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestValidateQuestionOperations() {
+	examId := primitive.NewObjectID().Hex()
+	questionId := primitive.NewObjectID()
+	questions := []model.Question{
+		{
+			Id:     questionId,
+			ExamId: examId,
+		},
+	}
+	sectionId := primitive.NewObjectID()
+	sections := []model.ExamSection{
+		{
+			Id:     sectionId,
+			ExamId: examId,
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		operation     QuestionOperation
+		expectedError string
+	}{
+		{
+			name: "Valid update",
+			operation: QuestionOperation{
+				Type:       QUESTION_OPERATION_UPDATE,
+				QuestionId: questionId.Hex(),
+				Ask:        "ask01",
+				Answers:    []string{"a01"},
+			},
+			expectedError: "",
+		},
+		{
+			name: "Update with empty ask",
+			operation: QuestionOperation{
+				Type:       QUESTION_OPERATION_UPDATE,
+				QuestionId: questionId.Hex(),
+				Ask:        " ",
+				Answers:    []string{"a01"},
+			},
+			expectedError: "Ask is empty",
+		},
+		{
+			name: "Update with invalid points",
+			operation: QuestionOperation{
+				Type:       QUESTION_OPERATION_UPDATE,
+				QuestionId: questionId.Hex(),
+				Ask:        "ask01",
+				Answers:    []string{"a01"},
+				Points:     101,
+			},
+			expectedError: "Invalid points: 101",
+		},
+		{
+			name: "Create in section",
+			operation: QuestionOperation{
+				Type:      QUESTION_OPERATION_CREATE,
+				Ask:       "ask01",
+				Answers:   []string{"a01"},
+				SectionId: sectionId.Hex(),
+			},
+			expectedError: "",
+		},
+		{
+			name: "Create in section not found",
+			operation: QuestionOperation{
+				Type:      QUESTION_OPERATION_CREATE,
+				Ask:       "ask01",
+				Answers:   []string{"a01"},
+				SectionId: "notExistSectionId",
+			},
+			expectedError: "Exam section not found by id: notExistSectionId",
+		},
+		{
+			name: "Delete question not found",
+			operation: QuestionOperation{
+				Type:       QUESTION_OPERATION_DELETE,
+				QuestionId: "notExistQuestionId",
+			},
+			expectedError: "Question not found by id: notExistQuestionId",
+		},
+		{
+			name: "Invalid operation type",
+			operation: QuestionOperation{
+				Type: "move",
+			},
+			expectedError: "Invalid operation type: move",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			results, isValid := validateQuestionOperations(
+				examId,
+				[]QuestionOperation{tc.operation},
+				questions,
+				sections,
+			)
+			s.Equal(tc.expectedError == "", isValid)
+			s.Equal(tc.expectedError, results[0].Error)
+		})
+	}
+
+	// 同一個題目不能有多個操作
+	results, isValid := validateQuestionOperations(
+		examId,
+		[]QuestionOperation{
+			{
+				Type:       QUESTION_OPERATION_DELETE,
+				QuestionId: questionId.Hex(),
+			},
+			{
+				Type:       QUESTION_OPERATION_DELETE,
+				QuestionId: questionId.Hex(),
+			},
+		},
+		questions,
+		sections,
+	)
+	s.False(isValid)
+	s.Equal("", results[0].Error)
+	s.Equal(fmt.Sprintf("Duplicate question id: %s", questionId.Hex()), results[1].Error)
+}
+
+func (s *MyTestSuite) TestFindRandomQuestions() {
+	type args struct {
+		examId string
+		userId string
+		size   int32
+	}
+
+	type result struct {
+		exam      *model.Exam
 		questions []model.Question
 		err       error
 	}
@@ -976,65 +2261,4935 @@ func (s *MyTestSuite) TestFindRandomQuestions() {
 		on       func(s *MyTestSuite, args *args)
 	}{
 		{
-			name: "Find random questions by size 5",
+			name: "Find random questions by size 5",
+			args: &args{
+				examId: examId,
+				userId: userId,
+				size:   5,
+			},
+			expected: &result{
+				exam:      &mockExam,
+				questions: []model.Question{{}, {}, {}, {}, {}},
+				err:       nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&mockExam, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindQuestionsByExamIdOrderByUpdateAtDesc(
+						mock.Anything,
+						args.examId,
+						mock.AnythingOfType("int32"),
+						int32(1)).
+					Return([]model.Question{
+						{},
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					CountQuestionsByExamId(mock.Anything, examId).
+					Return(int32(100), nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindExamSectionsByExamIdOrderByOrderAsc(mock.Anything, examId).
+					Return([]model.ExamSection{}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					CreateExamAttempt(mock.Anything, mock.AnythingOfType("model.ExamAttempt")).
+					Return("examAttempt01", nil)
+			},
+		},
+		{
+			name: "Find random questions by size 10",
+			args: &args{
+				examId: examId,
+				userId: userId,
+				size:   10,
+			},
+			expected: &result{
+				exam: &mockExam,
+				questions: []model.Question{
+					{}, {}, {}, {}, {}, {}, {}, {}, {}, {},
+				},
+				err: nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&mockExam, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindQuestionsByExamIdOrderByUpdateAtDesc(
+						mock.Anything,
+						args.examId,
+						mock.AnythingOfType("int32"),
+						int32(1)).
+					Return([]model.Question{
+						{},
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					CountQuestionsByExamId(mock.Anything, examId).
+					Return(int32(100), nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindExamSectionsByExamIdOrderByOrderAsc(mock.Anything, examId).
+					Return([]model.ExamSection{}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					CreateExamAttempt(mock.Anything, mock.AnythingOfType("model.ExamAttempt")).
+					Return("examAttempt01", nil)
+			},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			exam, questions, sections, examAttemptId, err := s.examService.FindRandomQuestions(
+				ctx,
+				args.examId,
+				args.userId,
+				args.size,
+			)
+
+			// 作答時不回傳答案
+			expected := tc.expected
+			s.Equal(expected.exam, exam)
+			s.Equal(hideQuestionAnswers(expected.questions), questions)
+			s.Empty(sections)
+			s.Equal("examAttempt01", examAttemptId)
+			s.Equal(expected.err, err)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestFindRandomQuestions_WhenExamIsPublished() {
+	examId := primitive.NewObjectID().Hex()
+	userId := "user01"
+	mockExam := &model.Exam{
+		IsPublic:      true,
+		UserId:        "user02",
+		LatestVersion: 2,
+	}
+	mockExamVersion := &model.ExamVersion{
+		ExamId:  examId,
+		Version: 2,
+		Questions: []model.ExamVersionQuestion{
+			{
+				QuestionId: primitive.NewObjectID().Hex(),
+				Ask:        "ask01",
+				Answers:    []string{"a01"},
+			},
+			{
+				QuestionId: primitive.NewObjectID().Hex(),
+				Ask:        "ask02",
+				Answers:    []string{"a02"},
+			},
+			{
+				QuestionId: primitive.NewObjectID().Hex(),
+				Ask:        "ask03",
+				Answers:    []string{"a03"},
+			},
+		},
+		UserId: "user02",
+	}
+
+	// 已發佈的測驗使用最新版本的題目快照，不會查詢目前的題目
+	s.mockDatabaseRepository.EXPECT().
+		GetExamById(mock.Anything, examId).
+		Return(mockExam, nil)
+	s.mockDatabaseRepository.EXPECT().
+		GetExamVersionByExamIdAndVersion(mock.Anything, examId, int32(2)).
+		Return(mockExamVersion, nil)
+
+	// 記錄出題時的版本，交卷時以該版本的題目評分
+	s.mockDatabaseRepository.EXPECT().
+		CreateExamAttempt(mock.Anything, mock.MatchedBy(func(examAttempt model.ExamAttempt) bool {
+			return examAttempt.ExamVersion == 2 && len(examAttempt.QuestionIds) == 2
+		})).
+		Return("examAttempt01", nil)
+
+	// Test
+	exam, questions, sections, examAttemptId, err := s.examService.FindRandomQuestions(
+		context.Background(),
+		examId,
+		userId,
+		2,
+	)
+	s.Nil(err)
+	s.Equal("examAttempt01", examAttemptId)
+	s.Empty(sections)
+	s.Equal(mockExam, exam)
+	s.Len(questions, 2)
+	s.Subset(hideQuestionAnswers(examVersionQuestions(mockExamVersion)), questions)
+
+	for _, question := range questions {
+		s.Empty(question.Answers)
+		s.Equal(int32(1), question.AnswerCount)
+	}
+}
+
+func (s *MyTestSuite) TestFindRandomQuestions_WhenExamHasSettings() {
+	id := primitive.NewObjectID()
+	examId := id.Hex()
+	userId := "user01"
+	mockExam := &model.Exam{
+		Id:            id,
+		IsPublic:      true,
+		UserId:        "user02",
+		LatestVersion: 1,
+		Settings: &model.ExamSettings{
+			QuestionsPerAttempt: 2,
+			ShuffleQuestions:    false,
+			MaxAttempts:         3,
+		},
+	}
+	mockExamVersion := &model.ExamVersion{
+		ExamId:  examId,
+		Version: 1,
+		Questions: []model.ExamVersionQuestion{
+			{
+				QuestionId: primitive.NewObjectID().Hex(),
+				Ask:        "ask01",
+				Answers:    []string{"a01"},
+				Hint:       "hint01",
+			},
+			{
+				QuestionId:  primitive.NewObjectID().Hex(),
+				Ask:         "ask02",
+				Answers:     []string{"a02"},
+				Hint:        "hint02",
+				Explanation: "explanation02",
+			},
+			{
+				QuestionId: primitive.NewObjectID().Hex(),
+				Ask:        "ask03",
+				Answers:    []string{"a03"},
+			},
+		},
+		UserId: "user02",
+	}
+
+	// 題數以測驗的設定為準，不打亂題目時依照題目的順序
+	s.mockDatabaseRepository.EXPECT().
+		GetExamById(mock.Anything, examId).
+		Return(mockExam, nil)
+	s.mockDatabaseRepository.EXPECT().
+		CountExamRecordsByExamIdAndUserId(mock.Anything, examId, userId).
+		Return(int32(2), nil)
+	s.mockDatabaseRepository.EXPECT().
+		GetExamVersionByExamIdAndVersion(mock.Anything, examId, int32(1)).
+		Return(mockExamVersion, nil)
+	s.mockDatabaseRepository.EXPECT().
+		CreateExamAttempt(mock.Anything, model.ExamAttempt{
+			ExamId:      examId,
+			ExamVersion: 1,
+			QuestionIds: []string{
+				mockExamVersion.Questions[0].QuestionId,
+				mockExamVersion.Questions[1].QuestionId,
+			},
+			UserId: userId,
+		}).
+		Return("examAttempt01", nil)
+
+	// Test
+	exam, questions, _, _, err := s.examService.FindRandomQuestions(
+		context.Background(),
+		examId,
+		userId,
+		10,
+	)
+	s.Nil(err)
+	s.Equal(mockExam, exam)
+
+	// 作答時不回傳答案、提示和解說，只標示題目有提示
+	expectedQuestions := hideQuestionAnswers(examVersionQuestions(mockExamVersion)[:2])
+	s.Equal(expectedQuestions, questions)
+	s.Empty(questions[1].Hint)
+	s.True(questions[1].HasHint)
+}
+
+func (s *MyTestSuite) TestFindRandomQuestions_WhenMaxAttemptsReached() {
+	id := primitive.NewObjectID()
+	examId := id.Hex()
+	userId := "user01"
+	mockExam := &model.Exam{
+		Id:       id,
+		IsPublic: true,
+		UserId:   "user02",
+		Settings: &model.ExamSettings{
+			ShuffleQuestions: true,
+			MaxAttempts:      3,
+		},
+	}
+
+	s.mockDatabaseRepository.EXPECT().
+		GetExamById(mock.Anything, examId).
+		Return(mockExam, nil)
+	s.mockDatabaseRepository.EXPECT().
+		CountExamRecordsByExamIdAndUserId(mock.Anything, examId, userId).
+		Return(int32(3), nil)
+
+	// Test
+	exam, questions, sections, examAttemptId, err := s.examService.FindRandomQuestions(
+		context.Background(),
+		examId,
+		userId,
+		10,
+	)
+	s.Nil(exam)
+	s.Nil(questions)
+	s.Nil(sections)
+	s.Empty(examAttemptId)
+	s.ErrorIs(err, MaxAttemptsReachedError)
+	s.EqualError(err, "FindRandomQuestions failed! error: Max attempts reached: 3")
+}
+
+func (s *MyTestSuite) TestIncreaseExamAttemptCount() {
+	type args struct {
+		exam     *model.Exam
+		settings model.ExamSettings
+		userId   string
+	}
+
+	id := primitive.NewObjectID()
+	examId := id.Hex()
+	exam := &model.Exam{
+		Id:       id,
+		IsPublic: true,
+		UserId:   "user02",
+	}
+	settings := model.ExamSettings{MaxAttempts: 3}
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected error
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Increase attempt count",
+			args: &args{
+				exam:     exam,
+				settings: settings,
+				userId:   "user01",
+			},
+			expected: nil,
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					CountExamRecordsByExamIdAndUserId(mock.Anything, examId, args.userId).
+					Return(int32(1), nil)
+				s.mockDatabaseRepository.EXPECT().
+					IncreaseExamAttemptCount(mock.Anything, examId, args.userId, int32(1), int32(3)).
+					Return(true, nil)
+			},
+		},
+		{
+			name: "Max attempts reached",
+			args: &args{
+				exam:     exam,
+				settings: settings,
+				userId:   "user01",
+			},
+			expected: maxAttemptsReachedError(3),
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					CountExamRecordsByExamIdAndUserId(mock.Anything, examId, args.userId).
+					Return(int32(2), nil)
+				s.mockDatabaseRepository.EXPECT().
+					IncreaseExamAttemptCount(mock.Anything, examId, args.userId, int32(2), int32(3)).
+					Return(false, nil)
+			},
+		},
+		{
+			name: "Owner is not limited",
+			args: &args{
+				exam:     exam,
+				settings: settings,
+				userId:   "user02",
+			},
+			expected: nil,
+			on:       func(s *MyTestSuite, args *args) {},
+		},
+		{
+			name: "Unlimited attempts",
+			args: &args{
+				exam:     exam,
+				settings: model.ExamSettings{},
+				userId:   "user01",
+			},
+			expected: nil,
+			on:       func(s *MyTestSuite, args *args) {},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			err := s.examService.increaseExamAttemptCount(
+				ctx,
+				args.exam,
+				args.settings,
+				args.userId,
+			)
+			s.Equal(tc.expected, err)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestFindRandomQuestions_WhenExamHasSections() {
+	id := primitive.NewObjectID()
+	examId := id.Hex()
+	userId := "user01"
+	mockExam := &model.Exam{
+		Id:       id,
+		IsPublic: true,
+		UserId:   userId,
+		Settings: &model.ExamSettings{
+			QuestionsPerAttempt: 3,
+		},
+	}
+	readingSection := model.ExamSection{
+		Id:      primitive.NewObjectID(),
+		ExamId:  examId,
+		Type:    EXAM_SECTION_TYPE_READING,
+		Title:   "reading01",
+		Passage: "passage01",
+		Order:   1,
+	}
+	listeningSection := model.ExamSection{
+		Id:       primitive.NewObjectID(),
+		ExamId:   examId,
+		Type:     EXAM_SECTION_TYPE_LISTENING,
+		Title:    "listening01",
+		AudioUrl: "https://example.com/audio01.mp3",
+		Order:    2,
+	}
+	standaloneQuestion := model.Question{Id: primitive.NewObjectID(), Ask: "ask01"}
+	listeningQuestion := model.Question{
+		Id:        primitive.NewObjectID(),
+		Ask:       "ask02",
+		SectionId: listeningSection.Id.Hex(),
+	}
+	readingQuestion01 := model.Question{
+		Id:        primitive.NewObjectID(),
+		Ask:       "ask03",
+		SectionId: readingSection.Id.Hex(),
+	}
+	readingQuestion02 := model.Question{
+		Id:        primitive.NewObjectID(),
+		Ask:       "ask04",
+		SectionId: readingSection.Id.Hex(),
+	}
+
+	s.mockDatabaseRepository.EXPECT().
+		GetExamById(mock.Anything, examId).
+		Return(mockExam, nil)
+	s.mockDatabaseRepository.EXPECT().
+		FindExamSectionsByExamIdOrderByOrderAsc(mock.Anything, examId).
+		Return([]model.ExamSection{readingSection, listeningSection}, nil)
+	s.mockDatabaseRepository.EXPECT().
+		FindQuestionsByExamId(mock.Anything, examId).
+		Return([]model.Question{
+			standaloneQuestion,
+			listeningQuestion,
+			readingQuestion01,
+			readingQuestion02,
+		}, nil)
+	s.mockDatabaseRepository.EXPECT().
+		CreateExamAttempt(mock.Anything, model.ExamAttempt{
+			ExamId: examId,
+			QuestionIds: []string{
+				readingQuestion01.Id.Hex(),
+				readingQuestion02.Id.Hex(),
+				listeningQuestion.Id.Hex(),
+			},
+			UserId: userId,
+		}).
+		Return("examAttempt01", nil)
+
+	// Test
+	exam, questions, sections, _, err := s.examService.FindRandomQuestions(
+		context.Background(),
+		examId,
+		userId,
+		10,
+	)
+	s.Nil(err)
+	s.Equal(mockExam, exam)
+
+	// 題組的題目依照題組的順序排在一起，題數不足時不拆開題組
+	s.Equal(hideQuestionAnswers([]model.Question{
+		readingQuestion01,
+		readingQuestion02,
+		listeningQuestion,
+	}), questions)
+	s.Equal([]model.ExamSection{readingSection, listeningSection}, sections)
+}
+
+func (s *MyTestSuite) TestRevealQuestionHint() {
+	type args struct {
+		examId        string
+		examAttemptId string
+		questionId    string
+		userId        string
+	}
+
+	type result struct {
+		hint string
+		err  error
+	}
+
+	userId := "user01"
+	id := primitive.NewObjectID()
+	examId := id.Hex()
+	examAttemptId := primitive.NewObjectID().Hex()
+	questionId01 := primitive.NewObjectID().Hex()
+	questionId02 := primitive.NewObjectID().Hex()
+	mockExam := &model.Exam{
+		Id:            id,
+		IsPublic:      true,
+		UserId:        userId,
+		LatestVersion: 1,
+	}
+	mockExamVersion := &model.ExamVersion{
+		ExamId:  examId,
+		Version: 1,
+		Questions: []model.ExamVersionQuestion{
+			{QuestionId: questionId01, Ask: "ask01", Answers: []string{"apple"}},
+			{QuestionId: questionId02, Ask: "ask02", Answers: []string{"red"}, Hint: "hint02"},
+		},
+		UserId: userId,
+	}
+
+	// 開始作答時出給使用者的題目
+	newExamAttempt := func(userId string) *model.ExamAttempt {
+		return &model.ExamAttempt{
+			ExamId:      examId,
+			ExamVersion: 1,
+			QuestionIds: []string{questionId01, questionId02},
+			UserId:      userId,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Reveal hint of question",
+			args: &args{
+				examId:        examId,
+				examAttemptId: examAttemptId,
+				questionId:    questionId02,
+				userId:        "user02",
+			},
+			expected: &result{
+				hint: "hint02",
+				err:  nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamAttemptById(mock.Anything, args.examAttemptId).
+					Return(newExamAttempt(args.userId), nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(mockExam, nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamVersionByExamIdAndVersion(mock.Anything, args.examId, int32(1)).
+					Return(mockExamVersion, nil)
+				s.mockDatabaseRepository.EXPECT().
+					AddExamAttemptHintQuestionId(mock.Anything, args.examAttemptId, args.questionId).
+					Return(true, nil)
+			},
+		},
+		{
+			name: "Reveal hint of question without hint",
+			args: &args{
+				examId:        examId,
+				examAttemptId: examAttemptId,
+				questionId:    questionId01,
+				userId:        "user02",
+			},
+			expected: &result{
+				// 沒有提示的題目不記錄，交卷時不扣分
+				hint: "",
+				err:  nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamAttemptById(mock.Anything, args.examAttemptId).
+					Return(newExamAttempt(args.userId), nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(mockExam, nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamVersionByExamIdAndVersion(mock.Anything, args.examId, int32(1)).
+					Return(mockExamVersion, nil)
+			},
+		},
+		{
+			name: "Reveal hint of question not in exam attempt",
+			args: &args{
+				examId:        examId,
+				examAttemptId: examAttemptId,
+				questionId:    "question99",
+				userId:        "user02",
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"RevealQuestionHint failed: %w",
+					fmt.Errorf("Invalid questionId: %s", "question99"),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamAttemptById(mock.Anything, args.examAttemptId).
+					Return(newExamAttempt(args.userId), nil)
+			},
+		},
+		{
+			name: "Reveal hint with exam attempt of other user",
+			args: &args{
+				examId:        examId,
+				examAttemptId: examAttemptId,
+				questionId:    questionId02,
+				userId:        "user02",
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"RevealQuestionHint failed: %w",
+					fmt.Errorf("Exam attempt not found by id: %s", examAttemptId),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamAttemptById(mock.Anything, args.examAttemptId).
+					Return(newExamAttempt("user03"), nil)
+			},
+		},
+		{
+			name: "Reveal hint with submitted exam attempt",
+			args: &args{
+				examId:        examId,
+				examAttemptId: examAttemptId,
+				questionId:    questionId02,
+				userId:        "user02",
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"RevealQuestionHint failed: %w",
+					examAttemptSubmittedError(examAttemptId),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				submittedAt := time.Now()
+				examAttempt := newExamAttempt(args.userId)
+				examAttempt.SubmittedAt = &submittedAt
+				s.mockDatabaseRepository.EXPECT().
+					GetExamAttemptById(mock.Anything, args.examAttemptId).
+					Return(examAttempt, nil)
+			},
+		},
+		{
+			name: "Reveal hint while exam attempt is submitted",
+			args: &args{
+				examId:        examId,
+				examAttemptId: examAttemptId,
+				questionId:    questionId02,
+				userId:        "user02",
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"RevealQuestionHint failed: %w",
+					examAttemptSubmittedError(examAttemptId),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamAttemptById(mock.Anything, args.examAttemptId).
+					Return(newExamAttempt(args.userId), nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(mockExam, nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamVersionByExamIdAndVersion(mock.Anything, args.examId, int32(1)).
+					Return(mockExamVersion, nil)
+				s.mockDatabaseRepository.EXPECT().
+					AddExamAttemptHintQuestionId(mock.Anything, args.examAttemptId, args.questionId).
+					Return(false, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			hint, err := s.examService.RevealQuestionHint(
+				context.Background(),
+				args.examId,
+				args.examAttemptId,
+				args.questionId,
+				args.userId,
+			)
+
+			s.Equal(tc.expected.hint, hint)
+			s.Equal(tc.expected.err, err)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestSelectQuestionGroups() {
+	sectionId := primitive.NewObjectID().Hex()
+	questions := []model.Question{
+		{Ask: "ask01", SectionId: sectionId},
+		{Ask: "ask02"},
+		{Ask: "ask03", SectionId: sectionId},
+		{Ask: "ask04", SectionId: sectionId},
+	}
+	groups := groupQuestionsBySection(questions)
+	s.Equal([][]model.Question{
+		{questions[0], questions[2], questions[3]},
+		{questions[1]},
+	}, groups)
+
+	s.Equal(
+		[]model.Question{questions[0], questions[2], questions[3], questions[1]},
+		selectQuestionGroups(groups, 4),
+	)
+
+	// 題組放不下時略過，選擇後面放得下的題目
+	s.Equal([]model.Question{questions[1]}, selectQuestionGroups(groups, 2))
+
+	// 沒有任何一組放得下時，仍然選出第一組
+	s.Equal(
+		[]model.Question{questions[0], questions[2], questions[3]},
+		selectQuestionGroups(groups[:1], 1),
+	)
+}
+
+func (s *MyTestSuite) TestValidateExamSection() {
+	testCases := []struct {
+		name        string
+		sectionType string
+		passage     string
+		audioUrl    string
+		expected    error
+	}{
+		{
+			name:        "Reading section",
+			sectionType: EXAM_SECTION_TYPE_READING,
+			passage:     "passage01",
+			expected:    nil,
+		},
+		{
+			name:        "Reading section without passage",
+			sectionType: EXAM_SECTION_TYPE_READING,
+			passage:     " ",
+			expected:    fmt.Errorf("Passage is empty"),
+		},
+		{
+			name:        "Listening section",
+			sectionType: EXAM_SECTION_TYPE_LISTENING,
+			audioUrl:    "https://example.com/audio01.mp3",
+			expected:    nil,
+		},
+		{
+			name:        "Listening section with invalid audioUrl",
+			sectionType: EXAM_SECTION_TYPE_LISTENING,
+			audioUrl:    "ftp://example.com/audio01.mp3",
+			expected:    fmt.Errorf("Invalid audioUrl: %s", "ftp://example.com/audio01.mp3"),
+		},
+		{
+			name:        "Invalid section type",
+			sectionType: "video",
+			expected:    fmt.Errorf("Invalid section type: %s", "video"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Equal(tc.expected, validateExamSection(tc.sectionType, "title01", tc.passage, tc.audioUrl))
+		})
+	}
+}
+
+func (s *MyTestSuite) TestCreateExamSection() {
+	type args struct {
+		examId      string
+		sectionType string
+		title       string
+		passage     string
+		audioUrl    string
+		order       int32
+		userId      string
+	}
+
+	type result struct {
+		sectionId string
+		err       error
+	}
+
+	userId := "user01"
+	id := primitive.NewObjectID()
+	examId := id.Hex()
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Create reading section",
+			args: &args{
+				examId:      examId,
+				sectionType: EXAM_SECTION_TYPE_READING,
+				title:       "title01",
+				passage:     "passage01",
+				order:       1,
+				userId:      userId,
+			},
+			expected: &result{
+				sectionId: "section01",
+				err:       nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Id:     id,
+						UserId: args.userId,
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					CreateExamSection(mock.Anything, model.ExamSection{
+						ExamId:  args.examId,
+						Type:    args.sectionType,
+						Title:   args.title,
+						Passage: args.passage,
+						Order:   args.order,
+						UserId:  args.userId,
+					}).
+					Return("section01", nil)
+			},
+		},
+		{
+			name: "Create section of other user",
+			args: &args{
+				examId:      examId,
+				sectionType: EXAM_SECTION_TYPE_LISTENING,
+				title:       "title02",
+				audioUrl:    "https://example.com/audio02.mp3",
+				order:       2,
+				userId:      userId,
+			},
+			expected: &result{
+				sectionId: "",
+				err:       fmt.Errorf("CreateExamSection failed: %w", unauthorizedOperationError),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Id:     id,
+						UserId: "user02",
+					}, nil)
+			},
+		},
+		{
+			name: "Listening section without audioUrl",
+			args: &args{
+				examId:      examId,
+				sectionType: EXAM_SECTION_TYPE_LISTENING,
+				title:       "title03",
+				userId:      userId,
+			},
+			expected: &result{
+				sectionId: "",
+				err: fmt.Errorf(
+					"CreateExamSection failed: %w",
+					fmt.Errorf("Invalid audioUrl: %s", ""),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			sectionId, err := s.examService.CreateExamSection(
+				ctx,
+				args.examId,
+				args.sectionType,
+				args.title,
+				args.passage,
+				args.audioUrl,
+				args.order,
+				args.userId,
+			)
+
+			expected := tc.expected
+			s.Equal(expected.sectionId, sectionId)
+			s.Equal(expected.err, err)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestDeleteExamSection() {
+	userId := "user01"
+	examId := primitive.NewObjectID().Hex()
+	sectionId := primitive.NewObjectID().Hex()
+
+	s.mockDatabaseRepository.EXPECT().
+		GetExamSectionById(mock.Anything, sectionId).
+		Return(&model.ExamSection{
+			ExamId: examId,
+			Type:   EXAM_SECTION_TYPE_READING,
+		}, nil)
+	s.mockDatabaseRepository.EXPECT().
+		GetExamById(mock.Anything, examId).
+		Return(&model.Exam{
+			UserId: userId,
+		}, nil)
+	s.mockDatabaseRepository.EXPECT().
+		WithTransaction(mock.Anything, mock.AnythingOfType("transactionFunc")).
+		Return(int32(2), nil)
+
+	// Test
+	err := s.examService.DeleteExamSection(context.Background(), sectionId, userId)
+	s.Nil(err)
+
+	// 共同編輯者以外的使用者不能刪除題組
+	s.SetupTest()
+	s.mockDatabaseRepository.EXPECT().
+		GetExamSectionById(mock.Anything, sectionId).
+		Return(&model.ExamSection{
+			ExamId: examId,
+			Type:   EXAM_SECTION_TYPE_READING,
+		}, nil)
+	s.mockDatabaseRepository.EXPECT().
+		GetExamById(mock.Anything, examId).
+		Return(&model.Exam{
+			UserId: "user02",
+		}, nil)
+
+	// Test
+	err = s.examService.DeleteExamSection(context.Background(), sectionId, userId)
+	s.Equal(fmt.Errorf("DeleteExamSection failed: %w", unauthorizedOperationError), err)
+}
+
+func (s *MyTestSuite) TestIsPassed() {
+	s.True(isPassed(60, 60))
+	s.False(isPassed(59.99, 60))
+	s.True(isPassed(66.67, 66))
+	s.False(isPassed(66.67, 67))
+}
+
+func (s *MyTestSuite) TestGradeExamAttempt() {
+	id01 := primitive.NewObjectID()
+	id02 := primitive.NewObjectID()
+	id03 := primitive.NewObjectID()
+	questions := []model.Question{
+		{Id: id01, Answers: []string{"apple"}, Points: 3},
+		{Id: id02, Answers: []string{"red", "blue"}, Points: 2, PartialCredit: true},
+		{Id: id03, Answers: []string{"cat", "dog"}},
+	}
+
+	// 可以部分給分的題目依照答對的填空數給分，其他答錯的題目為 0 分
+	examRecord, err := gradeExamAttempt(
+		questions,
+		[]model.ExamAttemptAnswer{
+			{QuestionId: id01.Hex(), Answer: "apple"},
+			{QuestionId: id02.Hex(), BlankAnswers: []string{"red", "green"}},
+			{QuestionId: id03.Hex(), BlankAnswers: []string{"cat"}, IsCorrect: true, Credit: 1},
+		},
+		0,
+	)
+	s.Nil(err)
+	s.Equal(int32(1), examRecord.Score)
+	s.Equal([]string{id01.Hex(), id02.Hex(), id03.Hex()}, examRecord.QuestionIds)
+	s.Equal([]string{id02.Hex(), id03.Hex()}, examRecord.WrongQuestionIds)
+	s.Equal(4.0, examRecord.EarnedPoints)
+	s.Equal(int32(6), examRecord.MaxPoints)
+	s.Equal(66.67, examRecord.Percentage)
+	s.Equal(model.ExamAttemptAnswer{
+		QuestionId:   id03.Hex(),
+		Answer:       "cat, ",
+		BlankAnswers: []string{"cat", ""},
+		IsCorrect:    false,
+		Credit:       0,
+	}, examRecord.Answers[2])
+
+	// 查看提示的題目依照測驗設定扣分
+	examRecord, err = gradeExamAttempt(
+		questions,
+		[]model.ExamAttemptAnswer{
+			{QuestionId: id01.Hex(), Answer: "apple", HintUsed: true},
+			{QuestionId: id02.Hex(), BlankAnswers: []string{"red", "green"}, HintUsed: true},
+		},
+		50,
+	)
+	s.Nil(err)
+	s.Equal(2.0, examRecord.EarnedPoints)
+	s.Equal(int32(5), examRecord.MaxPoints)
+	s.Equal(0.5, examRecord.Answers[0].Credit)
+	s.Equal(0.25, examRecord.Answers[1].Credit)
+
+	// 不屬於測驗的題目不能作答
+	_, err = gradeExamAttempt(
+		questions,
+		[]model.ExamAttemptAnswer{{QuestionId: "question99"}},
+		0,
+	)
+	s.Equal(fmt.Errorf("Invalid questionId: %s", "question99"), err)
+}
+
+func (s *MyTestSuite) TestGradeAttemptAnswer() {
+	question := model.Question{
+		Answers:         []string{"color", "grey", "center"},
+		AcceptedAnswers: [][]string{{"colour"}, {"gray", " Grey "}},
+		PartialCredit:   true,
+	}
+
+	// 每個填空可以是答案或該填空可以接受的其他答案之一
+	answer := gradeAttemptAnswer(
+		question,
+		model.ExamAttemptAnswer{BlankAnswers: []string{"colour", " gray ", "center"}},
+		0,
+	)
+	s.True(answer.IsCorrect)
+	s.Equal(1.0, answer.Credit)
+	s.Equal([]string{"colour", "gray", "center"}, answer.BlankAnswers)
+
+	// 其他填空可以接受的答案不能用在別的填空，沒有設定其他答案的填空只接受答案
+	answer = gradeAttemptAnswer(
+		question,
+		model.ExamAttemptAnswer{BlankAnswers: []string{"gray", "Grey", "centre"}},
+		0,
+	)
+	s.False(answer.IsCorrect)
+	s.Equal(1.0/3, answer.Credit)
+}
+
+func (s *MyTestSuite) TestExamRecordPercentage() {
+	percentage, ok := examRecordPercentage(model.ExamRecord{
+		Score:       3,
+		QuestionIds: []string{"q01", "q02", "q03", "q04"},
+		MaxPoints:   8,
+		Percentage:  75.5,
+	})
+	s.True(ok)
+	s.Equal(75.5, percentage)
+
+	percentage, ok = examRecordPercentage(model.ExamRecord{
+		Score:       3,
+		QuestionIds: []string{"q01", "q02", "q03", "q04"},
+	})
+	s.True(ok)
+	s.Equal(75.0, percentage)
+
+	// 連題目都沒有記錄的舊作答紀錄無法換算
+	_, ok = examRecordPercentage(model.ExamRecord{Score: 3})
+	s.False(ok)
+}
+
+func (s *MyTestSuite) TestCreateExamRecord() {
+	type args struct {
+		examId          string
+		examAttemptId   string
+		durationSeconds int32
+		answers         []model.ExamAttemptAnswer
+		userId          string
+	}
+
+	type result struct {
+		examRecord *model.ExamRecord
+		questions  []model.Question
+		err        error
+	}
+
+	userId := "user01"
+	id := primitive.NewObjectID()
+	examId := id.Hex()
+	examRecordId := primitive.NewObjectID()
+	questionId01 := primitive.NewObjectID()
+	questionId02 := primitive.NewObjectID()
+	examVersion := &model.ExamVersion{
+		ExamId:  examId,
+		Version: 1,
+		Questions: []model.ExamVersionQuestion{
+			{
+				QuestionId: questionId01.Hex(),
+				Ask:        "ask01",
+				Answers:    []string{"apple"},
+				Points:     2,
+			},
+			{
+				QuestionId:    questionId02.Hex(),
+				Ask:           "ask02",
+				Answers:       []string{"red", "blue"},
+				PartialCredit: true,
+			},
+		},
+		UserId: userId,
+	}
+	examAttemptId := primitive.NewObjectID().Hex()
+
+	// 開始作答時出給使用者的題目
+	newExamAttempt := func(userId string, examVersion int32, questionIds ...string) *model.ExamAttempt {
+		return &model.ExamAttempt{
+			ExamId:      examId,
+			ExamVersion: examVersion,
+			QuestionIds: questionIds,
+			UserId:      userId,
+		}
+	}
+	draftQuestion := model.Question{
+		Id:      questionId01,
+		ExamId:  examId,
+		Ask:     "ask01",
+		Answers: []string{"apple"},
+	}
+
+	// 用戶端傳送的 isCorrect 與 credit 都會被忽略，以後端評分為準
+	answers := []model.ExamAttemptAnswer{
+		{
+			QuestionId:       questionId02.Hex(),
+			BlankAnswers:     []string{"red", "green"},
+			IsCorrect:        true,
+			TimeSpentSeconds: 20,
+			Credit:           1,
+		},
+		{
+			QuestionId:       questionId01.Hex(),
+			BlankAnswers:     []string{" apple "},
+			IsCorrect:        false,
+			TimeSpentSeconds: 10,
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Create examRecord of published exam",
+			args: &args{
+				examId:          examId,
+				examAttemptId:   examAttemptId,
+				durationSeconds: 30,
+				answers:         answers,
+				userId:          "user02",
+			},
+			expected: &result{
+				examRecord: &model.ExamRecord{
+					Id:               examRecordId,
+					ExamId:           examId,
+					ExamVersion:      1,
+					Score:            1,
+					DurationSeconds:  30,
+					QuestionIds:      []string{questionId02.Hex(), questionId01.Hex()},
+					WrongQuestionIds: []string{questionId02.Hex()},
+					Answers: []model.ExamAttemptAnswer{
+						{
+							QuestionId:       questionId02.Hex(),
+							Answer:           "red, green",
+							BlankAnswers:     []string{"red", "green"},
+							IsCorrect:        false,
+							TimeSpentSeconds: 20,
+							Credit:           0.5,
+						},
+						{
+							QuestionId:       questionId01.Hex(),
+							Answer:           "apple",
+							BlankAnswers:     []string{"apple"},
+							IsCorrect:        true,
+							TimeSpentSeconds: 10,
+							Credit:           1,
+						},
+					},
+					PassingScorePercent: 80,
+					Passed:              true,
+					EarnedPoints:        2.5,
+					MaxPoints:           3,
+					Percentage:          83.33,
+					UserId:              "user02",
+				},
+				questions: []model.Question{
+					examVersionQuestions(examVersion)[1],
+					examVersionQuestions(examVersion)[0],
+				},
+				err: nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamAttemptById(mock.Anything, args.examAttemptId).
+					Return(newExamAttempt(args.userId, 1, questionId02.Hex(), questionId01.Hex()), nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Id:            id,
+						IsPublic:      true,
+						UserId:        userId,
+						LatestVersion: 1,
+						Settings: &model.ExamSettings{
+							ShowAnswersAfterSubmit: true,
+							PassingScorePercent:    80,
+						},
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamVersionByExamIdAndVersion(mock.Anything, args.examId, int32(1)).
+					Return(examVersion, nil)
+				s.mockDatabaseRepository.EXPECT().
+					WithTransaction(mock.Anything, mock.AnythingOfType("transactionFunc")).
+					Return(examRecordId.Hex(), nil)
+			},
+		},
+		{
+			name: "Create examRecord of draft exam",
+			args: &args{
+				examId:        examId,
+				examAttemptId: examAttemptId,
+				answers: []model.ExamAttemptAnswer{
+					{QuestionId: questionId01.Hex(), Answer: "apple"},
+				},
+				userId: "user02",
+			},
+			expected: &result{
+				examRecord: &model.ExamRecord{
+					Id:               examRecordId,
+					ExamId:           examId,
+					Score:            1,
+					QuestionIds:      []string{questionId01.Hex()},
+					WrongQuestionIds: []string{},
+					Answers: []model.ExamAttemptAnswer{
+						{
+							QuestionId:   questionId01.Hex(),
+							Answer:       "apple",
+							BlankAnswers: []string{"apple"},
+							IsCorrect:    true,
+							Credit:       1,
+						},
+					},
+					EarnedPoints: 1,
+					MaxPoints:    1,
+					Percentage:   100,
+					UserId:       "user02",
+				},
+				questions: []model.Question{
+					{
+						Id:          questionId01,
+						ExamId:      examId,
+						Ask:         "ask01",
+						Answers:     []string{},
+						AnswerCount: 1,
+					},
+				},
+				err: nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamAttemptById(mock.Anything, args.examAttemptId).
+					Return(newExamAttempt(args.userId, 0, questionId01.Hex()), nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Id:       id,
+						IsPublic: true,
+						UserId:   userId,
+						Settings: &model.ExamSettings{
+							ShowAnswersAfterSubmit: false,
+						},
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindQuestionsByQuestionIds(mock.Anything, []string{questionId01.Hex()}).
+					Return([]model.Question{draftQuestion}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					WithTransaction(mock.Anything, mock.AnythingOfType("transactionFunc")).
+					Return(examRecordId.Hex(), nil)
+			},
+		},
+		{
+			name: "Question is not in exam",
+			args: &args{
+				examId:        examId,
+				examAttemptId: examAttemptId,
+				answers: []model.ExamAttemptAnswer{
+					{QuestionId: questionId01.Hex(), Answer: "apple"},
+				},
+				userId: userId,
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"CreateExamRecord failed: %w",
+					fmt.Errorf("Invalid questionId: %s", questionId01.Hex()),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamAttemptById(mock.Anything, args.examAttemptId).
+					Return(newExamAttempt(args.userId, 0, questionId01.Hex()), nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Id:       id,
+						IsPublic: true,
+						UserId:   userId,
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindQuestionsByQuestionIds(mock.Anything, []string{questionId01.Hex()}).
+					Return([]model.Question{
+						{Id: questionId01, ExamId: "exam99", Answers: []string{"apple"}},
+					}, nil)
+			},
+		},
+		{
+			name: "Create examRecord of private exam assigned to student",
+			args: &args{
+				examId:        examId,
+				examAttemptId: examAttemptId,
+				answers: []model.ExamAttemptAnswer{
+					{QuestionId: questionId01.Hex(), Answer: "banana"},
+				},
+				userId: "student01",
+			},
+			expected: &result{
+				examRecord: &model.ExamRecord{
+					Id:               examRecordId,
+					ExamId:           examId,
+					QuestionIds:      []string{questionId01.Hex()},
+					WrongQuestionIds: []string{questionId01.Hex()},
+					Answers: []model.ExamAttemptAnswer{
+						{
+							QuestionId:   questionId01.Hex(),
+							Answer:       "banana",
+							BlankAnswers: []string{"banana"},
+						},
+					},
+					MaxPoints: 1,
+					UserId:    "student01",
+				},
+				questions: []model.Question{draftQuestion},
+				err:       nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				classId := primitive.NewObjectID()
+				s.mockDatabaseRepository.EXPECT().
+					GetExamAttemptById(mock.Anything, args.examAttemptId).
+					Return(newExamAttempt(args.userId, 0, questionId01.Hex()), nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Id:       id,
+						IsPublic: false,
+						UserId:   userId,
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc(mock.Anything, args.userId).
+					Return([]model.Class{
+						{
+							Id:     classId,
+							UserId: userId,
+							Students: []model.ClassStudent{
+								{UserId: args.userId},
+							},
+						},
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					CountAssignmentsByClassIdsAndExamId(
+						mock.Anything,
+						[]string{classId.Hex()},
+						args.examId,
+					).
+					Return(1, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindQuestionsByQuestionIds(mock.Anything, []string{questionId01.Hex()}).
+					Return([]model.Question{draftQuestion}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					WithTransaction(mock.Anything, mock.AnythingOfType("transactionFunc")).
+					Return(examRecordId.Hex(), nil)
+			},
+		},
+		{
+			name: "Create examRecord of private exam not assigned to student",
+			args: &args{
+				examId:        examId,
+				examAttemptId: examAttemptId,
+				answers:       answers,
+				userId:        "student01",
+			},
+			expected: &result{
+				err: fmt.Errorf("CreateExamRecord failed: %w", unauthorizedOperationError),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamAttemptById(mock.Anything, args.examAttemptId).
+					Return(newExamAttempt(args.userId, 0, questionId02.Hex(), questionId01.Hex()), nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Id:       id,
+						IsPublic: false,
+						UserId:   userId,
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc(mock.Anything, args.userId).
+					Return([]model.Class{}, nil)
+			},
+		},
+		{
+			name: "Create examRecord when max attempts reached",
+			args: &args{
+				examId:        examId,
+				examAttemptId: examAttemptId,
+				answers:       answers,
+				userId:        "user02",
+			},
+			expected: &result{
+				err: fmt.Errorf("CreateExamRecord failed: %w", maxAttemptsReachedError(2)),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamAttemptById(mock.Anything, args.examAttemptId).
+					Return(newExamAttempt(args.userId, 1, questionId02.Hex(), questionId01.Hex()), nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Id:            id,
+						IsPublic:      true,
+						UserId:        userId,
+						LatestVersion: 1,
+						Settings: &model.ExamSettings{
+							MaxAttempts: 2,
+						},
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamVersionByExamIdAndVersion(mock.Anything, args.examId, int32(1)).
+					Return(examVersion, nil)
+
+				// 作答次數在交易中以計數器檢查
+				s.mockDatabaseRepository.EXPECT().
+					WithTransaction(mock.Anything, mock.AnythingOfType("transactionFunc")).
+					Return(nil, maxAttemptsReachedError(2))
+			},
+		},
+		{
+			name: "Create examRecord without answers of some questions",
+			args: &args{
+				examId:        examId,
+				examAttemptId: examAttemptId,
+				answers: []model.ExamAttemptAnswer{
+					{QuestionId: questionId01.Hex(), Answer: "apple", TimeSpentSeconds: 10},
+				},
+				userId: "user02",
+			},
+			expected: &result{
+				// 沒有作答的題目以 0 分計入滿分
+				examRecord: &model.ExamRecord{
+					Id:               examRecordId,
+					ExamId:           examId,
+					ExamVersion:      1,
+					Score:            1,
+					QuestionIds:      []string{questionId01.Hex(), questionId02.Hex()},
+					WrongQuestionIds: []string{questionId02.Hex()},
+					Answers: []model.ExamAttemptAnswer{
+						{
+							QuestionId:       questionId01.Hex(),
+							Answer:           "apple",
+							BlankAnswers:     []string{"apple"},
+							IsCorrect:        true,
+							TimeSpentSeconds: 10,
+							Credit:           1,
+						},
+						{
+							QuestionId:   questionId02.Hex(),
+							Answer:       ", ",
+							BlankAnswers: []string{"", ""},
+						},
+					},
+					EarnedPoints: 2,
+					MaxPoints:    3,
+					Percentage:   66.67,
+					UserId:       "user02",
+				},
+				questions: examVersionQuestions(examVersion),
+				err:       nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamAttemptById(mock.Anything, args.examAttemptId).
+					Return(newExamAttempt(args.userId, 1, questionId01.Hex(), questionId02.Hex()), nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Id:            id,
+						IsPublic:      true,
+						UserId:        userId,
+						LatestVersion: 1,
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamVersionByExamIdAndVersion(mock.Anything, args.examId, int32(1)).
+					Return(examVersion, nil)
+				s.mockDatabaseRepository.EXPECT().
+					WithTransaction(mock.Anything, mock.AnythingOfType("transactionFunc")).
+					Return(examRecordId.Hex(), nil)
+			},
+		},
+		{
+			name: "Create examRecord with hints revealed in exam attempt",
+			args: &args{
+				examId:        examId,
+				examAttemptId: examAttemptId,
+				answers: []model.ExamAttemptAnswer{
+					{QuestionId: questionId01.Hex(), Answer: "apple", HintUsed: true},
+					{QuestionId: questionId02.Hex(), BlankAnswers: []string{"red", "blue"}},
+				},
+				userId: "user02",
+			},
+			expected: &result{
+				// 是否查看提示以作答中記錄的題目為準，不使用用戶端傳送的 hintUsed
+				examRecord: &model.ExamRecord{
+					Id:               examRecordId,
+					ExamId:           examId,
+					ExamVersion:      1,
+					Score:            2,
+					QuestionIds:      []string{questionId01.Hex(), questionId02.Hex()},
+					WrongQuestionIds: []string{},
+					Answers: []model.ExamAttemptAnswer{
+						{
+							QuestionId:   questionId01.Hex(),
+							Answer:       "apple",
+							BlankAnswers: []string{"apple"},
+							IsCorrect:    true,
+							Credit:       1,
+						},
+						{
+							QuestionId:   questionId02.Hex(),
+							Answer:       "red, blue",
+							BlankAnswers: []string{"red", "blue"},
+							IsCorrect:    true,
+							Credit:       0.5,
+							HintUsed:     true,
+						},
+					},
+					EarnedPoints: 2.5,
+					MaxPoints:    3,
+					Percentage:   83.33,
+					UserId:       "user02",
+				},
+				questions: examVersionQuestions(examVersion),
+				err:       nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				examAttempt := newExamAttempt(args.userId, 1, questionId01.Hex(), questionId02.Hex())
+				examAttempt.HintQuestionIds = []string{questionId02.Hex()}
+				s.mockDatabaseRepository.EXPECT().
+					GetExamAttemptById(mock.Anything, args.examAttemptId).
+					Return(examAttempt, nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Id:            id,
+						IsPublic:      true,
+						UserId:        userId,
+						LatestVersion: 1,
+						Settings: &model.ExamSettings{
+							ShowAnswersAfterSubmit: true,
+							HintPenaltyPercent:     50,
+						},
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamVersionByExamIdAndVersion(mock.Anything, args.examId, int32(1)).
+					Return(examVersion, nil)
+				s.mockDatabaseRepository.EXPECT().
+					WithTransaction(mock.Anything, mock.AnythingOfType("transactionFunc")).
+					Return(examRecordId.Hex(), nil)
+			},
+		},
+		{
+			name: "Create examRecord with answer of question not in exam attempt",
+			args: &args{
+				examId:        examId,
+				examAttemptId: examAttemptId,
+				answers:       answers,
+				userId:        userId,
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"CreateExamRecord failed: %w",
+					fmt.Errorf("Invalid questionId: %s", questionId02.Hex()),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamAttemptById(mock.Anything, args.examAttemptId).
+					Return(newExamAttempt(args.userId, 1, questionId01.Hex()), nil)
+			},
+		},
+		{
+			name: "Create examRecord with exam attempt of other user",
+			args: &args{
+				examId:        examId,
+				examAttemptId: examAttemptId,
+				answers:       answers,
+				userId:        userId,
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"CreateExamRecord failed: %w",
+					fmt.Errorf("Exam attempt not found by id: %s", examAttemptId),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamAttemptById(mock.Anything, args.examAttemptId).
+					Return(newExamAttempt("user03", 1, questionId02.Hex(), questionId01.Hex()), nil)
+			},
+		},
+		{
+			name: "Create examRecord with submitted exam attempt",
+			args: &args{
+				examId:        examId,
+				examAttemptId: examAttemptId,
+				answers:       answers,
+				userId:        userId,
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"CreateExamRecord failed: %w",
+					examAttemptSubmittedError(examAttemptId),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				submittedAt := time.Now()
+				examAttempt := newExamAttempt(args.userId, 1, questionId02.Hex(), questionId01.Hex())
+				examAttempt.SubmittedAt = &submittedAt
+				s.mockDatabaseRepository.EXPECT().
+					GetExamAttemptById(mock.Anything, args.examAttemptId).
+					Return(examAttempt, nil)
+			},
+		},
+		{
+			name: "Invalid durationSeconds",
+			args: &args{
+				examId:          examId,
+				examAttemptId:   examAttemptId,
+				durationSeconds: -1,
+				answers:         answers,
+				userId:          userId,
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"CreateExamRecord failed: %w",
+					fmt.Errorf("Invalid durationSeconds: %d", -1),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+		{
+			name: "Answers is empty",
+			args: &args{
+				examId:        examId,
+				examAttemptId: examAttemptId,
+				userId:        userId,
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"CreateExamRecord failed: %w",
+					fmt.Errorf("Answers is empty"),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+		{
+			name: "Duplicate answer of question",
+			args: &args{
+				examId:        examId,
+				examAttemptId: examAttemptId,
+				answers: []model.ExamAttemptAnswer{
+					{QuestionId: "question01"},
+					{QuestionId: "question01"},
+				},
+				userId: userId,
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"CreateExamRecord failed: %w",
+					fmt.Errorf("Duplicate answer of question: %s", "question01"),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamAttemptById(mock.Anything, args.examAttemptId).
+					Return(newExamAttempt(args.userId, 0, "question01"), nil)
+			},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			examRecord, questions, err := s.examService.CreateExamRecord(
+				ctx,
+				args.examId,
+				args.examAttemptId,
+				args.durationSeconds,
+				args.answers,
+				args.userId,
+			)
+
+			expected := tc.expected
+			s.Equal(expected.examRecord, examRecord)
+			s.Equal(expected.questions, questions)
+			s.Equal(expected.err, err)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestFindExamRecords() {
+	type args struct {
+		pageIndex int32
+		pageSize  int32
+		examId    string
+		userId    string
+		cursor    string
+	}
+
+	type result struct {
+		total       int32
+		pageCount   int32
+		examRecords []model.ExamRecord
+		nextCursor  string
+		err         error
+	}
+
+	id := primitive.NewObjectID()
+	examId := id.Hex()
+	userId := "user01"
+	mockExamRecords := []model.ExamRecord{
+		{},
+		{},
+		{},
+	}
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Find examRecords by page1",
+			args: &args{
+				pageIndex: 0,
+				pageSize:  10,
+				examId:    examId,
+				userId:    userId,
+			},
+			expected: &result{
+				total:       3,
+				pageCount:   1,
+				examRecords: mockExamRecords,
+				err:         nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc(
+						mock.Anything,
+						args.examId,
+						args.userId,
+						args.pageIndex*args.pageSize,
+						args.pageSize,
+					).
+					Return(mockExamRecords, nil)
+				s.mockDatabaseRepository.EXPECT().
+					CountExamRecordsByExamIdAndUserId(mock.Anything, args.examId, args.userId).
+					Return(int32(3), nil)
+			},
+		},
+		{
+			name: "Find examRecords by page2",
+			args: &args{
+				pageIndex: 1,
+				pageSize:  10,
+				examId:    examId,
+				userId:    userId,
+			},
+			expected: &result{
+				total:       13,
+				pageCount:   2,
+				examRecords: mockExamRecords,
+				err:         nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc(
+						mock.Anything,
+						args.examId,
+						args.userId,
+						args.pageIndex*args.pageSize,
+						args.pageSize,
+					).
+					Return(mockExamRecords, nil)
+				s.mockDatabaseRepository.EXPECT().
+					CountExamRecordsByExamIdAndUserId(mock.Anything, args.examId, args.userId).
+					Return(int32(13), nil)
+			},
+		},
+		{
+			name: "Find examRecords by page1 when has next page",
+			args: &args{
+				pageIndex: 0,
+				pageSize:  3,
+				examId:    examId,
+				userId:    userId,
+			},
+			expected: &result{
+				total:       13,
+				pageCount:   5,
+				examRecords: mockExamRecords,
+				nextCursor:  repository.EncodeCursor(time.Time{}, primitive.NilObjectID),
+				err:         nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc(
+						mock.Anything,
+						args.examId,
+						args.userId,
+						args.pageIndex*args.pageSize,
+						args.pageSize,
+					).
+					Return(mockExamRecords, nil)
+				s.mockDatabaseRepository.EXPECT().
+					CountExamRecordsByExamIdAndUserId(mock.Anything, args.examId, args.userId).
+					Return(int32(13), nil)
+			},
+		},
+		{
+			name: "Find examRecords by cursor",
+			args: &args{
+				pageSize: 10,
+				examId:   examId,
+				userId:   userId,
+				cursor:   "cursor01",
+			},
+			expected: &result{
+				total:       0,
+				pageCount:   0,
+				examRecords: mockExamRecords,
+				nextCursor:  "nextCursor01",
+				err:         nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					FindExamRecordsByExamIdAndUserIdAndCursorOrderByUpdateAtDesc(
+						mock.Anything,
+						args.examId,
+						args.userId,
+						args.cursor,
+						args.pageSize,
+					).
+					Return(mockExamRecords, "nextCursor01", nil)
+			},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			total, pageCount, examRecords, nextCursor, err := s.examService.FindExamRecords(
+				ctx,
+				args.pageIndex,
+				args.pageSize,
+				args.examId,
+				args.userId,
+				args.cursor,
+			)
+
+			expected := tc.expected
+			s.Equal(expected.total, total)
+			s.Equal(expected.pageCount, pageCount)
+			s.Equal(expected.examRecords, examRecords)
+			s.Equal(expected.nextCursor, nextCursor)
+			s.Equal(expected.err, err)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestFindExamRecordOverview() {
+	type args struct {
+		examId              string
+		userId              string
+		startDate           time.Time
+		endDate             time.Time
+		version             int32
+		dateRange           string
+		timezone            string
+		movingAverageWindow int32
+	}
+
+	type result struct {
+		strStartDate         string
+		exam                 *model.Exam
+		examVersion          int32
+		examVersionSummaries []repository.ExamVersionSummary
+		questions            []model.Question
+		answerWrongs         []model.AnswerWrong
+		examRecords          []model.ExamRecord
+		err                  error
+	}
+
+	userId := "user01"
+
+	examObjectId := primitive.NewObjectID()
+	examId := examObjectId.Hex()
+	mockExam := &model.Exam{Id: examObjectId, UserId: userId}
+	// 其他測驗的題目不會被查出
+	mockQuestions := []model.Question{{ExamId: examId}}
+	mockWrongAnswers := []model.AnswerWrong{
+		{
+			ExamId:     examId,
+			QuestionId: "q01",
+			Times:      1,
+			UserId:     userId,
+		},
+		{
+			ExamId:     examId,
+			QuestionId: "q02",
+			Times:      2,
+			UserId:     userId,
+		},
+	}
+	mockExamRecords := []model.ExamRecord{{MaxPoints: 1}}
+	startDate := time.Now()
+
+	examId02 := "exam02"
+	mockExam02 := &model.Exam{UserId: userId}
+	mockQuestions02 := []model.Question{}
+	mockWrongAnswers02 := []model.AnswerWrong{}
+	mockExamRecords02 := []model.ExamRecord{{MaxPoints: 1}, {MaxPoints: 1}}
+	startDate02 := time.Now()
+
+	// 已發佈兩個版本的測驗，查看第 1 版時使用當時的題目快照，
+	// 測驗設定交卷後不能查看答案，所以不回傳題目的答案
+	examId03 := "exam03"
+	questionId01 := primitive.NewObjectID()
+	questionId02 := primitive.NewObjectID()
+	mockExam03 := &model.Exam{
+		IsPublic:      true,
+		LatestVersion: 2,
+		Settings: &model.ExamSettings{
+			ShowAnswersAfterSubmit: false,
+		},
+		UserId: "user02",
+	}
+	mockExamVersion03 := &model.ExamVersion{
+		ExamId:  examId03,
+		Version: 1,
+		Questions: []model.ExamVersionQuestion{
+			{
+				QuestionId: questionId01.Hex(),
+				Ask:        "ask01",
+				Answers:    []string{"a01"},
+			},
+			{
+				QuestionId: questionId02.Hex(),
+				Ask:        "ask02",
+				Answers:    []string{"a02"},
+			},
+		},
+		UserId: "user02",
+	}
+	mockExamVersionSummaries03 := []repository.ExamVersionSummary{
+		{
+			Version:       2,
+			QuestionCount: 3,
+		},
+		{
+			Version:       1,
+			QuestionCount: 2,
+		},
+	}
+	mockWrongAnswers03 := []model.AnswerWrong{
+		{
+			ExamId:      examId03,
+			ExamVersion: 1,
+			QuestionId:  questionId02.Hex(),
+			Times:       3,
+			UserId:      userId,
+		},
+	}
+	mockExamRecords03 := []model.ExamRecord{
+		{
+			ExamVersion: 1,
+			MaxPoints:   1,
+		},
+		{
+			ExamVersion: 2,
+			MaxPoints:   1,
+		},
+	}
+	startDate03 := time.Now()
+
+	// 沒有分享或指派給使用者的不公開測驗
+	examId04 := "exam04"
+	mockExam04 := &model.Exam{UserId: "user02"}
+
+	taipei, err := time.LoadLocation("Asia/Taipei")
+	s.Nil(err)
+	today := startOfDay(time.Now().In(taipei), taipei)
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Find exam01 examRecordOverview",
+			args: &args{
+				examId:    examId,
+				userId:    userId,
+				startDate: startDate,
+			},
+			expected: &result{
+				strStartDate:         startDate.UTC().Format("2006/01/02"),
+				exam:                 mockExam,
+				examVersion:          0,
+				examVersionSummaries: []repository.ExamVersionSummary{},
+				questions:            mockQuestions,
+				answerWrongs:         mockWrongAnswers,
+				examRecords:          mockExamRecords,
+				err:                  nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(
+						mock.Anything,
+						args.examId,
+					).
+					Return(mockExam, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindExamVersionSummariesByExamIdOrderByVersionDesc(mock.Anything, args.examId).
+					Return([]repository.ExamVersionSummary{}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc(
+						mock.Anything,
+						args.examId,
+						int32(0),
+						args.userId,
+						int32(10)).
+					Return(mockWrongAnswers, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindQuestionsByQuestionIds(mock.Anything, []string{"q01", "q02"}).
+					Return(append(mockQuestions, model.Question{ExamId: "exam99"}), nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween(
+						mock.Anything,
+						args.examId,
+						args.userId,
+						startOfDay(args.startDate.UTC(), time.UTC),
+						mock.Anything,
+					).
+					Return(mockExamRecords, nil)
+			},
+		},
+		{
+			name: "Find exam02 examRecordOverview",
+			args: &args{
+				examId:    examId02,
+				userId:    userId,
+				startDate: startDate02,
+			},
+			expected: &result{
+				strStartDate:         startDate02.UTC().Format("2006/01/02"),
+				exam:                 mockExam02,
+				examVersion:          0,
+				examVersionSummaries: []repository.ExamVersionSummary{},
+				questions:            mockQuestions02,
+				answerWrongs:         mockWrongAnswers02,
+				examRecords:          mockExamRecords02,
+				err:                  nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(
+						mock.Anything,
+						args.examId,
+					).
+					Return(mockExam02, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindExamVersionSummariesByExamIdOrderByVersionDesc(mock.Anything, args.examId).
+					Return([]repository.ExamVersionSummary{}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc(
+						mock.Anything,
+						args.examId,
+						int32(0),
+						args.userId,
+						int32(10)).
+					Return(mockWrongAnswers02, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween(
+						mock.Anything,
+						args.examId,
+						args.userId,
+						startOfDay(args.startDate.UTC(), time.UTC),
+						mock.Anything,
+					).
+					Return(mockExamRecords02, nil)
+			},
+		},
+		{
+			name: "Find exam03 examRecordOverview of version 1",
+			args: &args{
+				examId:    examId03,
+				userId:    userId,
+				startDate: startDate03,
+				version:   1,
+			},
+			expected: &result{
+				strStartDate:         startDate03.UTC().Format("2006/01/02"),
+				exam:                 mockExam03,
+				examVersion:          1,
+				examVersionSummaries: mockExamVersionSummaries03,
+				questions: []model.Question{
+					{
+						Id:          questionId02,
+						ExamId:      examId03,
+						Ask:         "ask02",
+						Answers:     []string{},
+						AnswerCount: 1,
+						UserId:      "user02",
+					},
+				},
+				answerWrongs: mockWrongAnswers03,
+				examRecords:  mockExamRecords03,
+				err:          nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(mockExam03, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindExamVersionSummariesByExamIdOrderByVersionDesc(mock.Anything, args.examId).
+					Return(mockExamVersionSummaries03, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc(
+						mock.Anything,
+						args.examId,
+						int32(1),
+						args.userId,
+						int32(10)).
+					Return(mockWrongAnswers03, nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamVersionByExamIdAndVersion(mock.Anything, args.examId, int32(1)).
+					Return(mockExamVersion03, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween(
+						mock.Anything,
+						args.examId,
+						args.userId,
+						startOfDay(args.startDate.UTC(), time.UTC),
+						mock.Anything,
+					).
+					Return(mockExamRecords03, nil)
+			},
+		},
+		{
+			name: "Find exam03 examRecordOverview of version not published",
+			args: &args{
+				examId:    examId03,
+				userId:    userId,
+				startDate: startDate03,
+				version:   3,
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"FindExamRecordOverview failed: %w",
+					fmt.Errorf("Invalid examVersion: %d", 3),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(mockExam03, nil)
+			},
+		},
+		{
+			name: "Find examRecordOverview of last 7 days in user timezone",
+			args: &args{
+				examId:    examId,
+				userId:    userId,
+				dateRange: OVERVIEW_RANGE_7_DAYS,
+				timezone:  "Asia/Taipei",
+			},
+			expected: &result{
+				strStartDate:         today.AddDate(0, 0, -6).Format("2006/01/02"),
+				exam:                 mockExam,
+				examVersion:          0,
+				examVersionSummaries: []repository.ExamVersionSummary{},
+				questions:            []model.Question{},
+				answerWrongs:         []model.AnswerWrong{},
+				examRecords:          mockExamRecords,
+				err:                  nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(mockExam, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindExamVersionSummariesByExamIdOrderByVersionDesc(mock.Anything, args.examId).
+					Return([]repository.ExamVersionSummary{}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindAnswerWrongsByExamIdAndExamVersionAndUserIdOrderByTimesDesc(
+						mock.Anything,
+						args.examId,
+						int32(0),
+						args.userId,
+						int32(10)).
+					Return([]model.AnswerWrong{}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindExamRecordsByExamIdAndUserIdAndCreatedAtBetween(
+						mock.Anything,
+						args.examId,
+						args.userId,
+						today.AddDate(0, 0, -6),
+						today.AddDate(0, 0, 1),
+					).
+					Return(mockExamRecords, nil)
+			},
+		},
+		{
+			name: "Find examRecordOverview of exam not shared or assigned",
+			args: &args{
+				examId:    examId04,
+				userId:    userId,
+				startDate: startDate,
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"FindExamRecordOverview failed: %w",
+					unauthorizedOperationError,
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(mockExam04, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc(mock.Anything, args.userId).
+					Return([]model.Class{}, nil)
+			},
+		},
+		{
+			name: "Find examRecordOverview with invalid timezone",
+			args: &args{
+				examId:   examId,
+				userId:   userId,
+				timezone: "Mars/Olympus",
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"FindExamRecordOverview failed: %w",
+					fmt.Errorf("Invalid timezone: %s", "Mars/Olympus"),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+		{
+			name: "Find examRecordOverview with invalid range",
+			args: &args{
+				examId:    examId,
+				userId:    userId,
+				dateRange: "1y",
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"FindExamRecordOverview failed: %w",
+					fmt.Errorf("Invalid range: %s", "1y"),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+		{
+			name: "Find examRecordOverview with invalid movingAverageWindow",
+			args: &args{
+				examId:              examId,
+				userId:              userId,
+				movingAverageWindow: 31,
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"FindExamRecordOverview failed: %w",
+					fmt.Errorf("Invalid movingAverageWindow: %d", 31),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			strStartDate,
+				exam,
+				examVersion,
+				examVersionSummaries,
+				questions,
+				answerWrongs,
+				examRecords,
+				analytics,
+				err := s.examService.FindExamRecordOverview(
+				ctx,
+				args.examId,
+				args.userId,
+				args.startDate,
+				args.endDate,
+				args.version,
+				args.dateRange,
+				args.timezone,
+				args.movingAverageWindow,
+			)
+
+			expected := tc.expected
+			s.Equal(expected.strStartDate, strStartDate)
+			s.Equal(expected.exam, exam)
+			s.Equal(expected.examVersion, examVersion)
+			s.Equal(expected.examVersionSummaries, examVersionSummaries)
+			s.Equal(expected.questions, questions)
+			s.Equal(expected.answerWrongs, answerWrongs)
+			s.Equal(expected.examRecords, examRecords)
+			s.Equal(int32(len(expected.examRecords)), analytics.RecordCount)
+			s.Equal(expected.err, err)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestOverviewPeriod() {
+	taipei, err := time.LoadLocation("Asia/Taipei")
+	s.Nil(err)
+
+	// 台北時間 2026-10-21 01:30，UTC 仍是 2026-10-20
+	now := time.Date(2026, 10, 20, 17, 30, 0, 0, time.UTC)
+
+	startAt, endAt, err := overviewPeriod(OVERVIEW_RANGE_7_DAYS, time.Time{}, time.Time{}, now, taipei)
+	s.Nil(err)
+	s.Equal(time.Date(2026, 10, 15, 0, 0, 0, 0, taipei), startAt)
+	s.Equal(time.Date(2026, 10, 22, 0, 0, 0, 0, taipei), endAt)
+
+	// 沒有指定期間也沒有開始日期時為最近 30 天
+	startAt, endAt, err = overviewPeriod("", time.Time{}, time.Time{}, now, time.UTC)
+	s.Nil(err)
+	s.Equal(time.Date(2026, 9, 21, 0, 0, 0, 0, time.UTC), startAt)
+	s.Equal(time.Date(2026, 10, 21, 0, 0, 0, 0, time.UTC), endAt)
+
+	startAt, endAt, err = overviewPeriod(
+		OVERVIEW_RANGE_CUSTOM,
+		time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 10, 0, 0, 0, 0, time.UTC),
+		now,
+		taipei,
+	)
+	s.Nil(err)
+	s.Equal(time.Date(2026, 10, 1, 0, 0, 0, 0, taipei), startAt)
+	s.Equal(time.Date(2026, 10, 11, 0, 0, 0, 0, taipei), endAt)
+
+	_, _, err = overviewPeriod(
+		OVERVIEW_RANGE_CUSTOM,
+		time.Date(2026, 10, 10, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		now,
+		taipei,
+	)
+	s.Equal(fmt.Errorf("Invalid endDate: %s", "2026-10-01"), err)
+
+	_, _, err = overviewPeriod(
+		OVERVIEW_RANGE_CUSTOM,
+		time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC),
+		time.Time{},
+		now,
+		taipei,
+	)
+	s.Equal(fmt.Errorf("Invalid range: more than %d days", maxOverviewDays), err)
+
+	_, _, err = overviewPeriod(OVERVIEW_RANGE_CUSTOM, time.Time{}, time.Time{}, now, taipei)
+	s.Equal(fmt.Errorf("Invalid startDate: %s", "empty"), err)
+}
+
+func (s *MyTestSuite) TestNewExamRecordAnalytics() {
+	taipei, err := time.LoadLocation("Asia/Taipei")
+	s.Nil(err)
+
+	startAt := time.Date(2026, 10, 1, 0, 0, 0, 0, taipei)
+	endAt := time.Date(2026, 10, 6, 0, 0, 0, 0, taipei)
+	examRecords := []model.ExamRecord{
+		{
+			Score:      4,
+			MaxPoints:  100,
+			Percentage: 4,
+			CreatedAt:  time.Date(2026, 10, 1, 21, 0, 0, 0, taipei),
+		},
+		// UTC 是 10/1，台北時間是 10/2
+		{
+			Score:      6,
+			MaxPoints:  100,
+			Percentage: 6,
+			CreatedAt:  time.Date(2026, 10, 1, 16, 30, 0, 0, time.UTC),
+		},
+		{
+			Score:      8,
+			MaxPoints:  100,
+			Percentage: 8,
+			CreatedAt:  time.Date(2026, 10, 4, 21, 0, 0, 0, taipei),
+		},
+		{
+			Score:      10,
+			MaxPoints:  100,
+			Percentage: 10,
+			CreatedAt:  time.Date(2026, 10, 5, 21, 0, 0, 0, taipei),
+		},
+		// 無法換算成百分比的舊作答紀錄不列入分析
+		{
+			Score:     100,
+			CreatedAt: time.Date(2026, 10, 3, 21, 0, 0, 0, taipei),
+		},
+	}
+
+	analytics := newExamRecordAnalytics(examRecords, startAt, endAt, taipei, 2)
+
+	s.Equal("Asia/Taipei", analytics.Timezone)
+	s.Equal("2026-10-01", analytics.StartDate)
+	s.Equal("2026-10-05", analytics.EndDate)
+	s.Equal(int32(4), analytics.RecordCount)
+	s.Equal(7.0, analytics.AverageScore)
+	s.Equal(10.0, analytics.BestScore)
+	s.Equal(examRecords[3].CreatedAt, analytics.BestScoreAt)
+	s.Equal(4.0, analytics.WorstScore)
+	s.Equal(examRecords[0].CreatedAt, analytics.WorstScoreAt)
+	s.Equal(TREND_IMPROVING, analytics.Trend)
+	s.Greater(analytics.TrendSlope, 0.0)
+	s.Equal(int32(2), analytics.CurrentStreakDays)
+	s.Equal(int32(2), analytics.LongestStreakDays)
+	s.Equal([]DailyExamRecordStat{
+		{
+			Date:          "2026-10-01",
+			RecordCount:   1,
+			AverageScore:  4,
+			BestScore:     4,
+			WorstScore:    4,
+			MovingAverage: 4,
+		},
+		{
+			Date:          "2026-10-02",
+			RecordCount:   1,
+			AverageScore:  6,
+			BestScore:     6,
+			WorstScore:    6,
+			MovingAverage: 5,
+		},
+		{
+			Date:          "2026-10-03",
+			MovingAverage: 6,
+		},
+		{
+			Date:          "2026-10-04",
+			RecordCount:   1,
+			AverageScore:  8,
+			BestScore:     8,
+			WorstScore:    8,
+			MovingAverage: 8,
+		},
+		{
+			Date:          "2026-10-05",
+			RecordCount:   1,
+			AverageScore:  10,
+			BestScore:     10,
+			WorstScore:    10,
+			MovingAverage: 9,
+		},
+	}, analytics.DailyStats)
+	s.Len(analytics.HourOfDayStats, 24)
+	s.Equal(HourOfDayStat{Hour: 21, RecordCount: 3, AverageScore: 22.0 / 3}, analytics.HourOfDayStats[21])
+	s.Equal(HourOfDayStat{Hour: 0, RecordCount: 1, AverageScore: 6}, analytics.HourOfDayStats[0])
+
+	// 分數沒有明顯變化
+	analytics = newExamRecordAnalytics([]model.ExamRecord{
+		{
+			Score:      5,
+			MaxPoints:  100,
+			Percentage: 5,
+			CreatedAt:  time.Date(2026, 10, 1, 9, 0, 0, 0, taipei),
+		},
+		{
+			Score:      5,
+			MaxPoints:  100,
+			Percentage: 5,
+			CreatedAt:  time.Date(2026, 10, 3, 9, 0, 0, 0, taipei),
+		},
+	}, startAt, endAt, taipei, 7)
+	s.Equal(TREND_STABLE, analytics.Trend)
+	s.Equal(int32(0), analytics.CurrentStreakDays)
+	s.Equal(int32(1), analytics.LongestStreakDays)
+
+	// 只有一天的作答紀錄無法判斷趨勢
+	analytics = newExamRecordAnalytics(examRecords[:1], startAt, endAt, taipei, 7)
+	s.Equal(TREND_INSUFFICIENT_DATA, analytics.Trend)
+}
+
+func (s *MyTestSuite) TestFindExamInfos() {
+	type args struct {
+		userId   string
+		isPublic bool
+		tags     []string
+	}
+
+	type result struct {
+		examInfos []ExamInfo
+		err       error
+	}
+
+	userId := "user01"
+
+	id01 := primitive.NewObjectID()
+	examId01 := id01.Hex()
+
+	id02 := primitive.NewObjectID()
+	examId02 := id02.Hex()
+
+	var (
+		questionCount1 int32 = 10
+		questionCount2 int32 = 20
+		recordCount1   int32 = 1
+		recordCount2   int32 = 2
+	)
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Find examInfos by isPublic true",
+			args: &args{
+				userId:   userId,
+				isPublic: true,
+			},
+			expected: &result{
+				examInfos: []ExamInfo{
+					{
+						ExamId:        examId01,
+						Topic:         "topic01",
+						Description:   "desc01",
+						Tags:          []string{"a01"},
+						IsPublic:      true,
+						QuestionCount: questionCount1,
+						RecordCount:   recordCount1,
+					},
+					{
+						ExamId:        examId02,
+						Topic:         "topic02",
+						Description:   "desc02",
+						Tags:          []string{"a02"},
+						IsPublic:      true,
+						QuestionCount: questionCount2,
+						RecordCount:   recordCount2,
+					},
+				},
+				err: nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc(
+						mock.Anything, args.userId, args.isPublic, []string{}).
+					Return([]repository.ExamSummary{
+						{
+							Exam: model.Exam{
+								Id:          id01,
+								Topic:       "topic01",
+								Description: "desc01",
+								Tags:        []string{"a01"},
+								IsPublic:    args.isPublic,
+								UserId:      args.userId,
+							},
+							QuestionCount: questionCount1,
+							RecordCount:   recordCount1,
+						},
+						{
+							Exam: model.Exam{
+								Id:          id02,
+								Topic:       "topic02",
+								Description: "desc02",
+								Tags:        []string{"a02"},
+								IsPublic:    args.isPublic,
+								UserId:      args.userId,
+							},
+							QuestionCount: questionCount2,
+							RecordCount:   recordCount2,
+						},
+					}, nil)
+			},
+		},
+		{
+			name: "Find examInfos by isPublic false",
+			args: &args{
+				userId:   userId,
+				isPublic: false,
+				tags:     []string{" A01 "},
+			},
+			expected: &result{
+				examInfos: []ExamInfo{
+					{
+						ExamId:        examId01,
+						Topic:         "topic01",
+						Description:   "desc01",
+						Tags:          []string{"a01"},
+						IsPublic:      false,
+						QuestionCount: questionCount1,
+						RecordCount:   recordCount1,
+					},
+				},
+				err: nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					FindExamSummariesByUserIdAndIsPublicAndTagsOrderByUpdateAtDesc(
+						mock.Anything, args.userId, args.isPublic, []string{"a01"}).
+					Return([]repository.ExamSummary{
+						{
+							Exam: model.Exam{
+								Id:          id01,
+								Topic:       "topic01",
+								Description: "desc01",
+								Tags:        []string{"a01"},
+								IsPublic:    args.isPublic,
+								UserId:      args.userId,
+							},
+							QuestionCount: questionCount1,
+							RecordCount:   recordCount1,
+						},
+					}, nil)
+			},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			examInfos, err := s.examService.FindExamInfos(
+				ctx,
+				args.userId,
+				args.isPublic,
+				args.tags,
+			)
+
+			expected := tc.expected
+			s.Equal(expected.examInfos, examInfos)
+			s.Equal(expected.err, err)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestFindExamCatalog() {
+	type args struct {
+		keyword   string
+		tags      []string
+		sortBy    string
+		pageIndex int32
+		pageSize  int32
+	}
+
+	type result struct {
+		total         int32
+		pageCount     int32
+		examSummaries []repository.ExamSummary
+		err           error
+	}
+
+	examSummaries := []repository.ExamSummary{
+		{
+			Exam: model.Exam{
+				Id:       primitive.NewObjectID(),
+				Topic:    "topic01",
+				Tags:     []string{"toeic"},
+				IsPublic: true,
+				UserId:   "user02",
+			},
+			QuestionCount:     10,
+			RecordCount:       5,
+			AveragePercentage: 75,
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Find exam catalog with default sort",
+			args: &args{
+				keyword:   " topic ",
+				tags:      []string{"TOEIC"},
+				sortBy:    "",
+				pageIndex: 0,
+				pageSize:  10,
+			},
+			expected: &result{
+				total:         11,
+				pageCount:     2,
+				examSummaries: examSummaries,
+				err:           nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					FindPublicExamSummaries(
+						mock.Anything,
+						"topic",
+						[]string{"toeic"},
+						repository.EXAM_CATALOG_SORT_NEWEST,
+						int32(0),
+						int32(10),
+					).
+					Return(11, examSummaries, nil)
+			},
+		},
+		{
+			name: "Find exam catalog sorted by most attempted",
+			args: &args{
+				sortBy:    repository.EXAM_CATALOG_SORT_MOST_ATTEMPTED,
+				pageIndex: 1,
+				pageSize:  10,
+			},
+			expected: &result{
+				total:         11,
+				pageCount:     2,
+				examSummaries: examSummaries,
+				err:           nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					FindPublicExamSummaries(
+						mock.Anything,
+						"",
+						[]string{},
+						repository.EXAM_CATALOG_SORT_MOST_ATTEMPTED,
+						int32(10),
+						int32(10),
+					).
+					Return(11, examSummaries, nil)
+			},
+		},
+		{
+			name: "Invalid sortBy",
+			args: &args{
+				sortBy:    "oldest",
+				pageIndex: 0,
+				pageSize:  10,
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"FindExamCatalog failed: %w",
+					fmt.Errorf("Invalid sortBy: oldest"),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+		{
+			name: "Invalid pageSize",
+			args: &args{
+				pageIndex: 0,
+				pageSize:  0,
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"FindExamCatalog failed: %w",
+					fmt.Errorf("Invalid pageIndex: 0 or pageSize: 0"),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			total, pageCount, examSummaries, err := s.examService.FindExamCatalog(
+				ctx,
+				args.keyword,
+				args.tags,
+				args.sortBy,
+				args.pageIndex,
+				args.pageSize,
+			)
+
+			expected := tc.expected
+			s.Equal(expected.total, total)
+			s.Equal(expected.pageCount, pageCount)
+			s.Equal(expected.examSummaries, examSummaries)
+			s.Equal(expected.err, err)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestFindExamsByTags() {
+	type args struct {
+		tags      []string
+		userId    string
+		pageIndex int32
+		pageSize  int32
+	}
+
+	type result struct {
+		total     int32
+		pageCount int32
+		exams     []model.Exam
+		err       error
+	}
+
+	exams := []model.Exam{
+		{
+			Id:       primitive.NewObjectID(),
+			Topic:    "topic01",
+			Tags:     []string{"toeic", "grammar"},
+			IsPublic: true,
+			UserId:   "user02",
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Find exams by tags",
+			args: &args{
+				tags:      []string{"TOEIC", " grammar "},
+				userId:    "user01",
+				pageIndex: 0,
+				pageSize:  10,
+			},
+			expected: &result{
+				total:     11,
+				pageCount: 2,
+				exams:     exams,
+				err:       nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				tags := []string{"toeic", "grammar"}
+				s.mockDatabaseRepository.EXPECT().
+					FindExamsByTagsOrderByUpdateAtDesc(
+						mock.Anything, tags, args.userId, int32(0), args.pageSize).
+					Return(exams, nil)
+				s.mockDatabaseRepository.EXPECT().
+					CountExamsByTags(mock.Anything, tags, args.userId).
+					Return(11, nil)
+			},
+		},
+		{
+			name: "Find exams by empty tags",
+			args: &args{
+				tags:      []string{" "},
+				userId:    "user01",
+				pageIndex: 0,
+				pageSize:  10,
+			},
+			expected: &result{
+				err: fmt.Errorf("FindExamsByTags failed: %w", fmt.Errorf("Tags is empty")),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			total, pageCount, exams, err := s.examService.FindExamsByTags(
+				ctx,
+				args.tags,
+				args.userId,
+				args.pageIndex,
+				args.pageSize,
+			)
+
+			expected := tc.expected
+			s.Equal(expected.total, total)
+			s.Equal(expected.pageCount, pageCount)
+			s.Equal(expected.exams, exams)
+			s.Equal(expected.err, err)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestForkExam() {
+	type args struct {
+		examId string
+		userId string
+	}
+
+	type result struct {
+		examId        string
+		questionCount int32
+		err           error
+	}
+
+	userId := "user01"
+	examObjectId := primitive.NewObjectID()
+	examId := examObjectId.Hex()
+	forkedExamId := primitive.NewObjectID().Hex()
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Fork public exam of other user",
+			args: &args{
+				examId: examId,
+				userId: userId,
+			},
+			expected: &result{
+				examId:        forkedExamId,
+				questionCount: 3,
+				err:           nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Id:            examObjectId,
+						Topic:         "topic01",
+						IsPublic:      true,
+						UserId:        "user02",
+						LatestVersion: 1,
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamVersionByExamIdAndVersion(mock.Anything, args.examId, int32(1)).
+					Return(&model.ExamVersion{
+						ExamId:  args.examId,
+						Version: 1,
+						Questions: []model.ExamVersionQuestion{
+							{QuestionId: primitive.NewObjectID().Hex(), Ask: "ask01"},
+							{QuestionId: primitive.NewObjectID().Hex(), Ask: "ask02"},
+							{QuestionId: primitive.NewObjectID().Hex(), Ask: "ask03"},
+						},
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					WithTransaction(mock.Anything, mock.AnythingOfType("transactionFunc")).
+					Return(forkResult{forkedExamId, 3}, nil)
+			},
+		},
+		{
+			name: "Fork unpublished exam with bank questions",
+			args: &args{
+				examId: examId,
+				userId: userId,
+			},
+			expected: &result{
+				examId:        forkedExamId,
+				questionCount: 3,
+				err:           nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				fixedQuestionId := primitive.NewObjectID()
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Id:       examObjectId,
+						Topic:    "topic01",
+						IsPublic: true,
+						UserId:   "user02",
+						Settings: &model.ExamSettings{ShowAnswersAfterSubmit: true},
+						BankSource: &model.ExamBankSource{
+							QuestionIds: []string{fixedQuestionId.Hex()},
+							Pools:       []model.ExamBankPool{{Tag: "grammar", Size: 1}},
+						},
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindQuestionsByExamId(mock.Anything, args.examId).
+					Return([]model.Question{
+						{Id: primitive.NewObjectID(), ExamId: args.examId, Ask: "ask01"},
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindBankQuestionsByIds(mock.Anything, []string{fixedQuestionId.Hex()}).
+					Return([]model.BankQuestion{
+						{Id: fixedQuestionId, Ask: "ask02", UserId: "user02"},
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc(
+						mock.Anything,
+						"user02",
+						"grammar",
+						mock.Anything,
+						mock.Anything,
+					).
+					Return([]model.BankQuestion{
+						{Id: primitive.NewObjectID(), Ask: "ask03", UserId: "user02"},
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindExamSectionsByExamIdOrderByOrderAsc(mock.Anything, args.examId).
+					Return([]model.ExamSection{}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					WithTransaction(mock.Anything, mock.AnythingOfType("transactionFunc")).
+					Return(forkResult{forkedExamId, 3}, nil)
+			},
+		},
+		{
+			name: "Exam not found",
+			args: &args{
+				examId: examId,
+				userId: userId,
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"ForkExam failed: %w",
+					fmt.Errorf("Exam not found by id: %s", examId),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(nil, nil)
+			},
+		},
+		{
+			name: "Fork private exam of other user",
+			args: &args{
+				examId: examId,
+				userId: userId,
+			},
+			expected: &result{
+				err: fmt.Errorf("ForkExam failed: %w", unauthorizedOperationError),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Topic:    "topic01",
+						IsPublic: false,
+						UserId:   "user02",
+					}, nil)
+			},
+		},
+		{
+			name: "Fork public exam without answers of other user",
+			args: &args{
+				examId: examId,
+				userId: userId,
+			},
+			expected: &result{
+				err: fmt.Errorf("ForkExam failed: %w", unauthorizedOperationError),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Topic:    "topic01",
+						IsPublic: true,
+						UserId:   "user02",
+						Settings: &model.ExamSettings{
+							ShowAnswersAfterSubmit: false,
+						},
+					}, nil)
+			},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			examId, questionCount, err := s.examService.ForkExam(ctx, args.examId, args.userId)
+
+			expected := tc.expected
+			s.Equal(expected.examId, examId)
+			s.Equal(expected.questionCount, questionCount)
+			s.Equal(expected.err, err)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestPublishExam() {
+	type args struct {
+		examId string
+		userId string
+	}
+
+	type result struct {
+		version       int32
+		questionCount int32
+		err           error
+	}
+
+	userId := "user01"
+	examId := primitive.NewObjectID().Hex()
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Publish exam",
+			args: &args{
+				examId: examId,
+				userId: userId,
+			},
+			expected: &result{
+				version:       2,
+				questionCount: 3,
+				err:           nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Topic:         "topic01",
+						UserId:        args.userId,
+						LatestVersion: 1,
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					WithTransaction(mock.Anything, mock.AnythingOfType("transactionFunc")).
+					Return(publishResult{2, 3}, nil)
+			},
+		},
+		{
+			name: "Exam not found",
+			args: &args{
+				examId: examId,
+				userId: userId,
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"PublishExam failed: %w",
+					fmt.Errorf("Exam not found by id: %s", examId),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(nil, nil)
+			},
+		},
+		{
+			name: "Publish exam of other user",
+			args: &args{
+				examId: examId,
+				userId: userId,
+			},
+			expected: &result{
+				err: fmt.Errorf("PublishExam failed: %w", unauthorizedOperationError),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Topic:    "topic01",
+						IsPublic: true,
+						UserId:   "user02",
+					}, nil)
+			},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			version, questionCount, err := s.examService.PublishExam(ctx, args.examId, args.userId)
+
+			expected := tc.expected
+			s.Equal(expected.version, version)
+			s.Equal(expected.questionCount, questionCount)
+			s.Equal(expected.err, err)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestExamRole() {
+	exam := &model.Exam{
+		UserId: "owner01",
+		Shares: []model.ExamShare{
+			{
+				UserId: "editor01",
+				Role:   EXAM_ROLE_EDITOR,
+			},
+			{
+				UserId: "taker01",
+				Role:   EXAM_ROLE_TAKER,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		isPublic        bool
+		userId          string
+		expectedRole    string
+		expectedCanEdit bool
+		expectedCanTake bool
+	}{
+		{
+			name:            "Owner",
+			userId:          "owner01",
+			expectedRole:    EXAM_ROLE_OWNER,
+			expectedCanEdit: true,
+			expectedCanTake: true,
+		},
+		{
+			name:            "Editor",
+			userId:          "editor01",
+			expectedRole:    EXAM_ROLE_EDITOR,
+			expectedCanEdit: true,
+			expectedCanTake: true,
+		},
+		{
+			name:            "Taker",
+			userId:          "taker01",
+			expectedRole:    EXAM_ROLE_TAKER,
+			expectedCanEdit: false,
+			expectedCanTake: true,
+		},
+		{
+			name:            "Other user of private exam",
+			userId:          "user01",
+			expectedRole:    "",
+			expectedCanEdit: false,
+			expectedCanTake: false,
+		},
+		{
+			name:            "Other user of public exam",
+			isPublic:        true,
+			userId:          "user01",
+			expectedRole:    EXAM_ROLE_TAKER,
+			expectedCanEdit: false,
+			expectedCanTake: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			exam.IsPublic = tc.isPublic
+			s.Equal(tc.expectedRole, examRole(exam, tc.userId))
+			s.Equal(tc.expectedCanEdit, canEditExam(exam, tc.userId))
+			s.Equal(tc.expectedCanTake, canTakeExam(exam, tc.userId))
+		})
+	}
+}
+
+func (s *MyTestSuite) TestShareExam() {
+	type args struct {
+		examId       string
+		sharedUserId string
+		role         string
+		userId       string
+	}
+
+	userId := "user01"
+	examId := primitive.NewObjectID().Hex()
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected error
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Share exam with editor",
+			args: &args{
+				examId:       examId,
+				sharedUserId: "user02",
+				role:         EXAM_ROLE_EDITOR,
+				userId:       userId,
+			},
+			expected: nil,
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						UserId: args.userId,
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					UpdateExam(mock.Anything, mock.MatchedBy(func(exam model.Exam) bool {
+						return len(exam.Shares) == 1 &&
+							exam.Shares[0].UserId == "user02" &&
+							exam.Shares[0].Role == EXAM_ROLE_EDITOR
+					})).
+					Return(nil)
+			},
+		},
+		{
+			name: "Change role of shared user",
+			args: &args{
+				examId:       examId,
+				sharedUserId: "user02",
+				role:         EXAM_ROLE_TAKER,
+				userId:       userId,
+			},
+			expected: nil,
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						UserId: args.userId,
+						Shares: []model.ExamShare{
+							{
+								UserId: "user02",
+								Role:   EXAM_ROLE_EDITOR,
+							},
+						},
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					UpdateExam(mock.Anything, mock.MatchedBy(func(exam model.Exam) bool {
+						return len(exam.Shares) == 1 && exam.Shares[0].Role == EXAM_ROLE_TAKER
+					})).
+					Return(nil)
+			},
+		},
+		{
+			name: "Invalid role",
+			args: &args{
+				examId:       examId,
+				sharedUserId: "user02",
+				role:         EXAM_ROLE_OWNER,
+				userId:       userId,
+			},
+			expected: fmt.Errorf(
+				"ShareExam failed: %w",
+				fmt.Errorf("Invalid role: %s", EXAM_ROLE_OWNER),
+			),
+			on: func(s *MyTestSuite, args *args) {},
+		},
+		{
+			name: "Share exam with its owner",
+			args: &args{
+				examId:       examId,
+				sharedUserId: userId,
+				role:         EXAM_ROLE_TAKER,
+				userId:       userId,
+			},
+			expected: fmt.Errorf(
+				"ShareExam failed: %w",
+				fmt.Errorf("Invalid sharedUserId: %s", userId),
+			),
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						UserId: args.userId,
+					}, nil)
+			},
+		},
+		{
+			name: "Editor can not share exam",
+			args: &args{
+				examId:       examId,
+				sharedUserId: "user03",
+				role:         EXAM_ROLE_TAKER,
+				userId:       userId,
+			},
+			expected: fmt.Errorf("ShareExam failed: %w", unauthorizedOperationError),
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						UserId: "user02",
+						Shares: []model.ExamShare{
+							{
+								UserId: args.userId,
+								Role:   EXAM_ROLE_EDITOR,
+							},
+						},
+					}, nil)
+			},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			err := s.examService.ShareExam(
+				ctx,
+				args.examId,
+				args.sharedUserId,
+				args.role,
+				args.userId,
+			)
+			s.Equal(tc.expected, err)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestUnshareExam() {
+	userId := "user01"
+	examId := primitive.NewObjectID().Hex()
+	mockExam := &model.Exam{
+		UserId: userId,
+		Shares: []model.ExamShare{
+			{
+				UserId: "user02",
+				Role:   EXAM_ROLE_EDITOR,
+			},
+			{
+				UserId: "user03",
+				Role:   EXAM_ROLE_TAKER,
+			},
+		},
+	}
+
+	s.mockDatabaseRepository.EXPECT().
+		GetExamById(mock.Anything, examId).
+		Return(mockExam, nil)
+	s.mockDatabaseRepository.EXPECT().
+		UpdateExam(mock.Anything, mock.MatchedBy(func(exam model.Exam) bool {
+			return len(exam.Shares) == 1 && exam.Shares[0].UserId == "user03"
+		})).
+		Return(nil).
+		Once()
+
+	// Test
+	err := s.examService.UnshareExam(context.Background(), examId, "user02", userId)
+	s.Nil(err)
+
+	// 沒有分享給該使用者時不會修改測驗
+	err = s.examService.UnshareExam(context.Background(), examId, "user04", userId)
+	s.Nil(err)
+}
+
+func (s *MyTestSuite) TestFindExamShares() {
+	examId := primitive.NewObjectID().Hex()
+	mockShares := []model.ExamShare{
+		{
+			UserId: "user02",
+			Role:   EXAM_ROLE_EDITOR,
+		},
+		{
+			UserId: "user03",
+			Role:   EXAM_ROLE_TAKER,
+		},
+	}
+
+	s.mockDatabaseRepository.EXPECT().
+		GetExamById(mock.Anything, examId).
+		Return(&model.Exam{
+			UserId: "user01",
+			Shares: mockShares,
+		}, nil)
+
+	// Test
+	// 共同編輯者可以查詢
+	shares, err := s.examService.FindExamShares(context.Background(), examId, "user02")
+	s.Nil(err)
+	s.Equal(mockShares, shares)
+
+	// 作答者不能查詢
+	shares, err = s.examService.FindExamShares(context.Background(), examId, "user03")
+	s.Nil(shares)
+	s.Equal(fmt.Errorf("FindExamShares failed: %w", unauthorizedOperationError), err)
+}
+
+func (s *MyTestSuite) TestUpdateQuestion_WhenUserIsEditor() {
+	questionId := primitive.NewObjectID()
+	examId := primitive.NewObjectID().Hex()
+	userId := "user02"
+
+	// 共同編輯者可以修改擁有者的題目
+	s.mockDatabaseRepository.EXPECT().
+		GetQuestionById(mock.Anything, questionId.Hex()).
+		Return(&model.Question{
+			Id:     questionId,
+			ExamId: examId,
+			UserId: "user01",
+		}, nil)
+	s.mockDatabaseRepository.EXPECT().
+		GetExamById(mock.Anything, examId).
+		Return(&model.Exam{
+			UserId: "user01",
+			Shares: []model.ExamShare{
+				{
+					UserId: userId,
+					Role:   EXAM_ROLE_EDITOR,
+				},
+			},
+		}, nil)
+	s.mockDatabaseRepository.EXPECT().
+		WithTransaction(mock.Anything, mock.AnythingOfType("transactionFunc")).
+		Return(nil, nil)
+
+	// Test
+	result, version, err := s.examService.UpdateQuestion(
+		context.Background(),
+		questionId.Hex(),
+		"ask01",
+		[]string{"a01"},
+		nil,
+		0,
+		false,
+		"",
+		"",
+		"",
+		nil,
+		userId,
+	)
+	s.Nil(err)
+	s.Equal(questionId.Hex(), result)
+	s.Equal(int32(1), version)
+}
+
+func (s *MyTestSuite) TestImportExam() {
+	type args struct {
+		topic       string
+		description string
+		tags        []string
+		format      string
+		content     string
+		userId      string
+	}
+
+	type result struct {
+		examId        string
+		questionCount int32
+		importErrors  []ExamImportError
+		err           error
+	}
+
+	userId := "user01"
+	examId := primitive.NewObjectID().Hex()
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Import CSV",
+			args: &args{
+				topic:  "topic01",
+				format: EXAM_FORMAT_CSV,
+				content: "ask,answers\n" +
+					"apple,蘋果\n" +
+					"\"book, novel\",書|書本\n",
+				userId: userId,
+			},
+			expected: &result{
+				examId:        examId,
+				questionCount: 2,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					WithTransaction(mock.Anything, mock.AnythingOfType("transactionFunc")).
+					Return(examId, nil)
+			},
+		},
+		{
+			name: "Import JSON with topic in content",
+			args: &args{
+				format:  EXAM_FORMAT_JSON,
+				content: `{"topic": "topic01", "questions": [{"ask": "apple", "answers": ["蘋果"]}]}`,
+				userId:  userId,
+			},
+			expected: &result{
+				examId:        examId,
+				questionCount: 1,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					WithTransaction(mock.Anything, mock.AnythingOfType("transactionFunc")).
+					Return(examId, nil)
+			},
+		},
+		{
+			name: "Content has errors",
+			args: &args{
+				topic:  "topic01",
+				format: EXAM_FORMAT_CSV,
+				content: "apple,蘋果\n" +
+					",書\n" +
+					"cat\n",
+				userId: userId,
+			},
+			expected: &result{
+				importErrors: []ExamImportError{
+					{Line: 2, Message: "Ask is empty"},
+					{Line: 3, Message: "Expected 2 to 6 columns but got 1"},
+				},
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+		{
+			name: "Topic is empty",
+			args: &args{
+				format:  EXAM_FORMAT_CSV,
+				content: "apple,蘋果\n",
+				userId:  userId,
+			},
+			expected: &result{
+				err: fmt.Errorf("ImportExam failed: %w", fmt.Errorf("Topic is empty")),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+		{
+			name: "Invalid format",
+			args: &args{
+				topic:   "topic01",
+				format:  "xml",
+				content: "<exam />",
+				userId:  userId,
+			},
+			expected: &result{
+				err: fmt.Errorf("ImportExam failed: %w", fmt.Errorf("Invalid format: xml")),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			examId, questionCount, importErrors, err := s.examService.ImportExam(
+				ctx,
+				args.topic,
+				args.description,
+				args.tags,
+				args.format,
+				[]byte(args.content),
+				args.userId,
+			)
+
+			expected := tc.expected
+			s.Equal(expected.examId, examId)
+			s.Equal(expected.questionCount, questionCount)
+			s.Equal(expected.importErrors, importErrors)
+			s.Equal(expected.err, err)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestExportExam() {
+	userId := "user01"
+	examObjectId := primitive.NewObjectID()
+	examId := examObjectId.Hex()
+	bankQuestionId := primitive.NewObjectID()
+	ctx := context.Background()
+
+	s.mockDatabaseRepository.EXPECT().
+		GetExamById(mock.Anything, examId).
+		Return(&model.Exam{
+			Id:          examObjectId,
+			Topic:       "topic01",
+			Description: "desc01",
+			Tags:        []string{"toeic"},
+			IsPublic:    false,
+			UserId:      userId,
+			BankSource: &model.ExamBankSource{
+				QuestionIds: []string{bankQuestionId.Hex()},
+			},
+		}, nil)
+
+	// 尚未發佈的測驗匯出本身的題組、題目與引用的題庫題目
+	sectionId := primitive.NewObjectID()
+	s.mockDatabaseRepository.EXPECT().
+		FindQuestionsByExamId(mock.Anything, examId).
+		Return([]model.Question{
+			{
+				Id:        primitive.NewObjectID(),
+				Ask:       "apple",
+				Answers:   []string{"蘋果"},
+				SectionId: sectionId.Hex(),
+			},
+		}, nil)
+	s.mockDatabaseRepository.EXPECT().
+		FindBankQuestionsByIds(mock.Anything, []string{bankQuestionId.Hex()}).
+		Return([]model.BankQuestion{
+			{Id: bankQuestionId, Ask: "book", Answers: []string{"書", "書本"}, UserId: userId},
+		}, nil)
+	s.mockDatabaseRepository.EXPECT().
+		FindExamSectionsByExamIdOrderByOrderAsc(mock.Anything, examId).
+		Return([]model.ExamSection{
+			{
+				Id:      sectionId,
+				ExamId:  examId,
+				Type:    EXAM_SECTION_TYPE_READING,
+				Title:   "title01",
+				Passage: "passage01",
+				Order:   1,
+			},
+		}, nil)
+
+	// Test
+	chunks := []string{}
+	err := s.examService.ExportExam(
+		ctx,
+		examId,
+		userId,
+		EXAM_FORMAT_JSON,
+		func(chunk []byte) error {
+			chunks = append(chunks, string(chunk))
+			return nil
+		},
+	)
+	s.Nil(err)
+	s.Len(chunks, 3)
+	s.JSONEq(`{
+		"topic": "topic01",
+		"description": "desc01",
+		"tags": ["toeic"],
+		"sections": [
+			{"type": "reading", "title": "title01", "passage": "passage01"}
+		],
+		"questions": [
+			{"ask": "apple", "answers": ["蘋果"], "section": 1},
+			{"ask": "book", "answers": ["書", "書本"]}
+		]
+	}`, strings.Join(chunks, ""))
+}
+
+func (s *MyTestSuite) TestExportExam_WhenExamIsPrivateOfOtherUser() {
+	examId := primitive.NewObjectID().Hex()
+	ctx := context.Background()
+
+	s.mockDatabaseRepository.EXPECT().
+		GetExamById(mock.Anything, examId).
+		Return(&model.Exam{
+			Topic:    "topic01",
+			IsPublic: false,
+			UserId:   "user02",
+		}, nil)
+
+	// Test
+	err := s.examService.ExportExam(
+		ctx,
+		examId,
+		"user01",
+		EXAM_FORMAT_CSV,
+		func(chunk []byte) error {
+			s.Fail("should not send any chunk")
+			return nil
+		},
+	)
+	s.Equal(fmt.Errorf("ExportExam failed: %w", unauthorizedOperationError), err)
+}
+
+func (s *MyTestSuite) TestExportExam_WhenExamIsPublicOfOtherUser() {
+	examId := primitive.NewObjectID().Hex()
+	ctx := context.Background()
+
+	// 公布答案的公開測驗也只有擁有者和共同編輯者可以匯出
+	s.mockDatabaseRepository.EXPECT().
+		GetExamById(mock.Anything, examId).
+		Return(&model.Exam{
+			Topic:    "topic01",
+			IsPublic: true,
+			UserId:   "user02",
+			Settings: &model.ExamSettings{ShowAnswersAfterSubmit: true},
+		}, nil)
+
+	// Test
+	err := s.examService.ExportExam(
+		ctx,
+		examId,
+		"user01",
+		EXAM_FORMAT_JSON,
+		func(chunk []byte) error {
+			s.Fail("should not send any chunk")
+			return nil
+		},
+	)
+	s.Equal(fmt.Errorf("ExportExam failed: %w", unauthorizedOperationError), err)
+}
+
+func (s *MyTestSuite) TestParseExamContent() {
+	testCases := []struct {
+		name                 string
+		format               string
+		content              string
+		expectedSections     []sectionDocument
+		expectedQuestions    []questionDocument
+		expectedImportErrors []ExamImportError
+	}{
+		{
+			name:   "JSON",
+			format: EXAM_FORMAT_JSON,
+			content: `{
+  "topic": "topic01",
+  "questions": [
+    {"ask": "apple", "answers": ["蘋果", " "], "points": 2, "partialCredit": true},
+    {"ask": "", "answers": ["書"]},
+    {"ask": "cat", "answers": "貓"},
+    {"ask": "dog", "answers": ["狗"], "points": -1},
+    {"ask": "egg", "answers": ["蛋"], "hint": " e__ ", "explanation": "egg 是蛋"}
+  ]
+}`,
+			expectedQuestions: []questionDocument{
+				{Ask: "apple", Answers: []string{"蘋果"}, Points: 2, PartialCredit: true, line: 4},
+				{Ask: "", Answers: []string{"書"}, line: 5},
+				{Ask: "dog", Answers: []string{"狗"}, Points: -1, line: 7},
+				{Ask: "egg", Answers: []string{"蛋"}, Hint: "e__", Explanation: "egg 是蛋", line: 8},
+			},
+			expectedImportErrors: []ExamImportError{
+				{Line: 5, Message: "Ask is empty"},
+				{
+					Line:    6,
+					Message: "json: cannot unmarshal string into Go struct field questionDocument.answers of type []string",
+				},
+				{Line: 7, Message: "Invalid points: -1"},
+			},
+		},
+		{
+			name:   "JSON with sections",
+			format: EXAM_FORMAT_JSON,
+			content: `{
+  "topic": "topic01",
+  "sections": [
+    {"type": "reading", "title": " title01 ", "passage": "passage01"},
+    {"type": "listening", "audioUrl": "ftp://example.com/a.mp3"}
+  ],
+  "questions": [
+    {"ask": "apple", "answers": ["蘋果"], "section": 1},
+    {"ask": "book", "answers": ["書"], "section": 3}
+  ]
+}`,
+			expectedSections: []sectionDocument{
+				{Type: "reading", Title: "title01", Passage: "passage01", line: 4},
+				{Type: "listening", AudioUrl: "ftp://example.com/a.mp3", line: 5},
+			},
+			expectedQuestions: []questionDocument{
+				{Ask: "apple", Answers: []string{"蘋果"}, Section: 1, line: 8},
+				{Ask: "book", Answers: []string{"書"}, Section: 3, line: 9},
+			},
+			expectedImportErrors: []ExamImportError{
+				{Line: 5, Message: "Invalid audioUrl: ftp://example.com/a.mp3"},
+				{Line: 9, Message: "Section not found: 3"},
+			},
+		},
+		{
+			name:    "JSON syntax error",
+			format:  EXAM_FORMAT_JSON,
+			content: "{\n  \"topic\": \"topic01\",\n  \"questions\": [\n}",
+			expectedImportErrors: []ExamImportError{
+				{Line: 4, Message: "invalid character '}' looking for beginning of value"},
+			},
+		},
+		{
+			name:   "CSV without header",
+			format: EXAM_FORMAT_CSV,
+			content: "apple,蘋果|林檎\n" +
+				"\n" +
+				"\"a, b\",c\n",
+			expectedQuestions: []questionDocument{
+				{Ask: "apple", Answers: []string{"蘋果", "林檎"}, line: 1},
+				{Ask: "a, b", Answers: []string{"c"}, line: 3},
+			},
+		},
+		{
+			name:   "CSV with optional columns",
+			format: EXAM_FORMAT_CSV,
+			content: "ask,answers,points,partialCredit,hint,explanation\n" +
+				"apple,蘋果,3,true\n" +
+				"book,書,,,b___,book 是書\n" +
+				"cat,貓,abc\n" +
+				"dog,狗,1,false,,,extra\n",
+			expectedQuestions: []questionDocument{
+				{Ask: "apple", Answers: []string{"蘋果"}, Points: 3, PartialCredit: true, line: 2},
+				{Ask: "book", Answers: []string{"書"}, Hint: "b___", Explanation: "book 是書", line: 3},
+			},
+			expectedImportErrors: []ExamImportError{
+				{Line: 4, Message: "Invalid points: abc"},
+				{Line: 5, Message: "Expected 2 to 6 columns but got 7"},
+			},
+		},
+		{
+			name:   "GIFT",
+			format: EXAM_FORMAT_GIFT,
+			content: "// comment\n" +
+				"$CATEGORY: animals\n" +
+				"::Q1:: What is {=cat =kitty#feedback} in English?\n" +
+				"\n" +
+				"Apple means \\{fruit\\} {=蘋果 ~香蕉}\n" +
+				"\n" +
+				"The sun is hot. {T}\n" +
+				"\n" +
+				"Pi {#3.14:0.01 ####π 約等於 3.14}\n" +
+				"\n" +
+				"No answers here\n",
+			expectedQuestions: []questionDocument{
+				{Ask: "What is _____ in English?", Answers: []string{"cat", "kitty"}, line: 3},
+				{Ask: "Apple means {fruit}", Answers: []string{"蘋果"}, line: 5},
+				{Ask: "The sun is hot.", Answers: []string{"true"}, line: 7},
+				{Ask: "Pi", Answers: []string{"3.14"}, Explanation: "π 約等於 3.14", line: 9},
+			},
+			expectedImportErrors: []ExamImportError{
+				{Line: 11, Message: "Answers block {} not found"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Test
+			exam, importErrors := parseExamContent(tc.format, []byte(tc.content))
+			s.Equal(tc.expectedSections, exam.Sections)
+			s.Equal(tc.expectedQuestions, exam.Questions)
+			s.Equal(tc.expectedImportErrors, importErrors)
+		})
+	}
+}
+
+// 匯出後再匯入，內容應該相同
+func (s *MyTestSuite) TestExportAndParseExamContent() {
+	exam := &model.Exam{
+		Topic:       "topic01",
+		Description: "desc01",
+		Tags:        []string{"toeic"},
+	}
+	sections := []model.ExamSection{
+		{
+			Id:       primitive.NewObjectID(),
+			Type:     EXAM_SECTION_TYPE_LISTENING,
+			Title:    "title01",
+			AudioUrl: "https://example.com/a.mp3",
+			Order:    1,
+		},
+	}
+	questions := []model.Question{
+		{
+			Ask:           "What is {1 + 1} = ?",
+			Answers:       []string{"2", "two"},
+			Points:        2,
+			PartialCredit: true,
+			Hint:          "1 + 1",
+			Explanation:   "1 + 1 = 2 #math",
+			SectionId:     sections[0].Id.Hex(),
+		},
+		{Ask: "a, \"quoted\" ask: #1", Answers: []string{"~answer"}},
+	}
+	expectedSections := []sectionDocument{
+		{Type: EXAM_SECTION_TYPE_LISTENING, Title: "title01", AudioUrl: "https://example.com/a.mp3"},
+	}
+	expectedQuestions := []questionDocument{
+		{
+			Ask:           questions[0].Ask,
+			Answers:       questions[0].Answers,
+			Points:        2,
+			PartialCredit: true,
+			Hint:          "1 + 1",
+			Explanation:   "1 + 1 = 2 #math",
+			Section:       1,
+		},
+		{Ask: questions[1].Ask, Answers: questions[1].Answers},
+	}
+
+	// CSV 沒有題組
+	expectedCSVQuestions := []questionDocument{
+		{
+			Ask:           questions[0].Ask,
+			Answers:       questions[0].Answers,
+			Points:        2,
+			PartialCredit: true,
+			Hint:          "1 + 1",
+			Explanation:   "1 + 1 = 2 #math",
+		},
+		{Ask: questions[1].Ask, Answers: questions[1].Answers},
+	}
+
+	// GIFT 沒有題組、配分、部分給分與提示的欄位
+	expectedGIFTQuestions := []questionDocument{
+		{Ask: questions[0].Ask, Answers: questions[0].Answers, Explanation: "1 + 1 = 2 #math"},
+		{Ask: questions[1].Ask, Answers: questions[1].Answers},
+	}
+
+	for _, format := range []string{EXAM_FORMAT_JSON, EXAM_FORMAT_CSV, EXAM_FORMAT_GIFT} {
+		s.Run(format, func() {
+			exporter := newExamExporter(format)
+			content := exporter.writeHeader(exam, sections)
+			content = append(content, exporter.writeQuestions(questions[:1])...)
+			content = append(content, exporter.writeQuestions(questions[1:])...)
+			content = append(content, exporter.writeFooter()...)
+
+			// Test
+			examDocument, importErrors := parseExamContent(format, content)
+			s.Empty(importErrors)
+
+			for i := range examDocument.Sections {
+				examDocument.Sections[i].line = 0
+			}
+
+			for i := range examDocument.Questions {
+				examDocument.Questions[i].line = 0
+			}
+
+			switch format {
+			case EXAM_FORMAT_JSON:
+				s.Equal(expectedSections, examDocument.Sections)
+				s.Equal(expectedQuestions, examDocument.Questions)
+			case EXAM_FORMAT_CSV:
+				s.Empty(examDocument.Sections)
+				s.Equal(expectedCSVQuestions, examDocument.Questions)
+			default:
+				s.Empty(examDocument.Sections)
+				s.Equal(expectedGIFTQuestions, examDocument.Questions)
+			}
+		})
+	}
+}
+
+func (s *MyTestSuite) TestFindExamTagCounts() {
+	userId := "user01"
+	tagCounts := []repository.TagCount{
+		{Tag: "toeic", Count: 3},
+		{Tag: "grammar", Count: 1},
+	}
+	s.mockDatabaseRepository.EXPECT().
+		FindExamTagCounts(mock.Anything, userId, int32(100)).
+		Return(tagCounts, nil)
+
+	// Test
+	result, err := s.examService.FindExamTagCounts(context.Background(), userId, 0)
+	s.Nil(err)
+	s.Equal(tagCounts, result)
+}
+
+func (s *MyTestSuite) TestCreateClass() {
+	userId := "teacher01"
+	classId := primitive.NewObjectID().Hex()
+	var joinCode string
+
+	s.mockDatabaseRepository.EXPECT().
+		CreateClass(mock.Anything, mock.MatchedBy(func(class model.Class) bool {
+			joinCode = class.JoinCode
+			return class.Name == "Class A" &&
+				class.UserId == userId &&
+				len(class.JoinCode) == classJoinCodeLength
+		})).
+		Return(classId, nil).
+		Once()
+
+	// Test
+	resultClassId, resultJoinCode, err := s.examService.CreateClass(
+		context.Background(),
+		"  Class A ",
+		"desc",
+		userId,
+	)
+	s.Nil(err)
+	s.Equal(classId, resultClassId)
+	s.Equal(joinCode, resultJoinCode)
+
+	// 名稱不能是空白
+	_, _, err = s.examService.CreateClass(context.Background(), " ", "desc", userId)
+	s.Equal(
+		fmt.Errorf("CreateClass failed: %w", fmt.Errorf("Invalid class name: %s", "")),
+		err,
+	)
+}
+
+func (s *MyTestSuite) TestJoinClass() {
+	type args struct {
+		joinCode string
+		userId   string
+	}
+
+	type result struct {
+		classId string
+		err     error
+	}
+
+	classId := primitive.NewObjectID()
+	mockClass := &model.Class{
+		Id:       classId,
+		Name:     "Class A",
+		JoinCode: "AB3DK9MZ",
+		UserId:   "teacher01",
+		Students: []model.ClassStudent{
+			{UserId: "student01"},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Join class",
+			args: &args{
+				joinCode: " ab3dk9mz ",
+				userId:   "student02",
+			},
+			expected: &result{
+				classId: classId.Hex(),
+				err:     nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetClassByJoinCode(mock.Anything, "AB3DK9MZ").
+					Return(mockClass, nil)
+				s.mockDatabaseRepository.EXPECT().
+					AddClassStudent(
+						mock.Anything,
+						classId.Hex(),
+						mock.MatchedBy(func(student model.ClassStudent) bool {
+							return student.UserId == args.userId
+						}),
+					).
+					Return(1, nil).
+					Once()
+			},
+		},
+		{
+			name: "Join class again",
+			args: &args{
+				joinCode: "AB3DK9MZ",
+				userId:   "student01",
+			},
+			expected: &result{
+				classId: classId.Hex(),
+				err:     nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetClassByJoinCode(mock.Anything, "AB3DK9MZ").
+					Return(mockClass, nil)
+			},
+		},
+		{
+			name: "Join code not found",
+			args: &args{
+				joinCode: "ZZZZZZZZ",
+				userId:   "student02",
+			},
+			expected: &result{
+				classId: "",
+				err: fmt.Errorf(
+					"JoinClass failed: %w",
+					fmt.Errorf("Class not found by joinCode: %s", "ZZZZZZZZ"),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetClassByJoinCode(mock.Anything, "ZZZZZZZZ").
+					Return(nil, nil)
+			},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			class, err := s.examService.JoinClass(ctx, args.joinCode, args.userId)
+
+			expected := tc.expected
+			s.Equal(expected.err, err)
+
+			if expected.err == nil {
+				s.Equal(expected.classId, class.Id.Hex())
+			}
+		})
+	}
+}
+
+func (s *MyTestSuite) TestFindClasses() {
+	userId := "student01"
+	mockClasses := []model.Class{
+		{
+			Id:       primitive.NewObjectID(),
+			Name:     "Class A",
+			JoinCode: "AB3DK9MZ",
+			UserId:   "teacher01",
+			Students: []model.ClassStudent{
+				{UserId: "student01"},
+				{UserId: "student02"},
+			},
+		},
+		{
+			Id:       primitive.NewObjectID(),
+			Name:     "Class B",
+			JoinCode: "CD4EFGHJ",
+			UserId:   userId,
+			Students: []model.ClassStudent{
+				{UserId: "student03"},
+			},
+		},
+	}
+
+	s.mockDatabaseRepository.EXPECT().
+		FindClassesByUserIdOrStudentUserIdOrderByUpdateAtDesc(mock.Anything, userId).
+		Return(mockClasses, nil)
+
+	// Test
+	classes, err := s.examService.FindClasses(context.Background(), userId)
+	s.Nil(err)
+	s.Len(classes, 2)
+
+	// 學生看不到加入代碼和其他學生
+	s.Equal("", classes[0].JoinCode)
+	s.Empty(classes[0].Students)
+
+	// 老師可以看到自己班級的加入代碼和學生
+	s.Equal("CD4EFGHJ", classes[1].JoinCode)
+	s.Len(classes[1].Students, 1)
+}
+
+func (s *MyTestSuite) TestCreateAssignment() {
+	type args struct {
+		classId string
+		examId  string
+		title   string
+		dueAt   time.Time
+		userId  string
+	}
+
+	type result struct {
+		assignmentId string
+		err          error
+	}
+
+	userId := "teacher01"
+	classId := primitive.NewObjectID().Hex()
+	examId := primitive.NewObjectID().Hex()
+	assignmentId := primitive.NewObjectID().Hex()
+	dueAt := time.Now().Add(7 * 24 * time.Hour)
+	pastDueAt := time.Now().Add(-time.Hour)
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Create assignment with exam topic as title",
+			args: &args{
+				classId: classId,
+				examId:  examId,
+				title:   " ",
+				dueAt:   dueAt,
+				userId:  userId,
+			},
+			expected: &result{
+				assignmentId: assignmentId,
+				err:          nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetClassById(mock.Anything, args.classId).
+					Return(&model.Class{
+						UserId: args.userId,
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Topic:  "topic",
+						UserId: args.userId,
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					CreateAssignment(mock.Anything, model.Assignment{
+						ClassId: args.classId,
+						ExamId:  args.examId,
+						Title:   "topic",
+						DueAt:   args.dueAt,
+						UserId:  args.userId,
+					}).
+					Return(assignmentId, nil)
+			},
+		},
+		{
+			name: "Invalid dueAt",
+			args: &args{
+				classId: classId,
+				examId:  examId,
+				title:   "homework",
+				dueAt:   pastDueAt,
+				userId:  userId,
+			},
+			expected: &result{
+				assignmentId: "",
+				err: fmt.Errorf(
+					"CreateAssignment failed: %w",
+					fmt.Errorf("Invalid dueAt: %s", pastDueAt.Format(time.RFC3339)),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+		{
+			name: "User is not teacher of class",
+			args: &args{
+				classId: classId,
+				examId:  examId,
+				title:   "homework",
+				dueAt:   dueAt,
+				userId:  "student01",
+			},
+			expected: &result{
+				assignmentId: "",
+				err:          fmt.Errorf("CreateAssignment failed: %w", unauthorizedOperationError),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetClassById(mock.Anything, args.classId).
+					Return(&model.Class{
+						UserId: userId,
+					}, nil)
+			},
+		},
+		{
+			name: "Taker can not assign private exam",
+			args: &args{
+				classId: classId,
+				examId:  examId,
+				title:   "homework",
+				dueAt:   dueAt,
+				userId:  userId,
+			},
+			expected: &result{
+				assignmentId: "",
+				err:          fmt.Errorf("CreateAssignment failed: %w", unauthorizedOperationError),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetClassById(mock.Anything, args.classId).
+					Return(&model.Class{
+						UserId: args.userId,
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						UserId: "user02",
+						Shares: []model.ExamShare{
+							{
+								UserId: args.userId,
+								Role:   EXAM_ROLE_TAKER,
+							},
+						},
+					}, nil)
+			},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			assignmentId, err := s.examService.CreateAssignment(
+				ctx,
+				args.classId,
+				args.examId,
+				args.title,
+				args.dueAt,
+				args.userId,
+			)
+
+			expected := tc.expected
+			s.Equal(expected.assignmentId, assignmentId)
+			s.Equal(expected.err, err)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestFindAssignments() {
+	classId := primitive.NewObjectID().Hex()
+	mockAssignments := []model.Assignment{
+		{
+			Id:      primitive.NewObjectID(),
+			ClassId: classId,
+			ExamId:  "exam01",
+			Title:   "homework",
+		},
+	}
+
+	s.mockDatabaseRepository.EXPECT().
+		GetClassById(mock.Anything, classId).
+		Return(&model.Class{
+			UserId: "teacher01",
+			Students: []model.ClassStudent{
+				{UserId: "student01"},
+			},
+		}, nil)
+	s.mockDatabaseRepository.EXPECT().
+		FindAssignmentsByClassIdOrderByDueAtAsc(mock.Anything, classId).
+		Return(mockAssignments, nil)
+
+	// Test
+	// 學生可以查詢
+	assignments, err := s.examService.FindAssignments(context.Background(), classId, "student01")
+	s.Nil(err)
+	s.Equal(mockAssignments, assignments)
+
+	// 不在班級中的使用者不能查詢
+	assignments, err = s.examService.FindAssignments(context.Background(), classId, "student02")
+	s.Nil(assignments)
+	s.Equal(fmt.Errorf("FindAssignments failed: %w", unauthorizedOperationError), err)
+}
+
+func (s *MyTestSuite) TestFindClassGradebook() {
+	userId := "teacher01"
+	classId := primitive.NewObjectID().Hex()
+	now := time.Now()
+	mockClass := &model.Class{
+		UserId: userId,
+		Students: []model.ClassStudent{
+			{UserId: "student01"},
+			{UserId: "student02"},
+		},
+	}
+	mockAssignments := []model.Assignment{
+		{
+			Id:        primitive.NewObjectID(),
+			ClassId:   classId,
+			ExamId:    "exam01",
+			DueAt:     now.Add(24 * time.Hour),
+			CreatedAt: now.Add(-24 * time.Hour),
+		},
+		{
+			Id:        primitive.NewObjectID(),
+			ClassId:   classId,
+			ExamId:    "exam02",
+			DueAt:     now.Add(48 * time.Hour),
+			CreatedAt: now.Add(-24 * time.Hour),
+		},
+	}
+	studentUserIds := []string{"student01", "student02"}
+
+	s.mockDatabaseRepository.EXPECT().
+		GetClassById(mock.Anything, classId).
+		Return(mockClass, nil)
+	s.mockDatabaseRepository.EXPECT().
+		FindAssignmentsByClassIdOrderByDueAtAsc(mock.Anything, classId).
+		Return(mockAssignments, nil)
+	s.mockDatabaseRepository.EXPECT().
+		FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween(
+			mock.Anything,
+			"exam01",
+			studentUserIds,
+			mockAssignments[0].CreatedAt,
+			mockAssignments[0].DueAt,
+		).
+		Return([]repository.ExamRecordScore{
+			{
+				UserId:           "student01",
+				BestPercentage:   90,
+				LatestPercentage: 70,
+				RecordCount:      2,
+				LatestCreatedAt:  now,
+			},
+		}, nil)
+	s.mockDatabaseRepository.EXPECT().
+		FindExamRecordScoresByExamIdAndUserIdsAndCreatedAtBetween(
+			mock.Anything,
+			"exam02",
+			studentUserIds,
+			mockAssignments[1].CreatedAt,
+			mockAssignments[1].DueAt,
+		).
+		Return([]repository.ExamRecordScore{}, nil)
+
+	// Test
+	class, assignments, grades, err := s.examService.FindClassGradebook(
+		context.Background(),
+		classId,
+		userId,
+	)
+	s.Nil(err)
+	s.Equal(mockClass, class)
+	s.Equal(mockAssignments, assignments)
+	s.Equal([]ClassGrade{
+		{
+			AssignmentId:     mockAssignments[0].Id.Hex(),
+			UserId:           "student01",
+			BestPercentage:   90,
+			LatestPercentage: 70,
+			RecordCount:      2,
+			LatestCreatedAt:  now,
+		},
+	}, grades)
+
+	// 學生不能查詢成績簿
+	_, _, _, err = s.examService.FindClassGradebook(context.Background(), classId, "student01")
+	s.Equal(fmt.Errorf("FindClassGradebook failed: %w", unauthorizedOperationError), err)
+}
+
+func (s *MyTestSuite) TestNewClassJoinCode() {
+	joinCode, err := newClassJoinCode()
+	s.Nil(err)
+	s.Len(joinCode, classJoinCodeLength)
+
+	for _, c := range joinCode {
+		s.True(strings.ContainsRune(classJoinCodeCharacters, c))
+	}
+
+	s.Equal("AB3DK9MZ", normalizeClassJoinCode(" ab3dk9mz "))
+}
+
+func (s *MyTestSuite) TestLeaderboardStartAt() {
+	// 2026-10-21 是星期三
+	now := time.Date(2026, 10, 21, 15, 4, 5, 0, time.UTC)
+
+	startAt, err := leaderboardStartAt(LEADERBOARD_WINDOW_WEEKLY, now)
+	s.Nil(err)
+	s.Equal(time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), startAt)
+
+	// 星期日屬於前一個星期一開始的那一週
+	startAt, err = leaderboardStartAt(LEADERBOARD_WINDOW_WEEKLY, now.AddDate(0, 0, 4))
+	s.Nil(err)
+	s.Equal(time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), startAt)
+
+	startAt, err = leaderboardStartAt("", now)
+	s.Nil(err)
+	s.True(startAt.IsZero())
+
+	_, err = leaderboardStartAt("monthly", now)
+	s.Equal(fmt.Errorf("Invalid window: %s", "monthly"), err)
+}
+
+func (s *MyTestSuite) TestFindExamLeaderboard() {
+	type args struct {
+		examId          string
+		window          string
+		size            int32
+		excludedUserIds []string
+	}
+
+	type result struct {
+		leaderboardEntries []repository.LeaderboardEntry
+		err                error
+	}
+
+	examId := primitive.NewObjectID().Hex()
+	mockLeaderboardEntries := []repository.LeaderboardEntry{
+		{
+			UserId:          "user02",
+			Score:           10,
+			DurationSeconds: 60,
+		},
+		{
+			UserId:          "user01",
+			Score:           10,
+			DurationSeconds: 90,
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Find all time leaderboard",
+			args: &args{
+				examId:          examId,
+				window:          LEADERBOARD_WINDOW_ALL_TIME,
+				size:            10,
+				excludedUserIds: []string{"user03"},
+			},
+			expected: &result{
+				leaderboardEntries: mockLeaderboardEntries,
+				err:                nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						IsPublic: true,
+						UserId:   "user01",
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn(
+						mock.Anything,
+						args.examId,
+						time.Time{},
+						args.excludedUserIds,
+						args.size,
+					).
+					Return(mockLeaderboardEntries, nil)
+			},
+		},
+		{
+			name: "Find weekly leaderboard",
+			args: &args{
+				examId: examId,
+				window: LEADERBOARD_WINDOW_WEEKLY,
+				size:   10,
+			},
+			expected: &result{
+				leaderboardEntries: mockLeaderboardEntries,
+				err:                nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						IsPublic: true,
+						UserId:   "user01",
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindLeaderboardEntriesByExamIdAndCreatedAtAndUserIdNotIn(
+						mock.Anything,
+						args.examId,
+						mock.MatchedBy(func(createdAt time.Time) bool {
+							return createdAt.Weekday() == time.Monday &&
+								!createdAt.After(time.Now())
+						}),
+						args.excludedUserIds,
+						args.size,
+					).
+					Return(mockLeaderboardEntries, nil)
+			},
+		},
+		{
+			name: "Invalid size",
+			args: &args{
+				examId: examId,
+				window: LEADERBOARD_WINDOW_ALL_TIME,
+				size:   101,
+			},
+			expected: &result{
+				leaderboardEntries: nil,
+				err: fmt.Errorf(
+					"FindExamLeaderboard failed: %w",
+					fmt.Errorf("Invalid size: %d", 101),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+		{
+			name: "Exam is not public",
+			args: &args{
+				examId: examId,
+				window: LEADERBOARD_WINDOW_ALL_TIME,
+				size:   10,
+			},
+			expected: &result{
+				leaderboardEntries: nil,
+				err:                fmt.Errorf("FindExamLeaderboard failed: %w", unauthorizedOperationError),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						IsPublic: false,
+						UserId:   "user01",
+					}, nil)
+			},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			leaderboardEntries, err := s.examService.FindExamLeaderboard(
+				ctx,
+				args.examId,
+				args.window,
+				args.size,
+				args.excludedUserIds,
+			)
+
+			expected := tc.expected
+			s.Equal(expected.leaderboardEntries, leaderboardEntries)
+			s.Equal(expected.err, err)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestFindQuestionStatistics() {
+	type args struct {
+		examId string
+		userId string
+	}
+
+	type result struct {
+		summary            repository.ExamStatisticsSummary
+		questionStatistics []QuestionStatistic
+		err                error
+	}
+
+	examObjectId := primitive.NewObjectID()
+	examId := examObjectId.Hex()
+	questionId01 := primitive.NewObjectID()
+	questionId02 := primitive.NewObjectID()
+	questions := []model.Question{
+		{
+			Id:  questionId01,
+			Ask: "ask01",
+		},
+		{
+			Id:  questionId02,
+			Ask: "ask02",
+		},
+	}
+	summary := repository.ExamStatisticsSummary{
+		AttemptCount:     3,
+		UserCount:        3,
+		MeanPercentage:   50,
+		MedianPercentage: 50,
+		PercentageDistribution: []repository.PercentageCount{
+			{Percentage: 0, Count: 1},
+			{Percentage: 50, Count: 1},
+			{Percentage: 100, Count: 1},
+		},
+	}
+
+	// 已刪除的題目不列入結果
+	questionAttemptCounts := []repository.QuestionAttemptCount{
+		{
+			QuestionId:         questionId01.Hex(),
+			AttemptCount:       3,
+			WrongCount:         1,
+			TopAttemptCount:    1,
+			TopWrongCount:      0,
+			BottomAttemptCount: 1,
+			BottomWrongCount:   1,
+		},
+		{
+			QuestionId:         questionId02.Hex(),
+			AttemptCount:       3,
+			WrongCount:         3,
+			TopAttemptCount:    1,
+			TopWrongCount:      1,
+			BottomAttemptCount: 1,
+			BottomWrongCount:   1,
+		},
+		{
+			QuestionId:   primitive.NewObjectID().Hex(),
+			AttemptCount: 1,
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Find question statistics",
 			args: &args{
 				examId: examId,
-				userId: userId,
-				size:   5,
+				userId: "user01",
 			},
 			expected: &result{
-				exam:      &mockExam,
-				questions: []model.Question{{}, {}, {}, {}, {}},
-				err:       nil,
+				summary: summary,
+				questionStatistics: []QuestionStatistic{
+					{
+						QuestionId:          questionId01.Hex(),
+						Ask:                 "ask01",
+						AttemptCount:        3,
+						WrongCount:          1,
+						ErrorRate:           float64(1) / float64(3),
+						DiscriminationIndex: 1,
+					},
+					{
+						QuestionId:          questionId02.Hex(),
+						Ask:                 "ask02",
+						AttemptCount:        3,
+						WrongCount:          3,
+						ErrorRate:           1,
+						DiscriminationIndex: 0,
+					},
+				},
+				err: nil,
 			},
 			on: func(s *MyTestSuite, args *args) {
 				s.mockDatabaseRepository.EXPECT().
 					GetExamById(mock.Anything, args.examId).
-					Return(&mockExam, nil)
+					Return(&model.Exam{
+						Id:     examObjectId,
+						UserId: "user01",
+					}, nil)
 				s.mockDatabaseRepository.EXPECT().
-					FindQuestionsByExamIdOrderByUpdateAtDesc(
+					FindQuestionsByExamId(mock.Anything, args.examId).
+					Return(questions, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindExamSectionsByExamIdOrderByOrderAsc(mock.Anything, args.examId).
+					Return([]model.ExamSection{}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindExamStatisticsSummaryByExamId(mock.Anything, args.examId).
+					Return(summary, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindQuestionAttemptCountsByExamId(
 						mock.Anything,
 						args.examId,
-						mock.AnythingOfType("int32"),
-						int32(1)).
-					Return([]model.Question{
-						{},
-					}, nil)
-				s.mockDatabaseRepository.EXPECT().
-					CountQuestionsByExamId(mock.Anything, examId).
-					Return(int32(100), nil)
+						discriminationGroupRatio,
+					).
+					Return(questionAttemptCounts, nil)
 			},
 		},
 		{
-			name: "Find random questions by size 10",
+			name: "Find question statistics of exam without records",
 			args: &args{
 				examId: examId,
-				userId: userId,
-				size:   10,
+				userId: "user01",
 			},
 			expected: &result{
-				exam: &mockExam,
-				questions: []model.Question{
-					{}, {}, {}, {}, {}, {}, {}, {}, {}, {},
+				summary: repository.ExamStatisticsSummary{
+					PercentageDistribution: []repository.PercentageCount{},
+				},
+				questionStatistics: []QuestionStatistic{
+					{
+						QuestionId: questionId01.Hex(),
+						Ask:        "ask01",
+					},
+					{
+						QuestionId: questionId02.Hex(),
+						Ask:        "ask02",
+					},
 				},
 				err: nil,
 			},
 			on: func(s *MyTestSuite, args *args) {
 				s.mockDatabaseRepository.EXPECT().
 					GetExamById(mock.Anything, args.examId).
-					Return(&mockExam, nil)
+					Return(&model.Exam{
+						Id:     examObjectId,
+						UserId: "user01",
+					}, nil)
 				s.mockDatabaseRepository.EXPECT().
-					FindQuestionsByExamIdOrderByUpdateAtDesc(
+					FindQuestionsByExamId(mock.Anything, args.examId).
+					Return(questions, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindExamSectionsByExamIdOrderByOrderAsc(mock.Anything, args.examId).
+					Return([]model.ExamSection{}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindExamStatisticsSummaryByExamId(mock.Anything, args.examId).
+					Return(repository.ExamStatisticsSummary{
+						PercentageDistribution: []repository.PercentageCount{},
+					}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindQuestionAttemptCountsByExamId(
 						mock.Anything,
 						args.examId,
-						mock.AnythingOfType("int32"),
-						int32(1)).
-					Return([]model.Question{
-						{},
-					}, nil)
+						discriminationGroupRatio,
+					).
+					Return([]repository.QuestionAttemptCount{}, nil)
+			},
+		},
+		{
+			name: "Not exam owner",
+			args: &args{
+				examId: examId,
+				userId: "user02",
+			},
+			expected: &result{
+				summary:            repository.ExamStatisticsSummary{},
+				questionStatistics: nil,
+				err: fmt.Errorf(
+					"FindQuestionStatistics failed: %w",
+					unauthorizedOperationError,
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {
 				s.mockDatabaseRepository.EXPECT().
-					CountQuestionsByExamId(mock.Anything, examId).
-					Return(int32(100), nil)
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						UserId: "user01",
+					}, nil)
 			},
 		},
 	}
@@ -1048,36 +7203,94 @@ func (s *MyTestSuite) TestFindRandomQuestions() {
 			tc.on(s, args)
 
 			// Test
-			exam, questions, err := s.examService.FindRandomQuestions(
+			summary, questionStatistics, err := s.examService.FindQuestionStatistics(
 				ctx,
 				args.examId,
 				args.userId,
-				args.size,
 			)
 
 			expected := tc.expected
-			s.Equal(expected.exam, exam)
-			s.Equal(expected.questions, questions)
+			s.Equal(expected.summary, summary)
+			s.Equal(expected.questionStatistics, questionStatistics)
 			s.Equal(expected.err, err)
 		})
 	}
 }
 
-func (s *MyTestSuite) TestCreateExamRecord() {
+func (s *MyTestSuite) TestExamAttemptQuestionIds() {
+	questionIds, err := examAttemptQuestionIds([]model.ExamAttemptAnswer{
+		{
+			QuestionId: "question01",
+		},
+		{
+			QuestionId: "question02",
+		},
+	})
+	s.Nil(err)
+	s.Equal([]string{"question01", "question02"}, questionIds)
+
+	_, err = examAttemptQuestionIds([]model.ExamAttemptAnswer{
+		{
+			QuestionId:       "question01",
+			TimeSpentSeconds: -1,
+		},
+	})
+	s.Equal(fmt.Errorf("Invalid timeSpentSeconds: %d", -1), err)
+}
+
+func (s *MyTestSuite) TestGetExamRecordDetail() {
 	type args struct {
-		examId           string
-		score            int32
-		wrongQuestionIds []string
-		userId           string
+		examId       string
+		examRecordId string
+		userId       string
 	}
 
 	type result struct {
-		err error
+		examRecord *model.ExamRecord
+		questions  []model.Question
+		err        error
 	}
 
-	userId := "user01"
-	id := primitive.NewObjectID()
-	examId := id.Hex()
+	examObjectId := primitive.NewObjectID()
+	examId := examObjectId.Hex()
+	examRecordId := primitive.NewObjectID()
+	questionId01 := primitive.NewObjectID()
+	questionId02 := primitive.NewObjectID()
+	answers := []model.ExamAttemptAnswer{
+		{
+			QuestionId:       questionId02.Hex(),
+			Answer:           "banana",
+			IsCorrect:        false,
+			TimeSpentSeconds: 20,
+		},
+		{
+			QuestionId:       questionId01.Hex(),
+			Answer:           "apple",
+			IsCorrect:        true,
+			TimeSpentSeconds: 10,
+		},
+	}
+	examRecord := &model.ExamRecord{
+		Id:               examRecordId,
+		ExamId:           examId,
+		Score:            1,
+		QuestionIds:      []string{questionId02.Hex(), questionId01.Hex()},
+		WrongQuestionIds: []string{questionId02.Hex()},
+		Answers:          answers,
+		UserId:           "user01",
+	}
+	question01 := model.Question{
+		Id:      questionId01,
+		ExamId:  examId,
+		Ask:     "ask01",
+		Answers: []string{"apple"},
+	}
+	question02 := model.Question{
+		Id:      questionId02,
+		ExamId:  examId,
+		Ask:     "ask02",
+		Answers: []string{"cherry"},
+	}
 
 	testCases := []struct {
 		name     string
@@ -1086,57 +7299,111 @@ func (s *MyTestSuite) TestCreateExamRecord() {
 		on       func(s *MyTestSuite, args *args)
 	}{
 		{
-			name: "Create examRecord01",
+			name: "Get examRecord detail",
 			args: &args{
-				examId:           examId,
-				score:            9,
-				wrongQuestionIds: []string{"question01"},
-				userId:           userId,
+				examId:       examId,
+				examRecordId: examRecordId.Hex(),
+				userId:       "user01",
 			},
 			expected: &result{
-				err: nil,
+				examRecord: examRecord,
+				questions:  []model.Question{question02, question01},
+				err:        nil,
 			},
 			on: func(s *MyTestSuite, args *args) {
 				s.mockDatabaseRepository.EXPECT().
-					GetExamById(mock.Anything, args.examId).
-					Return(&model.Exam{
-						Id:          id,
-						Topic:       "topic",
-						Description: "desc",
-						Tags:        []string{"a01"},
-						IsPublic:    true,
-						UserId:      args.userId,
-					}, nil)
+					GetExamRecordById(mock.Anything, args.examRecordId).
+					Return(examRecord, nil)
 				s.mockDatabaseRepository.EXPECT().
-					WithTransaction(mock.Anything, mock.AnythingOfType("transactionFunc")).
-					Return(nil, nil)
+					FindQuestionsByQuestionIds(mock.Anything, examRecord.QuestionIds).
+					Return([]model.Question{question01, question02}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{Id: examObjectId, UserId: "user02"}, nil)
 			},
 		},
 		{
-			name: "Create examRecord02",
+			name: "Get examRecord detail without answers",
 			args: &args{
-				examId:           examId,
-				score:            8,
-				wrongQuestionIds: []string{"question01", "question02"},
-				userId:           userId,
+				examId:       examId,
+				examRecordId: examRecordId.Hex(),
+				userId:       "user01",
 			},
 			expected: &result{
+				examRecord: examRecord,
+				questions: []model.Question{
+					{
+						Id:          questionId02,
+						ExamId:      examId,
+						Ask:         "ask02",
+						Answers:     []string{},
+						AnswerCount: 1,
+					},
+					{
+						Id:          questionId01,
+						ExamId:      examId,
+						Ask:         "ask01",
+						Answers:     []string{},
+						AnswerCount: 1,
+					},
+				},
 				err: nil,
 			},
 			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamRecordById(mock.Anything, args.examRecordId).
+					Return(examRecord, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindQuestionsByQuestionIds(mock.Anything, examRecord.QuestionIds).
+					Return([]model.Question{question01, question02}, nil)
 				s.mockDatabaseRepository.EXPECT().
 					GetExamById(mock.Anything, args.examId).
 					Return(&model.Exam{
-						Id:          id,
-						Topic:       "topic",
-						Description: "desc",
-						Tags:        []string{"a01"},
-						IsPublic:    true,
-						UserId:      args.userId,
+						Id:     examObjectId,
+						UserId: "user02",
+						Settings: &model.ExamSettings{
+							ShowAnswersAfterSubmit: false,
+						},
 					}, nil)
+			},
+		},
+		{
+			name: "ExamRecord of other exam",
+			args: &args{
+				examId:       primitive.NewObjectID().Hex(),
+				examRecordId: examRecordId.Hex(),
+				userId:       "user01",
+			},
+			expected: &result{
+				examRecord: nil,
+				questions:  nil,
+				err: fmt.Errorf(
+					"GetExamRecordDetail failed: %w",
+					fmt.Errorf("Exam record not found by id: %s", examRecordId.Hex()),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {
 				s.mockDatabaseRepository.EXPECT().
-					WithTransaction(mock.Anything, mock.AnythingOfType("transactionFunc")).
-					Return(nil, nil)
+					GetExamRecordById(mock.Anything, args.examRecordId).
+					Return(examRecord, nil)
+			},
+		},
+		{
+			name: "ExamRecord of other user",
+			args: &args{
+				examId:       examId,
+				examRecordId: examRecordId.Hex(),
+				userId:       "user02",
+			},
+			expected: &result{
+				examRecord: nil,
+				questions:  nil,
+				err:        fmt.Errorf("GetExamRecordDetail failed: %w", unauthorizedOperationError),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamRecordById(mock.Anything, args.examRecordId).
+					Return(examRecord, nil)
 			},
 		},
 	}
@@ -1150,42 +7417,232 @@ func (s *MyTestSuite) TestCreateExamRecord() {
 			tc.on(s, args)
 
 			// Test
-			err := s.examService.CreateExamRecord(
+			examRecord, questions, err := s.examService.GetExamRecordDetail(
 				ctx,
 				args.examId,
-				args.score,
-				args.wrongQuestionIds,
+				args.examRecordId,
 				args.userId,
 			)
 
 			expected := tc.expected
+			s.Equal(expected.examRecord, examRecord)
+			s.Equal(expected.questions, questions)
 			s.Equal(expected.err, err)
 		})
 	}
 }
 
-func (s *MyTestSuite) TestFindExamRecords() {
+func (s *MyTestSuite) TestFindMistakeNotebook() {
+	userId := "user01"
+	examObjectId01 := primitive.NewObjectID()
+	examId01 := examObjectId01.Hex()
+	examId02 := primitive.NewObjectID().Hex()
+	questionId01 := primitive.NewObjectID()
+	questionId02 := primitive.NewObjectID()
+	answerWrongs := []model.AnswerWrong{
+		{
+			Id:         primitive.NewObjectID(),
+			ExamId:     examId01,
+			QuestionId: questionId01.Hex(),
+			Times:      2,
+			UserId:     userId,
+		},
+		{
+			Id:          primitive.NewObjectID(),
+			ExamId:      examId02,
+			ExamVersion: 1,
+			QuestionId:  questionId02.Hex(),
+			Times:       1,
+			UserId:      userId,
+		},
+	}
+	question01 := model.Question{Id: questionId01, ExamId: examId01, Ask: "ask01"}
+
+	s.mockDatabaseRepository.EXPECT().
+		FindAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetweenOrderByUpdatedAtDesc(
+			mock.Anything, userId, "", time.Time{}, time.Time{}, int32(0), int32(10)).
+		Return(answerWrongs, nil)
+	s.mockDatabaseRepository.EXPECT().
+		CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween(
+			mock.Anything, userId, "", time.Time{}, time.Time{}).
+		Return(int64(11), nil)
+	s.mockDatabaseRepository.EXPECT().
+		FindQuestionsByQuestionIds(mock.Anything, []string{questionId01.Hex()}).
+		Return([]model.Question{question01}, nil)
+	s.mockDatabaseRepository.EXPECT().
+		GetExamById(mock.Anything, examId01).
+		Return(&model.Exam{Id: examObjectId01, Topic: "topic01"}, nil)
+	s.mockDatabaseRepository.EXPECT().
+		GetExamById(mock.Anything, examId02).
+		Return(nil, nil)
+	s.mockDatabaseRepository.EXPECT().
+		GetExamVersionByExamIdAndVersion(mock.Anything, examId02, int32(1)).
+		Return(&model.ExamVersion{
+			ExamId:  examId02,
+			Version: 1,
+			Questions: []model.ExamVersionQuestion{
+				{
+					QuestionId:  questionId02.Hex(),
+					Ask:         "ask02",
+					Explanation: "explanation02",
+				},
+			},
+		}, nil)
+
+	// Test
+	total, pageCount, entries, err := s.examService.FindMistakeNotebook(
+		context.Background(),
+		userId,
+		"",
+		time.Time{},
+		time.Time{},
+		0,
+		10,
+	)
+	s.Nil(err)
+	s.Equal(int32(11), total)
+	s.Equal(int32(2), pageCount)
+	s.Len(entries, 2)
+	s.Equal(answerWrongs[0], entries[0].AnswerWrong)
+	s.Equal(&question01, entries[0].Question)
+	s.Equal("topic01", entries[0].ExamTopic)
+
+	// 已發佈版本的錯題使用當時的題目快照，測驗已被刪除時主題為空字串
+	s.Equal("ask02", entries[1].Question.Ask)
+	s.Equal("explanation02", entries[1].Question.Explanation)
+	s.Equal("", entries[1].ExamTopic)
+
+	// 開始日期必須早於結束日期
+	now := time.Now()
+	_, _, _, err = s.examService.FindMistakeNotebook(
+		context.Background(),
+		userId,
+		"",
+		now,
+		now,
+		0,
+		10,
+	)
+	s.ErrorContains(err, "Invalid date range")
+}
+
+func (s *MyTestSuite) TestCreateMistakeDrill() {
+	userId := "user01"
+	examObjectId := primitive.NewObjectID()
+	examId := examObjectId.Hex()
+	questionId01 := primitive.NewObjectID()
+	questionId02 := primitive.NewObjectID()
+
+	s.mockDatabaseRepository.EXPECT().
+		FindRandomAnswerWrongsByUserIdAndExamId(mock.Anything, userId, examId, int32(50)).
+		Return([]model.AnswerWrong{
+			{ExamId: examId, QuestionId: questionId01.Hex(), UserId: userId},
+			{ExamId: examId, QuestionId: questionId02.Hex(), UserId: userId},
+		}, nil)
+	s.mockDatabaseRepository.EXPECT().
+		FindQuestionsByQuestionIds(
+			mock.Anything,
+			[]string{questionId01.Hex(), questionId02.Hex()},
+		).
+		Return([]model.Question{
+			{
+				Id:          questionId01,
+				ExamId:      examId,
+				Ask:         "ask01",
+				Answers:     []string{"a01"},
+				Explanation: "explanation01",
+			},
+		}, nil)
+	// 其他使用者題庫中的題目不屬於此測驗
+	s.mockDatabaseRepository.EXPECT().
+		FindBankQuestionsByIds(mock.Anything, []string{questionId02.Hex()}).
+		Return([]model.BankQuestion{
+			{Id: questionId02, Ask: "ask02", Tags: []string{"tag01"}, UserId: "user02"},
+		}, nil)
+	s.mockDatabaseRepository.EXPECT().
+		GetExamById(mock.Anything, examId).
+		Return(&model.Exam{
+			Id:     examObjectId,
+			Topic:  "topic01",
+			UserId: userId,
+			BankSource: &model.ExamBankSource{
+				Pools: []model.ExamBankPool{{Tag: "tag01", Size: 1}},
+			},
+		}, nil)
+
+	// Test
+	entries, requiredCorrectStreak, err := s.examService.CreateMistakeDrill(
+		context.Background(),
+		userId,
+		examId,
+		100,
+	)
+	s.Nil(err)
+	s.Equal(int32(mistakeDrillRequiredCorrectStreak), requiredCorrectStreak)
+
+	// 已被刪除或不屬於測驗的題目不會出現，題目不回傳答案和解說
+	s.Len(entries, 1)
+	s.Equal("ask01", entries[0].Question.Ask)
+	s.Empty(entries[0].Question.Answers)
+	s.Equal(int32(1), entries[0].Question.AnswerCount)
+	s.Equal("", entries[0].Question.Explanation)
+}
+
+func (s *MyTestSuite) TestAnswerMistakeDrill() {
 	type args struct {
-		pageIndex int32
-		pageSize  int32
-		examId    string
-		userId    string
+		answerWrongId string
+		userId        string
+		blankAnswers  []string
 	}
 
 	type result struct {
-		total       int32
-		pageCount   int32
-		examRecords []model.ExamRecord
-		err         error
+		isCorrect      bool
+		correctAnswers []string
+		correctStreak  int32
+		removed        bool
+		err            error
 	}
 
-	id := primitive.NewObjectID()
-	examId := id.Hex()
-	userId := "user01"
-	mockExamRecords := []model.ExamRecord{
-		{},
-		{},
-		{},
+	answerWrongId := primitive.NewObjectID().Hex()
+	examObjectId := primitive.NewObjectID()
+	examId := examObjectId.Hex()
+	questionId := primitive.NewObjectID().Hex()
+	answerWrong := &model.AnswerWrong{
+		ExamId:        examId,
+		ExamVersion:   2,
+		QuestionId:    questionId,
+		CorrectStreak: 1,
+		UserId:        "user01",
+	}
+	exam := &model.Exam{
+		Id:            examObjectId,
+		IsPublic:      true,
+		UserId:        "user02",
+		LatestVersion: 2,
+	}
+	examVersion := &model.ExamVersion{
+		ExamId:  examId,
+		Version: 2,
+		Questions: []model.ExamVersionQuestion{
+			{
+				QuestionId: questionId,
+				Ask:        "ask01",
+				Answers:    []string{"red", "blue"},
+			},
+		},
+	}
+
+	// 以答錯時測驗版本的題目評分
+	onFindQuestion := func(s *MyTestSuite, args *args, exam *model.Exam) {
+		s.mockDatabaseRepository.EXPECT().
+			GetAnswerWrongById(mock.Anything, args.answerWrongId).
+			Return(answerWrong, nil)
+		s.mockDatabaseRepository.EXPECT().
+			GetExamById(mock.Anything, examId).
+			Return(exam, nil)
+		s.mockDatabaseRepository.EXPECT().
+			GetExamVersionByExamIdAndVersion(mock.Anything, examId, int32(2)).
+			Return(examVersion, nil)
 	}
 
 	testCases := []struct {
@@ -1195,61 +7652,138 @@ func (s *MyTestSuite) TestFindExamRecords() {
 		on       func(s *MyTestSuite, args *args)
 	}{
 		{
-			name: "Find examRecords by page1",
+			name: "Answer correctly",
 			args: &args{
-				pageIndex: 0,
-				pageSize:  10,
-				examId:    examId,
-				userId:    userId,
+				answerWrongId: answerWrongId,
+				userId:        "user01",
+				blankAnswers:  []string{" red", "blue "},
 			},
 			expected: &result{
-				total:       3,
-				pageCount:   1,
-				examRecords: mockExamRecords,
-				err:         nil,
+				isCorrect:      true,
+				correctAnswers: []string{"red", "blue"},
+				correctStreak:  2,
+				removed:        false,
+				err:            nil,
 			},
 			on: func(s *MyTestSuite, args *args) {
+				onFindQuestion(s, args, exam)
 				s.mockDatabaseRepository.EXPECT().
-					FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc(
-						mock.Anything,
-						args.examId,
-						args.userId,
-						args.pageIndex*args.pageSize,
-						args.pageSize,
-					).
-					Return(mockExamRecords, nil)
+					WithTransaction(mock.Anything, mock.AnythingOfType("transactionFunc")).
+					Return(int32(2), nil)
+			},
+		},
+		{
+			name: "Answer correctly and remove from notebook",
+			args: &args{
+				answerWrongId: answerWrongId,
+				userId:        "user01",
+				blankAnswers:  []string{"red", "blue"},
+			},
+			expected: &result{
+				isCorrect:      true,
+				correctAnswers: []string{"red", "blue"},
+				correctStreak:  3,
+				removed:        true,
+				err:            nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				onFindQuestion(s, args, exam)
 				s.mockDatabaseRepository.EXPECT().
-					CountExamRecordsByExamIdAndUserId(mock.Anything, args.examId, args.userId).
+					WithTransaction(mock.Anything, mock.AnythingOfType("transactionFunc")).
 					Return(int32(3), nil)
 			},
 		},
 		{
-			name: "Find examRecords by page2",
+			name: "Answer wrong",
 			args: &args{
-				pageIndex: 1,
-				pageSize:  10,
-				examId:    examId,
-				userId:    userId,
+				answerWrongId: answerWrongId,
+				userId:        "user01",
+				blankAnswers:  []string{"red", "green"},
+			},
+			expected: &result{
+				isCorrect:      false,
+				correctAnswers: []string{"red", "blue"},
+				correctStreak:  0,
+				removed:        false,
+				err:            nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				onFindQuestion(s, args, exam)
+				s.mockDatabaseRepository.EXPECT().
+					UpsertAnswerWrongByTimesPlusOne(
+						mock.Anything, examId, int32(2), questionId, args.userId).
+					Return(int32(1), int32(0), nil)
+			},
+		},
+		{
+			name: "Answer wrong of exam without answers",
+			args: &args{
+				answerWrongId: answerWrongId,
+				userId:        "user01",
+				blankAnswers:  []string{"red"},
+			},
+			expected: &result{
+				isCorrect:      false,
+				correctAnswers: []string{},
+				correctStreak:  0,
+				removed:        false,
+				err:            nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				onFindQuestion(s, args, &model.Exam{
+					Id:            examObjectId,
+					IsPublic:      true,
+					UserId:        "user02",
+					LatestVersion: 2,
+					Settings: &model.ExamSettings{
+						ShowAnswersAfterSubmit: false,
+					},
+				})
+				s.mockDatabaseRepository.EXPECT().
+					UpsertAnswerWrongByTimesPlusOne(
+						mock.Anything, examId, int32(2), questionId, args.userId).
+					Return(int32(1), int32(0), nil)
+			},
+		},
+		{
+			name: "Question not found",
+			args: &args{
+				answerWrongId: answerWrongId,
+				userId:        "user01",
+				blankAnswers:  []string{"red", "blue"},
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"AnswerMistakeDrill failed: %w",
+					fmt.Errorf("Question not found by id: %s", questionId),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetAnswerWrongById(mock.Anything, args.answerWrongId).
+					Return(answerWrong, nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, examId).
+					Return(exam, nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetExamVersionByExamIdAndVersion(mock.Anything, examId, int32(2)).
+					Return(nil, nil)
+			},
+		},
+		{
+			name: "Answer other user's mistake",
+			args: &args{
+				answerWrongId: answerWrongId,
+				userId:        "user02",
+				blankAnswers:  []string{"red", "blue"},
 			},
 			expected: &result{
-				total:       13,
-				pageCount:   2,
-				examRecords: mockExamRecords,
-				err:         nil,
+				err: fmt.Errorf("AnswerMistakeDrill failed: %w", unauthorizedOperationError),
 			},
 			on: func(s *MyTestSuite, args *args) {
 				s.mockDatabaseRepository.EXPECT().
-					FindExamRecordsByExamIdAndUserIdOrderByUpdateAtDesc(
-						mock.Anything,
-						args.examId,
-						args.userId,
-						args.pageIndex*args.pageSize,
-						args.pageSize,
-					).
-					Return(mockExamRecords, nil)
-				s.mockDatabaseRepository.EXPECT().
-					CountExamRecordsByExamIdAndUserId(mock.Anything, args.examId, args.userId).
-					Return(int32(13), nil)
+					GetAnswerWrongById(mock.Anything, args.answerWrongId).
+					Return(answerWrong, nil)
 			},
 		},
 	}
@@ -1263,68 +7797,38 @@ func (s *MyTestSuite) TestFindExamRecords() {
 			tc.on(s, args)
 
 			// Test
-			total, pageCount, examRecords, err := s.examService.FindExamRecords(
+			isCorrect, correctAnswers, correctStreak, removed, err := s.examService.AnswerMistakeDrill(
 				ctx,
-				args.pageIndex,
-				args.pageSize,
-				args.examId,
+				args.answerWrongId,
 				args.userId,
+				args.blankAnswers,
 			)
-
 			expected := tc.expected
-			s.Equal(expected.total, total)
-			s.Equal(expected.pageCount, pageCount)
-			s.Equal(expected.examRecords, examRecords)
+			s.Equal(expected.isCorrect, isCorrect)
+			s.Equal(expected.correctAnswers, correctAnswers)
+			s.Equal(expected.correctStreak, correctStreak)
+			s.Equal(expected.removed, removed)
 			s.Equal(expected.err, err)
 		})
 	}
 }
 
-func (s *MyTestSuite) TestFindExamRecordOverview() {
+func (s *MyTestSuite) TestCreateBankQuestion() {
 	type args struct {
-		examId    string
-		userId    string
-		startDate time.Time
+		ask     string
+		answers []string
+		tags    []string
+		points  int32
+		userId  string
 	}
 
 	type result struct {
-		strStartDate string
-		exam         *model.Exam
-		questions    []model.Question
-		answerWrongs []model.AnswerWrong
-		examRecords  []model.ExamRecord
-		err          error
+		bankQuestionId string
+		err            error
 	}
 
 	userId := "user01"
 
-	examId := "exam01"
-	mockExam := &model.Exam{}
-	mockQuestions := []model.Question{{}}
-	mockWrongAnswers := []model.AnswerWrong{
-		{
-			ExamId:     examId,
-			QuestionId: "q01",
-			Times:      1,
-			UserId:     userId,
-		},
-		{
-			ExamId:     examId,
-			QuestionId: "q02",
-			Times:      2,
-			UserId:     userId,
-		},
-	}
-	mockExamRecords := []model.ExamRecord{{}}
-	startDate := time.Now()
-
-	examId02 := "exam02"
-	mockExam02 := &model.Exam{}
-	mockQuestions02 := []model.Question{}
-	mockWrongAnswers02 := []model.AnswerWrong{}
-	mockExamRecords02 := []model.ExamRecord{{}, {}}
-	startDate02 := time.Now()
-
 	testCases := []struct {
 		name     string
 		args     *args
@@ -1332,80 +7836,46 @@ func (s *MyTestSuite) TestFindExamRecordOverview() {
 		on       func(s *MyTestSuite, args *args)
 	}{
 		{
-			name: "Find exam01 examRecordOverview",
+			name: "Create bank question",
 			args: &args{
-				examId:    examId,
-				userId:    userId,
-				startDate: startDate,
+				ask:     "ask01",
+				answers: []string{"a01"},
+				tags:    []string{" Grammar ", "grammar", "TOEIC"},
+				points:  2,
+				userId:  userId,
 			},
 			expected: &result{
-				strStartDate: startDate.Format("2006/01/02"),
-				exam:         mockExam,
-				questions:    mockQuestions,
-				answerWrongs: mockWrongAnswers,
-				examRecords:  mockExamRecords,
-				err:          nil,
+				bankQuestionId: "bankQuestion01",
+				err:            nil,
 			},
 			on: func(s *MyTestSuite, args *args) {
+				// 標籤會正規化並移除重複的標籤
 				s.mockDatabaseRepository.EXPECT().
-					GetExamById(
-						mock.Anything,
-						args.examId,
-					).
-					Return(mockExam, nil)
-				s.mockDatabaseRepository.EXPECT().
-					FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc(
-						mock.Anything,
-						args.examId,
-						args.userId,
-						int32(10)).
-					Return(mockWrongAnswers, nil)
-				s.mockDatabaseRepository.EXPECT().
-					FindQuestionsByQuestionIds(mock.Anything, []string{"q01", "q02"}).
-					Return(mockQuestions, nil)
-				s.mockDatabaseRepository.EXPECT().
-					FindExamRecordsByExamIdAndUserIdAndCreatedAt(
-						mock.Anything, args.examId, args.userId, args.startDate).
-					Return(mockExamRecords, nil)
+					CreateBankQuestion(mock.Anything, model.BankQuestion{
+						Ask:     args.ask,
+						Answers: args.answers,
+						Tags:    []string{"grammar", "toeic"},
+						Points:  args.points,
+						UserId:  args.userId,
+					}).
+					Return("bankQuestion01", nil)
 			},
 		},
 		{
-			name: "Find exam02 examRecordOverview",
+			name: "Answers is empty",
 			args: &args{
-				examId:    examId02,
-				userId:    userId,
-				startDate: startDate02,
+				ask:     "ask02",
+				answers: []string{" "},
+				userId:  userId,
 			},
 			expected: &result{
-				strStartDate: startDate02.Format("2006/01/02"),
-				exam:         mockExam02,
-				questions:    mockQuestions02,
-				answerWrongs: mockWrongAnswers02,
-				examRecords:  mockExamRecords02,
-				err:          nil,
-			},
-			on: func(s *MyTestSuite, args *args) {
-				s.mockDatabaseRepository.EXPECT().
-					GetExamById(
-						mock.Anything,
-						args.examId,
-					).
-					Return(mockExam02, nil)
-				s.mockDatabaseRepository.EXPECT().
-					FindAnswerWrongsByExamIdAndUserIdOrderByTimesDesc(
-						mock.Anything,
-						args.examId,
-						args.userId,
-						int32(10)).
-					Return(mockWrongAnswers02, nil)
-				s.mockDatabaseRepository.EXPECT().
-					FindQuestionsByQuestionIds(mock.Anything, []string{}).
-					Return(mockQuestions02, nil)
-				s.mockDatabaseRepository.EXPECT().
-					FindExamRecordsByExamIdAndUserIdAndCreatedAt(
-						mock.Anything, args.examId, args.userId, args.startDate).
-					Return(mockExamRecords02, nil)
+				bankQuestionId: "",
+				err: fmt.Errorf(
+					"CreateBankQuestion failed: %w",
+					fmt.Errorf("Answers is empty"),
+				),
 			},
+			on: func(s *MyTestSuite, args *args) {},
 		},
 	}
 
@@ -1418,49 +7888,99 @@ func (s *MyTestSuite) TestFindExamRecordOverview() {
 			tc.on(s, args)
 
 			// Test
-			strStartDate, exam, questions, answerWrongs, examRecords, err := s.examService.FindExamRecordOverview(
+			bankQuestionId, err := s.examService.CreateBankQuestion(
 				ctx,
-				args.examId,
+				args.ask,
+				args.answers,
+				args.tags,
+				args.points,
+				false,
+				"",
+				"",
 				args.userId,
-				args.startDate,
 			)
 
 			expected := tc.expected
-			s.Equal(expected.strStartDate, strStartDate)
-			s.Equal(expected.exam, exam)
-			s.Equal(expected.questions, questions)
-			s.Equal(expected.answerWrongs, answerWrongs)
-			s.Equal(expected.examRecords, examRecords)
+			s.Equal(expected.bankQuestionId, bankQuestionId)
 			s.Equal(expected.err, err)
 		})
 	}
 }
 
-func (s *MyTestSuite) TestFindExamInfos() {
+func (s *MyTestSuite) TestUpdateBankQuestion_WhenUserIsNotOwner() {
+	bankQuestionId := primitive.NewObjectID().Hex()
+
+	s.mockDatabaseRepository.EXPECT().
+		GetBankQuestionById(mock.Anything, bankQuestionId).
+		Return(&model.BankQuestion{UserId: "user02"}, nil)
+
+	// Test
+	_, err := s.examService.UpdateBankQuestion(
+		context.Background(),
+		bankQuestionId,
+		"ask01",
+		[]string{"a01"},
+		[]string{},
+		0,
+		false,
+		"",
+		"",
+		"user01",
+	)
+	s.Equal(fmt.Errorf("UpdateBankQuestion failed: %w", unauthorizedOperationError), err)
+}
+
+func (s *MyTestSuite) TestFindBankQuestions() {
+	userId := "user01"
+	bankQuestions := []model.BankQuestion{
+		{Id: primitive.NewObjectID(), Ask: "ask01", Tags: []string{"grammar"}, UserId: userId},
+	}
+
+	s.mockDatabaseRepository.EXPECT().
+		FindBankQuestionsByUserIdAndTagOrderByUpdateAtDesc(
+			mock.Anything,
+			userId,
+			"grammar",
+			int32(10),
+			int32(10),
+		).
+		Return(bankQuestions, nil)
+	s.mockDatabaseRepository.EXPECT().
+		CountBankQuestionsByUserIdAndTag(mock.Anything, userId, "grammar").
+		Return(11, nil)
+
+	// Test
+	total, pageCount, result, err := s.examService.FindBankQuestions(
+		context.Background(),
+		1,
+		10,
+		" Grammar",
+		userId,
+	)
+	s.Nil(err)
+	s.Equal(int32(11), total)
+	s.Equal(int32(2), pageCount)
+	s.Equal(bankQuestions, result)
+}
+
+func (s *MyTestSuite) TestSetExamBankSource() {
 	type args struct {
-		userId   string
-		isPublic bool
+		examId      string
+		questionIds []string
+		pools       []model.ExamBankPool
+		userId      string
 	}
 
 	type result struct {
-		examInfos []ExamInfo
-		err       error
+		bankSource *model.ExamBankSource
+		err        error
 	}
 
 	userId := "user01"
-
-	id01 := primitive.NewObjectID()
-	examId01 := id01.Hex()
-
-	id02 := primitive.NewObjectID()
-	examId02 := id02.Hex()
-
-	var (
-		questionCount1 int32 = 10
-		questionCount2 int32 = 20
-		recordCount1   int32 = 1
-		recordCount2   int32 = 2
-	)
+	id := primitive.NewObjectID()
+	examId := id.Hex()
+	bankQuestionId01 := primitive.NewObjectID()
+	bankQuestionId02 := primitive.NewObjectID()
 
 	testCases := []struct {
 		name     string
@@ -1469,109 +7989,116 @@ func (s *MyTestSuite) TestFindExamInfos() {
 		on       func(s *MyTestSuite, args *args)
 	}{
 		{
-			name: "Find examInfos by isPublic true",
+			name: "Set bank source",
 			args: &args{
-				userId:   userId,
-				isPublic: true,
+				examId:      examId,
+				questionIds: []string{bankQuestionId01.Hex(), bankQuestionId01.Hex()},
+				pools:       []model.ExamBankPool{{Tag: " Grammar", Size: 5}},
+				userId:      userId,
 			},
 			expected: &result{
-				examInfos: []ExamInfo{
-					{
-						ExamId:        examId01,
-						Topic:         "topic01",
-						Description:   "desc01",
-						IsPublic:      true,
-						QuestionCount: questionCount1,
-						RecordCount:   recordCount1,
-					},
-					{
-						ExamId:        examId02,
-						Topic:         "topic02",
-						Description:   "desc02",
-						IsPublic:      true,
-						QuestionCount: questionCount2,
-						RecordCount:   recordCount2,
-					},
+				bankSource: &model.ExamBankSource{
+					QuestionIds: []string{bankQuestionId01.Hex()},
+					Pools:       []model.ExamBankPool{{Tag: "grammar", Size: 5}},
 				},
 				err: nil,
 			},
 			on: func(s *MyTestSuite, args *args) {
 				s.mockDatabaseRepository.EXPECT().
-					FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc(
-						mock.Anything, args.userId, args.isPublic).
-					Return([]model.Exam{
-						{
-							Id:          id01,
-							Topic:       "topic01",
-							Description: "desc01",
-							Tags:        []string{"a01"},
-							IsPublic:    args.isPublic,
-							UserId:      args.userId,
-						},
-						{
-							Id:          id02,
-							Topic:       "topic02",
-							Description: "desc02",
-							Tags:        []string{"a02"},
-							IsPublic:    args.isPublic,
-							UserId:      args.userId,
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{Id: id, UserId: userId}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindBankQuestionsByIds(mock.Anything, []string{bankQuestionId01.Hex()}).
+					Return([]model.BankQuestion{{Id: bankQuestionId01, UserId: userId}}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					UpdateExam(mock.Anything, mock.AnythingOfType("model.Exam")).
+					Return(nil)
+			},
+		},
+		{
+			name: "Clear bank source",
+			args: &args{
+				examId: examId,
+				userId: userId,
+			},
+			expected: &result{
+				bankSource: nil,
+				err:        nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Id:     id,
+						UserId: userId,
+						BankSource: &model.ExamBankSource{
+							QuestionIds: []string{bankQuestionId01.Hex()},
 						},
 					}, nil)
 				s.mockDatabaseRepository.EXPECT().
-					CountQuestionsByExamId(mock.Anything, examId01).
-					Return(questionCount1, nil)
-				s.mockDatabaseRepository.EXPECT().
-					CountQuestionsByExamId(mock.Anything, examId02).
-					Return(questionCount2, nil)
+					UpdateExam(mock.Anything, model.Exam{Id: id, UserId: userId}).
+					Return(nil)
+			},
+		},
+		{
+			name: "Reference bank question of other user",
+			args: &args{
+				examId:      examId,
+				questionIds: []string{bankQuestionId02.Hex()},
+				userId:      userId,
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"SetExamBankSource failed: %w",
+					fmt.Errorf("BankQuestion not found by id: %s", bankQuestionId02.Hex()),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {
 				s.mockDatabaseRepository.EXPECT().
-					CountExamRecordsByExamIdAndUserId(mock.Anything, examId01, args.userId).
-					Return(recordCount1, nil)
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{Id: id, UserId: userId}, nil)
 				s.mockDatabaseRepository.EXPECT().
-					CountExamRecordsByExamIdAndUserId(mock.Anything, examId02, args.userId).
-					Return(recordCount2, nil)
+					FindBankQuestionsByIds(mock.Anything, []string{bankQuestionId02.Hex()}).
+					Return([]model.BankQuestion{{Id: bankQuestionId02, UserId: "user02"}}, nil)
 			},
 		},
 		{
-			name: "Find examInfos by isPublic false",
+			name: "Editor can not set bank source",
 			args: &args{
-				userId:   userId,
-				isPublic: false,
+				examId: examId,
+				pools:  []model.ExamBankPool{{Tag: "grammar", Size: 5}},
+				userId: userId,
 			},
 			expected: &result{
-				examInfos: []ExamInfo{
-					{
-						ExamId:        examId01,
-						Topic:         "topic01",
-						Description:   "desc01",
-						IsPublic:      false,
-						QuestionCount: questionCount1,
-						RecordCount:   recordCount1,
-					},
-				},
-				err: nil,
+				err: fmt.Errorf("SetExamBankSource failed: %w", unauthorizedOperationError),
 			},
 			on: func(s *MyTestSuite, args *args) {
 				s.mockDatabaseRepository.EXPECT().
-					FindExamsByUserIdAndIsPublicOrderByUpdateAtDesc(
-						mock.Anything, args.userId, args.isPublic).
-					Return([]model.Exam{
-						{
-							Id:          id01,
-							Topic:       "topic01",
-							Description: "desc01",
-							Tags:        []string{"a01"},
-							IsPublic:    args.isPublic,
-							UserId:      args.userId,
+					GetExamById(mock.Anything, args.examId).
+					Return(&model.Exam{
+						Id:     id,
+						UserId: "user02",
+						Shares: []model.ExamShare{
+							{UserId: userId, Role: EXAM_ROLE_EDITOR},
 						},
 					}, nil)
-				s.mockDatabaseRepository.EXPECT().
-					CountQuestionsByExamId(mock.Anything, examId01).
-					Return(questionCount1, nil)
-				s.mockDatabaseRepository.EXPECT().
-					CountExamRecordsByExamIdAndUserId(mock.Anything, examId01, args.userId).
-					Return(recordCount1, nil)
 			},
 		},
+		{
+			name: "Invalid pool size",
+			args: &args{
+				examId: examId,
+				pools:  []model.ExamBankPool{{Tag: "grammar", Size: 0}},
+				userId: userId,
+			},
+			expected: &result{
+				err: fmt.Errorf(
+					"SetExamBankSource failed: %w",
+					fmt.Errorf("Invalid pool size: %d", 0),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
 	}
 
 	ctx := context.Background()
@@ -1583,15 +8110,119 @@ func (s *MyTestSuite) TestFindExamInfos() {
 			tc.on(s, args)
 
 			// Test
-			examInfos, err := s.examService.FindExamInfos(
+			exam, err := s.examService.SetExamBankSource(
 				ctx,
+				args.examId,
+				args.questionIds,
+				args.pools,
 				args.userId,
-				args.isPublic,
 			)
 
 			expected := tc.expected
-			s.Equal(expected.examInfos, examInfos)
 			s.Equal(expected.err, err)
+
+			if expected.err == nil {
+				s.Equal(expected.bankSource, exam.BankSource)
+			}
 		})
 	}
 }
+
+func (s *MyTestSuite) TestFindRandomQuestions_WhenExamHasBankSource() {
+	id := primitive.NewObjectID()
+	examId := id.Hex()
+	userId := "user01"
+	fixedBankQuestion := model.BankQuestion{
+		Id:     primitive.NewObjectID(),
+		Ask:    "ask02",
+		UserId: userId,
+	}
+	poolBankQuestion := model.BankQuestion{
+		Id:     primitive.NewObjectID(),
+		Ask:    "ask03",
+		UserId: userId,
+	}
+	mockExam := &model.Exam{
+		Id:     id,
+		UserId: userId,
+		Settings: &model.ExamSettings{
+			ShuffleQuestions: false,
+		},
+		BankSource: &model.ExamBankSource{
+			QuestionIds: []string{fixedBankQuestion.Id.Hex()},
+			Pools:       []model.ExamBankPool{{Tag: "grammar", Size: 2}},
+		},
+	}
+	question := model.Question{Id: primitive.NewObjectID(), ExamId: examId, Ask: "ask01"}
+
+	s.mockDatabaseRepository.EXPECT().
+		GetExamById(mock.Anything, examId).
+		Return(mockExam, nil)
+	s.mockDatabaseRepository.EXPECT().
+		FindExamSectionsByExamIdOrderByOrderAsc(mock.Anything, examId).
+		Return([]model.ExamSection{}, nil)
+	s.mockDatabaseRepository.EXPECT().
+		FindQuestionsByExamId(mock.Anything, examId).
+		Return([]model.Question{question}, nil)
+	s.mockDatabaseRepository.EXPECT().
+		FindBankQuestionsByIds(mock.Anything, []string{fixedBankQuestion.Id.Hex()}).
+		Return([]model.BankQuestion{fixedBankQuestion}, nil)
+
+	// 隨機抽出固定引用的題目時只會出現一次
+	s.mockDatabaseRepository.EXPECT().
+		FindRandomBankQuestionsByUserIdAndTag(mock.Anything, userId, "grammar", int32(2)).
+		Return([]model.BankQuestion{poolBankQuestion, fixedBankQuestion}, nil)
+	s.mockDatabaseRepository.EXPECT().
+		CreateExamAttempt(mock.Anything, mock.AnythingOfType("model.ExamAttempt")).
+		Return("examAttempt01", nil)
+
+	// Test
+	exam, questions, sections, _, err := s.examService.FindRandomQuestions(
+		context.Background(),
+		examId,
+		userId,
+		10,
+	)
+	s.Nil(err)
+	s.Equal(mockExam, exam)
+	s.Empty(sections)
+	s.Equal(hideQuestionAnswers([]model.Question{
+		question,
+		bankQuestionToQuestion(fixedBankQuestion, examId),
+		bankQuestionToQuestion(poolBankQuestion, examId),
+	}), questions)
+}
+
+func (s *MyTestSuite) TestExamVersionAttemptQuestions() {
+	fixedQuestionId := primitive.NewObjectID().Hex()
+	candidateIds := []string{
+		primitive.NewObjectID().Hex(),
+		primitive.NewObjectID().Hex(),
+		primitive.NewObjectID().Hex(),
+	}
+	examVersion := &model.ExamVersion{
+		Questions: []model.ExamVersionQuestion{
+			{QuestionId: fixedQuestionId},
+			{QuestionId: candidateIds[0]},
+			{QuestionId: candidateIds[1]},
+			{QuestionId: candidateIds[2]},
+		},
+		BankPools: []model.ExamVersionBankPool{
+			{Tag: "grammar", Size: 2, QuestionIds: candidateIds},
+		},
+	}
+
+	// 固定的題目每次都會出現，候選題目只出現抽出的題數
+	questions := examVersionAttemptQuestions(
+		examVersion,
+		rand.New(rand.NewSource(time.Now().UnixNano())),
+	)
+	s.Len(questions, 3)
+	s.Equal(fixedQuestionId, questions[0].Id.Hex())
+	s.Equal(int32(3), examVersionAttemptQuestionCount(*examVersion))
+
+	// 沒有隨機抽題的版本回傳全部的題目
+	examVersion.BankPools = nil
+	s.Len(examVersionAttemptQuestions(examVersion, nil), 4)
+	s.Equal(int32(4), examVersionAttemptQuestionCount(*examVersion))
+}
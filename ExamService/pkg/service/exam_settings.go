@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/model"
+)
+
+// 沒有設定過的測驗使用的預設設定，與加入設定之前的行為相同
+func defaultExamSettings() model.ExamSettings {
+	return model.ExamSettings{
+		ShuffleQuestions:       true,
+		ShowAnswersAfterSubmit: true,
+	}
+}
+
+// 測驗實際使用的設定，exam 為 nil 或沒有設定過時回傳預設的設定
+func ExamSettingsOf(exam *model.Exam) model.ExamSettings {
+	if exam == nil || exam.Settings == nil {
+		return defaultExamSettings()
+	}
+
+	return *exam.Settings
+}
+
+func validateExamSettings(settings model.ExamSettings) error {
+	if settings.QuestionsPerAttempt < 0 {
+		return fmt.Errorf("Invalid questionsPerAttempt: %d", settings.QuestionsPerAttempt)
+	}
+
+	if settings.PassingScorePercent < 0 || settings.PassingScorePercent > 100 {
+		return fmt.Errorf("Invalid passingScorePercent: %d", settings.PassingScorePercent)
+	}
+
+	if settings.MaxAttempts < 0 {
+		return fmt.Errorf("Invalid maxAttempts: %d", settings.MaxAttempts)
+	}
+
+	if settings.HintPenaltyPercent < 0 || settings.HintPenaltyPercent > 100 {
+		return fmt.Errorf("Invalid hintPenaltyPercent: %d", settings.HintPenaltyPercent)
+	}
+
+	return nil
+}
+
+// 作答次數已達上限，呼叫端可以用 errors.Is 判斷
+var MaxAttemptsReachedError = fmt.Errorf("Max attempts reached")
+
+func maxAttemptsReachedError(maxAttempts int32) error {
+	return fmt.Errorf("%w: %d", MaxAttemptsReachedError, maxAttempts)
+}
+
+// 檢查使用者的作答次數是否已達上限，擁有者與共同編輯者不受限制，用於開始作答前的檢查
+func (examService examService) checkMaxAttempts(
+	ctx context.Context,
+	exam *model.Exam,
+	settings model.ExamSettings,
+	userId string,
+) error {
+	if settings.MaxAttempts == 0 || canEditExam(exam, userId) {
+		return nil
+	}
+
+	count, err := examService.databaseRepository.CountExamRecordsByExamIdAndUserId(
+		ctx,
+		exam.Id.Hex(),
+		userId,
+	)
+	if err != nil {
+		return err
+	}
+
+	if count >= settings.MaxAttempts {
+		return maxAttemptsReachedError(settings.MaxAttempts)
+	}
+
+	return nil
+}
+
+/*
+交卷時在交易中遞增使用者的作答次數，已達上限時回傳 MaxAttemptsReachedError，
+計數器使用條件更新，同時交卷也不會超過上限，擁有者與共同編輯者不受限制
+*/
+func (examService examService) increaseExamAttemptCount(
+	ctx context.Context,
+	exam *model.Exam,
+	settings model.ExamSettings,
+	userId string,
+) error {
+	if settings.MaxAttempts == 0 || canEditExam(exam, userId) {
+		return nil
+	}
+
+	databaseRepository := examService.databaseRepository
+	examId := exam.Id.Hex()
+
+	// 建立計數器之前的作答紀錄也要列入次數
+	count, err := databaseRepository.CountExamRecordsByExamIdAndUserId(ctx, examId, userId)
+	if err != nil {
+		return err
+	}
+
+	increased, err := databaseRepository.IncreaseExamAttemptCount(
+		ctx,
+		examId,
+		userId,
+		count,
+		settings.MaxAttempts,
+	)
+	if err != nil {
+		return err
+	}
+
+	if !increased {
+		return maxAttemptsReachedError(settings.MaxAttempts)
+	}
+
+	return nil
+}
+
+// 測驗設定公布答案時，或使用者是擁有者或共同編輯者時，可以查看題目的答案
+func canViewExamAnswers(exam *model.Exam, userId string) bool {
+	return ExamSettingsOf(exam).ShowAnswersAfterSubmit || canEditExam(exam, userId)
+}
+
+// 打亂每個題目選項的順序，複製選項避免修改到題目快照共用的 slice
+func shuffleQuestionChoices(questions []model.Question, rng *rand.Rand) {
+	for i := range questions {
+		choices := append([]string{}, questions[i].Choices...)
+		rng.Shuffle(len(choices), func(j, k int) {
+			choices[j], choices[k] = choices[k], choices[j]
+		})
+		questions[i].Choices = choices
+	}
+}
+
+/*
+移除題目的答案、提示和解說，用於作答時和交卷後不能查看答案的測驗，
+保留答案的數量用來顯示空格，提示要另外查看，由伺服器記錄查看提示的題目
+*/
+func hideQuestionAnswers(questions []model.Question) []model.Question {
+	result := []model.Question{}
+
+	for _, question := range questions {
+		question.AnswerCount = int32(len(question.Answers))
+		question.Answers = []string{}
+		question.AcceptedAnswers = nil
+		question.HasHint = question.Hint != ""
+		question.Hint = ""
+		question.Explanation = ""
+		result = append(result, question)
+	}
+
+	return result
+}
+
+// 百分比分數達到及格分數百分比即為及格
+func isPassed(percentage float64, passingScorePercent int32) bool {
+	return percentage >= float64(passingScorePercent)
+}
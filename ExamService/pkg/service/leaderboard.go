@@ -0,0 +1,32 @@
+package service
+
+import (
+	"fmt"
+	"time"
+)
+
+// 排行榜的期間
+const (
+	LEADERBOARD_WINDOW_WEEKLY   = "weekly"   // 本週，從星期一 00:00 UTC 開始
+	LEADERBOARD_WINDOW_ALL_TIME = "all_time" // 全部期間
+)
+
+// 排行榜最多回傳的筆數
+const maxLeaderboardSize = 100
+
+// 回傳排行榜期間的開始時間，全部期間回傳零值
+func leaderboardStartAt(window string, now time.Time) (time.Time, error) {
+	switch window {
+	case "", LEADERBOARD_WINDOW_ALL_TIME:
+		return time.Time{}, nil
+	case LEADERBOARD_WINDOW_WEEKLY:
+		now = now.UTC()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+		// time.Sunday 是 0，往前推到星期一
+		days := (int(today.Weekday()) + 6) % 7
+		return today.AddDate(0, 0, -days), nil
+	default:
+		return time.Time{}, fmt.Errorf("Invalid window: %s", window)
+	}
+}
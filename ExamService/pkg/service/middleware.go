@@ -7,6 +7,7 @@ import (
 	"github.com/go-kit/log"
 
 	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/model"
+	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/repository"
 )
 
 type loggingMiddleware struct {
@@ -15,50 +16,87 @@ type loggingMiddleware struct {
 }
 
 func (mw loggingMiddleware) CreateExam(
-	ctx context.Context, topic, description string, isPublic bool, userId string,
+	ctx context.Context,
+	topic, description string,
+	tags []string,
+	isPublic bool,
+	userId string,
 ) (examId string, err error) {
 	defer func() {
 		mw.logger.Log(
 			"method", "CreateExam",
 			"topic", topic,
 			"description", description,
+			"tags", tags,
 			"isPublic", isPublic,
 			"userId", userId,
 			"err", err)
 	}()
-	return mw.next.CreateExam(ctx, topic, description, isPublic, userId)
+	return mw.next.CreateExam(ctx, topic, description, tags, isPublic, userId)
 }
 
 func (mw loggingMiddleware) UpdateExam(
 	ctx context.Context,
-	examId,
-	topic, description string, isPublic bool, userId string,
-) (updatedExamId string, err error) {
+	examId, topic, description string,
+	tags []string,
+	isPublic bool,
+	settings *model.ExamSettings,
+	expectedVersion *int32,
+	userId string,
+) (updatedExamId string, version int32, err error) {
 	defer func() {
 		mw.logger.Log(
 			"method", "UpdateExam",
 			"examId", examId,
 			"topic", topic,
 			"description", description,
+			"tags", tags,
 			"isPublic", isPublic,
+			"settings", settings,
+			"expectedVersion", expectedVersion,
 			"userId", userId,
 			"err", err)
 	}()
-	return mw.next.UpdateExam(ctx, examId, topic, description, isPublic, userId)
+	return mw.next.UpdateExam(
+		ctx,
+		examId,
+		topic,
+		description,
+		tags,
+		isPublic,
+		settings,
+		expectedVersion,
+		userId,
+	)
+}
+
+func (mw loggingMiddleware) GetExam(
+	ctx context.Context,
+	examId, userId string,
+) (exam *model.Exam, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "GetExam",
+			"examId", examId,
+			"userId", userId,
+			"err", err)
+	}()
+	return mw.next.GetExam(ctx, examId, userId)
 }
 
 func (mw loggingMiddleware) FindExams(
-	ctx context.Context, pageIndex, pageSize int32, userId string,
-) (total, pageCount int32, exams []model.Exam, err error) {
+	ctx context.Context, pageIndex, pageSize int32, userId, cursor string,
+) (total, pageCount int32, exams []model.Exam, nextCursor string, err error) {
 	defer func() {
 		mw.logger.Log(
 			"method", "FindExams",
 			"pageIndex", pageIndex,
 			"pageSize", pageSize,
 			"userId", userId,
+			"cursor", cursor,
 			"err", err)
 	}()
-	return mw.next.FindExams(ctx, pageIndex, pageSize, userId)
+	return mw.next.FindExams(ctx, pageIndex, pageSize, userId, cursor)
 }
 
 func (mw loggingMiddleware) DeleteExam(
@@ -75,7 +113,14 @@ func (mw loggingMiddleware) DeleteExam(
 }
 
 func (mw loggingMiddleware) CreateQuestion(
-	ctx context.Context, examId, ask string, answers []string, userId string,
+	ctx context.Context,
+	examId, ask string,
+	answers []string,
+	acceptedAnswers [][]string,
+	points int32,
+	partialCredit bool,
+	hint, explanation, sectionId string,
+	userId string,
 ) (questionId string, err error) {
 	defer func() {
 		mw.logger.Log(
@@ -83,30 +128,90 @@ func (mw loggingMiddleware) CreateQuestion(
 			"examId", examId,
 			"ask", ask,
 			"answers", answers,
+			"acceptedAnswers", acceptedAnswers,
+			"points", points,
+			"partialCredit", partialCredit,
+			"hint", hint,
+			"explanation", explanation,
+			"sectionId", sectionId,
 			"userId", userId,
 			"err", err)
 	}()
-	return mw.next.CreateQuestion(ctx, examId, ask, answers, userId)
+	return mw.next.CreateQuestion(
+		ctx,
+		examId,
+		ask,
+		answers,
+		acceptedAnswers,
+		points,
+		partialCredit,
+		hint,
+		explanation,
+		sectionId,
+		userId,
+	)
 }
 
 func (mw loggingMiddleware) UpdateQuestion(
-	ctx context.Context, questionId, ask string, answers []string, userId string,
-) (updatedQuestionId string, err error) {
+	ctx context.Context,
+	questionId, ask string,
+	answers []string,
+	acceptedAnswers [][]string,
+	points int32,
+	partialCredit bool,
+	hint, explanation, sectionId string,
+	expectedVersion *int32,
+	userId string,
+) (updatedQuestionId string, version int32, err error) {
 	defer func() {
 		mw.logger.Log(
 			"method", "UpdateQuestion",
 			"questionId", questionId,
 			"ask", ask,
 			"answers", answers,
+			"acceptedAnswers", acceptedAnswers,
+			"points", points,
+			"partialCredit", partialCredit,
+			"hint", hint,
+			"explanation", explanation,
+			"sectionId", sectionId,
+			"expectedVersion", expectedVersion,
+			"userId", userId,
+			"err", err)
+	}()
+	return mw.next.UpdateQuestion(
+		ctx,
+		questionId,
+		ask,
+		answers,
+		acceptedAnswers,
+		points,
+		partialCredit,
+		hint,
+		explanation,
+		sectionId,
+		expectedVersion,
+		userId,
+	)
+}
+
+func (mw loggingMiddleware) GetQuestion(
+	ctx context.Context,
+	questionId, userId string,
+) (question *model.Question, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "GetQuestion",
+			"questionId", questionId,
 			"userId", userId,
 			"err", err)
 	}()
-	return mw.next.UpdateQuestion(ctx, questionId, ask, answers, userId)
+	return mw.next.GetQuestion(ctx, questionId, userId)
 }
 
 func (mw loggingMiddleware) FindQuestions(
-	ctx context.Context, pageIndex, pageSize int32, examId, userId string,
-) (total, pageCount int32, questions []model.Question, err error) {
+	ctx context.Context, pageIndex, pageSize int32, examId, userId, cursor string,
+) (total, pageCount int32, questions []model.Question, nextCursor string, err error) {
 	defer func() {
 		mw.logger.Log(
 			"method", "FindQuestions",
@@ -114,9 +219,10 @@ func (mw loggingMiddleware) FindQuestions(
 			"pageSize", pageSize,
 			"exmaId", examId,
 			"userId", userId,
+			"cursor", cursor,
 			"err", err)
 	}()
-	return mw.next.FindQuestions(ctx, pageIndex, pageSize, examId, userId)
+	return mw.next.FindQuestions(ctx, pageIndex, pageSize, examId, userId, cursor)
 }
 
 func (mw loggingMiddleware) DeleteQuestion(
@@ -133,23 +239,35 @@ func (mw loggingMiddleware) DeleteQuestion(
 }
 
 func (mw loggingMiddleware) CreateExamRecord(
-	ctx context.Context, examId string, score int32, wrongQuestionIds []string, userId string,
-) (err error) {
+	ctx context.Context,
+	examId, examAttemptId string,
+	durationSeconds int32,
+	answers []model.ExamAttemptAnswer,
+	userId string,
+) (examRecord *model.ExamRecord, questions []model.Question, err error) {
 	defer func() {
 		mw.logger.Log(
 			"method", "CreateExamRecord",
 			"examId", examId,
-			"score", score,
-			"wrongQuestionIds", wrongQuestionIds,
+			"examAttemptId", examAttemptId,
+			"durationSeconds", durationSeconds,
+			"answers size", len(answers),
 			"userId", userId,
 			"err", err)
 	}()
-	return mw.next.CreateExamRecord(ctx, examId, score, wrongQuestionIds, userId)
+	return mw.next.CreateExamRecord(
+		ctx,
+		examId,
+		examAttemptId,
+		durationSeconds,
+		answers,
+		userId,
+	)
 }
 
 func (mw loggingMiddleware) FindExamRecords(
-	ctx context.Context, pageIndex, pageSize int32, examId, userId string,
-) (total, pageCount int32, examRecords []model.ExamRecord, err error) {
+	ctx context.Context, pageIndex, pageSize int32, examId, userId, cursor string,
+) (total, pageCount int32, examRecords []model.ExamRecord, nextCursor string, err error) {
 	defer func() {
 		mw.logger.Log(
 			"method", "FindExamRecords",
@@ -157,19 +275,28 @@ func (mw loggingMiddleware) FindExamRecords(
 			"pageSize", pageSize,
 			"exmaId", examId,
 			"userId", userId,
+			"cursor", cursor,
 			"err", err)
 	}()
-	return mw.next.FindExamRecords(ctx, pageIndex, pageSize, examId, userId)
+	return mw.next.FindExamRecords(ctx, pageIndex, pageSize, examId, userId, cursor)
 }
 
 func (mw loggingMiddleware) FindExamRecordOverview(
-	ctx context.Context, examId, userId string, startDate time.Time,
+	ctx context.Context,
+	examId, userId string,
+	startDate, endDate time.Time,
+	version int32,
+	dateRange, timezone string,
+	movingAverageWindow int32,
 ) (
 	strStartDate string,
 	exam *model.Exam,
+	examVersion int32,
+	examVersionSummaries []repository.ExamVersionSummary,
 	questions []model.Question,
 	answerWrongs []model.AnswerWrong,
 	examRecords []model.ExamRecord,
+	analytics ExamRecordAnalytics,
 	err error,
 ) {
 	defer func() {
@@ -178,34 +305,711 @@ func (mw loggingMiddleware) FindExamRecordOverview(
 			"exmaId", examId,
 			"userId", userId,
 			"startDate", startDate,
+			"endDate", endDate,
+			"version", version,
+			"dateRange", dateRange,
+			"timezone", timezone,
+			"movingAverageWindow", movingAverageWindow,
+			"examVersion", examVersion,
+			"err", err)
+	}()
+	return mw.next.FindExamRecordOverview(
+		ctx,
+		examId,
+		userId,
+		startDate,
+		endDate,
+		version,
+		dateRange,
+		timezone,
+		movingAverageWindow,
+	)
+}
+
+func (mw loggingMiddleware) ShareExam(
+	ctx context.Context,
+	examId, sharedUserId, role, userId string,
+) (err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "ShareExam",
+			"examId", examId,
+			"sharedUserId", sharedUserId,
+			"role", role,
+			"userId", userId,
+			"err", err)
+	}()
+	return mw.next.ShareExam(ctx, examId, sharedUserId, role, userId)
+}
+
+func (mw loggingMiddleware) UnshareExam(
+	ctx context.Context,
+	examId, sharedUserId, userId string,
+) (err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "UnshareExam",
+			"examId", examId,
+			"sharedUserId", sharedUserId,
+			"userId", userId,
+			"err", err)
+	}()
+	return mw.next.UnshareExam(ctx, examId, sharedUserId, userId)
+}
+
+func (mw loggingMiddleware) FindExamShares(
+	ctx context.Context,
+	examId, userId string,
+) (shares []model.ExamShare, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "FindExamShares",
+			"examId", examId,
+			"userId", userId,
+			"shares size", len(shares),
+			"err", err)
+	}()
+	return mw.next.FindExamShares(ctx, examId, userId)
+}
+
+func (mw loggingMiddleware) PublishExam(
+	ctx context.Context,
+	examId, userId string,
+) (version, questionCount int32, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "PublishExam",
+			"examId", examId,
+			"userId", userId,
+			"version", version,
+			"questionCount", questionCount,
+			"err", err)
+	}()
+	return mw.next.PublishExam(ctx, examId, userId)
+}
+
+func (mw loggingMiddleware) ForkExam(
+	ctx context.Context,
+	examId, userId string,
+) (forkedExamId string, questionCount int32, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "ForkExam",
+			"examId", examId,
+			"userId", userId,
+			"forkedExamId", forkedExamId,
+			"questionCount", questionCount,
+			"err", err)
+	}()
+	return mw.next.ForkExam(ctx, examId, userId)
+}
+
+func (mw loggingMiddleware) ImportExam(
+	ctx context.Context,
+	topic, description string,
+	tags []string,
+	format string,
+	content []byte,
+	userId string,
+) (examId string, questionCount int32, importErrors []ExamImportError, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "ImportExam",
+			"topic", topic,
+			"description", description,
+			"tags", tags,
+			"format", format,
+			"content size", len(content),
+			"userId", userId,
+			"examId", examId,
+			"questionCount", questionCount,
+			"importErrors size", len(importErrors),
+			"err", err)
+	}()
+	return mw.next.ImportExam(ctx, topic, description, tags, format, content, userId)
+}
+
+func (mw loggingMiddleware) ExportExam(
+	ctx context.Context,
+	examId, userId, format string,
+	send func(chunk []byte) error,
+) (err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "ExportExam",
+			"examId", examId,
+			"userId", userId,
+			"format", format,
+			"err", err)
+	}()
+	return mw.next.ExportExam(ctx, examId, userId, format, send)
+}
+
+func (mw loggingMiddleware) BatchMutateQuestions(
+	ctx context.Context,
+	examId string,
+	operations []QuestionOperation,
+	userId string,
+) (applied bool, results []QuestionOperationResult, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "BatchMutateQuestions",
+			"examId", examId,
+			"operations size", len(operations),
+			"userId", userId,
+			"applied", applied,
 			"err", err)
 	}()
-	return mw.next.FindExamRecordOverview(ctx, examId, userId, startDate)
+	return mw.next.BatchMutateQuestions(ctx, examId, operations, userId)
 }
 
 func (mw loggingMiddleware) FindExamInfos(
-	ctx context.Context, userId string, isPublic bool,
+	ctx context.Context, userId string, isPublic bool, tags []string,
 ) (examInfos []ExamInfo, err error) {
 	defer func() {
 		mw.logger.Log(
 			"method", "FindExamInfos",
 			"userId", userId,
 			"isPublic", isPublic,
+			"tags", tags,
+			"err", err)
+	}()
+	return mw.next.FindExamInfos(ctx, userId, isPublic, tags)
+}
+
+func (mw loggingMiddleware) FindExamCatalog(
+	ctx context.Context,
+	keyword string,
+	tags []string,
+	sortBy string,
+	pageIndex, pageSize int32,
+) (total, pageCount int32, examSummaries []repository.ExamSummary, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "FindExamCatalog",
+			"keyword", keyword,
+			"tags", tags,
+			"sortBy", sortBy,
+			"pageIndex", pageIndex,
+			"pageSize", pageSize,
 			"err", err)
 	}()
-	return mw.next.FindExamInfos(ctx, userId, isPublic)
+	return mw.next.FindExamCatalog(ctx, keyword, tags, sortBy, pageIndex, pageSize)
 }
 
 func (mw loggingMiddleware) FindRandomQuestions(
 	ctx context.Context, examId, userId string, size int32,
-) (exam *model.Exam, questions []model.Question, err error) {
+) (
+	exam *model.Exam,
+	questions []model.Question,
+	sections []model.ExamSection,
+	examAttemptId string,
+	err error,
+) {
 	defer func() {
 		mw.logger.Log(
 			"method", "FindRandomQuestions",
 			"examId", examId,
 			"userId", userId,
 			"size", size,
+			"examAttemptId", examAttemptId,
 			"err", err)
 	}()
 	return mw.next.FindRandomQuestions(ctx, examId, userId, size)
 }
+
+func (mw loggingMiddleware) RevealQuestionHint(
+	ctx context.Context,
+	examId, examAttemptId, questionId, userId string,
+) (hint string, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "RevealQuestionHint",
+			"examId", examId,
+			"examAttemptId", examAttemptId,
+			"questionId", questionId,
+			"userId", userId,
+			"err", err)
+	}()
+	return mw.next.RevealQuestionHint(ctx, examId, examAttemptId, questionId, userId)
+}
+
+func (mw loggingMiddleware) CreateExamSection(
+	ctx context.Context,
+	examId, sectionType, title, passage, audioUrl string,
+	order int32,
+	userId string,
+) (sectionId string, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "CreateExamSection",
+			"examId", examId,
+			"sectionType", sectionType,
+			"title", title,
+			"audioUrl", audioUrl,
+			"order", order,
+			"userId", userId,
+			"err", err)
+	}()
+	return mw.next.CreateExamSection(
+		ctx,
+		examId,
+		sectionType,
+		title,
+		passage,
+		audioUrl,
+		order,
+		userId,
+	)
+}
+
+func (mw loggingMiddleware) UpdateExamSection(
+	ctx context.Context,
+	sectionId, sectionType, title, passage, audioUrl string,
+	order int32,
+	userId string,
+) (updatedSectionId string, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "UpdateExamSection",
+			"sectionId", sectionId,
+			"sectionType", sectionType,
+			"title", title,
+			"audioUrl", audioUrl,
+			"order", order,
+			"userId", userId,
+			"err", err)
+	}()
+	return mw.next.UpdateExamSection(
+		ctx,
+		sectionId,
+		sectionType,
+		title,
+		passage,
+		audioUrl,
+		order,
+		userId,
+	)
+}
+
+func (mw loggingMiddleware) DeleteExamSection(
+	ctx context.Context,
+	sectionId, userId string,
+) (err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "DeleteExamSection",
+			"sectionId", sectionId,
+			"userId", userId,
+			"err", err)
+	}()
+	return mw.next.DeleteExamSection(ctx, sectionId, userId)
+}
+
+func (mw loggingMiddleware) FindExamSections(
+	ctx context.Context,
+	examId, userId string,
+) (sections []model.ExamSection, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "FindExamSections",
+			"examId", examId,
+			"userId", userId,
+			"err", err)
+	}()
+	return mw.next.FindExamSections(ctx, examId, userId)
+}
+
+func (mw loggingMiddleware) CreateBankQuestion(
+	ctx context.Context,
+	ask string,
+	answers, tags []string,
+	points int32,
+	partialCredit bool,
+	hint, explanation string,
+	userId string,
+) (bankQuestionId string, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "CreateBankQuestion",
+			"ask", ask,
+			"answers", answers,
+			"tags", tags,
+			"points", points,
+			"partialCredit", partialCredit,
+			"userId", userId,
+			"err", err)
+	}()
+	return mw.next.CreateBankQuestion(
+		ctx,
+		ask,
+		answers,
+		tags,
+		points,
+		partialCredit,
+		hint,
+		explanation,
+		userId,
+	)
+}
+
+func (mw loggingMiddleware) UpdateBankQuestion(
+	ctx context.Context,
+	bankQuestionId, ask string,
+	answers, tags []string,
+	points int32,
+	partialCredit bool,
+	hint, explanation string,
+	userId string,
+) (updatedBankQuestionId string, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "UpdateBankQuestion",
+			"bankQuestionId", bankQuestionId,
+			"ask", ask,
+			"answers", answers,
+			"tags", tags,
+			"points", points,
+			"partialCredit", partialCredit,
+			"userId", userId,
+			"err", err)
+	}()
+	return mw.next.UpdateBankQuestion(
+		ctx,
+		bankQuestionId,
+		ask,
+		answers,
+		tags,
+		points,
+		partialCredit,
+		hint,
+		explanation,
+		userId,
+	)
+}
+
+func (mw loggingMiddleware) DeleteBankQuestion(
+	ctx context.Context,
+	bankQuestionId, userId string,
+) (err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "DeleteBankQuestion",
+			"bankQuestionId", bankQuestionId,
+			"userId", userId,
+			"err", err)
+	}()
+	return mw.next.DeleteBankQuestion(ctx, bankQuestionId, userId)
+}
+
+func (mw loggingMiddleware) FindBankQuestions(
+	ctx context.Context,
+	pageIndex, pageSize int32,
+	tag, userId string,
+) (total, pageCount int32, bankQuestions []model.BankQuestion, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "FindBankQuestions",
+			"pageIndex", pageIndex,
+			"pageSize", pageSize,
+			"tag", tag,
+			"userId", userId,
+			"err", err)
+	}()
+	return mw.next.FindBankQuestions(ctx, pageIndex, pageSize, tag, userId)
+}
+
+func (mw loggingMiddleware) SetExamBankSource(
+	ctx context.Context,
+	examId string,
+	questionIds []string,
+	pools []model.ExamBankPool,
+	userId string,
+) (exam *model.Exam, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "SetExamBankSource",
+			"examId", examId,
+			"questionIds", questionIds,
+			"pools", pools,
+			"userId", userId,
+			"err", err)
+	}()
+	return mw.next.SetExamBankSource(ctx, examId, questionIds, pools, userId)
+}
+
+func (mw loggingMiddleware) CreatePracticeExam(
+	ctx context.Context,
+	topic, description string,
+	practiceWords []PracticeWord,
+	questionTypes []string,
+	userId string,
+) (examId string, questionCount int32, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "CreatePracticeExam",
+			"topic", topic,
+			"description", description,
+			"practiceWordsSize", len(practiceWords),
+			"questionTypes", questionTypes,
+			"userId", userId,
+			"err", err)
+	}()
+	return mw.next.CreatePracticeExam(
+		ctx,
+		topic,
+		description,
+		practiceWords,
+		questionTypes,
+		userId,
+	)
+}
+
+func (mw loggingMiddleware) FindExamsByTags(
+	ctx context.Context,
+	tags []string,
+	userId string,
+	pageIndex, pageSize int32,
+) (total, pageCount int32, exams []model.Exam, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "FindExamsByTags",
+			"tags", tags,
+			"userId", userId,
+			"pageIndex", pageIndex,
+			"pageSize", pageSize,
+			"err", err)
+	}()
+	return mw.next.FindExamsByTags(ctx, tags, userId, pageIndex, pageSize)
+}
+
+func (mw loggingMiddleware) FindExamTagCounts(
+	ctx context.Context,
+	userId string,
+	size int32,
+) (tagCounts []repository.TagCount, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "FindExamTagCounts",
+			"userId", userId,
+			"size", size,
+			"err", err)
+	}()
+	return mw.next.FindExamTagCounts(ctx, userId, size)
+}
+
+func (mw loggingMiddleware) CreateClass(
+	ctx context.Context,
+	name, description, userId string,
+) (classId, joinCode string, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "CreateClass",
+			"name", name,
+			"userId", userId,
+			"classId", classId,
+			"err", err)
+	}()
+	return mw.next.CreateClass(ctx, name, description, userId)
+}
+
+func (mw loggingMiddleware) JoinClass(
+	ctx context.Context,
+	joinCode, userId string,
+) (class *model.Class, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "JoinClass",
+			"joinCode", joinCode,
+			"userId", userId,
+			"err", err)
+	}()
+	return mw.next.JoinClass(ctx, joinCode, userId)
+}
+
+func (mw loggingMiddleware) FindClasses(
+	ctx context.Context,
+	userId string,
+) (classes []model.Class, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "FindClasses",
+			"userId", userId,
+			"classes size", len(classes),
+			"err", err)
+	}()
+	return mw.next.FindClasses(ctx, userId)
+}
+
+func (mw loggingMiddleware) CreateAssignment(
+	ctx context.Context,
+	classId, examId, title string,
+	dueAt time.Time,
+	userId string,
+) (assignmentId string, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "CreateAssignment",
+			"classId", classId,
+			"examId", examId,
+			"title", title,
+			"dueAt", dueAt,
+			"userId", userId,
+			"assignmentId", assignmentId,
+			"err", err)
+	}()
+	return mw.next.CreateAssignment(ctx, classId, examId, title, dueAt, userId)
+}
+
+func (mw loggingMiddleware) FindAssignments(
+	ctx context.Context,
+	classId, userId string,
+) (assignments []model.Assignment, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "FindAssignments",
+			"classId", classId,
+			"userId", userId,
+			"assignments size", len(assignments),
+			"err", err)
+	}()
+	return mw.next.FindAssignments(ctx, classId, userId)
+}
+
+func (mw loggingMiddleware) FindClassGradebook(
+	ctx context.Context,
+	classId, userId string,
+) (class *model.Class, assignments []model.Assignment, grades []ClassGrade, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "FindClassGradebook",
+			"classId", classId,
+			"userId", userId,
+			"assignments size", len(assignments),
+			"grades size", len(grades),
+			"err", err)
+	}()
+	return mw.next.FindClassGradebook(ctx, classId, userId)
+}
+
+func (mw loggingMiddleware) FindExamLeaderboard(
+	ctx context.Context,
+	examId, window string,
+	size int32,
+	excludedUserIds []string,
+) (leaderboardEntries []repository.LeaderboardEntry, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "FindExamLeaderboard",
+			"examId", examId,
+			"window", window,
+			"size", size,
+			"excludedUserIds size", len(excludedUserIds),
+			"leaderboardEntries size", len(leaderboardEntries),
+			"err", err)
+	}()
+	return mw.next.FindExamLeaderboard(ctx, examId, window, size, excludedUserIds)
+}
+
+func (mw loggingMiddleware) FindQuestionStatistics(
+	ctx context.Context,
+	examId, userId string,
+) (
+	summary repository.ExamStatisticsSummary,
+	questionStatistics []QuestionStatistic,
+	err error,
+) {
+	defer func() {
+		mw.logger.Log(
+			"method", "FindQuestionStatistics",
+			"examId", examId,
+			"userId", userId,
+			"attemptCount", summary.AttemptCount,
+			"questionStatistics size", len(questionStatistics),
+			"err", err)
+	}()
+	return mw.next.FindQuestionStatistics(ctx, examId, userId)
+}
+
+func (mw loggingMiddleware) FindMistakeNotebook(
+	ctx context.Context,
+	userId, examId string,
+	startDate, endDate time.Time,
+	pageIndex, pageSize int32,
+) (total, pageCount int32, entries []MistakeNotebookEntry, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "FindMistakeNotebook",
+			"userId", userId,
+			"examId", examId,
+			"startDate", startDate,
+			"endDate", endDate,
+			"pageIndex", pageIndex,
+			"pageSize", pageSize,
+			"total", total,
+			"pageCount", pageCount,
+			"entries size", len(entries),
+			"err", err)
+	}()
+	return mw.next.FindMistakeNotebook(
+		ctx,
+		userId,
+		examId,
+		startDate,
+		endDate,
+		pageIndex,
+		pageSize,
+	)
+}
+
+func (mw loggingMiddleware) CreateMistakeDrill(
+	ctx context.Context,
+	userId, examId string,
+	size int32,
+) (entries []MistakeNotebookEntry, requiredCorrectStreak int32, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "CreateMistakeDrill",
+			"userId", userId,
+			"examId", examId,
+			"size", size,
+			"entries size", len(entries),
+			"err", err)
+	}()
+	return mw.next.CreateMistakeDrill(ctx, userId, examId, size)
+}
+
+func (mw loggingMiddleware) AnswerMistakeDrill(
+	ctx context.Context,
+	answerWrongId, userId string,
+	blankAnswers []string,
+) (isCorrect bool, correctAnswers []string, correctStreak int32, removed bool, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "AnswerMistakeDrill",
+			"answerWrongId", answerWrongId,
+			"userId", userId,
+			"blankAnswers size", len(blankAnswers),
+			"isCorrect", isCorrect,
+			"correctStreak", correctStreak,
+			"removed", removed,
+			"err", err)
+	}()
+	return mw.next.AnswerMistakeDrill(ctx, answerWrongId, userId, blankAnswers)
+}
+
+func (mw loggingMiddleware) GetExamRecordDetail(
+	ctx context.Context,
+	examId, examRecordId, userId string,
+) (examRecord *model.ExamRecord, questions []model.Question, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method", "GetExamRecordDetail",
+			"examId", examId,
+			"examRecordId", examRecordId,
+			"userId", userId,
+			"questions size", len(questions),
+			"err", err)
+	}()
+	return mw.next.GetExamRecordDetail(ctx, examId, examRecordId, userId)
+}
@@ -0,0 +1,377 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/model"
+)
+
+const (
+	// 錯題練習中連續答對幾次後，從錯題本移除
+	mistakeDrillRequiredCorrectStreak = 3
+
+	defaultMistakeDrillSize = 10
+	maxMistakeDrillSize     = 50
+)
+
+// 錯題本中的一題，Question 是答錯時測驗版本的題目內容，題目已被刪除時為 nil
+type MistakeNotebookEntry struct {
+	AnswerWrong model.AnswerWrong
+	Question    *model.Question
+	ExamTopic   string
+}
+
+/*
+查詢使用者所有測驗的錯題，examId 空字串表示全部測驗，
+startDate 與 endDate 是最後一次答錯的時間範圍，零值表示不限制
+*/
+func (examService examService) FindMistakeNotebook(
+	ctx context.Context,
+	userId, examId string,
+	startDate, endDate time.Time,
+	pageIndex, pageSize int32,
+) (total, pageCount int32, entries []MistakeNotebookEntry, err error) {
+	logger := examService.logger
+	errorLogger := examService.errorLogger
+	errorMessage := "FindMistakeNotebook failed: %w"
+
+	if !startDate.IsZero() && !endDate.IsZero() && !startDate.Before(endDate) {
+		err = fmt.Errorf("Invalid date range: %v ~ %v", startDate, endDate)
+		errorLogger.Log("err", err)
+		return 0, 0, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if pageIndex < 0 || pageSize <= 0 {
+		err = fmt.Errorf("Invalid page: %d, %d", pageIndex, pageSize)
+		errorLogger.Log("err", err)
+		return 0, 0, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	databaseRepository := examService.databaseRepository
+	skip := pageSize * pageIndex
+	limit := pageSize
+	answerWrongs, err := databaseRepository.FindAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetweenOrderByUpdatedAtDesc(
+		ctx, userId, examId, startDate, endDate, skip, limit)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return 0, 0, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	// Total
+	count, err := databaseRepository.CountAnswerWrongsByUserIdAndExamIdAndUpdatedAtBetween(
+		ctx,
+		userId,
+		examId,
+		startDate,
+		endDate,
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return 0, 0, nil, fmt.Errorf(errorMessage, err)
+	}
+	total = int32(count)
+
+	// PageCount
+	pageCount = int32(math.Ceil(float64(total) / float64(pageSize)))
+
+	entries, err = examService.toMistakeNotebookEntries(ctx, answerWrongs, userId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return 0, 0, nil, fmt.Errorf(errorMessage, err)
+	}
+
+	logger.Log("total", total, "pageCount", pageCount, "entries size", len(entries))
+	return total, pageCount, entries, nil
+}
+
+// 從錯題本隨機取出題目組成練習，題目不回傳答案和解說，已被刪除的題目不會出現
+func (examService examService) CreateMistakeDrill(
+	ctx context.Context,
+	userId, examId string,
+	size int32,
+) (entries []MistakeNotebookEntry, requiredCorrectStreak int32, err error) {
+	errorLogger := examService.errorLogger
+	errorMessage := "CreateMistakeDrill failed: %w"
+
+	if size <= 0 {
+		size = defaultMistakeDrillSize
+	}
+
+	if size > maxMistakeDrillSize {
+		size = maxMistakeDrillSize
+	}
+
+	answerWrongs, err := examService.databaseRepository.FindRandomAnswerWrongsByUserIdAndExamId(
+		ctx,
+		userId,
+		examId,
+		size,
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, 0, fmt.Errorf(errorMessage, err)
+	}
+
+	notebookEntries, err := examService.toMistakeNotebookEntries(ctx, answerWrongs, userId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, 0, fmt.Errorf(errorMessage, err)
+	}
+
+	entries = []MistakeNotebookEntry{}
+
+	for _, entry := range notebookEntries {
+		if entry.Question == nil {
+			continue
+		}
+
+		// 練習時不回傳答案，作答後由伺服器評分
+		question := hideQuestionAnswers([]model.Question{*entry.Question})[0]
+		entry.Question = &question
+		entries = append(entries, entry)
+	}
+
+	return entries, mistakeDrillRequiredCorrectStreak, nil
+}
+
+/*
+評分錯題練習的作答並記錄結果，答對時連續答對的次數加 1，
+達到 mistakeDrillRequiredCorrectStreak 次時從錯題本移除，
+答錯時增加答錯次數並將連續答對的次數歸零，
+測驗不公布答案時 correctAnswers 為空陣列
+*/
+func (examService examService) AnswerMistakeDrill(
+	ctx context.Context,
+	answerWrongId, userId string,
+	blankAnswers []string,
+) (isCorrect bool, correctAnswers []string, correctStreak int32, removed bool, err error) {
+	errorLogger := examService.errorLogger
+	errorMessage := "AnswerMistakeDrill failed: %w"
+
+	databaseRepository := examService.databaseRepository
+	answerWrong, err := databaseRepository.GetAnswerWrongById(ctx, answerWrongId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return false, nil, 0, false, fmt.Errorf(errorMessage, err)
+	}
+
+	if answerWrong == nil {
+		err = fmt.Errorf("AnswerWrong not found by id: %s", answerWrongId)
+		errorLogger.Log("err", err)
+		return false, nil, 0, false, fmt.Errorf(errorMessage, err)
+	}
+
+	// 只能練習自己的錯題
+	if answerWrong.UserId != userId {
+		err = unauthorizedOperationError
+		errorLogger.Log("err", err)
+		return false, nil, 0, false, fmt.Errorf(errorMessage, err)
+	}
+
+	// 以答錯時測驗版本的題目評分
+	exam, err := databaseRepository.GetExamById(ctx, answerWrong.ExamId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return false, nil, 0, false, fmt.Errorf(errorMessage, err)
+	}
+
+	questions, err := examService.findMistakeNotebookQuestions(
+		ctx,
+		exam,
+		answerWrong.ExamId,
+		answerWrong.ExamVersion,
+		[]string{answerWrong.QuestionId},
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return false, nil, 0, false, fmt.Errorf(errorMessage, err)
+	}
+
+	question, ok := questionMapById(questions)[answerWrong.QuestionId]
+	if !ok {
+		err = fmt.Errorf("Question not found by id: %s", answerWrong.QuestionId)
+		errorLogger.Log("err", err)
+		return false, nil, 0, false, fmt.Errorf(errorMessage, err)
+	}
+
+	gradedAnswer := gradeAttemptAnswer(
+		question,
+		model.ExamAttemptAnswer{QuestionId: answerWrong.QuestionId, BlankAnswers: blankAnswers},
+		0,
+	)
+	isCorrect = gradedAnswer.IsCorrect
+	correctAnswers = []string{}
+
+	if canViewExamAnswers(exam, userId) {
+		correctAnswers = question.Answers
+	}
+
+	if !isCorrect {
+		_, _, err = databaseRepository.UpsertAnswerWrongByTimesPlusOne(
+			ctx,
+			answerWrong.ExamId,
+			answerWrong.ExamVersion,
+			answerWrong.QuestionId,
+			userId,
+		)
+		if err != nil {
+			errorLogger.Log("err", err)
+			return false, nil, 0, false, fmt.Errorf(errorMessage, err)
+		}
+
+		return false, correctAnswers, 0, false, nil
+	}
+
+	result, err := databaseRepository.WithTransaction(
+		ctx,
+		func(ctx context.Context) (interface{}, error) {
+			correctStreak, err := databaseRepository.IncreaseAnswerWrongCorrectStreak(
+				ctx,
+				answerWrongId,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			if correctStreak >= mistakeDrillRequiredCorrectStreak {
+				_, err = databaseRepository.DeleteAnswerWrongById(ctx, answerWrongId)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			return correctStreak, nil
+		},
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return false, nil, 0, false, fmt.Errorf(errorMessage, err)
+	}
+
+	correctStreak = result.(int32)
+	removed = correctStreak >= mistakeDrillRequiredCorrectStreak
+	return true, correctAnswers, correctStreak, removed, nil
+}
+
+/*
+查詢錯題對應的題目內容與測驗主題，
+版本 0 的錯題使用該測驗目前的題目，已發佈版本的錯題使用當時的題目快照，
+測驗不公布答案時，除了擁有者與共同編輯者，都不回傳題目的答案
+*/
+func (examService examService) toMistakeNotebookEntries(
+	ctx context.Context,
+	answerWrongs []model.AnswerWrong,
+	userId string,
+) ([]MistakeNotebookEntry, error) {
+	databaseRepository := examService.databaseRepository
+	questionsByVersion := map[string]map[string]model.Question{}
+	exams := map[string]*model.Exam{}
+	draftQuestionIds := map[string][]string{}
+
+	for _, answerWrong := range answerWrongs {
+		if answerWrong.ExamVersion == 0 {
+			draftQuestionIds[answerWrong.ExamId] = append(
+				draftQuestionIds[answerWrong.ExamId],
+				answerWrong.QuestionId,
+			)
+		}
+	}
+
+	entries := []MistakeNotebookEntry{}
+
+	for _, answerWrong := range answerWrongs {
+		examId := answerWrong.ExamId
+		exam, ok := exams[examId]
+
+		if !ok {
+			var err error
+			exam, err = databaseRepository.GetExamById(ctx, examId)
+			if err != nil {
+				return nil, err
+			}
+
+			exams[examId] = exam
+		}
+
+		key := fmt.Sprintf("%s:%d", examId, answerWrong.ExamVersion)
+
+		if _, ok := questionsByVersion[key]; !ok {
+			questions, err := examService.findMistakeNotebookQuestions(
+				ctx,
+				exam,
+				examId,
+				answerWrong.ExamVersion,
+				draftQuestionIds[examId],
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			questionsByVersion[key] = questionMapById(questions)
+		}
+
+		entry := MistakeNotebookEntry{
+			AnswerWrong: answerWrong,
+		}
+
+		if exam != nil {
+			entry.ExamTopic = exam.Topic
+		}
+
+		if question, ok := questionsByVersion[key][answerWrong.QuestionId]; ok {
+			if !canViewExamAnswers(exam, userId) {
+				question = hideQuestionAnswers([]model.Question{question})[0]
+			}
+
+			entry.Question = &question
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+/*
+查詢錯題本中一個測驗版本的題目，版本 0 只能查詢測驗本身與其題庫來源的題目，
+測驗已被刪除時查不到題目
+*/
+func (examService examService) findMistakeNotebookQuestions(
+	ctx context.Context,
+	exam *model.Exam,
+	examId string,
+	examVersion int32,
+	draftQuestionIds []string,
+) ([]model.Question, error) {
+	if examVersion == 0 {
+		return examService.findQuestionsByQuestionIds(ctx, exam, draftQuestionIds)
+	}
+
+	snapshot, err := examService.databaseRepository.GetExamVersionByExamIdAndVersion(
+		ctx,
+		examId,
+		examVersion,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot == nil {
+		return []model.Question{}, nil
+	}
+
+	return examVersionQuestions(snapshot), nil
+}
+
+func questionMapById(questions []model.Question) map[string]model.Question {
+	questionMap := map[string]model.Question{}
+
+	for _, question := range questions {
+		questionMap[question.Id.Hex()] = question
+	}
+
+	return questionMap
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/model"
+)
+
+// 練習測驗的題型
+const (
+	PRACTICE_QUESTION_TYPE_DEFINITION_TO_WORD = "definition_to_word" // 看解釋寫單字
+	PRACTICE_QUESTION_TYPE_WORD_TO_DEFINITION = "word_to_definition" // 看單字寫解釋
+	PRACTICE_QUESTION_TYPE_CLOZE              = "cloze"              // 例句填空
+)
+
+var practiceQuestionTypes = []string{
+	PRACTICE_QUESTION_TYPE_DEFINITION_TO_WORD,
+	PRACTICE_QUESTION_TYPE_WORD_TO_DEFINITION,
+	PRACTICE_QUESTION_TYPE_CLOZE,
+}
+
+// 例句填空時用來取代單字的空格
+const clozeBlank = "_____"
+
+// 看單字選解釋的題目最多顯示的選項數量，包含正確的解釋
+const maxPracticeChoiceCount = 4
+
+// 題型不正確或無法產生任何題目，呼叫端可以用 errors.Is 判斷
+var InvalidPracticeExamError = fmt.Errorf("Invalid practice exam")
+
+// 用來產生練習測驗題目的單字解釋，這不是 MongoDB 的 document，所以不放在 model 目錄之下
+type PracticeWord struct {
+	WordMeaningId string
+	Word          string
+	Definition    string
+	Sentences     []string
+}
+
+func validatePracticeQuestionTypes(questionTypes []string) error {
+	for _, questionType := range questionTypes {
+		isValid := false
+
+		for _, practiceQuestionType := range practiceQuestionTypes {
+			if questionType == practiceQuestionType {
+				isValid = true
+				break
+			}
+		}
+
+		if !isValid {
+			return fmt.Errorf("%w: invalid question type: %s", InvalidPracticeExamError, questionType)
+		}
+	}
+
+	return nil
+}
+
+/*
+依序輪流使用指定的題型產生題目，
+若單字無法產生該題型的題目（例如沒有包含單字的例句），就改用下一個題型，
+所有題型都無法產生時略過該單字
+*/
+func buildPracticeQuestions(
+	practiceWords []PracticeWord,
+	questionTypes []string,
+	userId string,
+) []model.Question {
+	questions := []model.Question{}
+
+	for i, practiceWord := range practiceWords {
+		for j := range questionTypes {
+			questionType := questionTypes[(i+j)%len(questionTypes)]
+			question, ok := buildPracticeQuestion(
+				practiceWord,
+				otherPracticeDefinitions(practiceWords, i),
+				questionType,
+			)
+			if !ok {
+				continue
+			}
+
+			question.UserId = userId
+			question.WordMeaningId = practiceWord.WordMeaningId
+			questions = append(questions, question)
+			break
+		}
+	}
+
+	return questions
+}
+
+/*
+依序取出其他單字的解釋作為看單字選解釋的錯誤選項，
+略過空白及與正確解釋相同的解釋，最多取出 maxPracticeChoiceCount - 1 個
+*/
+func otherPracticeDefinitions(practiceWords []PracticeWord, index int) []string {
+	definition := strings.TrimSpace(practiceWords[index].Definition)
+	usedDefinitions := map[string]bool{definition: true}
+	otherDefinitions := []string{}
+
+	for i := 1; i < len(practiceWords); i++ {
+		if len(otherDefinitions) == maxPracticeChoiceCount-1 {
+			break
+		}
+
+		otherDefinition := strings.TrimSpace(
+			practiceWords[(index+i)%len(practiceWords)].Definition,
+		)
+
+		if otherDefinition == "" || usedDefinitions[otherDefinition] {
+			continue
+		}
+
+		usedDefinitions[otherDefinition] = true
+		otherDefinitions = append(otherDefinitions, otherDefinition)
+	}
+
+	return otherDefinitions
+}
+
+func buildPracticeQuestion(
+	practiceWord PracticeWord,
+	otherDefinitions []string,
+	questionType string,
+) (question model.Question, ok bool) {
+	word := strings.TrimSpace(practiceWord.Word)
+	definition := strings.TrimSpace(practiceWord.Definition)
+
+	if word == "" {
+		return model.Question{}, false
+	}
+
+	switch questionType {
+	case PRACTICE_QUESTION_TYPE_DEFINITION_TO_WORD:
+		if definition == "" {
+			return model.Question{}, false
+		}
+
+		return model.Question{
+			Ask:     definition,
+			Answers: []string{word},
+		}, true
+	case PRACTICE_QUESTION_TYPE_WORD_TO_DEFINITION:
+		// 解釋無法逐字寫出來，改成從選項中選出正確的解釋，沒有其他解釋可以當作錯誤選項時無法出題
+		if definition == "" || len(otherDefinitions) == 0 {
+			return model.Question{}, false
+		}
+
+		// 選項依字母排序，避免正確的解釋固定出現在同一個位置
+		choices := append([]string{definition}, otherDefinitions...)
+		sort.Strings(choices)
+
+		return model.Question{
+			Ask:     word,
+			Answers: []string{definition},
+			Choices: choices,
+		}, true
+	case PRACTICE_QUESTION_TYPE_CLOZE:
+		// 不分大小寫，只比對完整的單字，避免 "cat" 比對到 "category"
+		wordRegexp := regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(word) + `\b`)
+
+		for _, sentence := range practiceWord.Sentences {
+			// 每個出現單字的地方都是一個空格，答案依序使用例句中的寫法，例如句首的 "Book"
+			answers := wordRegexp.FindAllString(sentence, -1)
+			if len(answers) == 0 {
+				continue
+			}
+
+			return model.Question{
+				Ask:     wordRegexp.ReplaceAllLiteralString(sentence, clozeBlank),
+				Answers: answers,
+			}, true
+		}
+
+		return model.Question{}, false
+	default:
+		return model.Question{}, false
+	}
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/model"
+)
+
+// 批次修改題目的操作類型
+const (
+	QUESTION_OPERATION_CREATE = "create"
+	QUESTION_OPERATION_UPDATE = "update"
+	QUESTION_OPERATION_DELETE = "delete"
+)
+
+// 一次批次修改最多的操作數
+const maxQuestionOperationCount = 200
+
+// 批次修改題目的一個操作，QuestionId 只用於 update 和 delete
+type QuestionOperation struct {
+	Type          string
+	QuestionId    string
+	Ask           string
+	Answers       []string
+	Points        int32
+	PartialCredit bool
+	Hint          string
+	Explanation   string
+	SectionId     string
+}
+
+// 每個操作的結果，Error 不是空字串時表示該操作不合法，整批操作都不會執行
+type QuestionOperationResult struct {
+	Type       string
+	QuestionId string
+	Error      string
+}
+
+/*
+檢查每個操作是否合法，questions 是 update 和 delete 要修改的題目，
+sections 是測驗的題組，回傳的結果與 operations 的順序相同
+*/
+func validateQuestionOperations(
+	examId string,
+	operations []QuestionOperation,
+	questions []model.Question,
+	sections []model.ExamSection,
+) (results []QuestionOperationResult, isValid bool) {
+	questionsById := map[string]model.Question{}
+
+	for _, question := range questions {
+		questionsById[question.Id.Hex()] = question
+	}
+
+	sectionIds := map[string]bool{}
+
+	for _, section := range sections {
+		sectionIds[section.Id.Hex()] = true
+	}
+
+	results = []QuestionOperationResult{}
+	isValid = true
+	usedQuestionIds := map[string]bool{}
+
+	for _, operation := range operations {
+		result := QuestionOperationResult{
+			Type:       operation.Type,
+			QuestionId: operation.QuestionId,
+		}
+
+		switch operation.Type {
+		case QUESTION_OPERATION_CREATE:
+			result.Error = validateQuestionContent(operation, sectionIds)
+		case QUESTION_OPERATION_UPDATE, QUESTION_OPERATION_DELETE:
+			question, ok := questionsById[operation.QuestionId]
+
+			switch {
+			case !ok:
+				result.Error = fmt.Sprintf("Question not found by id: %s", operation.QuestionId)
+			case question.ExamId != examId:
+				// 只能修改同一個測驗的題目
+				result.Error = unauthorizedOperationError.Error()
+			case usedQuestionIds[operation.QuestionId]:
+				result.Error = fmt.Sprintf("Duplicate question id: %s", operation.QuestionId)
+			case operation.Type == QUESTION_OPERATION_UPDATE:
+				result.Error = validateQuestionContent(operation, sectionIds)
+			}
+
+			usedQuestionIds[operation.QuestionId] = true
+		default:
+			result.Error = fmt.Sprintf("Invalid operation type: %s", operation.Type)
+		}
+
+		if result.Error != "" {
+			isValid = false
+		}
+
+		results = append(results, result)
+	}
+
+	return results, isValid
+}
+
+func validateQuestionContent(operation QuestionOperation, sectionIds map[string]bool) string {
+	if strings.TrimSpace(operation.Ask) == "" {
+		return "Ask is empty"
+	}
+
+	if err := validateQuestionPoints(operation.Points); err != nil {
+		return err.Error()
+	}
+
+	if err := validateQuestionHint(operation.Hint, operation.Explanation); err != nil {
+		return err.Error()
+	}
+
+	// 題組必須屬於同一個測驗
+	if operation.SectionId != "" && !sectionIds[operation.SectionId] {
+		return fmt.Sprintf("Exam section not found by id: %s", operation.SectionId)
+	}
+
+	for _, answer := range operation.Answers {
+		if strings.TrimSpace(answer) != "" {
+			return ""
+		}
+	}
+
+	return "Answers is empty"
+}
+
+// 找出 update 和 delete 要修改的題目 id，格式不正確的 id 不需要查詢
+func operationQuestionIds(operations []QuestionOperation) []string {
+	questionIds := []string{}
+
+	for _, operation := range operations {
+		if operation.Type != QUESTION_OPERATION_UPDATE &&
+			operation.Type != QUESTION_OPERATION_DELETE {
+			continue
+		}
+
+		if _, err := primitive.ObjectIDFromHex(operation.QuestionId); err == nil {
+			questionIds = append(questionIds, operation.QuestionId)
+		}
+	}
+
+	return questionIds
+}
+
+// 是否有操作指定了題組，沒有時不需要查詢測驗的題組
+func hasOperationSectionId(operations []QuestionOperation) bool {
+	for _, operation := range operations {
+		if operation.SectionId != "" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+const (
+	maxHintLength        = 500
+	maxExplanationLength = 2000
+)
+
+// 提示和解說都是選填的，只限制長度
+func validateQuestionHint(hint, explanation string) error {
+	if utf8.RuneCountInString(hint) > maxHintLength {
+		return fmt.Errorf("Hint is too long: %d", utf8.RuneCountInString(hint))
+	}
+
+	if utf8.RuneCountInString(explanation) > maxExplanationLength {
+		return fmt.Errorf("Explanation is too long: %d", utf8.RuneCountInString(explanation))
+	}
+
+	return nil
+}
+
+// 查看提示的題目依照測驗設定扣除分數比例
+func applyHintPenalty(credit float64, hintUsed bool, hintPenaltyPercent int32) float64 {
+	if !hintUsed || hintPenaltyPercent == 0 {
+		return credit
+	}
+
+	return credit * float64(100-hintPenaltyPercent) / 100
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/model"
+	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/repository"
+)
+
+// 計算鑑別度時，高分組與低分組各取作答紀錄的比例
+const discriminationGroupRatio = 0.27
+
+// 題目的分析結果，這不是 MongoDB 的 document，所以不放在 model 目錄之下
+type QuestionStatistic struct {
+	QuestionId          string
+	Ask                 string
+	AttemptCount        int32
+	WrongCount          int32
+	ErrorRate           float64
+	DiscriminationIndex float64
+}
+
+/*
+計算每個題目的答錯率和鑑別度，作答次數在 MongoDB 彙總，
+鑑別度是百分比最高與最低各 27% 作答紀錄的答對率差距，任一組沒有作答該題時為 0
+*/
+func newQuestionStatistics(
+	questions []model.Question,
+	questionAttemptCounts []repository.QuestionAttemptCount,
+) []QuestionStatistic {
+	questionAttemptCountMap := map[string]repository.QuestionAttemptCount{}
+
+	for _, questionAttemptCount := range questionAttemptCounts {
+		questionAttemptCountMap[questionAttemptCount.QuestionId] = questionAttemptCount
+	}
+
+	questionStatistics := []QuestionStatistic{}
+
+	for _, question := range questions {
+		questionId := question.Id.Hex()
+		questionAttemptCount := questionAttemptCountMap[questionId]
+
+		questionStatistic := QuestionStatistic{
+			QuestionId:   questionId,
+			Ask:          question.Ask,
+			AttemptCount: questionAttemptCount.AttemptCount,
+			WrongCount:   questionAttemptCount.WrongCount,
+		}
+
+		if questionAttemptCount.AttemptCount > 0 {
+			questionStatistic.ErrorRate = float64(questionAttemptCount.WrongCount) /
+				float64(questionAttemptCount.AttemptCount)
+		}
+
+		if questionAttemptCount.TopAttemptCount > 0 &&
+			questionAttemptCount.BottomAttemptCount > 0 {
+			questionStatistic.DiscriminationIndex = correctRate(
+				questionAttemptCount.TopAttemptCount,
+				questionAttemptCount.TopWrongCount,
+			) - correctRate(
+				questionAttemptCount.BottomAttemptCount,
+				questionAttemptCount.BottomWrongCount,
+			)
+		}
+
+		questionStatistics = append(questionStatistics, questionStatistic)
+	}
+
+	return questionStatistics
+}
+
+func correctRate(attemptCount, wrongCount int32) float64 {
+	return float64(attemptCount-wrongCount) / float64(attemptCount)
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 每個測驗最多的標籤數與每個標籤最大長度
+const (
+	maxTagCount  = 10
+	maxTagLength = 30
+)
+
+// 標籤雲最多回傳的標籤數
+const maxTagCloudSize = 100
+
+// 標籤統一轉小寫，並合併多餘的空白，例如 "  Daily   English " => "daily english"
+func normalizeTag(tag string) string {
+	return strings.Join(strings.Fields(strings.ToLower(tag)), " ")
+}
+
+// 正規化標籤，移除空白與重複的標籤，並保持原本的順序
+func normalizeTags(tags []string) ([]string, error) {
+	result := []string{}
+
+	for _, tag := range tags {
+		tag = normalizeTag(tag)
+
+		if tag == "" {
+			continue
+		}
+
+		if len([]rune(tag)) > maxTagLength {
+			return nil, fmt.Errorf("Tag is too long: %s", tag)
+		}
+
+		isDuplicate := false
+
+		for _, existingTag := range result {
+			if tag == existingTag {
+				isDuplicate = true
+				break
+			}
+		}
+
+		if !isDuplicate {
+			result = append(result, tag)
+		}
+	}
+
+	if len(result) > maxTagCount {
+		return nil, fmt.Errorf("Too many tags: %d", len(result))
+	}
+
+	return result, nil
+}
@@ -7,11 +7,15 @@ import (
 
 	gt "github.com/go-kit/kit/transport/grpc"
 	"github.com/go-kit/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/kakurineuin/learn-english-microservices/exam-service/pb"
 	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/endpoint"
 	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/model"
+	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/repository"
+	"github.com/kakurineuin/learn-english-microservices/exam-service/pkg/service"
 )
 
 const TIMEOUT = 20 * time.Second
@@ -19,22 +23,61 @@ const TIMEOUT = 20 * time.Second
 type GRPCServer struct {
 	logger log.Logger
 
-	createExam gt.Handler
-	updateExam gt.Handler
-	findExams  gt.Handler
-	deleteExam gt.Handler
-
-	createQuestion      gt.Handler
-	updateQuestion      gt.Handler
-	findQuestions       gt.Handler
-	deleteQuestion      gt.Handler
-	findRandomQuestions gt.Handler
+	createExam         gt.Handler
+	updateExam         gt.Handler
+	getExam            gt.Handler
+	findExams          gt.Handler
+	deleteExam         gt.Handler
+	createPracticeExam gt.Handler
+	findExamsByTags    gt.Handler
+	findExamTagCounts  gt.Handler
+	forkExam           gt.Handler
+	publishExam        gt.Handler
+	shareExam          gt.Handler
+	unshareExam        gt.Handler
+	findExamShares     gt.Handler
+	importExam         gt.Handler
+	exportExam         gt.Handler
+
+	createQuestion       gt.Handler
+	updateQuestion       gt.Handler
+	getQuestion          gt.Handler
+	findQuestions        gt.Handler
+	deleteQuestion       gt.Handler
+	batchMutateQuestions gt.Handler
+	findRandomQuestions  gt.Handler
+	revealQuestionHint   gt.Handler
+
+	createBankQuestion gt.Handler
+	updateBankQuestion gt.Handler
+	deleteBankQuestion gt.Handler
+	findBankQuestions  gt.Handler
+	setExamBankSource  gt.Handler
+
+	createExamSection gt.Handler
+	updateExamSection gt.Handler
+	deleteExamSection gt.Handler
+	findExamSections  gt.Handler
 
 	createExamRecord       gt.Handler
 	findExamRecords        gt.Handler
+	getExamRecordDetail    gt.Handler
 	findExamRecordOverview gt.Handler
-
-	findExamInfos gt.Handler
+	findExamLeaderboard    gt.Handler
+	findQuestionStatistics gt.Handler
+
+	findMistakeNotebook gt.Handler
+	createMistakeDrill  gt.Handler
+	answerMistakeDrill  gt.Handler
+
+	findExamInfos      gt.Handler
+	findExamCatalog    gt.Handler
+	createClass        gt.Handler
+	joinClass          gt.Handler
+	findClasses        gt.Handler
+	createAssignment   gt.Handler
+	findAssignments    gt.Handler
+	findClassGradebook gt.Handler
 
 	pb.UnimplementedExamServiceServer
 }
@@ -55,6 +98,11 @@ func NewGRPCServer(endpointds endpoint.Endpoints, logger log.Logger) pb.ExamServ
 			decodeUpdateExamRequest,
 			encodeUpdateExamResponse,
 		),
+		getExam: gt.NewServer(
+			endpointds.GetExam,
+			decodeGetExamRequest,
+			encodeGetExamResponse,
+		),
 		findExams: gt.NewServer(
 			endpointds.FindExams,
 			decodeFindExamsRequest,
@@ -65,6 +113,56 @@ func NewGRPCServer(endpointds endpoint.Endpoints, logger log.Logger) pb.ExamServ
 			decodeDeleteExamRequest,
 			encodeDeleteExamResponse,
 		),
+		createPracticeExam: gt.NewServer(
+			endpointds.CreatePracticeExam,
+			decodeCreatePracticeExamRequest,
+			encodeCreatePracticeExamResponse,
+		),
+		findExamsByTags: gt.NewServer(
+			endpointds.FindExamsByTags,
+			decodeFindExamsByTagsRequest,
+			encodeFindExamsByTagsResponse,
+		),
+		findExamTagCounts: gt.NewServer(
+			endpointds.FindExamTagCounts,
+			decodeFindExamTagCountsRequest,
+			encodeFindExamTagCountsResponse,
+		),
+		forkExam: gt.NewServer(
+			endpointds.ForkExam,
+			decodeForkExamRequest,
+			encodeForkExamResponse,
+		),
+		publishExam: gt.NewServer(
+			endpointds.PublishExam,
+			decodePublishExamRequest,
+			encodePublishExamResponse,
+		),
+		shareExam: gt.NewServer(
+			endpointds.ShareExam,
+			decodeShareExamRequest,
+			encodeShareExamResponse,
+		),
+		unshareExam: gt.NewServer(
+			endpointds.UnshareExam,
+			decodeUnshareExamRequest,
+			encodeUnshareExamResponse,
+		),
+		findExamShares: gt.NewServer(
+			endpointds.FindExamShares,
+			decodeFindExamSharesRequest,
+			encodeFindExamSharesResponse,
+		),
+		importExam: gt.NewServer(
+			endpointds.ImportExam,
+			decodeImportExamRequest,
+			encodeImportExamResponse,
+		),
+		exportExam: gt.NewServer(
+			endpointds.ExportExam,
+			decodeExportExamRequest,
+			encodeExportExamResponse,
+		),
 
 		// Question
 		createQuestion: gt.NewServer(
@@ -77,6 +175,11 @@ func NewGRPCServer(endpointds endpoint.Endpoints, logger log.Logger) pb.ExamServ
 			decodeUpdateQuestionRequest,
 			encodeUpdateQuestionResponse,
 		),
+		getQuestion: gt.NewServer(
+			endpointds.GetQuestion,
+			decodeGetQuestionRequest,
+			encodeGetQuestionResponse,
+		),
 		findQuestions: gt.NewServer(
 			endpointds.FindQuestions,
 			decodeFindQuestionsRequest,
@@ -87,11 +190,70 @@ func NewGRPCServer(endpointds endpoint.Endpoints, logger log.Logger) pb.ExamServ
 			decodeDeleteQuestionRequest,
 			encodeDeleteQuestionResponse,
 		),
+		batchMutateQuestions: gt.NewServer(
+			endpointds.BatchMutateQuestions,
+			decodeBatchMutateQuestionsRequest,
+			encodeBatchMutateQuestionsResponse,
+		),
 		findRandomQuestions: gt.NewServer(
 			endpointds.FindRandomQuestions,
 			decodeFindRandomQuestionsRequest,
 			encodeFindRandomQuestionsResponse,
 		),
+		revealQuestionHint: gt.NewServer(
+			endpointds.RevealQuestionHint,
+			decodeRevealQuestionHintRequest,
+			encodeRevealQuestionHintResponse,
+		),
+
+		// BankQuestion
+		createBankQuestion: gt.NewServer(
+			endpointds.CreateBankQuestion,
+			decodeCreateBankQuestionRequest,
+			encodeCreateBankQuestionResponse,
+		),
+		updateBankQuestion: gt.NewServer(
+			endpointds.UpdateBankQuestion,
+			decodeUpdateBankQuestionRequest,
+			encodeUpdateBankQuestionResponse,
+		),
+		deleteBankQuestion: gt.NewServer(
+			endpointds.DeleteBankQuestion,
+			decodeDeleteBankQuestionRequest,
+			encodeDeleteBankQuestionResponse,
+		),
+		findBankQuestions: gt.NewServer(
+			endpointds.FindBankQuestions,
+			decodeFindBankQuestionsRequest,
+			encodeFindBankQuestionsResponse,
+		),
+		setExamBankSource: gt.NewServer(
+			endpointds.SetExamBankSource,
+			decodeSetExamBankSourceRequest,
+			encodeSetExamBankSourceResponse,
+		),
+
+		// ExamSection
+		createExamSection: gt.NewServer(
+			endpointds.CreateExamSection,
+			decodeCreateExamSectionRequest,
+			encodeCreateExamSectionResponse,
+		),
+		updateExamSection: gt.NewServer(
+			endpointds.UpdateExamSection,
+			decodeUpdateExamSectionRequest,
+			encodeUpdateExamSectionResponse,
+		),
+		deleteExamSection: gt.NewServer(
+			endpointds.DeleteExamSection,
+			decodeDeleteExamSectionRequest,
+			encodeDeleteExamSectionResponse,
+		),
+		findExamSections: gt.NewServer(
+			endpointds.FindExamSections,
+			decodeFindExamSectionsRequest,
+			encodeFindExamSectionsResponse,
+		),
 
 		// ExamRecord
 		createExamRecord: gt.NewServer(
@@ -104,11 +266,43 @@ func NewGRPCServer(endpointds endpoint.Endpoints, logger log.Logger) pb.ExamServ
 			decodeFindExamRecordsRequest,
 			encodeFindExamRecordsResponse,
 		),
+		getExamRecordDetail: gt.NewServer(
+			endpointds.GetExamRecordDetail,
+			decodeGetExamRecordDetailRequest,
+			encodeGetExamRecordDetailResponse,
+		),
 		findExamRecordOverview: gt.NewServer(
 			endpointds.FindExamRecordOverview,
 			decodeFindExamRecordOverviewRequest,
 			encodeFindExamRecordOverviewResponse,
 		),
+		findExamLeaderboard: gt.NewServer(
+			endpointds.FindExamLeaderboard,
+			decodeFindExamLeaderboardRequest,
+			encodeFindExamLeaderboardResponse,
+		),
+		findQuestionStatistics: gt.NewServer(
+			endpointds.FindQuestionStatistics,
+			decodeFindQuestionStatisticsRequest,
+			encodeFindQuestionStatisticsResponse,
+		),
+
+		// MistakeNotebook
+		findMistakeNotebook: gt.NewServer(
+			endpointds.FindMistakeNotebook,
+			decodeFindMistakeNotebookRequest,
+			encodeFindMistakeNotebookResponse,
+		),
+		createMistakeDrill: gt.NewServer(
+			endpointds.CreateMistakeDrill,
+			decodeCreateMistakeDrillRequest,
+			encodeCreateMistakeDrillResponse,
+		),
+		answerMistakeDrill: gt.NewServer(
+			endpointds.AnswerMistakeDrill,
+			decodeAnswerMistakeDrillRequest,
+			encodeAnswerMistakeDrillResponse,
+		),
 
 		// ExamInfo
 		findExamInfos: gt.NewServer(
@@ -116,6 +310,41 @@ func NewGRPCServer(endpointds endpoint.Endpoints, logger log.Logger) pb.ExamServ
 			decodeFindExamInfosRequest,
 			encodeFindExamInfosResponse,
 		),
+		findExamCatalog: gt.NewServer(
+			endpointds.FindExamCatalog,
+			decodeFindExamCatalogRequest,
+			encodeFindExamCatalogResponse,
+		),
+		createClass: gt.NewServer(
+			endpointds.CreateClass,
+			decodeCreateClassRequest,
+			encodeCreateClassResponse,
+		),
+		joinClass: gt.NewServer(
+			endpointds.JoinClass,
+			decodeJoinClassRequest,
+			encodeJoinClassResponse,
+		),
+		findClasses: gt.NewServer(
+			endpointds.FindClasses,
+			decodeFindClassesRequest,
+			encodeFindClassesResponse,
+		),
+		createAssignment: gt.NewServer(
+			endpointds.CreateAssignment,
+			decodeCreateAssignmentRequest,
+			encodeCreateAssignmentResponse,
+		),
+		findAssignments: gt.NewServer(
+			endpointds.FindAssignments,
+			decodeFindAssignmentsRequest,
+			encodeFindAssignmentsResponse,
+		),
+		findClassGradebook: gt.NewServer(
+			endpointds.FindClassGradebook,
+			decodeFindClassGradebookRequest,
+			encodeFindClassGradebookResponse,
+		),
 	}
 }
 
@@ -127,7 +356,7 @@ func (s GRPCServer) CreateExam(
 	defer cancel()
 	_, resp, err := s.createExam.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, toGRPCError(err)
 	}
 
 	return resp.(*pb.CreateExamResponse), nil
@@ -142,6 +371,7 @@ func decodeCreateExamRequest(_ context.Context, request interface{}) (interface{
 	return endpoint.CreateExamRequest{
 		Topic:       req.Topic,
 		Description: req.Description,
+		Tags:        req.Tags,
 		IsPublic:    req.IsPublic,
 		UserId:      req.UserId,
 	}, nil
@@ -166,7 +396,7 @@ func (s GRPCServer) UpdateExam(
 	defer cancel()
 	_, resp, err := s.updateExam.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, toGRPCError(err)
 	}
 
 	return resp.(*pb.UpdateExamResponse), nil
@@ -179,11 +409,14 @@ func decodeUpdateExamRequest(_ context.Context, request interface{}) (interface{
 	}
 
 	return endpoint.UpdateExamRequest{
-		ExamId:      req.ExamId,
-		Topic:       req.Topic,
-		Description: req.Description,
-		IsPublic:    req.IsPublic,
-		UserId:      req.UserId,
+		ExamId:          req.ExamId,
+		Topic:           req.Topic,
+		Description:     req.Description,
+		Tags:            req.Tags,
+		IsPublic:        req.IsPublic,
+		Settings:        toExamSettings(req.Settings),
+		ExpectedVersion: req.ExpectedVersion,
+		UserId:          req.UserId,
 	}, nil
 }
 
@@ -194,7 +427,45 @@ func encodeUpdateExamResponse(_ context.Context, response interface{}) (interfac
 	}
 
 	return &pb.UpdateExamResponse{
-		ExamId: resp.ExamId,
+		ExamId:  resp.ExamId,
+		Version: resp.Version,
+	}, nil
+}
+
+func (s GRPCServer) GetExam(
+	ctx context.Context,
+	req *pb.GetExamRequest,
+) (*pb.GetExamResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.getExam.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.GetExamResponse), nil
+}
+
+func decodeGetExamRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.GetExamRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.GetExamRequest{
+		ExamId: req.ExamId,
+		UserId: req.UserId,
+	}, nil
+}
+
+func encodeGetExamResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.GetExamResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.GetExamResponse{
+		Exam: toPBExam(resp.Exam),
 	}, nil
 }
 
@@ -206,7 +477,7 @@ func (s GRPCServer) FindExams(
 	defer cancel()
 	_, resp, err := s.findExams.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, toGRPCError(err)
 	}
 
 	return resp.(*pb.FindExamsResponse), nil
@@ -222,6 +493,7 @@ func decodeFindExamsRequest(_ context.Context, request interface{}) (interface{}
 		PageIndex: req.PageIndex,
 		PageSize:  req.PageSize,
 		UserId:    req.UserId,
+		Cursor:    req.Cursor,
 	}, nil
 }
 
@@ -238,9 +510,10 @@ func encodeFindExamsResponse(_ context.Context, response interface{}) (interface
 	}
 
 	return &pb.FindExamsResponse{
-		Total:     resp.Total,
-		PageCount: resp.PageCount,
-		Exams:     exams,
+		Total:      resp.Total,
+		PageCount:  resp.PageCount,
+		Exams:      exams,
+		NextCursor: resp.NextCursor,
 	}, nil
 }
 
@@ -252,7 +525,7 @@ func (s GRPCServer) DeleteExam(
 	defer cancel()
 	_, resp, err := s.deleteExam.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, toGRPCError(err)
 	}
 
 	return resp.(*pb.DeleteExamResponse), nil
@@ -279,430 +552,2203 @@ func encodeDeleteExamResponse(_ context.Context, response interface{}) (interfac
 	return &pb.DeleteExamResponse{}, nil
 }
 
-func (s GRPCServer) CreateQuestion(
+func (s GRPCServer) CreatePracticeExam(
 	ctx context.Context,
-	req *pb.CreateQuestionRequest,
-) (*pb.CreateQuestionResponse, error) {
+	req *pb.CreatePracticeExamRequest,
+) (*pb.CreatePracticeExamResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
 	defer cancel()
-	_, resp, err := s.createQuestion.ServeGRPC(ctx, req)
+	_, resp, err := s.createPracticeExam.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, toGRPCError(err)
 	}
 
-	return resp.(*pb.CreateQuestionResponse), nil
+	return resp.(*pb.CreatePracticeExamResponse), nil
 }
 
-func decodeCreateQuestionRequest(_ context.Context, request interface{}) (interface{}, error) {
-	req, ok := request.(*pb.CreateQuestionRequest)
+func decodeCreatePracticeExamRequest(
+	_ context.Context,
+	request interface{},
+) (interface{}, error) {
+	req, ok := request.(*pb.CreatePracticeExamRequest)
 	if !ok {
 		return nil, errors.New("invalid request body")
 	}
 
-	return endpoint.CreateQuestionRequest{
-		ExamId:  req.ExamId,
-		Ask:     req.Ask,
-		Answers: req.Answers,
-		UserId:  req.UserId,
+	practiceWords := []service.PracticeWord{}
+
+	for _, pbPracticeWord := range req.PracticeWords {
+		practiceWords = append(practiceWords, service.PracticeWord{
+			WordMeaningId: pbPracticeWord.WordMeaningId,
+			Word:          pbPracticeWord.Word,
+			Definition:    pbPracticeWord.Definition,
+			Sentences:     pbPracticeWord.Sentences,
+		})
+	}
+
+	return endpoint.CreatePracticeExamRequest{
+		Topic:         req.Topic,
+		Description:   req.Description,
+		PracticeWords: practiceWords,
+		QuestionTypes: req.QuestionTypes,
+		UserId:        req.UserId,
 	}, nil
 }
 
-func encodeCreateQuestionResponse(_ context.Context, response interface{}) (interface{}, error) {
-	resp, ok := response.(endpoint.CreateQuestionResponse)
+func encodeCreatePracticeExamResponse(
+	_ context.Context,
+	response interface{},
+) (interface{}, error) {
+	resp, ok := response.(endpoint.CreatePracticeExamResponse)
 	if !ok {
 		return nil, errors.New("invalid response body")
 	}
 
-	return &pb.CreateQuestionResponse{
-		QuestionId: resp.QuestionId,
+	return &pb.CreatePracticeExamResponse{
+		ExamId:        resp.ExamId,
+		QuestionCount: resp.QuestionCount,
 	}, nil
 }
 
-func (s GRPCServer) UpdateQuestion(
+func (s GRPCServer) FindExamsByTags(
 	ctx context.Context,
-	req *pb.UpdateQuestionRequest,
-) (*pb.UpdateQuestionResponse, error) {
+	req *pb.FindExamsByTagsRequest,
+) (*pb.FindExamsByTagsResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
 	defer cancel()
-	_, resp, err := s.updateQuestion.ServeGRPC(ctx, req)
+	_, resp, err := s.findExamsByTags.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, toGRPCError(err)
 	}
 
-	return resp.(*pb.UpdateQuestionResponse), nil
+	return resp.(*pb.FindExamsByTagsResponse), nil
 }
 
-func decodeUpdateQuestionRequest(_ context.Context, request interface{}) (interface{}, error) {
-	req, ok := request.(*pb.UpdateQuestionRequest)
+func decodeFindExamsByTagsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.FindExamsByTagsRequest)
 	if !ok {
 		return nil, errors.New("invalid request body")
 	}
 
-	return endpoint.UpdateQuestionRequest{
-		QuestionId: req.QuestionId,
-		Ask:        req.Ask,
-		Answers:    req.Answers,
-		UserId:     req.UserId,
+	return endpoint.FindExamsByTagsRequest{
+		Tags:      req.Tags,
+		UserId:    req.UserId,
+		PageIndex: req.PageIndex,
+		PageSize:  req.PageSize,
 	}, nil
 }
 
-func encodeUpdateQuestionResponse(_ context.Context, response interface{}) (interface{}, error) {
-	resp, ok := response.(endpoint.UpdateQuestionResponse)
+func encodeFindExamsByTagsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.FindExamsByTagsResponse)
 	if !ok {
 		return nil, errors.New("invalid response body")
 	}
 
-	return &pb.UpdateQuestionResponse{
-		QuestionId: resp.QuestionId,
+	exams := []*pb.Exam{}
+
+	for _, exam := range resp.Exams {
+		exams = append(exams, toPBExam(&exam))
+	}
+
+	return &pb.FindExamsByTagsResponse{
+		Total:     resp.Total,
+		PageCount: resp.PageCount,
+		Exams:     exams,
 	}, nil
 }
 
-func (s GRPCServer) FindQuestions(
+func (s GRPCServer) FindExamTagCounts(
 	ctx context.Context,
-	req *pb.FindQuestionsRequest,
-) (*pb.FindQuestionsResponse, error) {
+	req *pb.FindExamTagCountsRequest,
+) (*pb.FindExamTagCountsResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
 	defer cancel()
-	_, resp, err := s.findQuestions.ServeGRPC(ctx, req)
+	_, resp, err := s.findExamTagCounts.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, toGRPCError(err)
 	}
 
-	return resp.(*pb.FindQuestionsResponse), nil
+	return resp.(*pb.FindExamTagCountsResponse), nil
 }
 
-func decodeFindQuestionsRequest(_ context.Context, request interface{}) (interface{}, error) {
-	req, ok := request.(*pb.FindQuestionsRequest)
+func decodeFindExamTagCountsRequest(
+	_ context.Context,
+	request interface{},
+) (interface{}, error) {
+	req, ok := request.(*pb.FindExamTagCountsRequest)
 	if !ok {
 		return nil, errors.New("invalid request body")
 	}
 
-	return endpoint.FindQuestionsRequest{
-		PageIndex: req.PageIndex,
-		PageSize:  req.PageSize,
-		ExamId:    req.ExamId,
-		UserId:    req.UserId,
+	return endpoint.FindExamTagCountsRequest{
+		UserId: req.UserId,
+		Size:   req.Size,
 	}, nil
 }
 
-func encodeFindQuestionsResponse(_ context.Context, response interface{}) (interface{}, error) {
-	resp, ok := response.(endpoint.FindQuestionsResponse)
+func encodeFindExamTagCountsResponse(
+	_ context.Context,
+	response interface{},
+) (interface{}, error) {
+	resp, ok := response.(endpoint.FindExamTagCountsResponse)
 	if !ok {
 		return nil, errors.New("invalid response body")
 	}
 
-	questions := []*pb.Question{}
+	tagCounts := []*pb.TagCount{}
 
-	for _, question := range resp.Questions {
-		questions = append(questions, toPBQuestion(&question))
+	for _, tagCount := range resp.TagCounts {
+		tagCounts = append(tagCounts, &pb.TagCount{
+			Tag:   tagCount.Tag,
+			Count: tagCount.Count,
+		})
 	}
 
-	return &pb.FindQuestionsResponse{
-		Total:     resp.Total,
-		PageCount: resp.PageCount,
-		Questions: questions,
+	return &pb.FindExamTagCountsResponse{
+		TagCounts: tagCounts,
 	}, nil
 }
 
-func (s GRPCServer) DeleteQuestion(
+func (s GRPCServer) ForkExam(
 	ctx context.Context,
-	req *pb.DeleteQuestionRequest,
-) (*pb.DeleteQuestionResponse, error) {
+	req *pb.ForkExamRequest,
+) (*pb.ForkExamResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
 	defer cancel()
-	_, resp, err := s.deleteQuestion.ServeGRPC(ctx, req)
+	_, resp, err := s.forkExam.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, toGRPCError(err)
 	}
 
-	return resp.(*pb.DeleteQuestionResponse), nil
+	return resp.(*pb.ForkExamResponse), nil
 }
 
-func decodeDeleteQuestionRequest(_ context.Context, request interface{}) (interface{}, error) {
-	req, ok := request.(*pb.DeleteQuestionRequest)
+func decodeForkExamRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.ForkExamRequest)
 	if !ok {
 		return nil, errors.New("invalid request body")
 	}
 
-	return endpoint.DeleteQuestionRequest{
-		QuestionId: req.QuestionId,
-		UserId:     req.UserId,
+	return endpoint.ForkExamRequest{
+		ExamId: req.ExamId,
+		UserId: req.UserId,
 	}, nil
 }
 
-func encodeDeleteQuestionResponse(_ context.Context, response interface{}) (interface{}, error) {
-	_, ok := response.(endpoint.DeleteQuestionResponse)
+func encodeForkExamResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.ForkExamResponse)
 	if !ok {
 		return nil, errors.New("invalid response body")
 	}
 
-	return &pb.DeleteQuestionResponse{}, nil
+	return &pb.ForkExamResponse{
+		ExamId:        resp.ExamId,
+		QuestionCount: resp.QuestionCount,
+	}, nil
 }
 
-func (s GRPCServer) FindRandomQuestions(
+func (s GRPCServer) PublishExam(
 	ctx context.Context,
-	req *pb.FindRandomQuestionsRequest,
-) (*pb.FindRandomQuestionsResponse, error) {
+	req *pb.PublishExamRequest,
+) (*pb.PublishExamResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
 	defer cancel()
-	_, resp, err := s.findRandomQuestions.ServeGRPC(ctx, req)
+	_, resp, err := s.publishExam.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, toGRPCError(err)
 	}
 
-	return resp.(*pb.FindRandomQuestionsResponse), nil
+	return resp.(*pb.PublishExamResponse), nil
 }
 
-func decodeFindRandomQuestionsRequest(_ context.Context, request interface{}) (interface{}, error) {
-	req, ok := request.(*pb.FindRandomQuestionsRequest)
+func decodePublishExamRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.PublishExamRequest)
 	if !ok {
 		return nil, errors.New("invalid request body")
 	}
 
-	return endpoint.FindRandomQuestionsRequest{
+	return endpoint.PublishExamRequest{
 		ExamId: req.ExamId,
 		UserId: req.UserId,
-		Size:   req.Size,
 	}, nil
 }
 
-func encodeFindRandomQuestionsResponse(
-	_ context.Context,
-	response interface{},
-) (interface{}, error) {
-	resp, ok := response.(endpoint.FindRandomQuestionsResponse)
+func encodePublishExamResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.PublishExamResponse)
 	if !ok {
 		return nil, errors.New("invalid response body")
 	}
 
-	exam := toPBExam(resp.Exam)
-	questions := []*pb.Question{}
-
-	for _, question := range resp.Questions {
-		questions = append(questions, toPBQuestion(&question))
-	}
-
-	return &pb.FindRandomQuestionsResponse{
-		Exam:      exam,
-		Questions: questions,
+	return &pb.PublishExamResponse{
+		ExamId:        resp.ExamId,
+		Version:       resp.Version,
+		QuestionCount: resp.QuestionCount,
 	}, nil
 }
 
-func (s GRPCServer) CreateExamRecord(
+func (s GRPCServer) ShareExam(
 	ctx context.Context,
-	req *pb.CreateExamRecordRequest,
-) (*pb.CreateExamRecordResponse, error) {
+	req *pb.ShareExamRequest,
+) (*pb.ShareExamResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
 	defer cancel()
-	_, resp, err := s.createExamRecord.ServeGRPC(ctx, req)
+	_, resp, err := s.shareExam.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, toGRPCError(err)
 	}
 
-	return resp.(*pb.CreateExamRecordResponse), nil
+	return resp.(*pb.ShareExamResponse), nil
 }
 
-func decodeCreateExamRecordRequest(_ context.Context, request interface{}) (interface{}, error) {
-	req, ok := request.(*pb.CreateExamRecordRequest)
+func decodeShareExamRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.ShareExamRequest)
 	if !ok {
 		return nil, errors.New("invalid request body")
 	}
 
-	return endpoint.CreateExamRecordRequest{
-		ExamId:           req.ExamId,
-		Score:            req.Score,
-		WrongQuestionIds: req.WrongQuestionIds,
-		UserId:           req.UserId,
+	return endpoint.ShareExamRequest{
+		ExamId:       req.ExamId,
+		SharedUserId: req.SharedUserId,
+		Role:         req.Role,
+		UserId:       req.UserId,
 	}, nil
 }
 
-func encodeCreateExamRecordResponse(_ context.Context, response interface{}) (interface{}, error) {
-	_, ok := response.(endpoint.CreateExamRecordResponse)
+func encodeShareExamResponse(_ context.Context, response interface{}) (interface{}, error) {
+	_, ok := response.(endpoint.ShareExamResponse)
 	if !ok {
 		return nil, errors.New("invalid response body")
 	}
 
-	return &pb.CreateExamRecordResponse{}, nil
+	return &pb.ShareExamResponse{}, nil
 }
 
-func (s GRPCServer) FindExamRecords(
+func (s GRPCServer) UnshareExam(
 	ctx context.Context,
-	req *pb.FindExamRecordsRequest,
-) (*pb.FindExamRecordsResponse, error) {
+	req *pb.UnshareExamRequest,
+) (*pb.UnshareExamResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
 	defer cancel()
-	_, resp, err := s.findExamRecords.ServeGRPC(ctx, req)
+	_, resp, err := s.unshareExam.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, toGRPCError(err)
 	}
 
-	return resp.(*pb.FindExamRecordsResponse), nil
+	return resp.(*pb.UnshareExamResponse), nil
 }
 
-func decodeFindExamRecordsRequest(_ context.Context, request interface{}) (interface{}, error) {
-	req, ok := request.(*pb.FindExamRecordsRequest)
+func decodeUnshareExamRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.UnshareExamRequest)
 	if !ok {
 		return nil, errors.New("invalid request body")
 	}
 
-	return endpoint.FindExamRecordsRequest{
-		PageIndex: req.PageIndex,
-		PageSize:  req.PageSize,
-		ExamId:    req.ExamId,
-		UserId:    req.UserId,
+	return endpoint.UnshareExamRequest{
+		ExamId:       req.ExamId,
+		SharedUserId: req.SharedUserId,
+		UserId:       req.UserId,
 	}, nil
 }
 
-func encodeFindExamRecordsResponse(_ context.Context, response interface{}) (interface{}, error) {
-	resp, ok := response.(endpoint.FindExamRecordsResponse)
+func encodeUnshareExamResponse(_ context.Context, response interface{}) (interface{}, error) {
+	_, ok := response.(endpoint.UnshareExamResponse)
 	if !ok {
 		return nil, errors.New("invalid response body")
 	}
 
-	examRecords := []*pb.ExamRecord{}
-
-	for _, examRecord := range resp.ExamRecords {
-		examRecords = append(examRecords, toPBExamRecord(&examRecord))
-	}
-
-	return &pb.FindExamRecordsResponse{
-		Total:       resp.Total,
-		PageCount:   resp.PageCount,
-		ExamRecords: examRecords,
-	}, nil
+	return &pb.UnshareExamResponse{}, nil
 }
 
-func (s GRPCServer) FindExamRecordOverview(
+func (s GRPCServer) FindExamShares(
 	ctx context.Context,
-	req *pb.FindExamRecordOverviewRequest,
-) (*pb.FindExamRecordOverviewResponse, error) {
+	req *pb.FindExamSharesRequest,
+) (*pb.FindExamSharesResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
 	defer cancel()
-	_, resp, err := s.findExamRecordOverview.ServeGRPC(ctx, req)
+	_, resp, err := s.findExamShares.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, toGRPCError(err)
 	}
 
-	return resp.(*pb.FindExamRecordOverviewResponse), nil
+	return resp.(*pb.FindExamSharesResponse), nil
 }
 
-func decodeFindExamRecordOverviewRequest(
-	_ context.Context,
-	request interface{},
-) (interface{}, error) {
-	req, ok := request.(*pb.FindExamRecordOverviewRequest)
+func decodeFindExamSharesRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.FindExamSharesRequest)
 	if !ok {
 		return nil, errors.New("invalid request body")
 	}
 
-	return endpoint.FindExamRecordOverviewRequest{
-		ExamId:    req.ExamId,
-		UserId:    req.UserId,
-		StartDate: req.StartDate.AsTime(),
+	return endpoint.FindExamSharesRequest{
+		ExamId: req.ExamId,
+		UserId: req.UserId,
 	}, nil
 }
 
-func encodeFindExamRecordOverviewResponse(
-	_ context.Context,
-	response interface{},
-) (interface{}, error) {
-	resp, ok := response.(endpoint.FindExamRecordOverviewResponse)
+func encodeFindExamSharesResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.FindExamSharesResponse)
 	if !ok {
 		return nil, errors.New("invalid response body")
 	}
 
-	exam := toPBExam(resp.Exam)
-	questions := []*pb.Question{}
+	shares := []*pb.ExamShare{}
 
-	for _, question := range resp.Questions {
-		questions = append(questions, toPBQuestion(&question))
+	for _, share := range resp.Shares {
+		shares = append(shares, &pb.ExamShare{
+			UserId:    share.UserId,
+			Role:      share.Role,
+			CreatedAt: timestamppb.New(share.CreatedAt),
+		})
 	}
 
-	answerWrongs := []*pb.AnswerWrong{}
+	return &pb.FindExamSharesResponse{
+		Shares: shares,
+	}, nil
+}
 
-	for _, answerWrong := range resp.AnswerWrongs {
-		answerWrongs = append(answerWrongs, toPBAnswerWrong(&answerWrong))
-	}
+func (s GRPCServer) ImportExam(
+	ctx context.Context,
+	req *pb.ImportExamRequest,
+) (*pb.ImportExamResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.importExam.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.ImportExamResponse), nil
+}
+
+func decodeImportExamRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.ImportExamRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.ImportExamRequest{
+		Topic:       req.Topic,
+		Description: req.Description,
+		Tags:        req.Tags,
+		Format:      req.Format,
+		Content:     req.Content,
+		UserId:      req.UserId,
+	}, nil
+}
+
+func encodeImportExamResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.ImportExamResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	importErrors := []*pb.ExamImportError{}
+
+	for _, importError := range resp.ImportErrors {
+		importErrors = append(importErrors, &pb.ExamImportError{
+			Line:    importError.Line,
+			Message: importError.Message,
+		})
+	}
+
+	return &pb.ImportExamResponse{
+		ExamId:        resp.ExamId,
+		QuestionCount: resp.QuestionCount,
+		Errors:        importErrors,
+	}, nil
+}
+
+func (s GRPCServer) ExportExam(
+	req *pb.ExportExamRequest,
+	stream pb.ExamService_ExportExamServer,
+) error {
+	ctx, cancel := context.WithTimeout(stream.Context(), TIMEOUT)
+	defer cancel()
+	_, _, err := s.exportExam.ServeGRPC(ctx, exportExamStreamRequest{req, stream})
+	return err
+}
+
+// go-kit 的 gRPC transport 只支援 unary RPC，所以將 stream 和 request 一起傳給 decode
+type exportExamStreamRequest struct {
+	req    *pb.ExportExamRequest
+	stream pb.ExamService_ExportExamServer
+}
+
+func decodeExportExamRequest(_ context.Context, request interface{}) (interface{}, error) {
+	streamRequest, ok := request.(exportExamStreamRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	req := streamRequest.req
+	stream := streamRequest.stream
+
+	return endpoint.ExportExamRequest{
+		ExamId: req.ExamId,
+		UserId: req.UserId,
+		Format: req.Format,
+		Send: func(chunk []byte) error {
+			return stream.Send(&pb.ExportExamResponse{Chunk: chunk})
+		},
+	}, nil
+}
+
+func encodeExportExamResponse(_ context.Context, response interface{}) (interface{}, error) {
+	if _, ok := response.(endpoint.ExportExamResponse); !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.ExportExamResponse{}, nil
+}
+
+func (s GRPCServer) CreateQuestion(
+	ctx context.Context,
+	req *pb.CreateQuestionRequest,
+) (*pb.CreateQuestionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.createQuestion.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.CreateQuestionResponse), nil
+}
+
+func decodeCreateQuestionRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.CreateQuestionRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.CreateQuestionRequest{
+		ExamId:          req.ExamId,
+		Ask:             req.Ask,
+		Answers:         req.Answers,
+		AcceptedAnswers: toAcceptedAnswers(req.AcceptedAnswers),
+		Points:          req.Points,
+		PartialCredit:   req.PartialCredit,
+		Hint:            req.Hint,
+		Explanation:     req.Explanation,
+		SectionId:       req.SectionId,
+		UserId:          req.UserId,
+	}, nil
+}
+
+func encodeCreateQuestionResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.CreateQuestionResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.CreateQuestionResponse{
+		QuestionId: resp.QuestionId,
+	}, nil
+}
+
+func (s GRPCServer) UpdateQuestion(
+	ctx context.Context,
+	req *pb.UpdateQuestionRequest,
+) (*pb.UpdateQuestionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.updateQuestion.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.UpdateQuestionResponse), nil
+}
+
+func decodeUpdateQuestionRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.UpdateQuestionRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.UpdateQuestionRequest{
+		QuestionId:      req.QuestionId,
+		Ask:             req.Ask,
+		Answers:         req.Answers,
+		AcceptedAnswers: toAcceptedAnswers(req.AcceptedAnswers),
+		Points:          req.Points,
+		PartialCredit:   req.PartialCredit,
+		Hint:            req.Hint,
+		Explanation:     req.Explanation,
+		SectionId:       req.SectionId,
+		ExpectedVersion: req.ExpectedVersion,
+		UserId:          req.UserId,
+	}, nil
+}
+
+func encodeUpdateQuestionResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.UpdateQuestionResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.UpdateQuestionResponse{
+		QuestionId: resp.QuestionId,
+		Version:    resp.Version,
+	}, nil
+}
+
+func (s GRPCServer) GetQuestion(
+	ctx context.Context,
+	req *pb.GetQuestionRequest,
+) (*pb.GetQuestionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.getQuestion.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.GetQuestionResponse), nil
+}
+
+func decodeGetQuestionRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.GetQuestionRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.GetQuestionRequest{
+		QuestionId: req.QuestionId,
+		UserId:     req.UserId,
+	}, nil
+}
+
+func encodeGetQuestionResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.GetQuestionResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.GetQuestionResponse{
+		Question: toPBQuestion(resp.Question),
+	}, nil
+}
+
+func (s GRPCServer) FindQuestions(
+	ctx context.Context,
+	req *pb.FindQuestionsRequest,
+) (*pb.FindQuestionsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.findQuestions.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.FindQuestionsResponse), nil
+}
+
+func decodeFindQuestionsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.FindQuestionsRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.FindQuestionsRequest{
+		PageIndex: req.PageIndex,
+		PageSize:  req.PageSize,
+		ExamId:    req.ExamId,
+		UserId:    req.UserId,
+		Cursor:    req.Cursor,
+	}, nil
+}
+
+func encodeFindQuestionsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.FindQuestionsResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	questions := []*pb.Question{}
+
+	for _, question := range resp.Questions {
+		questions = append(questions, toPBQuestion(&question))
+	}
+
+	return &pb.FindQuestionsResponse{
+		Total:      resp.Total,
+		PageCount:  resp.PageCount,
+		Questions:  questions,
+		NextCursor: resp.NextCursor,
+	}, nil
+}
+
+func (s GRPCServer) DeleteQuestion(
+	ctx context.Context,
+	req *pb.DeleteQuestionRequest,
+) (*pb.DeleteQuestionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.deleteQuestion.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.DeleteQuestionResponse), nil
+}
+
+func decodeDeleteQuestionRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.DeleteQuestionRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.DeleteQuestionRequest{
+		QuestionId: req.QuestionId,
+		UserId:     req.UserId,
+	}, nil
+}
+
+func encodeDeleteQuestionResponse(_ context.Context, response interface{}) (interface{}, error) {
+	_, ok := response.(endpoint.DeleteQuestionResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.DeleteQuestionResponse{}, nil
+}
+
+func (s GRPCServer) BatchMutateQuestions(
+	ctx context.Context,
+	req *pb.BatchMutateQuestionsRequest,
+) (*pb.BatchMutateQuestionsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.batchMutateQuestions.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.BatchMutateQuestionsResponse), nil
+}
+
+func decodeBatchMutateQuestionsRequest(
+	_ context.Context,
+	request interface{},
+) (interface{}, error) {
+	req, ok := request.(*pb.BatchMutateQuestionsRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	operations := []service.QuestionOperation{}
+
+	for _, operation := range req.Operations {
+		operations = append(operations, service.QuestionOperation{
+			Type:          operation.Type,
+			QuestionId:    operation.QuestionId,
+			Ask:           operation.Ask,
+			Answers:       operation.Answers,
+			Points:        operation.Points,
+			PartialCredit: operation.PartialCredit,
+			Hint:          operation.Hint,
+			Explanation:   operation.Explanation,
+			SectionId:     operation.SectionId,
+		})
+	}
+
+	return endpoint.BatchMutateQuestionsRequest{
+		ExamId:     req.ExamId,
+		Operations: operations,
+		UserId:     req.UserId,
+	}, nil
+}
+
+func encodeBatchMutateQuestionsResponse(
+	_ context.Context,
+	response interface{},
+) (interface{}, error) {
+	resp, ok := response.(endpoint.BatchMutateQuestionsResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	results := []*pb.QuestionOperationResult{}
+
+	for _, result := range resp.Results {
+		results = append(results, &pb.QuestionOperationResult{
+			Type:       result.Type,
+			QuestionId: result.QuestionId,
+			Error:      result.Error,
+		})
+	}
+
+	return &pb.BatchMutateQuestionsResponse{
+		Applied: resp.Applied,
+		Results: results,
+	}, nil
+}
+
+func (s GRPCServer) FindRandomQuestions(
+	ctx context.Context,
+	req *pb.FindRandomQuestionsRequest,
+) (*pb.FindRandomQuestionsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.findRandomQuestions.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.FindRandomQuestionsResponse), nil
+}
+
+func decodeFindRandomQuestionsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.FindRandomQuestionsRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.FindRandomQuestionsRequest{
+		ExamId: req.ExamId,
+		UserId: req.UserId,
+		Size:   req.Size,
+	}, nil
+}
+
+func encodeFindRandomQuestionsResponse(
+	_ context.Context,
+	response interface{},
+) (interface{}, error) {
+	resp, ok := response.(endpoint.FindRandomQuestionsResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	exam := toPBExam(resp.Exam)
+	questions := []*pb.Question{}
+
+	for _, question := range resp.Questions {
+		questions = append(questions, toPBQuestion(&question))
+	}
+
+	sections := []*pb.ExamSection{}
+
+	for _, section := range resp.Sections {
+		sections = append(sections, toPBExamSection(&section))
+	}
+
+	return &pb.FindRandomQuestionsResponse{
+		Exam:          exam,
+		Questions:     questions,
+		Sections:      sections,
+		ExamAttemptId: resp.ExamAttemptId,
+	}, nil
+}
+
+func (s GRPCServer) RevealQuestionHint(
+	ctx context.Context,
+	req *pb.RevealQuestionHintRequest,
+) (*pb.RevealQuestionHintResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.revealQuestionHint.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.RevealQuestionHintResponse), nil
+}
+
+func decodeRevealQuestionHintRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.RevealQuestionHintRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.RevealQuestionHintRequest{
+		ExamId:        req.ExamId,
+		ExamAttemptId: req.ExamAttemptId,
+		QuestionId:    req.QuestionId,
+		UserId:        req.UserId,
+	}, nil
+}
+
+func encodeRevealQuestionHintResponse(
+	_ context.Context,
+	response interface{},
+) (interface{}, error) {
+	resp, ok := response.(endpoint.RevealQuestionHintResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.RevealQuestionHintResponse{
+		Hint: resp.Hint,
+	}, nil
+}
+
+func (s GRPCServer) CreateBankQuestion(
+	ctx context.Context,
+	req *pb.CreateBankQuestionRequest,
+) (*pb.CreateBankQuestionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.createBankQuestion.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.CreateBankQuestionResponse), nil
+}
+
+func decodeCreateBankQuestionRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.CreateBankQuestionRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.CreateBankQuestionRequest{
+		Ask:           req.Ask,
+		Answers:       req.Answers,
+		Tags:          req.Tags,
+		Points:        req.Points,
+		PartialCredit: req.PartialCredit,
+		Hint:          req.Hint,
+		Explanation:   req.Explanation,
+		UserId:        req.UserId,
+	}, nil
+}
+
+func encodeCreateBankQuestionResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.CreateBankQuestionResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.CreateBankQuestionResponse{
+		BankQuestionId: resp.BankQuestionId,
+	}, nil
+}
+
+func (s GRPCServer) UpdateBankQuestion(
+	ctx context.Context,
+	req *pb.UpdateBankQuestionRequest,
+) (*pb.UpdateBankQuestionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.updateBankQuestion.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.UpdateBankQuestionResponse), nil
+}
+
+func decodeUpdateBankQuestionRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.UpdateBankQuestionRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.UpdateBankQuestionRequest{
+		BankQuestionId: req.BankQuestionId,
+		Ask:            req.Ask,
+		Answers:        req.Answers,
+		Tags:           req.Tags,
+		Points:         req.Points,
+		PartialCredit:  req.PartialCredit,
+		Hint:           req.Hint,
+		Explanation:    req.Explanation,
+		UserId:         req.UserId,
+	}, nil
+}
+
+func encodeUpdateBankQuestionResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.UpdateBankQuestionResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.UpdateBankQuestionResponse{
+		BankQuestionId: resp.BankQuestionId,
+	}, nil
+}
+
+func (s GRPCServer) DeleteBankQuestion(
+	ctx context.Context,
+	req *pb.DeleteBankQuestionRequest,
+) (*pb.DeleteBankQuestionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.deleteBankQuestion.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.DeleteBankQuestionResponse), nil
+}
+
+func decodeDeleteBankQuestionRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.DeleteBankQuestionRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.DeleteBankQuestionRequest{
+		BankQuestionId: req.BankQuestionId,
+		UserId:         req.UserId,
+	}, nil
+}
+
+func encodeDeleteBankQuestionResponse(_ context.Context, response interface{}) (interface{}, error) {
+	_, ok := response.(endpoint.DeleteBankQuestionResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.DeleteBankQuestionResponse{}, nil
+}
+
+func (s GRPCServer) FindBankQuestions(
+	ctx context.Context,
+	req *pb.FindBankQuestionsRequest,
+) (*pb.FindBankQuestionsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.findBankQuestions.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.FindBankQuestionsResponse), nil
+}
+
+func decodeFindBankQuestionsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.FindBankQuestionsRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.FindBankQuestionsRequest{
+		PageIndex: req.PageIndex,
+		PageSize:  req.PageSize,
+		Tag:       req.Tag,
+		UserId:    req.UserId,
+	}, nil
+}
+
+func encodeFindBankQuestionsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.FindBankQuestionsResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	bankQuestions := []*pb.BankQuestion{}
+
+	for _, bankQuestion := range resp.BankQuestions {
+		bankQuestions = append(bankQuestions, toPBBankQuestion(&bankQuestion))
+	}
+
+	return &pb.FindBankQuestionsResponse{
+		Total:         resp.Total,
+		PageCount:     resp.PageCount,
+		BankQuestions: bankQuestions,
+	}, nil
+}
+
+func (s GRPCServer) SetExamBankSource(
+	ctx context.Context,
+	req *pb.SetExamBankSourceRequest,
+) (*pb.SetExamBankSourceResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.setExamBankSource.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.SetExamBankSourceResponse), nil
+}
+
+func decodeSetExamBankSourceRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.SetExamBankSourceRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	pools := []model.ExamBankPool{}
+
+	for _, pool := range req.Pools {
+		pools = append(pools, model.ExamBankPool{
+			Tag:  pool.Tag,
+			Size: pool.Size,
+		})
+	}
+
+	return endpoint.SetExamBankSourceRequest{
+		ExamId:      req.ExamId,
+		QuestionIds: req.QuestionIds,
+		Pools:       pools,
+		UserId:      req.UserId,
+	}, nil
+}
+
+func encodeSetExamBankSourceResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.SetExamBankSourceResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.SetExamBankSourceResponse{
+		Exam: toPBExam(resp.Exam),
+	}, nil
+}
+
+func (s GRPCServer) CreateExamSection(
+	ctx context.Context,
+	req *pb.CreateExamSectionRequest,
+) (*pb.CreateExamSectionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.createExamSection.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.CreateExamSectionResponse), nil
+}
+
+func decodeCreateExamSectionRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.CreateExamSectionRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.CreateExamSectionRequest{
+		ExamId:   req.ExamId,
+		Type:     req.Type,
+		Title:    req.Title,
+		Passage:  req.Passage,
+		AudioUrl: req.AudioUrl,
+		Order:    req.Order,
+		UserId:   req.UserId,
+	}, nil
+}
+
+func encodeCreateExamSectionResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.CreateExamSectionResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.CreateExamSectionResponse{
+		SectionId: resp.SectionId,
+	}, nil
+}
+
+func (s GRPCServer) UpdateExamSection(
+	ctx context.Context,
+	req *pb.UpdateExamSectionRequest,
+) (*pb.UpdateExamSectionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.updateExamSection.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.UpdateExamSectionResponse), nil
+}
+
+func decodeUpdateExamSectionRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.UpdateExamSectionRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.UpdateExamSectionRequest{
+		SectionId: req.SectionId,
+		Type:      req.Type,
+		Title:     req.Title,
+		Passage:   req.Passage,
+		AudioUrl:  req.AudioUrl,
+		Order:     req.Order,
+		UserId:    req.UserId,
+	}, nil
+}
+
+func encodeUpdateExamSectionResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.UpdateExamSectionResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.UpdateExamSectionResponse{
+		SectionId: resp.SectionId,
+	}, nil
+}
+
+func (s GRPCServer) DeleteExamSection(
+	ctx context.Context,
+	req *pb.DeleteExamSectionRequest,
+) (*pb.DeleteExamSectionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.deleteExamSection.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.DeleteExamSectionResponse), nil
+}
+
+func decodeDeleteExamSectionRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.DeleteExamSectionRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.DeleteExamSectionRequest{
+		SectionId: req.SectionId,
+		UserId:    req.UserId,
+	}, nil
+}
+
+func encodeDeleteExamSectionResponse(_ context.Context, response interface{}) (interface{}, error) {
+	_, ok := response.(endpoint.DeleteExamSectionResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.DeleteExamSectionResponse{}, nil
+}
+
+func (s GRPCServer) FindExamSections(
+	ctx context.Context,
+	req *pb.FindExamSectionsRequest,
+) (*pb.FindExamSectionsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.findExamSections.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.FindExamSectionsResponse), nil
+}
+
+func decodeFindExamSectionsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.FindExamSectionsRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.FindExamSectionsRequest{
+		ExamId: req.ExamId,
+		UserId: req.UserId,
+	}, nil
+}
+
+func encodeFindExamSectionsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.FindExamSectionsResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	sections := []*pb.ExamSection{}
+
+	for _, section := range resp.Sections {
+		sections = append(sections, toPBExamSection(&section))
+	}
+
+	return &pb.FindExamSectionsResponse{
+		Sections: sections,
+	}, nil
+}
+
+func (s GRPCServer) CreateExamRecord(
+	ctx context.Context,
+	req *pb.CreateExamRecordRequest,
+) (*pb.CreateExamRecordResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.createExamRecord.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.CreateExamRecordResponse), nil
+}
+
+func decodeCreateExamRecordRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.CreateExamRecordRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.CreateExamRecordRequest{
+		ExamId:          req.ExamId,
+		ExamAttemptId:   req.ExamAttemptId,
+		DurationSeconds: req.DurationSeconds,
+		Answers:         toExamAttemptAnswers(req.Answers),
+		UserId:          req.UserId,
+	}, nil
+}
+
+func encodeCreateExamRecordResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.CreateExamRecordResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	questions := []*pb.Question{}
+
+	for _, question := range resp.Questions {
+		questions = append(questions, toPBQuestion(&question))
+	}
+
+	return &pb.CreateExamRecordResponse{
+		ExamRecord: toPBExamRecord(resp.ExamRecord),
+		Questions:  questions,
+	}, nil
+}
+
+func (s GRPCServer) FindExamRecords(
+	ctx context.Context,
+	req *pb.FindExamRecordsRequest,
+) (*pb.FindExamRecordsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.findExamRecords.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.FindExamRecordsResponse), nil
+}
+
+func decodeFindExamRecordsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.FindExamRecordsRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.FindExamRecordsRequest{
+		PageIndex: req.PageIndex,
+		PageSize:  req.PageSize,
+		ExamId:    req.ExamId,
+		UserId:    req.UserId,
+		Cursor:    req.Cursor,
+	}, nil
+}
+
+func encodeFindExamRecordsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.FindExamRecordsResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	examRecords := []*pb.ExamRecord{}
+
+	for _, examRecord := range resp.ExamRecords {
+		examRecords = append(examRecords, toPBExamRecord(&examRecord))
+	}
+
+	return &pb.FindExamRecordsResponse{
+		Total:       resp.Total,
+		PageCount:   resp.PageCount,
+		ExamRecords: examRecords,
+		NextCursor:  resp.NextCursor,
+	}, nil
+}
+
+func (s GRPCServer) GetExamRecordDetail(
+	ctx context.Context,
+	req *pb.GetExamRecordDetailRequest,
+) (*pb.GetExamRecordDetailResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.getExamRecordDetail.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.GetExamRecordDetailResponse), nil
+}
+
+func decodeGetExamRecordDetailRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.GetExamRecordDetailRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.GetExamRecordDetailRequest{
+		ExamId:       req.ExamId,
+		ExamRecordId: req.ExamRecordId,
+		UserId:       req.UserId,
+	}, nil
+}
+
+func encodeGetExamRecordDetailResponse(
+	_ context.Context,
+	response interface{},
+) (interface{}, error) {
+	resp, ok := response.(endpoint.GetExamRecordDetailResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	questions := []*pb.Question{}
+
+	for _, question := range resp.Questions {
+		questions = append(questions, toPBQuestion(&question))
+	}
+
+	return &pb.GetExamRecordDetailResponse{
+		ExamRecord: toPBExamRecord(resp.ExamRecord),
+		Questions:  questions,
+	}, nil
+}
+
+func (s GRPCServer) FindExamRecordOverview(
+	ctx context.Context,
+	req *pb.FindExamRecordOverviewRequest,
+) (*pb.FindExamRecordOverviewResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.findExamRecordOverview.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.FindExamRecordOverviewResponse), nil
+}
+
+func decodeFindExamRecordOverviewRequest(
+	_ context.Context,
+	request interface{},
+) (interface{}, error) {
+	req, ok := request.(*pb.FindExamRecordOverviewRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.FindExamRecordOverviewRequest{
+		ExamId:              req.ExamId,
+		UserId:              req.UserId,
+		StartDate:           toTime(req.StartDate),
+		EndDate:             toTime(req.EndDate),
+		ExamVersion:         req.ExamVersion,
+		Range:               req.Range,
+		Timezone:            req.Timezone,
+		MovingAverageWindow: req.MovingAverageWindow,
+	}, nil
+}
+
+func encodeFindExamRecordOverviewResponse(
+	_ context.Context,
+	response interface{},
+) (interface{}, error) {
+	resp, ok := response.(endpoint.FindExamRecordOverviewResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	exam := toPBExam(resp.Exam)
+	questions := []*pb.Question{}
+
+	for _, question := range resp.Questions {
+		questions = append(questions, toPBQuestion(&question))
+	}
+
+	answerWrongs := []*pb.AnswerWrong{}
+
+	for _, answerWrong := range resp.AnswerWrongs {
+		answerWrongs = append(answerWrongs, toPBAnswerWrong(&answerWrong))
+	}
+
+	examRecords := []*pb.ExamRecord{}
+
+	for _, examRecord := range resp.ExamRecords {
+		examRecords = append(examRecords, toPBExamRecord(&examRecord))
+	}
+
+	examVersions := []*pb.ExamVersionSummary{}
+
+	for _, examVersionSummary := range resp.ExamVersionSummaries {
+		examVersions = append(examVersions, &pb.ExamVersionSummary{
+			Version:       examVersionSummary.Version,
+			QuestionCount: examVersionSummary.QuestionCount,
+			CreatedAt:     timestamppb.New(examVersionSummary.CreatedAt),
+		})
+	}
+
+	return &pb.FindExamRecordOverviewResponse{
+		StartDate:    resp.StartDate,
+		Exam:         exam,
+		Questions:    questions,
+		AnswerWrongs: answerWrongs,
+		ExamRecords:  examRecords,
+		ExamVersion:  resp.ExamVersion,
+		ExamVersions: examVersions,
+		Analytics:    toPBExamRecordAnalytics(&resp.Analytics),
+	}, nil
+}
+
+func (s GRPCServer) FindExamLeaderboard(
+	ctx context.Context,
+	req *pb.FindExamLeaderboardRequest,
+) (*pb.FindExamLeaderboardResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.findExamLeaderboard.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.FindExamLeaderboardResponse), nil
+}
+
+func decodeFindExamLeaderboardRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.FindExamLeaderboardRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.FindExamLeaderboardRequest{
+		ExamId:          req.ExamId,
+		Window:          req.Window,
+		Size:            req.Size,
+		ExcludedUserIds: req.ExcludedUserIds,
+	}, nil
+}
+
+func encodeFindExamLeaderboardResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.FindExamLeaderboardResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	entries := []*pb.LeaderboardEntry{}
+
+	for _, leaderboardEntry := range resp.LeaderboardEntries {
+		entries = append(entries, &pb.LeaderboardEntry{
+			UserId:          leaderboardEntry.UserId,
+			Score:           leaderboardEntry.Score,
+			Percentage:      leaderboardEntry.Percentage,
+			DurationSeconds: leaderboardEntry.DurationSeconds,
+			CreatedAt:       timestamppb.New(leaderboardEntry.CreatedAt),
+		})
+	}
+
+	return &pb.FindExamLeaderboardResponse{
+		Entries: entries,
+	}, nil
+}
+
+func (s GRPCServer) FindQuestionStatistics(
+	ctx context.Context,
+	req *pb.FindQuestionStatisticsRequest,
+) (*pb.FindQuestionStatisticsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.findQuestionStatistics.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.FindQuestionStatisticsResponse), nil
+}
+
+func decodeFindQuestionStatisticsRequest(
+	_ context.Context,
+	request interface{},
+) (interface{}, error) {
+	req, ok := request.(*pb.FindQuestionStatisticsRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.FindQuestionStatisticsRequest{
+		ExamId: req.ExamId,
+		UserId: req.UserId,
+	}, nil
+}
+
+func encodeFindQuestionStatisticsResponse(
+	_ context.Context,
+	response interface{},
+) (interface{}, error) {
+	resp, ok := response.(endpoint.FindQuestionStatisticsResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	percentageDistribution := []*pb.PercentageCount{}
+
+	for _, percentageCount := range resp.Summary.PercentageDistribution {
+		percentageDistribution = append(percentageDistribution, &pb.PercentageCount{
+			Percentage: percentageCount.Percentage,
+			Count:      percentageCount.Count,
+		})
+	}
+
+	questionStatistics := []*pb.QuestionStatistic{}
+
+	for _, questionStatistic := range resp.QuestionStatistics {
+		questionStatistics = append(questionStatistics, &pb.QuestionStatistic{
+			QuestionId:          questionStatistic.QuestionId,
+			Ask:                 questionStatistic.Ask,
+			AttemptCount:        questionStatistic.AttemptCount,
+			WrongCount:          questionStatistic.WrongCount,
+			ErrorRate:           questionStatistic.ErrorRate,
+			DiscriminationIndex: questionStatistic.DiscriminationIndex,
+		})
+	}
+
+	return &pb.FindQuestionStatisticsResponse{
+		Summary: &pb.ExamStatisticsSummary{
+			AttemptCount:           resp.Summary.AttemptCount,
+			UserCount:              resp.Summary.UserCount,
+			MeanPercentage:         resp.Summary.MeanPercentage,
+			MedianPercentage:       resp.Summary.MedianPercentage,
+			PercentageDistribution: percentageDistribution,
+		},
+		QuestionStatistics: questionStatistics,
+	}, nil
+}
+
+func (s GRPCServer) FindMistakeNotebook(
+	ctx context.Context,
+	req *pb.FindMistakeNotebookRequest,
+) (*pb.FindMistakeNotebookResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.findMistakeNotebook.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.FindMistakeNotebookResponse), nil
+}
+
+func decodeFindMistakeNotebookRequest(
+	_ context.Context,
+	request interface{},
+) (interface{}, error) {
+	req, ok := request.(*pb.FindMistakeNotebookRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.FindMistakeNotebookRequest{
+		UserId:    req.UserId,
+		ExamId:    req.ExamId,
+		StartDate: toTime(req.StartDate),
+		EndDate:   toTime(req.EndDate),
+		PageIndex: req.PageIndex,
+		PageSize:  req.PageSize,
+	}, nil
+}
+
+func encodeFindMistakeNotebookResponse(
+	_ context.Context,
+	response interface{},
+) (interface{}, error) {
+	resp, ok := response.(endpoint.FindMistakeNotebookResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.FindMistakeNotebookResponse{
+		Total:     resp.Total,
+		PageCount: resp.PageCount,
+		Entries:   toPBMistakeNotebookEntries(resp.Entries),
+	}, nil
+}
+
+func (s GRPCServer) CreateMistakeDrill(
+	ctx context.Context,
+	req *pb.CreateMistakeDrillRequest,
+) (*pb.CreateMistakeDrillResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.createMistakeDrill.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.CreateMistakeDrillResponse), nil
+}
+
+func decodeCreateMistakeDrillRequest(
+	_ context.Context,
+	request interface{},
+) (interface{}, error) {
+	req, ok := request.(*pb.CreateMistakeDrillRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.CreateMistakeDrillRequest{
+		UserId: req.UserId,
+		ExamId: req.ExamId,
+		Size:   req.Size,
+	}, nil
+}
+
+func encodeCreateMistakeDrillResponse(
+	_ context.Context,
+	response interface{},
+) (interface{}, error) {
+	resp, ok := response.(endpoint.CreateMistakeDrillResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.CreateMistakeDrillResponse{
+		Entries:               toPBMistakeNotebookEntries(resp.Entries),
+		RequiredCorrectStreak: resp.RequiredCorrectStreak,
+	}, nil
+}
+
+func (s GRPCServer) AnswerMistakeDrill(
+	ctx context.Context,
+	req *pb.AnswerMistakeDrillRequest,
+) (*pb.AnswerMistakeDrillResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.answerMistakeDrill.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.AnswerMistakeDrillResponse), nil
+}
+
+func decodeAnswerMistakeDrillRequest(
+	_ context.Context,
+	request interface{},
+) (interface{}, error) {
+	req, ok := request.(*pb.AnswerMistakeDrillRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.AnswerMistakeDrillRequest{
+		AnswerWrongId: req.AnswerWrongId,
+		UserId:        req.UserId,
+		BlankAnswers:  req.BlankAnswers,
+	}, nil
+}
+
+func encodeAnswerMistakeDrillResponse(
+	_ context.Context,
+	response interface{},
+) (interface{}, error) {
+	resp, ok := response.(endpoint.AnswerMistakeDrillResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.AnswerMistakeDrillResponse{
+		IsCorrect:      resp.IsCorrect,
+		CorrectAnswers: resp.CorrectAnswers,
+		CorrectStreak:  resp.CorrectStreak,
+		Removed:        resp.Removed,
+	}, nil
+}
+
+func toPBMistakeNotebookEntries(
+	entries []service.MistakeNotebookEntry,
+) []*pb.MistakeNotebookEntry {
+	pbEntries := []*pb.MistakeNotebookEntry{}
+
+	for _, entry := range entries {
+		pbEntries = append(pbEntries, &pb.MistakeNotebookEntry{
+			AnswerWrong: toPBAnswerWrong(&entry.AnswerWrong),
+			Question:    toPBQuestion(entry.Question),
+			ExamTopic:   entry.ExamTopic,
+		})
+	}
+
+	return pbEntries
+}
+
+func (s GRPCServer) FindExamInfos(
+	ctx context.Context,
+	req *pb.FindExamInfosRequest,
+) (*pb.FindExamInfosResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.findExamInfos.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.FindExamInfosResponse), nil
+}
+
+func decodeFindExamInfosRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.FindExamInfosRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.FindExamInfosRequest{
+		UserId:   req.UserId,
+		IsPublic: req.IsPublic,
+		Tags:     req.Tags,
+	}, nil
+}
+
+func encodeFindExamInfosResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.FindExamInfosResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	examInfos := []*pb.ExamInfo{}
+
+	for _, examInfo := range resp.ExamInfos {
+		examInfos = append(examInfos, &pb.ExamInfo{
+			ExamId:        examInfo.ExamId,
+			Topic:         examInfo.Topic,
+			Description:   examInfo.Description,
+			Tags:          examInfo.Tags,
+			IsPublic:      examInfo.IsPublic,
+			QuestionCount: examInfo.QuestionCount,
+			RecordCount:   examInfo.RecordCount,
+		})
+	}
+
+	return &pb.FindExamInfosResponse{
+		ExamInfos: examInfos,
+	}, nil
+}
+
+func (s GRPCServer) FindExamCatalog(
+	ctx context.Context,
+	req *pb.FindExamCatalogRequest,
+) (*pb.FindExamCatalogResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.findExamCatalog.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.FindExamCatalogResponse), nil
+}
+
+func decodeFindExamCatalogRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.FindExamCatalogRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.FindExamCatalogRequest{
+		Keyword:   req.Keyword,
+		Tags:      req.Tags,
+		SortBy:    req.SortBy,
+		PageIndex: req.PageIndex,
+		PageSize:  req.PageSize,
+	}, nil
+}
 
-	examRecords := []*pb.ExamRecord{}
+func encodeFindExamCatalogResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.FindExamCatalogResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
 
-	for _, examRecord := range resp.ExamRecords {
-		examRecords = append(examRecords, toPBExamRecord(&examRecord))
+	items := []*pb.ExamCatalogItem{}
+
+	for _, examSummary := range resp.ExamSummaries {
+		items = append(items, &pb.ExamCatalogItem{
+			ExamId:            examSummary.Id.Hex(),
+			Topic:             examSummary.Topic,
+			Description:       examSummary.Description,
+			Tags:              examSummary.Tags,
+			UserId:            examSummary.UserId,
+			QuestionCount:     examSummary.QuestionCount,
+			RecordCount:       examSummary.RecordCount,
+			AveragePercentage: examSummary.AveragePercentage,
+			UpdatedAt:         timestamppb.New(examSummary.UpdatedAt),
+		})
 	}
 
-	return &pb.FindExamRecordOverviewResponse{
-		StartDate:    resp.StartDate,
-		Exam:         exam,
-		Questions:    questions,
-		AnswerWrongs: answerWrongs,
-		ExamRecords:  examRecords,
+	return &pb.FindExamCatalogResponse{
+		Total:     resp.Total,
+		PageCount: resp.PageCount,
+		Items:     items,
 	}, nil
 }
 
-func (s GRPCServer) FindExamInfos(
+func (s GRPCServer) CreateClass(
 	ctx context.Context,
-	req *pb.FindExamInfosRequest,
-) (*pb.FindExamInfosResponse, error) {
+	req *pb.CreateClassRequest,
+) (*pb.CreateClassResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
 	defer cancel()
-	_, resp, err := s.findExamInfos.ServeGRPC(ctx, req)
+	_, resp, err := s.createClass.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, toGRPCError(err)
 	}
 
-	return resp.(*pb.FindExamInfosResponse), nil
+	return resp.(*pb.CreateClassResponse), nil
 }
 
-func decodeFindExamInfosRequest(_ context.Context, request interface{}) (interface{}, error) {
-	req, ok := request.(*pb.FindExamInfosRequest)
+func decodeCreateClassRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.CreateClassRequest)
 	if !ok {
 		return nil, errors.New("invalid request body")
 	}
 
-	return endpoint.FindExamInfosRequest{
+	return endpoint.CreateClassRequest{
+		Name:        req.Name,
+		Description: req.Description,
+		UserId:      req.UserId,
+	}, nil
+}
+
+func encodeCreateClassResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.CreateClassResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.CreateClassResponse{
+		ClassId:  resp.ClassId,
+		JoinCode: resp.JoinCode,
+	}, nil
+}
+
+func (s GRPCServer) JoinClass(
+	ctx context.Context,
+	req *pb.JoinClassRequest,
+) (*pb.JoinClassResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.joinClass.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.JoinClassResponse), nil
+}
+
+func decodeJoinClassRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.JoinClassRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.JoinClassRequest{
+		JoinCode: req.JoinCode,
 		UserId:   req.UserId,
-		IsPublic: req.IsPublic,
 	}, nil
 }
 
-func encodeFindExamInfosResponse(_ context.Context, response interface{}) (interface{}, error) {
-	resp, ok := response.(endpoint.FindExamInfosResponse)
+func encodeJoinClassResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.JoinClassResponse)
 	if !ok {
 		return nil, errors.New("invalid response body")
 	}
 
-	examInfos := []*pb.ExamInfo{}
+	return &pb.JoinClassResponse{
+		ClassId: resp.Class.Id.Hex(),
+		Name:    resp.Class.Name,
+	}, nil
+}
 
-	for _, examInfo := range resp.ExamInfos {
-		examInfos = append(examInfos, &pb.ExamInfo{
-			ExamId:        examInfo.ExamId,
-			Topic:         examInfo.Topic,
-			Description:   examInfo.Description,
-			IsPublic:      examInfo.IsPublic,
-			QuestionCount: examInfo.QuestionCount,
-			RecordCount:   examInfo.RecordCount,
+func (s GRPCServer) FindClasses(
+	ctx context.Context,
+	req *pb.FindClassesRequest,
+) (*pb.FindClassesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.findClasses.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.FindClassesResponse), nil
+}
+
+func decodeFindClassesRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.FindClassesRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.FindClassesRequest{
+		UserId: req.UserId,
+	}, nil
+}
+
+func encodeFindClassesResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.FindClassesResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	classes := []*pb.Class{}
+
+	for _, class := range resp.Classes {
+		classes = append(classes, toPBClass(&class))
+	}
+
+	return &pb.FindClassesResponse{
+		Classes: classes,
+	}, nil
+}
+
+func (s GRPCServer) CreateAssignment(
+	ctx context.Context,
+	req *pb.CreateAssignmentRequest,
+) (*pb.CreateAssignmentResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.createAssignment.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.CreateAssignmentResponse), nil
+}
+
+func decodeCreateAssignmentRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.CreateAssignmentRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.CreateAssignmentRequest{
+		ClassId: req.ClassId,
+		ExamId:  req.ExamId,
+		Title:   req.Title,
+		DueAt:   req.DueAt.AsTime(),
+		UserId:  req.UserId,
+	}, nil
+}
+
+func encodeCreateAssignmentResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.CreateAssignmentResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.CreateAssignmentResponse{
+		AssignmentId: resp.AssignmentId,
+	}, nil
+}
+
+func (s GRPCServer) FindAssignments(
+	ctx context.Context,
+	req *pb.FindAssignmentsRequest,
+) (*pb.FindAssignmentsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.findAssignments.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.FindAssignmentsResponse), nil
+}
+
+func decodeFindAssignmentsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.FindAssignmentsRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.FindAssignmentsRequest{
+		ClassId: req.ClassId,
+		UserId:  req.UserId,
+	}, nil
+}
+
+func encodeFindAssignmentsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.FindAssignmentsResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	assignments := []*pb.Assignment{}
+
+	for _, assignment := range resp.Assignments {
+		assignments = append(assignments, toPBAssignment(&assignment))
+	}
+
+	return &pb.FindAssignmentsResponse{
+		Assignments: assignments,
+	}, nil
+}
+
+func (s GRPCServer) FindClassGradebook(
+	ctx context.Context,
+	req *pb.FindClassGradebookRequest,
+) (*pb.FindClassGradebookResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.findClassGradebook.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return resp.(*pb.FindClassGradebookResponse), nil
+}
+
+func decodeFindClassGradebookRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*pb.FindClassGradebookRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.FindClassGradebookRequest{
+		ClassId: req.ClassId,
+		UserId:  req.UserId,
+	}, nil
+}
+
+func encodeFindClassGradebookResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(endpoint.FindClassGradebookResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	assignments := []*pb.Assignment{}
+
+	for _, assignment := range resp.Assignments {
+		assignments = append(assignments, toPBAssignment(&assignment))
+	}
+
+	grades := []*pb.ClassGrade{}
+
+	for _, grade := range resp.Grades {
+		grades = append(grades, &pb.ClassGrade{
+			AssignmentId:     grade.AssignmentId,
+			UserId:           grade.UserId,
+			BestPercentage:   grade.BestPercentage,
+			LatestPercentage: grade.LatestPercentage,
+			RecordCount:      grade.RecordCount,
+			LatestCreatedAt:  timestamppb.New(grade.LatestCreatedAt),
 		})
 	}
 
-	return &pb.FindExamInfosResponse{
-		ExamInfos: examInfos,
+	return &pb.FindClassGradebookResponse{
+		Class:       toPBClass(resp.Class),
+		Assignments: assignments,
+		Grades:      grades,
 	}, nil
 }
 
+// 將錯誤轉成對應的 gRPC 狀態碼，讓呼叫端可以區分錯誤的種類，其他錯誤維持原樣
+func toGRPCError(err error) error {
+	if errors.Is(err, repository.VersionConflictError) {
+		return status.Error(codes.Aborted, err.Error())
+	}
+
+	if errors.Is(err, service.MaxAttemptsReachedError) ||
+		errors.Is(err, service.ExamAttemptSubmittedError) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	if errors.Is(err, service.InvalidPracticeExamError) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return err
+}
+
 func toPBExam(exam *model.Exam) *pb.Exam {
 	if exam == nil {
 		return nil
 	}
 
 	return &pb.Exam{
-		Id:          exam.Id.Hex(),
-		Topic:       exam.Topic,
-		Description: exam.Description,
-		Tags:        exam.Tags,
-		IsPublic:    exam.IsPublic,
-		UserId:      exam.UserId,
-		CreatedAt:   timestamppb.New(exam.CreatedAt),
-		UpdatedAt:   timestamppb.New(exam.UpdatedAt),
+		Id:               exam.Id.Hex(),
+		Topic:            exam.Topic,
+		Description:      exam.Description,
+		Tags:             exam.Tags,
+		IsPublic:         exam.IsPublic,
+		UserId:           exam.UserId,
+		ForkedFromExamId: exam.ForkedFromExamId,
+		ForkedFromUserId: exam.ForkedFromUserId,
+		LatestVersion:    exam.LatestVersion,
+		Settings:         toPBExamSettings(service.ExamSettingsOf(exam)),
+		BankSource:       toPBExamBankSource(exam.BankSource),
+		Version:          exam.Version,
+		CreatedAt:        timestamppb.New(exam.CreatedAt),
+		UpdatedAt:        timestamppb.New(exam.UpdatedAt),
+	}
+}
+
+func toPBExamSettings(settings model.ExamSettings) *pb.ExamSettings {
+	return &pb.ExamSettings{
+		QuestionsPerAttempt:    settings.QuestionsPerAttempt,
+		ShuffleQuestions:       settings.ShuffleQuestions,
+		ShuffleAnswers:         settings.ShuffleAnswers,
+		PassingScorePercent:    settings.PassingScorePercent,
+		MaxAttempts:            settings.MaxAttempts,
+		ShowAnswersAfterSubmit: settings.ShowAnswersAfterSubmit,
+		HintPenaltyPercent:     settings.HintPenaltyPercent,
+	}
+}
+
+// 沒有傳入設定時回傳 nil，表示不修改原本的設定
+func toExamSettings(pbSettings *pb.ExamSettings) *model.ExamSettings {
+	if pbSettings == nil {
+		return nil
+	}
+
+	return &model.ExamSettings{
+		QuestionsPerAttempt:    pbSettings.QuestionsPerAttempt,
+		ShuffleQuestions:       pbSettings.ShuffleQuestions,
+		ShuffleAnswers:         pbSettings.ShuffleAnswers,
+		PassingScorePercent:    pbSettings.PassingScorePercent,
+		MaxAttempts:            pbSettings.MaxAttempts,
+		ShowAnswersAfterSubmit: pbSettings.ShowAnswersAfterSubmit,
+		HintPenaltyPercent:     pbSettings.HintPenaltyPercent,
+	}
+}
+
+func toPBExamBankSource(bankSource *model.ExamBankSource) *pb.ExamBankSource {
+	if bankSource == nil {
+		return nil
+	}
+
+	pools := []*pb.ExamBankPool{}
+
+	for _, pool := range bankSource.Pools {
+		pools = append(pools, &pb.ExamBankPool{
+			Tag:  pool.Tag,
+			Size: pool.Size,
+		})
+	}
+
+	return &pb.ExamBankSource{
+		QuestionIds: bankSource.QuestionIds,
+		Pools:       pools,
+	}
+}
+
+func toPBBankQuestion(bankQuestion *model.BankQuestion) *pb.BankQuestion {
+	if bankQuestion == nil {
+		return nil
+	}
+
+	return &pb.BankQuestion{
+		Id:            bankQuestion.Id.Hex(),
+		Ask:           bankQuestion.Ask,
+		Answers:       bankQuestion.Answers,
+		Tags:          bankQuestion.Tags,
+		Points:        bankQuestion.Points,
+		PartialCredit: bankQuestion.PartialCredit,
+		Hint:          bankQuestion.Hint,
+		Explanation:   bankQuestion.Explanation,
+		UserId:        bankQuestion.UserId,
+		CreatedAt:     timestamppb.New(bankQuestion.CreatedAt),
+		UpdatedAt:     timestamppb.New(bankQuestion.UpdatedAt),
 	}
 }
 
@@ -712,13 +2758,63 @@ func toPBQuestion(question *model.Question) *pb.Question {
 	}
 
 	return &pb.Question{
-		Id:        question.Id.Hex(),
-		ExamId:    question.ExamId,
-		Ask:       question.Ask,
-		Answers:   question.Answers,
-		UserId:    question.UserId,
-		CreatedAt: timestamppb.New(question.CreatedAt),
-		UpdatedAt: timestamppb.New(question.UpdatedAt),
+		Id:              question.Id.Hex(),
+		ExamId:          question.ExamId,
+		Ask:             question.Ask,
+		Answers:         question.Answers,
+		AcceptedAnswers: toPBAcceptedAnswers(question.AcceptedAnswers),
+		UserId:          question.UserId,
+		WordMeaningId:   question.WordMeaningId,
+		Points:          question.Points,
+		PartialCredit:   question.PartialCredit,
+		Hint:            question.Hint,
+		Explanation:     question.Explanation,
+		SectionId:       question.SectionId,
+		Version:         question.Version,
+		Choices:         question.Choices,
+		AnswerCount:     question.AnswerCount,
+		HasHint:         question.HasHint,
+		CreatedAt:       timestamppb.New(question.CreatedAt),
+		UpdatedAt:       timestamppb.New(question.UpdatedAt),
+	}
+}
+
+func toPBAcceptedAnswers(acceptedAnswers [][]string) []*pb.AcceptedAnswers {
+	pbAcceptedAnswers := []*pb.AcceptedAnswers{}
+
+	for _, answers := range acceptedAnswers {
+		pbAcceptedAnswers = append(pbAcceptedAnswers, &pb.AcceptedAnswers{Answers: answers})
+	}
+
+	return pbAcceptedAnswers
+}
+
+func toAcceptedAnswers(pbAcceptedAnswers []*pb.AcceptedAnswers) [][]string {
+	acceptedAnswers := [][]string{}
+
+	for _, pbAnswers := range pbAcceptedAnswers {
+		acceptedAnswers = append(acceptedAnswers, pbAnswers.GetAnswers())
+	}
+
+	return acceptedAnswers
+}
+
+func toPBExamSection(section *model.ExamSection) *pb.ExamSection {
+	if section == nil {
+		return nil
+	}
+
+	return &pb.ExamSection{
+		Id:        section.Id.Hex(),
+		ExamId:    section.ExamId,
+		Type:      section.Type,
+		Title:     section.Title,
+		Passage:   section.Passage,
+		AudioUrl:  section.AudioUrl,
+		Order:     section.Order,
+		UserId:    section.UserId,
+		CreatedAt: timestamppb.New(section.CreatedAt),
+		UpdatedAt: timestamppb.New(section.UpdatedAt),
 	}
 }
 
@@ -728,13 +2824,15 @@ func toPBAnswerWrong(answerWrong *model.AnswerWrong) *pb.AnswerWrong {
 	}
 
 	return &pb.AnswerWrong{
-		Id:         answerWrong.Id.Hex(),
-		ExamId:     answerWrong.ExamId,
-		QuestionId: answerWrong.QuestionId,
-		Times:      answerWrong.Times,
-		UserId:     answerWrong.UserId,
-		CreatedAt:  timestamppb.New(answerWrong.CreatedAt),
-		UpdatedAt:  timestamppb.New(answerWrong.UpdatedAt),
+		Id:            answerWrong.Id.Hex(),
+		ExamId:        answerWrong.ExamId,
+		ExamVersion:   answerWrong.ExamVersion,
+		QuestionId:    answerWrong.QuestionId,
+		Times:         answerWrong.Times,
+		UserId:        answerWrong.UserId,
+		CorrectStreak: answerWrong.CorrectStreak,
+		CreatedAt:     timestamppb.New(answerWrong.CreatedAt),
+		UpdatedAt:     timestamppb.New(answerWrong.UpdatedAt),
 	}
 }
 
@@ -744,11 +2842,153 @@ func toPBExamRecord(examRecord *model.ExamRecord) *pb.ExamRecord {
 	}
 
 	return &pb.ExamRecord{
-		Id:        examRecord.Id.Hex(),
-		ExamId:    examRecord.ExamId,
-		Score:     examRecord.Score,
-		UserId:    examRecord.UserId,
-		CreatedAt: timestamppb.New(examRecord.CreatedAt),
-		UpdatedAt: timestamppb.New(examRecord.UpdatedAt),
+		Id:                  examRecord.Id.Hex(),
+		ExamId:              examRecord.ExamId,
+		ExamVersion:         examRecord.ExamVersion,
+		Score:               examRecord.Score,
+		DurationSeconds:     examRecord.DurationSeconds,
+		Answers:             toPBExamAttemptAnswers(examRecord.Answers),
+		PassingScorePercent: examRecord.PassingScorePercent,
+		Passed:              examRecord.Passed,
+		EarnedPoints:        examRecord.EarnedPoints,
+		MaxPoints:           examRecord.MaxPoints,
+		Percentage:          examRecord.Percentage,
+		UserId:              examRecord.UserId,
+		CreatedAt:           timestamppb.New(examRecord.CreatedAt),
+		UpdatedAt:           timestamppb.New(examRecord.UpdatedAt),
+	}
+}
+
+func toPBExamAttemptAnswers(answers []model.ExamAttemptAnswer) []*pb.ExamAttemptAnswer {
+	pbAnswers := []*pb.ExamAttemptAnswer{}
+
+	for _, answer := range answers {
+		pbAnswers = append(pbAnswers, &pb.ExamAttemptAnswer{
+			QuestionId:       answer.QuestionId,
+			Answer:           answer.Answer,
+			BlankAnswers:     answer.BlankAnswers,
+			IsCorrect:        answer.IsCorrect,
+			TimeSpentSeconds: answer.TimeSpentSeconds,
+			Credit:           answer.Credit,
+			HintUsed:         answer.HintUsed,
+		})
+	}
+
+	return pbAnswers
+}
+
+// 是否答對、得分比例與是否查看提示都由後端決定，不使用用戶端傳送的值
+func toExamAttemptAnswers(pbAnswers []*pb.ExamAttemptAnswer) []model.ExamAttemptAnswer {
+	answers := []model.ExamAttemptAnswer{}
+
+	for _, pbAnswer := range pbAnswers {
+		answers = append(answers, model.ExamAttemptAnswer{
+			QuestionId:       pbAnswer.QuestionId,
+			Answer:           pbAnswer.Answer,
+			BlankAnswers:     pbAnswer.BlankAnswers,
+			TimeSpentSeconds: pbAnswer.TimeSpentSeconds,
+		})
+	}
+
+	return answers
+}
+
+func toPBExamRecordAnalytics(analytics *service.ExamRecordAnalytics) *pb.ExamRecordAnalytics {
+	dailyStats := []*pb.DailyExamRecordStat{}
+
+	for _, dailyStat := range analytics.DailyStats {
+		dailyStats = append(dailyStats, &pb.DailyExamRecordStat{
+			Date:          dailyStat.Date,
+			RecordCount:   dailyStat.RecordCount,
+			AverageScore:  dailyStat.AverageScore,
+			BestScore:     dailyStat.BestScore,
+			WorstScore:    dailyStat.WorstScore,
+			MovingAverage: dailyStat.MovingAverage,
+		})
+	}
+
+	hourOfDayStats := []*pb.HourOfDayStat{}
+
+	for _, hourOfDayStat := range analytics.HourOfDayStats {
+		hourOfDayStats = append(hourOfDayStats, &pb.HourOfDayStat{
+			Hour:         hourOfDayStat.Hour,
+			RecordCount:  hourOfDayStat.RecordCount,
+			AverageScore: hourOfDayStat.AverageScore,
+		})
+	}
+
+	pbAnalytics := &pb.ExamRecordAnalytics{
+		Timezone:            analytics.Timezone,
+		StartDate:           analytics.StartDate,
+		EndDate:             analytics.EndDate,
+		RecordCount:         analytics.RecordCount,
+		AverageScore:        analytics.AverageScore,
+		BestScore:           analytics.BestScore,
+		WorstScore:          analytics.WorstScore,
+		TrendSlope:          analytics.TrendSlope,
+		Trend:               analytics.Trend,
+		CurrentStreakDays:   analytics.CurrentStreakDays,
+		LongestStreakDays:   analytics.LongestStreakDays,
+		MovingAverageWindow: analytics.MovingAverageWindow,
+		DailyStats:          dailyStats,
+		HourOfDayStats:      hourOfDayStats,
+	}
+
+	// 期間內沒有作答紀錄時，沒有最佳與最差分數的時間
+	if analytics.RecordCount > 0 {
+		pbAnalytics.BestScoreAt = timestamppb.New(analytics.BestScoreAt)
+		pbAnalytics.WorstScoreAt = timestamppb.New(analytics.WorstScoreAt)
+	}
+
+	return pbAnalytics
+}
+
+// 沒有傳入的 Timestamp 轉成 time.Time 的零值，而不是 1970-01-01
+func toTime(timestamp *timestamppb.Timestamp) time.Time {
+	if timestamp == nil {
+		return time.Time{}
+	}
+
+	return timestamp.AsTime()
+}
+
+func toPBClass(class *model.Class) *pb.Class {
+	if class == nil {
+		return nil
+	}
+
+	students := []*pb.ClassStudent{}
+
+	for _, student := range class.Students {
+		students = append(students, &pb.ClassStudent{
+			UserId:   student.UserId,
+			JoinedAt: timestamppb.New(student.JoinedAt),
+		})
+	}
+
+	return &pb.Class{
+		Id:          class.Id.Hex(),
+		Name:        class.Name,
+		Description: class.Description,
+		JoinCode:    class.JoinCode,
+		UserId:      class.UserId,
+		Students:    students,
+		CreatedAt:   timestamppb.New(class.CreatedAt),
+		UpdatedAt:   timestamppb.New(class.UpdatedAt),
+	}
+}
+
+func toPBAssignment(assignment *model.Assignment) *pb.Assignment {
+	if assignment == nil {
+		return nil
+	}
+
+	return &pb.Assignment{
+		Id:        assignment.Id.Hex(),
+		ClassId:   assignment.ClassId,
+		ExamId:    assignment.ExamId,
+		Title:     assignment.Title,
+		DueAt:     timestamppb.New(assignment.DueAt),
+		CreatedAt: timestamppb.New(assignment.CreatedAt),
 	}
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/labstack/gommon/log"
 
 	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/config"
+	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/handler/class"
 	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/handler/exam"
 	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/handler/user"
 	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/handler/word"
@@ -88,8 +89,14 @@ func main() {
 
 	// Handlers
 	userHandler := user.NewHandler(databaseRepository)
-	examHandler := exam.NewHandler(examService, databaseRepository)
+	examHandler := exam.NewHandler(
+		examService,
+		wordService,
+		databaseRepository,
+		cacheRepository,
+	)
 	wordHandler := word.NewHandler(wordService, cacheRepository)
+	classHandler := class.NewHandler(examService, databaseRepository)
 
 	e := echo.New()
 	e.Logger.SetLevel(log.INFO)
@@ -119,7 +126,7 @@ func main() {
 	e.Static("/assets", "frontend/dist/assets")
 	e.File("/*", "frontend/dist/index.html")
 
-	setupAPIHandlers(e, userHandler, examHandler, wordHandler)
+	setupAPIHandlers(e, userHandler, examHandler, wordHandler, classHandler)
 
 	e.Logger.Info("Echo starts to listin at " + config.EnvWebServiceServerAddress())
 	e.Logger.Fatal(e.Start(config.EnvWebServiceServerAddress()))
@@ -130,6 +137,7 @@ func setupAPIHandlers(
 	userHandler user.UserHandler,
 	examHandler exam.ExamHandler,
 	wordHandler word.WordHandler,
+	classHandler class.ClassHandler,
 ) {
 	// API
 	api := e.Group("/api")
@@ -143,6 +151,9 @@ func setupAPIHandlers(
 	// 未登入時的 ExamInfo
 	api.GET("/exam/info", examHandler.FindExamInfosWhenNotSignIn)
 
+	// 公開的測驗目錄
+	api.GET("/exam/catalog", examHandler.FindExamCatalog)
+
 	// Restricted group，需要登入後才能呼叫的 API
 	restrictedApi := api.Group("/restricted")
 
@@ -162,17 +173,64 @@ func setupAPIHandlers(
 	restrictedApi.GET("/exam", examHandler.FindExams)
 	restrictedApi.POST("/exam", examHandler.CreateExam)
 	restrictedApi.PATCH("/exam", examHandler.UpdateExam)
+	restrictedApi.GET("/exam/:examId", examHandler.GetExam)
 	restrictedApi.DELETE("/exam/:examId", examHandler.DeleteExam)
+	restrictedApi.POST("/exam/:examId/fork", examHandler.ForkExam)
+	restrictedApi.POST("/exam/:examId/publish", examHandler.PublishExam)
+	restrictedApi.GET("/exam/:examId/share", examHandler.FindExamShares)
+	restrictedApi.PUT("/exam/:examId/share/:userId", examHandler.ShareExam)
+	restrictedApi.DELETE("/exam/:examId/share/:userId", examHandler.UnshareExam)
+	restrictedApi.POST("/exam/import", examHandler.ImportExam)
+	restrictedApi.GET("/exam/:examId/export", examHandler.ExportExam)
+	restrictedApi.POST("/exam/practice", examHandler.CreatePracticeExam)
+	restrictedApi.GET("/exam/tag", examHandler.FindExamTagCounts)
+	restrictedApi.GET("/exam/tag/exam", examHandler.FindExamsByTags)
 	restrictedApi.GET("/exam/:examId/question", examHandler.FindQuestions)
 	restrictedApi.POST("/exam/:examId/question", examHandler.CreateQuestion)
 	restrictedApi.PATCH("/exam/:examId/question", examHandler.UpdateQuestion)
+	restrictedApi.GET("/exam/:examId/question/:questionId", examHandler.GetQuestion)
 	restrictedApi.DELETE("/exam/:examId/question/:questionId", examHandler.DeleteQuestion)
+	restrictedApi.POST("/exam/:examId/question/batch", examHandler.BatchMutateQuestions)
+	restrictedApi.GET("/exam/:examId/section", examHandler.FindExamSections)
+	restrictedApi.POST("/exam/:examId/section", examHandler.CreateExamSection)
+	restrictedApi.PATCH("/exam/:examId/section", examHandler.UpdateExamSection)
+	restrictedApi.DELETE("/exam/:examId/section/:sectionId", examHandler.DeleteExamSection)
+	restrictedApi.PUT("/exam/:examId/bank", examHandler.SetExamBankSource)
 	restrictedApi.GET("/exam/:examId/start", examHandler.FindRandomQuestions)
+	restrictedApi.POST(
+		"/exam/:examId/attempt/:examAttemptId/hint",
+		examHandler.RevealQuestionHint,
+	)
 	restrictedApi.POST("/exam/:examId/record", examHandler.CreateExamRecord)
 	restrictedApi.GET("/exam/:examId/record/overview", examHandler.FindExamRecordOverview)
 	restrictedApi.GET("/exam/:examId/record", examHandler.FindExamRecords)
+	restrictedApi.GET("/exam/:examId/record/:recordId", examHandler.GetExamRecordDetail)
+	restrictedApi.GET("/exam/:examId/leaderboard", examHandler.FindExamLeaderboard)
+	restrictedApi.GET("/exam/:examId/statistics", examHandler.FindQuestionStatistics)
+	restrictedApi.GET("/exam/mistake", examHandler.FindMistakeNotebook)
+	restrictedApi.POST("/exam/mistake/drill", examHandler.CreateMistakeDrill)
+	restrictedApi.POST("/exam/mistake/drill/:answerWrongId", examHandler.AnswerMistakeDrill)
+
+	// BankQuestion
+	restrictedApi.GET("/exam/bank/question", examHandler.FindBankQuestions)
+	restrictedApi.POST("/exam/bank/question", examHandler.CreateBankQuestion)
+	restrictedApi.PATCH("/exam/bank/question", examHandler.UpdateBankQuestion)
+	restrictedApi.DELETE(
+		"/exam/bank/question/:bankQuestionId",
+		examHandler.DeleteBankQuestion,
+	)
+
+	// Class
+	restrictedApi.GET("/class", classHandler.FindClasses)
+	restrictedApi.POST("/class", classHandler.CreateClass)
+	restrictedApi.POST("/class/join", classHandler.JoinClass)
+	restrictedApi.GET("/class/:classId/assignment", classHandler.FindAssignments)
+	restrictedApi.POST("/class/:classId/assignment", classHandler.CreateAssignment)
+	restrictedApi.GET("/class/:classId/gradebook", classHandler.FindClassGradebook)
+	restrictedApi.GET("/class/:classId/gradebook/export", classHandler.ExportClassGradebook)
 
 	// Word
+	restrictedApi.GET("/word", wordHandler.FindWordMeanings)
 	restrictedApi.GET("/word/:word", wordHandler.FindWordMeanings)
 	restrictedApi.POST("/word/favorite", wordHandler.CreateFavoriteWordMeaning)
 	restrictedApi.GET("/word/favorite", wordHandler.FindFavoriteWordMeanings)
@@ -181,9 +239,17 @@ func setupAPIHandlers(
 		wordHandler.DeleteFavoriteWordMeaning,
 	)
 	restrictedApi.GET("/word/card", wordHandler.FindRandomFavoriteWordMeanings)
+	restrictedApi.POST("/word/card/reviewed", wordHandler.MarkFavoriteWordMeaningsReviewed)
+	restrictedApi.GET("/word/history", wordHandler.FindRecentLookups)
+	restrictedApi.DELETE("/word/history", wordHandler.ClearLookupHistory)
+	restrictedApi.GET("/word/dictation", wordHandler.FindDictationSentences)
+	restrictedApi.POST("/word/dictation", wordHandler.GradeDictation)
+	restrictedApi.GET("/word/dictation/history", wordHandler.FindDictationHistory)
 
 	// User
 	restrictedApi.GET("/user/history", userHandler.FindUserHistories)
+	restrictedApi.GET("/user/profile", userHandler.GetUserProfile)
+	restrictedApi.PATCH("/user/profile", userHandler.UpdateUserProfile)
 }
 
 func loadEnv() {
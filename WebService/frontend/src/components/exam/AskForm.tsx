@@ -1,4 +1,4 @@
-import { useEffect, useMemo, useState } from 'react';
+import { useEffect, useState } from 'react';
 import { VStack, Button, Text, useToast, Center } from '@chakra-ui/react';
 import axios, { AxiosError } from 'axios';
 import { motion } from 'framer-motion';
@@ -7,6 +7,7 @@ import AskQuestion from './AskQuestion';
 import { loaderActions } from '../../store/slices/loaderSlice';
 import { useAppSelector, useAppDispatch } from '../../store/hooks';
 import { Question } from '../../models/Question';
+import { ExamRecord } from '../../models/ExamRecord';
 
 type Props = {
   questions: Question[];
@@ -16,40 +17,36 @@ type Props = {
 function AskForm({ questions, onCreateExamRecord }: Props) {
   const maxScore = questions.length;
   const [isValidate, setIsValidate] = useState(false);
+  const [examRecord, setExamRecord] = useState<ExamRecord | null>(null);
+  const [gradedQuestions, setGradedQuestions] = useState<Question[]>([]);
   const dispatch = useAppDispatch();
   const toast = useToast();
-  const questionResults = useAppSelector(
-    (state) => state.askForm.questionResults,
+  const questionAnswers = useAppSelector(
+    (state) => state.askForm.questionAnswers,
   );
-
-  const wrongQuestionIds: string[] = useMemo(() => [], []);
-  let score = 0;
-  let isScored = false;
-
-  if (questionResults.length === questions.length) {
-    const ids = questionResults
-      .filter(({ isSuccess }) => !isSuccess)
-      .map(({ questionId }) => questionId);
-    wrongQuestionIds.length = 0;
-    wrongQuestionIds.push(...ids);
-    score = questions.length - wrongQuestionIds.length;
-    isScored = true;
-  }
+  const isAnswered = questionAnswers.length === questions.length;
 
   useEffect(() => {
-    if (!isScored) {
+    if (!isAnswered || examRecord) {
       return;
     }
 
-    // 保存測驗紀錄到後端
+    // 保存測驗紀錄到後端，由後端評分
     const createExamRecord = async () => {
       dispatch(loaderActions.toggleLoading());
 
       try {
-        await axios.post(`/restricted/exam/${questions[0].examId}/record`, {
-          score,
-          wrongQuestionIds,
-        });
+        const response = await axios.post(
+          `/restricted/exam/${questions[0].examId}/record`,
+          {
+            answers: questionAnswers.map(({ questionId, blankAnswers }) => ({
+              questionId,
+              blankAnswers,
+            })),
+          },
+        );
+        setExamRecord(response.data.examRecord);
+        setGradedQuestions(response.data.questions);
         toast({
           title: '新增紀錄成功',
           status: 'success',
@@ -79,11 +76,11 @@ function AskForm({ questions, onCreateExamRecord }: Props) {
     window.scrollTo(0, 0);
   }, [
     dispatch,
-    score,
-    isScored,
+    examRecord,
+    isAnswered,
+    questionAnswers,
     questions,
     toast,
-    wrongQuestionIds,
     onCreateExamRecord,
   ]);
 
@@ -97,13 +94,19 @@ function AskForm({ questions, onCreateExamRecord }: Props) {
       questionNumber={index + 1}
       question={question}
       isValidate={isValidate}
+      gradedAnswer={examRecord?.answers?.find(
+        ({ questionId }) => questionId === question._id,
+      )}
+      correctAnswers={
+        gradedQuestions.find(({ _id }) => _id === question._id)?.answers
+      }
       motionVariants={slideIn('left', 'tween', 0.1 * (index + 1), 1)}
     />
   ));
 
   return (
     <>
-      {isScored && (
+      {examRecord && (
         <motion.div
           variants={fadeIn('down', 'tween', 0.2, 1)}
           initial="hidden"
@@ -111,7 +114,7 @@ function AskForm({ questions, onCreateExamRecord }: Props) {
         >
           <Center>
             <Text fontSize="100px" data-testid="score" color="blue.200">
-              {score}/{maxScore}
+              {examRecord.score}/{maxScore}
             </Text>
           </Center>
         </motion.div>
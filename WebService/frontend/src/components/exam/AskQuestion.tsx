@@ -7,16 +7,13 @@ import {
   FormErrorMessage,
   FormLabel,
   Input,
+  Select,
 } from '@chakra-ui/react';
-import {
-  useForm,
-  useFieldArray,
-  FieldErrorsImpl,
-  DeepRequired,
-} from 'react-hook-form';
+import { useForm, useFieldArray } from 'react-hook-form';
 import { motion, Variants } from 'framer-motion';
 import { useAppDispatch } from '../../store/hooks';
 import { Question } from '../../models/Question';
+import { ExamAttemptAnswer } from '../../models/ExamRecord';
 import { askFormActions } from '../../store/slices/askFormSlice';
 import ShowText from '../ShowText';
 
@@ -24,6 +21,9 @@ type Props = {
   questionNumber: number;
   question: Question;
   isValidate: boolean;
+  // 後端評分的結果，correctAnswers 在測驗不公布答案時為空陣列
+  gradedAnswer?: ExamAttemptAnswer;
+  correctAnswers?: string[];
   motionVariants: Variants;
 };
 
@@ -35,26 +35,26 @@ type FormData = {
 
 function AskQuestion({
   questionNumber,
-  question: { _id, ask, answers },
+  question: { _id, ask, answers, answerCount, choices },
   isValidate,
+  gradedAnswer,
+  correctAnswers,
   motionVariants,
 }: Props) {
   const defaultValues: FormData = {
     answerObjects: [],
   };
 
-  for (let i = 0; i < answers.length; i += 1) {
+  // 作答時後端不回傳答案，以答案的數量顯示空格
+  const blankCount = answerCount || answers.length;
+
+  for (let i = 0; i < blankCount; i += 1) {
     defaultValues.answerObjects.push({
       answer: '',
     });
   }
 
-  const {
-    register,
-    control,
-    handleSubmit,
-    formState: { errors },
-  } = useForm<FormData>({
+  const { register, control, getValues } = useForm<FormData>({
     defaultValues,
   });
   const { fields } = useFieldArray({
@@ -70,29 +70,29 @@ function AskQuestion({
       return;
     }
 
-    const submitHandler = handleSubmit(
-      // eslint-disable-next-line
-      (data: FormData) => {
-        dispatch(
-          askFormActions.addQuestionResult({
-            questionId: _id!,
-            isSuccess: true,
-          }),
-        );
-      },
-
-      // eslint-disable-next-line
-      (formErrors: FieldErrorsImpl<DeepRequired<FormData>>) => {
-        dispatch(
-          askFormActions.addQuestionResult({
-            questionId: _id!,
-            isSuccess: false,
-          }),
-        );
-      },
+    // 只送出填入的答案，是否答對由後端評分
+    dispatch(
+      askFormActions.addQuestionAnswer({
+        questionId: _id!,
+        blankAnswers: getValues('answerObjects').map(({ answer }) =>
+          answer.trim(),
+        ),
+      }),
     );
-    submitHandler();
-  }, [_id, dispatch, handleSubmit, isValidate]);
+  }, [_id, dispatch, getValues, isValidate]);
+
+  // 有公布答案時標示答錯的填空，否則整題標示答錯
+  const isBlankWrong = (index: number) => {
+    if (!gradedAnswer || gradedAnswer.isCorrect) {
+      return false;
+    }
+
+    if (!correctAnswers || correctAnswers.length === 0) {
+      return true;
+    }
+
+    return gradedAnswer.blankAnswers[index] !== correctAnswers[index];
+  };
 
   return (
     <motion.div
@@ -121,32 +121,42 @@ function AskQuestion({
           <Box m={3}>
             {fields.map((field, index) => {
               const label = `Answer${index + 1}`;
-              const answer = answers[index];
+              const correctAnswer = correctAnswers?.[index];
 
               return (
-                <FormControl
-                  key={field.id}
-                  isInvalid={!!errors?.answerObjects?.[index]?.answer}
-                >
+                <FormControl key={field.id} isInvalid={isBlankWrong(index)}>
                   <FormLabel htmlFor={field.id}>
                     <Badge mt={2}>{label}</Badge>
                   </FormLabel>
-                  <Input
-                    id={field.id}
-                    placeholder="Enter answer"
-                    {...register(`answerObjects.${index}.answer` as const, {
-                      validate: (value) =>
-                        (value && value.trim() === answer) ||
-                        `${label}: ${answer}`,
-                    })}
-                    isDisabled={isValidate}
-                  />
+                  {choices && choices.length > 0 ? (
+                    <Select
+                      id={field.id}
+                      placeholder="Select answer"
+                      {...register(`answerObjects.${index}.answer` as const)}
+                      isDisabled={isValidate}
+                    >
+                      {choices.map((choice) => (
+                        <option key={choice} value={choice}>
+                          {choice}
+                        </option>
+                      ))}
+                    </Select>
+                  ) : (
+                    <Input
+                      id={field.id}
+                      placeholder="Enter answer"
+                      {...register(`answerObjects.${index}.answer` as const)}
+                      isDisabled={isValidate}
+                    />
+                  )}
                   <FormErrorMessage
                     data-testid={`question${questionNumber}Answer${
                       index + 1
                     }Error`}
                   >
-                    {errors?.answerObjects?.[index]?.answer?.message}
+                    {correctAnswer
+                      ? `${label}: ${correctAnswer}`
+                      : `${label}: 答錯`}
                   </FormErrorMessage>
                 </FormControl>
               );
@@ -28,8 +28,8 @@ function ExamInfoCard({
   const dispatch = useAppDispatch();
 
   const goStartExamClickHandler = () => {
-    // 避免重複計分，需要先清除 askFormSlice 的 questionResult
-    dispatch(askFormActions.clearQuestionResult());
+    // 避免重複送出作答，需要先清除 askFormSlice 的 questionAnswers
+    dispatch(askFormActions.clearQuestionAnswers());
     navigate(`/restricted/exam/${examId}/start`);
   };
   const goExamRecordOverviewClickHandler = () => {
@@ -83,7 +83,7 @@ function UpdateExamDialog({ data }: Props) {
         }),
       )
         .unwrap()
-        .then(() => {
+        .then(({ version }) => {
           toast({
             title: '修改成功。',
             status: 'success',
@@ -95,6 +95,7 @@ function UpdateExamDialog({ data }: Props) {
           data.topic = topic;
           data.description = description;
           data.isPublic = isPublic;
+          data.version = version;
           onClose();
         })
         .catch((message) => {
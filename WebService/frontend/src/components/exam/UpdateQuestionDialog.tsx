@@ -74,10 +74,11 @@ function UpdateQuestionDialog({ data }: Props) {
         examId: data.examId,
         ask: ask.trim(),
         answers: answers.map((item) => item.answer.trim()),
+        version: data.version,
       })
     )
       .unwrap()
-      .then(() => {
+      .then(({ version }) => {
         toast({
           title: '修改成功',
           status: 'success',
@@ -88,6 +89,7 @@ function UpdateQuestionDialog({ data }: Props) {
 
         data.ask = ask;
         data.answers = answers.map((item) => item.answer.trim());
+        data.version = version;
         onClose();
       })
       .catch((message) => {
@@ -54,7 +54,9 @@ function WordForm() {
     dispatch(loaderActions.toggleLoading());
 
     try {
-      const response = await axios.get(`/restricted/word/${word.trim()}`);
+      const response = await axios.get('/restricted/word', {
+        params: { word: word.trim() },
+      });
       setWordFamilyMembers(
         response.data.wordFamily ? response.data.wordFamily.members : [],
       );
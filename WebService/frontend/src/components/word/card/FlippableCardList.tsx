@@ -23,9 +23,11 @@ const CARD_WIDTH = 900;
 
 type Props = {
   wordMeanings: WordMeaning[];
+  // 翻開卡片時呼叫，用來記錄複習次數
+  onFlip?: (wordMeaning: WordMeaning) => void;
 };
 
-function FlippableCardList({ wordMeanings }: Props) {
+function FlippableCardList({ wordMeanings, onFlip }: Props) {
   let initX: number;
 
   if (wordMeanings.length % 2 === 0) {
@@ -66,6 +68,7 @@ function FlippableCardList({ wordMeanings }: Props) {
     }
 
     setIsFlip((prevIsFlip) => !prevIsFlip);
+    onFlip?.(wordMeanings[currentCardIndex]);
   };
 
   useHotkeys('a', goToPreviousCard, { scopes: ['card'] });
@@ -5,4 +5,6 @@ export interface Exam {
   tags: string[];
   isPublic: boolean;
   userId?: string;
+  // 修改時以 If-Match 帶入，避免覆蓋其他人的修改
+  version?: number;
 }
@@ -1,7 +1,18 @@
+// 每一題的作答內容，是否答對由後端評分
+export interface ExamAttemptAnswer {
+  questionId: string;
+  answer: string;
+  blankAnswers: string[];
+  isCorrect: boolean;
+  credit: number;
+}
+
 export interface ExamRecord {
   _id?: string;
   examId: string;
   score: number;
   userId: string;
   createdAt: string;
+  answers?: ExamAttemptAnswer[];
+  percentage?: number;
 }
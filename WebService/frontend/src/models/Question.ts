@@ -4,4 +4,10 @@ export interface Question {
   ask: string;
   answers: string[];
   userId?: string;
+  // 修改時以 If-Match 帶入，避免覆蓋其他人的修改
+  version?: number;
+  // 作答時不回傳答案，以答案的數量顯示空格
+  answerCount?: number;
+  // 作答時顯示的選項，沒有選項的題目直接填寫答案
+  choices?: string[];
 }
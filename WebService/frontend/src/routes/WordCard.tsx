@@ -7,7 +7,7 @@ import {
   useToast,
 } from '@chakra-ui/react';
 import axios, { AxiosError } from 'axios';
-import { useEffect, useState } from 'react';
+import { useEffect, useRef, useState } from 'react';
 import PageHeading from '../components/PageHeading';
 import FlippableCardList from '../components/word/card/FlippableCardList';
 import { WordMeaning } from '../models/WordMeaning';
@@ -23,6 +23,29 @@ function WordCard() {
   const dispatch = useAppDispatch();
   const toast = useToast();
 
+  // 已記錄複習次數的卡片，同一張卡片只記錄一次
+  const reviewedIds = useRef(new Set<string>());
+
+  const markReviewed = async ({ favoriteWordMeaningId }: WordMeaning) => {
+    if (
+      !favoriteWordMeaningId ||
+      reviewedIds.current.has(favoriteWordMeaningId)
+    ) {
+      return;
+    }
+
+    reviewedIds.current.add(favoriteWordMeaningId);
+
+    try {
+      await axios.post('/restricted/word/card/reviewed', {
+        favoriteWordMeaningIds: [favoriteWordMeaningId],
+      });
+    } catch (err) {
+      // 記錄失敗不影響複習，下次翻開時再試
+      reviewedIds.current.delete(favoriteWordMeaningId);
+    }
+  };
+
   useEffect(() => {
     const queryRandomFavoriteWordMeanings = async () => {
       dispatch(loaderActions.toggleLoading());
@@ -92,7 +115,10 @@ function WordCard() {
       </Container>
       {isReady &&
         (favoriteWordMeanings && favoriteWordMeanings.length > 0 ? (
-          <FlippableCardList wordMeanings={favoriteWordMeanings} />
+          <FlippableCardList
+            wordMeanings={favoriteWordMeanings}
+            onFlip={markReviewed}
+          />
         ) : (
           <Center>
             <Text fontSize="xl">
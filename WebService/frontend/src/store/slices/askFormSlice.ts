@@ -1,28 +1,29 @@
 import { createSlice } from '@reduxjs/toolkit';
 import type { PayloadAction } from '@reduxjs/toolkit';
 
-type QuestionResult = {
+// 每一題填入的答案，送到後端評分
+type QuestionAnswer = {
   questionId: string;
-  isSuccess: boolean;
+  blankAnswers: string[];
 };
 
 type AskFormState = {
-  questionResults: QuestionResult[];
+  questionAnswers: QuestionAnswer[];
 };
 
 const initialState: AskFormState = {
-  questionResults: [],
+  questionAnswers: [],
 };
 
 export const askFormSlice = createSlice({
   name: 'askForm',
   initialState,
   reducers: {
-    addQuestionResult: (state, action: PayloadAction<QuestionResult>) => {
-      state.questionResults.push(action.payload);
+    addQuestionAnswer: (state, action: PayloadAction<QuestionAnswer>) => {
+      state.questionAnswers.push(action.payload);
     },
-    clearQuestionResult: (state) => {
-      state.questionResults.length = 0;
+    clearQuestionAnswers: (state) => {
+      state.questionAnswers.length = 0;
     },
   },
 });
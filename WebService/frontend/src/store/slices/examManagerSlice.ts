@@ -3,6 +3,7 @@ import type { PayloadAction } from '@reduxjs/toolkit';
 import axios, { AxiosError } from 'axios';
 import { loaderActions } from './loaderSlice';
 import { Exam } from '../../models/Exam';
+import { ifMatchHeaders } from '../../utils/http';
 
 type ExamManagerState = {
   saveExamTimes: number;
@@ -65,7 +66,9 @@ export const updateExam = createAsyncThunk(
     dispatch(loaderActions.toggleLoading());
 
     try {
-      const response = await axios.patch('/restricted/exam', exam);
+      const response = await axios.patch('/restricted/exam', exam, {
+        headers: ifMatchHeaders(exam.version),
+      });
       return response.data;
     } catch (err) {
       const errorMessage = axios.isAxiosError(err)
@@ -3,6 +3,7 @@ import type { PayloadAction } from '@reduxjs/toolkit';
 import axios, { AxiosError } from 'axios';
 import { loaderActions } from './loaderSlice';
 import { Question } from '../../models/Question';
+import { ifMatchHeaders } from '../../utils/http';
 
 type QuestionManagerState = {
   saveQuestionTimes: number;
@@ -58,6 +59,7 @@ export const createQuestion = createAsyncThunk(
       const response = await axios.post(
         `/restricted/exam/${question.examId}/question`,
         question,
+        { headers: ifMatchHeaders(question.version) },
       );
       return response.data;
     } catch (err) {
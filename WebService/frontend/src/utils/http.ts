@@ -0,0 +1,3 @@
+// 有版本時帶入 If-Match，後端發現資料已被其他人修改會回傳 409
+export const ifMatchHeaders = (version?: number): Record<string, string> =>
+  version === undefined ? {} : { 'If-Match': `"${version}"` };
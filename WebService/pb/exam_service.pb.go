@@ -34,6 +34,17 @@ type Exam struct {
 	UserId      string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 複製自其他測驗時，原本的測驗 id 與作者
+	ForkedFromExamId string `protobuf:"bytes,9,opt,name=forked_from_exam_id,json=forkedFromExamId,proto3" json:"forked_from_exam_id,omitempty"`
+	ForkedFromUserId string `protobuf:"bytes,10,opt,name=forked_from_user_id,json=forkedFromUserId,proto3" json:"forked_from_user_id,omitempty"`
+	// 最新發佈的版本，0 表示尚未發佈
+	LatestVersion int32 `protobuf:"varint,11,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	// 沒有設定過的測驗回傳預設的設定
+	Settings *ExamSettings `protobuf:"bytes,12,opt,name=settings,proto3" json:"settings,omitempty"`
+	// 引用的題庫題目，沒有引用題庫時為空
+	BankSource *ExamBankSource `protobuf:"bytes,13,opt,name=bank_source,json=bankSource,proto3" json:"bank_source,omitempty"`
+	// 每次修改後加 1，用來檢查修改時是否已被其他人修改
+	Version int32 `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *Exam) Reset() {
@@ -124,6 +135,148 @@ func (x *Exam) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Exam) GetForkedFromExamId() string {
+	if x != nil {
+		return x.ForkedFromExamId
+	}
+	return ""
+}
+
+func (x *Exam) GetForkedFromUserId() string {
+	if x != nil {
+		return x.ForkedFromUserId
+	}
+	return ""
+}
+
+func (x *Exam) GetLatestVersion() int32 {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return 0
+}
+
+func (x *Exam) GetSettings() *ExamSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *Exam) GetBankSource() *ExamBankSource {
+	if x != nil {
+		return x.BankSource
+	}
+	return nil
+}
+
+func (x *Exam) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type ExamSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 每次作答的題數，0 表示由呼叫端決定
+	QuestionsPerAttempt int32 `protobuf:"varint,1,opt,name=questions_per_attempt,json=questionsPerAttempt,proto3" json:"questions_per_attempt,omitempty"`
+	ShuffleQuestions    bool  `protobuf:"varint,2,opt,name=shuffle_questions,json=shuffleQuestions,proto3" json:"shuffle_questions,omitempty"`
+	ShuffleAnswers      bool  `protobuf:"varint,3,opt,name=shuffle_answers,json=shuffleAnswers,proto3" json:"shuffle_answers,omitempty"`
+	// 及格分數的百分比，0 表示不設定及格分數
+	PassingScorePercent int32 `protobuf:"varint,4,opt,name=passing_score_percent,json=passingScorePercent,proto3" json:"passing_score_percent,omitempty"`
+	// 每個使用者最多的作答次數，0 表示不限制，擁有者與共同編輯者不受限制
+	MaxAttempts int32 `protobuf:"varint,5,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// 交卷後是否可以查看題目的答案和解說
+	ShowAnswersAfterSubmit bool `protobuf:"varint,6,opt,name=show_answers_after_submit,json=showAnswersAfterSubmit,proto3" json:"show_answers_after_submit,omitempty"`
+	// 作答時查看提示的題目扣除該題分數的百分比，0 表示不扣分
+	HintPenaltyPercent int32 `protobuf:"varint,7,opt,name=hint_penalty_percent,json=hintPenaltyPercent,proto3" json:"hint_penalty_percent,omitempty"`
+}
+
+func (x *ExamSettings) Reset() {
+	*x = ExamSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExamSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamSettings) ProtoMessage() {}
+
+func (x *ExamSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamSettings.ProtoReflect.Descriptor instead.
+func (*ExamSettings) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExamSettings) GetQuestionsPerAttempt() int32 {
+	if x != nil {
+		return x.QuestionsPerAttempt
+	}
+	return 0
+}
+
+func (x *ExamSettings) GetShuffleQuestions() bool {
+	if x != nil {
+		return x.ShuffleQuestions
+	}
+	return false
+}
+
+func (x *ExamSettings) GetShuffleAnswers() bool {
+	if x != nil {
+		return x.ShuffleAnswers
+	}
+	return false
+}
+
+func (x *ExamSettings) GetPassingScorePercent() int32 {
+	if x != nil {
+		return x.PassingScorePercent
+	}
+	return 0
+}
+
+func (x *ExamSettings) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *ExamSettings) GetShowAnswersAfterSubmit() bool {
+	if x != nil {
+		return x.ShowAnswersAfterSubmit
+	}
+	return false
+}
+
+func (x *ExamSettings) GetHintPenaltyPercent() int32 {
+	if x != nil {
+		return x.HintPenaltyPercent
+	}
+	return 0
+}
+
 type CreateExamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -133,12 +286,14 @@ type CreateExamRequest struct {
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	IsPublic    bool   `protobuf:"varint,3,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
 	UserId      string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 標籤會轉成小寫並移除重複的標籤
+	Tags []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
 func (x *CreateExamRequest) Reset() {
 	*x = CreateExamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[1]
+		mi := &file_exam_service_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -151,7 +306,7 @@ func (x *CreateExamRequest) String() string {
 func (*CreateExamRequest) ProtoMessage() {}
 
 func (x *CreateExamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[1]
+	mi := &file_exam_service_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -164,7 +319,7 @@ func (x *CreateExamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateExamRequest.ProtoReflect.Descriptor instead.
 func (*CreateExamRequest) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{1}
+	return file_exam_service_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateExamRequest) GetTopic() string {
@@ -195,6 +350,13 @@ func (x *CreateExamRequest) GetUserId() string {
 	return ""
 }
 
+func (x *CreateExamRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type CreateExamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -206,7 +368,7 @@ type CreateExamResponse struct {
 func (x *CreateExamResponse) Reset() {
 	*x = CreateExamResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[2]
+		mi := &file_exam_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -219,7 +381,7 @@ func (x *CreateExamResponse) String() string {
 func (*CreateExamResponse) ProtoMessage() {}
 
 func (x *CreateExamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[2]
+	mi := &file_exam_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -232,7 +394,7 @@ func (x *CreateExamResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateExamResponse.ProtoReflect.Descriptor instead.
 func (*CreateExamResponse) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{2}
+	return file_exam_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateExamResponse) GetExamId() string {
@@ -252,12 +414,19 @@ type UpdateExamRequest struct {
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	IsPublic    bool   `protobuf:"varint,4,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
 	UserId      string `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 標籤會轉成小寫並移除重複的標籤
+	Tags []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	// 沒有傳入時不修改原本的設定
+	Settings *ExamSettings `protobuf:"bytes,7,opt,name=settings,proto3" json:"settings,omitempty"`
+	// 讀取時的版本號，與目前的版本號不同時回傳 Aborted，沒有傳入時不檢查，
+	// 0 表示還沒有版本號的舊資料
+	ExpectedVersion *int32 `protobuf:"varint,8,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
 }
 
 func (x *UpdateExamRequest) Reset() {
 	*x = UpdateExamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[3]
+		mi := &file_exam_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -270,7 +439,7 @@ func (x *UpdateExamRequest) String() string {
 func (*UpdateExamRequest) ProtoMessage() {}
 
 func (x *UpdateExamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[3]
+	mi := &file_exam_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -283,7 +452,7 @@ func (x *UpdateExamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateExamRequest.ProtoReflect.Descriptor instead.
 func (*UpdateExamRequest) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{3}
+	return file_exam_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UpdateExamRequest) GetExamId() string {
@@ -321,18 +490,41 @@ func (x *UpdateExamRequest) GetUserId() string {
 	return ""
 }
 
+func (x *UpdateExamRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateExamRequest) GetSettings() *ExamSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *UpdateExamRequest) GetExpectedVersion() int32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type UpdateExamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ExamId string `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	// 修改後的版本號
+	Version int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *UpdateExamResponse) Reset() {
 	*x = UpdateExamResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[4]
+		mi := &file_exam_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -345,7 +537,7 @@ func (x *UpdateExamResponse) String() string {
 func (*UpdateExamResponse) ProtoMessage() {}
 
 func (x *UpdateExamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[4]
+	mi := &file_exam_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,7 +550,7 @@ func (x *UpdateExamResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateExamResponse.ProtoReflect.Descriptor instead.
 func (*UpdateExamResponse) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{4}
+	return file_exam_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateExamResponse) GetExamId() string {
@@ -368,6 +560,116 @@ func (x *UpdateExamResponse) GetExamId() string {
 	return ""
 }
 
+func (x *UpdateExamResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// 擁有者和共同編輯者可以查詢，用來取得修改前的版本號
+type GetExamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExamId string `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetExamRequest) Reset() {
+	*x = GetExamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExamRequest) ProtoMessage() {}
+
+func (x *GetExamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExamRequest.ProtoReflect.Descriptor instead.
+func (*GetExamRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetExamRequest) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *GetExamRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetExamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exam *Exam `protobuf:"bytes,1,opt,name=exam,proto3" json:"exam,omitempty"`
+}
+
+func (x *GetExamResponse) Reset() {
+	*x = GetExamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExamResponse) ProtoMessage() {}
+
+func (x *GetExamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExamResponse.ProtoReflect.Descriptor instead.
+func (*GetExamResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetExamResponse) GetExam() *Exam {
+	if x != nil {
+		return x.Exam
+	}
+	return nil
+}
+
 type FindExamsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -376,12 +678,14 @@ type FindExamsRequest struct {
 	PageIndex int32  `protobuf:"varint,1,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
 	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	UserId    string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 有值時改用 cursor 分頁，忽略 page_index 且不回傳 total、page_count
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
 func (x *FindExamsRequest) Reset() {
 	*x = FindExamsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[5]
+		mi := &file_exam_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -394,7 +698,7 @@ func (x *FindExamsRequest) String() string {
 func (*FindExamsRequest) ProtoMessage() {}
 
 func (x *FindExamsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[5]
+	mi := &file_exam_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -407,7 +711,7 @@ func (x *FindExamsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindExamsRequest.ProtoReflect.Descriptor instead.
 func (*FindExamsRequest) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{5}
+	return file_exam_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *FindExamsRequest) GetPageIndex() int32 {
@@ -431,6 +735,13 @@ func (x *FindExamsRequest) GetUserId() string {
 	return ""
 }
 
+func (x *FindExamsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type FindExamsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -439,12 +750,14 @@ type FindExamsResponse struct {
 	Total     int32   `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
 	PageCount int32   `protobuf:"varint,2,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
 	Exams     []*Exam `protobuf:"bytes,3,rep,name=exams,proto3" json:"exams,omitempty"`
+	// 下一頁的 cursor，沒有下一頁時為空字串
+	NextCursor string `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 }
 
 func (x *FindExamsResponse) Reset() {
 	*x = FindExamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[6]
+		mi := &file_exam_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -457,7 +770,7 @@ func (x *FindExamsResponse) String() string {
 func (*FindExamsResponse) ProtoMessage() {}
 
 func (x *FindExamsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[6]
+	mi := &file_exam_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -470,7 +783,7 @@ func (x *FindExamsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindExamsResponse.ProtoReflect.Descriptor instead.
 func (*FindExamsResponse) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{6}
+	return file_exam_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *FindExamsResponse) GetTotal() int32 {
@@ -494,6 +807,13 @@ func (x *FindExamsResponse) GetExams() []*Exam {
 	return nil
 }
 
+func (x *FindExamsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type DeleteExamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -506,7 +826,7 @@ type DeleteExamRequest struct {
 func (x *DeleteExamRequest) Reset() {
 	*x = DeleteExamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[7]
+		mi := &file_exam_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -519,7 +839,7 @@ func (x *DeleteExamRequest) String() string {
 func (*DeleteExamRequest) ProtoMessage() {}
 
 func (x *DeleteExamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[7]
+	mi := &file_exam_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +852,7 @@ func (x *DeleteExamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteExamRequest.ProtoReflect.Descriptor instead.
 func (*DeleteExamRequest) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{7}
+	return file_exam_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteExamRequest) GetExamId() string {
@@ -558,7 +878,7 @@ type DeleteExamResponse struct {
 func (x *DeleteExamResponse) Reset() {
 	*x = DeleteExamResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[8]
+		mi := &file_exam_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -571,7 +891,7 @@ func (x *DeleteExamResponse) String() string {
 func (*DeleteExamResponse) ProtoMessage() {}
 
 func (x *DeleteExamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[8]
+	mi := &file_exam_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -584,40 +904,39 @@ func (x *DeleteExamResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteExamResponse.ProtoReflect.Descriptor instead.
 func (*DeleteExamResponse) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{8}
+	return file_exam_service_proto_rawDescGZIP(), []int{11}
 }
 
-type Question struct {
+// 用來產生練習測驗題目的單字解釋
+type PracticeWord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string                 `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
-	ExamId    string                 `protobuf:"bytes,2,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
-	Ask       string                 `protobuf:"bytes,3,opt,name=ask,proto3" json:"ask,omitempty"`
-	Answers   []string               `protobuf:"bytes,4,rep,name=answers,proto3" json:"answers,omitempty"`
-	UserId    string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	WordMeaningId string `protobuf:"bytes,1,opt,name=word_meaning_id,json=wordMeaningId,proto3" json:"word_meaning_id,omitempty"`
+	Word          string `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
+	Definition    string `protobuf:"bytes,3,opt,name=definition,proto3" json:"definition,omitempty"`
+	// 例句，用來產生填空題
+	Sentences []string `protobuf:"bytes,4,rep,name=sentences,proto3" json:"sentences,omitempty"`
 }
 
-func (x *Question) Reset() {
-	*x = Question{}
+func (x *PracticeWord) Reset() {
+	*x = PracticeWord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[9]
+		mi := &file_exam_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Question) String() string {
+func (x *PracticeWord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Question) ProtoMessage() {}
+func (*PracticeWord) ProtoMessage() {}
 
-func (x *Question) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[9]
+func (x *PracticeWord) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -628,88 +947,69 @@ func (x *Question) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Question.ProtoReflect.Descriptor instead.
-func (*Question) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *Question) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use PracticeWord.ProtoReflect.Descriptor instead.
+func (*PracticeWord) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Question) GetExamId() string {
+func (x *PracticeWord) GetWordMeaningId() string {
 	if x != nil {
-		return x.ExamId
+		return x.WordMeaningId
 	}
 	return ""
 }
 
-func (x *Question) GetAsk() string {
+func (x *PracticeWord) GetWord() string {
 	if x != nil {
-		return x.Ask
+		return x.Word
 	}
 	return ""
 }
 
-func (x *Question) GetAnswers() []string {
-	if x != nil {
-		return x.Answers
-	}
-	return nil
-}
-
-func (x *Question) GetUserId() string {
+func (x *PracticeWord) GetDefinition() string {
 	if x != nil {
-		return x.UserId
+		return x.Definition
 	}
 	return ""
 }
 
-func (x *Question) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *Question) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *PracticeWord) GetSentences() []string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Sentences
 	}
 	return nil
 }
 
-type CreateQuestionRequest struct {
+type CreatePracticeExamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExamId  string   `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
-	Ask     string   `protobuf:"bytes,2,opt,name=ask,proto3" json:"ask,omitempty"`
-	Answers []string `protobuf:"bytes,3,rep,name=answers,proto3" json:"answers,omitempty"`
-	UserId  string   `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Topic         string          `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Description   string          `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PracticeWords []*PracticeWord `protobuf:"bytes,3,rep,name=practice_words,json=practiceWords,proto3" json:"practice_words,omitempty"`
+	// 題型：definition_to_word、word_to_definition、cloze，未指定時使用全部的題型
+	QuestionTypes []string `protobuf:"bytes,4,rep,name=question_types,json=questionTypes,proto3" json:"question_types,omitempty"`
+	UserId        string   `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *CreateQuestionRequest) Reset() {
-	*x = CreateQuestionRequest{}
+func (x *CreatePracticeExamRequest) Reset() {
+	*x = CreatePracticeExamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[10]
+		mi := &file_exam_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateQuestionRequest) String() string {
+func (x *CreatePracticeExamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateQuestionRequest) ProtoMessage() {}
+func (*CreatePracticeExamRequest) ProtoMessage() {}
 
-func (x *CreateQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[10]
+func (x *CreatePracticeExamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -720,64 +1020,72 @@ func (x *CreateQuestionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateQuestionRequest.ProtoReflect.Descriptor instead.
-func (*CreateQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use CreatePracticeExamRequest.ProtoReflect.Descriptor instead.
+func (*CreatePracticeExamRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CreateQuestionRequest) GetExamId() string {
+func (x *CreatePracticeExamRequest) GetTopic() string {
 	if x != nil {
-		return x.ExamId
+		return x.Topic
 	}
 	return ""
 }
 
-func (x *CreateQuestionRequest) GetAsk() string {
+func (x *CreatePracticeExamRequest) GetDescription() string {
 	if x != nil {
-		return x.Ask
+		return x.Description
 	}
 	return ""
 }
 
-func (x *CreateQuestionRequest) GetAnswers() []string {
+func (x *CreatePracticeExamRequest) GetPracticeWords() []*PracticeWord {
 	if x != nil {
-		return x.Answers
+		return x.PracticeWords
 	}
 	return nil
 }
 
-func (x *CreateQuestionRequest) GetUserId() string {
+func (x *CreatePracticeExamRequest) GetQuestionTypes() []string {
+	if x != nil {
+		return x.QuestionTypes
+	}
+	return nil
+}
+
+func (x *CreatePracticeExamRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type CreateQuestionResponse struct {
+type CreatePracticeExamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	QuestionId string `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	ExamId        string `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	QuestionCount int32  `protobuf:"varint,2,opt,name=question_count,json=questionCount,proto3" json:"question_count,omitempty"`
 }
 
-func (x *CreateQuestionResponse) Reset() {
-	*x = CreateQuestionResponse{}
+func (x *CreatePracticeExamResponse) Reset() {
+	*x = CreatePracticeExamResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[11]
+		mi := &file_exam_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateQuestionResponse) String() string {
+func (x *CreatePracticeExamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateQuestionResponse) ProtoMessage() {}
+func (*CreatePracticeExamResponse) ProtoMessage() {}
 
-func (x *CreateQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[11]
+func (x *CreatePracticeExamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,46 +1096,55 @@ func (x *CreateQuestionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateQuestionResponse.ProtoReflect.Descriptor instead.
-func (*CreateQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use CreatePracticeExamResponse.ProtoReflect.Descriptor instead.
+func (*CreatePracticeExamResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CreateQuestionResponse) GetQuestionId() string {
+func (x *CreatePracticeExamResponse) GetExamId() string {
 	if x != nil {
-		return x.QuestionId
+		return x.ExamId
 	}
 	return ""
 }
 
-type UpdateQuestionRequest struct {
+func (x *CreatePracticeExamResponse) GetQuestionCount() int32 {
+	if x != nil {
+		return x.QuestionCount
+	}
+	return 0
+}
+
+type FindExamsByTagsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	QuestionId string   `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
-	Ask        string   `protobuf:"bytes,2,opt,name=ask,proto3" json:"ask,omitempty"`
-	Answers    []string `protobuf:"bytes,3,rep,name=answers,proto3" json:"answers,omitempty"`
-	UserId     string   `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 查詢包含全部標籤的測驗
+	Tags []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	// 有值時除了公開的測驗，也查詢該使用者自己的測驗
+	UserId    string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageIndex int32  `protobuf:"varint,3,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
+	PageSize  int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 }
 
-func (x *UpdateQuestionRequest) Reset() {
-	*x = UpdateQuestionRequest{}
+func (x *FindExamsByTagsRequest) Reset() {
+	*x = FindExamsByTagsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[12]
+		mi := &file_exam_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateQuestionRequest) String() string {
+func (x *FindExamsByTagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateQuestionRequest) ProtoMessage() {}
+func (*FindExamsByTagsRequest) ProtoMessage() {}
 
-func (x *UpdateQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[12]
+func (x *FindExamsByTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -838,64 +1155,66 @@ func (x *UpdateQuestionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateQuestionRequest.ProtoReflect.Descriptor instead.
-func (*UpdateQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use FindExamsByTagsRequest.ProtoReflect.Descriptor instead.
+func (*FindExamsByTagsRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UpdateQuestionRequest) GetQuestionId() string {
+func (x *FindExamsByTagsRequest) GetTags() []string {
 	if x != nil {
-		return x.QuestionId
+		return x.Tags
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateQuestionRequest) GetAsk() string {
+func (x *FindExamsByTagsRequest) GetUserId() string {
 	if x != nil {
-		return x.Ask
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdateQuestionRequest) GetAnswers() []string {
+func (x *FindExamsByTagsRequest) GetPageIndex() int32 {
 	if x != nil {
-		return x.Answers
+		return x.PageIndex
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateQuestionRequest) GetUserId() string {
+func (x *FindExamsByTagsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.UserId
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-type UpdateQuestionResponse struct {
+type FindExamsByTagsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	QuestionId string `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Total     int32   `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	PageCount int32   `protobuf:"varint,2,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	Exams     []*Exam `protobuf:"bytes,3,rep,name=exams,proto3" json:"exams,omitempty"`
 }
 
-func (x *UpdateQuestionResponse) Reset() {
-	*x = UpdateQuestionResponse{}
+func (x *FindExamsByTagsResponse) Reset() {
+	*x = FindExamsByTagsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[13]
+		mi := &file_exam_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateQuestionResponse) String() string {
+func (x *FindExamsByTagsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateQuestionResponse) ProtoMessage() {}
+func (*FindExamsByTagsResponse) ProtoMessage() {}
 
-func (x *UpdateQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[13]
+func (x *FindExamsByTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -906,46 +1225,58 @@ func (x *UpdateQuestionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateQuestionResponse.ProtoReflect.Descriptor instead.
-func (*UpdateQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use FindExamsByTagsResponse.ProtoReflect.Descriptor instead.
+func (*FindExamsByTagsResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *UpdateQuestionResponse) GetQuestionId() string {
+func (x *FindExamsByTagsResponse) GetTotal() int32 {
 	if x != nil {
-		return x.QuestionId
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-type FindQuestionsRequest struct {
+func (x *FindExamsByTagsResponse) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+func (x *FindExamsByTagsResponse) GetExams() []*Exam {
+	if x != nil {
+		return x.Exams
+	}
+	return nil
+}
+
+type TagCount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageIndex int32  `protobuf:"varint,1,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
-	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	ExamId    string `protobuf:"bytes,3,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
-	UserId    string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Tag   string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Count int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *FindQuestionsRequest) Reset() {
-	*x = FindQuestionsRequest{}
+func (x *TagCount) Reset() {
+	*x = TagCount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[14]
+		mi := &file_exam_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindQuestionsRequest) String() string {
+func (x *TagCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindQuestionsRequest) ProtoMessage() {}
+func (*TagCount) ProtoMessage() {}
 
-func (x *FindQuestionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[14]
+func (x *TagCount) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -956,66 +1287,51 @@ func (x *FindQuestionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindQuestionsRequest.ProtoReflect.Descriptor instead.
-func (*FindQuestionsRequest) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *FindQuestionsRequest) GetPageIndex() int32 {
-	if x != nil {
-		return x.PageIndex
-	}
-	return 0
-}
-
-func (x *FindQuestionsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use TagCount.ProtoReflect.Descriptor instead.
+func (*TagCount) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *FindQuestionsRequest) GetExamId() string {
+func (x *TagCount) GetTag() string {
 	if x != nil {
-		return x.ExamId
+		return x.Tag
 	}
 	return ""
 }
 
-func (x *FindQuestionsRequest) GetUserId() string {
+func (x *TagCount) GetCount() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type FindQuestionsResponse struct {
+type ForkExamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total     int32       `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	PageCount int32       `protobuf:"varint,2,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
-	Questions []*Question `protobuf:"bytes,3,rep,name=questions,proto3" json:"questions,omitempty"`
+	ExamId string `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *FindQuestionsResponse) Reset() {
-	*x = FindQuestionsResponse{}
+func (x *ForkExamRequest) Reset() {
+	*x = ForkExamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[15]
+		mi := &file_exam_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindQuestionsResponse) String() string {
+func (x *ForkExamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindQuestionsResponse) ProtoMessage() {}
+func (*ForkExamRequest) ProtoMessage() {}
 
-func (x *FindQuestionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[15]
+func (x *ForkExamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1026,58 +1342,51 @@ func (x *FindQuestionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindQuestionsResponse.ProtoReflect.Descriptor instead.
-func (*FindQuestionsResponse) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *FindQuestionsResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+// Deprecated: Use ForkExamRequest.ProtoReflect.Descriptor instead.
+func (*ForkExamRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *FindQuestionsResponse) GetPageCount() int32 {
+func (x *ForkExamRequest) GetExamId() string {
 	if x != nil {
-		return x.PageCount
+		return x.ExamId
 	}
-	return 0
+	return ""
 }
 
-func (x *FindQuestionsResponse) GetQuestions() []*Question {
+func (x *ForkExamRequest) GetUserId() string {
 	if x != nil {
-		return x.Questions
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type DeleteQuestionRequest struct {
+type ForkExamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	QuestionId string `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
-	UserId     string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExamId        string `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	QuestionCount int32  `protobuf:"varint,2,opt,name=question_count,json=questionCount,proto3" json:"question_count,omitempty"`
 }
 
-func (x *DeleteQuestionRequest) Reset() {
-	*x = DeleteQuestionRequest{}
+func (x *ForkExamResponse) Reset() {
+	*x = ForkExamResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[16]
+		mi := &file_exam_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteQuestionRequest) String() string {
+func (x *ForkExamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteQuestionRequest) ProtoMessage() {}
+func (*ForkExamResponse) ProtoMessage() {}
 
-func (x *DeleteQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[16]
+func (x *ForkExamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1088,48 +1397,51 @@ func (x *DeleteQuestionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteQuestionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ForkExamResponse.ProtoReflect.Descriptor instead.
+func (*ForkExamResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DeleteQuestionRequest) GetQuestionId() string {
+func (x *ForkExamResponse) GetExamId() string {
 	if x != nil {
-		return x.QuestionId
+		return x.ExamId
 	}
 	return ""
 }
 
-func (x *DeleteQuestionRequest) GetUserId() string {
+func (x *ForkExamResponse) GetQuestionCount() int32 {
 	if x != nil {
-		return x.UserId
+		return x.QuestionCount
 	}
-	return ""
+	return 0
 }
 
-type DeleteQuestionResponse struct {
+type PublishExamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ExamId string `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *DeleteQuestionResponse) Reset() {
-	*x = DeleteQuestionResponse{}
+func (x *PublishExamRequest) Reset() {
+	*x = PublishExamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[17]
+		mi := &file_exam_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteQuestionResponse) String() string {
+func (x *PublishExamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteQuestionResponse) ProtoMessage() {}
+func (*PublishExamRequest) ProtoMessage() {}
 
-func (x *DeleteQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[17]
+func (x *PublishExamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1140,38 +1452,52 @@ func (x *DeleteQuestionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteQuestionResponse.ProtoReflect.Descriptor instead.
-func (*DeleteQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use PublishExamRequest.ProtoReflect.Descriptor instead.
+func (*PublishExamRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{20}
 }
 
-type FindRandomQuestionsRequest struct {
+func (x *PublishExamRequest) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *PublishExamRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type PublishExamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExamId string `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
-	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Size   int32  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	ExamId        string `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	Version       int32  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	QuestionCount int32  `protobuf:"varint,3,opt,name=question_count,json=questionCount,proto3" json:"question_count,omitempty"`
 }
 
-func (x *FindRandomQuestionsRequest) Reset() {
-	*x = FindRandomQuestionsRequest{}
+func (x *PublishExamResponse) Reset() {
+	*x = PublishExamResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[18]
+		mi := &file_exam_service_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindRandomQuestionsRequest) String() string {
+func (x *PublishExamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindRandomQuestionsRequest) ProtoMessage() {}
+func (*PublishExamResponse) ProtoMessage() {}
 
-func (x *FindRandomQuestionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[18]
+func (x *PublishExamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1182,58 +1508,60 @@ func (x *FindRandomQuestionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindRandomQuestionsRequest.ProtoReflect.Descriptor instead.
-func (*FindRandomQuestionsRequest) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use PublishExamResponse.ProtoReflect.Descriptor instead.
+func (*PublishExamResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *FindRandomQuestionsRequest) GetExamId() string {
+func (x *PublishExamResponse) GetExamId() string {
 	if x != nil {
 		return x.ExamId
 	}
 	return ""
 }
 
-func (x *FindRandomQuestionsRequest) GetUserId() string {
+func (x *PublishExamResponse) GetVersion() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-func (x *FindRandomQuestionsRequest) GetSize() int32 {
+func (x *PublishExamResponse) GetQuestionCount() int32 {
 	if x != nil {
-		return x.Size
+		return x.QuestionCount
 	}
 	return 0
 }
 
-type FindRandomQuestionsResponse struct {
+// 測驗分享的對象，role 是 editor（共同編輯者）或 taker（作答者）
+type ExamShare struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Exam      *Exam       `protobuf:"bytes,1,opt,name=exam,proto3" json:"exam,omitempty"`
-	Questions []*Question `protobuf:"bytes,2,rep,name=questions,proto3" json:"questions,omitempty"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role      string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *FindRandomQuestionsResponse) Reset() {
-	*x = FindRandomQuestionsResponse{}
+func (x *ExamShare) Reset() {
+	*x = ExamShare{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[19]
+		mi := &file_exam_service_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindRandomQuestionsResponse) String() string {
+func (x *ExamShare) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindRandomQuestionsResponse) ProtoMessage() {}
+func (*ExamShare) ProtoMessage() {}
 
-func (x *FindRandomQuestionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[19]
+func (x *ExamShare) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1244,55 +1572,60 @@ func (x *FindRandomQuestionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindRandomQuestionsResponse.ProtoReflect.Descriptor instead.
-func (*FindRandomQuestionsResponse) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ExamShare.ProtoReflect.Descriptor instead.
+func (*ExamShare) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *FindRandomQuestionsResponse) GetExam() *Exam {
+func (x *ExamShare) GetUserId() string {
 	if x != nil {
-		return x.Exam
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *FindRandomQuestionsResponse) GetQuestions() []*Question {
+func (x *ExamShare) GetRole() string {
 	if x != nil {
-		return x.Questions
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ExamShare) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type ExamRecord struct {
+type ShareExamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string                 `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
-	ExamId    string                 `protobuf:"bytes,2,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
-	Score     int32                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
-	UserId    string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ExamId       string `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	SharedUserId string `protobuf:"bytes,2,opt,name=shared_user_id,json=sharedUserId,proto3" json:"shared_user_id,omitempty"`
+	Role         string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	UserId       string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *ExamRecord) Reset() {
-	*x = ExamRecord{}
+func (x *ShareExamRequest) Reset() {
+	*x = ShareExamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[20]
+		mi := &file_exam_service_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExamRecord) String() string {
+func (x *ShareExamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExamRecord) ProtoMessage() {}
+func (*ShareExamRequest) ProtoMessage() {}
 
-func (x *ExamRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[20]
+func (x *ShareExamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1303,81 +1636,104 @@ func (x *ExamRecord) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExamRecord.ProtoReflect.Descriptor instead.
-func (*ExamRecord) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ShareExamRequest.ProtoReflect.Descriptor instead.
+func (*ShareExamRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ExamRecord) GetId() string {
+func (x *ShareExamRequest) GetExamId() string {
 	if x != nil {
-		return x.Id
+		return x.ExamId
 	}
 	return ""
 }
 
-func (x *ExamRecord) GetExamId() string {
+func (x *ShareExamRequest) GetSharedUserId() string {
 	if x != nil {
-		return x.ExamId
+		return x.SharedUserId
 	}
 	return ""
 }
 
-func (x *ExamRecord) GetScore() int32 {
+func (x *ShareExamRequest) GetRole() string {
 	if x != nil {
-		return x.Score
+		return x.Role
 	}
-	return 0
+	return ""
 }
 
-func (x *ExamRecord) GetUserId() string {
+func (x *ShareExamRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *ExamRecord) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
+type ShareExamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ShareExamResponse) Reset() {
+	*x = ShareExamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ExamRecord) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
+func (x *ShareExamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareExamResponse) ProtoMessage() {}
+
+func (x *ShareExamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type CreateExamRecordRequest struct {
+// Deprecated: Use ShareExamResponse.ProtoReflect.Descriptor instead.
+func (*ShareExamResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{24}
+}
+
+type UnshareExamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExamId           string   `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
-	Score            int32    `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
-	WrongQuestionIds []string `protobuf:"bytes,3,rep,name=wrong_question_ids,json=wrongQuestionIds,proto3" json:"wrong_question_ids,omitempty"`
-	UserId           string   `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExamId       string `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	SharedUserId string `protobuf:"bytes,2,opt,name=shared_user_id,json=sharedUserId,proto3" json:"shared_user_id,omitempty"`
+	UserId       string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *CreateExamRecordRequest) Reset() {
-	*x = CreateExamRecordRequest{}
+func (x *UnshareExamRequest) Reset() {
+	*x = UnshareExamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[21]
+		mi := &file_exam_service_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateExamRecordRequest) String() string {
+func (x *UnshareExamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateExamRecordRequest) ProtoMessage() {}
+func (*UnshareExamRequest) ProtoMessage() {}
 
-func (x *CreateExamRecordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[21]
+func (x *UnshareExamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1388,62 +1744,55 @@ func (x *CreateExamRecordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateExamRecordRequest.ProtoReflect.Descriptor instead.
-func (*CreateExamRecordRequest) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use UnshareExamRequest.ProtoReflect.Descriptor instead.
+func (*UnshareExamRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CreateExamRecordRequest) GetExamId() string {
+func (x *UnshareExamRequest) GetExamId() string {
 	if x != nil {
 		return x.ExamId
 	}
 	return ""
 }
 
-func (x *CreateExamRecordRequest) GetScore() int32 {
-	if x != nil {
-		return x.Score
-	}
-	return 0
-}
-
-func (x *CreateExamRecordRequest) GetWrongQuestionIds() []string {
+func (x *UnshareExamRequest) GetSharedUserId() string {
 	if x != nil {
-		return x.WrongQuestionIds
+		return x.SharedUserId
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateExamRecordRequest) GetUserId() string {
+func (x *UnshareExamRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type CreateExamRecordResponse struct {
+type UnshareExamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *CreateExamRecordResponse) Reset() {
-	*x = CreateExamRecordResponse{}
+func (x *UnshareExamResponse) Reset() {
+	*x = UnshareExamResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[22]
+		mi := &file_exam_service_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateExamRecordResponse) String() string {
+func (x *UnshareExamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateExamRecordResponse) ProtoMessage() {}
+func (*UnshareExamResponse) ProtoMessage() {}
 
-func (x *CreateExamRecordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[22]
+func (x *UnshareExamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1454,39 +1803,37 @@ func (x *CreateExamRecordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateExamRecordResponse.ProtoReflect.Descriptor instead.
-func (*CreateExamRecordResponse) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UnshareExamResponse.ProtoReflect.Descriptor instead.
+func (*UnshareExamResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{26}
 }
 
-type FindExamRecordsRequest struct {
+type FindExamSharesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageIndex int32  `protobuf:"varint,1,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
-	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	ExamId    string `protobuf:"bytes,3,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
-	UserId    string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExamId string `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *FindExamRecordsRequest) Reset() {
-	*x = FindExamRecordsRequest{}
+func (x *FindExamSharesRequest) Reset() {
+	*x = FindExamSharesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[23]
+		mi := &file_exam_service_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindExamRecordsRequest) String() string {
+func (x *FindExamSharesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindExamRecordsRequest) ProtoMessage() {}
+func (*FindExamSharesRequest) ProtoMessage() {}
 
-func (x *FindExamRecordsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[23]
+func (x *FindExamSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1497,66 +1844,50 @@ func (x *FindExamRecordsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindExamRecordsRequest.ProtoReflect.Descriptor instead.
-func (*FindExamRecordsRequest) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *FindExamRecordsRequest) GetPageIndex() int32 {
-	if x != nil {
-		return x.PageIndex
-	}
-	return 0
-}
-
-func (x *FindExamRecordsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use FindExamSharesRequest.ProtoReflect.Descriptor instead.
+func (*FindExamSharesRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *FindExamRecordsRequest) GetExamId() string {
+func (x *FindExamSharesRequest) GetExamId() string {
 	if x != nil {
 		return x.ExamId
 	}
 	return ""
 }
 
-func (x *FindExamRecordsRequest) GetUserId() string {
+func (x *FindExamSharesRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type FindExamRecordsResponse struct {
+type FindExamSharesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total       int32         `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	PageCount   int32         `protobuf:"varint,2,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
-	ExamRecords []*ExamRecord `protobuf:"bytes,3,rep,name=exam_records,json=examRecords,proto3" json:"exam_records,omitempty"`
+	Shares []*ExamShare `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
 }
 
-func (x *FindExamRecordsResponse) Reset() {
-	*x = FindExamRecordsResponse{}
+func (x *FindExamSharesResponse) Reset() {
+	*x = FindExamSharesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[24]
+		mi := &file_exam_service_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindExamRecordsResponse) String() string {
+func (x *FindExamSharesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindExamRecordsResponse) ProtoMessage() {}
+func (*FindExamSharesResponse) ProtoMessage() {}
 
-func (x *FindExamRecordsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[24]
+func (x *FindExamSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1567,63 +1898,50 @@ func (x *FindExamRecordsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindExamRecordsResponse.ProtoReflect.Descriptor instead.
-func (*FindExamRecordsResponse) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use FindExamSharesResponse.ProtoReflect.Descriptor instead.
+func (*FindExamSharesResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *FindExamRecordsResponse) GetTotal() int32 {
+func (x *FindExamSharesResponse) GetShares() []*ExamShare {
 	if x != nil {
-		return x.Total
+		return x.Shares
 	}
-	return 0
+	return nil
 }
 
-func (x *FindExamRecordsResponse) GetPageCount() int32 {
-	if x != nil {
-		return x.PageCount
-	}
-	return 0
-}
-
-func (x *FindExamRecordsResponse) GetExamRecords() []*ExamRecord {
-	if x != nil {
-		return x.ExamRecords
-	}
-	return nil
-}
-
-type AnswerWrong struct {
+// 匯入、匯出的格式：json、csv 或 gift（Moodle GIFT）
+type ImportExamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         string                 `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
-	ExamId     string                 `protobuf:"bytes,2,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
-	QuestionId string                 `protobuf:"bytes,3,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
-	Times      int32                  `protobuf:"varint,4,opt,name=times,proto3" json:"times,omitempty"`
-	UserId     string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 主題、說明和標籤有值時優先使用，否則使用 JSON 內容中的值
+	Topic       string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Tags        []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Format      string   `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	Content     []byte   `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	UserId      string   `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *AnswerWrong) Reset() {
-	*x = AnswerWrong{}
+func (x *ImportExamRequest) Reset() {
+	*x = ImportExamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[25]
+		mi := &file_exam_service_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AnswerWrong) String() string {
+func (x *ImportExamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnswerWrong) ProtoMessage() {}
+func (*ImportExamRequest) ProtoMessage() {}
 
-func (x *AnswerWrong) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[25]
+func (x *ImportExamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1634,87 +1952,136 @@ func (x *AnswerWrong) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnswerWrong.ProtoReflect.Descriptor instead.
-func (*AnswerWrong) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ImportExamRequest.ProtoReflect.Descriptor instead.
+func (*ImportExamRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *AnswerWrong) GetId() string {
+func (x *ImportExamRequest) GetTopic() string {
 	if x != nil {
-		return x.Id
+		return x.Topic
 	}
 	return ""
 }
 
-func (x *AnswerWrong) GetExamId() string {
+func (x *ImportExamRequest) GetDescription() string {
 	if x != nil {
-		return x.ExamId
+		return x.Description
 	}
 	return ""
 }
 
-func (x *AnswerWrong) GetQuestionId() string {
+func (x *ImportExamRequest) GetTags() []string {
 	if x != nil {
-		return x.QuestionId
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ImportExamRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
 	}
 	return ""
 }
 
-func (x *AnswerWrong) GetTimes() int32 {
+func (x *ImportExamRequest) GetContent() []byte {
 	if x != nil {
-		return x.Times
+		return x.Content
 	}
-	return 0
+	return nil
 }
 
-func (x *AnswerWrong) GetUserId() string {
+func (x *ImportExamRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *AnswerWrong) GetCreatedAt() *timestamppb.Timestamp {
+type ExamImportError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Line    int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ExamImportError) Reset() {
+	*x = ExamImportError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExamImportError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamImportError) ProtoMessage() {}
+
+func (x *ExamImportError) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamImportError.ProtoReflect.Descriptor instead.
+func (*ExamImportError) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ExamImportError) GetLine() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Line
 	}
-	return nil
+	return 0
 }
 
-func (x *AnswerWrong) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ExamImportError) GetMessage() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type FindExamRecordOverviewRequest struct {
+type ImportExamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExamId    string                 `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
-	UserId    string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	StartDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	ExamId        string `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	QuestionCount int32  `protobuf:"varint,2,opt,name=question_count,json=questionCount,proto3" json:"question_count,omitempty"`
+	// 有任何錯誤時不會匯入
+	Errors []*ExamImportError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *FindExamRecordOverviewRequest) Reset() {
-	*x = FindExamRecordOverviewRequest{}
+func (x *ImportExamResponse) Reset() {
+	*x = ImportExamResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[26]
+		mi := &file_exam_service_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindExamRecordOverviewRequest) String() string {
+func (x *ImportExamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindExamRecordOverviewRequest) ProtoMessage() {}
+func (*ImportExamResponse) ProtoMessage() {}
 
-func (x *FindExamRecordOverviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[26]
+func (x *ImportExamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1725,61 +2092,59 @@ func (x *FindExamRecordOverviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindExamRecordOverviewRequest.ProtoReflect.Descriptor instead.
-func (*FindExamRecordOverviewRequest) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ImportExamResponse.ProtoReflect.Descriptor instead.
+func (*ImportExamResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *FindExamRecordOverviewRequest) GetExamId() string {
+func (x *ImportExamResponse) GetExamId() string {
 	if x != nil {
 		return x.ExamId
 	}
 	return ""
 }
 
-func (x *FindExamRecordOverviewRequest) GetUserId() string {
+func (x *ImportExamResponse) GetQuestionCount() int32 {
 	if x != nil {
-		return x.UserId
+		return x.QuestionCount
 	}
-	return ""
+	return 0
 }
 
-func (x *FindExamRecordOverviewRequest) GetStartDate() *timestamppb.Timestamp {
+func (x *ImportExamResponse) GetErrors() []*ExamImportError {
 	if x != nil {
-		return x.StartDate
+		return x.Errors
 	}
 	return nil
 }
 
-type FindExamRecordOverviewResponse struct {
+type ExportExamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StartDate    string         `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	Exam         *Exam          `protobuf:"bytes,2,opt,name=exam,proto3" json:"exam,omitempty"`
-	Questions    []*Question    `protobuf:"bytes,3,rep,name=questions,proto3" json:"questions,omitempty"`
-	AnswerWrongs []*AnswerWrong `protobuf:"bytes,4,rep,name=answer_wrongs,json=answerWrongs,proto3" json:"answer_wrongs,omitempty"`
-	ExamRecords  []*ExamRecord  `protobuf:"bytes,5,rep,name=exam_records,json=examRecords,proto3" json:"exam_records,omitempty"`
+	ExamId string `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
 }
 
-func (x *FindExamRecordOverviewResponse) Reset() {
-	*x = FindExamRecordOverviewResponse{}
+func (x *ExportExamRequest) Reset() {
+	*x = ExportExamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[27]
+		mi := &file_exam_service_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindExamRecordOverviewResponse) String() string {
+func (x *ExportExamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindExamRecordOverviewResponse) ProtoMessage() {}
+func (*ExportExamRequest) ProtoMessage() {}
 
-func (x *FindExamRecordOverviewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[27]
+func (x *ExportExamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1790,76 +2155,106 @@ func (x *FindExamRecordOverviewResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindExamRecordOverviewResponse.ProtoReflect.Descriptor instead.
-func (*FindExamRecordOverviewResponse) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ExportExamRequest.ProtoReflect.Descriptor instead.
+func (*ExportExamRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *FindExamRecordOverviewResponse) GetStartDate() string {
+func (x *ExportExamRequest) GetExamId() string {
 	if x != nil {
-		return x.StartDate
+		return x.ExamId
 	}
 	return ""
 }
 
-func (x *FindExamRecordOverviewResponse) GetExam() *Exam {
+func (x *ExportExamRequest) GetUserId() string {
 	if x != nil {
-		return x.Exam
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *FindExamRecordOverviewResponse) GetQuestions() []*Question {
+func (x *ExportExamRequest) GetFormat() string {
 	if x != nil {
-		return x.Questions
+		return x.Format
 	}
-	return nil
+	return ""
 }
 
-func (x *FindExamRecordOverviewResponse) GetAnswerWrongs() []*AnswerWrong {
-	if x != nil {
-		return x.AnswerWrongs
+type ExportExamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *ExportExamResponse) Reset() {
+	*x = ExportExamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *FindExamRecordOverviewResponse) GetExamRecords() []*ExamRecord {
+func (x *ExportExamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportExamResponse) ProtoMessage() {}
+
+func (x *ExportExamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportExamResponse.ProtoReflect.Descriptor instead.
+func (*ExportExamResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ExportExamResponse) GetChunk() []byte {
 	if x != nil {
-		return x.ExamRecords
+		return x.Chunk
 	}
 	return nil
 }
 
-type ExamInfo struct {
+type FindExamTagCountsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExamId        string `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
-	Topic         string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
-	Description   string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	IsPublic      bool   `protobuf:"varint,4,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
-	QuestionCount int32  `protobuf:"varint,5,opt,name=question_count,json=questionCount,proto3" json:"question_count,omitempty"`
-	RecordCount   int32  `protobuf:"varint,6,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+	// 有值時除了公開的測驗，也統計該使用者自己的測驗
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Size   int32  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (x *ExamInfo) Reset() {
-	*x = ExamInfo{}
+func (x *FindExamTagCountsRequest) Reset() {
+	*x = FindExamTagCountsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[28]
+		mi := &file_exam_service_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExamInfo) String() string {
+func (x *FindExamTagCountsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExamInfo) ProtoMessage() {}
+func (*FindExamTagCountsRequest) ProtoMessage() {}
 
-func (x *ExamInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[28]
+func (x *FindExamTagCountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1870,570 +2265,9888 @@ func (x *ExamInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExamInfo.ProtoReflect.Descriptor instead.
-func (*ExamInfo) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use FindExamTagCountsRequest.ProtoReflect.Descriptor instead.
+func (*FindExamTagCountsRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ExamInfo) GetExamId() string {
+func (x *FindExamTagCountsRequest) GetUserId() string {
 	if x != nil {
-		return x.ExamId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *ExamInfo) GetTopic() string {
+func (x *FindExamTagCountsRequest) GetSize() int32 {
 	if x != nil {
-		return x.Topic
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *ExamInfo) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+type FindExamTagCountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TagCounts []*TagCount `protobuf:"bytes,1,rep,name=tag_counts,json=tagCounts,proto3" json:"tag_counts,omitempty"`
 }
 
-func (x *ExamInfo) GetIsPublic() bool {
-	if x != nil {
-		return x.IsPublic
+func (x *FindExamTagCountsResponse) Reset() {
+	*x = FindExamTagCountsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *ExamInfo) GetQuestionCount() int32 {
-	if x != nil {
-		return x.QuestionCount
+func (x *FindExamTagCountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindExamTagCountsResponse) ProtoMessage() {}
+
+func (x *FindExamTagCountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ExamInfo) GetRecordCount() int32 {
+// Deprecated: Use FindExamTagCountsResponse.ProtoReflect.Descriptor instead.
+func (*FindExamTagCountsResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *FindExamTagCountsResponse) GetTagCounts() []*TagCount {
 	if x != nil {
-		return x.RecordCount
+		return x.TagCounts
 	}
-	return 0
+	return nil
 }
 
-type FindExamInfosRequest struct {
+type Question struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	IsPublic bool   `protobuf:"varint,2,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	ExamId    string                 `protobuf:"bytes,2,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	Ask       string                 `protobuf:"bytes,3,opt,name=ask,proto3" json:"ask,omitempty"`
+	Answers   []string               `protobuf:"bytes,4,rep,name=answers,proto3" json:"answers,omitempty"`
+	UserId    string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 由單字解釋產生的題目，記錄來源 WordService 的 WordMeaning id
+	WordMeaningId string `protobuf:"bytes,8,opt,name=word_meaning_id,json=wordMeaningId,proto3" json:"word_meaning_id,omitempty"`
+	// 題目的配分，0 表示預設的 1 分
+	Points int32 `protobuf:"varint,9,opt,name=points,proto3" json:"points,omitempty"`
+	// 是否可以部分給分，例如有多個空格或需要回答多個答案的題目
+	PartialCredit bool `protobuf:"varint,10,opt,name=partial_credit,json=partialCredit,proto3" json:"partial_credit,omitempty"`
+	// 作答時可以查看的提示
+	Hint string `protobuf:"bytes,11,opt,name=hint,proto3" json:"hint,omitempty"`
+	// 交卷後顯示的解說，作答時不回傳
+	Explanation string `protobuf:"bytes,12,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	// 題目所屬的題組，空字串表示獨立的題目
+	SectionId string `protobuf:"bytes,13,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	// 每次修改後加 1，用來檢查修改時是否已被其他人修改
+	Version int32 `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
+	// 作答時顯示的選項，沒有選項的題目為空陣列
+	Choices []string `protobuf:"bytes,15,rep,name=choices,proto3" json:"choices,omitempty"`
+	// 答案的數量，作答時不回傳答案，用來顯示空格的數量
+	AnswerCount int32 `protobuf:"varint,16,opt,name=answer_count,json=answerCount,proto3" json:"answer_count,omitempty"`
+	// 每個填空可以接受的其他答案，依照 answers 的順序，作答時不回傳
+	AcceptedAnswers []*AcceptedAnswers `protobuf:"bytes,17,rep,name=accepted_answers,json=acceptedAnswers,proto3" json:"accepted_answers,omitempty"`
+	// 題目是否有提示，作答時不回傳提示，要另外查看
+	HasHint bool `protobuf:"varint,18,opt,name=has_hint,json=hasHint,proto3" json:"has_hint,omitempty"`
 }
 
-func (x *FindExamInfosRequest) Reset() {
-	*x = FindExamInfosRequest{}
+func (x *Question) Reset() {
+	*x = Question{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[29]
+		mi := &file_exam_service_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindExamInfosRequest) String() string {
+func (x *Question) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindExamInfosRequest) ProtoMessage() {}
+func (*Question) ProtoMessage() {}
 
-func (x *FindExamInfosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[29]
+func (x *Question) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use FindExamInfosRequest.ProtoReflect.Descriptor instead.
-func (*FindExamInfosRequest) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *FindExamInfosRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *FindExamInfosRequest) GetIsPublic() bool {
-	if x != nil {
-		return x.IsPublic
-	}
-	return false
-}
-
-type FindExamInfosResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ExamInfos []*ExamInfo `protobuf:"bytes,1,rep,name=exam_infos,json=examInfos,proto3" json:"exam_infos,omitempty"`
-}
-
-func (x *FindExamInfosResponse) Reset() {
-	*x = FindExamInfosResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_exam_service_proto_msgTypes[30]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *FindExamInfosResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*FindExamInfosResponse) ProtoMessage() {}
-
-func (x *FindExamInfosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_exam_service_proto_msgTypes[30]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Question.ProtoReflect.Descriptor instead.
+func (*Question) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Question) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Question) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *Question) GetAsk() string {
+	if x != nil {
+		return x.Ask
+	}
+	return ""
+}
+
+func (x *Question) GetAnswers() []string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *Question) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Question) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Question) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Question) GetWordMeaningId() string {
+	if x != nil {
+		return x.WordMeaningId
+	}
+	return ""
+}
+
+func (x *Question) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *Question) GetPartialCredit() bool {
+	if x != nil {
+		return x.PartialCredit
+	}
+	return false
+}
+
+func (x *Question) GetHint() string {
+	if x != nil {
+		return x.Hint
+	}
+	return ""
+}
+
+func (x *Question) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *Question) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *Question) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Question) GetChoices() []string {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *Question) GetAnswerCount() int32 {
+	if x != nil {
+		return x.AnswerCount
+	}
+	return 0
+}
+
+func (x *Question) GetAcceptedAnswers() []*AcceptedAnswers {
+	if x != nil {
+		return x.AcceptedAnswers
+	}
+	return nil
+}
+
+func (x *Question) GetHasHint() bool {
+	if x != nil {
+		return x.HasHint
+	}
+	return false
+}
+
+// 一個填空可以接受的其他答案
+type AcceptedAnswers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Answers []string `protobuf:"bytes,1,rep,name=answers,proto3" json:"answers,omitempty"`
+}
+
+func (x *AcceptedAnswers) Reset() {
+	*x = AcceptedAnswers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptedAnswers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptedAnswers) ProtoMessage() {}
+
+func (x *AcceptedAnswers) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptedAnswers.ProtoReflect.Descriptor instead.
+func (*AcceptedAnswers) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AcceptedAnswers) GetAnswers() []string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type CreateQuestionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExamId          string             `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	Ask             string             `protobuf:"bytes,2,opt,name=ask,proto3" json:"ask,omitempty"`
+	Answers         []string           `protobuf:"bytes,3,rep,name=answers,proto3" json:"answers,omitempty"`
+	UserId          string             `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Points          int32              `protobuf:"varint,5,opt,name=points,proto3" json:"points,omitempty"`
+	PartialCredit   bool               `protobuf:"varint,6,opt,name=partial_credit,json=partialCredit,proto3" json:"partial_credit,omitempty"`
+	Hint            string             `protobuf:"bytes,7,opt,name=hint,proto3" json:"hint,omitempty"`
+	Explanation     string             `protobuf:"bytes,8,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	SectionId       string             `protobuf:"bytes,9,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	AcceptedAnswers []*AcceptedAnswers `protobuf:"bytes,10,rep,name=accepted_answers,json=acceptedAnswers,proto3" json:"accepted_answers,omitempty"`
+}
+
+func (x *CreateQuestionRequest) Reset() {
+	*x = CreateQuestionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuestionRequest) ProtoMessage() {}
+
+func (x *CreateQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuestionRequest.ProtoReflect.Descriptor instead.
+func (*CreateQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateQuestionRequest) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *CreateQuestionRequest) GetAsk() string {
+	if x != nil {
+		return x.Ask
+	}
+	return ""
+}
+
+func (x *CreateQuestionRequest) GetAnswers() []string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *CreateQuestionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateQuestionRequest) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *CreateQuestionRequest) GetPartialCredit() bool {
+	if x != nil {
+		return x.PartialCredit
+	}
+	return false
+}
+
+func (x *CreateQuestionRequest) GetHint() string {
+	if x != nil {
+		return x.Hint
+	}
+	return ""
+}
+
+func (x *CreateQuestionRequest) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *CreateQuestionRequest) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *CreateQuestionRequest) GetAcceptedAnswers() []*AcceptedAnswers {
+	if x != nil {
+		return x.AcceptedAnswers
+	}
+	return nil
+}
+
+type CreateQuestionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionId string `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+}
+
+func (x *CreateQuestionResponse) Reset() {
+	*x = CreateQuestionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuestionResponse) ProtoMessage() {}
+
+func (x *CreateQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuestionResponse.ProtoReflect.Descriptor instead.
+func (*CreateQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateQuestionResponse) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+type UpdateQuestionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionId    string   `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Ask           string   `protobuf:"bytes,2,opt,name=ask,proto3" json:"ask,omitempty"`
+	Answers       []string `protobuf:"bytes,3,rep,name=answers,proto3" json:"answers,omitempty"`
+	UserId        string   `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Points        int32    `protobuf:"varint,5,opt,name=points,proto3" json:"points,omitempty"`
+	PartialCredit bool     `protobuf:"varint,6,opt,name=partial_credit,json=partialCredit,proto3" json:"partial_credit,omitempty"`
+	Hint          string   `protobuf:"bytes,7,opt,name=hint,proto3" json:"hint,omitempty"`
+	Explanation   string   `protobuf:"bytes,8,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	SectionId     string   `protobuf:"bytes,9,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	// 讀取時的版本號，與目前的版本號不同時回傳 Aborted，沒有傳入時不檢查，
+	// 0 表示還沒有版本號的舊資料
+	ExpectedVersion *int32             `protobuf:"varint,10,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	AcceptedAnswers []*AcceptedAnswers `protobuf:"bytes,11,rep,name=accepted_answers,json=acceptedAnswers,proto3" json:"accepted_answers,omitempty"`
+}
+
+func (x *UpdateQuestionRequest) Reset() {
+	*x = UpdateQuestionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQuestionRequest) ProtoMessage() {}
+
+func (x *UpdateQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQuestionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateQuestionRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *UpdateQuestionRequest) GetAsk() string {
+	if x != nil {
+		return x.Ask
+	}
+	return ""
+}
+
+func (x *UpdateQuestionRequest) GetAnswers() []string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *UpdateQuestionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateQuestionRequest) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *UpdateQuestionRequest) GetPartialCredit() bool {
+	if x != nil {
+		return x.PartialCredit
+	}
+	return false
+}
+
+func (x *UpdateQuestionRequest) GetHint() string {
+	if x != nil {
+		return x.Hint
+	}
+	return ""
+}
+
+func (x *UpdateQuestionRequest) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *UpdateQuestionRequest) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *UpdateQuestionRequest) GetExpectedVersion() int32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateQuestionRequest) GetAcceptedAnswers() []*AcceptedAnswers {
+	if x != nil {
+		return x.AcceptedAnswers
+	}
+	return nil
+}
+
+type UpdateQuestionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionId string `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	// 修改後的版本號
+	Version int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *UpdateQuestionResponse) Reset() {
+	*x = UpdateQuestionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQuestionResponse) ProtoMessage() {}
+
+func (x *UpdateQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQuestionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateQuestionResponse) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *UpdateQuestionResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// 擁有者和共同編輯者可以查詢，用來取得修改前的版本號
+type GetQuestionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionId string `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	UserId     string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetQuestionRequest) Reset() {
+	*x = GetQuestionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionRequest) ProtoMessage() {}
+
+func (x *GetQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionRequest.ProtoReflect.Descriptor instead.
+func (*GetQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetQuestionRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *GetQuestionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetQuestionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Question *Question `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+}
+
+func (x *GetQuestionResponse) Reset() {
+	*x = GetQuestionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionResponse) ProtoMessage() {}
+
+func (x *GetQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionResponse.ProtoReflect.Descriptor instead.
+func (*GetQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetQuestionResponse) GetQuestion() *Question {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+type FindQuestionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageIndex int32  `protobuf:"varint,1,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	ExamId    string `protobuf:"bytes,3,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	UserId    string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 有值時改用 cursor 分頁，忽略 page_index 且不回傳 total、page_count
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *FindQuestionsRequest) Reset() {
+	*x = FindQuestionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindQuestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindQuestionsRequest) ProtoMessage() {}
+
+func (x *FindQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*FindQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *FindQuestionsRequest) GetPageIndex() int32 {
+	if x != nil {
+		return x.PageIndex
+	}
+	return 0
+}
+
+func (x *FindQuestionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *FindQuestionsRequest) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *FindQuestionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FindQuestionsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type FindQuestionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total     int32       `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	PageCount int32       `protobuf:"varint,2,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	Questions []*Question `protobuf:"bytes,3,rep,name=questions,proto3" json:"questions,omitempty"`
+	// 下一頁的 cursor，沒有下一頁時為空字串
+	NextCursor string `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *FindQuestionsResponse) Reset() {
+	*x = FindQuestionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindQuestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindQuestionsResponse) ProtoMessage() {}
+
+func (x *FindQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*FindQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *FindQuestionsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *FindQuestionsResponse) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+func (x *FindQuestionsResponse) GetQuestions() []*Question {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *FindQuestionsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type DeleteQuestionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionId string `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	UserId     string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *DeleteQuestionRequest) Reset() {
+	*x = DeleteQuestionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuestionRequest) ProtoMessage() {}
+
+func (x *DeleteQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuestionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeleteQuestionRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *DeleteQuestionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteQuestionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteQuestionResponse) Reset() {
+	*x = DeleteQuestionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuestionResponse) ProtoMessage() {}
+
+func (x *DeleteQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuestionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{47}
+}
+
+type QuestionOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type          string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	QuestionId    string   `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Ask           string   `protobuf:"bytes,3,opt,name=ask,proto3" json:"ask,omitempty"`
+	Answers       []string `protobuf:"bytes,4,rep,name=answers,proto3" json:"answers,omitempty"`
+	Points        int32    `protobuf:"varint,5,opt,name=points,proto3" json:"points,omitempty"`
+	PartialCredit bool     `protobuf:"varint,6,opt,name=partial_credit,json=partialCredit,proto3" json:"partial_credit,omitempty"`
+	Hint          string   `protobuf:"bytes,7,opt,name=hint,proto3" json:"hint,omitempty"`
+	Explanation   string   `protobuf:"bytes,8,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	SectionId     string   `protobuf:"bytes,9,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+}
+
+func (x *QuestionOperation) Reset() {
+	*x = QuestionOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuestionOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionOperation) ProtoMessage() {}
+
+func (x *QuestionOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionOperation.ProtoReflect.Descriptor instead.
+func (*QuestionOperation) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *QuestionOperation) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *QuestionOperation) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *QuestionOperation) GetAsk() string {
+	if x != nil {
+		return x.Ask
+	}
+	return ""
+}
+
+func (x *QuestionOperation) GetAnswers() []string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *QuestionOperation) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *QuestionOperation) GetPartialCredit() bool {
+	if x != nil {
+		return x.PartialCredit
+	}
+	return false
+}
+
+func (x *QuestionOperation) GetHint() string {
+	if x != nil {
+		return x.Hint
+	}
+	return ""
+}
+
+func (x *QuestionOperation) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *QuestionOperation) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+type QuestionOperationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	QuestionId string `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Error      string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *QuestionOperationResult) Reset() {
+	*x = QuestionOperationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuestionOperationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionOperationResult) ProtoMessage() {}
+
+func (x *QuestionOperationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionOperationResult.ProtoReflect.Descriptor instead.
+func (*QuestionOperationResult) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *QuestionOperationResult) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *QuestionOperationResult) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *QuestionOperationResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BatchMutateQuestionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExamId     string               `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	Operations []*QuestionOperation `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+	UserId     string               `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *BatchMutateQuestionsRequest) Reset() {
+	*x = BatchMutateQuestionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchMutateQuestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMutateQuestionsRequest) ProtoMessage() {}
+
+func (x *BatchMutateQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMutateQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*BatchMutateQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BatchMutateQuestionsRequest) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *BatchMutateQuestionsRequest) GetOperations() []*QuestionOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *BatchMutateQuestionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type BatchMutateQuestionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Applied bool                       `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"`
+	Results []*QuestionOperationResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchMutateQuestionsResponse) Reset() {
+	*x = BatchMutateQuestionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchMutateQuestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMutateQuestionsResponse) ProtoMessage() {}
+
+func (x *BatchMutateQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMutateQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*BatchMutateQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *BatchMutateQuestionsResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *BatchMutateQuestionsResponse) GetResults() []*QuestionOperationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// 題組，閱讀題組有一篇文章，聽力題組有一段音檔，後面接著多個題目
+type ExamSection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	ExamId string `protobuf:"bytes,2,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	// reading 或 listening
+	Type    string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Title   string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Passage string `protobuf:"bytes,5,opt,name=passage,proto3" json:"passage,omitempty"`
+	// 音檔的網址，例如 WordService 例句的 audio_url
+	AudioUrl string `protobuf:"bytes,6,opt,name=audio_url,json=audioUrl,proto3" json:"audio_url,omitempty"`
+	// 題組的排列順序，由小到大
+	Order     int32                  `protobuf:"varint,7,opt,name=order,proto3" json:"order,omitempty"`
+	UserId    string                 `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *ExamSection) Reset() {
+	*x = ExamSection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExamSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamSection) ProtoMessage() {}
+
+func (x *ExamSection) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamSection.ProtoReflect.Descriptor instead.
+func (*ExamSection) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ExamSection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExamSection) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *ExamSection) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ExamSection) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ExamSection) GetPassage() string {
+	if x != nil {
+		return x.Passage
+	}
+	return ""
+}
+
+func (x *ExamSection) GetAudioUrl() string {
+	if x != nil {
+		return x.AudioUrl
+	}
+	return ""
+}
+
+func (x *ExamSection) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *ExamSection) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExamSection) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ExamSection) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateExamSectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExamId   string `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	Type     string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Title    string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Passage  string `protobuf:"bytes,4,opt,name=passage,proto3" json:"passage,omitempty"`
+	AudioUrl string `protobuf:"bytes,5,opt,name=audio_url,json=audioUrl,proto3" json:"audio_url,omitempty"`
+	Order    int32  `protobuf:"varint,6,opt,name=order,proto3" json:"order,omitempty"`
+	UserId   string `protobuf:"bytes,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *CreateExamSectionRequest) Reset() {
+	*x = CreateExamSectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateExamSectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExamSectionRequest) ProtoMessage() {}
+
+func (x *CreateExamSectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExamSectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateExamSectionRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreateExamSectionRequest) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *CreateExamSectionRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateExamSectionRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateExamSectionRequest) GetPassage() string {
+	if x != nil {
+		return x.Passage
+	}
+	return ""
+}
+
+func (x *CreateExamSectionRequest) GetAudioUrl() string {
+	if x != nil {
+		return x.AudioUrl
+	}
+	return ""
+}
+
+func (x *CreateExamSectionRequest) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *CreateExamSectionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CreateExamSectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SectionId string `protobuf:"bytes,1,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+}
+
+func (x *CreateExamSectionResponse) Reset() {
+	*x = CreateExamSectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateExamSectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExamSectionResponse) ProtoMessage() {}
+
+func (x *CreateExamSectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExamSectionResponse.ProtoReflect.Descriptor instead.
+func (*CreateExamSectionResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateExamSectionResponse) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+type UpdateExamSectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SectionId string `protobuf:"bytes,1,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Title     string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Passage   string `protobuf:"bytes,4,opt,name=passage,proto3" json:"passage,omitempty"`
+	AudioUrl  string `protobuf:"bytes,5,opt,name=audio_url,json=audioUrl,proto3" json:"audio_url,omitempty"`
+	Order     int32  `protobuf:"varint,6,opt,name=order,proto3" json:"order,omitempty"`
+	UserId    string `protobuf:"bytes,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *UpdateExamSectionRequest) Reset() {
+	*x = UpdateExamSectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateExamSectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExamSectionRequest) ProtoMessage() {}
+
+func (x *UpdateExamSectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExamSectionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExamSectionRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UpdateExamSectionRequest) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *UpdateExamSectionRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UpdateExamSectionRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateExamSectionRequest) GetPassage() string {
+	if x != nil {
+		return x.Passage
+	}
+	return ""
+}
+
+func (x *UpdateExamSectionRequest) GetAudioUrl() string {
+	if x != nil {
+		return x.AudioUrl
+	}
+	return ""
+}
+
+func (x *UpdateExamSectionRequest) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *UpdateExamSectionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UpdateExamSectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SectionId string `protobuf:"bytes,1,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+}
+
+func (x *UpdateExamSectionResponse) Reset() {
+	*x = UpdateExamSectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateExamSectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExamSectionResponse) ProtoMessage() {}
+
+func (x *UpdateExamSectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExamSectionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateExamSectionResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UpdateExamSectionResponse) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+// 刪除題組時，題組的題目改為獨立的題目，不會刪除題目
+type DeleteExamSectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SectionId string `protobuf:"bytes,1,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	UserId    string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *DeleteExamSectionRequest) Reset() {
+	*x = DeleteExamSectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteExamSectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExamSectionRequest) ProtoMessage() {}
+
+func (x *DeleteExamSectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExamSectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExamSectionRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DeleteExamSectionRequest) GetSectionId() string {
+	if x != nil {
+		return x.SectionId
+	}
+	return ""
+}
+
+func (x *DeleteExamSectionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteExamSectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteExamSectionResponse) Reset() {
+	*x = DeleteExamSectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteExamSectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExamSectionResponse) ProtoMessage() {}
+
+func (x *DeleteExamSectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExamSectionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteExamSectionResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{58}
+}
+
+type FindExamSectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExamId string `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *FindExamSectionsRequest) Reset() {
+	*x = FindExamSectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindExamSectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindExamSectionsRequest) ProtoMessage() {}
+
+func (x *FindExamSectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindExamSectionsRequest.ProtoReflect.Descriptor instead.
+func (*FindExamSectionsRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *FindExamSectionsRequest) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *FindExamSectionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type FindExamSectionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sections []*ExamSection `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"`
+}
+
+func (x *FindExamSectionsResponse) Reset() {
+	*x = FindExamSectionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindExamSectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindExamSectionsResponse) ProtoMessage() {}
+
+func (x *FindExamSectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindExamSectionsResponse.ProtoReflect.Descriptor instead.
+func (*FindExamSectionsResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *FindExamSectionsResponse) GetSections() []*ExamSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+// 查看作答中題目的提示，伺服器記錄查看的題目，交卷時依照測驗設定扣分
+type RevealQuestionHintRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExamId        string `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	ExamAttemptId string `protobuf:"bytes,2,opt,name=exam_attempt_id,json=examAttemptId,proto3" json:"exam_attempt_id,omitempty"`
+	QuestionId    string `protobuf:"bytes,3,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	UserId        string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *RevealQuestionHintRequest) Reset() {
+	*x = RevealQuestionHintRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevealQuestionHintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealQuestionHintRequest) ProtoMessage() {}
+
+func (x *RevealQuestionHintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevealQuestionHintRequest.ProtoReflect.Descriptor instead.
+func (*RevealQuestionHintRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RevealQuestionHintRequest) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *RevealQuestionHintRequest) GetExamAttemptId() string {
+	if x != nil {
+		return x.ExamAttemptId
+	}
+	return ""
+}
+
+func (x *RevealQuestionHintRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *RevealQuestionHintRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RevealQuestionHintResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hint string `protobuf:"bytes,1,opt,name=hint,proto3" json:"hint,omitempty"`
+}
+
+func (x *RevealQuestionHintResponse) Reset() {
+	*x = RevealQuestionHintResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevealQuestionHintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealQuestionHintResponse) ProtoMessage() {}
+
+func (x *RevealQuestionHintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevealQuestionHintResponse.ProtoReflect.Descriptor instead.
+func (*RevealQuestionHintResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RevealQuestionHintResponse) GetHint() string {
+	if x != nil {
+		return x.Hint
+	}
+	return ""
+}
+
+type FindRandomQuestionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExamId string `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Size   int32  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *FindRandomQuestionsRequest) Reset() {
+	*x = FindRandomQuestionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindRandomQuestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindRandomQuestionsRequest) ProtoMessage() {}
+
+func (x *FindRandomQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindRandomQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*FindRandomQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *FindRandomQuestionsRequest) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *FindRandomQuestionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FindRandomQuestionsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// 同一個題組的題目會排在一起，sections 是這次出現的題目所屬的題組
+type FindRandomQuestionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exam      *Exam          `protobuf:"bytes,1,opt,name=exam,proto3" json:"exam,omitempty"`
+	Questions []*Question    `protobuf:"bytes,2,rep,name=questions,proto3" json:"questions,omitempty"`
+	Sections  []*ExamSection `protobuf:"bytes,3,rep,name=sections,proto3" json:"sections,omitempty"`
+	// 這次作答的 id，交卷時傳入，只能作答這次出的題目
+	ExamAttemptId string `protobuf:"bytes,4,opt,name=exam_attempt_id,json=examAttemptId,proto3" json:"exam_attempt_id,omitempty"`
+}
+
+func (x *FindRandomQuestionsResponse) Reset() {
+	*x = FindRandomQuestionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindRandomQuestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindRandomQuestionsResponse) ProtoMessage() {}
+
+func (x *FindRandomQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindRandomQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*FindRandomQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *FindRandomQuestionsResponse) GetExam() *Exam {
+	if x != nil {
+		return x.Exam
+	}
+	return nil
+}
+
+func (x *FindRandomQuestionsResponse) GetQuestions() []*Question {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *FindRandomQuestionsResponse) GetSections() []*ExamSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *FindRandomQuestionsResponse) GetExamAttemptId() string {
+	if x != nil {
+		return x.ExamAttemptId
+	}
+	return ""
+}
+
+// 使用者題庫中的題目，可以被多個測驗引用
+type BankQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                 `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	Ask           string                 `protobuf:"bytes,2,opt,name=ask,proto3" json:"ask,omitempty"`
+	Answers       []string               `protobuf:"bytes,3,rep,name=answers,proto3" json:"answers,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Points        int32                  `protobuf:"varint,5,opt,name=points,proto3" json:"points,omitempty"`
+	PartialCredit bool                   `protobuf:"varint,6,opt,name=partial_credit,json=partialCredit,proto3" json:"partial_credit,omitempty"`
+	Hint          string                 `protobuf:"bytes,7,opt,name=hint,proto3" json:"hint,omitempty"`
+	Explanation   string                 `protobuf:"bytes,8,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	UserId        string                 `protobuf:"bytes,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *BankQuestion) Reset() {
+	*x = BankQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BankQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BankQuestion) ProtoMessage() {}
+
+func (x *BankQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BankQuestion.ProtoReflect.Descriptor instead.
+func (*BankQuestion) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *BankQuestion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BankQuestion) GetAsk() string {
+	if x != nil {
+		return x.Ask
+	}
+	return ""
+}
+
+func (x *BankQuestion) GetAnswers() []string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *BankQuestion) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *BankQuestion) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *BankQuestion) GetPartialCredit() bool {
+	if x != nil {
+		return x.PartialCredit
+	}
+	return false
+}
+
+func (x *BankQuestion) GetHint() string {
+	if x != nil {
+		return x.Hint
+	}
+	return ""
+}
+
+func (x *BankQuestion) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *BankQuestion) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BankQuestion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *BankQuestion) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// 測驗引用的題庫題目，question_ids 每次作答都會出現，pools 每次作答隨機抽題
+type ExamBankSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionIds []string        `protobuf:"bytes,1,rep,name=question_ids,json=questionIds,proto3" json:"question_ids,omitempty"`
+	Pools       []*ExamBankPool `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools,omitempty"`
+}
+
+func (x *ExamBankSource) Reset() {
+	*x = ExamBankSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExamBankSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamBankSource) ProtoMessage() {}
+
+func (x *ExamBankSource) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamBankSource.ProtoReflect.Descriptor instead.
+func (*ExamBankSource) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ExamBankSource) GetQuestionIds() []string {
+	if x != nil {
+		return x.QuestionIds
+	}
+	return nil
+}
+
+func (x *ExamBankSource) GetPools() []*ExamBankPool {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+// 從題庫中含有 tag 標籤的題目隨機抽出 size 題
+type ExamBankPool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag  string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Size int32  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ExamBankPool) Reset() {
+	*x = ExamBankPool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExamBankPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamBankPool) ProtoMessage() {}
+
+func (x *ExamBankPool) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamBankPool.ProtoReflect.Descriptor instead.
+func (*ExamBankPool) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ExamBankPool) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ExamBankPool) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type CreateBankQuestionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ask           string   `protobuf:"bytes,1,opt,name=ask,proto3" json:"ask,omitempty"`
+	Answers       []string `protobuf:"bytes,2,rep,name=answers,proto3" json:"answers,omitempty"`
+	Tags          []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Points        int32    `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	PartialCredit bool     `protobuf:"varint,5,opt,name=partial_credit,json=partialCredit,proto3" json:"partial_credit,omitempty"`
+	Hint          string   `protobuf:"bytes,6,opt,name=hint,proto3" json:"hint,omitempty"`
+	Explanation   string   `protobuf:"bytes,7,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	UserId        string   `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *CreateBankQuestionRequest) Reset() {
+	*x = CreateBankQuestionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBankQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBankQuestionRequest) ProtoMessage() {}
+
+func (x *CreateBankQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBankQuestionRequest.ProtoReflect.Descriptor instead.
+func (*CreateBankQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateBankQuestionRequest) GetAsk() string {
+	if x != nil {
+		return x.Ask
+	}
+	return ""
+}
+
+func (x *CreateBankQuestionRequest) GetAnswers() []string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *CreateBankQuestionRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateBankQuestionRequest) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *CreateBankQuestionRequest) GetPartialCredit() bool {
+	if x != nil {
+		return x.PartialCredit
+	}
+	return false
+}
+
+func (x *CreateBankQuestionRequest) GetHint() string {
+	if x != nil {
+		return x.Hint
+	}
+	return ""
+}
+
+func (x *CreateBankQuestionRequest) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *CreateBankQuestionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CreateBankQuestionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BankQuestionId string `protobuf:"bytes,1,opt,name=bank_question_id,json=bankQuestionId,proto3" json:"bank_question_id,omitempty"`
+}
+
+func (x *CreateBankQuestionResponse) Reset() {
+	*x = CreateBankQuestionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBankQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBankQuestionResponse) ProtoMessage() {}
+
+func (x *CreateBankQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBankQuestionResponse.ProtoReflect.Descriptor instead.
+func (*CreateBankQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CreateBankQuestionResponse) GetBankQuestionId() string {
+	if x != nil {
+		return x.BankQuestionId
+	}
+	return ""
+}
+
+type UpdateBankQuestionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BankQuestionId string   `protobuf:"bytes,1,opt,name=bank_question_id,json=bankQuestionId,proto3" json:"bank_question_id,omitempty"`
+	Ask            string   `protobuf:"bytes,2,opt,name=ask,proto3" json:"ask,omitempty"`
+	Answers        []string `protobuf:"bytes,3,rep,name=answers,proto3" json:"answers,omitempty"`
+	Tags           []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Points         int32    `protobuf:"varint,5,opt,name=points,proto3" json:"points,omitempty"`
+	PartialCredit  bool     `protobuf:"varint,6,opt,name=partial_credit,json=partialCredit,proto3" json:"partial_credit,omitempty"`
+	Hint           string   `protobuf:"bytes,7,opt,name=hint,proto3" json:"hint,omitempty"`
+	Explanation    string   `protobuf:"bytes,8,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	UserId         string   `protobuf:"bytes,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *UpdateBankQuestionRequest) Reset() {
+	*x = UpdateBankQuestionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBankQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBankQuestionRequest) ProtoMessage() {}
+
+func (x *UpdateBankQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBankQuestionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBankQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UpdateBankQuestionRequest) GetBankQuestionId() string {
+	if x != nil {
+		return x.BankQuestionId
+	}
+	return ""
+}
+
+func (x *UpdateBankQuestionRequest) GetAsk() string {
+	if x != nil {
+		return x.Ask
+	}
+	return ""
+}
+
+func (x *UpdateBankQuestionRequest) GetAnswers() []string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *UpdateBankQuestionRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateBankQuestionRequest) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *UpdateBankQuestionRequest) GetPartialCredit() bool {
+	if x != nil {
+		return x.PartialCredit
+	}
+	return false
+}
+
+func (x *UpdateBankQuestionRequest) GetHint() string {
+	if x != nil {
+		return x.Hint
+	}
+	return ""
+}
+
+func (x *UpdateBankQuestionRequest) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *UpdateBankQuestionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UpdateBankQuestionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BankQuestionId string `protobuf:"bytes,1,opt,name=bank_question_id,json=bankQuestionId,proto3" json:"bank_question_id,omitempty"`
+}
+
+func (x *UpdateBankQuestionResponse) Reset() {
+	*x = UpdateBankQuestionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBankQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBankQuestionResponse) ProtoMessage() {}
+
+func (x *UpdateBankQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBankQuestionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBankQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UpdateBankQuestionResponse) GetBankQuestionId() string {
+	if x != nil {
+		return x.BankQuestionId
+	}
+	return ""
+}
+
+// 刪除題庫題目時，引用該題目的測驗之後作答時會略過該題目
+type DeleteBankQuestionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BankQuestionId string `protobuf:"bytes,1,opt,name=bank_question_id,json=bankQuestionId,proto3" json:"bank_question_id,omitempty"`
+	UserId         string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *DeleteBankQuestionRequest) Reset() {
+	*x = DeleteBankQuestionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBankQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBankQuestionRequest) ProtoMessage() {}
+
+func (x *DeleteBankQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBankQuestionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBankQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeleteBankQuestionRequest) GetBankQuestionId() string {
+	if x != nil {
+		return x.BankQuestionId
+	}
+	return ""
+}
+
+func (x *DeleteBankQuestionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteBankQuestionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteBankQuestionResponse) Reset() {
+	*x = DeleteBankQuestionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBankQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBankQuestionResponse) ProtoMessage() {}
+
+func (x *DeleteBankQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBankQuestionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBankQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{73}
+}
+
+type FindBankQuestionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageIndex int32 `protobuf:"varint,1,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
+	PageSize  int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// 空字串表示全部的題目
+	Tag    string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *FindBankQuestionsRequest) Reset() {
+	*x = FindBankQuestionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindBankQuestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindBankQuestionsRequest) ProtoMessage() {}
+
+func (x *FindBankQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindBankQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*FindBankQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *FindBankQuestionsRequest) GetPageIndex() int32 {
+	if x != nil {
+		return x.PageIndex
+	}
+	return 0
+}
+
+func (x *FindBankQuestionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *FindBankQuestionsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *FindBankQuestionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type FindBankQuestionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total         int32           `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	PageCount     int32           `protobuf:"varint,2,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	BankQuestions []*BankQuestion `protobuf:"bytes,3,rep,name=bank_questions,json=bankQuestions,proto3" json:"bank_questions,omitempty"`
+}
+
+func (x *FindBankQuestionsResponse) Reset() {
+	*x = FindBankQuestionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindBankQuestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindBankQuestionsResponse) ProtoMessage() {}
+
+func (x *FindBankQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindBankQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*FindBankQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *FindBankQuestionsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *FindBankQuestionsResponse) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+func (x *FindBankQuestionsResponse) GetBankQuestions() []*BankQuestion {
+	if x != nil {
+		return x.BankQuestions
+	}
+	return nil
+}
+
+// 取代測驗原本引用的題庫題目，都沒有值時表示不再引用題庫
+type SetExamBankSourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExamId      string          `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	QuestionIds []string        `protobuf:"bytes,2,rep,name=question_ids,json=questionIds,proto3" json:"question_ids,omitempty"`
+	Pools       []*ExamBankPool `protobuf:"bytes,3,rep,name=pools,proto3" json:"pools,omitempty"`
+	UserId      string          `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *SetExamBankSourceRequest) Reset() {
+	*x = SetExamBankSourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetExamBankSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExamBankSourceRequest) ProtoMessage() {}
+
+func (x *SetExamBankSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExamBankSourceRequest.ProtoReflect.Descriptor instead.
+func (*SetExamBankSourceRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SetExamBankSourceRequest) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *SetExamBankSourceRequest) GetQuestionIds() []string {
+	if x != nil {
+		return x.QuestionIds
+	}
+	return nil
+}
+
+func (x *SetExamBankSourceRequest) GetPools() []*ExamBankPool {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+func (x *SetExamBankSourceRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SetExamBankSourceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exam *Exam `protobuf:"bytes,1,opt,name=exam,proto3" json:"exam,omitempty"`
+}
+
+func (x *SetExamBankSourceResponse) Reset() {
+	*x = SetExamBankSourceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetExamBankSourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExamBankSourceResponse) ProtoMessage() {}
+
+func (x *SetExamBankSourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExamBankSourceResponse.ProtoReflect.Descriptor instead.
+func (*SetExamBankSourceResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SetExamBankSourceResponse) GetExam() *Exam {
+	if x != nil {
+		return x.Exam
+	}
+	return nil
+}
+
+type ExamRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                 `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	ExamId      string                 `protobuf:"bytes,2,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	Score       int32                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	UserId      string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ExamVersion int32                  `protobuf:"varint,7,opt,name=exam_version,json=examVersion,proto3" json:"exam_version,omitempty"`
+	// 作答花費的秒數，0 表示沒有記錄
+	DurationSeconds int32 `protobuf:"varint,8,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// 每一題的作答內容，舊的作答紀錄沒有這個欄位
+	Answers []*ExamAttemptAnswer `protobuf:"bytes,9,rep,name=answers,proto3" json:"answers,omitempty"`
+	// 作答時的及格分數百分比，0 表示當時沒有設定及格分數
+	PassingScorePercent int32 `protobuf:"varint,10,opt,name=passing_score_percent,json=passingScorePercent,proto3" json:"passing_score_percent,omitempty"`
+	Passed              bool  `protobuf:"varint,11,opt,name=passed,proto3" json:"passed,omitempty"`
+	// 依照題目配分計算的得分、滿分與百分比，舊的作答紀錄沒有這些欄位
+	EarnedPoints float64 `protobuf:"fixed64,12,opt,name=earned_points,json=earnedPoints,proto3" json:"earned_points,omitempty"`
+	MaxPoints    int32   `protobuf:"varint,13,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"`
+	Percentage   float64 `protobuf:"fixed64,14,opt,name=percentage,proto3" json:"percentage,omitempty"`
+}
+
+func (x *ExamRecord) Reset() {
+	*x = ExamRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExamRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamRecord) ProtoMessage() {}
+
+func (x *ExamRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamRecord.ProtoReflect.Descriptor instead.
+func (*ExamRecord) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ExamRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExamRecord) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *ExamRecord) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ExamRecord) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExamRecord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ExamRecord) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ExamRecord) GetExamVersion() int32 {
+	if x != nil {
+		return x.ExamVersion
+	}
+	return 0
+}
+
+func (x *ExamRecord) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *ExamRecord) GetAnswers() []*ExamAttemptAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *ExamRecord) GetPassingScorePercent() int32 {
+	if x != nil {
+		return x.PassingScorePercent
+	}
+	return 0
+}
+
+func (x *ExamRecord) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *ExamRecord) GetEarnedPoints() float64 {
+	if x != nil {
+		return x.EarnedPoints
+	}
+	return 0
+}
+
+func (x *ExamRecord) GetMaxPoints() int32 {
+	if x != nil {
+		return x.MaxPoints
+	}
+	return 0
+}
+
+func (x *ExamRecord) GetPercentage() float64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+type ExamAttemptAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionId string `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	// 合併每個填空的答案，用來顯示
+	Answer string `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+	// 由後端比對題目的答案產生，用戶端傳送的值會被忽略
+	IsCorrect        bool  `protobuf:"varint,3,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
+	TimeSpentSeconds int32 `protobuf:"varint,4,opt,name=time_spent_seconds,json=timeSpentSeconds,proto3" json:"time_spent_seconds,omitempty"`
+	// 部分給分的題目得到的分數比例，0 到 1，答對時為 1，已扣除查看提示的分數，由後端計算
+	Credit float64 `protobuf:"fixed64,5,opt,name=credit,proto3" json:"credit,omitempty"`
+	// 作答時是否查看了提示，以伺服器記錄的查看提示為準，不使用用戶端傳送的值
+	HintUsed bool `protobuf:"varint,6,opt,name=hint_used,json=hintUsed,proto3" json:"hint_used,omitempty"`
+	// 每個填空填入的答案，依照題目答案的順序
+	BlankAnswers []string `protobuf:"bytes,7,rep,name=blank_answers,json=blankAnswers,proto3" json:"blank_answers,omitempty"`
+}
+
+func (x *ExamAttemptAnswer) Reset() {
+	*x = ExamAttemptAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExamAttemptAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamAttemptAnswer) ProtoMessage() {}
+
+func (x *ExamAttemptAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamAttemptAnswer.ProtoReflect.Descriptor instead.
+func (*ExamAttemptAnswer) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ExamAttemptAnswer) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *ExamAttemptAnswer) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *ExamAttemptAnswer) GetIsCorrect() bool {
+	if x != nil {
+		return x.IsCorrect
+	}
+	return false
+}
+
+func (x *ExamAttemptAnswer) GetTimeSpentSeconds() int32 {
+	if x != nil {
+		return x.TimeSpentSeconds
+	}
+	return 0
+}
+
+func (x *ExamAttemptAnswer) GetCredit() float64 {
+	if x != nil {
+		return x.Credit
+	}
+	return 0
+}
+
+func (x *ExamAttemptAnswer) GetHintUsed() bool {
+	if x != nil {
+		return x.HintUsed
+	}
+	return false
+}
+
+func (x *ExamAttemptAnswer) GetBlankAnswers() []string {
+	if x != nil {
+		return x.BlankAnswers
+	}
+	return nil
+}
+
+type CreateExamRecordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExamId          string `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	UserId          string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DurationSeconds int32  `protobuf:"varint,5,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// 每一題的作答內容，後端依照題目的答案評分
+	Answers []*ExamAttemptAnswer `protobuf:"bytes,7,rep,name=answers,proto3" json:"answers,omitempty"`
+	// FindRandomQuestions 回傳的作答 id，沒有作答的題目以 0 分計入滿分
+	ExamAttemptId string `protobuf:"bytes,8,opt,name=exam_attempt_id,json=examAttemptId,proto3" json:"exam_attempt_id,omitempty"`
+}
+
+func (x *CreateExamRecordRequest) Reset() {
+	*x = CreateExamRecordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateExamRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExamRecordRequest) ProtoMessage() {}
+
+func (x *CreateExamRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExamRecordRequest.ProtoReflect.Descriptor instead.
+func (*CreateExamRecordRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CreateExamRecordRequest) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *CreateExamRecordRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateExamRecordRequest) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *CreateExamRecordRequest) GetAnswers() []*ExamAttemptAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *CreateExamRecordRequest) GetExamAttemptId() string {
+	if x != nil {
+		return x.ExamAttemptId
+	}
+	return ""
+}
+
+type CreateExamRecordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 評分後的作答紀錄
+	ExamRecord *ExamRecord `protobuf:"bytes,1,opt,name=exam_record,json=examRecord,proto3" json:"exam_record,omitempty"`
+	// 作答的題目，測驗設定交卷後不能查看答案時不包含答案
+	Questions []*Question `protobuf:"bytes,2,rep,name=questions,proto3" json:"questions,omitempty"`
+}
+
+func (x *CreateExamRecordResponse) Reset() {
+	*x = CreateExamRecordResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateExamRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExamRecordResponse) ProtoMessage() {}
+
+func (x *CreateExamRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExamRecordResponse.ProtoReflect.Descriptor instead.
+func (*CreateExamRecordResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CreateExamRecordResponse) GetExamRecord() *ExamRecord {
+	if x != nil {
+		return x.ExamRecord
+	}
+	return nil
+}
+
+func (x *CreateExamRecordResponse) GetQuestions() []*Question {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+type FindExamRecordsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageIndex int32  `protobuf:"varint,1,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	ExamId    string `protobuf:"bytes,3,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	UserId    string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 有值時改用 cursor 分頁，忽略 page_index 且不回傳 total、page_count
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *FindExamRecordsRequest) Reset() {
+	*x = FindExamRecordsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindExamRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindExamRecordsRequest) ProtoMessage() {}
+
+func (x *FindExamRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindExamRecordsRequest.ProtoReflect.Descriptor instead.
+func (*FindExamRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *FindExamRecordsRequest) GetPageIndex() int32 {
+	if x != nil {
+		return x.PageIndex
+	}
+	return 0
+}
+
+func (x *FindExamRecordsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *FindExamRecordsRequest) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *FindExamRecordsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FindExamRecordsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type FindExamRecordsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total       int32         `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	PageCount   int32         `protobuf:"varint,2,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	ExamRecords []*ExamRecord `protobuf:"bytes,3,rep,name=exam_records,json=examRecords,proto3" json:"exam_records,omitempty"`
+	// 下一頁的 cursor，沒有下一頁時為空字串
+	NextCursor string `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *FindExamRecordsResponse) Reset() {
+	*x = FindExamRecordsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindExamRecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindExamRecordsResponse) ProtoMessage() {}
+
+func (x *FindExamRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindExamRecordsResponse.ProtoReflect.Descriptor instead.
+func (*FindExamRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *FindExamRecordsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *FindExamRecordsResponse) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+func (x *FindExamRecordsResponse) GetExamRecords() []*ExamRecord {
+	if x != nil {
+		return x.ExamRecords
+	}
+	return nil
+}
+
+func (x *FindExamRecordsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// 查詢作答紀錄的每一題作答內容，只有作答者本人可以查詢
+type GetExamRecordDetailRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExamId       string `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	ExamRecordId string `protobuf:"bytes,2,opt,name=exam_record_id,json=examRecordId,proto3" json:"exam_record_id,omitempty"`
+	UserId       string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetExamRecordDetailRequest) Reset() {
+	*x = GetExamRecordDetailRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExamRecordDetailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExamRecordDetailRequest) ProtoMessage() {}
+
+func (x *GetExamRecordDetailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExamRecordDetailRequest.ProtoReflect.Descriptor instead.
+func (*GetExamRecordDetailRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetExamRecordDetailRequest) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *GetExamRecordDetailRequest) GetExamRecordId() string {
+	if x != nil {
+		return x.ExamRecordId
+	}
+	return ""
+}
+
+func (x *GetExamRecordDetailRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetExamRecordDetailResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExamRecord *ExamRecord `protobuf:"bytes,1,opt,name=exam_record,json=examRecord,proto3" json:"exam_record,omitempty"`
+	// 依照作答順序排列的題目，已刪除的題目不會出現
+	Questions []*Question `protobuf:"bytes,2,rep,name=questions,proto3" json:"questions,omitempty"`
+}
+
+func (x *GetExamRecordDetailResponse) Reset() {
+	*x = GetExamRecordDetailResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExamRecordDetailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExamRecordDetailResponse) ProtoMessage() {}
+
+func (x *GetExamRecordDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExamRecordDetailResponse.ProtoReflect.Descriptor instead.
+func (*GetExamRecordDetailResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetExamRecordDetailResponse) GetExamRecord() *ExamRecord {
+	if x != nil {
+		return x.ExamRecord
+	}
+	return nil
+}
+
+func (x *GetExamRecordDetailResponse) GetQuestions() []*Question {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+type AnswerWrong struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                 `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	ExamId      string                 `protobuf:"bytes,2,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	QuestionId  string                 `protobuf:"bytes,3,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Times       int32                  `protobuf:"varint,4,opt,name=times,proto3" json:"times,omitempty"`
+	UserId      string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ExamVersion int32                  `protobuf:"varint,8,opt,name=exam_version,json=examVersion,proto3" json:"exam_version,omitempty"`
+	// 錯題練習中連續答對的次數，再次答錯時歸零
+	CorrectStreak int32 `protobuf:"varint,9,opt,name=correct_streak,json=correctStreak,proto3" json:"correct_streak,omitempty"`
+}
+
+func (x *AnswerWrong) Reset() {
+	*x = AnswerWrong{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnswerWrong) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerWrong) ProtoMessage() {}
+
+func (x *AnswerWrong) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerWrong.ProtoReflect.Descriptor instead.
+func (*AnswerWrong) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *AnswerWrong) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AnswerWrong) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *AnswerWrong) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *AnswerWrong) GetTimes() int32 {
+	if x != nil {
+		return x.Times
+	}
+	return 0
+}
+
+func (x *AnswerWrong) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AnswerWrong) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AnswerWrong) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *AnswerWrong) GetExamVersion() int32 {
+	if x != nil {
+		return x.ExamVersion
+	}
+	return 0
+}
+
+func (x *AnswerWrong) GetCorrectStreak() int32 {
+	if x != nil {
+		return x.CorrectStreak
+	}
+	return 0
+}
+
+type ExamVersionSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	QuestionCount int32                  `protobuf:"varint,2,opt,name=question_count,json=questionCount,proto3" json:"question_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ExamVersionSummary) Reset() {
+	*x = ExamVersionSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExamVersionSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamVersionSummary) ProtoMessage() {}
+
+func (x *ExamVersionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamVersionSummary.ProtoReflect.Descriptor instead.
+func (*ExamVersionSummary) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ExamVersionSummary) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ExamVersionSummary) GetQuestionCount() int32 {
+	if x != nil {
+		return x.QuestionCount
+	}
+	return 0
+}
+
+func (x *ExamVersionSummary) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type FindExamRecordOverviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExamId    string                 `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	UserId    string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// 要查看的測驗版本，0 表示最新版本
+	ExamVersion int32 `protobuf:"varint,4,opt,name=exam_version,json=examVersion,proto3" json:"exam_version,omitempty"`
+	// 使用者的 IANA 時區，例如 Asia/Taipei，空值表示 UTC
+	Timezone string `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// 7d、30d、90d 或 custom，空值時有 start_date 視為 custom，否則為 30d
+	Range string `protobuf:"bytes,6,opt,name=range,proto3" json:"range,omitempty"`
+	// custom 期間的結束日期（包含），空值表示今天，只使用日期部分
+	EndDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// 移動平均的天數，0 表示預設的 7 天
+	MovingAverageWindow int32 `protobuf:"varint,8,opt,name=moving_average_window,json=movingAverageWindow,proto3" json:"moving_average_window,omitempty"`
+}
+
+func (x *FindExamRecordOverviewRequest) Reset() {
+	*x = FindExamRecordOverviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindExamRecordOverviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindExamRecordOverviewRequest) ProtoMessage() {}
+
+func (x *FindExamRecordOverviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindExamRecordOverviewRequest.ProtoReflect.Descriptor instead.
+func (*FindExamRecordOverviewRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *FindExamRecordOverviewRequest) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *FindExamRecordOverviewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FindExamRecordOverviewRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *FindExamRecordOverviewRequest) GetExamVersion() int32 {
+	if x != nil {
+		return x.ExamVersion
+	}
+	return 0
+}
+
+func (x *FindExamRecordOverviewRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *FindExamRecordOverviewRequest) GetRange() string {
+	if x != nil {
+		return x.Range
+	}
+	return ""
+}
+
+func (x *FindExamRecordOverviewRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *FindExamRecordOverviewRequest) GetMovingAverageWindow() int32 {
+	if x != nil {
+		return x.MovingAverageWindow
+	}
+	return 0
+}
+
+type FindExamRecordOverviewResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartDate    string                `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	Exam         *Exam                 `protobuf:"bytes,2,opt,name=exam,proto3" json:"exam,omitempty"`
+	Questions    []*Question           `protobuf:"bytes,3,rep,name=questions,proto3" json:"questions,omitempty"`
+	AnswerWrongs []*AnswerWrong        `protobuf:"bytes,4,rep,name=answer_wrongs,json=answerWrongs,proto3" json:"answer_wrongs,omitempty"`
+	ExamRecords  []*ExamRecord         `protobuf:"bytes,5,rep,name=exam_records,json=examRecords,proto3" json:"exam_records,omitempty"`
+	ExamVersion  int32                 `protobuf:"varint,6,opt,name=exam_version,json=examVersion,proto3" json:"exam_version,omitempty"`
+	ExamVersions []*ExamVersionSummary `protobuf:"bytes,7,rep,name=exam_versions,json=examVersions,proto3" json:"exam_versions,omitempty"`
+	Analytics    *ExamRecordAnalytics  `protobuf:"bytes,8,opt,name=analytics,proto3" json:"analytics,omitempty"`
+}
+
+func (x *FindExamRecordOverviewResponse) Reset() {
+	*x = FindExamRecordOverviewResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindExamRecordOverviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindExamRecordOverviewResponse) ProtoMessage() {}
+
+func (x *FindExamRecordOverviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindExamRecordOverviewResponse.ProtoReflect.Descriptor instead.
+func (*FindExamRecordOverviewResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *FindExamRecordOverviewResponse) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *FindExamRecordOverviewResponse) GetExam() *Exam {
+	if x != nil {
+		return x.Exam
+	}
+	return nil
+}
+
+func (x *FindExamRecordOverviewResponse) GetQuestions() []*Question {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *FindExamRecordOverviewResponse) GetAnswerWrongs() []*AnswerWrong {
+	if x != nil {
+		return x.AnswerWrongs
+	}
+	return nil
+}
+
+func (x *FindExamRecordOverviewResponse) GetExamRecords() []*ExamRecord {
+	if x != nil {
+		return x.ExamRecords
+	}
+	return nil
+}
+
+func (x *FindExamRecordOverviewResponse) GetExamVersion() int32 {
+	if x != nil {
+		return x.ExamVersion
+	}
+	return 0
+}
+
+func (x *FindExamRecordOverviewResponse) GetExamVersions() []*ExamVersionSummary {
+	if x != nil {
+		return x.ExamVersions
+	}
+	return nil
+}
+
+func (x *FindExamRecordOverviewResponse) GetAnalytics() *ExamRecordAnalytics {
+	if x != nil {
+		return x.Analytics
+	}
+	return nil
+}
+
+// 分數都是作答紀錄的百分比分數
+type DailyExamRecordStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 使用者時區的日期，格式為 2006-01-02
+	Date          string  `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	RecordCount   int32   `protobuf:"varint,2,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+	AverageScore  float64 `protobuf:"fixed64,3,opt,name=average_score,json=averageScore,proto3" json:"average_score,omitempty"`
+	BestScore     float64 `protobuf:"fixed64,4,opt,name=best_score,json=bestScore,proto3" json:"best_score,omitempty"`
+	WorstScore    float64 `protobuf:"fixed64,5,opt,name=worst_score,json=worstScore,proto3" json:"worst_score,omitempty"`
+	MovingAverage float64 `protobuf:"fixed64,6,opt,name=moving_average,json=movingAverage,proto3" json:"moving_average,omitempty"`
+}
+
+func (x *DailyExamRecordStat) Reset() {
+	*x = DailyExamRecordStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DailyExamRecordStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyExamRecordStat) ProtoMessage() {}
+
+func (x *DailyExamRecordStat) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyExamRecordStat.ProtoReflect.Descriptor instead.
+func (*DailyExamRecordStat) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *DailyExamRecordStat) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyExamRecordStat) GetRecordCount() int32 {
+	if x != nil {
+		return x.RecordCount
+	}
+	return 0
+}
+
+func (x *DailyExamRecordStat) GetAverageScore() float64 {
+	if x != nil {
+		return x.AverageScore
+	}
+	return 0
+}
+
+func (x *DailyExamRecordStat) GetBestScore() float64 {
+	if x != nil {
+		return x.BestScore
+	}
+	return 0
+}
+
+func (x *DailyExamRecordStat) GetWorstScore() float64 {
+	if x != nil {
+		return x.WorstScore
+	}
+	return 0
+}
+
+func (x *DailyExamRecordStat) GetMovingAverage() float64 {
+	if x != nil {
+		return x.MovingAverage
+	}
+	return 0
+}
+
+type HourOfDayStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hour         int32   `protobuf:"varint,1,opt,name=hour,proto3" json:"hour,omitempty"`
+	RecordCount  int32   `protobuf:"varint,2,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+	AverageScore float64 `protobuf:"fixed64,3,opt,name=average_score,json=averageScore,proto3" json:"average_score,omitempty"`
+}
+
+func (x *HourOfDayStat) Reset() {
+	*x = HourOfDayStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HourOfDayStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HourOfDayStat) ProtoMessage() {}
+
+func (x *HourOfDayStat) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HourOfDayStat.ProtoReflect.Descriptor instead.
+func (*HourOfDayStat) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *HourOfDayStat) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *HourOfDayStat) GetRecordCount() int32 {
+	if x != nil {
+		return x.RecordCount
+	}
+	return 0
+}
+
+func (x *HourOfDayStat) GetAverageScore() float64 {
+	if x != nil {
+		return x.AverageScore
+	}
+	return 0
+}
+
+// 分數都是百分比分數，無法換算成百分比的舊作答紀錄不列入分析
+type ExamRecordAnalytics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timezone     string                 `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	StartDate    string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate      string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	RecordCount  int32                  `protobuf:"varint,4,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+	AverageScore float64                `protobuf:"fixed64,5,opt,name=average_score,json=averageScore,proto3" json:"average_score,omitempty"`
+	BestScore    float64                `protobuf:"fixed64,6,opt,name=best_score,json=bestScore,proto3" json:"best_score,omitempty"`
+	BestScoreAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=best_score_at,json=bestScoreAt,proto3" json:"best_score_at,omitempty"`
+	WorstScore   float64                `protobuf:"fixed64,8,opt,name=worst_score,json=worstScore,proto3" json:"worst_score,omitempty"`
+	WorstScoreAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=worst_score_at,json=worstScoreAt,proto3" json:"worst_score_at,omitempty"`
+	// 趨勢線每天的分數變化
+	TrendSlope float64 `protobuf:"fixed64,10,opt,name=trend_slope,json=trendSlope,proto3" json:"trend_slope,omitempty"`
+	// improving、declining、stable 或 insufficient_data
+	Trend               string                 `protobuf:"bytes,11,opt,name=trend,proto3" json:"trend,omitempty"`
+	CurrentStreakDays   int32                  `protobuf:"varint,12,opt,name=current_streak_days,json=currentStreakDays,proto3" json:"current_streak_days,omitempty"`
+	LongestStreakDays   int32                  `protobuf:"varint,13,opt,name=longest_streak_days,json=longestStreakDays,proto3" json:"longest_streak_days,omitempty"`
+	MovingAverageWindow int32                  `protobuf:"varint,14,opt,name=moving_average_window,json=movingAverageWindow,proto3" json:"moving_average_window,omitempty"`
+	DailyStats          []*DailyExamRecordStat `protobuf:"bytes,15,rep,name=daily_stats,json=dailyStats,proto3" json:"daily_stats,omitempty"`
+	HourOfDayStats      []*HourOfDayStat       `protobuf:"bytes,16,rep,name=hour_of_day_stats,json=hourOfDayStats,proto3" json:"hour_of_day_stats,omitempty"`
+}
+
+func (x *ExamRecordAnalytics) Reset() {
+	*x = ExamRecordAnalytics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExamRecordAnalytics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamRecordAnalytics) ProtoMessage() {}
+
+func (x *ExamRecordAnalytics) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamRecordAnalytics.ProtoReflect.Descriptor instead.
+func (*ExamRecordAnalytics) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ExamRecordAnalytics) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ExamRecordAnalytics) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ExamRecordAnalytics) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *ExamRecordAnalytics) GetRecordCount() int32 {
+	if x != nil {
+		return x.RecordCount
+	}
+	return 0
+}
+
+func (x *ExamRecordAnalytics) GetAverageScore() float64 {
+	if x != nil {
+		return x.AverageScore
+	}
+	return 0
+}
+
+func (x *ExamRecordAnalytics) GetBestScore() float64 {
+	if x != nil {
+		return x.BestScore
+	}
+	return 0
+}
+
+func (x *ExamRecordAnalytics) GetBestScoreAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BestScoreAt
+	}
+	return nil
+}
+
+func (x *ExamRecordAnalytics) GetWorstScore() float64 {
+	if x != nil {
+		return x.WorstScore
+	}
+	return 0
+}
+
+func (x *ExamRecordAnalytics) GetWorstScoreAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WorstScoreAt
+	}
+	return nil
+}
+
+func (x *ExamRecordAnalytics) GetTrendSlope() float64 {
+	if x != nil {
+		return x.TrendSlope
+	}
+	return 0
+}
+
+func (x *ExamRecordAnalytics) GetTrend() string {
+	if x != nil {
+		return x.Trend
+	}
+	return ""
+}
+
+func (x *ExamRecordAnalytics) GetCurrentStreakDays() int32 {
+	if x != nil {
+		return x.CurrentStreakDays
+	}
+	return 0
+}
+
+func (x *ExamRecordAnalytics) GetLongestStreakDays() int32 {
+	if x != nil {
+		return x.LongestStreakDays
+	}
+	return 0
+}
+
+func (x *ExamRecordAnalytics) GetMovingAverageWindow() int32 {
+	if x != nil {
+		return x.MovingAverageWindow
+	}
+	return 0
+}
+
+func (x *ExamRecordAnalytics) GetDailyStats() []*DailyExamRecordStat {
+	if x != nil {
+		return x.DailyStats
+	}
+	return nil
+}
+
+func (x *ExamRecordAnalytics) GetHourOfDayStats() []*HourOfDayStat {
+	if x != nil {
+		return x.HourOfDayStats
+	}
+	return nil
+}
+
+type ExamInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExamId        string   `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	Topic         string   `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Description   string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	IsPublic      bool     `protobuf:"varint,4,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	QuestionCount int32    `protobuf:"varint,5,opt,name=question_count,json=questionCount,proto3" json:"question_count,omitempty"`
+	RecordCount   int32    `protobuf:"varint,6,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+	Tags          []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *ExamInfo) Reset() {
+	*x = ExamInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExamInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamInfo) ProtoMessage() {}
+
+func (x *ExamInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamInfo.ProtoReflect.Descriptor instead.
+func (*ExamInfo) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ExamInfo) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *ExamInfo) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *ExamInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ExamInfo) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *ExamInfo) GetQuestionCount() int32 {
+	if x != nil {
+		return x.QuestionCount
+	}
+	return 0
+}
+
+func (x *ExamInfo) GetRecordCount() int32 {
+	if x != nil {
+		return x.RecordCount
+	}
+	return 0
+}
+
+func (x *ExamInfo) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type FindExamInfosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsPublic bool   `protobuf:"varint,2,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	// 有值時只查詢包含全部標籤的測驗
+	Tags []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *FindExamInfosRequest) Reset() {
+	*x = FindExamInfosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindExamInfosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindExamInfosRequest) ProtoMessage() {}
+
+func (x *FindExamInfosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindExamInfosRequest.ProtoReflect.Descriptor instead.
+func (*FindExamInfosRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *FindExamInfosRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FindExamInfosRequest) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *FindExamInfosRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type FindExamInfosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExamInfos []*ExamInfo `protobuf:"bytes,1,rep,name=exam_infos,json=examInfos,proto3" json:"exam_infos,omitempty"`
+}
+
+func (x *FindExamInfosResponse) Reset() {
+	*x = FindExamInfosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindExamInfosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindExamInfosResponse) ProtoMessage() {}
+
+func (x *FindExamInfosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindExamInfosResponse.ProtoReflect.Descriptor instead.
+func (*FindExamInfosResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *FindExamInfosResponse) GetExamInfos() []*ExamInfo {
+	if x != nil {
+		return x.ExamInfos
+	}
+	return nil
+}
+
+type ExamCatalogItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExamId        string   `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	Topic         string   `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Description   string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Tags          []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	UserId        string   `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	QuestionCount int32    `protobuf:"varint,6,opt,name=question_count,json=questionCount,proto3" json:"question_count,omitempty"`
+	// 全部使用者的作答次數
+	RecordCount int32 `protobuf:"varint,7,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+	// 全部使用者作答的平均得分百分比
+	AveragePercentage float64                `protobuf:"fixed64,8,opt,name=average_percentage,json=averagePercentage,proto3" json:"average_percentage,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *ExamCatalogItem) Reset() {
+	*x = ExamCatalogItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExamCatalogItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamCatalogItem) ProtoMessage() {}
+
+func (x *ExamCatalogItem) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamCatalogItem.ProtoReflect.Descriptor instead.
+func (*ExamCatalogItem) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ExamCatalogItem) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *ExamCatalogItem) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *ExamCatalogItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ExamCatalogItem) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ExamCatalogItem) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExamCatalogItem) GetQuestionCount() int32 {
+	if x != nil {
+		return x.QuestionCount
+	}
+	return 0
+}
+
+func (x *ExamCatalogItem) GetRecordCount() int32 {
+	if x != nil {
+		return x.RecordCount
+	}
+	return 0
+}
+
+func (x *ExamCatalogItem) GetAveragePercentage() float64 {
+	if x != nil {
+		return x.AveragePercentage
+	}
+	return 0
+}
+
+func (x *ExamCatalogItem) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type FindExamCatalogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 搜尋主題和說明中的單字，不分大小寫
+	Keyword string   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Tags    []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	// newest、most_attempted 或 highest_scoring，預設為 newest
+	SortBy    string `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	PageIndex int32  `protobuf:"varint,4,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
+	PageSize  int32  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *FindExamCatalogRequest) Reset() {
+	*x = FindExamCatalogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindExamCatalogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindExamCatalogRequest) ProtoMessage() {}
+
+func (x *FindExamCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindExamCatalogRequest.ProtoReflect.Descriptor instead.
+func (*FindExamCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *FindExamCatalogRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *FindExamCatalogRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *FindExamCatalogRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *FindExamCatalogRequest) GetPageIndex() int32 {
+	if x != nil {
+		return x.PageIndex
+	}
+	return 0
+}
+
+func (x *FindExamCatalogRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type FindExamCatalogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total     int32              `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	PageCount int32              `protobuf:"varint,2,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	Items     []*ExamCatalogItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *FindExamCatalogResponse) Reset() {
+	*x = FindExamCatalogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindExamCatalogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindExamCatalogResponse) ProtoMessage() {}
+
+func (x *FindExamCatalogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindExamCatalogResponse.ProtoReflect.Descriptor instead.
+func (*FindExamCatalogResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *FindExamCatalogResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *FindExamCatalogResponse) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+func (x *FindExamCatalogResponse) GetItems() []*ExamCatalogItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ClassStudent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	JoinedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+}
+
+func (x *ClassStudent) Reset() {
+	*x = ClassStudent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClassStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassStudent) ProtoMessage() {}
+
+func (x *ClassStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassStudent.ProtoReflect.Descriptor instead.
+func (*ClassStudent) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ClassStudent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ClassStudent) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+// 學生查詢時不會回傳加入代碼和其他學生
+type Class struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	JoinCode    string `protobuf:"bytes,4,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`
+	// 建立班級的老師
+	UserId    string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Students  []*ClassStudent        `protobuf:"bytes,6,rep,name=students,proto3" json:"students,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Class) Reset() {
+	*x = Class{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Class) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Class) ProtoMessage() {}
+
+func (x *Class) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Class.ProtoReflect.Descriptor instead.
+func (*Class) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *Class) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Class) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Class) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Class) GetJoinCode() string {
+	if x != nil {
+		return x.JoinCode
+	}
+	return ""
+}
+
+func (x *Class) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Class) GetStudents() []*ClassStudent {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+func (x *Class) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Class) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateClassRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	UserId      string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *CreateClassRequest) Reset() {
+	*x = CreateClassRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClassRequest) ProtoMessage() {}
+
+func (x *CreateClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClassRequest.ProtoReflect.Descriptor instead.
+func (*CreateClassRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *CreateClassRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateClassRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateClassRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CreateClassResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClassId  string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	JoinCode string `protobuf:"bytes,2,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`
+}
+
+func (x *CreateClassResponse) Reset() {
+	*x = CreateClassResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateClassResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClassResponse) ProtoMessage() {}
+
+func (x *CreateClassResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClassResponse.ProtoReflect.Descriptor instead.
+func (*CreateClassResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *CreateClassResponse) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *CreateClassResponse) GetJoinCode() string {
+	if x != nil {
+		return x.JoinCode
+	}
+	return ""
+}
+
+type JoinClassRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JoinCode string `protobuf:"bytes,1,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`
+	UserId   string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *JoinClassRequest) Reset() {
+	*x = JoinClassRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinClassRequest) ProtoMessage() {}
+
+func (x *JoinClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinClassRequest.ProtoReflect.Descriptor instead.
+func (*JoinClassRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *JoinClassRequest) GetJoinCode() string {
+	if x != nil {
+		return x.JoinCode
+	}
+	return ""
+}
+
+func (x *JoinClassRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type JoinClassResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *JoinClassResponse) Reset() {
+	*x = JoinClassResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinClassResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinClassResponse) ProtoMessage() {}
+
+func (x *JoinClassResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinClassResponse.ProtoReflect.Descriptor instead.
+func (*JoinClassResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *JoinClassResponse) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *JoinClassResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type FindClassesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *FindClassesRequest) Reset() {
+	*x = FindClassesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindClassesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindClassesRequest) ProtoMessage() {}
+
+func (x *FindClassesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindClassesRequest.ProtoReflect.Descriptor instead.
+func (*FindClassesRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *FindClassesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type FindClassesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Classes []*Class `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
+}
+
+func (x *FindClassesResponse) Reset() {
+	*x = FindClassesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindClassesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindClassesResponse) ProtoMessage() {}
+
+func (x *FindClassesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindClassesResponse.ProtoReflect.Descriptor instead.
+func (*FindClassesResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *FindClassesResponse) GetClasses() []*Class {
+	if x != nil {
+		return x.Classes
+	}
+	return nil
+}
+
+type Assignment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClassId   string                 `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	ExamId    string                 `protobuf:"bytes,3,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	Title     string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	DueAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Assignment) Reset() {
+	*x = Assignment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Assignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assignment) ProtoMessage() {}
+
+func (x *Assignment) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assignment.ProtoReflect.Descriptor instead.
+func (*Assignment) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *Assignment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Assignment) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *Assignment) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *Assignment) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Assignment) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *Assignment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateAssignmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	ExamId  string `protobuf:"bytes,2,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	// 沒有值時使用測驗的主題
+	Title  string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	DueAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	UserId string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *CreateAssignmentRequest) Reset() {
+	*x = CreateAssignmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssignmentRequest) ProtoMessage() {}
+
+func (x *CreateAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *CreateAssignmentRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *CreateAssignmentRequest) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *CreateAssignmentRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateAssignmentRequest) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *CreateAssignmentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CreateAssignmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId string `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+}
+
+func (x *CreateAssignmentResponse) Reset() {
+	*x = CreateAssignmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAssignmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssignmentResponse) ProtoMessage() {}
+
+func (x *CreateAssignmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssignmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateAssignmentResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *CreateAssignmentResponse) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+type FindAssignmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	UserId  string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *FindAssignmentsRequest) Reset() {
+	*x = FindAssignmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAssignmentsRequest) ProtoMessage() {}
+
+func (x *FindAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*FindAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *FindAssignmentsRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *FindAssignmentsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type FindAssignmentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assignments []*Assignment `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+}
+
+func (x *FindAssignmentsResponse) Reset() {
+	*x = FindAssignmentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAssignmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAssignmentsResponse) ProtoMessage() {}
+
+func (x *FindAssignmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAssignmentsResponse.ProtoReflect.Descriptor instead.
+func (*FindAssignmentsResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *FindAssignmentsResponse) GetAssignments() []*Assignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+// 學生在作業期間（建立作業到截止時間）的成績，沒有作答的學生不會有成績，成績是得分百分比
+type ClassGrade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssignmentId     string                 `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RecordCount      int32                  `protobuf:"varint,5,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+	LatestCreatedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=latest_created_at,json=latestCreatedAt,proto3" json:"latest_created_at,omitempty"`
+	BestPercentage   float64                `protobuf:"fixed64,7,opt,name=best_percentage,json=bestPercentage,proto3" json:"best_percentage,omitempty"`
+	LatestPercentage float64                `protobuf:"fixed64,8,opt,name=latest_percentage,json=latestPercentage,proto3" json:"latest_percentage,omitempty"`
+}
+
+func (x *ClassGrade) Reset() {
+	*x = ClassGrade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClassGrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassGrade) ProtoMessage() {}
+
+func (x *ClassGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassGrade.ProtoReflect.Descriptor instead.
+func (*ClassGrade) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ClassGrade) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *ClassGrade) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ClassGrade) GetRecordCount() int32 {
+	if x != nil {
+		return x.RecordCount
+	}
+	return 0
+}
+
+func (x *ClassGrade) GetLatestCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LatestCreatedAt
+	}
+	return nil
+}
+
+func (x *ClassGrade) GetBestPercentage() float64 {
+	if x != nil {
+		return x.BestPercentage
+	}
+	return 0
+}
+
+func (x *ClassGrade) GetLatestPercentage() float64 {
+	if x != nil {
+		return x.LatestPercentage
+	}
+	return 0
+}
+
+type FindClassGradebookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	UserId  string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *FindClassGradebookRequest) Reset() {
+	*x = FindClassGradebookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindClassGradebookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindClassGradebookRequest) ProtoMessage() {}
+
+func (x *FindClassGradebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindClassGradebookRequest.ProtoReflect.Descriptor instead.
+func (*FindClassGradebookRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *FindClassGradebookRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *FindClassGradebookRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type FindClassGradebookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Class       *Class        `protobuf:"bytes,1,opt,name=class,proto3" json:"class,omitempty"`
+	Assignments []*Assignment `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	Grades      []*ClassGrade `protobuf:"bytes,3,rep,name=grades,proto3" json:"grades,omitempty"`
+}
+
+func (x *FindClassGradebookResponse) Reset() {
+	*x = FindClassGradebookResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindClassGradebookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindClassGradebookResponse) ProtoMessage() {}
+
+func (x *FindClassGradebookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindClassGradebookResponse.ProtoReflect.Descriptor instead.
+func (*FindClassGradebookResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *FindClassGradebookResponse) GetClass() *Class {
+	if x != nil {
+		return x.Class
+	}
+	return nil
+}
+
+func (x *FindClassGradebookResponse) GetAssignments() []*Assignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *FindClassGradebookResponse) GetGrades() []*ClassGrade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+// 使用者在排行榜期間內的最佳紀錄
+type LeaderboardEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Score           int32                  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	DurationSeconds int32                  `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Percentage      float64                `protobuf:"fixed64,5,opt,name=percentage,proto3" json:"percentage,omitempty"`
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *LeaderboardEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LeaderboardEntry) GetPercentage() float64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+// 排行榜依百分比分數排序，同分時作答時間較短、較早作答的排在前面
+type FindExamLeaderboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExamId string `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	// weekly（本週，從星期一 00:00 UTC 開始）或 all_time，預設為 all_time
+	Window string `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	Size   int32  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// 不顯示在排行榜的使用者，查詢時就排除，不佔排行榜的名額
+	ExcludedUserIds []string `protobuf:"bytes,4,rep,name=excluded_user_ids,json=excludedUserIds,proto3" json:"excluded_user_ids,omitempty"`
+}
+
+func (x *FindExamLeaderboardRequest) Reset() {
+	*x = FindExamLeaderboardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindExamLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindExamLeaderboardRequest) ProtoMessage() {}
+
+func (x *FindExamLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindExamLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*FindExamLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *FindExamLeaderboardRequest) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *FindExamLeaderboardRequest) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *FindExamLeaderboardRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FindExamLeaderboardRequest) GetExcludedUserIds() []string {
+	if x != nil {
+		return x.ExcludedUserIds
+	}
+	return nil
+}
+
+type FindExamLeaderboardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*LeaderboardEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *FindExamLeaderboardResponse) Reset() {
+	*x = FindExamLeaderboardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindExamLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindExamLeaderboardResponse) ProtoMessage() {}
+
+func (x *FindExamLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindExamLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*FindExamLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *FindExamLeaderboardResponse) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// 題目分析，只有測驗的擁有者可以查詢
+type QuestionStatistic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionId   string  `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Ask          string  `protobuf:"bytes,2,opt,name=ask,proto3" json:"ask,omitempty"`
+	AttemptCount int32   `protobuf:"varint,3,opt,name=attempt_count,json=attemptCount,proto3" json:"attempt_count,omitempty"`
+	WrongCount   int32   `protobuf:"varint,4,opt,name=wrong_count,json=wrongCount,proto3" json:"wrong_count,omitempty"`
+	ErrorRate    float64 `protobuf:"fixed64,5,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`
+	// 鑑別度，高分組答對率減去低分組答對率，介於 -1 到 1 之間
+	DiscriminationIndex float64 `protobuf:"fixed64,6,opt,name=discrimination_index,json=discriminationIndex,proto3" json:"discrimination_index,omitempty"`
+}
+
+func (x *QuestionStatistic) Reset() {
+	*x = QuestionStatistic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuestionStatistic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionStatistic) ProtoMessage() {}
+
+func (x *QuestionStatistic) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionStatistic.ProtoReflect.Descriptor instead.
+func (*QuestionStatistic) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *QuestionStatistic) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *QuestionStatistic) GetAsk() string {
+	if x != nil {
+		return x.Ask
+	}
+	return ""
+}
+
+func (x *QuestionStatistic) GetAttemptCount() int32 {
+	if x != nil {
+		return x.AttemptCount
+	}
+	return 0
+}
+
+func (x *QuestionStatistic) GetWrongCount() int32 {
+	if x != nil {
+		return x.WrongCount
+	}
+	return 0
+}
+
+func (x *QuestionStatistic) GetErrorRate() float64 {
+	if x != nil {
+		return x.ErrorRate
+	}
+	return 0
+}
+
+func (x *QuestionStatistic) GetDiscriminationIndex() float64 {
+	if x != nil {
+		return x.DiscriminationIndex
+	}
+	return 0
+}
+
+// 百分比級距與該級距的作答次數，percentage 是級距的下限
+type PercentageCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Percentage int32 `protobuf:"varint,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	Count      int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *PercentageCount) Reset() {
+	*x = PercentageCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PercentageCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PercentageCount) ProtoMessage() {}
+
+func (x *PercentageCount) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PercentageCount.ProtoReflect.Descriptor instead.
+func (*PercentageCount) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *PercentageCount) GetPercentage() int32 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *PercentageCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 只包含可以換算成百分比的作答紀錄，百分比以 10 為級距，100 分自成一個級距
+type ExamStatisticsSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AttemptCount           int32              `protobuf:"varint,1,opt,name=attempt_count,json=attemptCount,proto3" json:"attempt_count,omitempty"`
+	UserCount              int32              `protobuf:"varint,2,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
+	MeanPercentage         float64            `protobuf:"fixed64,6,opt,name=mean_percentage,json=meanPercentage,proto3" json:"mean_percentage,omitempty"`
+	MedianPercentage       float64            `protobuf:"fixed64,7,opt,name=median_percentage,json=medianPercentage,proto3" json:"median_percentage,omitempty"`
+	PercentageDistribution []*PercentageCount `protobuf:"bytes,8,rep,name=percentage_distribution,json=percentageDistribution,proto3" json:"percentage_distribution,omitempty"`
+}
+
+func (x *ExamStatisticsSummary) Reset() {
+	*x = ExamStatisticsSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExamStatisticsSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExamStatisticsSummary) ProtoMessage() {}
+
+func (x *ExamStatisticsSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExamStatisticsSummary.ProtoReflect.Descriptor instead.
+func (*ExamStatisticsSummary) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ExamStatisticsSummary) GetAttemptCount() int32 {
+	if x != nil {
+		return x.AttemptCount
+	}
+	return 0
+}
+
+func (x *ExamStatisticsSummary) GetUserCount() int32 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+func (x *ExamStatisticsSummary) GetMeanPercentage() float64 {
+	if x != nil {
+		return x.MeanPercentage
+	}
+	return 0
+}
+
+func (x *ExamStatisticsSummary) GetMedianPercentage() float64 {
+	if x != nil {
+		return x.MedianPercentage
+	}
+	return 0
+}
+
+func (x *ExamStatisticsSummary) GetPercentageDistribution() []*PercentageCount {
+	if x != nil {
+		return x.PercentageDistribution
+	}
+	return nil
+}
+
+type FindQuestionStatisticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExamId string `protobuf:"bytes,1,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *FindQuestionStatisticsRequest) Reset() {
+	*x = FindQuestionStatisticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindQuestionStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindQuestionStatisticsRequest) ProtoMessage() {}
+
+func (x *FindQuestionStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindQuestionStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*FindQuestionStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *FindQuestionStatisticsRequest) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *FindQuestionStatisticsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type FindQuestionStatisticsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Summary            *ExamStatisticsSummary `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	QuestionStatistics []*QuestionStatistic   `protobuf:"bytes,2,rep,name=question_statistics,json=questionStatistics,proto3" json:"question_statistics,omitempty"`
+}
+
+func (x *FindQuestionStatisticsResponse) Reset() {
+	*x = FindQuestionStatisticsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindQuestionStatisticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindQuestionStatisticsResponse) ProtoMessage() {}
+
+func (x *FindQuestionStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindQuestionStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*FindQuestionStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *FindQuestionStatisticsResponse) GetSummary() *ExamStatisticsSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *FindQuestionStatisticsResponse) GetQuestionStatistics() []*QuestionStatistic {
+	if x != nil {
+		return x.QuestionStatistics
+	}
+	return nil
+}
+
+// 錯題本中的一題，question 是答錯時測驗版本的題目內容，題目已被刪除時為空
+type MistakeNotebookEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnswerWrong *AnswerWrong `protobuf:"bytes,1,opt,name=answer_wrong,json=answerWrong,proto3" json:"answer_wrong,omitempty"`
+	Question    *Question    `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	// 題目所屬測驗的主題，測驗已被刪除時為空字串
+	ExamTopic string `protobuf:"bytes,3,opt,name=exam_topic,json=examTopic,proto3" json:"exam_topic,omitempty"`
+}
+
+func (x *MistakeNotebookEntry) Reset() {
+	*x = MistakeNotebookEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MistakeNotebookEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MistakeNotebookEntry) ProtoMessage() {}
+
+func (x *MistakeNotebookEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MistakeNotebookEntry.ProtoReflect.Descriptor instead.
+func (*MistakeNotebookEntry) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *MistakeNotebookEntry) GetAnswerWrong() *AnswerWrong {
+	if x != nil {
+		return x.AnswerWrong
+	}
+	return nil
+}
+
+func (x *MistakeNotebookEntry) GetQuestion() *Question {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+func (x *MistakeNotebookEntry) GetExamTopic() string {
+	if x != nil {
+		return x.ExamTopic
+	}
+	return ""
+}
+
+type FindMistakeNotebookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 空字串表示全部測驗
+	ExamId string `protobuf:"bytes,2,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	// 最後一次答錯的時間範圍，包含 start_date，不包含 end_date，空值表示不限制
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	PageIndex int32                  `protobuf:"varint,5,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
+	PageSize  int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *FindMistakeNotebookRequest) Reset() {
+	*x = FindMistakeNotebookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindMistakeNotebookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindMistakeNotebookRequest) ProtoMessage() {}
+
+func (x *FindMistakeNotebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindMistakeNotebookRequest.ProtoReflect.Descriptor instead.
+func (*FindMistakeNotebookRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *FindMistakeNotebookRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FindMistakeNotebookRequest) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *FindMistakeNotebookRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *FindMistakeNotebookRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *FindMistakeNotebookRequest) GetPageIndex() int32 {
+	if x != nil {
+		return x.PageIndex
+	}
+	return 0
+}
+
+func (x *FindMistakeNotebookRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type FindMistakeNotebookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total     int32                   `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	PageCount int32                   `protobuf:"varint,2,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	Entries   []*MistakeNotebookEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *FindMistakeNotebookResponse) Reset() {
+	*x = FindMistakeNotebookResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindMistakeNotebookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindMistakeNotebookResponse) ProtoMessage() {}
+
+func (x *FindMistakeNotebookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindMistakeNotebookResponse.ProtoReflect.Descriptor instead.
+func (*FindMistakeNotebookResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *FindMistakeNotebookResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *FindMistakeNotebookResponse) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+func (x *FindMistakeNotebookResponse) GetEntries() []*MistakeNotebookEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type CreateMistakeDrillRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 空字串表示從全部測驗的錯題中出題
+	ExamId string `protobuf:"bytes,2,opt,name=exam_id,json=examId,proto3" json:"exam_id,omitempty"`
+	// 題數，0 表示預設的 10 題
+	Size int32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *CreateMistakeDrillRequest) Reset() {
+	*x = CreateMistakeDrillRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateMistakeDrillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMistakeDrillRequest) ProtoMessage() {}
+
+func (x *CreateMistakeDrillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMistakeDrillRequest.ProtoReflect.Descriptor instead.
+func (*CreateMistakeDrillRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *CreateMistakeDrillRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateMistakeDrillRequest) GetExamId() string {
+	if x != nil {
+		return x.ExamId
+	}
+	return ""
+}
+
+func (x *CreateMistakeDrillRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type CreateMistakeDrillResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 練習的題目不回傳答案和解說
+	Entries []*MistakeNotebookEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// 連續答對幾次後會從錯題本移除
+	RequiredCorrectStreak int32 `protobuf:"varint,2,opt,name=required_correct_streak,json=requiredCorrectStreak,proto3" json:"required_correct_streak,omitempty"`
+}
+
+func (x *CreateMistakeDrillResponse) Reset() {
+	*x = CreateMistakeDrillResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateMistakeDrillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMistakeDrillResponse) ProtoMessage() {}
+
+func (x *CreateMistakeDrillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMistakeDrillResponse.ProtoReflect.Descriptor instead.
+func (*CreateMistakeDrillResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *CreateMistakeDrillResponse) GetEntries() []*MistakeNotebookEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *CreateMistakeDrillResponse) GetRequiredCorrectStreak() int32 {
+	if x != nil {
+		return x.RequiredCorrectStreak
+	}
+	return 0
+}
+
+type AnswerMistakeDrillRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnswerWrongId string `protobuf:"bytes,1,opt,name=answer_wrong_id,json=answerWrongId,proto3" json:"answer_wrong_id,omitempty"`
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 每個填空的答案，是否答對由後端評分
+	BlankAnswers []string `protobuf:"bytes,4,rep,name=blank_answers,json=blankAnswers,proto3" json:"blank_answers,omitempty"`
+}
+
+func (x *AnswerMistakeDrillRequest) Reset() {
+	*x = AnswerMistakeDrillRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnswerMistakeDrillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerMistakeDrillRequest) ProtoMessage() {}
+
+func (x *AnswerMistakeDrillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerMistakeDrillRequest.ProtoReflect.Descriptor instead.
+func (*AnswerMistakeDrillRequest) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *AnswerMistakeDrillRequest) GetAnswerWrongId() string {
+	if x != nil {
+		return x.AnswerWrongId
+	}
+	return ""
+}
+
+func (x *AnswerMistakeDrillRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AnswerMistakeDrillRequest) GetBlankAnswers() []string {
+	if x != nil {
+		return x.BlankAnswers
+	}
+	return nil
+}
+
+type AnswerMistakeDrillResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CorrectStreak int32 `protobuf:"varint,1,opt,name=correct_streak,json=correctStreak,proto3" json:"correct_streak,omitempty"`
+	// 是否已經從錯題本移除
+	Removed   bool `protobuf:"varint,2,opt,name=removed,proto3" json:"removed,omitempty"`
+	IsCorrect bool `protobuf:"varint,3,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
+	// 正確答案，測驗不公布答案時為空陣列
+	CorrectAnswers []string `protobuf:"bytes,4,rep,name=correct_answers,json=correctAnswers,proto3" json:"correct_answers,omitempty"`
+}
+
+func (x *AnswerMistakeDrillResponse) Reset() {
+	*x = AnswerMistakeDrillResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exam_service_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnswerMistakeDrillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerMistakeDrillResponse) ProtoMessage() {}
+
+func (x *AnswerMistakeDrillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exam_service_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerMistakeDrillResponse.ProtoReflect.Descriptor instead.
+func (*AnswerMistakeDrillResponse) Descriptor() ([]byte, []int) {
+	return file_exam_service_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *AnswerMistakeDrillResponse) GetCorrectStreak() int32 {
+	if x != nil {
+		return x.CorrectStreak
+	}
+	return 0
+}
+
+func (x *AnswerMistakeDrillResponse) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+func (x *AnswerMistakeDrillResponse) GetIsCorrect() bool {
+	if x != nil {
+		return x.IsCorrect
+	}
+	return false
+}
+
+func (x *AnswerMistakeDrillResponse) GetCorrectAnswers() []string {
+	if x != nil {
+		return x.CorrectAnswers
+	}
+	return nil
+}
+
+var File_exam_service_proto protoreflect.FileDescriptor
+
+var file_exam_service_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x91, 0x04, 0x0a, 0x04, 0x45, 0x78,
+	0x61, 0x6d, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x5f, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a, 0x13, 0x66,
+	0x6f, 0x72, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x6f, 0x72, 0x6b, 0x65, 0x64,
+	0x46, 0x72, 0x6f, 0x6d, 0x45, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x13, 0x66, 0x6f,
+	0x72, 0x6b, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x6f, 0x72, 0x6b, 0x65, 0x64, 0x46,
+	0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x2c, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x33,
+	0x0a, 0x0b, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x42, 0x61, 0x6e,
+	0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0a, 0x62, 0x61, 0x6e, 0x6b, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xdc, 0x02,
+	0x0a, 0x0c, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x32,
+	0x0a, 0x15, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x41, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x5f, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73,
+	0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c,
+	0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x61, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x70, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12,
+	0x39, 0x0a, 0x19, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x5f,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x16, 0x73, 0x68, 0x6f, 0x77, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x68, 0x69,
+	0x6e, 0x74, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x68, 0x69, 0x6e, 0x74, 0x50, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x95, 0x01, 0x0a,
+	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
+	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x22, 0x2d, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78,
+	0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61,
+	0x6d, 0x49, 0x64, 0x22, 0xa1, 0x02, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
+	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x2e, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0f,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x47, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x42, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x2f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x65, 0x78, 0x61, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x52,
+	0x04, 0x65, 0x78, 0x61, 0x6d, 0x22, 0x7f, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x89, 0x01, 0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64, 0x45,
+	0x78, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1e, 0x0a, 0x05, 0x65, 0x78, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x05, 0x65, 0x78, 0x61, 0x6d,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x22, 0x45, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x88, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x57, 0x6f, 0x72, 0x64,
+	0x12, 0x26, 0x0a, 0x0f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x6f, 0x72, 0x64, 0x4d,
+	0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xcc, 0x01, 0x0a, 0x19, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x45, 0x78, 0x61,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x37, 0x0a, 0x0e, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x0d, 0x70, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x63, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x5c, 0x0a, 0x1a, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64,
+	0x45, 0x78, 0x61, 0x6d, 0x73, 0x42, 0x79, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x6e, 0x0a, 0x17, 0x46,
+	0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x73, 0x42, 0x79, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x05, 0x65,
+	0x78, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e,
+	0x45, 0x78, 0x61, 0x6d, 0x52, 0x05, 0x65, 0x78, 0x61, 0x6d, 0x73, 0x22, 0x32, 0x0a, 0x08, 0x54,
+	0x61, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x43, 0x0a, 0x0f, 0x46, 0x6f, 0x72, 0x6b, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x10, 0x46, 0x6f, 0x72, 0x6b, 0x45, 0x78, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x46, 0x0a, 0x12, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x6f, 0x0a, 0x13, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x78, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x73, 0x0a, 0x09, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x7e, 0x0a, 0x10, 0x53, 0x68, 0x61, 0x72, 0x65, 0x45,
+	0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78,
+	0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61,
+	0x6d, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x68, 0x61, 0x72, 0x65, 0x45,
+	0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6c, 0x0a, 0x12, 0x55,
+	0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x6e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x49, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x16, 0x46,
+	0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0xaa, 0x01, 0x0a,
+	0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x0f, 0x45, 0x78, 0x61,
+	0x6d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x12, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x5d,
+	0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x2a, 0x0a,
+	0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x47, 0x0a, 0x18, 0x46, 0x69, 0x6e,
+	0x64, 0x45, 0x78, 0x61, 0x6d, 0x54, 0x61, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x22, 0x48, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x54, 0x61,
+	0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2b, 0x0a, 0x0a, 0x74, 0x61, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x67, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x09, 0x74, 0x61, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0xdd, 0x04, 0x0a,
+	0x08, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78,
+	0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61,
+	0x6d, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x26,
+	0x0a, 0x0f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61,
+	0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x43,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70,
+	0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x3e, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73,
+	0x52, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x48, 0x69, 0x6e, 0x74, 0x22, 0x2b, 0x0a, 0x0f,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x22, 0xc9, 0x02, 0x0a, 0x15, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x73, 0x6b, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x68, 0x69, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x73, 0x52, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x73, 0x22, 0x39, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x22, 0x96, 0x03, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61,
+	0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x61, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
+	0x69, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00,
+	0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x73, 0x52, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x53, 0x0a, 0x16, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4e,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3f,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x9c, 0x01, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x99,
+	0x01, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a,
+	0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x51, 0x0a, 0x15, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x18, 0x0a,
+	0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x88, 0x02, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61,
+	0x6c, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x6e,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x22, 0x64, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x86, 0x01, 0x0a, 0x1b, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49,
+	0x64, 0x12, 0x35, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x6f, 0x0a, 0x1c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70,
+	0x62, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x22, 0xbd, 0x02, 0x0a, 0x0b, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x5f, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xc3, 0x01, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61,
+	0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x22, 0xc9, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45,
+	0x78, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x55, 0x72,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x3a, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x18,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x1b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x0a,
+	0x17, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x18, 0x46, 0x69,
+	0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78,
+	0x61, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x78,
+	0x61, 0x6d, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x61, 0x6d, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x1a,
+	0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x22, 0x62,
+	0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x22, 0xbc, 0x01, 0x0a, 0x1b, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x64, 0x6f,
+	0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x65, 0x78, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x04, 0x65, 0x78, 0x61, 0x6d,
+	0x12, 0x2a, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x08,
+	0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x78, 0x61,
+	0x6d, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x61, 0x6d, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x49,
+	0x64, 0x22, 0xe3, 0x02, 0x0a, 0x0c, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x5f, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70,
+	0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5b, 0x0a, 0x0e, 0x45, 0x78, 0x61, 0x6d, 0x42,
+	0x61, 0x6e, 0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x05,
+	0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62,
+	0x2e, 0x45, 0x78, 0x61, 0x6d, 0x42, 0x61, 0x6e, 0x6b, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x05, 0x70,
+	0x6f, 0x6f, 0x6c, 0x73, 0x22, 0x34, 0x0a, 0x0c, 0x45, 0x78, 0x61, 0x6d, 0x42, 0x61, 0x6e, 0x6b,
+	0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xe9, 0x01, 0x0a, 0x19, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61,
+	0x6c, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x65,
+	0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x62, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x93,
+	0x02, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10,
+	0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x43,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70,
+	0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61,
+	0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x61,
+	0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x5e, 0x0a, 0x19,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x61, 0x6e,
+	0x6b, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x1c, 0x0a, 0x1a,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x18, 0x46,
+	0x69, 0x6e, 0x64, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x89,
+	0x01, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x37, 0x0a, 0x0e, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x42,
+	0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x62, 0x61, 0x6e,
+	0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x18, 0x53,
+	0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x42, 0x61, 0x6e, 0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x42, 0x61, 0x6e, 0x6b,
+	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x42,
+	0x61, 0x6e, 0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1c, 0x0a, 0x04, 0x65, 0x78, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x08, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x04, 0x65, 0x78, 0x61, 0x6d, 0x22,
+	0x8a, 0x04, 0x0a, 0x0a, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0f,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x78, 0x61, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x29, 0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x61,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70,
+	0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x15,
+	0x70, 0x61, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x70, 0x61, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x61, 0x72, 0x6e,
+	0x65, 0x64, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0xf3, 0x01, 0x0a,
+	0x11, 0x45, 0x78, 0x61, 0x6d, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x41, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x73, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x69, 0x73, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x68, 0x69, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x68, 0x69, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x62, 0x6c, 0x61, 0x6e, 0x6b, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x6c, 0x61, 0x6e, 0x6b, 0x41, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x73, 0x22, 0x8a, 0x02, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61,
+	0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x29, 0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x61,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70,
+	0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x65, 0x78, 0x61, 0x6d, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x61, 0x6d, 0x41, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04,
+	0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x12, 0x77,
+	0x72, 0x6f, 0x6e, 0x67, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x73, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x22,
+	0x77, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0b, 0x65,
+	0x78, 0x61, 0x6d, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x0a, 0x65, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x2a, 0x0a, 0x09,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x16, 0x46, 0x69, 0x6e,
+	0x64, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xa2, 0x01, 0x0a, 0x17, 0x46, 0x69,
+	0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x0c, 0x65, 0x78,
+	0x61, 0x6d, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x0b, 0x65, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x74,
+	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65,
+	0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x7a, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x0b, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78,
+	0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0a, 0x65, 0x78, 0x61, 0x6d, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x12, 0x2a, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0xc7, 0x02, 0x0a, 0x0b, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x57, 0x72, 0x6f, 0x6e, 0x67,
+	0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x78, 0x61, 0x6d, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x72,
+	0x72, 0x65, 0x63, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x22, 0x90, 0x01, 0x0a, 0x12, 0x45,
+	0x78, 0x61, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xcc, 0x02,
+	0x0a, 0x1d, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x78, 0x61, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x65, 0x78, 0x61, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x61, 0x6e, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x6f, 0x76, 0x69,
+	0x6e, 0x67, 0x5f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x41,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x89, 0x03, 0x0a,
+	0x1e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4f,
+	0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1c,
+	0x0a, 0x04, 0x65, 0x78, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70,
+	0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x04, 0x65, 0x78, 0x61, 0x6d, 0x12, 0x2a, 0x0a, 0x09,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x0d, 0x61, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x5f, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x57, 0x72, 0x6f, 0x6e, 0x67,
+	0x52, 0x0c, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x31,
+	0x0a, 0x0c, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x0b, 0x65, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x78, 0x61, 0x6d, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0d, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62,
+	0x2e, 0x45, 0x78, 0x61, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x52, 0x0c, 0x65, 0x78, 0x61, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x35, 0x0a, 0x09, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x09, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x22, 0xd8, 0x01, 0x0a, 0x13, 0x44, 0x61, 0x69,
+	0x6c, 0x79, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x62, 0x65, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x77,
+	0x6f, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x77, 0x6f, 0x72, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x41, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x22, 0x6b, 0x0a, 0x0d, 0x48, 0x6f, 0x75, 0x72, 0x4f, 0x66, 0x44, 0x61, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x22, 0xb8, 0x05, 0x0a, 0x13, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x62, 0x65, 0x73, 0x74,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x62, 0x65, 0x73, 0x74, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x73, 0x74, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x73,
+	0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x77, 0x6f, 0x72, 0x73, 0x74, 0x5f,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x73,
+	0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x65, 0x6e,
+	0x64, 0x5f, 0x73, 0x6c, 0x6f, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74,
+	0x72, 0x65, 0x6e, 0x64, 0x53, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x65,
+	0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x12,
+	0x2e, 0x0a, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6b, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x44, 0x61, 0x79, 0x73, 0x12,
+	0x2e, 0x0a, 0x13, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6b, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6c, 0x6f,
+	0x6e, 0x67, 0x65, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x44, 0x61, 0x79, 0x73, 0x12,
+	0x32, 0x0a, 0x15, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13,
+	0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x38, 0x0a, 0x0b, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x61,
+	0x69, 0x6c, 0x79, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x52, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x3c, 0x0a,
+	0x11, 0x68, 0x6f, 0x75, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x64, 0x61, 0x79, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x6f,
+	0x75, 0x72, 0x4f, 0x66, 0x44, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x52, 0x0e, 0x68, 0x6f, 0x75,
+	0x72, 0x4f, 0x66, 0x44, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x08,
+	0x45, 0x78, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x22, 0x60, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d,
+	0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x44, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78,
+	0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2b, 0x0a, 0x0a, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x09, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0xc3, 0x02, 0x0a,
+	0x0f, 0x45, 0x78, 0x61, 0x6d, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x43,
+	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x73,
+	0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f,
+	0x72, 0x74, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x79, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x43, 0x61, 0x74, 0x61,
+	0x6c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x60, 0x0a, 0x0c, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa7, 0x02,
+	0x0a, 0x05, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x08, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x63, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x13,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x48, 0x0a, 0x10, 0x4a,
+	0x6f, 0x69, 0x6e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x11, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2d, 0x0a, 0x12, 0x46, 0x69, 0x6e,
+	0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x23, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x07, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x65, 0x73, 0x22, 0xd4, 0x01, 0x0a, 0x0a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x31, 0x0a,
+	0x06, 0x64, 0x75, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x64, 0x75, 0x65, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xaf, 0x01, 0x0a, 0x17,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x31, 0x0a, 0x06, 0x64, 0x75, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x64,
+	0x75, 0x65, 0x41, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3f, 0x0a,
+	0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x4c,
+	0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x17,
+	0x46, 0x69, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
+	0x62, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xb1, 0x02, 0x0a, 0x0a, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x62, 0x65, 0x73, 0x74,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08,
+	0x04, 0x10, 0x05, 0x52, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x52,
+	0x0c, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x4f, 0x0a,
+	0x19, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x47, 0x72, 0x61, 0x64, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x97,
+	0x01, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
+	0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70,
+	0x62, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x30,
+	0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x26, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x10, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x22, 0x4d, 0x0a, 0x1b, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x22, 0xde, 0x01, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x73, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x31, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x63, 0x72, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x64,
+	0x69, 0x73, 0x63, 0x72, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x22, 0x47, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xbf, 0x02, 0x0a, 0x15,
+	0x45, 0x78, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x65, 0x61,
+	0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0e, 0x6d, 0x65, 0x61, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12,
+	0x4c, 0x0a, 0x17, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x16, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08,
+	0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x52,
+	0x0a, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x0c, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x12, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a,
+	0x1d, 0x46, 0x69, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x9d, 0x01, 0x0a, 0x1e, 0x46, 0x69, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x46, 0x0a, 0x13, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x52, 0x12, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x22, 0x93, 0x01, 0x0a, 0x14, 0x4d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x4e, 0x6f, 0x74, 0x65,
+	0x62, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x32, 0x0a, 0x0c, 0x61, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x5f, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x57, 0x72, 0x6f, 0x6e, 0x67,
+	0x52, 0x0b, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x12, 0x28, 0x0a,
+	0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x61, 0x6d, 0x5f,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x61,
+	0x6d, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x22, 0xfc, 0x01, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x4d,
+	0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x1b, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x69,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62,
+	0x2e, 0x4d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x61,
+	0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x44,
+	0x72, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x22, 0x88, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x44, 0x72, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x32, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x4e, 0x6f,
+	0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x43,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x22, 0x93, 0x01, 0x0a,
+	0x19, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x72,
+	0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x5f, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x57, 0x72, 0x6f, 0x6e, 0x67,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x62,
+	0x6c, 0x61, 0x6e, 0x6b, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0c, 0x62, 0x6c, 0x61, 0x6e, 0x6b, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73,
+	0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x0a, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x63, 0x74, 0x22, 0xa5, 0x01, 0x0a, 0x1a, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x4d, 0x69, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x44, 0x72, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x63, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x61, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x72, 0x72,
+	0x65, 0x63, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x32, 0xf7, 0x1c, 0x0a, 0x0b, 0x45,
+	0x78, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70,
+	0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x12,
+	0x12, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x46, 0x69, 0x6e, 0x64,
+	0x45, 0x78, 0x61, 0x6d, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45,
+	0x78, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d,
+	0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x53, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63,
+	0x65, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d,
+	0x73, 0x42, 0x79, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x45, 0x78, 0x61, 0x6d, 0x73, 0x42, 0x79, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61,
+	0x6d, 0x73, 0x42, 0x79, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x50, 0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x54, 0x61, 0x67, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45,
+	0x78, 0x61, 0x6d, 0x54, 0x61, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61,
+	0x6d, 0x54, 0x61, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x46, 0x6f, 0x72, 0x6b, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x13,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x45, 0x78, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x78, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x78,
+	0x61, 0x6d, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45,
+	0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e,
+	0x55, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45,
+	0x78, 0x61, 0x6d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x47, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x46, 0x69,
+	0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x47, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x14, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x75, 0x74, 0x61,
+	0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x75, 0x74,
+	0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x62,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x12,
+	0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69,
+	0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x51, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x53, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x70,
+	0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6e,
+	0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6e, 0x6b,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x50, 0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x42,
+	0x61, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x61, 0x6e,
+	0x6b, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x42, 0x61, 0x6e,
+	0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74,
+	0x45, 0x78, 0x61, 0x6d, 0x42, 0x61, 0x6e, 0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x78,
+	0x61, 0x6d, 0x42, 0x61, 0x6e, 0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78,
+	0x61, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x45, 0x78, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x70, 0x62,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e,
+	0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x46, 0x69,
+	0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64,
+	0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1e, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x16,
+	0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4f, 0x76,
+	0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4f, 0x76, 0x65,
+	0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a,
+	0x13, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78,
+	0x61, 0x6d, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78,
+	0x61, 0x6d, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12,
+	0x21, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x69,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x1e, 0x2e,
+	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x4e, 0x6f,
+	0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x4e, 0x6f,
+	0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53,
+	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x44,
+	0x72, 0x69, 0x6c, 0x6c, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x72, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
+	0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x72, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x4d, 0x69, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x44, 0x72, 0x69, 0x6c, 0x6c, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x72, 0x69, 0x6c,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x44, 0x72, 0x69, 0x6c, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x64,
+	0x45, 0x78, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61,
+	0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a,
+	0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f,
+	0x67, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x43,
+	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x43, 0x61, 0x74, 0x61, 0x6c,
+	0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x4a, 0x6f,
+	0x69, 0x6e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x69,
+	0x6e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x53, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x47, 0x72, 0x61, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x47, 0x72, 0x61, 0x64, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_exam_service_proto_rawDescOnce sync.Once
+	file_exam_service_proto_rawDescData = file_exam_service_proto_rawDesc
+)
+
+func file_exam_service_proto_rawDescGZIP() []byte {
+	file_exam_service_proto_rawDescOnce.Do(func() {
+		file_exam_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_exam_service_proto_rawDescData)
+	})
+	return file_exam_service_proto_rawDescData
+}
+
+var file_exam_service_proto_msgTypes = make([]protoimpl.MessageInfo, 130)
+var file_exam_service_proto_goTypes = []interface{}{
+	(*Exam)(nil),                           // 0: pb.Exam
+	(*ExamSettings)(nil),                   // 1: pb.ExamSettings
+	(*CreateExamRequest)(nil),              // 2: pb.CreateExamRequest
+	(*CreateExamResponse)(nil),             // 3: pb.CreateExamResponse
+	(*UpdateExamRequest)(nil),              // 4: pb.UpdateExamRequest
+	(*UpdateExamResponse)(nil),             // 5: pb.UpdateExamResponse
+	(*GetExamRequest)(nil),                 // 6: pb.GetExamRequest
+	(*GetExamResponse)(nil),                // 7: pb.GetExamResponse
+	(*FindExamsRequest)(nil),               // 8: pb.FindExamsRequest
+	(*FindExamsResponse)(nil),              // 9: pb.FindExamsResponse
+	(*DeleteExamRequest)(nil),              // 10: pb.DeleteExamRequest
+	(*DeleteExamResponse)(nil),             // 11: pb.DeleteExamResponse
+	(*PracticeWord)(nil),                   // 12: pb.PracticeWord
+	(*CreatePracticeExamRequest)(nil),      // 13: pb.CreatePracticeExamRequest
+	(*CreatePracticeExamResponse)(nil),     // 14: pb.CreatePracticeExamResponse
+	(*FindExamsByTagsRequest)(nil),         // 15: pb.FindExamsByTagsRequest
+	(*FindExamsByTagsResponse)(nil),        // 16: pb.FindExamsByTagsResponse
+	(*TagCount)(nil),                       // 17: pb.TagCount
+	(*ForkExamRequest)(nil),                // 18: pb.ForkExamRequest
+	(*ForkExamResponse)(nil),               // 19: pb.ForkExamResponse
+	(*PublishExamRequest)(nil),             // 20: pb.PublishExamRequest
+	(*PublishExamResponse)(nil),            // 21: pb.PublishExamResponse
+	(*ExamShare)(nil),                      // 22: pb.ExamShare
+	(*ShareExamRequest)(nil),               // 23: pb.ShareExamRequest
+	(*ShareExamResponse)(nil),              // 24: pb.ShareExamResponse
+	(*UnshareExamRequest)(nil),             // 25: pb.UnshareExamRequest
+	(*UnshareExamResponse)(nil),            // 26: pb.UnshareExamResponse
+	(*FindExamSharesRequest)(nil),          // 27: pb.FindExamSharesRequest
+	(*FindExamSharesResponse)(nil),         // 28: pb.FindExamSharesResponse
+	(*ImportExamRequest)(nil),              // 29: pb.ImportExamRequest
+	(*ExamImportError)(nil),                // 30: pb.ExamImportError
+	(*ImportExamResponse)(nil),             // 31: pb.ImportExamResponse
+	(*ExportExamRequest)(nil),              // 32: pb.ExportExamRequest
+	(*ExportExamResponse)(nil),             // 33: pb.ExportExamResponse
+	(*FindExamTagCountsRequest)(nil),       // 34: pb.FindExamTagCountsRequest
+	(*FindExamTagCountsResponse)(nil),      // 35: pb.FindExamTagCountsResponse
+	(*Question)(nil),                       // 36: pb.Question
+	(*AcceptedAnswers)(nil),                // 37: pb.AcceptedAnswers
+	(*CreateQuestionRequest)(nil),          // 38: pb.CreateQuestionRequest
+	(*CreateQuestionResponse)(nil),         // 39: pb.CreateQuestionResponse
+	(*UpdateQuestionRequest)(nil),          // 40: pb.UpdateQuestionRequest
+	(*UpdateQuestionResponse)(nil),         // 41: pb.UpdateQuestionResponse
+	(*GetQuestionRequest)(nil),             // 42: pb.GetQuestionRequest
+	(*GetQuestionResponse)(nil),            // 43: pb.GetQuestionResponse
+	(*FindQuestionsRequest)(nil),           // 44: pb.FindQuestionsRequest
+	(*FindQuestionsResponse)(nil),          // 45: pb.FindQuestionsResponse
+	(*DeleteQuestionRequest)(nil),          // 46: pb.DeleteQuestionRequest
+	(*DeleteQuestionResponse)(nil),         // 47: pb.DeleteQuestionResponse
+	(*QuestionOperation)(nil),              // 48: pb.QuestionOperation
+	(*QuestionOperationResult)(nil),        // 49: pb.QuestionOperationResult
+	(*BatchMutateQuestionsRequest)(nil),    // 50: pb.BatchMutateQuestionsRequest
+	(*BatchMutateQuestionsResponse)(nil),   // 51: pb.BatchMutateQuestionsResponse
+	(*ExamSection)(nil),                    // 52: pb.ExamSection
+	(*CreateExamSectionRequest)(nil),       // 53: pb.CreateExamSectionRequest
+	(*CreateExamSectionResponse)(nil),      // 54: pb.CreateExamSectionResponse
+	(*UpdateExamSectionRequest)(nil),       // 55: pb.UpdateExamSectionRequest
+	(*UpdateExamSectionResponse)(nil),      // 56: pb.UpdateExamSectionResponse
+	(*DeleteExamSectionRequest)(nil),       // 57: pb.DeleteExamSectionRequest
+	(*DeleteExamSectionResponse)(nil),      // 58: pb.DeleteExamSectionResponse
+	(*FindExamSectionsRequest)(nil),        // 59: pb.FindExamSectionsRequest
+	(*FindExamSectionsResponse)(nil),       // 60: pb.FindExamSectionsResponse
+	(*RevealQuestionHintRequest)(nil),      // 61: pb.RevealQuestionHintRequest
+	(*RevealQuestionHintResponse)(nil),     // 62: pb.RevealQuestionHintResponse
+	(*FindRandomQuestionsRequest)(nil),     // 63: pb.FindRandomQuestionsRequest
+	(*FindRandomQuestionsResponse)(nil),    // 64: pb.FindRandomQuestionsResponse
+	(*BankQuestion)(nil),                   // 65: pb.BankQuestion
+	(*ExamBankSource)(nil),                 // 66: pb.ExamBankSource
+	(*ExamBankPool)(nil),                   // 67: pb.ExamBankPool
+	(*CreateBankQuestionRequest)(nil),      // 68: pb.CreateBankQuestionRequest
+	(*CreateBankQuestionResponse)(nil),     // 69: pb.CreateBankQuestionResponse
+	(*UpdateBankQuestionRequest)(nil),      // 70: pb.UpdateBankQuestionRequest
+	(*UpdateBankQuestionResponse)(nil),     // 71: pb.UpdateBankQuestionResponse
+	(*DeleteBankQuestionRequest)(nil),      // 72: pb.DeleteBankQuestionRequest
+	(*DeleteBankQuestionResponse)(nil),     // 73: pb.DeleteBankQuestionResponse
+	(*FindBankQuestionsRequest)(nil),       // 74: pb.FindBankQuestionsRequest
+	(*FindBankQuestionsResponse)(nil),      // 75: pb.FindBankQuestionsResponse
+	(*SetExamBankSourceRequest)(nil),       // 76: pb.SetExamBankSourceRequest
+	(*SetExamBankSourceResponse)(nil),      // 77: pb.SetExamBankSourceResponse
+	(*ExamRecord)(nil),                     // 78: pb.ExamRecord
+	(*ExamAttemptAnswer)(nil),              // 79: pb.ExamAttemptAnswer
+	(*CreateExamRecordRequest)(nil),        // 80: pb.CreateExamRecordRequest
+	(*CreateExamRecordResponse)(nil),       // 81: pb.CreateExamRecordResponse
+	(*FindExamRecordsRequest)(nil),         // 82: pb.FindExamRecordsRequest
+	(*FindExamRecordsResponse)(nil),        // 83: pb.FindExamRecordsResponse
+	(*GetExamRecordDetailRequest)(nil),     // 84: pb.GetExamRecordDetailRequest
+	(*GetExamRecordDetailResponse)(nil),    // 85: pb.GetExamRecordDetailResponse
+	(*AnswerWrong)(nil),                    // 86: pb.AnswerWrong
+	(*ExamVersionSummary)(nil),             // 87: pb.ExamVersionSummary
+	(*FindExamRecordOverviewRequest)(nil),  // 88: pb.FindExamRecordOverviewRequest
+	(*FindExamRecordOverviewResponse)(nil), // 89: pb.FindExamRecordOverviewResponse
+	(*DailyExamRecordStat)(nil),            // 90: pb.DailyExamRecordStat
+	(*HourOfDayStat)(nil),                  // 91: pb.HourOfDayStat
+	(*ExamRecordAnalytics)(nil),            // 92: pb.ExamRecordAnalytics
+	(*ExamInfo)(nil),                       // 93: pb.ExamInfo
+	(*FindExamInfosRequest)(nil),           // 94: pb.FindExamInfosRequest
+	(*FindExamInfosResponse)(nil),          // 95: pb.FindExamInfosResponse
+	(*ExamCatalogItem)(nil),                // 96: pb.ExamCatalogItem
+	(*FindExamCatalogRequest)(nil),         // 97: pb.FindExamCatalogRequest
+	(*FindExamCatalogResponse)(nil),        // 98: pb.FindExamCatalogResponse
+	(*ClassStudent)(nil),                   // 99: pb.ClassStudent
+	(*Class)(nil),                          // 100: pb.Class
+	(*CreateClassRequest)(nil),             // 101: pb.CreateClassRequest
+	(*CreateClassResponse)(nil),            // 102: pb.CreateClassResponse
+	(*JoinClassRequest)(nil),               // 103: pb.JoinClassRequest
+	(*JoinClassResponse)(nil),              // 104: pb.JoinClassResponse
+	(*FindClassesRequest)(nil),             // 105: pb.FindClassesRequest
+	(*FindClassesResponse)(nil),            // 106: pb.FindClassesResponse
+	(*Assignment)(nil),                     // 107: pb.Assignment
+	(*CreateAssignmentRequest)(nil),        // 108: pb.CreateAssignmentRequest
+	(*CreateAssignmentResponse)(nil),       // 109: pb.CreateAssignmentResponse
+	(*FindAssignmentsRequest)(nil),         // 110: pb.FindAssignmentsRequest
+	(*FindAssignmentsResponse)(nil),        // 111: pb.FindAssignmentsResponse
+	(*ClassGrade)(nil),                     // 112: pb.ClassGrade
+	(*FindClassGradebookRequest)(nil),      // 113: pb.FindClassGradebookRequest
+	(*FindClassGradebookResponse)(nil),     // 114: pb.FindClassGradebookResponse
+	(*LeaderboardEntry)(nil),               // 115: pb.LeaderboardEntry
+	(*FindExamLeaderboardRequest)(nil),     // 116: pb.FindExamLeaderboardRequest
+	(*FindExamLeaderboardResponse)(nil),    // 117: pb.FindExamLeaderboardResponse
+	(*QuestionStatistic)(nil),              // 118: pb.QuestionStatistic
+	(*PercentageCount)(nil),                // 119: pb.PercentageCount
+	(*ExamStatisticsSummary)(nil),          // 120: pb.ExamStatisticsSummary
+	(*FindQuestionStatisticsRequest)(nil),  // 121: pb.FindQuestionStatisticsRequest
+	(*FindQuestionStatisticsResponse)(nil), // 122: pb.FindQuestionStatisticsResponse
+	(*MistakeNotebookEntry)(nil),           // 123: pb.MistakeNotebookEntry
+	(*FindMistakeNotebookRequest)(nil),     // 124: pb.FindMistakeNotebookRequest
+	(*FindMistakeNotebookResponse)(nil),    // 125: pb.FindMistakeNotebookResponse
+	(*CreateMistakeDrillRequest)(nil),      // 126: pb.CreateMistakeDrillRequest
+	(*CreateMistakeDrillResponse)(nil),     // 127: pb.CreateMistakeDrillResponse
+	(*AnswerMistakeDrillRequest)(nil),      // 128: pb.AnswerMistakeDrillRequest
+	(*AnswerMistakeDrillResponse)(nil),     // 129: pb.AnswerMistakeDrillResponse
+	(*timestamppb.Timestamp)(nil),          // 130: google.protobuf.Timestamp
+}
+var file_exam_service_proto_depIdxs = []int32{
+	130, // 0: pb.Exam.created_at:type_name -> google.protobuf.Timestamp
+	130, // 1: pb.Exam.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 2: pb.Exam.settings:type_name -> pb.ExamSettings
+	66,  // 3: pb.Exam.bank_source:type_name -> pb.ExamBankSource
+	1,   // 4: pb.UpdateExamRequest.settings:type_name -> pb.ExamSettings
+	0,   // 5: pb.GetExamResponse.exam:type_name -> pb.Exam
+	0,   // 6: pb.FindExamsResponse.exams:type_name -> pb.Exam
+	12,  // 7: pb.CreatePracticeExamRequest.practice_words:type_name -> pb.PracticeWord
+	0,   // 8: pb.FindExamsByTagsResponse.exams:type_name -> pb.Exam
+	130, // 9: pb.ExamShare.created_at:type_name -> google.protobuf.Timestamp
+	22,  // 10: pb.FindExamSharesResponse.shares:type_name -> pb.ExamShare
+	30,  // 11: pb.ImportExamResponse.errors:type_name -> pb.ExamImportError
+	17,  // 12: pb.FindExamTagCountsResponse.tag_counts:type_name -> pb.TagCount
+	130, // 13: pb.Question.created_at:type_name -> google.protobuf.Timestamp
+	130, // 14: pb.Question.updated_at:type_name -> google.protobuf.Timestamp
+	37,  // 15: pb.Question.accepted_answers:type_name -> pb.AcceptedAnswers
+	37,  // 16: pb.CreateQuestionRequest.accepted_answers:type_name -> pb.AcceptedAnswers
+	37,  // 17: pb.UpdateQuestionRequest.accepted_answers:type_name -> pb.AcceptedAnswers
+	36,  // 18: pb.GetQuestionResponse.question:type_name -> pb.Question
+	36,  // 19: pb.FindQuestionsResponse.questions:type_name -> pb.Question
+	48,  // 20: pb.BatchMutateQuestionsRequest.operations:type_name -> pb.QuestionOperation
+	49,  // 21: pb.BatchMutateQuestionsResponse.results:type_name -> pb.QuestionOperationResult
+	130, // 22: pb.ExamSection.created_at:type_name -> google.protobuf.Timestamp
+	130, // 23: pb.ExamSection.updated_at:type_name -> google.protobuf.Timestamp
+	52,  // 24: pb.FindExamSectionsResponse.sections:type_name -> pb.ExamSection
+	0,   // 25: pb.FindRandomQuestionsResponse.exam:type_name -> pb.Exam
+	36,  // 26: pb.FindRandomQuestionsResponse.questions:type_name -> pb.Question
+	52,  // 27: pb.FindRandomQuestionsResponse.sections:type_name -> pb.ExamSection
+	130, // 28: pb.BankQuestion.created_at:type_name -> google.protobuf.Timestamp
+	130, // 29: pb.BankQuestion.updated_at:type_name -> google.protobuf.Timestamp
+	67,  // 30: pb.ExamBankSource.pools:type_name -> pb.ExamBankPool
+	65,  // 31: pb.FindBankQuestionsResponse.bank_questions:type_name -> pb.BankQuestion
+	67,  // 32: pb.SetExamBankSourceRequest.pools:type_name -> pb.ExamBankPool
+	0,   // 33: pb.SetExamBankSourceResponse.exam:type_name -> pb.Exam
+	130, // 34: pb.ExamRecord.created_at:type_name -> google.protobuf.Timestamp
+	130, // 35: pb.ExamRecord.updated_at:type_name -> google.protobuf.Timestamp
+	79,  // 36: pb.ExamRecord.answers:type_name -> pb.ExamAttemptAnswer
+	79,  // 37: pb.CreateExamRecordRequest.answers:type_name -> pb.ExamAttemptAnswer
+	78,  // 38: pb.CreateExamRecordResponse.exam_record:type_name -> pb.ExamRecord
+	36,  // 39: pb.CreateExamRecordResponse.questions:type_name -> pb.Question
+	78,  // 40: pb.FindExamRecordsResponse.exam_records:type_name -> pb.ExamRecord
+	78,  // 41: pb.GetExamRecordDetailResponse.exam_record:type_name -> pb.ExamRecord
+	36,  // 42: pb.GetExamRecordDetailResponse.questions:type_name -> pb.Question
+	130, // 43: pb.AnswerWrong.created_at:type_name -> google.protobuf.Timestamp
+	130, // 44: pb.AnswerWrong.updated_at:type_name -> google.protobuf.Timestamp
+	130, // 45: pb.ExamVersionSummary.created_at:type_name -> google.protobuf.Timestamp
+	130, // 46: pb.FindExamRecordOverviewRequest.start_date:type_name -> google.protobuf.Timestamp
+	130, // 47: pb.FindExamRecordOverviewRequest.end_date:type_name -> google.protobuf.Timestamp
+	0,   // 48: pb.FindExamRecordOverviewResponse.exam:type_name -> pb.Exam
+	36,  // 49: pb.FindExamRecordOverviewResponse.questions:type_name -> pb.Question
+	86,  // 50: pb.FindExamRecordOverviewResponse.answer_wrongs:type_name -> pb.AnswerWrong
+	78,  // 51: pb.FindExamRecordOverviewResponse.exam_records:type_name -> pb.ExamRecord
+	87,  // 52: pb.FindExamRecordOverviewResponse.exam_versions:type_name -> pb.ExamVersionSummary
+	92,  // 53: pb.FindExamRecordOverviewResponse.analytics:type_name -> pb.ExamRecordAnalytics
+	130, // 54: pb.ExamRecordAnalytics.best_score_at:type_name -> google.protobuf.Timestamp
+	130, // 55: pb.ExamRecordAnalytics.worst_score_at:type_name -> google.protobuf.Timestamp
+	90,  // 56: pb.ExamRecordAnalytics.daily_stats:type_name -> pb.DailyExamRecordStat
+	91,  // 57: pb.ExamRecordAnalytics.hour_of_day_stats:type_name -> pb.HourOfDayStat
+	93,  // 58: pb.FindExamInfosResponse.exam_infos:type_name -> pb.ExamInfo
+	130, // 59: pb.ExamCatalogItem.updated_at:type_name -> google.protobuf.Timestamp
+	96,  // 60: pb.FindExamCatalogResponse.items:type_name -> pb.ExamCatalogItem
+	130, // 61: pb.ClassStudent.joined_at:type_name -> google.protobuf.Timestamp
+	99,  // 62: pb.Class.students:type_name -> pb.ClassStudent
+	130, // 63: pb.Class.created_at:type_name -> google.protobuf.Timestamp
+	130, // 64: pb.Class.updated_at:type_name -> google.protobuf.Timestamp
+	100, // 65: pb.FindClassesResponse.classes:type_name -> pb.Class
+	130, // 66: pb.Assignment.due_at:type_name -> google.protobuf.Timestamp
+	130, // 67: pb.Assignment.created_at:type_name -> google.protobuf.Timestamp
+	130, // 68: pb.CreateAssignmentRequest.due_at:type_name -> google.protobuf.Timestamp
+	107, // 69: pb.FindAssignmentsResponse.assignments:type_name -> pb.Assignment
+	130, // 70: pb.ClassGrade.latest_created_at:type_name -> google.protobuf.Timestamp
+	100, // 71: pb.FindClassGradebookResponse.class:type_name -> pb.Class
+	107, // 72: pb.FindClassGradebookResponse.assignments:type_name -> pb.Assignment
+	112, // 73: pb.FindClassGradebookResponse.grades:type_name -> pb.ClassGrade
+	130, // 74: pb.LeaderboardEntry.created_at:type_name -> google.protobuf.Timestamp
+	115, // 75: pb.FindExamLeaderboardResponse.entries:type_name -> pb.LeaderboardEntry
+	119, // 76: pb.ExamStatisticsSummary.percentage_distribution:type_name -> pb.PercentageCount
+	120, // 77: pb.FindQuestionStatisticsResponse.summary:type_name -> pb.ExamStatisticsSummary
+	118, // 78: pb.FindQuestionStatisticsResponse.question_statistics:type_name -> pb.QuestionStatistic
+	86,  // 79: pb.MistakeNotebookEntry.answer_wrong:type_name -> pb.AnswerWrong
+	36,  // 80: pb.MistakeNotebookEntry.question:type_name -> pb.Question
+	130, // 81: pb.FindMistakeNotebookRequest.start_date:type_name -> google.protobuf.Timestamp
+	130, // 82: pb.FindMistakeNotebookRequest.end_date:type_name -> google.protobuf.Timestamp
+	123, // 83: pb.FindMistakeNotebookResponse.entries:type_name -> pb.MistakeNotebookEntry
+	123, // 84: pb.CreateMistakeDrillResponse.entries:type_name -> pb.MistakeNotebookEntry
+	2,   // 85: pb.ExamService.CreateExam:input_type -> pb.CreateExamRequest
+	4,   // 86: pb.ExamService.UpdateExam:input_type -> pb.UpdateExamRequest
+	6,   // 87: pb.ExamService.GetExam:input_type -> pb.GetExamRequest
+	8,   // 88: pb.ExamService.FindExams:input_type -> pb.FindExamsRequest
+	10,  // 89: pb.ExamService.DeleteExam:input_type -> pb.DeleteExamRequest
+	13,  // 90: pb.ExamService.CreatePracticeExam:input_type -> pb.CreatePracticeExamRequest
+	15,  // 91: pb.ExamService.FindExamsByTags:input_type -> pb.FindExamsByTagsRequest
+	34,  // 92: pb.ExamService.FindExamTagCounts:input_type -> pb.FindExamTagCountsRequest
+	18,  // 93: pb.ExamService.ForkExam:input_type -> pb.ForkExamRequest
+	20,  // 94: pb.ExamService.PublishExam:input_type -> pb.PublishExamRequest
+	23,  // 95: pb.ExamService.ShareExam:input_type -> pb.ShareExamRequest
+	25,  // 96: pb.ExamService.UnshareExam:input_type -> pb.UnshareExamRequest
+	27,  // 97: pb.ExamService.FindExamShares:input_type -> pb.FindExamSharesRequest
+	29,  // 98: pb.ExamService.ImportExam:input_type -> pb.ImportExamRequest
+	32,  // 99: pb.ExamService.ExportExam:input_type -> pb.ExportExamRequest
+	38,  // 100: pb.ExamService.CreateQuestion:input_type -> pb.CreateQuestionRequest
+	40,  // 101: pb.ExamService.UpdateQuestion:input_type -> pb.UpdateQuestionRequest
+	42,  // 102: pb.ExamService.GetQuestion:input_type -> pb.GetQuestionRequest
+	44,  // 103: pb.ExamService.FindQuestions:input_type -> pb.FindQuestionsRequest
+	46,  // 104: pb.ExamService.DeleteQuestion:input_type -> pb.DeleteQuestionRequest
+	50,  // 105: pb.ExamService.BatchMutateQuestions:input_type -> pb.BatchMutateQuestionsRequest
+	63,  // 106: pb.ExamService.FindRandomQuestions:input_type -> pb.FindRandomQuestionsRequest
+	61,  // 107: pb.ExamService.RevealQuestionHint:input_type -> pb.RevealQuestionHintRequest
+	68,  // 108: pb.ExamService.CreateBankQuestion:input_type -> pb.CreateBankQuestionRequest
+	70,  // 109: pb.ExamService.UpdateBankQuestion:input_type -> pb.UpdateBankQuestionRequest
+	72,  // 110: pb.ExamService.DeleteBankQuestion:input_type -> pb.DeleteBankQuestionRequest
+	74,  // 111: pb.ExamService.FindBankQuestions:input_type -> pb.FindBankQuestionsRequest
+	76,  // 112: pb.ExamService.SetExamBankSource:input_type -> pb.SetExamBankSourceRequest
+	53,  // 113: pb.ExamService.CreateExamSection:input_type -> pb.CreateExamSectionRequest
+	55,  // 114: pb.ExamService.UpdateExamSection:input_type -> pb.UpdateExamSectionRequest
+	57,  // 115: pb.ExamService.DeleteExamSection:input_type -> pb.DeleteExamSectionRequest
+	59,  // 116: pb.ExamService.FindExamSections:input_type -> pb.FindExamSectionsRequest
+	80,  // 117: pb.ExamService.CreateExamRecord:input_type -> pb.CreateExamRecordRequest
+	82,  // 118: pb.ExamService.FindExamRecords:input_type -> pb.FindExamRecordsRequest
+	84,  // 119: pb.ExamService.GetExamRecordDetail:input_type -> pb.GetExamRecordDetailRequest
+	88,  // 120: pb.ExamService.FindExamRecordOverview:input_type -> pb.FindExamRecordOverviewRequest
+	116, // 121: pb.ExamService.FindExamLeaderboard:input_type -> pb.FindExamLeaderboardRequest
+	121, // 122: pb.ExamService.FindQuestionStatistics:input_type -> pb.FindQuestionStatisticsRequest
+	124, // 123: pb.ExamService.FindMistakeNotebook:input_type -> pb.FindMistakeNotebookRequest
+	126, // 124: pb.ExamService.CreateMistakeDrill:input_type -> pb.CreateMistakeDrillRequest
+	128, // 125: pb.ExamService.AnswerMistakeDrill:input_type -> pb.AnswerMistakeDrillRequest
+	94,  // 126: pb.ExamService.FindExamInfos:input_type -> pb.FindExamInfosRequest
+	97,  // 127: pb.ExamService.FindExamCatalog:input_type -> pb.FindExamCatalogRequest
+	101, // 128: pb.ExamService.CreateClass:input_type -> pb.CreateClassRequest
+	103, // 129: pb.ExamService.JoinClass:input_type -> pb.JoinClassRequest
+	105, // 130: pb.ExamService.FindClasses:input_type -> pb.FindClassesRequest
+	108, // 131: pb.ExamService.CreateAssignment:input_type -> pb.CreateAssignmentRequest
+	110, // 132: pb.ExamService.FindAssignments:input_type -> pb.FindAssignmentsRequest
+	113, // 133: pb.ExamService.FindClassGradebook:input_type -> pb.FindClassGradebookRequest
+	3,   // 134: pb.ExamService.CreateExam:output_type -> pb.CreateExamResponse
+	5,   // 135: pb.ExamService.UpdateExam:output_type -> pb.UpdateExamResponse
+	7,   // 136: pb.ExamService.GetExam:output_type -> pb.GetExamResponse
+	9,   // 137: pb.ExamService.FindExams:output_type -> pb.FindExamsResponse
+	11,  // 138: pb.ExamService.DeleteExam:output_type -> pb.DeleteExamResponse
+	14,  // 139: pb.ExamService.CreatePracticeExam:output_type -> pb.CreatePracticeExamResponse
+	16,  // 140: pb.ExamService.FindExamsByTags:output_type -> pb.FindExamsByTagsResponse
+	35,  // 141: pb.ExamService.FindExamTagCounts:output_type -> pb.FindExamTagCountsResponse
+	19,  // 142: pb.ExamService.ForkExam:output_type -> pb.ForkExamResponse
+	21,  // 143: pb.ExamService.PublishExam:output_type -> pb.PublishExamResponse
+	24,  // 144: pb.ExamService.ShareExam:output_type -> pb.ShareExamResponse
+	26,  // 145: pb.ExamService.UnshareExam:output_type -> pb.UnshareExamResponse
+	28,  // 146: pb.ExamService.FindExamShares:output_type -> pb.FindExamSharesResponse
+	31,  // 147: pb.ExamService.ImportExam:output_type -> pb.ImportExamResponse
+	33,  // 148: pb.ExamService.ExportExam:output_type -> pb.ExportExamResponse
+	39,  // 149: pb.ExamService.CreateQuestion:output_type -> pb.CreateQuestionResponse
+	41,  // 150: pb.ExamService.UpdateQuestion:output_type -> pb.UpdateQuestionResponse
+	43,  // 151: pb.ExamService.GetQuestion:output_type -> pb.GetQuestionResponse
+	45,  // 152: pb.ExamService.FindQuestions:output_type -> pb.FindQuestionsResponse
+	47,  // 153: pb.ExamService.DeleteQuestion:output_type -> pb.DeleteQuestionResponse
+	51,  // 154: pb.ExamService.BatchMutateQuestions:output_type -> pb.BatchMutateQuestionsResponse
+	64,  // 155: pb.ExamService.FindRandomQuestions:output_type -> pb.FindRandomQuestionsResponse
+	62,  // 156: pb.ExamService.RevealQuestionHint:output_type -> pb.RevealQuestionHintResponse
+	69,  // 157: pb.ExamService.CreateBankQuestion:output_type -> pb.CreateBankQuestionResponse
+	71,  // 158: pb.ExamService.UpdateBankQuestion:output_type -> pb.UpdateBankQuestionResponse
+	73,  // 159: pb.ExamService.DeleteBankQuestion:output_type -> pb.DeleteBankQuestionResponse
+	75,  // 160: pb.ExamService.FindBankQuestions:output_type -> pb.FindBankQuestionsResponse
+	77,  // 161: pb.ExamService.SetExamBankSource:output_type -> pb.SetExamBankSourceResponse
+	54,  // 162: pb.ExamService.CreateExamSection:output_type -> pb.CreateExamSectionResponse
+	56,  // 163: pb.ExamService.UpdateExamSection:output_type -> pb.UpdateExamSectionResponse
+	58,  // 164: pb.ExamService.DeleteExamSection:output_type -> pb.DeleteExamSectionResponse
+	60,  // 165: pb.ExamService.FindExamSections:output_type -> pb.FindExamSectionsResponse
+	81,  // 166: pb.ExamService.CreateExamRecord:output_type -> pb.CreateExamRecordResponse
+	83,  // 167: pb.ExamService.FindExamRecords:output_type -> pb.FindExamRecordsResponse
+	85,  // 168: pb.ExamService.GetExamRecordDetail:output_type -> pb.GetExamRecordDetailResponse
+	89,  // 169: pb.ExamService.FindExamRecordOverview:output_type -> pb.FindExamRecordOverviewResponse
+	117, // 170: pb.ExamService.FindExamLeaderboard:output_type -> pb.FindExamLeaderboardResponse
+	122, // 171: pb.ExamService.FindQuestionStatistics:output_type -> pb.FindQuestionStatisticsResponse
+	125, // 172: pb.ExamService.FindMistakeNotebook:output_type -> pb.FindMistakeNotebookResponse
+	127, // 173: pb.ExamService.CreateMistakeDrill:output_type -> pb.CreateMistakeDrillResponse
+	129, // 174: pb.ExamService.AnswerMistakeDrill:output_type -> pb.AnswerMistakeDrillResponse
+	95,  // 175: pb.ExamService.FindExamInfos:output_type -> pb.FindExamInfosResponse
+	98,  // 176: pb.ExamService.FindExamCatalog:output_type -> pb.FindExamCatalogResponse
+	102, // 177: pb.ExamService.CreateClass:output_type -> pb.CreateClassResponse
+	104, // 178: pb.ExamService.JoinClass:output_type -> pb.JoinClassResponse
+	106, // 179: pb.ExamService.FindClasses:output_type -> pb.FindClassesResponse
+	109, // 180: pb.ExamService.CreateAssignment:output_type -> pb.CreateAssignmentResponse
+	111, // 181: pb.ExamService.FindAssignments:output_type -> pb.FindAssignmentsResponse
+	114, // 182: pb.ExamService.FindClassGradebook:output_type -> pb.FindClassGradebookResponse
+	134, // [134:183] is the sub-list for method output_type
+	85,  // [85:134] is the sub-list for method input_type
+	85,  // [85:85] is the sub-list for extension type_name
+	85,  // [85:85] is the sub-list for extension extendee
+	0,   // [0:85] is the sub-list for field type_name
+}
+
+func init() { file_exam_service_proto_init() }
+func file_exam_service_proto_init() {
+	if File_exam_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_exam_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Exam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExamSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateExamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateExamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateExamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateExamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindExamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindExamsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteExamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteExamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PracticeWord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePracticeExamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePracticeExamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindExamsByTagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindExamsByTagsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForkExamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForkExamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishExamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishExamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExamShare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareExamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareExamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnshareExamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnshareExamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindExamSharesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindExamSharesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportExamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExamImportError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportExamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportExamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportExamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindExamTagCountsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindExamTagCountsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Question); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptedAnswers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateQuestionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateQuestionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateQuestionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateQuestionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQuestionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQuestionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindQuestionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindQuestionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteQuestionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteQuestionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuestionOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuestionOperationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchMutateQuestionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchMutateQuestionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExamSection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateExamSectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateExamSectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateExamSectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateExamSectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteExamSectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteExamSectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindExamSectionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindExamSectionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevealQuestionHintRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevealQuestionHintResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindRandomQuestionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindRandomQuestionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BankQuestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExamBankSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExamBankPool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBankQuestionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBankQuestionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBankQuestionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBankQuestionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBankQuestionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBankQuestionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindBankQuestionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindBankQuestionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetExamBankSourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetExamBankSourceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExamRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExamAttemptAnswer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateExamRecordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateExamRecordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindExamRecordsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindExamRecordsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExamRecordDetailRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExamRecordDetailResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnswerWrong); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExamVersionSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindExamRecordOverviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindExamRecordOverviewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DailyExamRecordStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HourOfDayStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExamRecordAnalytics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExamInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindExamInfosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindExamInfosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExamCatalogItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use FindExamInfosResponse.ProtoReflect.Descriptor instead.
-func (*FindExamInfosResponse) Descriptor() ([]byte, []int) {
-	return file_exam_service_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *FindExamInfosResponse) GetExamInfos() []*ExamInfo {
-	if x != nil {
-		return x.ExamInfos
-	}
-	return nil
-}
-
-var File_exam_service_proto protoreflect.FileDescriptor
-
-var file_exam_service_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8f, 0x02, 0x0a, 0x04, 0x45, 0x78,
-	0x61, 0x6d, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x5f, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12,
-	0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x11,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x2d, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x9a,
-	0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x12, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x67, 0x0a, 0x10, 0x46, 0x69,
-	0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a,
-	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0x68, 0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1d,
-	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a,
-	0x05, 0x65, 0x78, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70,
-	0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x05, 0x65, 0x78, 0x61, 0x6d, 0x73, 0x22, 0x45, 0x0a,
-	0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78,
-	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xef, 0x01, 0x0a, 0x08, 0x51,
-	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49,
-	0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x75, 0x0a, 0x15,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x10,
-	0x0a, 0x03, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x73, 0x6b,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65,
-	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x7d,
-	0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6b, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e,
-	0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6e, 0x73,
-	0x77, 0x65, 0x72, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x39, 0x0a,
-	0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x14, 0x46, 0x69, 0x6e,
-	0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x17, 0x0a,
-	0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x78, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1d,
-	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a,
-	0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x51, 0x0a, 0x15, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x61,
-	0x6e, 0x64, 0x6f, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x67, 0x0a, 0x1b, 0x46, 0x69,
-	0x6e, 0x64, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x65, 0x78, 0x61,
-	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61,
-	0x6d, 0x52, 0x04, 0x65, 0x78, 0x61, 0x6d, 0x12, 0x2a, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
-	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x22, 0xdb, 0x01, 0x0a, 0x0a, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x5f, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x22, 0x8f, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2c, 0x0a, 0x12,
-	0x77, 0x72, 0x6f, 0x6e, 0x67, 0x5f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x51,
-	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61,
-	0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x86, 0x01, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
-	0x70, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x17, 0x46, 0x69, 0x6e,
-	0x64, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
-	0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x0c, 0x65, 0x78, 0x61,
-	0x6d, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
-	0x0b, 0x65, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xfd, 0x01, 0x0a,
-	0x0b, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x12, 0x0f, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x17, 0x0a,
-	0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8c, 0x01, 0x0a,
-	0x1d, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4f,
-	0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x22, 0xf2, 0x01, 0x0a, 0x1e,
-	0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4f, 0x76,
-	0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a,
-	0x04, 0x65, 0x78, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62,
-	0x2e, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x04, 0x65, 0x78, 0x61, 0x6d, 0x12, 0x2a, 0x0a, 0x09, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x0d, 0x61, 0x6e, 0x73, 0x77, 0x65,
-	0x72, 0x5f, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x52,
-	0x0c, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x31, 0x0a,
-	0x0c, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x52, 0x0b, 0x65, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
-	0x22, 0xc2, 0x01, 0x0a, 0x08, 0x45, 0x78, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a,
-	0x07, 0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x65, 0x78, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b,
-	0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x25, 0x0a, 0x0e, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4c, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61,
-	0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x22, 0x44, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x49,
-	0x6e, 0x66, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x0a,
-	0x65, 0x78, 0x61, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09,
-	0x65, 0x78, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x32, 0xb9, 0x07, 0x0a, 0x0b, 0x45, 0x78,
-	0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x45, 0x78, 0x61, 0x6d, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x73,
-	0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64,
-	0x45, 0x78, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
-	0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x12, 0x15, 0x2e, 0x70, 0x62,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78,
-	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x70,
-	0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65,
-	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d,
-	0x46, 0x69, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x2e,
-	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e,
-	0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x13, 0x46,
-	0x69, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x64,
-	0x6f, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x64,
-	0x6f, 0x6d, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61,
-	0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45,
-	0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f,
-	0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69,
-	0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4f, 0x76, 0x65, 0x72,
-	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x62,
-	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4f,
-	0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x44, 0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x73,
-	0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x49, 0x6e,
-	0x66, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e,
-	0x46, 0x69, 0x6e, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x3b, 0x70, 0x62, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_exam_service_proto_rawDescOnce sync.Once
-	file_exam_service_proto_rawDescData = file_exam_service_proto_rawDesc
-)
-
-func file_exam_service_proto_rawDescGZIP() []byte {
-	file_exam_service_proto_rawDescOnce.Do(func() {
-		file_exam_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_exam_service_proto_rawDescData)
-	})
-	return file_exam_service_proto_rawDescData
-}
-
-var file_exam_service_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
-var file_exam_service_proto_goTypes = []interface{}{
-	(*Exam)(nil),                           // 0: pb.Exam
-	(*CreateExamRequest)(nil),              // 1: pb.CreateExamRequest
-	(*CreateExamResponse)(nil),             // 2: pb.CreateExamResponse
-	(*UpdateExamRequest)(nil),              // 3: pb.UpdateExamRequest
-	(*UpdateExamResponse)(nil),             // 4: pb.UpdateExamResponse
-	(*FindExamsRequest)(nil),               // 5: pb.FindExamsRequest
-	(*FindExamsResponse)(nil),              // 6: pb.FindExamsResponse
-	(*DeleteExamRequest)(nil),              // 7: pb.DeleteExamRequest
-	(*DeleteExamResponse)(nil),             // 8: pb.DeleteExamResponse
-	(*Question)(nil),                       // 9: pb.Question
-	(*CreateQuestionRequest)(nil),          // 10: pb.CreateQuestionRequest
-	(*CreateQuestionResponse)(nil),         // 11: pb.CreateQuestionResponse
-	(*UpdateQuestionRequest)(nil),          // 12: pb.UpdateQuestionRequest
-	(*UpdateQuestionResponse)(nil),         // 13: pb.UpdateQuestionResponse
-	(*FindQuestionsRequest)(nil),           // 14: pb.FindQuestionsRequest
-	(*FindQuestionsResponse)(nil),          // 15: pb.FindQuestionsResponse
-	(*DeleteQuestionRequest)(nil),          // 16: pb.DeleteQuestionRequest
-	(*DeleteQuestionResponse)(nil),         // 17: pb.DeleteQuestionResponse
-	(*FindRandomQuestionsRequest)(nil),     // 18: pb.FindRandomQuestionsRequest
-	(*FindRandomQuestionsResponse)(nil),    // 19: pb.FindRandomQuestionsResponse
-	(*ExamRecord)(nil),                     // 20: pb.ExamRecord
-	(*CreateExamRecordRequest)(nil),        // 21: pb.CreateExamRecordRequest
-	(*CreateExamRecordResponse)(nil),       // 22: pb.CreateExamRecordResponse
-	(*FindExamRecordsRequest)(nil),         // 23: pb.FindExamRecordsRequest
-	(*FindExamRecordsResponse)(nil),        // 24: pb.FindExamRecordsResponse
-	(*AnswerWrong)(nil),                    // 25: pb.AnswerWrong
-	(*FindExamRecordOverviewRequest)(nil),  // 26: pb.FindExamRecordOverviewRequest
-	(*FindExamRecordOverviewResponse)(nil), // 27: pb.FindExamRecordOverviewResponse
-	(*ExamInfo)(nil),                       // 28: pb.ExamInfo
-	(*FindExamInfosRequest)(nil),           // 29: pb.FindExamInfosRequest
-	(*FindExamInfosResponse)(nil),          // 30: pb.FindExamInfosResponse
-	(*timestamppb.Timestamp)(nil),          // 31: google.protobuf.Timestamp
-}
-var file_exam_service_proto_depIdxs = []int32{
-	31, // 0: pb.Exam.created_at:type_name -> google.protobuf.Timestamp
-	31, // 1: pb.Exam.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: pb.FindExamsResponse.exams:type_name -> pb.Exam
-	31, // 3: pb.Question.created_at:type_name -> google.protobuf.Timestamp
-	31, // 4: pb.Question.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 5: pb.FindQuestionsResponse.questions:type_name -> pb.Question
-	0,  // 6: pb.FindRandomQuestionsResponse.exam:type_name -> pb.Exam
-	9,  // 7: pb.FindRandomQuestionsResponse.questions:type_name -> pb.Question
-	31, // 8: pb.ExamRecord.created_at:type_name -> google.protobuf.Timestamp
-	31, // 9: pb.ExamRecord.updated_at:type_name -> google.protobuf.Timestamp
-	20, // 10: pb.FindExamRecordsResponse.exam_records:type_name -> pb.ExamRecord
-	31, // 11: pb.AnswerWrong.created_at:type_name -> google.protobuf.Timestamp
-	31, // 12: pb.AnswerWrong.updated_at:type_name -> google.protobuf.Timestamp
-	31, // 13: pb.FindExamRecordOverviewRequest.start_date:type_name -> google.protobuf.Timestamp
-	0,  // 14: pb.FindExamRecordOverviewResponse.exam:type_name -> pb.Exam
-	9,  // 15: pb.FindExamRecordOverviewResponse.questions:type_name -> pb.Question
-	25, // 16: pb.FindExamRecordOverviewResponse.answer_wrongs:type_name -> pb.AnswerWrong
-	20, // 17: pb.FindExamRecordOverviewResponse.exam_records:type_name -> pb.ExamRecord
-	28, // 18: pb.FindExamInfosResponse.exam_infos:type_name -> pb.ExamInfo
-	1,  // 19: pb.ExamService.CreateExam:input_type -> pb.CreateExamRequest
-	3,  // 20: pb.ExamService.UpdateExam:input_type -> pb.UpdateExamRequest
-	5,  // 21: pb.ExamService.FindExams:input_type -> pb.FindExamsRequest
-	7,  // 22: pb.ExamService.DeleteExam:input_type -> pb.DeleteExamRequest
-	10, // 23: pb.ExamService.CreateQuestion:input_type -> pb.CreateQuestionRequest
-	12, // 24: pb.ExamService.UpdateQuestion:input_type -> pb.UpdateQuestionRequest
-	14, // 25: pb.ExamService.FindQuestions:input_type -> pb.FindQuestionsRequest
-	16, // 26: pb.ExamService.DeleteQuestion:input_type -> pb.DeleteQuestionRequest
-	18, // 27: pb.ExamService.FindRandomQuestions:input_type -> pb.FindRandomQuestionsRequest
-	21, // 28: pb.ExamService.CreateExamRecord:input_type -> pb.CreateExamRecordRequest
-	23, // 29: pb.ExamService.FindExamRecords:input_type -> pb.FindExamRecordsRequest
-	26, // 30: pb.ExamService.FindExamRecordOverview:input_type -> pb.FindExamRecordOverviewRequest
-	29, // 31: pb.ExamService.FindExamInfos:input_type -> pb.FindExamInfosRequest
-	2,  // 32: pb.ExamService.CreateExam:output_type -> pb.CreateExamResponse
-	4,  // 33: pb.ExamService.UpdateExam:output_type -> pb.UpdateExamResponse
-	6,  // 34: pb.ExamService.FindExams:output_type -> pb.FindExamsResponse
-	8,  // 35: pb.ExamService.DeleteExam:output_type -> pb.DeleteExamResponse
-	11, // 36: pb.ExamService.CreateQuestion:output_type -> pb.CreateQuestionResponse
-	13, // 37: pb.ExamService.UpdateQuestion:output_type -> pb.UpdateQuestionResponse
-	15, // 38: pb.ExamService.FindQuestions:output_type -> pb.FindQuestionsResponse
-	17, // 39: pb.ExamService.DeleteQuestion:output_type -> pb.DeleteQuestionResponse
-	19, // 40: pb.ExamService.FindRandomQuestions:output_type -> pb.FindRandomQuestionsResponse
-	22, // 41: pb.ExamService.CreateExamRecord:output_type -> pb.CreateExamRecordResponse
-	24, // 42: pb.ExamService.FindExamRecords:output_type -> pb.FindExamRecordsResponse
-	27, // 43: pb.ExamService.FindExamRecordOverview:output_type -> pb.FindExamRecordOverviewResponse
-	30, // 44: pb.ExamService.FindExamInfos:output_type -> pb.FindExamInfosResponse
-	32, // [32:45] is the sub-list for method output_type
-	19, // [19:32] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
-}
-
-func init() { file_exam_service_proto_init() }
-func file_exam_service_proto_init() {
-	if File_exam_service_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_exam_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Exam); i {
+		file_exam_service_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindExamCatalogRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2444,8 +12157,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateExamRequest); i {
+		file_exam_service_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindExamCatalogResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2456,8 +12169,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateExamResponse); i {
+		file_exam_service_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClassStudent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2468,8 +12181,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateExamRequest); i {
+		file_exam_service_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Class); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2480,8 +12193,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateExamResponse); i {
+		file_exam_service_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateClassRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2492,8 +12205,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindExamsRequest); i {
+		file_exam_service_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateClassResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2504,8 +12217,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindExamsResponse); i {
+		file_exam_service_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinClassRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2516,8 +12229,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteExamRequest); i {
+		file_exam_service_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinClassResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2528,8 +12241,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteExamResponse); i {
+		file_exam_service_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindClassesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2540,8 +12253,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Question); i {
+		file_exam_service_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindClassesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2552,8 +12265,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateQuestionRequest); i {
+		file_exam_service_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Assignment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2564,8 +12277,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateQuestionResponse); i {
+		file_exam_service_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAssignmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2576,8 +12289,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateQuestionRequest); i {
+		file_exam_service_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAssignmentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2588,8 +12301,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateQuestionResponse); i {
+		file_exam_service_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAssignmentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2600,8 +12313,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindQuestionsRequest); i {
+		file_exam_service_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAssignmentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2612,8 +12325,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindQuestionsResponse); i {
+		file_exam_service_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClassGrade); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2624,8 +12337,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteQuestionRequest); i {
+		file_exam_service_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindClassGradebookRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2636,8 +12349,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteQuestionResponse); i {
+		file_exam_service_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindClassGradebookResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2648,8 +12361,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindRandomQuestionsRequest); i {
+		file_exam_service_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderboardEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2660,8 +12373,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindRandomQuestionsResponse); i {
+		file_exam_service_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindExamLeaderboardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2672,8 +12385,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExamRecord); i {
+		file_exam_service_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindExamLeaderboardResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2684,8 +12397,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateExamRecordRequest); i {
+		file_exam_service_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuestionStatistic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2696,8 +12409,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateExamRecordResponse); i {
+		file_exam_service_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PercentageCount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2708,8 +12421,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindExamRecordsRequest); i {
+		file_exam_service_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExamStatisticsSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2720,8 +12433,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindExamRecordsResponse); i {
+		file_exam_service_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindQuestionStatisticsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2732,8 +12445,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnswerWrong); i {
+		file_exam_service_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindQuestionStatisticsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2744,8 +12457,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindExamRecordOverviewRequest); i {
+		file_exam_service_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MistakeNotebookEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2756,8 +12469,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindExamRecordOverviewResponse); i {
+		file_exam_service_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindMistakeNotebookRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2768,8 +12481,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExamInfo); i {
+		file_exam_service_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindMistakeNotebookResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2780,8 +12493,8 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindExamInfosRequest); i {
+		file_exam_service_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMistakeDrillRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2792,8 +12505,32 @@ func file_exam_service_proto_init() {
 				return nil
 			}
 		}
-		file_exam_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindExamInfosResponse); i {
+		file_exam_service_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMistakeDrillResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnswerMistakeDrillRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exam_service_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnswerMistakeDrillResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2805,13 +12542,15 @@ func file_exam_service_proto_init() {
 			}
 		}
 	}
+	file_exam_service_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_exam_service_proto_msgTypes[40].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_exam_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   31,
+			NumMessages:   130,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
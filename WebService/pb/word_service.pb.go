@@ -9,6 +9,7 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -499,6 +500,8 @@ type FindFavoriteWordMeaningsRequest struct {
 	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	UserId    string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Word      string `protobuf:"bytes,4,opt,name=word,proto3" json:"word,omitempty"`
+	// 有值時改用 cursor 分頁，忽略 page_index 且不回傳 total、page_count
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
 func (x *FindFavoriteWordMeaningsRequest) Reset() {
@@ -561,6 +564,13 @@ func (x *FindFavoriteWordMeaningsRequest) GetWord() string {
 	return ""
 }
 
+func (x *FindFavoriteWordMeaningsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type FindFavoriteWordMeaningsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -569,6 +579,8 @@ type FindFavoriteWordMeaningsResponse struct {
 	Total                int32          `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
 	PageCount            int32          `protobuf:"varint,2,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
 	FavoriteWordMeanings []*WordMeaning `protobuf:"bytes,3,rep,name=favorite_word_meanings,json=favoriteWordMeanings,proto3" json:"favorite_word_meanings,omitempty"`
+	// 下一頁的 cursor，沒有下一頁時為空字串
+	NextCursor string `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 }
 
 func (x *FindFavoriteWordMeaningsResponse) Reset() {
@@ -624,6 +636,13 @@ func (x *FindFavoriteWordMeaningsResponse) GetFavoriteWordMeanings() []*WordMean
 	return nil
 }
 
+func (x *FindFavoriteWordMeaningsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type FindRandomFavoriteWordMeaningsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -631,6 +650,8 @@ type FindRandomFavoriteWordMeaningsRequest struct {
 
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Size   int32  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// 加權方式：空字串（不加權）、recent（偏重最近加入的）、less_reviewed（偏重較少複習的）
+	Weighting string `protobuf:"bytes,3,opt,name=weighting,proto3" json:"weighting,omitempty"`
 }
 
 func (x *FindRandomFavoriteWordMeaningsRequest) Reset() {
@@ -679,6 +700,13 @@ func (x *FindRandomFavoriteWordMeaningsRequest) GetSize() int32 {
 	return 0
 }
 
+func (x *FindRandomFavoriteWordMeaningsRequest) GetWeighting() string {
+	if x != nil {
+		return x.Weighting
+	}
+	return ""
+}
+
 type FindRandomFavoriteWordMeaningsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -726,6 +754,212 @@ func (x *FindRandomFavoriteWordMeaningsResponse) GetFavoriteWordMeanings() []*Wo
 	return nil
 }
 
+// 依 id 查詢使用者自己的喜歡的單字解釋，例如使用者挑選出來要練習的單字卡
+type FindFavoriteWordMeaningsByIdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FavoriteWordMeaningIds []string `protobuf:"bytes,1,rep,name=favorite_word_meaning_ids,json=favoriteWordMeaningIds,proto3" json:"favorite_word_meaning_ids,omitempty"`
+	UserId                 string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *FindFavoriteWordMeaningsByIdsRequest) Reset() {
+	*x = FindFavoriteWordMeaningsByIdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_word_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindFavoriteWordMeaningsByIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindFavoriteWordMeaningsByIdsRequest) ProtoMessage() {}
+
+func (x *FindFavoriteWordMeaningsByIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_word_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindFavoriteWordMeaningsByIdsRequest.ProtoReflect.Descriptor instead.
+func (*FindFavoriteWordMeaningsByIdsRequest) Descriptor() ([]byte, []int) {
+	return file_word_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FindFavoriteWordMeaningsByIdsRequest) GetFavoriteWordMeaningIds() []string {
+	if x != nil {
+		return x.FavoriteWordMeaningIds
+	}
+	return nil
+}
+
+func (x *FindFavoriteWordMeaningsByIdsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type FindFavoriteWordMeaningsByIdsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FavoriteWordMeanings []*WordMeaning `protobuf:"bytes,1,rep,name=favorite_word_meanings,json=favoriteWordMeanings,proto3" json:"favorite_word_meanings,omitempty"`
+}
+
+func (x *FindFavoriteWordMeaningsByIdsResponse) Reset() {
+	*x = FindFavoriteWordMeaningsByIdsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_word_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindFavoriteWordMeaningsByIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindFavoriteWordMeaningsByIdsResponse) ProtoMessage() {}
+
+func (x *FindFavoriteWordMeaningsByIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_word_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindFavoriteWordMeaningsByIdsResponse.ProtoReflect.Descriptor instead.
+func (*FindFavoriteWordMeaningsByIdsResponse) Descriptor() ([]byte, []int) {
+	return file_word_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FindFavoriteWordMeaningsByIdsResponse) GetFavoriteWordMeanings() []*WordMeaning {
+	if x != nil {
+		return x.FavoriteWordMeanings
+	}
+	return nil
+}
+
+// 使用者複習過單字卡後記錄複習次數，供「偏重較少複習的」加權使用
+type MarkFavoriteWordMeaningsReviewedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FavoriteWordMeaningIds []string `protobuf:"bytes,1,rep,name=favorite_word_meaning_ids,json=favoriteWordMeaningIds,proto3" json:"favorite_word_meaning_ids,omitempty"`
+	UserId                 string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *MarkFavoriteWordMeaningsReviewedRequest) Reset() {
+	*x = MarkFavoriteWordMeaningsReviewedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_word_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkFavoriteWordMeaningsReviewedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkFavoriteWordMeaningsReviewedRequest) ProtoMessage() {}
+
+func (x *MarkFavoriteWordMeaningsReviewedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_word_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkFavoriteWordMeaningsReviewedRequest.ProtoReflect.Descriptor instead.
+func (*MarkFavoriteWordMeaningsReviewedRequest) Descriptor() ([]byte, []int) {
+	return file_word_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MarkFavoriteWordMeaningsReviewedRequest) GetFavoriteWordMeaningIds() []string {
+	if x != nil {
+		return x.FavoriteWordMeaningIds
+	}
+	return nil
+}
+
+func (x *MarkFavoriteWordMeaningsReviewedRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type MarkFavoriteWordMeaningsReviewedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModifiedCount int32 `protobuf:"varint,1,opt,name=modified_count,json=modifiedCount,proto3" json:"modified_count,omitempty"`
+}
+
+func (x *MarkFavoriteWordMeaningsReviewedResponse) Reset() {
+	*x = MarkFavoriteWordMeaningsReviewedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_word_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkFavoriteWordMeaningsReviewedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkFavoriteWordMeaningsReviewedResponse) ProtoMessage() {}
+
+func (x *MarkFavoriteWordMeaningsReviewedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_word_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkFavoriteWordMeaningsReviewedResponse.ProtoReflect.Descriptor instead.
+func (*MarkFavoriteWordMeaningsReviewedResponse) Descriptor() ([]byte, []int) {
+	return file_word_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MarkFavoriteWordMeaningsReviewedResponse) GetModifiedCount() int32 {
+	if x != nil {
+		return x.ModifiedCount
+	}
+	return 0
+}
+
 type WordMeaning struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -747,7 +981,7 @@ type WordMeaning struct {
 func (x *WordMeaning) Reset() {
 	*x = WordMeaning{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_word_service_proto_msgTypes[13]
+		mi := &file_word_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -760,7 +994,7 @@ func (x *WordMeaning) String() string {
 func (*WordMeaning) ProtoMessage() {}
 
 func (x *WordMeaning) ProtoReflect() protoreflect.Message {
-	mi := &file_word_service_proto_msgTypes[13]
+	mi := &file_word_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -773,7 +1007,7 @@ func (x *WordMeaning) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WordMeaning.ProtoReflect.Descriptor instead.
 func (*WordMeaning) Descriptor() ([]byte, []int) {
-	return file_word_service_proto_rawDescGZIP(), []int{13}
+	return file_word_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *WordMeaning) GetId() string {
@@ -853,248 +1087,1378 @@ func (x *WordMeaning) GetFavoriteWordMeaningId() string {
 	return ""
 }
 
-var File_word_service_proto protoreflect.FileDescriptor
+type LookupHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_word_service_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x4a, 0x0a, 0x1b, 0x46, 0x69, 0x6e, 0x64,
-	0x57, 0x6f, 0x72, 0x64, 0x42, 0x79, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x1c, 0x46, 0x69, 0x6e, 0x64, 0x57, 0x6f, 0x72, 0x64,
-	0x42, 0x79, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x61,
-	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62,
-	0x2e, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x77, 0x6f,
-	0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x67, 0x0a, 0x0d, 0x50, 0x72,
-	0x6f, 0x6e, 0x75, 0x6e, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12,
-	0x20, 0x0a, 0x0c, 0x75, 0x6b, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6b, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x55, 0x72,
-	0x6c, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x75, 0x72,
-	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x41, 0x75, 0x64, 0x69, 0x6f,
-	0x55, 0x72, 0x6c, 0x22, 0x3b, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x12,
-	0x1b, 0x0a, 0x09, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
-	0x22, 0x4d, 0x0a, 0x07, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61,
-	0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x28, 0x0a, 0x08, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e,
-	0x74, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22,
-	0x63, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
-	0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f,
-	0x77, 0x6f, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69,
-	0x6e, 0x67, 0x49, 0x64, 0x22, 0x5c, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61,
-	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x66, 0x61, 0x76,
-	0x6f, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69,
-	0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x66, 0x61, 0x76,
-	0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67,
-	0x49, 0x64, 0x22, 0x74, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f,
-	0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69,
-	0x74, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69,
-	0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x23, 0x0a, 0x21, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65,
-	0x61, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8a, 0x01,
-	0x0a, 0x1f, 0x46, 0x69, 0x6e, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f,
-	0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x20, 0x46,
-	0x69, 0x6e, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d,
-	0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x45, 0x0a, 0x16, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
-	0x5f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65,
-	0x61, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57,
-	0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x54, 0x0a, 0x25, 0x46,
-	0x69, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
-	0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a,
-	0x65, 0x22, 0x6f, 0x0a, 0x26, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x46,
-	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69,
-	0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x16, 0x66,
-	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x61,
-	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62,
-	0x2e, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x66, 0x61,
-	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e,
-	0x67, 0x73, 0x22, 0x88, 0x03, 0x0a, 0x0b, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69,
-	0x6e, 0x67, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x5f,
-	0x6f, 0x66, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x70, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x12, 0x12, 0x0a,
-	0x04, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x72, 0x61,
-	0x6d, 0x12, 0x37, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x6e, 0x75, 0x6e, 0x63, 0x69, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72,
-	0x6f, 0x6e, 0x75, 0x6e, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x70, 0x72, 0x6f,
-	0x6e, 0x75, 0x6e, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x65,
-	0x66, 0x5f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65,
-	0x66, 0x47, 0x72, 0x61, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x08, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x52, 0x08, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x1e,
-	0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x5f, 0x6e, 0x6f, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x4e, 0x6f, 0x12, 0x24,
-	0x0a, 0x0e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x62, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73,
-	0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x42, 0x79, 0x57,
-	0x6f, 0x72, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
-	0x5f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
-	0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x32, 0x9c, 0x04,
-	0x0a, 0x0b, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a,
-	0x14, 0x46, 0x69, 0x6e, 0x64, 0x57, 0x6f, 0x72, 0x64, 0x42, 0x79, 0x44, 0x69, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x57,
-	0x6f, 0x72, 0x64, 0x42, 0x79, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64,
-	0x57, 0x6f, 0x72, 0x64, 0x42, 0x79, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65,
-	0x61, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61,
-	0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x62,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57,
-	0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x68, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f,
-	0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x12,
-	0x24, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72,
-	0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61,
-	0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x18,
-	0x46, 0x69, 0x6e, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64,
-	0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69,
-	0x6e, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65,
-	0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
-	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57,
-	0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x1e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x64, 0x6f,
-	0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61,
-	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x29, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52,
-	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72,
-	0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2a, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
-	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e,
-	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x07, 0x5a, 0x05,
-	0x2e, 0x2f, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Id     string `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Word   string `protobuf:"bytes,3,opt,name=word,proto3" json:"word,omitempty"`
+	// 查詢次數
+	Times     int32                  `protobuf:"varint,4,opt,name=times,proto3" json:"times,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 }
 
-var (
-	file_word_service_proto_rawDescOnce sync.Once
-	file_word_service_proto_rawDescData = file_word_service_proto_rawDesc
-)
+func (x *LookupHistory) Reset() {
+	*x = LookupHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_word_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_word_service_proto_rawDescGZIP() []byte {
-	file_word_service_proto_rawDescOnce.Do(func() {
-		file_word_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_word_service_proto_rawDescData)
-	})
-	return file_word_service_proto_rawDescData
+func (x *LookupHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_word_service_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
-var file_word_service_proto_goTypes = []interface{}{
-	(*FindWordByDictionaryRequest)(nil),            // 0: pb.FindWordByDictionaryRequest
-	(*FindWordByDictionaryResponse)(nil),           // 1: pb.FindWordByDictionaryResponse
-	(*Pronunciation)(nil),                          // 2: pb.Pronunciation
-	(*Sentence)(nil),                               // 3: pb.Sentence
-	(*Example)(nil),                                // 4: pb.Example
-	(*CreateFavoriteWordMeaningRequest)(nil),       // 5: pb.CreateFavoriteWordMeaningRequest
-	(*CreateFavoriteWordMeaningResponse)(nil),      // 6: pb.CreateFavoriteWordMeaningResponse
-	(*DeleteFavoriteWordMeaningRequest)(nil),       // 7: pb.DeleteFavoriteWordMeaningRequest
-	(*DeleteFavoriteWordMeaningResponse)(nil),      // 8: pb.DeleteFavoriteWordMeaningResponse
-	(*FindFavoriteWordMeaningsRequest)(nil),        // 9: pb.FindFavoriteWordMeaningsRequest
-	(*FindFavoriteWordMeaningsResponse)(nil),       // 10: pb.FindFavoriteWordMeaningsResponse
-	(*FindRandomFavoriteWordMeaningsRequest)(nil),  // 11: pb.FindRandomFavoriteWordMeaningsRequest
-	(*FindRandomFavoriteWordMeaningsResponse)(nil), // 12: pb.FindRandomFavoriteWordMeaningsResponse
-	(*WordMeaning)(nil),                            // 13: pb.WordMeaning
+func (*LookupHistory) ProtoMessage() {}
+
+func (x *LookupHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_word_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_word_service_proto_depIdxs = []int32{
-	13, // 0: pb.FindWordByDictionaryResponse.word_meanings:type_name -> pb.WordMeaning
-	3,  // 1: pb.Example.examples:type_name -> pb.Sentence
-	13, // 2: pb.FindFavoriteWordMeaningsResponse.favorite_word_meanings:type_name -> pb.WordMeaning
-	13, // 3: pb.FindRandomFavoriteWordMeaningsResponse.favorite_word_meanings:type_name -> pb.WordMeaning
-	2,  // 4: pb.WordMeaning.pronunciation:type_name -> pb.Pronunciation
-	4,  // 5: pb.WordMeaning.examples:type_name -> pb.Example
-	0,  // 6: pb.WordService.FindWordByDictionary:input_type -> pb.FindWordByDictionaryRequest
-	5,  // 7: pb.WordService.CreateFavoriteWordMeaning:input_type -> pb.CreateFavoriteWordMeaningRequest
-	7,  // 8: pb.WordService.DeleteFavoriteWordMeaning:input_type -> pb.DeleteFavoriteWordMeaningRequest
-	9,  // 9: pb.WordService.FindFavoriteWordMeanings:input_type -> pb.FindFavoriteWordMeaningsRequest
-	11, // 10: pb.WordService.FindRandomFavoriteWordMeanings:input_type -> pb.FindRandomFavoriteWordMeaningsRequest
-	1,  // 11: pb.WordService.FindWordByDictionary:output_type -> pb.FindWordByDictionaryResponse
-	6,  // 12: pb.WordService.CreateFavoriteWordMeaning:output_type -> pb.CreateFavoriteWordMeaningResponse
-	8,  // 13: pb.WordService.DeleteFavoriteWordMeaning:output_type -> pb.DeleteFavoriteWordMeaningResponse
-	10, // 14: pb.WordService.FindFavoriteWordMeanings:output_type -> pb.FindFavoriteWordMeaningsResponse
-	12, // 15: pb.WordService.FindRandomFavoriteWordMeanings:output_type -> pb.FindRandomFavoriteWordMeaningsResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+
+// Deprecated: Use LookupHistory.ProtoReflect.Descriptor instead.
+func (*LookupHistory) Descriptor() ([]byte, []int) {
+	return file_word_service_proto_rawDescGZIP(), []int{18}
 }
 
-func init() { file_word_service_proto_init() }
-func file_word_service_proto_init() {
-	if File_word_service_proto != nil {
-		return
+func (x *LookupHistory) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_word_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindWordByDictionaryRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_word_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindWordByDictionaryResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_word_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pronunciation); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_word_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Sentence); i {
+	return ""
+}
+
+func (x *LookupHistory) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LookupHistory) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *LookupHistory) GetTimes() int32 {
+	if x != nil {
+		return x.Times
+	}
+	return 0
+}
+
+func (x *LookupHistory) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LookupHistory) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type FindRecentLookupsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Size   int32  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *FindRecentLookupsRequest) Reset() {
+	*x = FindRecentLookupsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_word_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindRecentLookupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindRecentLookupsRequest) ProtoMessage() {}
+
+func (x *FindRecentLookupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_word_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindRecentLookupsRequest.ProtoReflect.Descriptor instead.
+func (*FindRecentLookupsRequest) Descriptor() ([]byte, []int) {
+	return file_word_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FindRecentLookupsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FindRecentLookupsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type FindRecentLookupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LookupHistories []*LookupHistory `protobuf:"bytes,1,rep,name=lookup_histories,json=lookupHistories,proto3" json:"lookup_histories,omitempty"`
+}
+
+func (x *FindRecentLookupsResponse) Reset() {
+	*x = FindRecentLookupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_word_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindRecentLookupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindRecentLookupsResponse) ProtoMessage() {}
+
+func (x *FindRecentLookupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_word_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindRecentLookupsResponse.ProtoReflect.Descriptor instead.
+func (*FindRecentLookupsResponse) Descriptor() ([]byte, []int) {
+	return file_word_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FindRecentLookupsResponse) GetLookupHistories() []*LookupHistory {
+	if x != nil {
+		return x.LookupHistories
+	}
+	return nil
+}
+
+type ClearLookupHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ClearLookupHistoryRequest) Reset() {
+	*x = ClearLookupHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_word_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearLookupHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearLookupHistoryRequest) ProtoMessage() {}
+
+func (x *ClearLookupHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_word_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearLookupHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ClearLookupHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_word_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ClearLookupHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ClearLookupHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeletedCount int32 `protobuf:"varint,1,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"`
+}
+
+func (x *ClearLookupHistoryResponse) Reset() {
+	*x = ClearLookupHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_word_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearLookupHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearLookupHistoryResponse) ProtoMessage() {}
+
+func (x *ClearLookupHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_word_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearLookupHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ClearLookupHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_word_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ClearLookupHistoryResponse) GetDeletedCount() int32 {
+	if x != nil {
+		return x.DeletedCount
+	}
+	return 0
+}
+
+// 聽寫練習的句子，作答前不回傳句子內容
+type DictationSentence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WordMeaningId string `protobuf:"bytes,1,opt,name=word_meaning_id,json=wordMeaningId,proto3" json:"word_meaning_id,omitempty"`
+	Word          string `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
+	AudioUrl      string `protobuf:"bytes,3,opt,name=audio_url,json=audioUrl,proto3" json:"audio_url,omitempty"`
+	// 句子的單字數
+	WordCount int32 `protobuf:"varint,4,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+}
+
+func (x *DictationSentence) Reset() {
+	*x = DictationSentence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_word_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DictationSentence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictationSentence) ProtoMessage() {}
+
+func (x *DictationSentence) ProtoReflect() protoreflect.Message {
+	mi := &file_word_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictationSentence.ProtoReflect.Descriptor instead.
+func (*DictationSentence) Descriptor() ([]byte, []int) {
+	return file_word_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DictationSentence) GetWordMeaningId() string {
+	if x != nil {
+		return x.WordMeaningId
+	}
+	return ""
+}
+
+func (x *DictationSentence) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *DictationSentence) GetAudioUrl() string {
+	if x != nil {
+		return x.AudioUrl
+	}
+	return ""
+}
+
+func (x *DictationSentence) GetWordCount() int32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+type FindDictationSentencesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Size   int32  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *FindDictationSentencesRequest) Reset() {
+	*x = FindDictationSentencesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_word_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindDictationSentencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDictationSentencesRequest) ProtoMessage() {}
+
+func (x *FindDictationSentencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_word_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDictationSentencesRequest.ProtoReflect.Descriptor instead.
+func (*FindDictationSentencesRequest) Descriptor() ([]byte, []int) {
+	return file_word_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FindDictationSentencesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FindDictationSentencesRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type FindDictationSentencesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sentences []*DictationSentence `protobuf:"bytes,1,rep,name=sentences,proto3" json:"sentences,omitempty"`
+}
+
+func (x *FindDictationSentencesResponse) Reset() {
+	*x = FindDictationSentencesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_word_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindDictationSentencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDictationSentencesResponse) ProtoMessage() {}
+
+func (x *FindDictationSentencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_word_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDictationSentencesResponse.ProtoReflect.Descriptor instead.
+func (*FindDictationSentencesResponse) Descriptor() ([]byte, []int) {
+	return file_word_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FindDictationSentencesResponse) GetSentences() []*DictationSentence {
+	if x != nil {
+		return x.Sentences
+	}
+	return nil
+}
+
+type DictationWordDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// correct（正確）、missing（漏寫）、extra（多寫）、misspelled（拼錯）
+	Type     string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Expected string `protobuf:"bytes,2,opt,name=expected,proto3" json:"expected,omitempty"`
+	Actual   string `protobuf:"bytes,3,opt,name=actual,proto3" json:"actual,omitempty"`
+}
+
+func (x *DictationWordDiff) Reset() {
+	*x = DictationWordDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_word_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DictationWordDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictationWordDiff) ProtoMessage() {}
+
+func (x *DictationWordDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_word_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictationWordDiff.ProtoReflect.Descriptor instead.
+func (*DictationWordDiff) Descriptor() ([]byte, []int) {
+	return file_word_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DictationWordDiff) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DictationWordDiff) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *DictationWordDiff) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
+type DictationRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string `protobuf:"bytes,1,opt,name=id,json=_id,proto3" json:"id,omitempty"`
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WordMeaningId string `protobuf:"bytes,3,opt,name=word_meaning_id,json=wordMeaningId,proto3" json:"word_meaning_id,omitempty"`
+	Word          string `protobuf:"bytes,4,opt,name=word,proto3" json:"word,omitempty"`
+	AudioUrl      string `protobuf:"bytes,5,opt,name=audio_url,json=audioUrl,proto3" json:"audio_url,omitempty"`
+	Text          string `protobuf:"bytes,6,opt,name=text,proto3" json:"text,omitempty"`
+	Answer        string `protobuf:"bytes,7,opt,name=answer,proto3" json:"answer,omitempty"`
+	// 正確率百分比，0 到 100
+	Accuracy     float64                `protobuf:"fixed64,8,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	WordCount    int32                  `protobuf:"varint,9,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+	CorrectCount int32                  `protobuf:"varint,10,opt,name=correct_count,json=correctCount,proto3" json:"correct_count,omitempty"`
+	Diffs        []*DictationWordDiff   `protobuf:"bytes,11,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *DictationRecord) Reset() {
+	*x = DictationRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_word_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DictationRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictationRecord) ProtoMessage() {}
+
+func (x *DictationRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_word_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictationRecord.ProtoReflect.Descriptor instead.
+func (*DictationRecord) Descriptor() ([]byte, []int) {
+	return file_word_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DictationRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DictationRecord) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DictationRecord) GetWordMeaningId() string {
+	if x != nil {
+		return x.WordMeaningId
+	}
+	return ""
+}
+
+func (x *DictationRecord) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *DictationRecord) GetAudioUrl() string {
+	if x != nil {
+		return x.AudioUrl
+	}
+	return ""
+}
+
+func (x *DictationRecord) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *DictationRecord) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *DictationRecord) GetAccuracy() float64 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *DictationRecord) GetWordCount() int32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+func (x *DictationRecord) GetCorrectCount() int32 {
+	if x != nil {
+		return x.CorrectCount
+	}
+	return 0
+}
+
+func (x *DictationRecord) GetDiffs() []*DictationWordDiff {
+	if x != nil {
+		return x.Diffs
+	}
+	return nil
+}
+
+func (x *DictationRecord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DictationRecord) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GradeDictationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WordMeaningId string `protobuf:"bytes,2,opt,name=word_meaning_id,json=wordMeaningId,proto3" json:"word_meaning_id,omitempty"`
+	AudioUrl      string `protobuf:"bytes,3,opt,name=audio_url,json=audioUrl,proto3" json:"audio_url,omitempty"`
+	Answer        string `protobuf:"bytes,4,opt,name=answer,proto3" json:"answer,omitempty"`
+}
+
+func (x *GradeDictationRequest) Reset() {
+	*x = GradeDictationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_word_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradeDictationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeDictationRequest) ProtoMessage() {}
+
+func (x *GradeDictationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_word_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeDictationRequest.ProtoReflect.Descriptor instead.
+func (*GradeDictationRequest) Descriptor() ([]byte, []int) {
+	return file_word_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GradeDictationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GradeDictationRequest) GetWordMeaningId() string {
+	if x != nil {
+		return x.WordMeaningId
+	}
+	return ""
+}
+
+func (x *GradeDictationRequest) GetAudioUrl() string {
+	if x != nil {
+		return x.AudioUrl
+	}
+	return ""
+}
+
+func (x *GradeDictationRequest) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+type GradeDictationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DictationRecord *DictationRecord `protobuf:"bytes,1,opt,name=dictation_record,json=dictationRecord,proto3" json:"dictation_record,omitempty"`
+}
+
+func (x *GradeDictationResponse) Reset() {
+	*x = GradeDictationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_word_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradeDictationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeDictationResponse) ProtoMessage() {}
+
+func (x *GradeDictationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_word_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeDictationResponse.ProtoReflect.Descriptor instead.
+func (*GradeDictationResponse) Descriptor() ([]byte, []int) {
+	return file_word_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GradeDictationResponse) GetDictationRecord() *DictationRecord {
+	if x != nil {
+		return x.DictationRecord
+	}
+	return nil
+}
+
+type FindDictationHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Size   int32  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *FindDictationHistoryRequest) Reset() {
+	*x = FindDictationHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_word_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindDictationHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDictationHistoryRequest) ProtoMessage() {}
+
+func (x *FindDictationHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_word_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDictationHistoryRequest.ProtoReflect.Descriptor instead.
+func (*FindDictationHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_word_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *FindDictationHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FindDictationHistoryRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type FindDictationHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DictationRecords []*DictationRecord `protobuf:"bytes,1,rep,name=dictation_records,json=dictationRecords,proto3" json:"dictation_records,omitempty"`
+	// 回傳的聽寫紀錄的平均正確率
+	AverageAccuracy float64 `protobuf:"fixed64,2,opt,name=average_accuracy,json=averageAccuracy,proto3" json:"average_accuracy,omitempty"`
+}
+
+func (x *FindDictationHistoryResponse) Reset() {
+	*x = FindDictationHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_word_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindDictationHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDictationHistoryResponse) ProtoMessage() {}
+
+func (x *FindDictationHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_word_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDictationHistoryResponse.ProtoReflect.Descriptor instead.
+func (*FindDictationHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_word_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FindDictationHistoryResponse) GetDictationRecords() []*DictationRecord {
+	if x != nil {
+		return x.DictationRecords
+	}
+	return nil
+}
+
+func (x *FindDictationHistoryResponse) GetAverageAccuracy() float64 {
+	if x != nil {
+		return x.AverageAccuracy
+	}
+	return 0
+}
+
+var File_word_service_proto protoreflect.FileDescriptor
+
+var file_word_service_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4a, 0x0a, 0x1b, 0x46, 0x69, 0x6e,
+	0x64, 0x57, 0x6f, 0x72, 0x64, 0x42, 0x79, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x1c, 0x46, 0x69, 0x6e, 0x64, 0x57, 0x6f, 0x72,
+	0x64, 0x42, 0x79, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x6d, 0x65,
+	0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x62, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x77,
+	0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x67, 0x0a, 0x0d, 0x50,
+	0x72, 0x6f, 0x6e, 0x75, 0x6e, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x20, 0x0a, 0x0c, 0x75, 0x6b, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6b, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x55,
+	0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x41, 0x75, 0x64, 0x69,
+	0x6f, 0x55, 0x72, 0x6c, 0x22, 0x3b, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x22, 0x4d, 0x0a, 0x07, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x28, 0x0a, 0x08, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x22, 0x63, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a,
+	0x0f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e,
+	0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x5c, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46,
+	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x66, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x61, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x66, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e,
+	0x67, 0x49, 0x64, 0x22, 0x74, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x66, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x66, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x23, 0x0a, 0x21, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d,
+	0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa2,
+	0x01, 0x0a, 0x1f, 0x46, 0x69, 0x6e, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57,
+	0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x22, 0xbf, 0x01, 0x0a, 0x20, 0x46, 0x69, 0x6e, 0x64, 0x46, 0x61, 0x76, 0x6f,
+	0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x45, 0x0a,
+	0x16, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x6d,
+	0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x70, 0x62, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x14,
+	0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x72, 0x0a, 0x25, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d,
+	0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x6f, 0x0a, 0x26, 0x46, 0x69, 0x6e,
+	0x64, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57,
+	0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x16, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x5f,
+	0x77, 0x6f, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61,
+	0x6e, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f,
+	0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x7a, 0x0a, 0x24, 0x46, 0x69,
+	0x6e, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65,
+	0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x77,
+	0x6f, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57,
+	0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6e, 0x0a, 0x25, 0x46, 0x69, 0x6e, 0x64, 0x46, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x45, 0x0a, 0x16, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x64,
+	0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67,
+	0x52, 0x14, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65,
+	0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x7d, 0x0a, 0x27, 0x4d, 0x61, 0x72, 0x6b, 0x46, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x39, 0x0a, 0x19, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x77, 0x6f,
+	0x72, 0x64, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f,
+	0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x73, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x51, 0x0a, 0x28, 0x4d, 0x61, 0x72, 0x6b, 0x46, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x88, 0x03, 0x0a, 0x0b, 0x57, 0x6f, 0x72,
+	0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x24, 0x0a,
+	0x0e, 0x70, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x63, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x53, 0x70, 0x65,
+	0x65, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x37, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x6e, 0x75,
+	0x6e, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6e, 0x75, 0x6e, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x6e, 0x75, 0x6e, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x66, 0x5f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x66, 0x47, 0x72, 0x61, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x08, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x70, 0x62, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x08, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79,
+	0x5f, 0x6e, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x79, 0x4e, 0x6f, 0x12, 0x24, 0x0a, 0x0e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x62, 0x79,
+	0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x42, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x66, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x61, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x66, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e,
+	0x67, 0x49, 0x64, 0x22, 0xd9, 0x01, 0x0a, 0x0d, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x5f, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x47, 0x0a, 0x18, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x59, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64,
+	0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x10, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x5f,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x0f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0x34, 0x0a, 0x19, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x1a, 0x43, 0x6c, 0x65,
+	0x61, 0x72, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8b, 0x01, 0x0a,
+	0x11, 0x44, 0x69, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6e, 0x74, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x6f, 0x72,
+	0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x77,
+	0x6f, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4c, 0x0a, 0x1d, 0x46, 0x69,
+	0x6e, 0x64, 0x44, 0x69, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6e, 0x74, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x55, 0x0a, 0x1e, 0x46, 0x69, 0x6e, 0x64,
+	0x44, 0x69, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x73, 0x65,
+	0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x70, 0x62, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6e, 0x74,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22,
+	0x5b, 0x0a, 0x11, 0x44, 0x69, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x6f, 0x72, 0x64,
+	0x44, 0x69, 0x66, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x22, 0xc3, 0x03, 0x0a,
+	0x0f, 0x44, 0x69, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x0f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x5f, 0x69,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x6f,
+	0x72, 0x64, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x6f,
+	0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x77,
+	0x6f, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2b, 0x0a, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x6f, 0x72,
+	0x64, 0x44, 0x69, 0x66, 0x66, 0x52, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x15, 0x47, 0x72, 0x61, 0x64, 0x65, 0x44, 0x69, 0x63, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x6d, 0x65,
+	0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x77, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x22, 0x58, 0x0a, 0x16, 0x47, 0x72, 0x61, 0x64, 0x65, 0x44, 0x69, 0x63, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x10,
+	0x64, 0x69, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x63, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0f, 0x64, 0x69, 0x63,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x22, 0x4a, 0x0a, 0x1b,
+	0x46, 0x69, 0x6e, 0x64, 0x44, 0x69, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x1c, 0x46, 0x69, 0x6e,
+	0x64, 0x44, 0x69, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x11, 0x64, 0x69, 0x63,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x10, 0x64, 0x69, 0x63, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x61,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x41, 0x63,
+	0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x32, 0xbd, 0x09, 0x0a, 0x0b, 0x57, 0x6f, 0x72, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x57, 0x6f,
+	0x72, 0x64, 0x42, 0x79, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x1f,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x57, 0x6f, 0x72, 0x64, 0x42, 0x79, 0x44, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x57, 0x6f, 0x72, 0x64, 0x42, 0x79, 0x44,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x68, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x24,
+	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x19, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72,
+	0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64,
+	0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x18, 0x46, 0x69, 0x6e, 0x64, 0x46, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x1e,
+	0x46, 0x69, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x29,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x46, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x62, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x1d, 0x46, 0x69, 0x6e, 0x64, 0x46, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x12, 0x28, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e,
+	0x69, 0x6e, 0x67, 0x73, 0x42, 0x79, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x79,
+	0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x20, 0x4d,
+	0x61, 0x72, 0x6b, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d,
+	0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x12,
+	0x2b, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x57, 0x6f, 0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70,
+	0x62, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x57, 0x6f,
+	0x72, 0x64, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x46, 0x69,
+	0x6e, 0x64, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x12,
+	0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x12,
+	0x43, 0x6c, 0x65, 0x61, 0x72, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5f, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x69, 0x63, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x62,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x69, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x69, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x44, 0x69, 0x63, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x44,
+	0x69, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x44, 0x69, 0x63, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x14, 0x46,
+	0x69, 0x6e, 0x64, 0x44, 0x69, 0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x69, 0x63,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x69,
+	0x63, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x3b, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_word_service_proto_rawDescOnce sync.Once
+	file_word_service_proto_rawDescData = file_word_service_proto_rawDesc
+)
+
+func file_word_service_proto_rawDescGZIP() []byte {
+	file_word_service_proto_rawDescOnce.Do(func() {
+		file_word_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_word_service_proto_rawDescData)
+	})
+	return file_word_service_proto_rawDescData
+}
+
+var file_word_service_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_word_service_proto_goTypes = []interface{}{
+	(*FindWordByDictionaryRequest)(nil),              // 0: pb.FindWordByDictionaryRequest
+	(*FindWordByDictionaryResponse)(nil),             // 1: pb.FindWordByDictionaryResponse
+	(*Pronunciation)(nil),                            // 2: pb.Pronunciation
+	(*Sentence)(nil),                                 // 3: pb.Sentence
+	(*Example)(nil),                                  // 4: pb.Example
+	(*CreateFavoriteWordMeaningRequest)(nil),         // 5: pb.CreateFavoriteWordMeaningRequest
+	(*CreateFavoriteWordMeaningResponse)(nil),        // 6: pb.CreateFavoriteWordMeaningResponse
+	(*DeleteFavoriteWordMeaningRequest)(nil),         // 7: pb.DeleteFavoriteWordMeaningRequest
+	(*DeleteFavoriteWordMeaningResponse)(nil),        // 8: pb.DeleteFavoriteWordMeaningResponse
+	(*FindFavoriteWordMeaningsRequest)(nil),          // 9: pb.FindFavoriteWordMeaningsRequest
+	(*FindFavoriteWordMeaningsResponse)(nil),         // 10: pb.FindFavoriteWordMeaningsResponse
+	(*FindRandomFavoriteWordMeaningsRequest)(nil),    // 11: pb.FindRandomFavoriteWordMeaningsRequest
+	(*FindRandomFavoriteWordMeaningsResponse)(nil),   // 12: pb.FindRandomFavoriteWordMeaningsResponse
+	(*FindFavoriteWordMeaningsByIdsRequest)(nil),     // 13: pb.FindFavoriteWordMeaningsByIdsRequest
+	(*FindFavoriteWordMeaningsByIdsResponse)(nil),    // 14: pb.FindFavoriteWordMeaningsByIdsResponse
+	(*MarkFavoriteWordMeaningsReviewedRequest)(nil),  // 15: pb.MarkFavoriteWordMeaningsReviewedRequest
+	(*MarkFavoriteWordMeaningsReviewedResponse)(nil), // 16: pb.MarkFavoriteWordMeaningsReviewedResponse
+	(*WordMeaning)(nil),                              // 17: pb.WordMeaning
+	(*LookupHistory)(nil),                            // 18: pb.LookupHistory
+	(*FindRecentLookupsRequest)(nil),                 // 19: pb.FindRecentLookupsRequest
+	(*FindRecentLookupsResponse)(nil),                // 20: pb.FindRecentLookupsResponse
+	(*ClearLookupHistoryRequest)(nil),                // 21: pb.ClearLookupHistoryRequest
+	(*ClearLookupHistoryResponse)(nil),               // 22: pb.ClearLookupHistoryResponse
+	(*DictationSentence)(nil),                        // 23: pb.DictationSentence
+	(*FindDictationSentencesRequest)(nil),            // 24: pb.FindDictationSentencesRequest
+	(*FindDictationSentencesResponse)(nil),           // 25: pb.FindDictationSentencesResponse
+	(*DictationWordDiff)(nil),                        // 26: pb.DictationWordDiff
+	(*DictationRecord)(nil),                          // 27: pb.DictationRecord
+	(*GradeDictationRequest)(nil),                    // 28: pb.GradeDictationRequest
+	(*GradeDictationResponse)(nil),                   // 29: pb.GradeDictationResponse
+	(*FindDictationHistoryRequest)(nil),              // 30: pb.FindDictationHistoryRequest
+	(*FindDictationHistoryResponse)(nil),             // 31: pb.FindDictationHistoryResponse
+	(*timestamppb.Timestamp)(nil),                    // 32: google.protobuf.Timestamp
+}
+var file_word_service_proto_depIdxs = []int32{
+	17, // 0: pb.FindWordByDictionaryResponse.word_meanings:type_name -> pb.WordMeaning
+	3,  // 1: pb.Example.examples:type_name -> pb.Sentence
+	17, // 2: pb.FindFavoriteWordMeaningsResponse.favorite_word_meanings:type_name -> pb.WordMeaning
+	17, // 3: pb.FindRandomFavoriteWordMeaningsResponse.favorite_word_meanings:type_name -> pb.WordMeaning
+	17, // 4: pb.FindFavoriteWordMeaningsByIdsResponse.favorite_word_meanings:type_name -> pb.WordMeaning
+	2,  // 5: pb.WordMeaning.pronunciation:type_name -> pb.Pronunciation
+	4,  // 6: pb.WordMeaning.examples:type_name -> pb.Example
+	32, // 7: pb.LookupHistory.created_at:type_name -> google.protobuf.Timestamp
+	32, // 8: pb.LookupHistory.updated_at:type_name -> google.protobuf.Timestamp
+	18, // 9: pb.FindRecentLookupsResponse.lookup_histories:type_name -> pb.LookupHistory
+	23, // 10: pb.FindDictationSentencesResponse.sentences:type_name -> pb.DictationSentence
+	26, // 11: pb.DictationRecord.diffs:type_name -> pb.DictationWordDiff
+	32, // 12: pb.DictationRecord.created_at:type_name -> google.protobuf.Timestamp
+	32, // 13: pb.DictationRecord.updated_at:type_name -> google.protobuf.Timestamp
+	27, // 14: pb.GradeDictationResponse.dictation_record:type_name -> pb.DictationRecord
+	27, // 15: pb.FindDictationHistoryResponse.dictation_records:type_name -> pb.DictationRecord
+	0,  // 16: pb.WordService.FindWordByDictionary:input_type -> pb.FindWordByDictionaryRequest
+	5,  // 17: pb.WordService.CreateFavoriteWordMeaning:input_type -> pb.CreateFavoriteWordMeaningRequest
+	7,  // 18: pb.WordService.DeleteFavoriteWordMeaning:input_type -> pb.DeleteFavoriteWordMeaningRequest
+	9,  // 19: pb.WordService.FindFavoriteWordMeanings:input_type -> pb.FindFavoriteWordMeaningsRequest
+	11, // 20: pb.WordService.FindRandomFavoriteWordMeanings:input_type -> pb.FindRandomFavoriteWordMeaningsRequest
+	13, // 21: pb.WordService.FindFavoriteWordMeaningsByIds:input_type -> pb.FindFavoriteWordMeaningsByIdsRequest
+	15, // 22: pb.WordService.MarkFavoriteWordMeaningsReviewed:input_type -> pb.MarkFavoriteWordMeaningsReviewedRequest
+	19, // 23: pb.WordService.FindRecentLookups:input_type -> pb.FindRecentLookupsRequest
+	21, // 24: pb.WordService.ClearLookupHistory:input_type -> pb.ClearLookupHistoryRequest
+	24, // 25: pb.WordService.FindDictationSentences:input_type -> pb.FindDictationSentencesRequest
+	28, // 26: pb.WordService.GradeDictation:input_type -> pb.GradeDictationRequest
+	30, // 27: pb.WordService.FindDictationHistory:input_type -> pb.FindDictationHistoryRequest
+	1,  // 28: pb.WordService.FindWordByDictionary:output_type -> pb.FindWordByDictionaryResponse
+	6,  // 29: pb.WordService.CreateFavoriteWordMeaning:output_type -> pb.CreateFavoriteWordMeaningResponse
+	8,  // 30: pb.WordService.DeleteFavoriteWordMeaning:output_type -> pb.DeleteFavoriteWordMeaningResponse
+	10, // 31: pb.WordService.FindFavoriteWordMeanings:output_type -> pb.FindFavoriteWordMeaningsResponse
+	12, // 32: pb.WordService.FindRandomFavoriteWordMeanings:output_type -> pb.FindRandomFavoriteWordMeaningsResponse
+	14, // 33: pb.WordService.FindFavoriteWordMeaningsByIds:output_type -> pb.FindFavoriteWordMeaningsByIdsResponse
+	16, // 34: pb.WordService.MarkFavoriteWordMeaningsReviewed:output_type -> pb.MarkFavoriteWordMeaningsReviewedResponse
+	20, // 35: pb.WordService.FindRecentLookups:output_type -> pb.FindRecentLookupsResponse
+	22, // 36: pb.WordService.ClearLookupHistory:output_type -> pb.ClearLookupHistoryResponse
+	25, // 37: pb.WordService.FindDictationSentences:output_type -> pb.FindDictationSentencesResponse
+	29, // 38: pb.WordService.GradeDictation:output_type -> pb.GradeDictationResponse
+	31, // 39: pb.WordService.FindDictationHistory:output_type -> pb.FindDictationHistoryResponse
+	28, // [28:40] is the sub-list for method output_type
+	16, // [16:28] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_word_service_proto_init() }
+func file_word_service_proto_init() {
+	if File_word_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_word_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindWordByDictionaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindWordByDictionaryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pronunciation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sentence); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1214,6 +2578,54 @@ func file_word_service_proto_init() {
 			}
 		}
 		file_word_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindFavoriteWordMeaningsByIdsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindFavoriteWordMeaningsByIdsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkFavoriteWordMeaningsReviewedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkFavoriteWordMeaningsReviewedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WordMeaning); i {
 			case 0:
 				return &v.state
@@ -1225,6 +2637,174 @@ func file_word_service_proto_init() {
 				return nil
 			}
 		}
+		file_word_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindRecentLookupsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindRecentLookupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearLookupHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearLookupHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DictationSentence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindDictationSentencesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindDictationSentencesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DictationWordDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DictationRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradeDictationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GradeDictationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindDictationHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindDictationHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1232,7 +2812,7 @@ func file_word_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_word_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
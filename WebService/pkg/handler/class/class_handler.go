@@ -0,0 +1,310 @@
+package class
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/kakurineuin/learn-english-microservices/web-service/pb"
+	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/microservice/examservice"
+	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/repository"
+	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/util"
+)
+
+// For mock at test
+var utilGetJWTClaims = util.GetJWTClaims
+
+type ClassHandler interface {
+	CreateClass(c echo.Context) error
+	JoinClass(c echo.Context) error
+	FindClasses(c echo.Context) error
+
+	CreateAssignment(c echo.Context) error
+	FindAssignments(c echo.Context) error
+
+	FindClassGradebook(c echo.Context) error
+	ExportClassGradebook(c echo.Context) error
+}
+
+type classHandler struct {
+	examService        examservice.ExamService
+	databaseRepository repository.DatabaseRepository
+}
+
+func NewHandler(
+	examServce examservice.ExamService,
+	databaseRepository repository.DatabaseRepository,
+) ClassHandler {
+	return &classHandler{
+		examService:        examServce,
+		databaseRepository: databaseRepository,
+	}
+}
+
+func (handler classHandler) CreateClass(c echo.Context) error {
+	type RequestBody struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+
+	errorMessage := "CreateClass failed! error: %w"
+
+	requestBody := new(RequestBody)
+	if err := c.Bind(&requestBody); err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+
+	microserviceResponse, err := handler.examService.CreateClass(
+		requestBody.Name,
+		requestBody.Description,
+		userId,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler classHandler) JoinClass(c echo.Context) error {
+	type RequestBody struct {
+		JoinCode string `json:"joinCode"`
+	}
+
+	errorMessage := "JoinClass failed! error: %w"
+
+	requestBody := new(RequestBody)
+	if err := c.Bind(&requestBody); err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+
+	microserviceResponse, err := handler.examService.JoinClass(requestBody.JoinCode, userId)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler classHandler) FindClasses(c echo.Context) error {
+	errorMessage := "FindClasses failed! error: %w"
+
+	userId := utilGetJWTClaims(c).UserId
+
+	microserviceResponse, err := handler.examService.FindClasses(userId)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler classHandler) CreateAssignment(c echo.Context) error {
+	type RequestBody struct {
+		ExamId string    `json:"examId"`
+		Title  string    `json:"title"`
+		DueAt  time.Time `json:"dueAt"`
+	}
+
+	errorMessage := "CreateAssignment failed! error: %w"
+
+	classId := ""
+	err := echo.PathParamsBinder(c).
+		String("classId", &classId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	requestBody := new(RequestBody)
+	if err := c.Bind(&requestBody); err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+
+	microserviceResponse, err := handler.examService.CreateAssignment(
+		classId,
+		requestBody.ExamId,
+		requestBody.Title,
+		requestBody.DueAt,
+		userId,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler classHandler) FindAssignments(c echo.Context) error {
+	errorMessage := "FindAssignments failed! error: %w"
+
+	classId := ""
+	err := echo.PathParamsBinder(c).
+		String("classId", &classId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+
+	microserviceResponse, err := handler.examService.FindAssignments(classId, userId)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler classHandler) FindClassGradebook(c echo.Context) error {
+	errorMessage := "FindClassGradebook failed! error: %w"
+
+	classId := ""
+	err := echo.PathParamsBinder(c).
+		String("classId", &classId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+
+	microserviceResponse, err := handler.examService.FindClassGradebook(classId, userId)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+/*
+匯出班級的成績簿 CSV，每個學生一列，每份作業有最高分數和最近一次分數兩欄，
+沒有作答的作業欄位是空白
+*/
+func (handler classHandler) ExportClassGradebook(c echo.Context) error {
+	errorMessage := "ExportClassGradebook failed! error: %w"
+
+	classId := ""
+	err := echo.PathParamsBinder(c).
+		String("classId", &classId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+
+	microserviceResponse, err := handler.examService.FindClassGradebook(classId, userId)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	// 學生的 userId 對應到使用者名稱，方便老師辨識
+	usernames := map[string]string{}
+
+	for _, student := range microserviceResponse.Class.Students {
+		user, err := handler.databaseRepository.GetUserById(
+			c.Request().Context(),
+			student.UserId,
+		)
+		if err != nil {
+			c.Logger().Error(fmt.Errorf(errorMessage, err))
+			return util.SendJSONInternalServerError(c)
+		}
+
+		if user != nil {
+			usernames[student.UserId] = user.Username
+		}
+	}
+
+	content, err := gradebookCSV(microserviceResponse, usernames)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	c.Response().Header().Set(
+		echo.HeaderContentDisposition,
+		fmt.Sprintf("attachment; filename=\"gradebook-%s.csv\"", classId),
+	)
+	return c.Blob(http.StatusOK, "text/csv; charset=UTF-8", content)
+}
+
+// 成績簿的 CSV，每份作業列出最高與最近一次的得分百分比
+func gradebookCSV(
+	gradebook *pb.FindClassGradebookResponse,
+	usernames map[string]string,
+) ([]byte, error) {
+	header := []string{"userId", "username"}
+
+	for _, assignment := range gradebook.Assignments {
+		header = append(
+			header,
+			fmt.Sprintf("%s (best %%)", assignment.Title),
+			fmt.Sprintf("%s (latest %%)", assignment.Title),
+		)
+	}
+
+	// key 是 assignmentId + userId
+	grades := map[string]*pb.ClassGrade{}
+
+	for _, grade := range gradebook.Grades {
+		grades[grade.AssignmentId+grade.UserId] = grade
+	}
+
+	records := [][]string{header}
+
+	for _, student := range gradebook.Class.Students {
+		record := []string{student.UserId, usernames[student.UserId]}
+
+		for _, assignment := range gradebook.Assignments {
+			grade, ok := grades[assignment.Id+student.UserId]
+
+			if !ok {
+				record = append(record, "", "")
+				continue
+			}
+
+			record = append(
+				record,
+				fmt.Sprintf("%.1f", grade.BestPercentage),
+				fmt.Sprintf("%.1f", grade.LatestPercentage),
+			)
+		}
+
+		records = append(records, record)
+	}
+
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+
+	if err := writer.WriteAll(records); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,317 @@
+package class
+
+import (
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kakurineuin/learn-english-microservices/web-service/pb"
+	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/microservice/examservice"
+	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/model"
+	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/repository"
+	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/util"
+)
+
+const USER_ID = "user01"
+
+type MyTestSuite struct {
+	suite.Suite
+	classHandler           classHandler
+	mockExamService        *examservice.MockExamService
+	mockDatabaseRepository *repository.MockDatabaseRepository
+}
+
+func TestMyTestSuite(t *testing.T) {
+	suite.Run(t, new(MyTestSuite))
+}
+
+// run once, before test suite methods
+func (s *MyTestSuite) SetupSuite() {
+	log.Println("SetupSuite()")
+
+	// Mock JWT
+	utilGetJWTClaims = func(c echo.Context) *util.JwtCustomClaims {
+		return &util.JwtCustomClaims{
+			UserId:           USER_ID,
+			Username:         "test01",
+			Role:             "user",
+			RegisteredClaims: jwt.RegisteredClaims{},
+		}
+	}
+
+	s.classHandler = classHandler{
+		examService:        nil,
+		databaseRepository: nil,
+	}
+}
+
+// run once, after test suite methods
+func (s *MyTestSuite) TearDownSuite() {
+	log.Println("TearDownSuite()")
+}
+
+// run before each test
+func (s *MyTestSuite) SetupTest() {
+	log.Println("SetupTest()")
+
+	// Reset mock，避免在不同測試方法之間互相影響
+	mockExamService := examservice.NewMockExamService(s.T())
+	s.classHandler.examService = mockExamService
+	s.mockExamService = mockExamService
+
+	mockDatabaseRepository := repository.NewMockDatabaseRepository(s.T())
+	s.classHandler.databaseRepository = mockDatabaseRepository
+	s.mockDatabaseRepository = mockDatabaseRepository
+}
+
+// run after each test
+func (s *MyTestSuite) TearDownTest() {
+	log.Println("TearDownTest()")
+}
+
+// run before each test
+func (s *MyTestSuite) BeforeTest(suiteName, testName string) {
+	log.Println("BeforeTest()", suiteName, testName)
+}
+
+// run after each test
+func (s *MyTestSuite) AfterTest(suiteName, testName string) {
+	log.Println("AfterTest()", suiteName, testName)
+}
+
+func (s *MyTestSuite) TestCreateClass() {
+	// Setup
+	requestJSON := `{"name": "Class A", "description": "d01"}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockExamService.EXPECT().
+		CreateClass("Class A", "d01", USER_ID).
+		Return(&pb.CreateClassResponse{
+			ClassId:  "class01",
+			JoinCode: "AB3DK9MZ",
+		}, nil)
+
+	// Test
+	err := s.classHandler.CreateClass(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"classId": "class01", "joinCode": "AB3DK9MZ"}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestJoinClass() {
+	// Setup
+	requestJSON := `{"joinCode": "ab3dk9mz"}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockExamService.EXPECT().
+		JoinClass("ab3dk9mz", USER_ID).
+		Return(&pb.JoinClassResponse{
+			ClassId: "class01",
+			Name:    "Class A",
+		}, nil)
+
+	// Test
+	err := s.classHandler.JoinClass(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"classId": "class01", "name": "Class A"}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestFindClasses() {
+	// Setup
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockExamService.EXPECT().
+		FindClasses(USER_ID).
+		Return(&pb.FindClassesResponse{
+			Classes: []*pb.Class{
+				{
+					Id:       "class01",
+					Name:     "Class A",
+					UserId:   "teacher01",
+					Students: []*pb.ClassStudent{},
+				},
+			},
+		}, nil)
+
+	// Test
+	err := s.classHandler.FindClasses(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(
+		`{
+			"classes": [
+				{
+					"id": "class01",
+					"name": "Class A",
+					"description": "",
+					"joinCode": "",
+					"userId": "teacher01",
+					"students": [],
+					"createdAt": null,
+					"updatedAt": null
+				}
+			]
+		}`,
+		rec.Body.String(),
+	)
+}
+
+func (s *MyTestSuite) TestCreateAssignment() {
+	// Setup
+	requestJSON := `{
+		"examId": "exam01",
+		"title": "homework",
+		"dueAt": "2030-01-02T15:04:05Z"
+	}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	classId := "class01"
+	c.SetParamNames("classId")
+	c.SetParamValues(classId)
+
+	dueAt := time.Date(2030, 1, 2, 15, 4, 5, 0, time.UTC)
+	s.mockExamService.EXPECT().
+		CreateAssignment(classId, "exam01", "homework", dueAt, USER_ID).
+		Return(&pb.CreateAssignmentResponse{
+			AssignmentId: "assignment01",
+		}, nil)
+
+	// Test
+	err := s.classHandler.CreateAssignment(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"assignmentId": "assignment01"}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestFindAssignments() {
+	// Setup
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	classId := "class01"
+	c.SetParamNames("classId")
+	c.SetParamValues(classId)
+
+	s.mockExamService.EXPECT().
+		FindAssignments(classId, USER_ID).
+		Return(&pb.FindAssignmentsResponse{
+			Assignments: []*pb.Assignment{
+				{
+					Id:      "assignment01",
+					ClassId: classId,
+					ExamId:  "exam01",
+					Title:   "homework",
+				},
+			},
+		}, nil)
+
+	// Test
+	err := s.classHandler.FindAssignments(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(
+		`{
+			"assignments": [
+				{
+					"id": "assignment01",
+					"classId": "class01",
+					"examId": "exam01",
+					"title": "homework",
+					"dueAt": null,
+					"createdAt": null
+				}
+			]
+		}`,
+		rec.Body.String(),
+	)
+}
+
+func (s *MyTestSuite) TestExportClassGradebook() {
+	// Setup
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	classId := "class01"
+	c.SetParamNames("classId")
+	c.SetParamValues(classId)
+
+	s.mockExamService.EXPECT().
+		FindClassGradebook(classId, USER_ID).
+		Return(&pb.FindClassGradebookResponse{
+			Class: &pb.Class{
+				Id: classId,
+				Students: []*pb.ClassStudent{
+					{UserId: "student01"},
+					{UserId: "student02"},
+				},
+			},
+			Assignments: []*pb.Assignment{
+				{Id: "assignment01", Title: "Unit 1"},
+				{Id: "assignment02", Title: "Unit 2"},
+			},
+			Grades: []*pb.ClassGrade{
+				{
+					AssignmentId:     "assignment01",
+					UserId:           "student01",
+					BestPercentage:   90,
+					LatestPercentage: 62.5,
+				},
+				{
+					AssignmentId:     "assignment02",
+					UserId:           "student02",
+					BestPercentage:   50,
+					LatestPercentage: 50,
+				},
+			},
+		}, nil)
+	s.mockDatabaseRepository.EXPECT().
+		GetUserById(mock.Anything, "student01").
+		Return(&model.User{Username: "alice"}, nil)
+	s.mockDatabaseRepository.EXPECT().
+		GetUserById(mock.Anything, "student02").
+		Return(nil, nil)
+
+	// Test
+	err := s.classHandler.ExportClassGradebook(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.Equal("text/csv; charset=UTF-8", rec.Header().Get(echo.HeaderContentType))
+	s.Equal(
+		`attachment; filename="gradebook-class01.csv"`,
+		rec.Header().Get(echo.HeaderContentDisposition),
+	)
+	s.Equal(
+		"userId,username,Unit 1 (best %),Unit 1 (latest %),Unit 2 (best %),Unit 2 (latest %)\n"+
+			"student01,alice,90.0,62.5,,\n"+
+			"student02,,,,50.0,50.0\n",
+		rec.Body.String(),
+	)
+}
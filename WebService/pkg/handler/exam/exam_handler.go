@@ -2,13 +2,22 @@ package exam
 
 import (
 	"fmt"
+	"io"
 	"net/http"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/kakurineuin/learn-english-microservices/web-service/pb"
 	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/microservice/examservice"
+	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/microservice/wordservice"
+	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/model"
 	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/repository"
 	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/util"
 )
@@ -16,46 +25,126 @@ import (
 // For mock at test
 var utilGetJWTClaims = util.GetJWTClaims
 
+// 匯入檔案的大小上限
+const maxImportFileSize = 4 * 1024 * 1024
+
+// 修改測驗與題目時，以 ETag 回傳版本號，並以 If-Match 檢查是否已被其他人修改
+const (
+	headerETag    = "ETag"
+	headerIfMatch = "If-Match"
+)
+
+// 匯入、匯出格式與對應的 Content-Type
+var examFormatContentTypes = map[string]string{
+	"json": echo.MIMEApplicationJSONCharsetUTF8,
+	"csv":  "text/csv; charset=UTF-8",
+	"gift": echo.MIMETextPlainCharsetUTF8,
+}
+
+// 練習測驗預設與最多的題數
+const (
+	defaultPracticeExamSize = 10
+	maxPracticeExamSize     = 50
+)
+
+// 作答紀錄總覽的期間，custom 需要指定開始日期
+var overviewRanges = []string{"7d", "30d", "90d", "custom"}
+
+const defaultOverviewRange = "30d"
+
+// 排行榜的期間
+var leaderboardWindows = []string{"weekly", "all_time"}
+
+const (
+	defaultLeaderboardSize = 10 // 預設回傳的排行榜筆數
+	maxLeaderboardSize     = 50 // 最多回傳的排行榜筆數
+
+	// 向 ExamService 查詢並放入 cache 的筆數，比回傳的筆數多，
+	// 是為了扣掉放入 cache 之後才設定不顯示在排行榜的使用者，仍有足夠的筆數
+	leaderboardCacheSize       = 100
+	leaderboardCacheExpiration = 5 * time.Minute
+)
+
 type ExamHandler interface {
 	CreateExam(c echo.Context) error
 	FindExams(c echo.Context) error
 	UpdateExam(c echo.Context) error
+	GetExam(c echo.Context) error
 	DeleteExam(c echo.Context) error
+	CreatePracticeExam(c echo.Context) error
+	ForkExam(c echo.Context) error
+	PublishExam(c echo.Context) error
+	ShareExam(c echo.Context) error
+	UnshareExam(c echo.Context) error
+	FindExamShares(c echo.Context) error
+	ImportExam(c echo.Context) error
+	ExportExam(c echo.Context) error
 
 	FindQuestions(c echo.Context) error
 	CreateQuestion(c echo.Context) error
 	UpdateQuestion(c echo.Context) error
+	GetQuestion(c echo.Context) error
 	DeleteQuestion(c echo.Context) error
+	BatchMutateQuestions(c echo.Context) error
 	FindRandomQuestions(c echo.Context) error
+	RevealQuestionHint(c echo.Context) error
+
+	FindBankQuestions(c echo.Context) error
+	CreateBankQuestion(c echo.Context) error
+	UpdateBankQuestion(c echo.Context) error
+	DeleteBankQuestion(c echo.Context) error
+	SetExamBankSource(c echo.Context) error
+
+	FindExamSections(c echo.Context) error
+	CreateExamSection(c echo.Context) error
+	UpdateExamSection(c echo.Context) error
+	DeleteExamSection(c echo.Context) error
 
 	CreateExamRecord(c echo.Context) error
 	FindExamRecordOverview(c echo.Context) error
 	FindExamRecords(c echo.Context) error
+	GetExamRecordDetail(c echo.Context) error
+	FindExamLeaderboard(c echo.Context) error
+	FindQuestionStatistics(c echo.Context) error
+
+	FindMistakeNotebook(c echo.Context) error
+	CreateMistakeDrill(c echo.Context) error
+	AnswerMistakeDrill(c echo.Context) error
 
 	FindExamInfosWhenNotSignIn(c echo.Context) error
 	FindExamInfosWhenSignIn(c echo.Context) error
+	FindExamCatalog(c echo.Context) error
+	FindExamsByTags(c echo.Context) error
+	FindExamTagCounts(c echo.Context) error
 }
 
 type examHandler struct {
 	examService        examservice.ExamService
+	wordService        wordservice.WordService
 	databaseRepository repository.DatabaseRepository
+	cacheRepository    repository.CacheRepository
 }
 
 func NewHandler(
 	examServce examservice.ExamService,
+	wordService wordservice.WordService,
 	databaseRepository repository.DatabaseRepository,
+	cacheRepository repository.CacheRepository,
 ) ExamHandler {
 	return &examHandler{
 		examService:        examServce,
+		wordService:        wordService,
 		databaseRepository: databaseRepository,
+		cacheRepository:    cacheRepository,
 	}
 }
 
 func (handler examHandler) CreateExam(c echo.Context) error {
 	type RequestBody struct {
-		Topic       string `json:"topic"`
-		Description string `json:"description"`
-		IsPublic    bool   `json:"isPublic"`
+		Topic       string   `json:"topic"`
+		Description string   `json:"description"`
+		Tags        []string `json:"tags"`
+		IsPublic    bool     `json:"isPublic"`
 	}
 
 	errorMessage := "CreateExam failed! error: %w"
@@ -71,6 +160,7 @@ func (handler examHandler) CreateExam(c echo.Context) error {
 	microserviceResponse, err := handler.examService.CreateExam(
 		requestBody.Topic,
 		requestBody.Description,
+		requestBody.Tags,
 		requestBody.IsPublic,
 		userId,
 	)
@@ -86,13 +176,15 @@ func (handler examHandler) FindExams(c echo.Context) error {
 	errorMessage := "FindExams failed! error: %w"
 
 	var (
-		pageIndex int32 = 0
-		pageSize  int32 = 0
+		pageIndex int32  = 0
+		pageSize  int32  = 0
+		cursor    string = ""
 	)
 
 	err := echo.QueryParamsBinder(c).
 		Int32("pageIndex", &pageIndex).
 		Int32("pageSize", &pageSize).
+		String("cursor", &cursor).
 		BindError() // returns first binding error
 	if err != nil {
 		c.Logger().Error(fmt.Errorf(errorMessage, err))
@@ -105,6 +197,7 @@ func (handler examHandler) FindExams(c echo.Context) error {
 		pageIndex,
 		pageSize,
 		userId,
+		cursor,
 	)
 	if err != nil {
 		c.Logger().Error(fmt.Errorf(errorMessage, err))
@@ -115,11 +208,24 @@ func (handler examHandler) FindExams(c echo.Context) error {
 }
 
 func (handler examHandler) UpdateExam(c echo.Context) error {
+	type Settings struct {
+		QuestionsPerAttempt    int32 `json:"questionsPerAttempt"`
+		ShuffleQuestions       bool  `json:"shuffleQuestions"`
+		ShuffleAnswers         bool  `json:"shuffleAnswers"`
+		PassingScorePercent    int32 `json:"passingScorePercent"`
+		MaxAttempts            int32 `json:"maxAttempts"`
+		ShowAnswersAfterSubmit bool  `json:"showAnswersAfterSubmit"`
+		HintPenaltyPercent     int32 `json:"hintPenaltyPercent"`
+	}
+
+	// 沒有傳入 settings 時不修改測驗原本的設定
 	type RequestBody struct {
-		ExamId      string `json:"_id"`
-		Topic       string `json:"topic"`
-		Description string `json:"description"`
-		IsPublic    bool   `json:"isPublic"`
+		ExamId      string    `json:"_id"`
+		Topic       string    `json:"topic"`
+		Description string    `json:"description"`
+		Tags        []string  `json:"tags"`
+		IsPublic    bool      `json:"isPublic"`
+		Settings    *Settings `json:"settings"`
 	}
 
 	errorMessage := "UpdateExam failed! error: %w"
@@ -130,25 +236,55 @@ func (handler examHandler) UpdateExam(c echo.Context) error {
 		return util.SendJSONBadRequest(c)
 	}
 
+	expectedVersion, err := ifMatchVersion(c)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	var settings *pb.ExamSettings = nil
+
+	if requestBody.Settings != nil {
+		settings = &pb.ExamSettings{
+			QuestionsPerAttempt:    requestBody.Settings.QuestionsPerAttempt,
+			ShuffleQuestions:       requestBody.Settings.ShuffleQuestions,
+			ShuffleAnswers:         requestBody.Settings.ShuffleAnswers,
+			PassingScorePercent:    requestBody.Settings.PassingScorePercent,
+			MaxAttempts:            requestBody.Settings.MaxAttempts,
+			ShowAnswersAfterSubmit: requestBody.Settings.ShowAnswersAfterSubmit,
+			HintPenaltyPercent:     requestBody.Settings.HintPenaltyPercent,
+		}
+	}
+
 	userId := utilGetJWTClaims(c).UserId
 
 	microserviceResponse, err := handler.examService.UpdateExam(
 		requestBody.ExamId,
 		requestBody.Topic,
 		requestBody.Description,
+		requestBody.Tags,
 		requestBody.IsPublic,
+		settings,
+		expectedVersion,
 		userId,
 	)
 	if err != nil {
 		c.Logger().Error(fmt.Errorf(errorMessage, err))
+
+		if status.Code(err) == codes.Aborted {
+			return util.SendJSONConflict(c)
+		}
+
 		return util.SendJSONInternalServerError(c)
 	}
 
+	setETag(c, microserviceResponse.Version)
 	return util.SendJSONResponse(c, microserviceResponse)
 }
 
-func (handler examHandler) DeleteExam(c echo.Context) error {
-	errorMessage := "DeleteExam failed! error: %w"
+// 查詢要修改的測驗，以 ETag 回傳版本號，修改時以 If-Match 傳回
+func (handler examHandler) GetExam(c echo.Context) error {
+	errorMessage := "GetExam failed! error: %w"
 
 	examId := ""
 	err := echo.PathParamsBinder(c).
@@ -160,25 +296,20 @@ func (handler examHandler) DeleteExam(c echo.Context) error {
 	}
 
 	userId := utilGetJWTClaims(c).UserId
-
-	_, err = handler.examService.DeleteExam(examId, userId)
+	microserviceResponse, err := handler.examService.GetExam(examId, userId)
 	if err != nil {
 		c.Logger().Error(fmt.Errorf(errorMessage, err))
 		return util.SendJSONInternalServerError(c)
 	}
 
-	return c.NoContent(http.StatusOK)
+	setETag(c, microserviceResponse.Exam.GetVersion())
+	return util.SendJSONResponse(c, microserviceResponse)
 }
 
-func (handler examHandler) FindQuestions(c echo.Context) error {
-	errorMessage := "FindQuestions failed! error: %w"
-
-	var (
-		examId    string = ""
-		pageIndex int32  = 0
-		pageSize  int32  = 0
-	)
+func (handler examHandler) DeleteExam(c echo.Context) error {
+	errorMessage := "DeleteExam failed! error: %w"
 
+	examId := ""
 	err := echo.PathParamsBinder(c).
 		String("examId", &examId).
 		BindError() // returns first binding error
@@ -187,47 +318,30 @@ func (handler examHandler) FindQuestions(c echo.Context) error {
 		return util.SendJSONBadRequest(c)
 	}
 
-	err = echo.QueryParamsBinder(c).
-		Int32("pageIndex", &pageIndex).
-		Int32("pageSize", &pageSize).
-		BindError() // returns first binding error
-	if err != nil {
-		c.Logger().Error(fmt.Errorf(errorMessage, err))
-		return util.SendJSONBadRequest(c)
-	}
-
 	userId := utilGetJWTClaims(c).UserId
 
-	microserviceResponse, err := handler.examService.FindQuestions(
-		pageIndex,
-		pageSize,
-		examId,
-		userId,
-	)
+	_, err = handler.examService.DeleteExam(examId, userId)
 	if err != nil {
 		c.Logger().Error(fmt.Errorf(errorMessage, err))
 		return util.SendJSONInternalServerError(c)
 	}
 
-	return util.SendJSONResponse(c, microserviceResponse)
+	return c.NoContent(http.StatusOK)
 }
 
-func (handler examHandler) CreateQuestion(c echo.Context) error {
+func (handler examHandler) CreatePracticeExam(c echo.Context) error {
 	type RequestBody struct {
-		Ask     string   `json:"ask"`
-		Answers []string `json:"answers"`
+		Topic         string   `json:"topic"`
+		Description   string   `json:"description"`
+		Size          int32    `json:"size"`
+		Weighting     string   `json:"weighting"`
+		QuestionTypes []string `json:"questionTypes"`
+
+		// 使用者挑選的單字卡，有值時只用這些喜歡的單字解釋出題，否則隨機取出 size 個
+		FavoriteWordMeaningIds []string `json:"favoriteWordMeaningIds"`
 	}
 
-	errorMessage := "CreateQuestion failed! error: %w"
-
-	examId := ""
-	err := echo.PathParamsBinder(c).
-		String("examId", &examId).
-		BindError() // returns first binding error
-	if err != nil {
-		c.Logger().Error(fmt.Errorf(errorMessage, err))
-		return util.SendJSONBadRequest(c)
-	}
+	errorMessage := "CreatePracticeExam failed! error: %w"
 
 	requestBody := new(RequestBody)
 	if err := c.Bind(&requestBody); err != nil {
@@ -235,57 +349,119 @@ func (handler examHandler) CreateQuestion(c echo.Context) error {
 		return util.SendJSONBadRequest(c)
 	}
 
-	userId := utilGetJWTClaims(c).UserId
-	microserviceResponse, err := handler.examService.CreateQuestion(
-		examId,
-		requestBody.Ask,
-		requestBody.Answers,
-		userId,
-	)
-	if err != nil {
-		c.Logger().Error(fmt.Errorf(errorMessage, err))
-		return util.SendJSONInternalServerError(c)
+	if requestBody.Size == 0 {
+		requestBody.Size = defaultPracticeExamSize
 	}
 
-	return util.SendJSONResponse(c, microserviceResponse)
-}
+	if requestBody.Size < 0 || requestBody.Size > maxPracticeExamSize {
+		c.Logger().Error(
+			fmt.Errorf(errorMessage, fmt.Errorf("invalid size: %d", requestBody.Size)),
+		)
+		return util.SendJSONBadRequest(c)
+	}
 
-func (handler examHandler) UpdateQuestion(c echo.Context) error {
-	type RequestBody struct {
-		QuestionId string   `json:"_id"`
-		Ask        string   `json:"ask"`
-		Answers    []string `json:"answers"`
+	if len(requestBody.FavoriteWordMeaningIds) > maxPracticeExamSize {
+		c.Logger().Error(
+			fmt.Errorf(
+				errorMessage,
+				fmt.Errorf(
+					"too many favoriteWordMeaningIds: %d",
+					len(requestBody.FavoriteWordMeaningIds),
+				),
+			),
+		)
+		return util.SendJSONBadRequest(c)
 	}
 
-	errorMessage := "UpdateQuestion failed! error: %w"
+	if requestBody.Topic == "" {
+		requestBody.Topic = fmt.Sprintf("單字練習 %s", time.Now().Format("2006-01-02"))
+	}
 
-	requestBody := new(RequestBody)
-	if err := c.Bind(&requestBody); err != nil {
-		c.Logger().Error(fmt.Errorf(errorMessage, err))
+	userId := utilGetJWTClaims(c).UserId
+
+	var favoriteWordMeanings []*pb.WordMeaning
+
+	if len(requestBody.FavoriteWordMeaningIds) > 0 {
+		// 使用挑選的單字卡出題
+		wordServiceResponse, err := handler.wordService.FindFavoriteWordMeaningsByIds(
+			requestBody.FavoriteWordMeaningIds,
+			userId,
+		)
+		if err != nil {
+			c.Logger().Error(fmt.Errorf(errorMessage, err))
+			return util.SendJSONInternalServerError(c)
+		}
+
+		favoriteWordMeanings = wordServiceResponse.FavoriteWordMeanings
+	} else {
+		// 從喜歡的單字解釋中隨機取出要練習的單字
+		wordServiceResponse, err := handler.wordService.FindRandomFavoriteWordMeanings(
+			userId,
+			requestBody.Size,
+			requestBody.Weighting,
+		)
+		if err != nil {
+			c.Logger().Error(fmt.Errorf(errorMessage, err))
+			return util.SendJSONInternalServerError(c)
+		}
+
+		favoriteWordMeanings = wordServiceResponse.FavoriteWordMeanings
+	}
+
+	if len(favoriteWordMeanings) == 0 {
+		c.Logger().Error(fmt.Errorf(errorMessage, fmt.Errorf("no favorite word meanings")))
 		return util.SendJSONBadRequest(c)
 	}
 
-	userId := utilGetJWTClaims(c).UserId
-	microserviceResponse, err := handler.examService.UpdateQuestion(
-		requestBody.QuestionId,
-		requestBody.Ask,
-		requestBody.Answers,
+	practiceWords := []*pb.PracticeWord{}
+
+	for _, wordMeaning := range favoriteWordMeanings {
+		sentences := []string{}
+
+		for _, example := range wordMeaning.Examples {
+			for _, sentence := range example.Examples {
+				sentences = append(sentences, sentence.Text)
+			}
+		}
+
+		practiceWords = append(practiceWords, &pb.PracticeWord{
+			WordMeaningId: wordMeaning.Id,
+			Word:          wordMeaning.Word,
+			Definition:    wordMeaning.Definition,
+			Sentences:     sentences,
+		})
+	}
+
+	microserviceResponse, err := handler.examService.CreatePracticeExam(
+		requestBody.Topic,
+		requestBody.Description,
+		practiceWords,
+		requestBody.QuestionTypes,
 		userId,
 	)
 	if err != nil {
 		c.Logger().Error(fmt.Errorf(errorMessage, err))
+
+		// 題型不正確或無法產生任何題目
+		if status.Code(err) == codes.InvalidArgument {
+			return util.SendJSONBadRequest(c)
+		}
+
 		return util.SendJSONInternalServerError(c)
 	}
 
 	return util.SendJSONResponse(c, microserviceResponse)
 }
 
-func (handler examHandler) DeleteQuestion(c echo.Context) error {
-	errorMessage := "DeleteQuestion failed! error: %w"
+/*
+將公開的測驗和全部題目複製到登入者的帳號之下
+*/
+func (handler examHandler) ForkExam(c echo.Context) error {
+	errorMessage := "ForkExam failed! error: %w"
 
-	questionId := ""
+	examId := ""
 	err := echo.PathParamsBinder(c).
-		String("questionId", &questionId).
+		String("examId", &examId).
 		BindError() // returns first binding error
 	if err != nil {
 		c.Logger().Error(fmt.Errorf(errorMessage, err))
@@ -293,20 +469,19 @@ func (handler examHandler) DeleteQuestion(c echo.Context) error {
 	}
 
 	userId := utilGetJWTClaims(c).UserId
-	_, err = handler.examService.DeleteQuestion(
-		questionId,
-		userId,
-	)
+
+	microserviceResponse, err := handler.examService.ForkExam(examId, userId)
 	if err != nil {
 		c.Logger().Error(fmt.Errorf(errorMessage, err))
 		return util.SendJSONInternalServerError(c)
 	}
 
-	return c.NoContent(http.StatusOK)
+	return util.SendJSONResponse(c, microserviceResponse)
 }
 
-func (handler examHandler) FindRandomQuestions(c echo.Context) error {
-	errorMessage := "FindRandomQuestions failed! error: %w"
+// 發佈測驗，將目前的題目建立成新的版本並公開測驗
+func (handler examHandler) PublishExam(c echo.Context) error {
+	errorMessage := "PublishExam failed! error: %w"
 
 	examId := ""
 	err := echo.PathParamsBinder(c).
@@ -318,13 +493,8 @@ func (handler examHandler) FindRandomQuestions(c echo.Context) error {
 	}
 
 	userId := utilGetJWTClaims(c).UserId
-	var size int32 = 10
 
-	microserviceResponse, err := handler.examService.FindRandomQuestions(
-		examId,
-		userId,
-		size,
-	)
+	microserviceResponse, err := handler.examService.PublishExam(examId, userId)
 	if err != nil {
 		c.Logger().Error(fmt.Errorf(errorMessage, err))
 		return util.SendJSONInternalServerError(c)
@@ -333,17 +503,22 @@ func (handler examHandler) FindRandomQuestions(c echo.Context) error {
 	return util.SendJSONResponse(c, microserviceResponse)
 }
 
-func (handler examHandler) CreateExamRecord(c echo.Context) error {
+// 分享測驗給其他使用者，role 是 editor（共同編輯者）或 taker（作答者）
+func (handler examHandler) ShareExam(c echo.Context) error {
 	type RequestBody struct {
-		Score            int32    `json:"score"`
-		WrongQuestionIds []string `json:"wrongQuestionIds"`
+		Role string `json:"role"`
 	}
 
-	errorMessage := "CreateExamRecord failed! error: %w"
+	errorMessage := "ShareExam failed! error: %w"
+
+	var (
+		examId       string = ""
+		sharedUserId string = ""
+	)
 
-	examId := ""
 	err := echo.PathParamsBinder(c).
 		String("examId", &examId).
+		String("userId", &sharedUserId).
 		BindError() // returns first binding error
 	if err != nil {
 		c.Logger().Error(fmt.Errorf(errorMessage, err))
@@ -357,10 +532,10 @@ func (handler examHandler) CreateExamRecord(c echo.Context) error {
 	}
 
 	userId := utilGetJWTClaims(c).UserId
-	microserviceResponse, err := handler.examService.CreateExamRecord(
+	_, err = handler.examService.ShareExam(
 		examId,
-		requestBody.Score,
-		requestBody.WrongQuestionIds,
+		sharedUserId,
+		requestBody.Role,
 		userId,
 	)
 	if err != nil {
@@ -368,15 +543,20 @@ func (handler examHandler) CreateExamRecord(c echo.Context) error {
 		return util.SendJSONInternalServerError(c)
 	}
 
-	return util.SendJSONResponse(c, microserviceResponse)
+	return c.NoContent(http.StatusOK)
 }
 
-func (handler examHandler) FindExamRecordOverview(c echo.Context) error {
-	errorMessage := "FindExamRecordOverview failed! error: %w"
+func (handler examHandler) UnshareExam(c echo.Context) error {
+	errorMessage := "UnshareExam failed! error: %w"
+
+	var (
+		examId       string = ""
+		sharedUserId string = ""
+	)
 
-	examId := ""
 	err := echo.PathParamsBinder(c).
 		String("examId", &examId).
+		String("userId", &sharedUserId).
 		BindError() // returns first binding error
 	if err != nil {
 		c.Logger().Error(fmt.Errorf(errorMessage, err))
@@ -384,29 +564,19 @@ func (handler examHandler) FindExamRecordOverview(c echo.Context) error {
 	}
 
 	userId := utilGetJWTClaims(c).UserId
-	startDate := time.Now().AddDate(0, 0, -29)
-	microserviceResponse, err := handler.examService.FindExamRecordOverview(
-		examId,
-		userId,
-		startDate,
-	)
+	_, err = handler.examService.UnshareExam(examId, sharedUserId, userId)
 	if err != nil {
 		c.Logger().Error(fmt.Errorf(errorMessage, err))
 		return util.SendJSONInternalServerError(c)
 	}
 
-	return util.SendJSONResponse(c, microserviceResponse)
+	return c.NoContent(http.StatusOK)
 }
 
-func (handler examHandler) FindExamRecords(c echo.Context) error {
-	errorMessage := "FindExamRecords failed! error: %w"
-
-	var (
-		examId    string = ""
-		pageIndex int32  = 0
-		pageSize  int32  = 0
-	)
+func (handler examHandler) FindExamShares(c echo.Context) error {
+	errorMessage := "FindExamShares failed! error: %w"
 
+	examId := ""
 	err := echo.PathParamsBinder(c).
 		String("examId", &examId).
 		BindError() // returns first binding error
@@ -415,23 +585,8 @@ func (handler examHandler) FindExamRecords(c echo.Context) error {
 		return util.SendJSONBadRequest(c)
 	}
 
-	err = echo.QueryParamsBinder(c).
-		Int32("pageIndex", &pageIndex).
-		Int32("pageSize", &pageSize).
-		BindError() // returns first binding error
-	if err != nil {
-		c.Logger().Error(fmt.Errorf(errorMessage, err))
-		return util.SendJSONBadRequest(c)
-	}
-
 	userId := utilGetJWTClaims(c).UserId
-
-	microserviceResponse, err := handler.examService.FindExamRecords(
-		pageIndex,
-		pageSize,
-		examId,
-		userId,
-	)
+	microserviceResponse, err := handler.examService.FindExamShares(examId, userId)
 	if err != nil {
 		c.Logger().Error(fmt.Errorf(errorMessage, err))
 		return util.SendJSONInternalServerError(c)
@@ -441,30 +596,61 @@ func (handler examHandler) FindExamRecords(c echo.Context) error {
 }
 
 /*
-若尚未登入，則只能看到系統管理員公開的 ExamInfo
+上傳檔案匯入測驗，未指定 format 時依副檔名判斷格式，
+內容有錯誤時不會匯入，並在回應的 errors 中列出每個錯誤所在的行數
 */
-func (handler examHandler) FindExamInfosWhenNotSignIn(c echo.Context) error {
-	errorMessage := "FindExamInfosWhenNotSignIn failed! error: %w"
+func (handler examHandler) ImportExam(c echo.Context) error {
+	errorMessage := "ImportExam failed! error: %w"
 
-	databaseRepository := handler.databaseRepository
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
 
-	// Get admin userId
-	adminUser, err := databaseRepository.GetAdminUser(c.Request().Context())
+	if fileHeader.Size > maxImportFileSize {
+		c.Logger().Error(fmt.Errorf(errorMessage, fmt.Errorf("file is too large: %d", fileHeader.Size)))
+		return util.SendJSONBadRequest(c)
+	}
+
+	format := c.FormValue("format")
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(fileHeader.Filename)), ".")
+	}
+
+	if _, ok := examFormatContentTypes[format]; !ok {
+		c.Logger().Error(fmt.Errorf(errorMessage, fmt.Errorf("invalid format: %s", format)))
+		return util.SendJSONBadRequest(c)
+	}
+
+	file, err := fileHeader.Open()
 	if err != nil {
 		c.Logger().Error(fmt.Errorf(errorMessage, err))
 		return util.SendJSONInternalServerError(c)
 	}
+	defer file.Close()
 
-	// 若尚未建立 admin user
-	if adminUser == nil {
-		return util.SendJSONResponse(c, &pb.FindExamInfosResponse{
-			ExamInfos: []*pb.ExamInfo{},
-		})
+	content, err := io.ReadAll(file)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
 	}
 
-	microserviceResponse, err := handler.examService.FindExamInfos(
-		adminUser.Id.Hex(),
-		true,
+	form, err := c.MultipartForm()
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+
+	microserviceResponse, err := handler.examService.ImportExam(
+		c.FormValue("topic"),
+		c.FormValue("description"),
+		form.Value["tag"],
+		format,
+		content,
+		userId,
 	)
 	if err != nil {
 		c.Logger().Error(fmt.Errorf(errorMessage, err))
@@ -475,70 +661,1558 @@ func (handler examHandler) FindExamInfosWhenNotSignIn(c echo.Context) error {
 }
 
 /*
-若已經登入，則能看到系統管理員公開的 ExamInfo 和自己全部的 ExamInfo
+匯出測驗成檔案下載，內容由 ExamService 分批串流回來，收到後直接寫入回應
 */
+func (handler examHandler) ExportExam(c echo.Context) error {
+	errorMessage := "ExportExam failed! error: %w"
 
-func (handler examHandler) FindExamInfosWhenSignIn(c echo.Context) error {
-	errorMessage := "FindExamInfosWhenSignIn failed! error: %w"
-
-	databaseRepository := handler.databaseRepository
-
-	// Get admin userId
-	adminUser, err := databaseRepository.GetAdminUser(c.Request().Context())
-	if err != nil {
-		c.Logger().Error(fmt.Errorf(errorMessage, err))
+	examId := ""
+	format := "json"
+	err := echo.PathParamsBinder(c).
+		String("examId", &examId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	err = echo.QueryParamsBinder(c).
+		String("format", &format).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	contentType, ok := examFormatContentTypes[format]
+	if !ok {
+		c.Logger().Error(fmt.Errorf(errorMessage, fmt.Errorf("invalid format: %s", format)))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+
+	stream, err := handler.examService.ExportExam(examId, userId, format)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	// 先收到第一批內容再送出回應標頭，讓權限不足等錯誤仍能回傳錯誤的狀態碼
+	response, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, contentType)
+	c.Response().Header().Set(
+		echo.HeaderContentDisposition,
+		fmt.Sprintf("attachment; filename=\"exam-%s.%s\"", examId, format),
+	)
+	c.Response().WriteHeader(http.StatusOK)
+
+	for err == nil {
+		if _, err = c.Response().Write(response.Chunk); err != nil {
+			break
+		}
+
+		c.Response().Flush()
+		response, err = stream.Recv()
+	}
+
+	// 已經送出回應標頭，串流中途發生錯誤時只能記錄
+	if err != io.EOF {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+	}
+
+	return nil
+}
+
+func (handler examHandler) FindQuestions(c echo.Context) error {
+	errorMessage := "FindQuestions failed! error: %w"
+
+	var (
+		examId    string = ""
+		pageIndex int32  = 0
+		pageSize  int32  = 0
+		cursor    string = ""
+	)
+
+	err := echo.PathParamsBinder(c).
+		String("examId", &examId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	err = echo.QueryParamsBinder(c).
+		Int32("pageIndex", &pageIndex).
+		Int32("pageSize", &pageSize).
+		String("cursor", &cursor).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+
+	microserviceResponse, err := handler.examService.FindQuestions(
+		pageIndex,
+		pageSize,
+		examId,
+		userId,
+		cursor,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler examHandler) CreateQuestion(c echo.Context) error {
+	type RequestBody struct {
+		Ask             string     `json:"ask"`
+		Answers         []string   `json:"answers"`
+		AcceptedAnswers [][]string `json:"acceptedAnswers"`
+		Points          int32      `json:"points"`
+		PartialCredit   bool       `json:"partialCredit"`
+		Hint            string     `json:"hint"`
+		Explanation     string     `json:"explanation"`
+		SectionId       string     `json:"sectionId"`
+	}
+
+	errorMessage := "CreateQuestion failed! error: %w"
+
+	examId := ""
+	err := echo.PathParamsBinder(c).
+		String("examId", &examId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	requestBody := new(RequestBody)
+	if err := c.Bind(&requestBody); err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+	microserviceResponse, err := handler.examService.CreateQuestion(
+		examId,
+		requestBody.Ask,
+		requestBody.Answers,
+		toPBAcceptedAnswers(requestBody.AcceptedAnswers),
+		requestBody.Points,
+		requestBody.PartialCredit,
+		requestBody.Hint,
+		requestBody.Explanation,
+		requestBody.SectionId,
+		userId,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler examHandler) UpdateQuestion(c echo.Context) error {
+	type RequestBody struct {
+		QuestionId      string     `json:"_id"`
+		Ask             string     `json:"ask"`
+		Answers         []string   `json:"answers"`
+		AcceptedAnswers [][]string `json:"acceptedAnswers"`
+		Points          int32      `json:"points"`
+		PartialCredit   bool       `json:"partialCredit"`
+		Hint            string     `json:"hint"`
+		Explanation     string     `json:"explanation"`
+		SectionId       string     `json:"sectionId"`
+	}
+
+	errorMessage := "UpdateQuestion failed! error: %w"
+
+	requestBody := new(RequestBody)
+	if err := c.Bind(&requestBody); err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	expectedVersion, err := ifMatchVersion(c)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+	microserviceResponse, err := handler.examService.UpdateQuestion(
+		requestBody.QuestionId,
+		requestBody.Ask,
+		requestBody.Answers,
+		toPBAcceptedAnswers(requestBody.AcceptedAnswers),
+		requestBody.Points,
+		requestBody.PartialCredit,
+		requestBody.Hint,
+		requestBody.Explanation,
+		requestBody.SectionId,
+		expectedVersion,
+		userId,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+
+		if status.Code(err) == codes.Aborted {
+			return util.SendJSONConflict(c)
+		}
+
+		return util.SendJSONInternalServerError(c)
+	}
+
+	setETag(c, microserviceResponse.Version)
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+// 查詢要修改的題目，以 ETag 回傳版本號，修改時以 If-Match 傳回
+func (handler examHandler) GetQuestion(c echo.Context) error {
+	errorMessage := "GetQuestion failed! error: %w"
+
+	questionId := ""
+	err := echo.PathParamsBinder(c).
+		String("questionId", &questionId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+	microserviceResponse, err := handler.examService.GetQuestion(questionId, userId)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	setETag(c, microserviceResponse.Question.GetVersion())
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler examHandler) DeleteQuestion(c echo.Context) error {
+	errorMessage := "DeleteQuestion failed! error: %w"
+
+	questionId := ""
+	err := echo.PathParamsBinder(c).
+		String("questionId", &questionId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+	_, err = handler.examService.DeleteQuestion(
+		questionId,
+		userId,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+func (handler examHandler) BatchMutateQuestions(c echo.Context) error {
+	type Operation struct {
+		Type          string   `json:"type"`
+		QuestionId    string   `json:"questionId"`
+		Ask           string   `json:"ask"`
+		Answers       []string `json:"answers"`
+		Points        int32    `json:"points"`
+		PartialCredit bool     `json:"partialCredit"`
+		Hint          string   `json:"hint"`
+		Explanation   string   `json:"explanation"`
+		SectionId     string   `json:"sectionId"`
+	}
+
+	type RequestBody struct {
+		Operations []Operation `json:"operations"`
+	}
+
+	errorMessage := "BatchMutateQuestions failed! error: %w"
+
+	examId := ""
+	err := echo.PathParamsBinder(c).
+		String("examId", &examId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	requestBody := new(RequestBody)
+	if err := c.Bind(&requestBody); err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	operations := []*pb.QuestionOperation{}
+
+	for _, operation := range requestBody.Operations {
+		operations = append(operations, &pb.QuestionOperation{
+			Type:          operation.Type,
+			QuestionId:    operation.QuestionId,
+			Ask:           operation.Ask,
+			Answers:       operation.Answers,
+			Points:        operation.Points,
+			PartialCredit: operation.PartialCredit,
+			Hint:          operation.Hint,
+			Explanation:   operation.Explanation,
+			SectionId:     operation.SectionId,
+		})
+	}
+
+	// 操作不合法時 applied 為 false，results 會說明每個操作的錯誤
+	userId := utilGetJWTClaims(c).UserId
+	microserviceResponse, err := handler.examService.BatchMutateQuestions(
+		examId,
+		operations,
+		userId,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler examHandler) FindRandomQuestions(c echo.Context) error {
+	errorMessage := "FindRandomQuestions failed! error: %w"
+
+	examId := ""
+	err := echo.PathParamsBinder(c).
+		String("examId", &examId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+	var size int32 = 10
+
+	microserviceResponse, err := handler.examService.FindRandomQuestions(
+		examId,
+		userId,
+		size,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+
+		if status.Code(err) == codes.FailedPrecondition {
+			return util.SendJSONForbidden(c)
+		}
+
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+// 作答時不回傳提示，查看提示由 ExamService 記錄在這次作答中，交卷時依此扣分
+func (handler examHandler) RevealQuestionHint(c echo.Context) error {
+	type RequestBody struct {
+		QuestionId string `json:"questionId"`
+	}
+
+	errorMessage := "RevealQuestionHint failed! error: %w"
+
+	examId := ""
+	examAttemptId := ""
+	err := echo.PathParamsBinder(c).
+		String("examId", &examId).
+		String("examAttemptId", &examAttemptId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	requestBody := new(RequestBody)
+	if err := c.Bind(&requestBody); err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+	microserviceResponse, err := handler.examService.RevealQuestionHint(
+		examId,
+		examAttemptId,
+		requestBody.QuestionId,
+		userId,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+
+		if status.Code(err) == codes.FailedPrecondition {
+			return util.SendJSONForbidden(c)
+		}
+
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler examHandler) FindExamSections(c echo.Context) error {
+	errorMessage := "FindExamSections failed! error: %w"
+
+	examId := ""
+	err := echo.PathParamsBinder(c).
+		String("examId", &examId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+	microserviceResponse, err := handler.examService.FindExamSections(
+		examId,
+		userId,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler examHandler) CreateExamSection(c echo.Context) error {
+	type RequestBody struct {
+		Type     string `json:"type"`
+		Title    string `json:"title"`
+		Passage  string `json:"passage"`
+		AudioUrl string `json:"audioUrl"`
+		Order    int32  `json:"order"`
+	}
+
+	errorMessage := "CreateExamSection failed! error: %w"
+
+	examId := ""
+	err := echo.PathParamsBinder(c).
+		String("examId", &examId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	requestBody := new(RequestBody)
+	if err := c.Bind(&requestBody); err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+	microserviceResponse, err := handler.examService.CreateExamSection(
+		examId,
+		requestBody.Type,
+		requestBody.Title,
+		requestBody.Passage,
+		requestBody.AudioUrl,
+		requestBody.Order,
+		userId,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler examHandler) UpdateExamSection(c echo.Context) error {
+	type RequestBody struct {
+		SectionId string `json:"_id"`
+		Type      string `json:"type"`
+		Title     string `json:"title"`
+		Passage   string `json:"passage"`
+		AudioUrl  string `json:"audioUrl"`
+		Order     int32  `json:"order"`
+	}
+
+	errorMessage := "UpdateExamSection failed! error: %w"
+
+	requestBody := new(RequestBody)
+	if err := c.Bind(&requestBody); err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+	microserviceResponse, err := handler.examService.UpdateExamSection(
+		requestBody.SectionId,
+		requestBody.Type,
+		requestBody.Title,
+		requestBody.Passage,
+		requestBody.AudioUrl,
+		requestBody.Order,
+		userId,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+// 刪除題組時，題組的題目會改為獨立的題目
+func (handler examHandler) DeleteExamSection(c echo.Context) error {
+	errorMessage := "DeleteExamSection failed! error: %w"
+
+	sectionId := ""
+	err := echo.PathParamsBinder(c).
+		String("sectionId", &sectionId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+	_, err = handler.examService.DeleteExamSection(
+		sectionId,
+		userId,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// 前端只傳送開始作答時取得的 examAttemptId 與作答內容，是否答對與得分都由 ExamService 評分
+func (handler examHandler) CreateExamRecord(c echo.Context) error {
+	type Answer struct {
+		QuestionId       string   `json:"questionId"`
+		Answer           string   `json:"answer"`
+		BlankAnswers     []string `json:"blankAnswers"`
+		TimeSpentSeconds int32    `json:"timeSpentSeconds"`
+	}
+
+	type RequestBody struct {
+		ExamAttemptId   string   `json:"examAttemptId"`
+		DurationSeconds int32    `json:"durationSeconds"`
+		Answers         []Answer `json:"answers"`
+	}
+
+	errorMessage := "CreateExamRecord failed! error: %w"
+
+	examId := ""
+	err := echo.PathParamsBinder(c).
+		String("examId", &examId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	requestBody := new(RequestBody)
+	if err := c.Bind(&requestBody); err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	answers := []*pb.ExamAttemptAnswer{}
+
+	for _, answer := range requestBody.Answers {
+		answers = append(answers, &pb.ExamAttemptAnswer{
+			QuestionId:       answer.QuestionId,
+			Answer:           answer.Answer,
+			BlankAnswers:     answer.BlankAnswers,
+			TimeSpentSeconds: answer.TimeSpentSeconds,
+		})
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+	microserviceResponse, err := handler.examService.CreateExamRecord(
+		examId,
+		requestBody.ExamAttemptId,
+		requestBody.DurationSeconds,
+		answers,
+		userId,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+
+		if status.Code(err) == codes.FailedPrecondition {
+			return util.SendJSONForbidden(c)
+		}
+
+		return util.SendJSONInternalServerError(c)
+	}
+
+	// 作答紀錄已經新增成功，清除排行榜 cache 失敗只記錄錯誤，等 cache 過期後就會更新
+	keys := []string{}
+
+	now := time.Now()
+
+	for _, window := range leaderboardWindows {
+		keys = append(keys, leaderboardCacheKey(examId, window, now))
+	}
+
+	err = handler.cacheRepository.DeleteLeaderboards(c.Request().Context(), keys)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler examHandler) FindExamRecordOverview(c echo.Context) error {
+	errorMessage := "FindExamRecordOverview failed! error: %w"
+
+	examId := ""
+	err := echo.PathParamsBinder(c).
+		String("examId", &examId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	// version 是要查看的測驗版本，未指定時查看最新版本，
+	// timezone 是使用者的 IANA 時區，未指定時為 UTC，
+	// range 為 custom 時，startDate 與 endDate 的格式為 2006-01-02，endDate 未指定時為今天
+	var (
+		examVersion         int32  = 0
+		dateRange           string = defaultOverviewRange
+		timezone            string = ""
+		strStartDate        string = ""
+		strEndDate          string = ""
+		movingAverageWindow int32  = 0
+	)
+	err = echo.QueryParamsBinder(c).
+		Int32("version", &examVersion).
+		String("range", &dateRange).
+		String("timezone", &timezone).
+		String("startDate", &strStartDate).
+		String("endDate", &strEndDate).
+		Int32("movingAverageWindow", &movingAverageWindow).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	if !slices.Contains(overviewRanges, dateRange) {
+		c.Logger().Error(fmt.Errorf(errorMessage, fmt.Errorf("invalid range: %q", dateRange)))
+		return util.SendJSONBadRequest(c)
+	}
+
+	var startDate, endDate time.Time
+
+	if dateRange == "custom" {
+		startDate, err = time.Parse(time.DateOnly, strStartDate)
+		if err != nil {
+			c.Logger().Error(fmt.Errorf(errorMessage, err))
+			return util.SendJSONBadRequest(c)
+		}
+
+		if strEndDate != "" {
+			endDate, err = time.Parse(time.DateOnly, strEndDate)
+			if err != nil {
+				c.Logger().Error(fmt.Errorf(errorMessage, err))
+				return util.SendJSONBadRequest(c)
+			}
+		}
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+	microserviceResponse, err := handler.examService.FindExamRecordOverview(
+		examId,
+		userId,
+		startDate,
+		endDate,
+		examVersion,
+		dateRange,
+		timezone,
+		movingAverageWindow,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler examHandler) FindExamRecords(c echo.Context) error {
+	errorMessage := "FindExamRecords failed! error: %w"
+
+	var (
+		examId    string = ""
+		pageIndex int32  = 0
+		pageSize  int32  = 0
+		cursor    string = ""
+	)
+
+	err := echo.PathParamsBinder(c).
+		String("examId", &examId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	err = echo.QueryParamsBinder(c).
+		Int32("pageIndex", &pageIndex).
+		Int32("pageSize", &pageSize).
+		String("cursor", &cursor).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+
+	microserviceResponse, err := handler.examService.FindExamRecords(
+		pageIndex,
+		pageSize,
+		examId,
+		userId,
+		cursor,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+// 逐題回顧作答紀錄的作答內容
+func (handler examHandler) GetExamRecordDetail(c echo.Context) error {
+	errorMessage := "GetExamRecordDetail failed! error: %w"
+
+	examId := ""
+	examRecordId := ""
+	err := echo.PathParamsBinder(c).
+		String("examId", &examId).
+		String("recordId", &examRecordId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+	microserviceResponse, err := handler.examService.GetExamRecordDetail(
+		examId,
+		examRecordId,
+		userId,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+/*
+若尚未登入，則只能看到系統管理員公開的 ExamInfo
+*/
+func (handler examHandler) FindExamInfosWhenNotSignIn(c echo.Context) error {
+	errorMessage := "FindExamInfosWhenNotSignIn failed! error: %w"
+
+	databaseRepository := handler.databaseRepository
+
+	// 可用 ?tag=a&tag=b 篩選同時包含這些標籤的測驗
+	tags := c.QueryParams()["tag"]
+
+	// Get admin userId
+	adminUser, err := databaseRepository.GetAdminUser(c.Request().Context())
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	// 若尚未建立 admin user
+	if adminUser == nil {
+		return util.SendJSONResponse(c, &pb.FindExamInfosResponse{
+			ExamInfos: []*pb.ExamInfo{},
+		})
+	}
+
+	microserviceResponse, err := handler.examService.FindExamInfos(
+		adminUser.Id.Hex(),
+		true,
+		tags,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+/*
+若已經登入，則能看到系統管理員公開的 ExamInfo 和自己全部的 ExamInfo
+*/
+
+func (handler examHandler) FindExamInfosWhenSignIn(c echo.Context) error {
+	errorMessage := "FindExamInfosWhenSignIn failed! error: %w"
+
+	databaseRepository := handler.databaseRepository
+
+	// 可用 ?tag=a&tag=b 篩選同時包含這些標籤的測驗
+	tags := c.QueryParams()["tag"]
+
+	// Get admin userId
+	adminUser, err := databaseRepository.GetAdminUser(c.Request().Context())
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	// 系統管理員公開的測驗
+	adminUserPBPublicExamInfos := []*pb.ExamInfo{}
+
+	if adminUser != nil {
+		microserviceResponse, err := handler.examService.FindExamInfos(
+			adminUser.Id.Hex(),
+			true,
+			tags,
+		)
+		if err != nil {
+			c.Logger().Error(fmt.Errorf(errorMessage, err))
+			return util.SendJSONInternalServerError(c)
+		}
+
+		adminUserPBPublicExamInfos = microserviceResponse.ExamInfos
+	}
+
+	response := &pb.FindExamInfosResponse{}
+	response.ExamInfos = append(response.ExamInfos, adminUserPBPublicExamInfos...)
+
+	// 查詢登入者的測驗
+	userId := utilGetJWTClaims(c).UserId
+
+	// 若登入者不是系統管理員
+	if adminUser != nil && userId != adminUser.Id.Hex() {
+		microserviceResponse, err := handler.examService.FindExamInfos(
+			userId,
+			true,
+			tags,
+		)
+		if err != nil {
+			c.Logger().Error(fmt.Errorf(errorMessage, err))
+			return util.SendJSONInternalServerError(c)
+		}
+
+		// 使用者公開的測驗
+		userPBPublicExamInfos := microserviceResponse.ExamInfos
+		response.ExamInfos = append(response.ExamInfos, userPBPublicExamInfos...)
+	}
+
+	microserviceResponse, err := handler.examService.FindExamInfos(
+		userId,
+		false,
+		tags,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	// 使用者私有的測驗
+	userPBPrivateExamInfos := microserviceResponse.ExamInfos
+	response.ExamInfos = append(response.ExamInfos, userPBPrivateExamInfos...)
+	return util.SendJSONResponse(c, response)
+}
+
+/*
+公開的測驗目錄，不需要登入，可用 keyword 搜尋、tag 篩選，並用 sortBy 指定排序方式
+*/
+func (handler examHandler) FindExamCatalog(c echo.Context) error {
+	errorMessage := "FindExamCatalog failed! error: %w"
+
+	var (
+		keyword   string = ""
+		sortBy    string = ""
+		pageIndex int32  = 0
+		pageSize  int32  = 0
+	)
+
+	err := echo.QueryParamsBinder(c).
+		String("keyword", &keyword).
+		String("sortBy", &sortBy).
+		Int32("pageIndex", &pageIndex).
+		Int32("pageSize", &pageSize).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	tags := c.QueryParams()["tag"]
+
+	microserviceResponse, err := handler.examService.FindExamCatalog(
+		keyword,
+		tags,
+		sortBy,
+		pageIndex,
+		pageSize,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+/*
+查詢包含全部指定標籤的測驗，登入者可以看到公開的測驗和自己的測驗
+*/
+func (handler examHandler) FindExamsByTags(c echo.Context) error {
+	errorMessage := "FindExamsByTags failed! error: %w"
+
+	var (
+		pageIndex int32 = 0
+		pageSize  int32 = 0
+	)
+
+	err := echo.QueryParamsBinder(c).
+		Int32("pageIndex", &pageIndex).
+		Int32("pageSize", &pageSize).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	tags := c.QueryParams()["tag"]
+	if len(tags) == 0 {
+		c.Logger().Error(fmt.Errorf(errorMessage, fmt.Errorf("tag is empty")))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+
+	microserviceResponse, err := handler.examService.FindExamsByTags(
+		tags,
+		userId,
+		pageIndex,
+		pageSize,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+/*
+查詢標籤與使用次數，用於顯示標籤雲
+*/
+func (handler examHandler) FindExamTagCounts(c echo.Context) error {
+	errorMessage := "FindExamTagCounts failed! error: %w"
+
+	var size int32 = 0
+
+	err := echo.QueryParamsBinder(c).
+		Int32("size", &size).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+
+	microserviceResponse, err := handler.examService.FindExamTagCounts(userId, size)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+/*
+查詢公開測驗的排行榜，ExamService 的結果會放入 cache，
+不顯示在排行榜的使用者在查詢 ExamService 時就排除，不佔排行榜的名額，
+放入 cache 之後才變更設定的使用者在讀取時過濾，讓使用者變更設定後立即生效
+*/
+func (handler examHandler) FindExamLeaderboard(c echo.Context) error {
+	type LeaderboardEntry struct {
+		Rank            int32     `json:"rank"`
+		UserId          string    `json:"userId"`
+		Username        string    `json:"username"`
+		Score           int32     `json:"score"`
+		Percentage      float64   `json:"percentage"`
+		DurationSeconds int32     `json:"durationSeconds"`
+		CreatedAt       time.Time `json:"createdAt"`
+	}
+
+	errorMessage := "FindExamLeaderboard failed! error: %w"
+
+	examId := ""
+	err := echo.PathParamsBinder(c).
+		String("examId", &examId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	window := "all_time"
+	var size int32 = defaultLeaderboardSize
+	err = echo.QueryParamsBinder(c).
+		String("window", &window).
+		Int32("size", &size).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	isValidWindow := false
+
+	for _, leaderboardWindow := range leaderboardWindows {
+		if window == leaderboardWindow {
+			isValidWindow = true
+			break
+		}
+	}
+
+	if !isValidWindow {
+		c.Logger().Error(fmt.Errorf(errorMessage, fmt.Errorf("invalid window: %q", window)))
+		return util.SendJSONBadRequest(c)
+	}
+
+	if size < 1 || size > maxLeaderboardSize {
+		c.Logger().Error(fmt.Errorf(errorMessage, fmt.Errorf("invalid size: %d", size)))
+		return util.SendJSONBadRequest(c)
+	}
+
+	// 先檢查 cache
+	cacheRepository := handler.cacheRepository
+	key := leaderboardCacheKey(examId, window, time.Now())
+	leaderboardEntries, err := cacheRepository.FindLeaderboard(c.Request().Context(), key)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	// 若 cache 無資料，就呼叫 ExamService
+	if len(leaderboardEntries) == 0 {
+		hiddenUserIds, err := handler.databaseRepository.FindUserIdsByHideFromLeaderboard(
+			c.Request().Context(),
+		)
+		if err != nil {
+			c.Logger().Error(fmt.Errorf(errorMessage, err))
+			return util.SendJSONInternalServerError(c)
+		}
+
+		microserviceResponse, err := handler.examService.FindExamLeaderboard(
+			examId,
+			window,
+			leaderboardCacheSize,
+			hiddenUserIds,
+		)
+		if err != nil {
+			c.Logger().Error(fmt.Errorf(errorMessage, err))
+			return util.SendJSONInternalServerError(c)
+		}
+
+		leaderboardEntries = microserviceResponse.Entries
+
+		if len(leaderboardEntries) > 0 {
+			err = cacheRepository.CreateLeaderboard(
+				c.Request().Context(),
+				key,
+				leaderboardEntries,
+				leaderboardCacheExpiration,
+			)
+			if err != nil {
+				c.Logger().Error(fmt.Errorf(errorMessage, err))
+				return util.SendJSONInternalServerError(c)
+			}
+		}
+	}
+
+	result := []LeaderboardEntry{}
+
+	if len(leaderboardEntries) == 0 {
+		return c.JSON(http.StatusOK, echo.Map{
+			"entries": result,
+		})
+	}
+
+	userIds := []string{}
+
+	for _, leaderboardEntry := range leaderboardEntries {
+		userIds = append(userIds, leaderboardEntry.UserId)
+	}
+
+	users, err := handler.databaseRepository.FindUsersByIds(c.Request().Context(), userIds)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	userMap := map[string]model.User{}
+
+	for _, user := range users {
+		userMap[user.Id.Hex()] = user
+	}
+
+	for _, leaderboardEntry := range leaderboardEntries {
+		if int32(len(result)) >= size {
+			break
+		}
+
+		// 查無使用者或放入 cache 之後才設定不顯示在排行榜，名次由後面的使用者遞補
+		user, ok := userMap[leaderboardEntry.UserId]
+		if !ok || user.HideFromLeaderboard {
+			continue
+		}
+
+		result = append(result, LeaderboardEntry{
+			Rank:            int32(len(result)) + 1,
+			UserId:          leaderboardEntry.UserId,
+			Username:        user.Username,
+			Score:           leaderboardEntry.Score,
+			Percentage:      leaderboardEntry.Percentage,
+			DurationSeconds: leaderboardEntry.DurationSeconds,
+			CreatedAt:       leaderboardEntry.CreatedAt.AsTime(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"entries": result,
+	})
+}
+
+func (handler examHandler) FindQuestionStatistics(c echo.Context) error {
+	errorMessage := "FindQuestionStatistics failed! error: %w"
+
+	examId := ""
+	err := echo.PathParamsBinder(c).
+		String("examId", &examId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+	microserviceResponse, err := handler.examService.FindQuestionStatistics(examId, userId)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
 		return util.SendJSONInternalServerError(c)
 	}
 
-	// 系統管理員公開的測驗
-	adminUserPBPublicExamInfos := []*pb.ExamInfo{}
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+/*
+從 If-Match 取得讀取時的版本號，ETag 的格式為 "版本號"，
+沒有 If-Match 或是 * 時回傳 nil，表示不檢查版本號，"0" 表示讀取時還沒有版本號的舊資料
+*/
+func ifMatchVersion(c echo.Context) (*int32, error) {
+	ifMatch := strings.TrimSpace(c.Request().Header.Get(headerIfMatch))
+
+	if ifMatch == "" || ifMatch == "*" {
+		return nil, nil
+	}
+
+	version, err := strconv.ParseInt(strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`), 10, 32)
+	if err != nil || version < 0 {
+		return nil, fmt.Errorf("Invalid If-Match: %s", ifMatch)
+	}
 
-	if adminUser != nil {
-		microserviceResponse, err := handler.examService.FindExamInfos(
-			adminUser.Id.Hex(),
-			true,
+	expectedVersion := int32(version)
+	return &expectedVersion, nil
+}
+
+func setETag(c echo.Context, version int32) {
+	c.Response().Header().Set(headerETag, fmt.Sprintf(`"%d"`, version))
+}
+
+// 每個填空可以接受的其他答案，依照答案的順序
+func toPBAcceptedAnswers(acceptedAnswers [][]string) []*pb.AcceptedAnswers {
+	pbAcceptedAnswers := []*pb.AcceptedAnswers{}
+
+	for _, answers := range acceptedAnswers {
+		pbAcceptedAnswers = append(pbAcceptedAnswers, &pb.AcceptedAnswers{Answers: answers})
+	}
+
+	return pbAcceptedAnswers
+}
+
+// 本週排行榜的 key 加上本週的開始日期，跨週之後就不會讀到上週的 cache
+func leaderboardCacheKey(examId, window string, now time.Time) string {
+	if window == "weekly" {
+		return fmt.Sprintf(
+			"%s:%s:%s",
+			examId,
+			window,
+			leaderboardWeekStart(now).Format(time.DateOnly),
 		)
+	}
+
+	return fmt.Sprintf("%s:%s", examId, window)
+}
+
+// 本週的開始時間，和 ExamService 相同是星期一 00:00 UTC
+func leaderboardWeekStart(now time.Time) time.Time {
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	// time.Sunday 是 0，往前推到星期一
+	days := (int(today.Weekday()) + 6) % 7
+	return today.AddDate(0, 0, -days)
+}
+
+func (handler examHandler) FindMistakeNotebook(c echo.Context) error {
+	errorMessage := "FindMistakeNotebook failed! error: %w"
+
+	// examId 未指定時查詢全部測驗的錯題，
+	// startDate 與 endDate 的格式為 2006-01-02，包含 endDate 當天，依照 timezone 計算，未指定時為 UTC
+	var (
+		examId       string = ""
+		strStartDate string = ""
+		strEndDate   string = ""
+		timezone     string = ""
+		pageIndex    int32  = 0
+		pageSize     int32  = 10
+	)
+	err := echo.QueryParamsBinder(c).
+		String("examId", &examId).
+		String("startDate", &strStartDate).
+		String("endDate", &strEndDate).
+		String("timezone", &timezone).
+		Int32("pageIndex", &pageIndex).
+		Int32("pageSize", &pageSize).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	var startDate, endDate time.Time
+
+	if strStartDate != "" {
+		startDate, err = time.ParseInLocation(time.DateOnly, strStartDate, location)
 		if err != nil {
 			c.Logger().Error(fmt.Errorf(errorMessage, err))
-			return util.SendJSONInternalServerError(c)
+			return util.SendJSONBadRequest(c)
 		}
+	}
 
-		adminUserPBPublicExamInfos = microserviceResponse.ExamInfos
+	if strEndDate != "" {
+		endDate, err = time.ParseInLocation(time.DateOnly, strEndDate, location)
+		if err != nil {
+			c.Logger().Error(fmt.Errorf(errorMessage, err))
+			return util.SendJSONBadRequest(c)
+		}
+
+		// ExamService 的結束時間不包含在範圍內，所以改成隔天的開始
+		endDate = endDate.AddDate(0, 0, 1)
 	}
 
-	response := &pb.FindExamInfosResponse{}
-	response.ExamInfos = append(response.ExamInfos, adminUserPBPublicExamInfos...)
+	userId := utilGetJWTClaims(c).UserId
+	microserviceResponse, err := handler.examService.FindMistakeNotebook(
+		userId,
+		examId,
+		startDate,
+		endDate,
+		pageIndex,
+		pageSize,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler examHandler) CreateMistakeDrill(c echo.Context) error {
+	type RequestBody struct {
+		ExamId string `json:"examId"`
+		Size   int32  `json:"size"`
+	}
+
+	errorMessage := "CreateMistakeDrill failed! error: %w"
+
+	requestBody := new(RequestBody)
+	if err := c.Bind(&requestBody); err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
 
-	// 查詢登入者的測驗
 	userId := utilGetJWTClaims(c).UserId
+	microserviceResponse, err := handler.examService.CreateMistakeDrill(
+		userId,
+		requestBody.ExamId,
+		requestBody.Size,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
 
-	// 若登入者不是系統管理員
-	if adminUser != nil && userId != adminUser.Id.Hex() {
-		microserviceResponse, err := handler.examService.FindExamInfos(
-			userId,
-			true,
-		)
-		if err != nil {
-			c.Logger().Error(fmt.Errorf(errorMessage, err))
-			return util.SendJSONInternalServerError(c)
-		}
+	return util.SendJSONResponse(c, microserviceResponse)
+}
 
-		// 使用者公開的測驗
-		userPBPublicExamInfos := microserviceResponse.ExamInfos
-		response.ExamInfos = append(response.ExamInfos, userPBPublicExamInfos...)
+func (handler examHandler) AnswerMistakeDrill(c echo.Context) error {
+	// 是否答對由 ExamService 評分
+	type RequestBody struct {
+		BlankAnswers []string `json:"blankAnswers"`
 	}
 
-	microserviceResponse, err := handler.examService.FindExamInfos(
+	errorMessage := "AnswerMistakeDrill failed! error: %w"
+
+	answerWrongId := ""
+	err := echo.PathParamsBinder(c).
+		String("answerWrongId", &answerWrongId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	requestBody := new(RequestBody)
+	if err := c.Bind(&requestBody); err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+	microserviceResponse, err := handler.examService.AnswerMistakeDrill(
+		answerWrongId,
 		userId,
-		false,
+		requestBody.BlankAnswers,
 	)
 	if err != nil {
 		c.Logger().Error(fmt.Errorf(errorMessage, err))
 		return util.SendJSONInternalServerError(c)
 	}
 
-	// 使用者私有的測驗
-	userPBPrivateExamInfos := microserviceResponse.ExamInfos
-	response.ExamInfos = append(response.ExamInfos, userPBPrivateExamInfos...)
-	return util.SendJSONResponse(c, response)
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler examHandler) FindBankQuestions(c echo.Context) error {
+	errorMessage := "FindBankQuestions failed! error: %w"
+
+	var (
+		pageIndex int32  = 0
+		pageSize  int32  = 0
+		tag       string = ""
+	)
+
+	err := echo.QueryParamsBinder(c).
+		Int32("pageIndex", &pageIndex).
+		Int32("pageSize", &pageSize).
+		String("tag", &tag).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+	microserviceResponse, err := handler.examService.FindBankQuestions(
+		pageIndex,
+		pageSize,
+		tag,
+		userId,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler examHandler) CreateBankQuestion(c echo.Context) error {
+	type RequestBody struct {
+		Ask           string   `json:"ask"`
+		Answers       []string `json:"answers"`
+		Tags          []string `json:"tags"`
+		Points        int32    `json:"points"`
+		PartialCredit bool     `json:"partialCredit"`
+		Hint          string   `json:"hint"`
+		Explanation   string   `json:"explanation"`
+	}
+
+	errorMessage := "CreateBankQuestion failed! error: %w"
+
+	requestBody := new(RequestBody)
+	if err := c.Bind(&requestBody); err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+	microserviceResponse, err := handler.examService.CreateBankQuestion(
+		requestBody.Ask,
+		requestBody.Answers,
+		requestBody.Tags,
+		requestBody.Points,
+		requestBody.PartialCredit,
+		requestBody.Hint,
+		requestBody.Explanation,
+		userId,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler examHandler) UpdateBankQuestion(c echo.Context) error {
+	type RequestBody struct {
+		BankQuestionId string   `json:"_id"`
+		Ask            string   `json:"ask"`
+		Answers        []string `json:"answers"`
+		Tags           []string `json:"tags"`
+		Points         int32    `json:"points"`
+		PartialCredit  bool     `json:"partialCredit"`
+		Hint           string   `json:"hint"`
+		Explanation    string   `json:"explanation"`
+	}
+
+	errorMessage := "UpdateBankQuestion failed! error: %w"
+
+	requestBody := new(RequestBody)
+	if err := c.Bind(&requestBody); err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+	microserviceResponse, err := handler.examService.UpdateBankQuestion(
+		requestBody.BankQuestionId,
+		requestBody.Ask,
+		requestBody.Answers,
+		requestBody.Tags,
+		requestBody.Points,
+		requestBody.PartialCredit,
+		requestBody.Hint,
+		requestBody.Explanation,
+		userId,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler examHandler) DeleteBankQuestion(c echo.Context) error {
+	errorMessage := "DeleteBankQuestion failed! error: %w"
+
+	bankQuestionId := ""
+	err := echo.PathParamsBinder(c).
+		String("bankQuestionId", &bankQuestionId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+	_, err = handler.examService.DeleteBankQuestion(
+		bankQuestionId,
+		userId,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// 設定測驗引用的題庫題目與隨機抽題，會取代原本的設定
+func (handler examHandler) SetExamBankSource(c echo.Context) error {
+	type Pool struct {
+		Tag  string `json:"tag"`
+		Size int32  `json:"size"`
+	}
+
+	type RequestBody struct {
+		QuestionIds []string `json:"questionIds"`
+		Pools       []Pool   `json:"pools"`
+	}
+
+	errorMessage := "SetExamBankSource failed! error: %w"
+
+	examId := ""
+	err := echo.PathParamsBinder(c).
+		String("examId", &examId).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	requestBody := new(RequestBody)
+	if err := c.Bind(&requestBody); err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	pools := []*pb.ExamBankPool{}
+
+	for _, pool := range requestBody.Pools {
+		pools = append(pools, &pb.ExamBankPool{
+			Tag:  pool.Tag,
+			Size: pool.Size,
+		})
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+	microserviceResponse, err := handler.examService.SetExamBankSource(
+		examId,
+		requestBody.QuestionIds,
+		pools,
+		userId,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
 }
@@ -1,21 +1,31 @@
 package exam
 
 import (
+	"bytes"
+	"errors"
+	"io"
 	"log"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/kakurineuin/learn-english-microservices/web-service/pb"
 	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/microservice/examservice"
+	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/microservice/wordservice"
 	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/model"
 	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/repository"
 	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/util"
@@ -27,7 +37,9 @@ type MyTestSuite struct {
 	suite.Suite
 	examHandler            examHandler
 	mockExamService        *examservice.MockExamService
+	mockWordService        *wordservice.MockWordService
 	mockDatabaseRepository *repository.MockDatabaseRepository
+	mockCacheRepository    *repository.MockCacheRepository
 }
 
 func TestMyTestSuite(t *testing.T) {
@@ -50,7 +62,9 @@ func (s *MyTestSuite) SetupSuite() {
 
 	s.examHandler = examHandler{
 		examService:        nil,
+		wordService:        nil,
 		databaseRepository: nil,
+		cacheRepository:    nil,
 	}
 }
 
@@ -68,9 +82,17 @@ func (s *MyTestSuite) SetupTest() {
 	s.examHandler.examService = mockExamService
 	s.mockExamService = mockExamService
 
+	mockWordService := wordservice.NewMockWordService(s.T())
+	s.examHandler.wordService = mockWordService
+	s.mockWordService = mockWordService
+
 	mockDatabaseRepository := repository.NewMockDatabaseRepository(s.T())
 	s.examHandler.databaseRepository = mockDatabaseRepository
 	s.mockDatabaseRepository = mockDatabaseRepository
+
+	mockCacheRepository := repository.NewMockCacheRepository(s.T())
+	s.examHandler.cacheRepository = mockCacheRepository
+	s.mockCacheRepository = mockCacheRepository
 }
 
 // run after each test
@@ -93,6 +115,7 @@ func (s *MyTestSuite) TestCreateExam() {
 	requestJSON := `{
   	"topic": "t01",
   	"description": "d01",
+  	"tags": ["toeic"],
   	"isPublic": false
 	}`
 	e := echo.New()
@@ -102,7 +125,7 @@ func (s *MyTestSuite) TestCreateExam() {
 	c := e.NewContext(req, rec)
 
 	s.mockExamService.EXPECT().
-		CreateExam("t01", "d01", false, USER_ID).
+		CreateExam("t01", "d01", []string{"toeic"}, false, USER_ID).
 		Return(&pb.CreateExamResponse{
 			ExamId: "exam01",
 		}, nil)
@@ -126,7 +149,7 @@ func (s *MyTestSuite) TestFindExams() {
 	c := e.NewContext(req, rec)
 
 	s.mockExamService.EXPECT().
-		FindExams(int32(0), int32(10), USER_ID).
+		FindExams(int32(0), int32(10), USER_ID, "").
 		Return(&pb.FindExamsResponse{
 			Total:     1,
 			PageCount: 1,
@@ -155,9 +178,43 @@ func (s *MyTestSuite) TestFindExams() {
 		"tags": [],
 		"isPublic": true,
 		"userId": "`+USER_ID+`",
+		"forkedFromExamId": "",
+		"forkedFromUserId": "",
+		"latestVersion": 0,
+		"settings": null,
+		"bankSource": null,
+		"version": 0,
 		"createdAt": null,
 		"updatedAt": null
-	}]}`, rec.Body.String())
+	}], "nextCursor": ""}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestFindExams_WhenUseCursor() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Set("pageSize", "10")
+	q.Set("cursor", "cursor01")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockExamService.EXPECT().
+		FindExams(int32(0), int32(10), USER_ID, "cursor01").
+		Return(&pb.FindExamsResponse{
+			Exams:      []*pb.Exam{},
+			NextCursor: "cursor02",
+		}, nil)
+
+	// Test
+	err := s.examHandler.FindExams(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(
+		`{"total": 0, "pageCount": 0, "exams": [], "nextCursor": "cursor02"}`,
+		rec.Body.String(),
+	)
 }
 
 func (s *MyTestSuite) TestUpdateExam() {
@@ -166,154 +223,219 @@ func (s *MyTestSuite) TestUpdateExam() {
 		"_id": "exam01",
   	"topic": "t01",
   	"description": "d01",
+  	"tags": ["toeic"],
   	"isPublic": false
 	}`
 	e := echo.New()
 	req := httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(requestJSON))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("If-Match", `"3"`)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 
 	s.mockExamService.EXPECT().
-		UpdateExam("exam01", "t01", "d01", false, USER_ID).
+		UpdateExam(
+			"exam01",
+			"t01",
+			"d01",
+			[]string{"toeic"},
+			false,
+			(*pb.ExamSettings)(nil),
+			int32Pointer(3),
+			USER_ID,
+		).
 		Return(&pb.UpdateExamResponse{
-			ExamId: "exam01",
+			ExamId:  "exam01",
+			Version: 4,
 		}, nil)
 
 	// Test
 	err := s.examHandler.UpdateExam(c)
 	s.Nil(err)
 	s.Equal(http.StatusOK, rec.Code)
-	s.JSONEq(`{"examId": "exam01"}`, rec.Body.String())
+	s.Equal(`"4"`, rec.Header().Get("ETag"))
+	s.JSONEq(`{"examId": "exam01", "version": 4}`, rec.Body.String())
 }
 
-func (s *MyTestSuite) TestDeleteExam() {
+func (s *MyTestSuite) TestUpdateExam_WithSettings() {
 	// Setup
+	requestJSON := `{
+		"_id": "exam01",
+		"topic": "t01",
+		"description": "d01",
+		"tags": [],
+		"isPublic": true,
+		"settings": {
+			"questionsPerAttempt": 20,
+			"shuffleQuestions": true,
+			"shuffleAnswers": false,
+			"passingScorePercent": 60,
+			"maxAttempts": 3,
+			"showAnswersAfterSubmit": false,
+			"hintPenaltyPercent": 50
+		}
+	}`
 	e := echo.New()
-	req := httptest.NewRequest(http.MethodDelete, "/", nil)
+	req := httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(requestJSON))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 
-	examId := "exam01"
-	c.SetParamNames("examId")
-	c.SetParamValues(examId)
-
 	s.mockExamService.EXPECT().
-		DeleteExam(examId, USER_ID).
-		Return(&pb.DeleteExamResponse{}, nil)
+		UpdateExam(
+			"exam01",
+			"t01",
+			"d01",
+			[]string{},
+			true,
+			&pb.ExamSettings{
+				QuestionsPerAttempt: 20,
+				ShuffleQuestions:    true,
+				PassingScorePercent: 60,
+				MaxAttempts:         3,
+				HintPenaltyPercent:  50,
+			},
+			(*int32)(nil),
+			USER_ID,
+		).
+		Return(&pb.UpdateExamResponse{
+			ExamId:  "exam01",
+			Version: 1,
+		}, nil)
 
 	// Test
-	err := s.examHandler.DeleteExam(c)
+	err := s.examHandler.UpdateExam(c)
 	s.Nil(err)
 	s.Equal(http.StatusOK, rec.Code)
-	s.Empty(rec.Body.String())
+	s.JSONEq(`{"examId": "exam01", "version": 1}`, rec.Body.String())
 }
 
-func (s *MyTestSuite) TestFindQuestions() {
+func (s *MyTestSuite) TestUpdateExam_WhenVersionConflicts() {
 	// Setup
+	requestJSON := `{
+		"_id": "exam01",
+		"topic": "t01",
+		"description": "d01",
+		"tags": [],
+		"isPublic": true
+	}`
 	e := echo.New()
-	q := make(url.Values)
-	q.Set("pageIndex", "0")
-	q.Set("pageSize", "10")
-	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req := httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(requestJSON))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("If-Match", `W/"2"`)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 
-	examId := "exam01"
-	c.SetParamNames("examId")
-	c.SetParamValues(examId)
-
 	s.mockExamService.EXPECT().
-		FindQuestions(int32(0), int32(10), examId, USER_ID).
-		Return(&pb.FindQuestionsResponse{
-			Total:     1,
-			PageCount: 1,
-			Questions: []*pb.Question{
-				{
-					Id:        "id01",
-					ExamId:    examId,
-					Ask:       "ask01",
-					Answers:   []string{"a01", "a02"},
-					UserId:    USER_ID,
-					CreatedAt: nil,
-					UpdatedAt: nil,
-				},
-			},
-		}, nil)
+		UpdateExam(
+			"exam01",
+			"t01",
+			"d01",
+			[]string{},
+			true,
+			(*pb.ExamSettings)(nil),
+			int32Pointer(2),
+			USER_ID,
+		).
+		Return(nil, status.Error(codes.Aborted, "Version conflict"))
 
 	// Test
-	err := s.examHandler.FindQuestions(c)
+	err := s.examHandler.UpdateExam(c)
 	s.Nil(err)
-	s.Equal(http.StatusOK, rec.Code)
-	s.JSONEq(`{"total": 1, "pageCount": 1, "questions": [{
-		"_id": "id01",
-		"examId": "`+examId+`",
-		"ask": "ask01",
-		"answers": ["a01", "a02"],
-		"userId": "`+USER_ID+`",
-		"createdAt": null,
-		"updatedAt": null
-	}]}`, rec.Body.String())
+	s.Equal(http.StatusConflict, rec.Code)
+	s.Empty(rec.Header().Get("ETag"))
 }
 
-func (s *MyTestSuite) TestCreateQuestion() {
+func (s *MyTestSuite) TestUpdateExam_WhenIfMatchIsInvalid() {
 	// Setup
 	requestJSON := `{
-  	"ask": "ask01",
-  	"answers": ["a01", "a02"]
+		"_id": "exam01",
+		"topic": "t01",
+		"description": "d01",
+		"tags": [],
+		"isPublic": true
 	}`
 	e := echo.New()
-	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(requestJSON))
+	req := httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(requestJSON))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("If-Match", `"abc"`)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 
-	examId := "exam01"
-	c.SetParamNames("examId")
-	c.SetParamValues(examId)
-
-	s.mockExamService.EXPECT().
-		CreateQuestion(examId, "ask01", []string{"a01", "a02"}, USER_ID).
-		Return(&pb.CreateQuestionResponse{
-			QuestionId: "question01",
-		}, nil)
-
 	// Test
-	err := s.examHandler.CreateQuestion(c)
+	err := s.examHandler.UpdateExam(c)
 	s.Nil(err)
-	s.Equal(http.StatusOK, rec.Code)
-	s.JSONEq(`{"questionId": "question01"}`, rec.Body.String())
+	s.Equal(http.StatusBadRequest, rec.Code)
 }
 
-func (s *MyTestSuite) TestUpdateQuestion() {
+func (s *MyTestSuite) TestUpdateExam_WhenIfMatchIsZero() {
 	// Setup
 	requestJSON := `{
-		"_id": "question01",
-  	"ask": "ask01",
-  	"answers": ["a01", "a02"]
+		"_id": "exam01",
+		"topic": "t01",
+		"description": "d01",
+		"tags": [],
+		"isPublic": true
 	}`
 	e := echo.New()
 	req := httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(requestJSON))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("If-Match", `"0"`)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 
+	// 舊資料的版本為 0，If-Match 為 "0" 時仍須檢查版本
 	s.mockExamService.EXPECT().
-		UpdateQuestion("question01", "ask01", []string{"a01", "a02"}, USER_ID).
-		Return(&pb.UpdateQuestionResponse{
-			QuestionId: "question01",
+		UpdateExam(
+			"exam01",
+			"t01",
+			"d01",
+			[]string{},
+			true,
+			(*pb.ExamSettings)(nil),
+			int32Pointer(0),
+			USER_ID,
+		).
+		Return(nil, status.Error(codes.Aborted, "Version conflict"))
+
+	// Test
+	err := s.examHandler.UpdateExam(c)
+	s.Nil(err)
+	s.Equal(http.StatusConflict, rec.Code)
+}
+
+func (s *MyTestSuite) TestGetExam() {
+	// Setup
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	examId := "exam01"
+	c.SetParamNames("examId")
+	c.SetParamValues(examId)
+
+	s.mockExamService.EXPECT().
+		GetExam(examId, USER_ID).
+		Return(&pb.GetExamResponse{
+			Exam: &pb.Exam{
+				Id:      examId,
+				Topic:   "t01",
+				UserId:  USER_ID,
+				Version: 2,
+			},
 		}, nil)
 
 	// Test
-	err := s.examHandler.UpdateQuestion(c)
+	err := s.examHandler.GetExam(c)
 	s.Nil(err)
 	s.Equal(http.StatusOK, rec.Code)
-	s.JSONEq(`{"questionId": "question01"}`, rec.Body.String())
+	s.Equal(`"2"`, rec.Header().Get("ETag"))
+	s.Regexp(`"version":\s*2`, rec.Body.String())
 }
 
-func (s *MyTestSuite) TestDeleteQuestion() {
+func (s *MyTestSuite) TestDeleteExam() {
 	// Setup
 	e := echo.New()
 	req := httptest.NewRequest(http.MethodDelete, "/", nil)
@@ -321,25 +443,25 @@ func (s *MyTestSuite) TestDeleteQuestion() {
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 
-	questionId := "question01"
-	c.SetParamNames("questionId")
-	c.SetParamValues(questionId)
+	examId := "exam01"
+	c.SetParamNames("examId")
+	c.SetParamValues(examId)
 
 	s.mockExamService.EXPECT().
-		DeleteQuestion(questionId, USER_ID).
-		Return(&pb.DeleteQuestionResponse{}, nil)
+		DeleteExam(examId, USER_ID).
+		Return(&pb.DeleteExamResponse{}, nil)
 
 	// Test
-	err := s.examHandler.DeleteQuestion(c)
+	err := s.examHandler.DeleteExam(c)
 	s.Nil(err)
 	s.Equal(http.StatusOK, rec.Code)
 	s.Empty(rec.Body.String())
 }
 
-func (s *MyTestSuite) TestFindRandomQuestions() {
+func (s *MyTestSuite) TestForkExam() {
 	// Setup
 	e := echo.New()
-	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
@@ -347,67 +469,25 @@ func (s *MyTestSuite) TestFindRandomQuestions() {
 	examId := "exam01"
 	c.SetParamNames("examId")
 	c.SetParamValues(examId)
-	var size int32 = 10
 
 	s.mockExamService.EXPECT().
-		FindRandomQuestions(examId, USER_ID, size).
-		Return(&pb.FindRandomQuestionsResponse{
-			Exam: &pb.Exam{
-				Id:          "examId01",
-				Topic:       "t01",
-				Description: "d01",
-				Tags:        []string{},
-				IsPublic:    true,
-				UserId:      USER_ID,
-				CreatedAt:   nil,
-				UpdatedAt:   nil,
-			},
-			Questions: []*pb.Question{
-				{
-					Id:        "id01",
-					ExamId:    examId,
-					Ask:       "ask01",
-					Answers:   []string{"a01", "a02"},
-					UserId:    USER_ID,
-					CreatedAt: nil,
-					UpdatedAt: nil,
-				},
-			},
+		ForkExam(examId, USER_ID).
+		Return(&pb.ForkExamResponse{
+			ExamId:        "exam02",
+			QuestionCount: 10,
 		}, nil)
 
 	// Test
-	err := s.examHandler.FindRandomQuestions(c)
+	err := s.examHandler.ForkExam(c)
 	s.Nil(err)
 	s.Equal(http.StatusOK, rec.Code)
-	s.JSONEq(`{"exam": {
-		"_id": "examId01",
-		"topic": "t01",
-		"description": "d01",
-		"tags": [],
-		"isPublic": true,
-		"userId": "`+USER_ID+`",
-		"createdAt": null,
-		"updatedAt": null
-	}, 
-	"questions": [{
-		"_id": "id01",
-		"examId": "`+examId+`",
-		"ask": "ask01",
-		"answers": ["a01", "a02"],
-		"userId": "`+USER_ID+`",
-		"createdAt": null,
-		"updatedAt": null
-	}]}`, rec.Body.String())
+	s.JSONEq(`{"examId": "exam02", "questionCount": 10}`, rec.Body.String())
 }
 
-func (s *MyTestSuite) TestCreateExamRecord() {
+func (s *MyTestSuite) TestPublishExam() {
 	// Setup
-	requestJSON := `{
-  	"Score": 10,
-  	"WrongQuestionIds": ["q01", "q02"]
-	}`
 	e := echo.New()
-	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(requestJSON))
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
@@ -417,89 +497,68 @@ func (s *MyTestSuite) TestCreateExamRecord() {
 	c.SetParamValues(examId)
 
 	s.mockExamService.EXPECT().
-		CreateExamRecord(examId, int32(10), []string{"q01", "q02"}, USER_ID).
-		Return(&pb.CreateExamRecordResponse{}, nil)
+		PublishExam(examId, USER_ID).
+		Return(&pb.PublishExamResponse{
+			ExamId:        examId,
+			Version:       2,
+			QuestionCount: 10,
+		}, nil)
 
 	// Test
-	err := s.examHandler.CreateExamRecord(c)
+	err := s.examHandler.PublishExam(c)
 	s.Nil(err)
 	s.Equal(http.StatusOK, rec.Code)
-	s.JSONEq(`{}`, rec.Body.String())
+	s.JSONEq(`{"examId": "exam01", "version": 2, "questionCount": 10}`, rec.Body.String())
 }
 
-func (s *MyTestSuite) TestFindExamRecordOverview() {
+func (s *MyTestSuite) TestShareExam() {
 	// Setup
+	requestJSON := `{"role": "editor"}`
 	e := echo.New()
-	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(requestJSON))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 
 	examId := "exam01"
-	c.SetParamNames("examId")
-	c.SetParamValues(examId)
+	c.SetParamNames("examId", "userId")
+	c.SetParamValues(examId, "user02")
 
 	s.mockExamService.EXPECT().
-		FindExamRecordOverview(examId, USER_ID, mock.Anything).
-		Return(&pb.FindExamRecordOverviewResponse{}, nil)
+		ShareExam(examId, "user02", "editor", USER_ID).
+		Return(&pb.ShareExamResponse{}, nil)
 
 	// Test
-	err := s.examHandler.FindExamRecordOverview(c)
+	err := s.examHandler.ShareExam(c)
 	s.Nil(err)
 	s.Equal(http.StatusOK, rec.Code)
-	s.JSONEq(
-		`{"startDate": "", "exam": null, "questions": [], "answerWrongs": [], "examRecords": []}`,
-		rec.Body.String(),
-	)
+	s.Empty(rec.Body.String())
 }
 
-func (s *MyTestSuite) TestFindExamRecords() {
+func (s *MyTestSuite) TestUnshareExam() {
 	// Setup
 	e := echo.New()
-	q := make(url.Values)
-	q.Set("pageIndex", "0")
-	q.Set("pageSize", "10")
-	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req := httptest.NewRequest(http.MethodDelete, "/", nil)
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 
 	examId := "exam01"
-	c.SetParamNames("examId")
-	c.SetParamValues(examId)
+	c.SetParamNames("examId", "userId")
+	c.SetParamValues(examId, "user02")
 
 	s.mockExamService.EXPECT().
-		FindExamRecords(int32(0), int32(10), examId, USER_ID).
-		Return(&pb.FindExamRecordsResponse{
-			Total:     1,
-			PageCount: 1,
-			ExamRecords: []*pb.ExamRecord{
-				{
-					Id:        "id01",
-					ExamId:    examId,
-					Score:     10,
-					UserId:    USER_ID,
-					CreatedAt: nil,
-					UpdatedAt: nil,
-				},
-			},
-		}, nil)
+		UnshareExam(examId, "user02", USER_ID).
+		Return(&pb.UnshareExamResponse{}, nil)
 
 	// Test
-	err := s.examHandler.FindExamRecords(c)
+	err := s.examHandler.UnshareExam(c)
 	s.Nil(err)
 	s.Equal(http.StatusOK, rec.Code)
-	s.JSONEq(`{"total": 1, "pageCount": 1, "examRecords": [{
-		"_id": "id01",
-		"examId": "`+examId+`",
-		"score": 10,
-		"userId": "`+USER_ID+`",
-		"createdAt": null,
-		"updatedAt": null
-	}]}`, rec.Body.String())
+	s.Empty(rec.Body.String())
 }
 
-func (s *MyTestSuite) TestFindExamInfosWhenNotSignIn() {
+func (s *MyTestSuite) TestFindExamShares() {
 	// Setup
 	e := echo.New()
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
@@ -507,7 +566,1667 @@ func (s *MyTestSuite) TestFindExamInfosWhenNotSignIn() {
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 
-	adminUserObjectId := primitive.NewObjectID()
+	examId := "exam01"
+	c.SetParamNames("examId")
+	c.SetParamValues(examId)
+
+	s.mockExamService.EXPECT().
+		FindExamShares(examId, USER_ID).
+		Return(&pb.FindExamSharesResponse{
+			Shares: []*pb.ExamShare{
+				{
+					UserId: "user02",
+					Role:   "editor",
+				},
+			},
+		}, nil)
+
+	// Test
+	err := s.examHandler.FindExamShares(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(
+		`{"shares": [{"userId": "user02", "role": "editor", "createdAt": null}]}`,
+		rec.Body.String(),
+	)
+}
+
+func (s *MyTestSuite) TestImportExam() {
+	// Setup
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	s.Nil(writer.WriteField("topic", "t01"))
+	s.Nil(writer.WriteField("tag", "toeic"))
+	s.Nil(writer.WriteField("tag", "grammar"))
+	part, err := writer.CreateFormFile("file", "exam01.csv")
+	s.Nil(err)
+	_, err = part.Write([]byte("apple,蘋果\n"))
+	s.Nil(err)
+	s.Nil(writer.Close())
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockExamService.EXPECT().
+		ImportExam("t01", "", []string{"toeic", "grammar"}, "csv", []byte("apple,蘋果\n"), USER_ID).
+		Return(&pb.ImportExamResponse{
+			ExamId:        "exam01",
+			QuestionCount: 1,
+			Errors:        []*pb.ExamImportError{},
+		}, nil)
+
+	// Test
+	err = s.examHandler.ImportExam(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"examId": "exam01", "questionCount": 1, "errors": []}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestImportExam_WhenFormatIsInvalid() {
+	// Setup
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", "exam01.xml")
+	s.Nil(err)
+	_, err = part.Write([]byte("<exam />"))
+	s.Nil(err)
+	s.Nil(writer.Close())
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Test
+	err = s.examHandler.ImportExam(c)
+	s.Nil(err)
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+// 模擬 ExamService 回傳的匯出串流
+type fakeExportExamClient struct {
+	grpc.ClientStream
+	chunks []string
+	err    error
+}
+
+func (client *fakeExportExamClient) Recv() (*pb.ExportExamResponse, error) {
+	if len(client.chunks) == 0 {
+		if client.err != nil {
+			return nil, client.err
+		}
+
+		return nil, io.EOF
+	}
+
+	chunk := client.chunks[0]
+	client.chunks = client.chunks[1:]
+	return &pb.ExportExamResponse{Chunk: []byte(chunk)}, nil
+}
+
+func (s *MyTestSuite) TestExportExam() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Set("format", "csv")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	examId := "exam01"
+	c.SetParamNames("examId")
+	c.SetParamValues(examId)
+
+	s.mockExamService.EXPECT().
+		ExportExam(examId, USER_ID, "csv").
+		Return(&fakeExportExamClient{
+			chunks: []string{"ask,answers\n", "apple,蘋果\n"},
+		}, nil)
+
+	// Test
+	err := s.examHandler.ExportExam(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.Equal("text/csv; charset=UTF-8", rec.Header().Get(echo.HeaderContentType))
+	s.Equal(
+		`attachment; filename="exam-exam01.csv"`,
+		rec.Header().Get(echo.HeaderContentDisposition),
+	)
+	s.Equal("ask,answers\napple,蘋果\n", rec.Body.String())
+}
+
+func (s *MyTestSuite) TestExportExam_WhenExamServiceFailed() {
+	// Setup
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	examId := "exam01"
+	c.SetParamNames("examId")
+	c.SetParamValues(examId)
+
+	s.mockExamService.EXPECT().
+		ExportExam(examId, USER_ID, "json").
+		Return(&fakeExportExamClient{
+			err: errors.New("Unauthorized operation"),
+		}, nil)
+
+	// Test
+	err := s.examHandler.ExportExam(c)
+	s.Nil(err)
+	s.Equal(http.StatusInternalServerError, rec.Code)
+}
+
+func (s *MyTestSuite) TestCreatePracticeExam() {
+	// Setup
+	requestJSON := `{
+  	"topic": "practice01",
+  	"description": "d01",
+  	"size": 2,
+  	"weighting": "recent",
+  	"questionTypes": ["cloze"]
+	}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockWordService.EXPECT().
+		FindRandomFavoriteWordMeanings(USER_ID, int32(2), "recent").
+		Return(&pb.FindRandomFavoriteWordMeaningsResponse{
+			FavoriteWordMeanings: []*pb.WordMeaning{
+				{
+					Id:         "wm01",
+					Word:       "book",
+					Definition: "a written text",
+					Examples: []*pb.Example{
+						{
+							Examples: []*pb.Sentence{
+								{Text: "I read a book."},
+							},
+						},
+					},
+				},
+			},
+		}, nil)
+	s.mockExamService.EXPECT().
+		CreatePracticeExam(
+			"practice01",
+			"d01",
+			[]*pb.PracticeWord{
+				{
+					WordMeaningId: "wm01",
+					Word:          "book",
+					Definition:    "a written text",
+					Sentences:     []string{"I read a book."},
+				},
+			},
+			[]string{"cloze"},
+			USER_ID,
+		).
+		Return(&pb.CreatePracticeExamResponse{
+			ExamId:        "exam01",
+			QuestionCount: 1,
+		}, nil)
+
+	// Test
+	err := s.examHandler.CreatePracticeExam(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"examId": "exam01", "questionCount": 1}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestCreatePracticeExam_WhenFavoriteWordMeaningIdsAreGiven() {
+	// Setup
+	requestJSON := `{
+  	"topic": "practice01",
+  	"description": "d01",
+  	"questionTypes": ["word_to_definition"],
+  	"favoriteWordMeaningIds": ["fwm01", "fwm02"]
+	}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockWordService.EXPECT().
+		FindFavoriteWordMeaningsByIds([]string{"fwm01", "fwm02"}, USER_ID).
+		Return(&pb.FindFavoriteWordMeaningsByIdsResponse{
+			FavoriteWordMeanings: []*pb.WordMeaning{
+				{
+					Id:         "wm01",
+					Word:       "book",
+					Definition: "a written text",
+				},
+				{
+					Id:         "wm02",
+					Word:       "cat",
+					Definition: "a small animal",
+				},
+			},
+		}, nil)
+	s.mockExamService.EXPECT().
+		CreatePracticeExam(
+			"practice01",
+			"d01",
+			[]*pb.PracticeWord{
+				{
+					WordMeaningId: "wm01",
+					Word:          "book",
+					Definition:    "a written text",
+					Sentences:     []string{},
+				},
+				{
+					WordMeaningId: "wm02",
+					Word:          "cat",
+					Definition:    "a small animal",
+					Sentences:     []string{},
+				},
+			},
+			[]string{"word_to_definition"},
+			USER_ID,
+		).
+		Return(&pb.CreatePracticeExamResponse{
+			ExamId:        "exam01",
+			QuestionCount: 2,
+		}, nil)
+
+	// Test
+	err := s.examHandler.CreatePracticeExam(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"examId": "exam01", "questionCount": 2}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestCreatePracticeExam_WhenQuestionTypeIsInvalid() {
+	// Setup
+	requestJSON := `{"topic": "practice01", "size": 1, "questionTypes": ["unknown"]}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockWordService.EXPECT().
+		FindRandomFavoriteWordMeanings(USER_ID, int32(1), "").
+		Return(&pb.FindRandomFavoriteWordMeaningsResponse{
+			FavoriteWordMeanings: []*pb.WordMeaning{
+				{
+					Id:         "wm01",
+					Word:       "book",
+					Definition: "a written text",
+				},
+			},
+		}, nil)
+	s.mockExamService.EXPECT().
+		CreatePracticeExam(
+			"practice01",
+			"",
+			mock.Anything,
+			[]string{"unknown"},
+			USER_ID,
+		).
+		Return(nil, status.Error(codes.InvalidArgument, "Invalid practice exam"))
+
+	// Test
+	err := s.examHandler.CreatePracticeExam(c)
+	s.Nil(err)
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *MyTestSuite) TestCreatePracticeExam_WhenNoFavorites() {
+	// Setup
+	requestJSON := `{"topic": "practice01"}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockWordService.EXPECT().
+		FindRandomFavoriteWordMeanings(USER_ID, int32(10), "").
+		Return(&pb.FindRandomFavoriteWordMeaningsResponse{
+			FavoriteWordMeanings: []*pb.WordMeaning{},
+		}, nil)
+
+	// Test
+	err := s.examHandler.CreatePracticeExam(c)
+	s.Nil(err)
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *MyTestSuite) TestCreatePracticeExam_WhenSizeIsInvalid() {
+	// Setup
+	requestJSON := `{"topic": "practice01", "size": 100}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Test
+	err := s.examHandler.CreatePracticeExam(c)
+	s.Nil(err)
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *MyTestSuite) TestFindQuestions() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Set("pageIndex", "0")
+	q.Set("pageSize", "10")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	examId := "exam01"
+	c.SetParamNames("examId")
+	c.SetParamValues(examId)
+
+	s.mockExamService.EXPECT().
+		FindQuestions(int32(0), int32(10), examId, USER_ID, "").
+		Return(&pb.FindQuestionsResponse{
+			Total:     1,
+			PageCount: 1,
+			Questions: []*pb.Question{
+				{
+					Id:        "id01",
+					ExamId:    examId,
+					Ask:       "ask01",
+					Answers:   []string{"a01", "a02"},
+					UserId:    USER_ID,
+					CreatedAt: nil,
+					UpdatedAt: nil,
+				},
+			},
+		}, nil)
+
+	// Test
+	err := s.examHandler.FindQuestions(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"total": 1, "pageCount": 1, "questions": [{
+		"_id": "id01",
+		"examId": "`+examId+`",
+		"ask": "ask01",
+		"answers": ["a01", "a02"],
+		"userId": "`+USER_ID+`",
+		"createdAt": null,
+		"updatedAt": null,
+		"wordMeaningId": "",
+		"choices": [],
+		"acceptedAnswers": [],
+		"hasHint": false,
+		"answerCount": 0,
+		"points": 0,
+		"partialCredit": false,
+		"hint": "",
+		"explanation": "",
+		"sectionId": "",
+		"version": 0
+	}], "nextCursor": ""}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestCreateQuestion() {
+	// Setup
+	requestJSON := `{
+  	"ask": "ask01",
+  	"answers": ["a01", "a02"],
+  	"acceptedAnswers": [["b01"], ["b02", "c02"]],
+  	"points": 2,
+  	"partialCredit": true,
+  	"hint": "hint01",
+  	"explanation": "explanation01",
+  	"sectionId": "section01"
+	}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	examId := "exam01"
+	c.SetParamNames("examId")
+	c.SetParamValues(examId)
+
+	s.mockExamService.EXPECT().
+		CreateQuestion(
+			examId,
+			"ask01",
+			[]string{"a01", "a02"},
+			[]*pb.AcceptedAnswers{
+				{Answers: []string{"b01"}},
+				{Answers: []string{"b02", "c02"}},
+			},
+			int32(2),
+			true,
+			"hint01",
+			"explanation01",
+			"section01",
+			USER_ID,
+		).
+		Return(&pb.CreateQuestionResponse{
+			QuestionId: "question01",
+		}, nil)
+
+	// Test
+	err := s.examHandler.CreateQuestion(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"questionId": "question01"}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestUpdateQuestion() {
+	// Setup
+	requestJSON := `{
+		"_id": "question01",
+  	"ask": "ask01",
+  	"answers": ["a01", "a02"]
+	}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("If-Match", `"1"`)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockExamService.EXPECT().
+		UpdateQuestion(
+			"question01",
+			"ask01",
+			[]string{"a01", "a02"},
+			[]*pb.AcceptedAnswers{},
+			int32(0),
+			false,
+			"",
+			"",
+			"",
+			int32Pointer(1),
+			USER_ID,
+		).
+		Return(&pb.UpdateQuestionResponse{
+			QuestionId: "question01",
+			Version:    2,
+		}, nil)
+
+	// Test
+	err := s.examHandler.UpdateQuestion(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.Equal(`"2"`, rec.Header().Get("ETag"))
+	s.JSONEq(`{"questionId": "question01", "version": 2}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestGetQuestion() {
+	// Setup
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	examId := "exam01"
+	questionId := "question01"
+	c.SetParamNames("examId", "questionId")
+	c.SetParamValues(examId, questionId)
+
+	s.mockExamService.EXPECT().
+		GetQuestion(questionId, USER_ID).
+		Return(&pb.GetQuestionResponse{
+			Question: &pb.Question{
+				Id:      questionId,
+				ExamId:  examId,
+				Ask:     "ask01",
+				Answers: []string{"a01"},
+				UserId:  USER_ID,
+				Version: 2,
+			},
+		}, nil)
+
+	// Test
+	err := s.examHandler.GetQuestion(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.Equal(`"2"`, rec.Header().Get("ETag"))
+	s.Regexp(`"version":\s*2`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestUpdateQuestion_WhenVersionConflicts() {
+	// Setup
+	requestJSON := `{
+		"_id": "question01",
+		"ask": "ask01",
+		"answers": ["a01"]
+	}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("If-Match", `"1"`)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockExamService.EXPECT().
+		UpdateQuestion(
+			"question01",
+			"ask01",
+			[]string{"a01"},
+			[]*pb.AcceptedAnswers{},
+			int32(0),
+			false,
+			"",
+			"",
+			"",
+			int32Pointer(1),
+			USER_ID,
+		).
+		Return(nil, status.Error(codes.Aborted, "Version conflict"))
+
+	// Test
+	err := s.examHandler.UpdateQuestion(c)
+	s.Nil(err)
+	s.Equal(http.StatusConflict, rec.Code)
+}
+
+func (s *MyTestSuite) TestDeleteQuestion() {
+	// Setup
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/", nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	questionId := "question01"
+	c.SetParamNames("questionId")
+	c.SetParamValues(questionId)
+
+	s.mockExamService.EXPECT().
+		DeleteQuestion(questionId, USER_ID).
+		Return(&pb.DeleteQuestionResponse{}, nil)
+
+	// Test
+	err := s.examHandler.DeleteQuestion(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.Empty(rec.Body.String())
+}
+
+func (s *MyTestSuite) TestBatchMutateQuestions() {
+	// Setup
+	requestJSON := `{
+		"operations": [
+			{"type": "create", "ask": "ask01", "answers": ["a01"]},
+			{"type": "update", "questionId": "question02", "ask": "ask02", "answers": ["a02"]},
+			{"type": "delete", "questionId": "question03"}
+		]
+	}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	examId := "exam01"
+	c.SetParamNames("examId")
+	c.SetParamValues(examId)
+
+	s.mockExamService.EXPECT().
+		BatchMutateQuestions(examId, []*pb.QuestionOperation{
+			{
+				Type:    "create",
+				Ask:     "ask01",
+				Answers: []string{"a01"},
+			},
+			{
+				Type:       "update",
+				QuestionId: "question02",
+				Ask:        "ask02",
+				Answers:    []string{"a02"},
+			},
+			{
+				Type:       "delete",
+				QuestionId: "question03",
+			},
+		}, USER_ID).
+		Return(&pb.BatchMutateQuestionsResponse{
+			Applied: true,
+			Results: []*pb.QuestionOperationResult{
+				{
+					Type:       "create",
+					QuestionId: "question01",
+				},
+				{
+					Type:       "update",
+					QuestionId: "question02",
+				},
+				{
+					Type:       "delete",
+					QuestionId: "question03",
+				},
+			},
+		}, nil)
+
+	// Test
+	err := s.examHandler.BatchMutateQuestions(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{
+		"applied": true,
+		"results": [
+			{"type": "create", "questionId": "question01", "error": ""},
+			{"type": "update", "questionId": "question02", "error": ""},
+			{"type": "delete", "questionId": "question03", "error": ""}
+		]
+	}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestFindRandomQuestions() {
+	// Setup
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	examId := "exam01"
+	c.SetParamNames("examId")
+	c.SetParamValues(examId)
+	var size int32 = 10
+
+	s.mockExamService.EXPECT().
+		FindRandomQuestions(examId, USER_ID, size).
+		Return(&pb.FindRandomQuestionsResponse{
+			Exam: &pb.Exam{
+				Id:          "examId01",
+				Topic:       "t01",
+				Description: "d01",
+				Tags:        []string{},
+				IsPublic:    true,
+				UserId:      USER_ID,
+				CreatedAt:   nil,
+				UpdatedAt:   nil,
+			},
+			Questions: []*pb.Question{
+				{
+					Id:          "id01",
+					ExamId:      examId,
+					Ask:         "ask01",
+					Answers:     []string{},
+					UserId:      USER_ID,
+					SectionId:   "section01",
+					AnswerCount: 2,
+					CreatedAt:   nil,
+					UpdatedAt:   nil,
+				},
+			},
+			Sections: []*pb.ExamSection{
+				{
+					Id:      "section01",
+					ExamId:  examId,
+					Type:    "reading",
+					Title:   "title01",
+					Passage: "passage01",
+					Order:   1,
+					UserId:  USER_ID,
+				},
+			},
+			ExamAttemptId: "attempt01",
+		}, nil)
+
+	// Test
+	err := s.examHandler.FindRandomQuestions(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"exam": {
+		"_id": "examId01",
+		"topic": "t01",
+		"description": "d01",
+		"tags": [],
+		"isPublic": true,
+		"userId": "`+USER_ID+`",
+		"forkedFromExamId": "",
+		"forkedFromUserId": "",
+		"latestVersion": 0,
+		"settings": null,
+		"bankSource": null,
+		"version": 0,
+		"createdAt": null,
+		"updatedAt": null
+	}, 
+	"questions": [{
+		"_id": "id01",
+		"examId": "`+examId+`",
+		"ask": "ask01",
+		"answers": [],
+		"userId": "`+USER_ID+`",
+		"createdAt": null,
+		"updatedAt": null,
+		"wordMeaningId": "",
+		"choices": [],
+		"acceptedAnswers": [],
+		"hasHint": false,
+		"answerCount": 2,
+		"points": 0,
+		"partialCredit": false,
+		"hint": "",
+		"explanation": "",
+		"sectionId": "section01",
+		"version": 0
+	}],
+	"sections": [{
+		"_id": "section01",
+		"examId": "`+examId+`",
+		"type": "reading",
+		"title": "title01",
+		"passage": "passage01",
+		"audioUrl": "",
+		"order": 1,
+		"userId": "`+USER_ID+`",
+		"createdAt": null,
+		"updatedAt": null
+	}],
+	"examAttemptId": "attempt01"}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestRevealQuestionHint() {
+	// Setup
+	requestJSON := `{"questionId": "q01"}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	examId := "exam01"
+	examAttemptId := "attempt01"
+	c.SetParamNames("examId", "examAttemptId")
+	c.SetParamValues(examId, examAttemptId)
+
+	s.mockExamService.EXPECT().
+		RevealQuestionHint(examId, examAttemptId, "q01", USER_ID).
+		Return(&pb.RevealQuestionHintResponse{Hint: "hint01"}, nil)
+
+	// Test
+	err := s.examHandler.RevealQuestionHint(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"hint": "hint01"}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestRevealQuestionHint_WhenExamAttemptSubmitted() {
+	// Setup
+	requestJSON := `{"questionId": "q01"}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	examId := "exam01"
+	examAttemptId := "attempt01"
+	c.SetParamNames("examId", "examAttemptId")
+	c.SetParamValues(examId, examAttemptId)
+
+	s.mockExamService.EXPECT().
+		RevealQuestionHint(examId, examAttemptId, "q01", USER_ID).
+		Return(nil, status.Error(codes.FailedPrecondition, "Exam attempt already submitted"))
+
+	// Test
+	err := s.examHandler.RevealQuestionHint(c)
+	s.Nil(err)
+	s.Equal(http.StatusForbidden, rec.Code)
+}
+
+func (s *MyTestSuite) TestFindExamSections() {
+	// Setup
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	examId := "exam01"
+	c.SetParamNames("examId")
+	c.SetParamValues(examId)
+
+	s.mockExamService.EXPECT().
+		FindExamSections(examId, USER_ID).
+		Return(&pb.FindExamSectionsResponse{
+			Sections: []*pb.ExamSection{
+				{
+					Id:       "section01",
+					ExamId:   examId,
+					Type:     "listening",
+					Title:    "title01",
+					AudioUrl: "https://example.com/audio01.mp3",
+					Order:    1,
+					UserId:   USER_ID,
+				},
+			},
+		}, nil)
+
+	// Test
+	err := s.examHandler.FindExamSections(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"sections": [{
+		"_id": "section01",
+		"examId": "`+examId+`",
+		"type": "listening",
+		"title": "title01",
+		"passage": "",
+		"audioUrl": "https://example.com/audio01.mp3",
+		"order": 1,
+		"userId": "`+USER_ID+`",
+		"createdAt": null,
+		"updatedAt": null
+	}]}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestCreateExamSection() {
+	// Setup
+	requestJSON := `{
+		"type": "reading",
+		"title": "title01",
+		"passage": "passage01",
+		"order": 1
+	}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	examId := "exam01"
+	c.SetParamNames("examId")
+	c.SetParamValues(examId)
+
+	s.mockExamService.EXPECT().
+		CreateExamSection(examId, "reading", "title01", "passage01", "", int32(1), USER_ID).
+		Return(&pb.CreateExamSectionResponse{
+			SectionId: "section01",
+		}, nil)
+
+	// Test
+	err := s.examHandler.CreateExamSection(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"sectionId": "section01"}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestUpdateExamSection() {
+	// Setup
+	requestJSON := `{
+		"_id": "section01",
+		"type": "listening",
+		"title": "title01",
+		"audioUrl": "https://example.com/audio01.mp3",
+		"order": 2
+	}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockExamService.EXPECT().
+		UpdateExamSection(
+			"section01",
+			"listening",
+			"title01",
+			"",
+			"https://example.com/audio01.mp3",
+			int32(2),
+			USER_ID,
+		).
+		Return(&pb.UpdateExamSectionResponse{
+			SectionId: "section01",
+		}, nil)
+
+	// Test
+	err := s.examHandler.UpdateExamSection(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"sectionId": "section01"}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestDeleteExamSection() {
+	// Setup
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/", nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	sectionId := "section01"
+	c.SetParamNames("sectionId")
+	c.SetParamValues(sectionId)
+
+	s.mockExamService.EXPECT().
+		DeleteExamSection(sectionId, USER_ID).
+		Return(&pb.DeleteExamSectionResponse{}, nil)
+
+	// Test
+	err := s.examHandler.DeleteExamSection(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.Empty(rec.Body.String())
+}
+
+func (s *MyTestSuite) TestCreateExamRecord() {
+	// Setup
+	requestJSON := `{
+  	"examAttemptId": "attempt01",
+  	"durationSeconds": 120,
+  	"answers": [
+  		{"questionId": "q01", "blankAnswers": ["apple"], "isCorrect": true, "timeSpentSeconds": 30, "hintUsed": true}
+  	]
+	}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	examId := "exam01"
+	c.SetParamNames("examId")
+	c.SetParamValues(examId)
+
+	// 前端傳送的 isCorrect 與 hintUsed 不會轉送給 ExamService
+	s.mockExamService.EXPECT().
+		CreateExamRecord(
+			examId,
+			"attempt01",
+			int32(120),
+			[]*pb.ExamAttemptAnswer{
+				{
+					QuestionId:       "q01",
+					BlankAnswers:     []string{"apple"},
+					TimeSpentSeconds: 30,
+				},
+			},
+			USER_ID,
+		).
+		Return(&pb.CreateExamRecordResponse{
+			ExamRecord: &pb.ExamRecord{
+				Id:              "record01",
+				ExamId:          examId,
+				Score:           1,
+				UserId:          USER_ID,
+				DurationSeconds: 120,
+				MaxPoints:       1,
+			},
+			Questions: []*pb.Question{},
+		}, nil)
+	s.mockCacheRepository.EXPECT().
+		DeleteLeaderboards(
+			mock.Anything,
+			[]string{leaderboardCacheKey(examId, "weekly", time.Now()), "exam01:all_time"},
+		).
+		Return(nil)
+
+	// Test
+	err := s.examHandler.CreateExamRecord(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{
+		"examRecord": {
+			"_id": "record01",
+			"examId": "`+examId+`",
+			"score": 1,
+			"userId": "`+USER_ID+`",
+			"createdAt": null,
+			"updatedAt": null,
+			"examVersion": 0,
+			"durationSeconds": 120,
+			"answers": [],
+			"passingScorePercent": 0,
+			"passed": false,
+			"earnedPoints": 0,
+			"maxPoints": 1,
+			"percentage": 0
+		},
+		"questions": []
+	}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestCreateExamRecord_WhenMaxAttemptsReached() {
+	// Setup
+	requestJSON := `{
+  	"examAttemptId": "attempt01",
+  	"durationSeconds": 120,
+  	"answers": [
+  		{"questionId": "q01", "blankAnswers": ["apple"], "timeSpentSeconds": 30}
+  	]
+	}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	examId := "exam01"
+	c.SetParamNames("examId")
+	c.SetParamValues(examId)
+
+	s.mockExamService.EXPECT().
+		CreateExamRecord(
+			examId,
+			"attempt01",
+			int32(120),
+			[]*pb.ExamAttemptAnswer{
+				{
+					QuestionId:       "q01",
+					BlankAnswers:     []string{"apple"},
+					TimeSpentSeconds: 30,
+				},
+			},
+			USER_ID,
+		).
+		Return(nil, status.Error(codes.FailedPrecondition, "Max attempts reached: 3"))
+
+	// Test
+	err := s.examHandler.CreateExamRecord(c)
+	s.Nil(err)
+	s.Equal(http.StatusForbidden, rec.Code)
+}
+
+func (s *MyTestSuite) TestFindExamLeaderboard() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Set("window", "weekly")
+	q.Set("size", "2")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	examId := "exam01"
+	c.SetParamNames("examId")
+	c.SetParamValues(examId)
+
+	userId01 := primitive.NewObjectID()
+	userId02 := primitive.NewObjectID()
+	userId03 := primitive.NewObjectID()
+	createdAt := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	leaderboardEntries := []*pb.LeaderboardEntry{
+		{
+			UserId:          userId01.Hex(),
+			Score:           10,
+			DurationSeconds: 60,
+			CreatedAt:       timestamppb.New(createdAt),
+		},
+		{
+			UserId:          userId02.Hex(),
+			Score:           9,
+			DurationSeconds: 90,
+			CreatedAt:       timestamppb.New(createdAt),
+		},
+		{
+			UserId:          userId03.Hex(),
+			Score:           8,
+			DurationSeconds: 0,
+			CreatedAt:       timestamppb.New(createdAt),
+		},
+	}
+	key := leaderboardCacheKey(examId, "weekly", time.Now())
+	hiddenUserIds := []string{primitive.NewObjectID().Hex()}
+
+	s.mockCacheRepository.EXPECT().
+		FindLeaderboard(mock.Anything, key).
+		Return(nil, nil)
+	s.mockDatabaseRepository.EXPECT().
+		FindUserIdsByHideFromLeaderboard(mock.Anything).
+		Return(hiddenUserIds, nil)
+	s.mockExamService.EXPECT().
+		FindExamLeaderboard(examId, "weekly", int32(leaderboardCacheSize), hiddenUserIds).
+		Return(&pb.FindExamLeaderboardResponse{
+			Entries: leaderboardEntries,
+		}, nil)
+	s.mockCacheRepository.EXPECT().
+		CreateLeaderboard(mock.Anything, key, leaderboardEntries, leaderboardCacheExpiration).
+		Return(nil)
+	s.mockDatabaseRepository.EXPECT().
+		FindUsersByIds(
+			mock.Anything,
+			[]string{userId01.Hex(), userId02.Hex(), userId03.Hex()},
+		).
+		Return([]model.User{
+			{
+				Id:                  userId01,
+				Username:            "user01",
+				HideFromLeaderboard: true,
+			},
+			{
+				Id:       userId02,
+				Username: "user02",
+			},
+			{
+				Id:       userId03,
+				Username: "user03",
+			},
+		}, nil)
+
+	// Test
+	err := s.examHandler.FindExamLeaderboard(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"entries": [
+		{
+			"rank": 1,
+			"userId": "`+userId02.Hex()+`",
+			"username": "user02",
+			"score": 9,
+			"percentage": 0,
+			"durationSeconds": 90,
+			"createdAt": "2026-10-19T08:00:00Z"
+		},
+		{
+			"rank": 2,
+			"userId": "`+userId03.Hex()+`",
+			"username": "user03",
+			"score": 8,
+			"percentage": 0,
+			"durationSeconds": 0,
+			"createdAt": "2026-10-19T08:00:00Z"
+		}
+	]}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestFindExamLeaderboard_WhenCacheHit() {
+	// Setup
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	examId := "exam01"
+	c.SetParamNames("examId")
+	c.SetParamValues(examId)
+
+	userId := primitive.NewObjectID()
+	createdAt := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+
+	s.mockCacheRepository.EXPECT().
+		FindLeaderboard(mock.Anything, "exam01:all_time").
+		Return([]*pb.LeaderboardEntry{
+			{
+				UserId:          userId.Hex(),
+				Score:           10,
+				DurationSeconds: 60,
+				CreatedAt:       timestamppb.New(createdAt),
+			},
+		}, nil)
+	s.mockDatabaseRepository.EXPECT().
+		FindUsersByIds(mock.Anything, []string{userId.Hex()}).
+		Return([]model.User{
+			{
+				Id:       userId,
+				Username: "user01",
+			},
+		}, nil)
+
+	// Test
+	err := s.examHandler.FindExamLeaderboard(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"entries": [
+		{
+			"rank": 1,
+			"userId": "`+userId.Hex()+`",
+			"username": "user01",
+			"score": 10,
+			"percentage": 0,
+			"durationSeconds": 60,
+			"createdAt": "2026-10-19T08:00:00Z"
+		}
+	]}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestLeaderboardCacheKey() {
+	// 2026-10-21 是星期三
+	now := time.Date(2026, 10, 21, 8, 0, 0, 0, time.UTC)
+	s.Equal("exam01:weekly:2026-10-19", leaderboardCacheKey("exam01", "weekly", now))
+	s.Equal("exam01:all_time", leaderboardCacheKey("exam01", "all_time", now))
+
+	// 星期日仍屬於同一週，星期一是新的一週
+	s.Equal(
+		"exam01:weekly:2026-10-19",
+		leaderboardCacheKey("exam01", "weekly", now.AddDate(0, 0, 4)),
+	)
+	s.Equal(
+		"exam01:weekly:2026-10-26",
+		leaderboardCacheKey("exam01", "weekly", now.AddDate(0, 0, 5)),
+	)
+}
+
+func (s *MyTestSuite) TestFindExamLeaderboard_WhenInvalidWindow() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Set("window", "monthly")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	c.SetParamNames("examId")
+	c.SetParamValues("exam01")
+
+	// Test
+	err := s.examHandler.FindExamLeaderboard(c)
+	s.Nil(err)
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *MyTestSuite) TestFindQuestionStatistics() {
+	// Setup
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	examId := "exam01"
+	c.SetParamNames("examId")
+	c.SetParamValues(examId)
+
+	s.mockExamService.EXPECT().
+		FindQuestionStatistics(examId, USER_ID).
+		Return(&pb.FindQuestionStatisticsResponse{
+			Summary: &pb.ExamStatisticsSummary{
+				AttemptCount:     2,
+				UserCount:        1,
+				MeanPercentage:   75,
+				MedianPercentage: 75,
+				PercentageDistribution: []*pb.PercentageCount{
+					{Percentage: 50, Count: 1},
+					{Percentage: 100, Count: 1},
+				},
+			},
+			QuestionStatistics: []*pb.QuestionStatistic{
+				{
+					QuestionId:          "q01",
+					Ask:                 "ask01",
+					AttemptCount:        2,
+					WrongCount:          1,
+					ErrorRate:           0.5,
+					DiscriminationIndex: 1,
+				},
+			},
+		}, nil)
+
+	// Test
+	err := s.examHandler.FindQuestionStatistics(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{
+		"summary": {
+			"attemptCount": 2,
+			"userCount": 1,
+			"meanPercentage": 75,
+			"medianPercentage": 75,
+			"percentageDistribution": [
+				{"percentage": 50, "count": 1},
+				{"percentage": 100, "count": 1}
+			]
+		},
+		"questionStatistics": [
+			{
+				"questionId": "q01",
+				"ask": "ask01",
+				"attemptCount": 2,
+				"wrongCount": 1,
+				"errorRate": 0.5,
+				"discriminationIndex": 1
+			}
+		]
+	}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestFindExamRecordOverview() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Set("version", "2")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	examId := "exam01"
+	c.SetParamNames("examId")
+	c.SetParamValues(examId)
+
+	s.mockExamService.EXPECT().
+		FindExamRecordOverview(
+			examId,
+			USER_ID,
+			time.Time{},
+			time.Time{},
+			int32(2),
+			"30d",
+			"",
+			int32(0),
+		).
+		Return(&pb.FindExamRecordOverviewResponse{
+			ExamVersion: 2,
+			ExamVersions: []*pb.ExamVersionSummary{
+				{
+					Version:       2,
+					QuestionCount: 10,
+				},
+				{
+					Version:       1,
+					QuestionCount: 8,
+				},
+			},
+		}, nil)
+
+	// Test
+	err := s.examHandler.FindExamRecordOverview(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(
+		`{
+			"startDate": "",
+			"exam": null,
+			"questions": [],
+			"answerWrongs": [],
+			"examRecords": [],
+			"examVersion": 2,
+			"examVersions": [
+				{"version": 2, "questionCount": 10, "createdAt": null},
+				{"version": 1, "questionCount": 8, "createdAt": null}
+			],
+			"analytics": null
+		}`,
+		rec.Body.String(),
+	)
+}
+
+func (s *MyTestSuite) TestFindExamRecordOverview_WhenCustomRange() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Set("range", "custom")
+	q.Set("startDate", "2026-10-01")
+	q.Set("endDate", "2026-10-07")
+	q.Set("timezone", "Asia/Taipei")
+	q.Set("movingAverageWindow", "3")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	examId := "exam01"
+	c.SetParamNames("examId")
+	c.SetParamValues(examId)
+
+	s.mockExamService.EXPECT().
+		FindExamRecordOverview(
+			examId,
+			USER_ID,
+			time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, 10, 7, 0, 0, 0, 0, time.UTC),
+			int32(0),
+			"custom",
+			"Asia/Taipei",
+			int32(3),
+		).
+		Return(&pb.FindExamRecordOverviewResponse{
+			StartDate: "2026/10/01",
+			Analytics: &pb.ExamRecordAnalytics{
+				Timezone:            "Asia/Taipei",
+				StartDate:           "2026-10-01",
+				EndDate:             "2026-10-07",
+				Trend:               "insufficient_data",
+				MovingAverageWindow: 3,
+				DailyStats: []*pb.DailyExamRecordStat{
+					{
+						Date: "2026-10-01",
+					},
+				},
+			},
+		}, nil)
+
+	// Test
+	err := s.examHandler.FindExamRecordOverview(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(
+		`{
+			"startDate": "2026/10/01",
+			"exam": null,
+			"questions": [],
+			"answerWrongs": [],
+			"examRecords": [],
+			"examVersion": 0,
+			"examVersions": [],
+			"analytics": {
+				"timezone": "Asia/Taipei",
+				"startDate": "2026-10-01",
+				"endDate": "2026-10-07",
+				"recordCount": 0,
+				"averageScore": 0,
+				"bestScore": 0,
+				"bestScoreAt": null,
+				"worstScore": 0,
+				"worstScoreAt": null,
+				"trendSlope": 0,
+				"trend": "insufficient_data",
+				"currentStreakDays": 0,
+				"longestStreakDays": 0,
+				"movingAverageWindow": 3,
+				"dailyStats": [
+					{
+						"date": "2026-10-01",
+						"recordCount": 0,
+						"averageScore": 0,
+						"bestScore": 0,
+						"worstScore": 0,
+						"movingAverage": 0
+					}
+				],
+				"hourOfDayStats": []
+			}
+		}`,
+		rec.Body.String(),
+	)
+}
+
+func (s *MyTestSuite) TestFindExamRecordOverview_WhenInvalidRange() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Set("range", "1y")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	c.SetParamNames("examId")
+	c.SetParamValues("exam01")
+
+	// Test
+	err := s.examHandler.FindExamRecordOverview(c)
+	s.Nil(err)
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *MyTestSuite) TestFindExamRecords() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Set("pageIndex", "0")
+	q.Set("pageSize", "10")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	examId := "exam01"
+	c.SetParamNames("examId")
+	c.SetParamValues(examId)
+
+	s.mockExamService.EXPECT().
+		FindExamRecords(int32(0), int32(10), examId, USER_ID, "").
+		Return(&pb.FindExamRecordsResponse{
+			Total:     1,
+			PageCount: 1,
+			ExamRecords: []*pb.ExamRecord{
+				{
+					Id:        "id01",
+					ExamId:    examId,
+					Score:     10,
+					UserId:    USER_ID,
+					CreatedAt: nil,
+					UpdatedAt: nil,
+				},
+			},
+		}, nil)
+
+	// Test
+	err := s.examHandler.FindExamRecords(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"total": 1, "pageCount": 1, "examRecords": [{
+		"_id": "id01",
+		"examId": "`+examId+`",
+		"score": 10,
+		"userId": "`+USER_ID+`",
+		"createdAt": null,
+		"updatedAt": null,
+		"examVersion": 0,
+		"durationSeconds": 0,
+		"answers": [],
+		"passingScorePercent": 0,
+		"passed": false,
+		"earnedPoints": 0,
+		"maxPoints": 0,
+		"percentage": 0
+	}], "nextCursor": ""}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestGetExamRecordDetail() {
+	// Setup
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	examId := "exam01"
+	examRecordId := "record01"
+	c.SetParamNames("examId", "recordId")
+	c.SetParamValues(examId, examRecordId)
+
+	s.mockExamService.EXPECT().
+		GetExamRecordDetail(examId, examRecordId, USER_ID).
+		Return(&pb.GetExamRecordDetailResponse{
+			ExamRecord: &pb.ExamRecord{
+				Id:     examRecordId,
+				ExamId: examId,
+				Score:  0,
+				UserId: USER_ID,
+				Answers: []*pb.ExamAttemptAnswer{
+					{
+						QuestionId:       "q01",
+						Answer:           "apple",
+						IsCorrect:        false,
+						TimeSpentSeconds: 30,
+					},
+				},
+			},
+			Questions: []*pb.Question{
+				{
+					Id:      "q01",
+					ExamId:  examId,
+					Ask:     "ask01",
+					Answers: []string{"banana"},
+					UserId:  USER_ID,
+				},
+			},
+		}, nil)
+
+	// Test
+	err := s.examHandler.GetExamRecordDetail(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{
+		"examRecord": {
+			"_id": "`+examRecordId+`",
+			"examId": "`+examId+`",
+			"score": 0,
+			"userId": "`+USER_ID+`",
+			"createdAt": null,
+			"updatedAt": null,
+			"examVersion": 0,
+			"durationSeconds": 0,
+			"answers": [
+				{
+					"questionId": "q01",
+					"answer": "apple",
+					"blankAnswers": [],
+					"isCorrect": false,
+					"timeSpentSeconds": 30,
+					"credit": 0,
+					"hintUsed": false
+				}
+			],
+			"passingScorePercent": 0,
+			"passed": false,
+			"earnedPoints": 0,
+			"maxPoints": 0,
+			"percentage": 0
+		},
+		"questions": [
+			{
+				"_id": "q01",
+				"examId": "`+examId+`",
+				"ask": "ask01",
+				"answers": ["banana"],
+				"userId": "`+USER_ID+`",
+				"createdAt": null,
+				"updatedAt": null,
+				"wordMeaningId": "",
+				"choices": [],
+				"acceptedAnswers": [],
+				"hasHint": false,
+				"answerCount": 0,
+				"points": 0,
+				"partialCredit": false,
+				"hint": "",
+				"explanation": "",
+				"sectionId": "",
+				"version": 0
+			}
+		]
+	}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestFindExamInfosWhenNotSignIn() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Add("tag", "toeic")
+	q.Add("tag", "grammar")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	adminUserObjectId := primitive.NewObjectID()
 	adminUserId := adminUserObjectId.Hex()
 
 	s.mockDatabaseRepository.EXPECT().
@@ -520,13 +2239,14 @@ func (s *MyTestSuite) TestFindExamInfosWhenNotSignIn() {
 		}, nil)
 
 	s.mockExamService.EXPECT().
-		FindExamInfos(adminUserId, true).
+		FindExamInfos(adminUserId, true, []string{"toeic", "grammar"}).
 		Return(&pb.FindExamInfosResponse{
 			ExamInfos: []*pb.ExamInfo{
 				{
 					ExamId:        "exam01",
 					Topic:         "t01",
 					Description:   "d01",
+					Tags:          []string{"toeic", "grammar"},
 					IsPublic:      true,
 					QuestionCount: 10,
 					RecordCount:   1,
@@ -542,6 +2262,7 @@ func (s *MyTestSuite) TestFindExamInfosWhenNotSignIn() {
 		"examId": "exam01",
 		"topic": "t01",
 		"description": "d01",
+		"tags": ["toeic", "grammar"],
 		"isPublic": true,
 		"questionCount": 10,
 		"recordCount": 1
@@ -569,7 +2290,7 @@ func (s *MyTestSuite) TestFindExamInfosWhenSignIn() {
 		}, nil)
 
 	s.mockExamService.EXPECT().
-		FindExamInfos(adminUserId, true).
+		FindExamInfos(adminUserId, true, []string(nil)).
 		Return(&pb.FindExamInfosResponse{
 			ExamInfos: []*pb.ExamInfo{
 				{
@@ -583,7 +2304,7 @@ func (s *MyTestSuite) TestFindExamInfosWhenSignIn() {
 			},
 		}, nil)
 	s.mockExamService.EXPECT().
-		FindExamInfos(USER_ID, true).
+		FindExamInfos(USER_ID, true, []string(nil)).
 		Return(&pb.FindExamInfosResponse{
 			ExamInfos: []*pb.ExamInfo{
 				{
@@ -597,7 +2318,7 @@ func (s *MyTestSuite) TestFindExamInfosWhenSignIn() {
 			},
 		}, nil)
 	s.mockExamService.EXPECT().
-		FindExamInfos(USER_ID, false).
+		FindExamInfos(USER_ID, false, []string(nil)).
 		Return(&pb.FindExamInfosResponse{
 			ExamInfos: []*pb.ExamInfo{
 				{
@@ -619,6 +2340,7 @@ func (s *MyTestSuite) TestFindExamInfosWhenSignIn() {
 		"examId": "exam01",
 		"topic": "t01",
 		"description": "d01",
+		"tags": [],
 		"isPublic": true,
 		"questionCount": 10,
 		"recordCount": 1
@@ -626,6 +2348,7 @@ func (s *MyTestSuite) TestFindExamInfosWhenSignIn() {
 		"examId": "exam02",
 		"topic": "t02",
 		"description": "d02",
+		"tags": [],
 		"isPublic": true,
 		"questionCount": 20,
 		"recordCount": 2
@@ -633,8 +2356,384 @@ func (s *MyTestSuite) TestFindExamInfosWhenSignIn() {
 		"examId": "exam03",
 		"topic": "t03",
 		"description": "d03",
+		"tags": [],
 		"isPublic": false,
 		"questionCount": 30,
 		"recordCount": 3
 	}]}`, rec.Body.String())
 }
+
+func (s *MyTestSuite) TestFindExamCatalog() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Set("keyword", "toeic")
+	q.Add("tag", "grammar")
+	q.Set("sortBy", "most_attempted")
+	q.Set("pageIndex", "0")
+	q.Set("pageSize", "10")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockExamService.EXPECT().
+		FindExamCatalog("toeic", []string{"grammar"}, "most_attempted", int32(0), int32(10)).
+		Return(&pb.FindExamCatalogResponse{
+			Total:     1,
+			PageCount: 1,
+			Items: []*pb.ExamCatalogItem{
+				{
+					ExamId:            "exam01",
+					Topic:             "toeic 01",
+					Description:       "d01",
+					Tags:              []string{"grammar"},
+					UserId:            "user02",
+					QuestionCount:     10,
+					RecordCount:       3,
+					AveragePercentage: 75,
+				},
+			},
+		}, nil)
+
+	// Test
+	err := s.examHandler.FindExamCatalog(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"total": 1, "pageCount": 1, "items": [{
+		"examId": "exam01",
+		"topic": "toeic 01",
+		"description": "d01",
+		"tags": ["grammar"],
+		"userId": "user02",
+		"questionCount": 10,
+		"recordCount": 3,
+		"averagePercentage": 75,
+		"updatedAt": null
+	}]}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestFindExamsByTags() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Add("tag", "toeic")
+	q.Set("pageIndex", "0")
+	q.Set("pageSize", "10")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockExamService.EXPECT().
+		FindExamsByTags([]string{"toeic"}, USER_ID, int32(0), int32(10)).
+		Return(&pb.FindExamsByTagsResponse{
+			Total:     1,
+			PageCount: 1,
+			Exams: []*pb.Exam{
+				{
+					Id:          "exam01",
+					Topic:       "t01",
+					Description: "d01",
+					Tags:        []string{"toeic"},
+					IsPublic:    true,
+					UserId:      "user02",
+				},
+			},
+		}, nil)
+
+	// Test
+	err := s.examHandler.FindExamsByTags(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"total": 1, "pageCount": 1, "exams": [{
+		"_id": "exam01",
+		"topic": "t01",
+		"description": "d01",
+		"tags": ["toeic"],
+		"isPublic": true,
+		"userId": "user02",
+		"forkedFromExamId": "",
+		"forkedFromUserId": "",
+		"latestVersion": 0,
+		"settings": null,
+		"bankSource": null,
+		"version": 0,
+		"createdAt": null,
+		"updatedAt": null
+	}]}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestFindExamsByTags_WhenTagIsEmpty() {
+	// Setup
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Test
+	err := s.examHandler.FindExamsByTags(c)
+	s.Nil(err)
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *MyTestSuite) TestFindExamTagCounts() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Set("size", "20")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockExamService.EXPECT().
+		FindExamTagCounts(USER_ID, int32(20)).
+		Return(&pb.FindExamTagCountsResponse{
+			TagCounts: []*pb.TagCount{
+				{Tag: "toeic", Count: 3},
+				{Tag: "grammar", Count: 1},
+			},
+		}, nil)
+
+	// Test
+	err := s.examHandler.FindExamTagCounts(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"tagCounts": [
+		{"tag": "toeic", "count": 3},
+		{"tag": "grammar", "count": 1}
+	]}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestFindMistakeNotebook() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Set("examId", "exam01")
+	q.Set("startDate", "2024-01-01")
+	q.Set("endDate", "2024-01-31")
+	q.Set("timezone", "Asia/Taipei")
+	q.Set("pageIndex", "1")
+	q.Set("pageSize", "20")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	location, err := time.LoadLocation("Asia/Taipei")
+	s.Nil(err)
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, location)
+
+	// 包含結束日期當天
+	endDate := time.Date(2024, 2, 1, 0, 0, 0, 0, location)
+
+	s.mockExamService.EXPECT().
+		FindMistakeNotebook(USER_ID, "exam01", startDate, endDate, int32(1), int32(20)).
+		Return(&pb.FindMistakeNotebookResponse{
+			Total:     21,
+			PageCount: 2,
+			Entries:   []*pb.MistakeNotebookEntry{},
+		}, nil)
+
+	// Test
+	err = s.examHandler.FindMistakeNotebook(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"total": 21, "pageCount": 2, "entries": []}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestFindMistakeNotebook_WhenDateIsInvalid() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Set("startDate", "2024/01/01")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Test
+	err := s.examHandler.FindMistakeNotebook(c)
+	s.Nil(err)
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *MyTestSuite) TestCreateMistakeDrill() {
+	// Setup
+	requestJSON := `{
+		"examId": "exam01",
+		"size": 5
+	}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockExamService.EXPECT().
+		CreateMistakeDrill(USER_ID, "exam01", int32(5)).
+		Return(&pb.CreateMistakeDrillResponse{
+			Entries:               []*pb.MistakeNotebookEntry{},
+			RequiredCorrectStreak: 3,
+		}, nil)
+
+	// Test
+	err := s.examHandler.CreateMistakeDrill(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"entries": [], "requiredCorrectStreak": 3}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestAnswerMistakeDrill() {
+	// Setup
+	requestJSON := `{
+		"blankAnswers": ["red", "blue"]
+	}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("answerWrongId")
+	c.SetParamValues("aw01")
+
+	s.mockExamService.EXPECT().
+		AnswerMistakeDrill("aw01", USER_ID, []string{"red", "blue"}).
+		Return(&pb.AnswerMistakeDrillResponse{
+			CorrectStreak:  3,
+			Removed:        true,
+			IsCorrect:      true,
+			CorrectAnswers: []string{"red", "blue"},
+		}, nil)
+
+	// Test
+	err := s.examHandler.AnswerMistakeDrill(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{
+		"correctStreak": 3,
+		"removed": true,
+		"isCorrect": true,
+		"correctAnswers": ["red", "blue"]
+	}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestFindBankQuestions() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Set("pageIndex", "0")
+	q.Set("pageSize", "10")
+	q.Set("tag", "grammar")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockExamService.EXPECT().
+		FindBankQuestions(int32(0), int32(10), "grammar", USER_ID).
+		Return(&pb.FindBankQuestionsResponse{
+			Total:         0,
+			PageCount:     0,
+			BankQuestions: []*pb.BankQuestion{},
+		}, nil)
+
+	// Test
+	err := s.examHandler.FindBankQuestions(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"total": 0, "pageCount": 0, "bankQuestions": []}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestCreateBankQuestion() {
+	// Setup
+	requestJSON := `{
+		"ask": "ask01",
+		"answers": ["a01"],
+		"tags": ["grammar"],
+		"points": 2
+	}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockExamService.EXPECT().
+		CreateBankQuestion(
+			"ask01",
+			[]string{"a01"},
+			[]string{"grammar"},
+			int32(2),
+			false,
+			"",
+			"",
+			USER_ID,
+		).
+		Return(&pb.CreateBankQuestionResponse{
+			BankQuestionId: "bankQuestion01",
+		}, nil)
+
+	// Test
+	err := s.examHandler.CreateBankQuestion(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"bankQuestionId": "bankQuestion01"}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestDeleteBankQuestion() {
+	// Setup
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/", nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("bankQuestionId")
+	c.SetParamValues("bankQuestion01")
+
+	s.mockExamService.EXPECT().
+		DeleteBankQuestion("bankQuestion01", USER_ID).
+		Return(&pb.DeleteBankQuestionResponse{}, nil)
+
+	// Test
+	err := s.examHandler.DeleteBankQuestion(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.Empty(rec.Body.String())
+}
+
+func (s *MyTestSuite) TestSetExamBankSource() {
+	// Setup
+	requestJSON := `{
+		"questionIds": ["bankQuestion01"],
+		"pools": [{"tag": "grammar", "size": 5}]
+	}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("examId")
+	c.SetParamValues("exam01")
+
+	s.mockExamService.EXPECT().
+		SetExamBankSource(
+			"exam01",
+			[]string{"bankQuestion01"},
+			[]*pb.ExamBankPool{{Tag: "grammar", Size: 5}},
+			USER_ID,
+		).
+		Return(&pb.SetExamBankSourceResponse{}, nil)
+
+	// Test
+	err := s.examHandler.SetExamBankSource(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"exam": null}`, rec.Body.String())
+}
+
+func int32Pointer(value int32) *int32 {
+	return &value
+}
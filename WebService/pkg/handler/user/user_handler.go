@@ -23,6 +23,9 @@ type UserHandler interface {
 	CreateUser(c echo.Context) error
 	Login(c echo.Context) error
 	FindUserHistories(c echo.Context) error
+
+	GetUserProfile(c echo.Context) error
+	UpdateUserProfile(c echo.Context) error
 }
 
 type userHandler struct {
@@ -152,13 +155,15 @@ func (handler userHandler) FindUserHistories(c echo.Context) error {
 	errorMessage := "FindUserHistories failed! error: %w"
 
 	var (
-		pageIndex int32 = 0
-		pageSize  int32 = 0
+		pageIndex int32  = 0
+		pageSize  int32  = 0
+		cursor    string = ""
 	)
 
 	err := echo.QueryParamsBinder(c).
 		Int32("pageIndex", &pageIndex).
 		Int32("pageSize", &pageSize).
+		String("cursor", &cursor).
 		BindError() // returns first binding error
 	if err != nil {
 		c.Logger().Error(fmt.Errorf(errorMessage, err))
@@ -166,8 +171,9 @@ func (handler userHandler) FindUserHistories(c echo.Context) error {
 	}
 
 	databaseRepository := handler.databaseRepository
-	userHistoryResponses, err := databaseRepository.FindUserHistoryResponsesOrderByUpdatedAt(
+	userHistoryResponses, nextCursor, err := databaseRepository.FindUserHistoryResponsesOrderByUpdatedAt(
 		c.Request().Context(),
+		cursor,
 		pageIndex,
 		pageSize,
 	)
@@ -176,6 +182,16 @@ func (handler userHandler) FindUserHistories(c echo.Context) error {
 		return util.SendJSONInternalServerError(c)
 	}
 
+	// 使用 cursor 分頁時不需要再查詢總數
+	if cursor != "" {
+		return c.JSON(http.StatusOK, echo.Map{
+			"total":         0,
+			"pageCount":     0,
+			"userHistories": userHistoryResponses,
+			"nextCursor":    nextCursor,
+		})
+	}
+
 	// Total
 	total, err := databaseRepository.CountUserHistories(c.Request().Context())
 	if err != nil {
@@ -190,5 +206,58 @@ func (handler userHandler) FindUserHistories(c echo.Context) error {
 		"total":         total,
 		"pageCount":     pageCount,
 		"userHistories": userHistoryResponses,
+		"nextCursor":    nextCursor,
+	})
+}
+
+func (handler userHandler) GetUserProfile(c echo.Context) error {
+	errorMessage := "GetUserProfile failed! error: %w"
+
+	userId := utilGetJWTClaims(c).UserId
+
+	user, err := handler.databaseRepository.GetUserById(c.Request().Context(), userId)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	if user == nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, fmt.Errorf("User not found by id: %s", userId)))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"username":            user.Username,
+		"hideFromLeaderboard": user.HideFromLeaderboard,
+	})
+}
+
+func (handler userHandler) UpdateUserProfile(c echo.Context) error {
+	type RequestBody struct {
+		HideFromLeaderboard bool `json:"hideFromLeaderboard"`
+	}
+
+	errorMessage := "UpdateUserProfile failed! error: %w"
+
+	requestBody := new(RequestBody)
+	if err := c.Bind(&requestBody); err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+
+	err := handler.databaseRepository.UpdateUserHideFromLeaderboard(
+		c.Request().Context(),
+		userId,
+		requestBody.HideFromLeaderboard,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return c.JSON(http.StatusOK, echo.Map{
+		"hideFromLeaderboard": requestBody.HideFromLeaderboard,
 	})
 }
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -16,9 +17,13 @@ import (
 
 	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/model"
 	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/repository"
+	"github.com/kakurineuin/learn-english-microservices/web-service/pkg/util"
 )
 
-const TOKEN = "jwt_abc123"
+const (
+	TOKEN   = "jwt_abc123"
+	USER_ID = "user01"
+)
 
 type MyTestSuite struct {
 	suite.Suite
@@ -39,6 +44,16 @@ func (s *MyTestSuite) SetupSuite() {
 		return TOKEN, nil
 	}
 
+	// Mock JWT claims
+	utilGetJWTClaims = func(c echo.Context) *util.JwtCustomClaims {
+		return &util.JwtCustomClaims{
+			UserId:           USER_ID,
+			Username:         "someone",
+			Role:             "user",
+			RegisteredClaims: jwt.RegisteredClaims{},
+		}
+	}
+
 	s.userHandler = userHandler{
 		databaseRepository: nil,
 	}
@@ -146,7 +161,7 @@ func (s *MyTestSuite) TestFindUserHistories() {
 	path := "/api/test"
 	now := time.Now()
 	s.mockDatabaseRepository.EXPECT().
-		FindUserHistoryResponsesOrderByUpdatedAt(mock.Anything, int32(0), int32(10)).
+		FindUserHistoryResponsesOrderByUpdatedAt(mock.Anything, "", int32(0), int32(10)).
 		Return([]repository.UserHistoryResponse{
 			{
 				Id:        id,
@@ -158,7 +173,7 @@ func (s *MyTestSuite) TestFindUserHistories() {
 				CreatedAt: now,
 				UpdatedAt: now,
 			},
-		}, nil)
+		}, "", nil)
 	s.mockDatabaseRepository.EXPECT().
 		CountUserHistories(mock.Anything).
 		Return(int32(1), nil)
@@ -176,5 +191,73 @@ func (s *MyTestSuite) TestFindUserHistories() {
 		"path": "`+path+`",
 		"createdAt": "`+now.Format(time.RFC3339Nano)+`",
 		"updatedAt": "`+now.Format(time.RFC3339Nano)+`"
-	}]}`, rec.Body.String())
+	}], "nextCursor": ""}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestFindUserHistories_WhenUseCursor() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Set("pageSize", "10")
+	q.Set("cursor", "cursor01")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockDatabaseRepository.EXPECT().
+		FindUserHistoryResponsesOrderByUpdatedAt(mock.Anything, "cursor01", int32(0), int32(10)).
+		Return([]repository.UserHistoryResponse{}, "cursor02", nil)
+
+	// Test
+	err := s.userHandler.FindUserHistories(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(
+		`{"total": 0, "pageCount": 0, "userHistories": [], "nextCursor": "cursor02"}`,
+		rec.Body.String(),
+	)
+}
+
+func (s *MyTestSuite) TestGetUserProfile() {
+	// Setup
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockDatabaseRepository.EXPECT().
+		GetUserById(mock.Anything, USER_ID).
+		Return(&model.User{
+			Username:            "someone",
+			HideFromLeaderboard: true,
+		}, nil)
+
+	// Test
+	err := s.userHandler.GetUserProfile(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"username": "someone", "hideFromLeaderboard": true}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestUpdateUserProfile() {
+	// Setup
+	requestJSON := `{
+		"hideFromLeaderboard": true
+	}`
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(requestJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockDatabaseRepository.EXPECT().
+		UpdateUserHideFromLeaderboard(mock.Anything, USER_ID, true).
+		Return(nil)
+
+	// Test
+	err := s.userHandler.UpdateUserProfile(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"hideFromLeaderboard": true}`, rec.Body.String())
 }
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"math/rand"
 	"net/http"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -17,12 +19,27 @@ import (
 // For mock at test
 var utilGetJWTClaims = util.GetJWTClaims
 
+// 查詢的單字或片語最大長度
+const maxWordLength = 50
+
+// 只允許字母、數字、空白與片語常見的標點符號，例如 "look forward to"、"rock 'n' roll"
+var wordRegexp = regexp.MustCompile(`^[\p{L}\p{N}][\p{L}\p{N} '’\-.,/]*$`)
+
+// 隨機取出喜歡的單字解釋時可用的加權方式：不加權、偏重最近加入的、偏重較少複習的
+var randomWeightings = []string{"", "recent", "less_reviewed"}
+
 type WordHandler interface {
 	FindWordMeanings(c echo.Context) error
 	CreateFavoriteWordMeaning(c echo.Context) error
 	DeleteFavoriteWordMeaning(c echo.Context) error
 	FindFavoriteWordMeanings(c echo.Context) error
 	FindRandomFavoriteWordMeanings(c echo.Context) error
+	MarkFavoriteWordMeaningsReviewed(c echo.Context) error
+	FindRecentLookups(c echo.Context) error
+	ClearLookupHistory(c echo.Context) error
+	FindDictationSentences(c echo.Context) error
+	GradeDictation(c echo.Context) error
+	FindDictationHistory(c echo.Context) error
 }
 
 func NewHandler(
@@ -43,7 +60,24 @@ type wordHandler struct {
 func (handler wordHandler) FindWordMeanings(c echo.Context) error {
 	errorMessage := "FindWordMeanings failed! error: %w"
 
-	word := c.Param("word")
+	// 片語含有空白等字元，所以支援從 path 或 query string 傳入，query string 已經解碼過
+	word, err := util.PathParam(c, "word")
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	if word == "" {
+		word = c.QueryParam("word")
+	}
+
+	word = strings.TrimSpace(word)
+
+	if len([]rune(word)) > maxWordLength || !wordRegexp.MatchString(word) {
+		c.Logger().Error(fmt.Errorf(errorMessage, fmt.Errorf("invalid word: %q", word)))
+		return util.SendJSONBadRequest(c)
+	}
+
 	userId := utilGetJWTClaims(c).UserId
 	c.Logger().Infof("============== word: %s, userId: %s", word, userId)
 
@@ -109,12 +143,14 @@ func (handler wordHandler) FindFavoriteWordMeanings(c echo.Context) error {
 		pageIndex int32  = 0
 		pageSize  int32  = 0
 		word      string = ""
+		cursor    string = ""
 	)
 
 	err := echo.QueryParamsBinder(c).
 		Int32("pageIndex", &pageIndex).
 		Int32("pageSize", &pageSize).
 		String("word", &word).
+		String("cursor", &cursor).
 		BindError() // returns first binding error
 	if err != nil {
 		c.Logger().Error(fmt.Errorf(errorMessage, err))
@@ -128,6 +164,7 @@ func (handler wordHandler) FindFavoriteWordMeanings(c echo.Context) error {
 		pageSize,
 		userId,
 		word,
+		cursor,
 	)
 	if err != nil {
 		c.Logger().Error(fmt.Errorf(errorMessage, err))
@@ -143,9 +180,28 @@ func (handler wordHandler) FindRandomFavoriteWordMeanings(c echo.Context) error
 	userId := utilGetJWTClaims(c).UserId
 	returnSize := 10
 
+	weighting := c.QueryParam("weighting")
+	isValidWeighting := false
+
+	for _, randomWeighting := range randomWeightings {
+		if weighting == randomWeighting {
+			isValidWeighting = true
+			break
+		}
+	}
+
+	if !isValidWeighting {
+		c.Logger().Error(fmt.Errorf(errorMessage, fmt.Errorf("invalid weighting: %q", weighting)))
+		return util.SendJSONBadRequest(c)
+	}
+
 	// 先檢查 cache
 	cacheRepository := handler.cacheRepository
 	key := fmt.Sprintf("FindRandomFavoriteWordMeanings:%s", userId)
+
+	if weighting != "" {
+		key = fmt.Sprintf("%s:%s", key, weighting)
+	}
 	wordMeanings, err := cacheRepository.FindWordMeanings(c.Request().Context(), key)
 	if err != nil {
 		c.Logger().Error(fmt.Errorf(errorMessage, err))
@@ -158,6 +214,7 @@ func (handler wordHandler) FindRandomFavoriteWordMeanings(c echo.Context) error
 		microserviceResponse, err := handler.wordService.FindRandomFavoriteWordMeanings(
 			userId,
 			maxSize,
+			weighting,
 		)
 		if err != nil {
 			c.Logger().Error(fmt.Errorf(errorMessage, err))
@@ -200,3 +257,163 @@ func (handler wordHandler) FindRandomFavoriteWordMeanings(c echo.Context) error
 		FavoriteWordMeanings: wordMeanings,
 	})
 }
+
+func (handler wordHandler) FindRecentLookups(c echo.Context) error {
+	errorMessage := "FindRecentLookups failed! error: %w"
+
+	var size int32 = 0
+
+	err := echo.QueryParamsBinder(c).
+		Int32("size", &size).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+
+	microserviceResponse, err := handler.wordService.FindRecentLookups(userId, size)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler wordHandler) ClearLookupHistory(c echo.Context) error {
+	errorMessage := "ClearLookupHistory failed! error: %w"
+	userId := utilGetJWTClaims(c).UserId
+
+	microserviceResponse, err := handler.wordService.ClearLookupHistory(userId)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler wordHandler) FindDictationSentences(c echo.Context) error {
+	errorMessage := "FindDictationSentences failed! error: %w"
+
+	var size int32 = 0
+
+	err := echo.QueryParamsBinder(c).
+		Int32("size", &size).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+
+	microserviceResponse, err := handler.wordService.FindDictationSentences(userId, size)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+// 使用者看過單字卡的背面後才記錄複習次數
+func (handler wordHandler) MarkFavoriteWordMeaningsReviewed(c echo.Context) error {
+	type RequestBody struct {
+		FavoriteWordMeaningIds []string `json:"favoriteWordMeaningIds"`
+	}
+
+	errorMessage := "MarkFavoriteWordMeaningsReviewed failed! error: %w"
+
+	requestBody := new(RequestBody)
+	if err := c.Bind(&requestBody); err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	if len(requestBody.FavoriteWordMeaningIds) == 0 {
+		c.Logger().Error(
+			fmt.Errorf(errorMessage, fmt.Errorf("favoriteWordMeaningIds is required")),
+		)
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+	c.Logger().Infof("requestBody: %v, userId: %s", requestBody, userId)
+
+	microserviceResponse, err := handler.wordService.MarkFavoriteWordMeaningsReviewed(
+		requestBody.FavoriteWordMeaningIds,
+		userId,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler wordHandler) GradeDictation(c echo.Context) error {
+	type RequestBody struct {
+		WordMeaningId string `json:"wordMeaningId"`
+		AudioUrl      string `json:"audioUrl"`
+		Answer        string `json:"answer"`
+	}
+
+	errorMessage := "GradeDictation failed! error: %w"
+
+	requestBody := new(RequestBody)
+	if err := c.Bind(&requestBody); err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	if requestBody.WordMeaningId == "" || requestBody.AudioUrl == "" {
+		c.Logger().Error(
+			fmt.Errorf(errorMessage, fmt.Errorf("wordMeaningId and audioUrl are required")),
+		)
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+	c.Logger().Infof("requestBody: %v, userId: %s", requestBody, userId)
+
+	microserviceResponse, err := handler.wordService.GradeDictation(
+		userId,
+		requestBody.WordMeaningId,
+		requestBody.AudioUrl,
+		requestBody.Answer,
+	)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
+
+func (handler wordHandler) FindDictationHistory(c echo.Context) error {
+	errorMessage := "FindDictationHistory failed! error: %w"
+
+	var size int32 = 0
+
+	err := echo.QueryParamsBinder(c).
+		Int32("size", &size).
+		BindError() // returns first binding error
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONBadRequest(c)
+	}
+
+	userId := utilGetJWTClaims(c).UserId
+
+	microserviceResponse, err := handler.wordService.FindDictationHistory(userId, size)
+	if err != nil {
+		c.Logger().Error(fmt.Errorf(errorMessage, err))
+		return util.SendJSONInternalServerError(c)
+	}
+
+	return util.SendJSONResponse(c, microserviceResponse)
+}
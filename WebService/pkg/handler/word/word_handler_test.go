@@ -108,6 +108,96 @@ func (s *MyTestSuite) TestFindWordMeanings() {
 	s.JSONEq(`{"wordMeanings": []}`, rec.Body.String())
 }
 
+func (s *MyTestSuite) TestFindWordMeanings_WhenWordIsExpression() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Set("word", " look forward to ")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockWordService.EXPECT().
+		FindWordByDictionary("look forward to", "user01").
+		Return(&pb.FindWordByDictionaryResponse{
+			WordMeanings: []*pb.WordMeaning{},
+		}, nil)
+
+	// Test
+	err := s.wordHandler.FindWordMeanings(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"wordMeanings": []}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestFindWordMeanings_WhenPathIsEscaped() {
+	// Setup
+	e := echo.New()
+	e.GET("/word/:word", s.wordHandler.FindWordMeanings)
+	req := httptest.NewRequest(http.MethodGet, "/word/rock%20'n'%20roll", nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+
+	s.mockWordService.EXPECT().
+		FindWordByDictionary("rock 'n' roll", "user01").
+		Return(&pb.FindWordByDictionaryResponse{
+			WordMeanings: []*pb.WordMeaning{},
+		}, nil)
+
+	// Test
+	e.ServeHTTP(rec, req)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"wordMeanings": []}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestFindWordMeanings_WhenQueryParamIsDecodedTwice() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Set("word", "look%20after")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// query string 已經解碼過，不能再解碼一次
+	// Test
+	err := s.wordHandler.FindWordMeanings(c)
+	s.Nil(err)
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *MyTestSuite) TestFindWordMeanings_WhenWordIsInvalid() {
+	testCases := []struct {
+		name string
+		word string
+	}{
+		{name: "Blank", word: "   "},
+		{name: "Script", word: "<script>"},
+		{name: "Too long", word: strings.Repeat("a", 51)},
+	}
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			// Setup
+			e := echo.New()
+			q := make(url.Values)
+			q.Set("word", tc.word)
+			req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			// Test
+			err := s.wordHandler.FindWordMeanings(c)
+			s.Nil(err)
+			s.Equal(http.StatusBadRequest, rec.Code)
+		})
+	}
+}
+
 func (s *MyTestSuite) TestCreateFavoriteWordMeaning() {
 	// Setup
 	requestJSON := `{
@@ -170,7 +260,7 @@ func (s *MyTestSuite) TestFindFavoriteWordMeanings() {
 	c := e.NewContext(req, rec)
 
 	s.mockWordService.EXPECT().
-		FindFavoriteWordMeanings(int32(0), int32(10), "user01", "test").
+		FindFavoriteWordMeanings(int32(0), int32(10), "user01", "test", "").
 		Return(&pb.FindFavoriteWordMeaningsResponse{
 			Total:                0,
 			PageCount:            0,
@@ -181,7 +271,38 @@ func (s *MyTestSuite) TestFindFavoriteWordMeanings() {
 	err := s.wordHandler.FindFavoriteWordMeanings(c)
 	s.Nil(err)
 	s.Equal(http.StatusOK, rec.Code)
-	s.JSONEq(`{"total": 0, "pageCount": 0, "favoriteWordMeanings": []}`, rec.Body.String())
+	s.JSONEq(
+		`{"total": 0, "pageCount": 0, "favoriteWordMeanings": [], "nextCursor": ""}`,
+		rec.Body.String(),
+	)
+}
+
+func (s *MyTestSuite) TestFindFavoriteWordMeanings_WhenUseCursor() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Set("pageSize", "10")
+	q.Set("cursor", "cursor01")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockWordService.EXPECT().
+		FindFavoriteWordMeanings(int32(0), int32(10), "user01", "", "cursor01").
+		Return(&pb.FindFavoriteWordMeaningsResponse{
+			FavoriteWordMeanings: []*pb.WordMeaning{},
+			NextCursor:           "cursor02",
+		}, nil)
+
+	// Test
+	err := s.wordHandler.FindFavoriteWordMeanings(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(
+		`{"total": 0, "pageCount": 0, "favoriteWordMeanings": [], "nextCursor": "cursor02"}`,
+		rec.Body.String(),
+	)
 }
 
 func (s *MyTestSuite) TestFindRandomFavoriteWordMeanings_WhenCacheHasData() {
@@ -219,7 +340,7 @@ func (s *MyTestSuite) TestFindRandomFavoriteWordMeanings_WhenCacheHasNoData() {
 		FindWordMeanings(mock.Anything, key).
 		Return(nil, nil)
 	s.mockWordService.EXPECT().
-		FindRandomFavoriteWordMeanings("user01", int32(20)).
+		FindRandomFavoriteWordMeanings("user01", int32(20), "").
 		Return(&pb.FindRandomFavoriteWordMeaningsResponse{
 			FavoriteWordMeanings: []*pb.WordMeaning{
 				{},
@@ -232,3 +353,270 @@ func (s *MyTestSuite) TestFindRandomFavoriteWordMeanings_WhenCacheHasNoData() {
 	s.Equal(http.StatusOK, rec.Code)
 	s.NotEmpty(rec.Body.String())
 }
+
+func (s *MyTestSuite) TestFindRandomFavoriteWordMeanings_WhenWeightingIsSet() {
+	// Setup
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/?weighting=less_reviewed", nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	key := fmt.Sprintf("FindRandomFavoriteWordMeanings:%s:%s", "user01", "less_reviewed")
+	s.mockCacheRepository.EXPECT().
+		FindWordMeanings(mock.Anything, key).
+		Return(nil, nil)
+	s.mockWordService.EXPECT().
+		FindRandomFavoriteWordMeanings("user01", int32(20), "less_reviewed").
+		Return(&pb.FindRandomFavoriteWordMeaningsResponse{
+			FavoriteWordMeanings: []*pb.WordMeaning{
+				{},
+			},
+		}, nil)
+
+	// Test
+	err := s.wordHandler.FindRandomFavoriteWordMeanings(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.NotEmpty(rec.Body.String())
+}
+
+func (s *MyTestSuite) TestFindRandomFavoriteWordMeanings_WhenWeightingIsInvalid() {
+	// Setup
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/?weighting=unknown", nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Test
+	err := s.wordHandler.FindRandomFavoriteWordMeanings(c)
+	s.Nil(err)
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *MyTestSuite) TestFindRecentLookups() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Set("size", "10")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockWordService.EXPECT().
+		FindRecentLookups("user01", int32(10)).
+		Return(&pb.FindRecentLookupsResponse{
+			LookupHistories: []*pb.LookupHistory{},
+		}, nil)
+
+	// Test
+	err := s.wordHandler.FindRecentLookups(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"lookupHistories": []}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestFindRecentLookups_WhenSizeIsInvalid() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Set("size", "abc")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Test
+	err := s.wordHandler.FindRecentLookups(c)
+	s.Nil(err)
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *MyTestSuite) TestClearLookupHistory() {
+	// Setup
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/", nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockWordService.EXPECT().
+		ClearLookupHistory("user01").
+		Return(&pb.ClearLookupHistoryResponse{
+			DeletedCount: 3,
+		}, nil)
+
+	// Test
+	err := s.wordHandler.ClearLookupHistory(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"deletedCount": 3}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestFindDictationSentences() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Set("size", "5")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockWordService.EXPECT().
+		FindDictationSentences("user01", int32(5)).
+		Return(&pb.FindDictationSentencesResponse{
+			Sentences: []*pb.DictationSentence{
+				{
+					WordMeaningId: "id01",
+					Word:          "test",
+					AudioUrl:      "https://example.com/test.mp3",
+					WordCount:     3,
+				},
+			},
+		}, nil)
+
+	// Test
+	err := s.wordHandler.FindDictationSentences(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{
+		"sentences": [
+			{
+				"wordMeaningId": "id01",
+				"word": "test",
+				"audioUrl": "https://example.com/test.mp3",
+				"wordCount": 3
+			}
+		]
+	}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestMarkFavoriteWordMeaningsReviewed() {
+	// Setup
+	requestJSON := `{"favoriteWordMeaningIds": ["f01", "f02"]}`
+	e := echo.New()
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/restricted/word/card/reviewed",
+		strings.NewReader(requestJSON),
+	)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockWordService.EXPECT().
+		MarkFavoriteWordMeaningsReviewed([]string{"f01", "f02"}, "user01").
+		Return(&pb.MarkFavoriteWordMeaningsReviewedResponse{
+			ModifiedCount: 2,
+		}, nil)
+
+	// Test
+	err := s.wordHandler.MarkFavoriteWordMeaningsReviewed(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"modifiedCount": 2}`, rec.Body.String())
+}
+
+func (s *MyTestSuite) TestMarkFavoriteWordMeaningsReviewed_WhenIdsAreEmpty() {
+	// Setup
+	requestJSON := `{"favoriteWordMeaningIds": []}`
+	e := echo.New()
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/restricted/word/card/reviewed",
+		strings.NewReader(requestJSON),
+	)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Test
+	err := s.wordHandler.MarkFavoriteWordMeaningsReviewed(c)
+	s.Nil(err)
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *MyTestSuite) TestGradeDictation() {
+	// Setup
+	requestJSON := `{
+  	"wordMeaningId": "id01",
+  	"audioUrl": "https://example.com/test.mp3",
+  	"answer": "pass the test"
+	}`
+	e := echo.New()
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/restricted/word/dictation",
+		strings.NewReader(requestJSON),
+	)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockWordService.EXPECT().
+		GradeDictation("user01", "id01", "https://example.com/test.mp3", "pass the test").
+		Return(&pb.GradeDictationResponse{
+			DictationRecord: &pb.DictationRecord{
+				Id:           "rid01",
+				Accuracy:     100,
+				WordCount:    3,
+				CorrectCount: 3,
+				Diffs:        []*pb.DictationWordDiff{},
+			},
+		}, nil)
+
+	// Test
+	err := s.wordHandler.GradeDictation(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"accuracy":100`)
+}
+
+func (s *MyTestSuite) TestGradeDictation_WhenAudioUrlIsEmpty() {
+	// Setup
+	requestJSON := `{
+  	"wordMeaningId": "id01",
+  	"answer": "pass the test"
+	}`
+	e := echo.New()
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/restricted/word/dictation",
+		strings.NewReader(requestJSON),
+	)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Test
+	err := s.wordHandler.GradeDictation(c)
+	s.Nil(err)
+	s.Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *MyTestSuite) TestFindDictationHistory() {
+	// Setup
+	e := echo.New()
+	q := make(url.Values)
+	q.Set("size", "20")
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	s.mockWordService.EXPECT().
+		FindDictationHistory("user01", int32(20)).
+		Return(&pb.FindDictationHistoryResponse{
+			DictationRecords: []*pb.DictationRecord{},
+			AverageAccuracy:  87.5,
+		}, nil)
+
+	// Test
+	err := s.wordHandler.FindDictationHistory(c)
+	s.Nil(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.JSONEq(`{"dictationRecords": [], "averageAccuracy": 87.5}`, rec.Body.String())
+}
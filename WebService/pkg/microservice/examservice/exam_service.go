@@ -17,47 +17,196 @@ type ExamService interface {
 	Connect() error
 	Disconnect() error
 	CreateExam(
-		topic, description string, isPublic bool, userId string,
+		topic, description string, tags []string, isPublic bool, userId string,
 	) (*pb.CreateExamResponse, error)
 	FindExams(
-		pageIndex, pageSize int32, userId string,
+		pageIndex, pageSize int32, userId, cursor string,
 	) (*pb.FindExamsResponse, error)
 	UpdateExam(
-		examId, topic, description string, isPublic bool, userId string,
+		examId, topic, description string,
+		tags []string,
+		isPublic bool,
+		settings *pb.ExamSettings,
+		expectedVersion *int32,
+		userId string,
 	) (*pb.UpdateExamResponse, error)
+	GetExam(examId, userId string) (*pb.GetExamResponse, error)
 	DeleteExam(
 		examId, userId string,
 	) (*pb.DeleteExamResponse, error)
+	CreatePracticeExam(
+		topic, description string,
+		practiceWords []*pb.PracticeWord,
+		questionTypes []string,
+		userId string,
+	) (*pb.CreatePracticeExamResponse, error)
 
 	FindQuestions(
-		pageIndex, pageSize int32, examId, userId string,
+		pageIndex, pageSize int32, examId, userId, cursor string,
 	) (*pb.FindQuestionsResponse, error)
 	CreateQuestion(
-		examId, ask string, answers []string, userId string,
+		examId, ask string,
+		answers []string,
+		acceptedAnswers []*pb.AcceptedAnswers,
+		points int32,
+		partialCredit bool,
+		hint, explanation, sectionId string,
+		userId string,
 	) (*pb.CreateQuestionResponse, error)
 	UpdateQuestion(
-		questionId, ask string, answers []string, userId string,
+		questionId, ask string,
+		answers []string,
+		acceptedAnswers []*pb.AcceptedAnswers,
+		points int32,
+		partialCredit bool,
+		hint, explanation, sectionId string,
+		expectedVersion *int32,
+		userId string,
 	) (*pb.UpdateQuestionResponse, error)
+	GetQuestion(questionId, userId string) (*pb.GetQuestionResponse, error)
 	DeleteQuestion(
 		questionId, userId string,
 	) (*pb.DeleteQuestionResponse, error)
+	BatchMutateQuestions(
+		examId string, operations []*pb.QuestionOperation, userId string,
+	) (*pb.BatchMutateQuestionsResponse, error)
 	FindRandomQuestions(
 		examId, userId string, size int32,
 	) (*pb.FindRandomQuestionsResponse, error)
+	RevealQuestionHint(
+		examId, examAttemptId, questionId, userId string,
+	) (*pb.RevealQuestionHintResponse, error)
+
+	CreateBankQuestion(
+		ask string,
+		answers, tags []string,
+		points int32,
+		partialCredit bool,
+		hint, explanation string,
+		userId string,
+	) (*pb.CreateBankQuestionResponse, error)
+	UpdateBankQuestion(
+		bankQuestionId, ask string,
+		answers, tags []string,
+		points int32,
+		partialCredit bool,
+		hint, explanation string,
+		userId string,
+	) (*pb.UpdateBankQuestionResponse, error)
+	DeleteBankQuestion(
+		bankQuestionId, userId string,
+	) (*pb.DeleteBankQuestionResponse, error)
+	FindBankQuestions(
+		pageIndex, pageSize int32, tag, userId string,
+	) (*pb.FindBankQuestionsResponse, error)
+	SetExamBankSource(
+		examId string,
+		questionIds []string,
+		pools []*pb.ExamBankPool,
+		userId string,
+	) (*pb.SetExamBankSourceResponse, error)
+
+	CreateExamSection(
+		examId, sectionType, title, passage, audioUrl string,
+		order int32,
+		userId string,
+	) (*pb.CreateExamSectionResponse, error)
+	UpdateExamSection(
+		sectionId, sectionType, title, passage, audioUrl string,
+		order int32,
+		userId string,
+	) (*pb.UpdateExamSectionResponse, error)
+	DeleteExamSection(
+		sectionId, userId string,
+	) (*pb.DeleteExamSectionResponse, error)
+	FindExamSections(
+		examId, userId string,
+	) (*pb.FindExamSectionsResponse, error)
 
 	CreateExamRecord(
-		examId string, score int32, wrongQuestionIds []string, userId string,
+		examId, examAttemptId string,
+		durationSeconds int32,
+		answers []*pb.ExamAttemptAnswer,
+		userId string,
 	) (*pb.CreateExamRecordResponse, error)
 	FindExamRecords(
-		pageIndex, pageSize int32, examId, userId string,
+		pageIndex, pageSize int32, examId, userId, cursor string,
 	) (*pb.FindExamRecordsResponse, error)
+	GetExamRecordDetail(
+		examId, examRecordId, userId string,
+	) (*pb.GetExamRecordDetailResponse, error)
 	FindExamRecordOverview(
-		examId, userId string, startDate time.Time,
+		examId, userId string,
+		startDate, endDate time.Time,
+		examVersion int32,
+		dateRange, timezone string,
+		movingAverageWindow int32,
 	) (*pb.FindExamRecordOverviewResponse, error)
+	FindExamLeaderboard(
+		examId, window string, size int32, excludedUserIds []string,
+	) (*pb.FindExamLeaderboardResponse, error)
+	FindQuestionStatistics(
+		examId, userId string,
+	) (*pb.FindQuestionStatisticsResponse, error)
+
+	FindMistakeNotebook(
+		userId, examId string,
+		startDate, endDate time.Time,
+		pageIndex, pageSize int32,
+	) (*pb.FindMistakeNotebookResponse, error)
+	CreateMistakeDrill(
+		userId, examId string, size int32,
+	) (*pb.CreateMistakeDrillResponse, error)
+	AnswerMistakeDrill(
+		answerWrongId, userId string, blankAnswers []string,
+	) (*pb.AnswerMistakeDrillResponse, error)
 
 	FindExamInfos(
-		userId string, isPublic bool,
+		userId string, isPublic bool, tags []string,
 	) (*pb.FindExamInfosResponse, error)
+	FindExamsByTags(
+		tags []string, userId string, pageIndex, pageSize int32,
+	) (*pb.FindExamsByTagsResponse, error)
+	ForkExam(
+		examId, userId string,
+	) (*pb.ForkExamResponse, error)
+	PublishExam(
+		examId, userId string,
+	) (*pb.PublishExamResponse, error)
+	ShareExam(
+		examId, sharedUserId, role, userId string,
+	) (*pb.ShareExamResponse, error)
+	UnshareExam(
+		examId, sharedUserId, userId string,
+	) (*pb.UnshareExamResponse, error)
+	FindExamShares(
+		examId, userId string,
+	) (*pb.FindExamSharesResponse, error)
+	ImportExam(
+		topic, description string,
+		tags []string,
+		format string,
+		content []byte,
+		userId string,
+	) (*pb.ImportExamResponse, error)
+	ExportExam(
+		examId, userId, format string,
+	) (pb.ExamService_ExportExamClient, error)
+	FindExamTagCounts(
+		userId string, size int32,
+	) (*pb.FindExamTagCountsResponse, error)
+	FindExamCatalog(
+		keyword string, tags []string, sortBy string, pageIndex, pageSize int32,
+	) (*pb.FindExamCatalogResponse, error)
+
+	CreateClass(name, description, userId string) (*pb.CreateClassResponse, error)
+	JoinClass(joinCode, userId string) (*pb.JoinClassResponse, error)
+	FindClasses(userId string) (*pb.FindClassesResponse, error)
+	CreateAssignment(
+		classId, examId, title string, dueAt time.Time, userId string,
+	) (*pb.CreateAssignmentResponse, error)
+	FindAssignments(classId, userId string) (*pb.FindAssignmentsResponse, error)
+	FindClassGradebook(classId, userId string) (*pb.FindClassGradebookResponse, error)
 }
 
 func New(serverAddress string) ExamService {
@@ -103,6 +252,7 @@ func (service *examService) Disconnect() error {
 func (service examService) CreateExam(
 	topic,
 	description string,
+	tags []string,
 	isPublic bool,
 	userId string,
 ) (*pb.CreateExamResponse, error) {
@@ -111,6 +261,7 @@ func (service examService) CreateExam(
 		&pb.CreateExamRequest{
 			Topic:       topic,
 			Description: description,
+			Tags:        tags,
 			IsPublic:    isPublic,
 			UserId:      userId,
 		},
@@ -119,7 +270,7 @@ func (service examService) CreateExam(
 
 func (service examService) FindExams(
 	pageIndex, pageSize int32,
-	userId string,
+	userId, cursor string,
 ) (*pb.FindExamsResponse, error) {
 	return service.client.FindExams(
 		context.Background(),
@@ -127,6 +278,7 @@ func (service examService) FindExams(
 			PageIndex: pageIndex,
 			PageSize:  pageSize,
 			UserId:    userId,
+			Cursor:    cursor,
 		},
 	)
 }
@@ -135,17 +287,35 @@ func (examServiceClient examService) UpdateExam(
 	examId,
 	topic,
 	description string,
+	tags []string,
 	isPublic bool,
+	settings *pb.ExamSettings,
+	expectedVersion *int32,
 	userId string,
 ) (*pb.UpdateExamResponse, error) {
 	return examServiceClient.client.UpdateExam(
 		context.Background(),
 		&pb.UpdateExamRequest{
-			ExamId:      examId,
-			Topic:       topic,
-			Description: description,
-			IsPublic:    isPublic,
-			UserId:      userId,
+			ExamId:          examId,
+			Topic:           topic,
+			Description:     description,
+			Tags:            tags,
+			IsPublic:        isPublic,
+			Settings:        settings,
+			ExpectedVersion: expectedVersion,
+			UserId:          userId,
+		},
+	)
+}
+
+func (examServiceClient examService) GetExam(
+	examId, userId string,
+) (*pb.GetExamResponse, error) {
+	return examServiceClient.client.GetExam(
+		context.Background(),
+		&pb.GetExamRequest{
+			ExamId: examId,
+			UserId: userId,
 		},
 	)
 }
@@ -162,9 +332,27 @@ func (examServiceClient examService) DeleteExam(
 	)
 }
 
+func (service examService) CreatePracticeExam(
+	topic, description string,
+	practiceWords []*pb.PracticeWord,
+	questionTypes []string,
+	userId string,
+) (*pb.CreatePracticeExamResponse, error) {
+	return service.client.CreatePracticeExam(
+		context.Background(),
+		&pb.CreatePracticeExamRequest{
+			Topic:         topic,
+			Description:   description,
+			PracticeWords: practiceWords,
+			QuestionTypes: questionTypes,
+			UserId:        userId,
+		},
+	)
+}
+
 func (service examService) FindQuestions(
 	pageIndex, pageSize int32,
-	examId, userId string,
+	examId, userId, cursor string,
 ) (*pb.FindQuestionsResponse, error) {
 	return service.client.FindQuestions(
 		context.Background(),
@@ -173,33 +361,72 @@ func (service examService) FindQuestions(
 			PageSize:  pageSize,
 			ExamId:    examId,
 			UserId:    userId,
+			Cursor:    cursor,
 		},
 	)
 }
 
 func (service examService) CreateQuestion(
-	examId, ask string, answers []string, userId string,
+	examId, ask string,
+	answers []string,
+	acceptedAnswers []*pb.AcceptedAnswers,
+	points int32,
+	partialCredit bool,
+	hint, explanation, sectionId string,
+	userId string,
 ) (*pb.CreateQuestionResponse, error) {
 	return service.client.CreateQuestion(
 		context.Background(),
 		&pb.CreateQuestionRequest{
-			ExamId:  examId,
-			Ask:     ask,
-			Answers: answers,
-			UserId:  userId,
+			ExamId:          examId,
+			Ask:             ask,
+			Answers:         answers,
+			AcceptedAnswers: acceptedAnswers,
+			Points:          points,
+			PartialCredit:   partialCredit,
+			Hint:            hint,
+			Explanation:     explanation,
+			SectionId:       sectionId,
+			UserId:          userId,
 		},
 	)
 }
 
 func (service examService) UpdateQuestion(
-	questionId, ask string, answers []string, userId string,
+	questionId, ask string,
+	answers []string,
+	acceptedAnswers []*pb.AcceptedAnswers,
+	points int32,
+	partialCredit bool,
+	hint, explanation, sectionId string,
+	expectedVersion *int32,
+	userId string,
 ) (*pb.UpdateQuestionResponse, error) {
 	return service.client.UpdateQuestion(
 		context.Background(),
 		&pb.UpdateQuestionRequest{
+			QuestionId:      questionId,
+			Ask:             ask,
+			Answers:         answers,
+			AcceptedAnswers: acceptedAnswers,
+			Points:          points,
+			PartialCredit:   partialCredit,
+			Hint:            hint,
+			Explanation:     explanation,
+			SectionId:       sectionId,
+			ExpectedVersion: expectedVersion,
+			UserId:          userId,
+		},
+	)
+}
+
+func (service examService) GetQuestion(
+	questionId, userId string,
+) (*pb.GetQuestionResponse, error) {
+	return service.client.GetQuestion(
+		context.Background(),
+		&pb.GetQuestionRequest{
 			QuestionId: questionId,
-			Ask:        ask,
-			Answers:    answers,
 			UserId:     userId,
 		},
 	)
@@ -217,6 +444,19 @@ func (service examService) DeleteQuestion(
 	)
 }
 
+func (service examService) BatchMutateQuestions(
+	examId string, operations []*pb.QuestionOperation, userId string,
+) (*pb.BatchMutateQuestionsResponse, error) {
+	return service.client.BatchMutateQuestions(
+		context.Background(),
+		&pb.BatchMutateQuestionsRequest{
+			ExamId:     examId,
+			Operations: operations,
+			UserId:     userId,
+		},
+	)
+}
+
 func (service examService) FindRandomQuestions(
 	examId, userId string, size int32,
 ) (*pb.FindRandomQuestionsResponse, error) {
@@ -230,35 +470,296 @@ func (service examService) FindRandomQuestions(
 	)
 }
 
+func (service examService) RevealQuestionHint(
+	examId, examAttemptId, questionId, userId string,
+) (*pb.RevealQuestionHintResponse, error) {
+	return service.client.RevealQuestionHint(
+		context.Background(),
+		&pb.RevealQuestionHintRequest{
+			ExamId:        examId,
+			ExamAttemptId: examAttemptId,
+			QuestionId:    questionId,
+			UserId:        userId,
+		},
+	)
+}
+
+func (service examService) CreateBankQuestion(
+	ask string,
+	answers, tags []string,
+	points int32,
+	partialCredit bool,
+	hint, explanation string,
+	userId string,
+) (*pb.CreateBankQuestionResponse, error) {
+	return service.client.CreateBankQuestion(
+		context.Background(),
+		&pb.CreateBankQuestionRequest{
+			Ask:           ask,
+			Answers:       answers,
+			Tags:          tags,
+			Points:        points,
+			PartialCredit: partialCredit,
+			Hint:          hint,
+			Explanation:   explanation,
+			UserId:        userId,
+		},
+	)
+}
+
+func (service examService) UpdateBankQuestion(
+	bankQuestionId, ask string,
+	answers, tags []string,
+	points int32,
+	partialCredit bool,
+	hint, explanation string,
+	userId string,
+) (*pb.UpdateBankQuestionResponse, error) {
+	return service.client.UpdateBankQuestion(
+		context.Background(),
+		&pb.UpdateBankQuestionRequest{
+			BankQuestionId: bankQuestionId,
+			Ask:            ask,
+			Answers:        answers,
+			Tags:           tags,
+			Points:         points,
+			PartialCredit:  partialCredit,
+			Hint:           hint,
+			Explanation:    explanation,
+			UserId:         userId,
+		},
+	)
+}
+
+func (service examService) DeleteBankQuestion(
+	bankQuestionId, userId string,
+) (*pb.DeleteBankQuestionResponse, error) {
+	return service.client.DeleteBankQuestion(
+		context.Background(),
+		&pb.DeleteBankQuestionRequest{
+			BankQuestionId: bankQuestionId,
+			UserId:         userId,
+		},
+	)
+}
+
+func (service examService) FindBankQuestions(
+	pageIndex, pageSize int32, tag, userId string,
+) (*pb.FindBankQuestionsResponse, error) {
+	return service.client.FindBankQuestions(
+		context.Background(),
+		&pb.FindBankQuestionsRequest{
+			PageIndex: pageIndex,
+			PageSize:  pageSize,
+			Tag:       tag,
+			UserId:    userId,
+		},
+	)
+}
+
+func (service examService) SetExamBankSource(
+	examId string,
+	questionIds []string,
+	pools []*pb.ExamBankPool,
+	userId string,
+) (*pb.SetExamBankSourceResponse, error) {
+	return service.client.SetExamBankSource(
+		context.Background(),
+		&pb.SetExamBankSourceRequest{
+			ExamId:      examId,
+			QuestionIds: questionIds,
+			Pools:       pools,
+			UserId:      userId,
+		},
+	)
+}
+
+func (service examService) CreateExamSection(
+	examId, sectionType, title, passage, audioUrl string,
+	order int32,
+	userId string,
+) (*pb.CreateExamSectionResponse, error) {
+	return service.client.CreateExamSection(
+		context.Background(),
+		&pb.CreateExamSectionRequest{
+			ExamId:   examId,
+			Type:     sectionType,
+			Title:    title,
+			Passage:  passage,
+			AudioUrl: audioUrl,
+			Order:    order,
+			UserId:   userId,
+		},
+	)
+}
+
+func (service examService) UpdateExamSection(
+	sectionId, sectionType, title, passage, audioUrl string,
+	order int32,
+	userId string,
+) (*pb.UpdateExamSectionResponse, error) {
+	return service.client.UpdateExamSection(
+		context.Background(),
+		&pb.UpdateExamSectionRequest{
+			SectionId: sectionId,
+			Type:      sectionType,
+			Title:     title,
+			Passage:   passage,
+			AudioUrl:  audioUrl,
+			Order:     order,
+			UserId:    userId,
+		},
+	)
+}
+
+func (service examService) DeleteExamSection(
+	sectionId, userId string,
+) (*pb.DeleteExamSectionResponse, error) {
+	return service.client.DeleteExamSection(
+		context.Background(),
+		&pb.DeleteExamSectionRequest{
+			SectionId: sectionId,
+			UserId:    userId,
+		},
+	)
+}
+
+func (service examService) FindExamSections(
+	examId, userId string,
+) (*pb.FindExamSectionsResponse, error) {
+	return service.client.FindExamSections(
+		context.Background(),
+		&pb.FindExamSectionsRequest{
+			ExamId: examId,
+			UserId: userId,
+		},
+	)
+}
+
 func (service examService) CreateExamRecord(
-	examId string, score int32, wrongQuestionIds []string, userId string,
+	examId, examAttemptId string,
+	durationSeconds int32,
+	answers []*pb.ExamAttemptAnswer,
+	userId string,
 ) (*pb.CreateExamRecordResponse, error) {
 	return service.client.CreateExamRecord(
 		context.Background(),
 		&pb.CreateExamRecordRequest{
-			ExamId:           examId,
-			Score:            score,
-			WrongQuestionIds: wrongQuestionIds,
-			UserId:           userId,
+			ExamId:          examId,
+			ExamAttemptId:   examAttemptId,
+			UserId:          userId,
+			DurationSeconds: durationSeconds,
+			Answers:         answers,
 		},
 	)
 }
 
 func (service examService) FindExamRecordOverview(
-	examId, userId string, startDate time.Time,
+	examId, userId string,
+	startDate, endDate time.Time,
+	examVersion int32,
+	dateRange, timezone string,
+	movingAverageWindow int32,
 ) (*pb.FindExamRecordOverviewResponse, error) {
-	return service.client.FindExamRecordOverview(
+	request := &pb.FindExamRecordOverviewRequest{
+		ExamId:              examId,
+		UserId:              userId,
+		ExamVersion:         examVersion,
+		Range:               dateRange,
+		Timezone:            timezone,
+		MovingAverageWindow: movingAverageWindow,
+	}
+
+	// 沒有指定日期時不傳入，讓 ExamService 依照 range 計算期間
+	if !startDate.IsZero() {
+		request.StartDate = timestamppb.New(startDate)
+	}
+
+	if !endDate.IsZero() {
+		request.EndDate = timestamppb.New(endDate)
+	}
+
+	return service.client.FindExamRecordOverview(context.Background(), request)
+}
+
+func (service examService) FindExamLeaderboard(
+	examId, window string, size int32, excludedUserIds []string,
+) (*pb.FindExamLeaderboardResponse, error) {
+	return service.client.FindExamLeaderboard(
 		context.Background(),
-		&pb.FindExamRecordOverviewRequest{
-			ExamId:    examId,
-			UserId:    userId,
-			StartDate: timestamppb.New(startDate),
+		&pb.FindExamLeaderboardRequest{
+			ExamId:          examId,
+			Window:          window,
+			Size:            size,
+			ExcludedUserIds: excludedUserIds,
+		},
+	)
+}
+
+func (service examService) FindQuestionStatistics(
+	examId, userId string,
+) (*pb.FindQuestionStatisticsResponse, error) {
+	return service.client.FindQuestionStatistics(
+		context.Background(),
+		&pb.FindQuestionStatisticsRequest{
+			ExamId: examId,
+			UserId: userId,
+		},
+	)
+}
+
+func (service examService) FindMistakeNotebook(
+	userId, examId string,
+	startDate, endDate time.Time,
+	pageIndex, pageSize int32,
+) (*pb.FindMistakeNotebookResponse, error) {
+	request := &pb.FindMistakeNotebookRequest{
+		UserId:    userId,
+		ExamId:    examId,
+		PageIndex: pageIndex,
+		PageSize:  pageSize,
+	}
+
+	// 沒有指定日期時不傳入，表示不限制
+	if !startDate.IsZero() {
+		request.StartDate = timestamppb.New(startDate)
+	}
+
+	if !endDate.IsZero() {
+		request.EndDate = timestamppb.New(endDate)
+	}
+
+	return service.client.FindMistakeNotebook(context.Background(), request)
+}
+
+func (service examService) CreateMistakeDrill(
+	userId, examId string, size int32,
+) (*pb.CreateMistakeDrillResponse, error) {
+	return service.client.CreateMistakeDrill(
+		context.Background(),
+		&pb.CreateMistakeDrillRequest{
+			UserId: userId,
+			ExamId: examId,
+			Size:   size,
+		},
+	)
+}
+
+func (service examService) AnswerMistakeDrill(
+	answerWrongId, userId string, blankAnswers []string,
+) (*pb.AnswerMistakeDrillResponse, error) {
+	return service.client.AnswerMistakeDrill(
+		context.Background(),
+		&pb.AnswerMistakeDrillRequest{
+			AnswerWrongId: answerWrongId,
+			UserId:        userId,
+			BlankAnswers:  blankAnswers,
 		},
 	)
 }
 
 func (service examService) FindExamRecords(
-	pageIndex, pageSize int32, examId, userId string,
+	pageIndex, pageSize int32, examId, userId, cursor string,
 ) (*pb.FindExamRecordsResponse, error) {
 	return service.client.FindExamRecords(
 		context.Background(),
@@ -267,18 +768,245 @@ func (service examService) FindExamRecords(
 			PageSize:  pageSize,
 			ExamId:    examId,
 			UserId:    userId,
+			Cursor:    cursor,
+		},
+	)
+}
+
+func (service examService) GetExamRecordDetail(
+	examId, examRecordId, userId string,
+) (*pb.GetExamRecordDetailResponse, error) {
+	return service.client.GetExamRecordDetail(
+		context.Background(),
+		&pb.GetExamRecordDetailRequest{
+			ExamId:       examId,
+			ExamRecordId: examRecordId,
+			UserId:       userId,
 		},
 	)
 }
 
 func (service examService) FindExamInfos(
-	userId string, isPublic bool,
+	userId string, isPublic bool, tags []string,
 ) (*pb.FindExamInfosResponse, error) {
 	return service.client.FindExamInfos(
 		context.Background(),
 		&pb.FindExamInfosRequest{
 			UserId:   userId,
 			IsPublic: isPublic,
+			Tags:     tags,
+		},
+	)
+}
+
+func (service examService) FindExamCatalog(
+	keyword string, tags []string, sortBy string, pageIndex, pageSize int32,
+) (*pb.FindExamCatalogResponse, error) {
+	return service.client.FindExamCatalog(
+		context.Background(),
+		&pb.FindExamCatalogRequest{
+			Keyword:   keyword,
+			Tags:      tags,
+			SortBy:    sortBy,
+			PageIndex: pageIndex,
+			PageSize:  pageSize,
+		},
+	)
+}
+
+func (service examService) FindExamsByTags(
+	tags []string, userId string, pageIndex, pageSize int32,
+) (*pb.FindExamsByTagsResponse, error) {
+	return service.client.FindExamsByTags(
+		context.Background(),
+		&pb.FindExamsByTagsRequest{
+			Tags:      tags,
+			UserId:    userId,
+			PageIndex: pageIndex,
+			PageSize:  pageSize,
+		},
+	)
+}
+
+func (service examService) ForkExam(
+	examId, userId string,
+) (*pb.ForkExamResponse, error) {
+	return service.client.ForkExam(
+		context.Background(),
+		&pb.ForkExamRequest{
+			ExamId: examId,
+			UserId: userId,
+		},
+	)
+}
+
+func (service examService) PublishExam(
+	examId, userId string,
+) (*pb.PublishExamResponse, error) {
+	return service.client.PublishExam(
+		context.Background(),
+		&pb.PublishExamRequest{
+			ExamId: examId,
+			UserId: userId,
+		},
+	)
+}
+
+func (service examService) ShareExam(
+	examId, sharedUserId, role, userId string,
+) (*pb.ShareExamResponse, error) {
+	return service.client.ShareExam(
+		context.Background(),
+		&pb.ShareExamRequest{
+			ExamId:       examId,
+			SharedUserId: sharedUserId,
+			Role:         role,
+			UserId:       userId,
+		},
+	)
+}
+
+func (service examService) UnshareExam(
+	examId, sharedUserId, userId string,
+) (*pb.UnshareExamResponse, error) {
+	return service.client.UnshareExam(
+		context.Background(),
+		&pb.UnshareExamRequest{
+			ExamId:       examId,
+			SharedUserId: sharedUserId,
+			UserId:       userId,
+		},
+	)
+}
+
+func (service examService) FindExamShares(
+	examId, userId string,
+) (*pb.FindExamSharesResponse, error) {
+	return service.client.FindExamShares(
+		context.Background(),
+		&pb.FindExamSharesRequest{
+			ExamId: examId,
+			UserId: userId,
+		},
+	)
+}
+
+func (service examService) ImportExam(
+	topic, description string,
+	tags []string,
+	format string,
+	content []byte,
+	userId string,
+) (*pb.ImportExamResponse, error) {
+	return service.client.ImportExam(
+		context.Background(),
+		&pb.ImportExamRequest{
+			Topic:       topic,
+			Description: description,
+			Tags:        tags,
+			Format:      format,
+			Content:     content,
+			UserId:      userId,
+		},
+	)
+}
+
+func (service examService) ExportExam(
+	examId, userId, format string,
+) (pb.ExamService_ExportExamClient, error) {
+	return service.client.ExportExam(
+		context.Background(),
+		&pb.ExportExamRequest{
+			ExamId: examId,
+			UserId: userId,
+			Format: format,
+		},
+	)
+}
+
+func (service examService) FindExamTagCounts(
+	userId string, size int32,
+) (*pb.FindExamTagCountsResponse, error) {
+	return service.client.FindExamTagCounts(
+		context.Background(),
+		&pb.FindExamTagCountsRequest{
+			UserId: userId,
+			Size:   size,
+		},
+	)
+}
+
+func (service examService) CreateClass(
+	name, description, userId string,
+) (*pb.CreateClassResponse, error) {
+	return service.client.CreateClass(
+		context.Background(),
+		&pb.CreateClassRequest{
+			Name:        name,
+			Description: description,
+			UserId:      userId,
+		},
+	)
+}
+
+func (service examService) JoinClass(
+	joinCode, userId string,
+) (*pb.JoinClassResponse, error) {
+	return service.client.JoinClass(
+		context.Background(),
+		&pb.JoinClassRequest{
+			JoinCode: joinCode,
+			UserId:   userId,
+		},
+	)
+}
+
+func (service examService) FindClasses(
+	userId string,
+) (*pb.FindClassesResponse, error) {
+	return service.client.FindClasses(
+		context.Background(),
+		&pb.FindClassesRequest{
+			UserId: userId,
+		},
+	)
+}
+
+func (service examService) CreateAssignment(
+	classId, examId, title string, dueAt time.Time, userId string,
+) (*pb.CreateAssignmentResponse, error) {
+	return service.client.CreateAssignment(
+		context.Background(),
+		&pb.CreateAssignmentRequest{
+			ClassId: classId,
+			ExamId:  examId,
+			Title:   title,
+			DueAt:   timestamppb.New(dueAt),
+			UserId:  userId,
+		},
+	)
+}
+
+func (service examService) FindAssignments(
+	classId, userId string,
+) (*pb.FindAssignmentsResponse, error) {
+	return service.client.FindAssignments(
+		context.Background(),
+		&pb.FindAssignmentsRequest{
+			ClassId: classId,
+			UserId:  userId,
+		},
+	)
+}
+
+func (service examService) FindClassGradebook(
+	classId, userId string,
+) (*pb.FindClassGradebookResponse, error) {
+	return service.client.FindClassGradebook(
+		context.Background(),
+		&pb.FindClassGradebookRequest{
+			ClassId: classId,
+			UserId:  userId,
 		},
 	)
 }
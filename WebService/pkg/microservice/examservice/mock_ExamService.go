@@ -22,6 +22,126 @@ func (_m *MockExamService) EXPECT() *MockExamService_Expecter {
 	return &MockExamService_Expecter{mock: &_m.Mock}
 }
 
+// AnswerMistakeDrill provides a mock function with given fields: answerWrongId, userId, blankAnswers
+func (_m *MockExamService) AnswerMistakeDrill(answerWrongId string, userId string, blankAnswers []string) (*pb.AnswerMistakeDrillResponse, error) {
+	ret := _m.Called(answerWrongId, userId, blankAnswers)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AnswerMistakeDrill")
+	}
+
+	var r0 *pb.AnswerMistakeDrillResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, []string) (*pb.AnswerMistakeDrillResponse, error)); ok {
+		return rf(answerWrongId, userId, blankAnswers)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, []string) *pb.AnswerMistakeDrillResponse); ok {
+		r0 = rf(answerWrongId, userId, blankAnswers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.AnswerMistakeDrillResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, []string) error); ok {
+		r1 = rf(answerWrongId, userId, blankAnswers)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_AnswerMistakeDrill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AnswerMistakeDrill'
+type MockExamService_AnswerMistakeDrill_Call struct {
+	*mock.Call
+}
+
+// AnswerMistakeDrill is a helper method to define mock.On call
+//   - answerWrongId string
+//   - userId string
+//   - blankAnswers []string
+func (_e *MockExamService_Expecter) AnswerMistakeDrill(answerWrongId interface{}, userId interface{}, blankAnswers interface{}) *MockExamService_AnswerMistakeDrill_Call {
+	return &MockExamService_AnswerMistakeDrill_Call{Call: _e.mock.On("AnswerMistakeDrill", answerWrongId, userId, blankAnswers)}
+}
+
+func (_c *MockExamService_AnswerMistakeDrill_Call) Run(run func(answerWrongId string, userId string, blankAnswers []string)) *MockExamService_AnswerMistakeDrill_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_AnswerMistakeDrill_Call) Return(_a0 *pb.AnswerMistakeDrillResponse, _a1 error) *MockExamService_AnswerMistakeDrill_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_AnswerMistakeDrill_Call) RunAndReturn(run func(string, string, []string) (*pb.AnswerMistakeDrillResponse, error)) *MockExamService_AnswerMistakeDrill_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchMutateQuestions provides a mock function with given fields: examId, operations, userId
+func (_m *MockExamService) BatchMutateQuestions(examId string, operations []*pb.QuestionOperation, userId string) (*pb.BatchMutateQuestionsResponse, error) {
+	ret := _m.Called(examId, operations, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchMutateQuestions")
+	}
+
+	var r0 *pb.BatchMutateQuestionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []*pb.QuestionOperation, string) (*pb.BatchMutateQuestionsResponse, error)); ok {
+		return rf(examId, operations, userId)
+	}
+	if rf, ok := ret.Get(0).(func(string, []*pb.QuestionOperation, string) *pb.BatchMutateQuestionsResponse); ok {
+		r0 = rf(examId, operations, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.BatchMutateQuestionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []*pb.QuestionOperation, string) error); ok {
+		r1 = rf(examId, operations, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_BatchMutateQuestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchMutateQuestions'
+type MockExamService_BatchMutateQuestions_Call struct {
+	*mock.Call
+}
+
+// BatchMutateQuestions is a helper method to define mock.On call
+//   - examId string
+//   - operations []*pb.QuestionOperation
+//   - userId string
+func (_e *MockExamService_Expecter) BatchMutateQuestions(examId interface{}, operations interface{}, userId interface{}) *MockExamService_BatchMutateQuestions_Call {
+	return &MockExamService_BatchMutateQuestions_Call{Call: _e.mock.On("BatchMutateQuestions", examId, operations, userId)}
+}
+
+func (_c *MockExamService_BatchMutateQuestions_Call) Run(run func(examId string, operations []*pb.QuestionOperation, userId string)) *MockExamService_BatchMutateQuestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]*pb.QuestionOperation), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_BatchMutateQuestions_Call) Return(_a0 *pb.BatchMutateQuestionsResponse, _a1 error) *MockExamService_BatchMutateQuestions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_BatchMutateQuestions_Call) RunAndReturn(run func(string, []*pb.QuestionOperation, string) (*pb.BatchMutateQuestionsResponse, error)) *MockExamService_BatchMutateQuestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Connect provides a mock function with given fields:
 func (_m *MockExamService) Connect() error {
 	ret := _m.Called()
@@ -50,46 +170,2041 @@ func (_e *MockExamService_Expecter) Connect() *MockExamService_Connect_Call {
 	return &MockExamService_Connect_Call{Call: _e.mock.On("Connect")}
 }
 
-func (_c *MockExamService_Connect_Call) Run(run func()) *MockExamService_Connect_Call {
+func (_c *MockExamService_Connect_Call) Run(run func()) *MockExamService_Connect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockExamService_Connect_Call) Return(_a0 error) *MockExamService_Connect_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockExamService_Connect_Call) RunAndReturn(run func() error) *MockExamService_Connect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAssignment provides a mock function with given fields: classId, examId, title, dueAt, userId
+func (_m *MockExamService) CreateAssignment(classId string, examId string, title string, dueAt time.Time, userId string) (*pb.CreateAssignmentResponse, error) {
+	ret := _m.Called(classId, examId, title, dueAt, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAssignment")
+	}
+
+	var r0 *pb.CreateAssignmentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, time.Time, string) (*pb.CreateAssignmentResponse, error)); ok {
+		return rf(classId, examId, title, dueAt, userId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, time.Time, string) *pb.CreateAssignmentResponse); ok {
+		r0 = rf(classId, examId, title, dueAt, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CreateAssignmentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, time.Time, string) error); ok {
+		r1 = rf(classId, examId, title, dueAt, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_CreateAssignment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAssignment'
+type MockExamService_CreateAssignment_Call struct {
+	*mock.Call
+}
+
+// CreateAssignment is a helper method to define mock.On call
+//   - classId string
+//   - examId string
+//   - title string
+//   - dueAt time.Time
+//   - userId string
+func (_e *MockExamService_Expecter) CreateAssignment(classId interface{}, examId interface{}, title interface{}, dueAt interface{}, userId interface{}) *MockExamService_CreateAssignment_Call {
+	return &MockExamService_CreateAssignment_Call{Call: _e.mock.On("CreateAssignment", classId, examId, title, dueAt, userId)}
+}
+
+func (_c *MockExamService_CreateAssignment_Call) Run(run func(classId string, examId string, title string, dueAt time.Time, userId string)) *MockExamService_CreateAssignment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(time.Time), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_CreateAssignment_Call) Return(_a0 *pb.CreateAssignmentResponse, _a1 error) *MockExamService_CreateAssignment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_CreateAssignment_Call) RunAndReturn(run func(string, string, string, time.Time, string) (*pb.CreateAssignmentResponse, error)) *MockExamService_CreateAssignment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBankQuestion provides a mock function with given fields: ask, answers, tags, points, partialCredit, hint, explanation, userId
+func (_m *MockExamService) CreateBankQuestion(ask string, answers []string, tags []string, points int32, partialCredit bool, hint string, explanation string, userId string) (*pb.CreateBankQuestionResponse, error) {
+	ret := _m.Called(ask, answers, tags, points, partialCredit, hint, explanation, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBankQuestion")
+	}
+
+	var r0 *pb.CreateBankQuestionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []string, []string, int32, bool, string, string, string) (*pb.CreateBankQuestionResponse, error)); ok {
+		return rf(ask, answers, tags, points, partialCredit, hint, explanation, userId)
+	}
+	if rf, ok := ret.Get(0).(func(string, []string, []string, int32, bool, string, string, string) *pb.CreateBankQuestionResponse); ok {
+		r0 = rf(ask, answers, tags, points, partialCredit, hint, explanation, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CreateBankQuestionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []string, []string, int32, bool, string, string, string) error); ok {
+		r1 = rf(ask, answers, tags, points, partialCredit, hint, explanation, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_CreateBankQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBankQuestion'
+type MockExamService_CreateBankQuestion_Call struct {
+	*mock.Call
+}
+
+// CreateBankQuestion is a helper method to define mock.On call
+//   - ask string
+//   - answers []string
+//   - tags []string
+//   - points int32
+//   - partialCredit bool
+//   - hint string
+//   - explanation string
+//   - userId string
+func (_e *MockExamService_Expecter) CreateBankQuestion(ask interface{}, answers interface{}, tags interface{}, points interface{}, partialCredit interface{}, hint interface{}, explanation interface{}, userId interface{}) *MockExamService_CreateBankQuestion_Call {
+	return &MockExamService_CreateBankQuestion_Call{Call: _e.mock.On("CreateBankQuestion", ask, answers, tags, points, partialCredit, hint, explanation, userId)}
+}
+
+func (_c *MockExamService_CreateBankQuestion_Call) Run(run func(ask string, answers []string, tags []string, points int32, partialCredit bool, hint string, explanation string, userId string)) *MockExamService_CreateBankQuestion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]string), args[2].([]string), args[3].(int32), args[4].(bool), args[5].(string), args[6].(string), args[7].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_CreateBankQuestion_Call) Return(_a0 *pb.CreateBankQuestionResponse, _a1 error) *MockExamService_CreateBankQuestion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_CreateBankQuestion_Call) RunAndReturn(run func(string, []string, []string, int32, bool, string, string, string) (*pb.CreateBankQuestionResponse, error)) *MockExamService_CreateBankQuestion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateClass provides a mock function with given fields: name, description, userId
+func (_m *MockExamService) CreateClass(name string, description string, userId string) (*pb.CreateClassResponse, error) {
+	ret := _m.Called(name, description, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateClass")
+	}
+
+	var r0 *pb.CreateClassResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (*pb.CreateClassResponse, error)); ok {
+		return rf(name, description, userId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) *pb.CreateClassResponse); ok {
+		r0 = rf(name, description, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CreateClassResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(name, description, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_CreateClass_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateClass'
+type MockExamService_CreateClass_Call struct {
+	*mock.Call
+}
+
+// CreateClass is a helper method to define mock.On call
+//   - name string
+//   - description string
+//   - userId string
+func (_e *MockExamService_Expecter) CreateClass(name interface{}, description interface{}, userId interface{}) *MockExamService_CreateClass_Call {
+	return &MockExamService_CreateClass_Call{Call: _e.mock.On("CreateClass", name, description, userId)}
+}
+
+func (_c *MockExamService_CreateClass_Call) Run(run func(name string, description string, userId string)) *MockExamService_CreateClass_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_CreateClass_Call) Return(_a0 *pb.CreateClassResponse, _a1 error) *MockExamService_CreateClass_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_CreateClass_Call) RunAndReturn(run func(string, string, string) (*pb.CreateClassResponse, error)) *MockExamService_CreateClass_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateExam provides a mock function with given fields: topic, description, tags, isPublic, userId
+func (_m *MockExamService) CreateExam(topic string, description string, tags []string, isPublic bool, userId string) (*pb.CreateExamResponse, error) {
+	ret := _m.Called(topic, description, tags, isPublic, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExam")
+	}
+
+	var r0 *pb.CreateExamResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, []string, bool, string) (*pb.CreateExamResponse, error)); ok {
+		return rf(topic, description, tags, isPublic, userId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, []string, bool, string) *pb.CreateExamResponse); ok {
+		r0 = rf(topic, description, tags, isPublic, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CreateExamResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, []string, bool, string) error); ok {
+		r1 = rf(topic, description, tags, isPublic, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_CreateExam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExam'
+type MockExamService_CreateExam_Call struct {
+	*mock.Call
+}
+
+// CreateExam is a helper method to define mock.On call
+//   - topic string
+//   - description string
+//   - tags []string
+//   - isPublic bool
+//   - userId string
+func (_e *MockExamService_Expecter) CreateExam(topic interface{}, description interface{}, tags interface{}, isPublic interface{}, userId interface{}) *MockExamService_CreateExam_Call {
+	return &MockExamService_CreateExam_Call{Call: _e.mock.On("CreateExam", topic, description, tags, isPublic, userId)}
+}
+
+func (_c *MockExamService_CreateExam_Call) Run(run func(topic string, description string, tags []string, isPublic bool, userId string)) *MockExamService_CreateExam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].([]string), args[3].(bool), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_CreateExam_Call) Return(_a0 *pb.CreateExamResponse, _a1 error) *MockExamService_CreateExam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_CreateExam_Call) RunAndReturn(run func(string, string, []string, bool, string) (*pb.CreateExamResponse, error)) *MockExamService_CreateExam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateExamRecord provides a mock function with given fields: examId, examAttemptId, durationSeconds, answers, userId
+func (_m *MockExamService) CreateExamRecord(examId string, examAttemptId string, durationSeconds int32, answers []*pb.ExamAttemptAnswer, userId string) (*pb.CreateExamRecordResponse, error) {
+	ret := _m.Called(examId, examAttemptId, durationSeconds, answers, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExamRecord")
+	}
+
+	var r0 *pb.CreateExamRecordResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int32, []*pb.ExamAttemptAnswer, string) (*pb.CreateExamRecordResponse, error)); ok {
+		return rf(examId, examAttemptId, durationSeconds, answers, userId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int32, []*pb.ExamAttemptAnswer, string) *pb.CreateExamRecordResponse); ok {
+		r0 = rf(examId, examAttemptId, durationSeconds, answers, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CreateExamRecordResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int32, []*pb.ExamAttemptAnswer, string) error); ok {
+		r1 = rf(examId, examAttemptId, durationSeconds, answers, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_CreateExamRecord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExamRecord'
+type MockExamService_CreateExamRecord_Call struct {
+	*mock.Call
+}
+
+// CreateExamRecord is a helper method to define mock.On call
+//   - examId string
+//   - examAttemptId string
+//   - durationSeconds int32
+//   - answers []*pb.ExamAttemptAnswer
+//   - userId string
+func (_e *MockExamService_Expecter) CreateExamRecord(examId interface{}, examAttemptId interface{}, durationSeconds interface{}, answers interface{}, userId interface{}) *MockExamService_CreateExamRecord_Call {
+	return &MockExamService_CreateExamRecord_Call{Call: _e.mock.On("CreateExamRecord", examId, examAttemptId, durationSeconds, answers, userId)}
+}
+
+func (_c *MockExamService_CreateExamRecord_Call) Run(run func(examId string, examAttemptId string, durationSeconds int32, answers []*pb.ExamAttemptAnswer, userId string)) *MockExamService_CreateExamRecord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(int32), args[3].([]*pb.ExamAttemptAnswer), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_CreateExamRecord_Call) Return(_a0 *pb.CreateExamRecordResponse, _a1 error) *MockExamService_CreateExamRecord_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_CreateExamRecord_Call) RunAndReturn(run func(string, string, int32, []*pb.ExamAttemptAnswer, string) (*pb.CreateExamRecordResponse, error)) *MockExamService_CreateExamRecord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateExamSection provides a mock function with given fields: examId, sectionType, title, passage, audioUrl, order, userId
+func (_m *MockExamService) CreateExamSection(examId string, sectionType string, title string, passage string, audioUrl string, order int32, userId string) (*pb.CreateExamSectionResponse, error) {
+	ret := _m.Called(examId, sectionType, title, passage, audioUrl, order, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExamSection")
+	}
+
+	var r0 *pb.CreateExamSectionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, string, int32, string) (*pb.CreateExamSectionResponse, error)); ok {
+		return rf(examId, sectionType, title, passage, audioUrl, order, userId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string, string, int32, string) *pb.CreateExamSectionResponse); ok {
+		r0 = rf(examId, sectionType, title, passage, audioUrl, order, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CreateExamSectionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string, string, int32, string) error); ok {
+		r1 = rf(examId, sectionType, title, passage, audioUrl, order, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_CreateExamSection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExamSection'
+type MockExamService_CreateExamSection_Call struct {
+	*mock.Call
+}
+
+// CreateExamSection is a helper method to define mock.On call
+//   - examId string
+//   - sectionType string
+//   - title string
+//   - passage string
+//   - audioUrl string
+//   - order int32
+//   - userId string
+func (_e *MockExamService_Expecter) CreateExamSection(examId interface{}, sectionType interface{}, title interface{}, passage interface{}, audioUrl interface{}, order interface{}, userId interface{}) *MockExamService_CreateExamSection_Call {
+	return &MockExamService_CreateExamSection_Call{Call: _e.mock.On("CreateExamSection", examId, sectionType, title, passage, audioUrl, order, userId)}
+}
+
+func (_c *MockExamService_CreateExamSection_Call) Run(run func(examId string, sectionType string, title string, passage string, audioUrl string, order int32, userId string)) *MockExamService_CreateExamSection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(int32), args[6].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_CreateExamSection_Call) Return(_a0 *pb.CreateExamSectionResponse, _a1 error) *MockExamService_CreateExamSection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_CreateExamSection_Call) RunAndReturn(run func(string, string, string, string, string, int32, string) (*pb.CreateExamSectionResponse, error)) *MockExamService_CreateExamSection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMistakeDrill provides a mock function with given fields: userId, examId, size
+func (_m *MockExamService) CreateMistakeDrill(userId string, examId string, size int32) (*pb.CreateMistakeDrillResponse, error) {
+	ret := _m.Called(userId, examId, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMistakeDrill")
+	}
+
+	var r0 *pb.CreateMistakeDrillResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int32) (*pb.CreateMistakeDrillResponse, error)); ok {
+		return rf(userId, examId, size)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int32) *pb.CreateMistakeDrillResponse); ok {
+		r0 = rf(userId, examId, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CreateMistakeDrillResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int32) error); ok {
+		r1 = rf(userId, examId, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_CreateMistakeDrill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMistakeDrill'
+type MockExamService_CreateMistakeDrill_Call struct {
+	*mock.Call
+}
+
+// CreateMistakeDrill is a helper method to define mock.On call
+//   - userId string
+//   - examId string
+//   - size int32
+func (_e *MockExamService_Expecter) CreateMistakeDrill(userId interface{}, examId interface{}, size interface{}) *MockExamService_CreateMistakeDrill_Call {
+	return &MockExamService_CreateMistakeDrill_Call{Call: _e.mock.On("CreateMistakeDrill", userId, examId, size)}
+}
+
+func (_c *MockExamService_CreateMistakeDrill_Call) Run(run func(userId string, examId string, size int32)) *MockExamService_CreateMistakeDrill_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *MockExamService_CreateMistakeDrill_Call) Return(_a0 *pb.CreateMistakeDrillResponse, _a1 error) *MockExamService_CreateMistakeDrill_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_CreateMistakeDrill_Call) RunAndReturn(run func(string, string, int32) (*pb.CreateMistakeDrillResponse, error)) *MockExamService_CreateMistakeDrill_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePracticeExam provides a mock function with given fields: topic, description, practiceWords, questionTypes, userId
+func (_m *MockExamService) CreatePracticeExam(topic string, description string, practiceWords []*pb.PracticeWord, questionTypes []string, userId string) (*pb.CreatePracticeExamResponse, error) {
+	ret := _m.Called(topic, description, practiceWords, questionTypes, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePracticeExam")
+	}
+
+	var r0 *pb.CreatePracticeExamResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, []*pb.PracticeWord, []string, string) (*pb.CreatePracticeExamResponse, error)); ok {
+		return rf(topic, description, practiceWords, questionTypes, userId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, []*pb.PracticeWord, []string, string) *pb.CreatePracticeExamResponse); ok {
+		r0 = rf(topic, description, practiceWords, questionTypes, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CreatePracticeExamResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, []*pb.PracticeWord, []string, string) error); ok {
+		r1 = rf(topic, description, practiceWords, questionTypes, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_CreatePracticeExam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePracticeExam'
+type MockExamService_CreatePracticeExam_Call struct {
+	*mock.Call
+}
+
+// CreatePracticeExam is a helper method to define mock.On call
+//   - topic string
+//   - description string
+//   - practiceWords []*pb.PracticeWord
+//   - questionTypes []string
+//   - userId string
+func (_e *MockExamService_Expecter) CreatePracticeExam(topic interface{}, description interface{}, practiceWords interface{}, questionTypes interface{}, userId interface{}) *MockExamService_CreatePracticeExam_Call {
+	return &MockExamService_CreatePracticeExam_Call{Call: _e.mock.On("CreatePracticeExam", topic, description, practiceWords, questionTypes, userId)}
+}
+
+func (_c *MockExamService_CreatePracticeExam_Call) Run(run func(topic string, description string, practiceWords []*pb.PracticeWord, questionTypes []string, userId string)) *MockExamService_CreatePracticeExam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].([]*pb.PracticeWord), args[3].([]string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_CreatePracticeExam_Call) Return(_a0 *pb.CreatePracticeExamResponse, _a1 error) *MockExamService_CreatePracticeExam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_CreatePracticeExam_Call) RunAndReturn(run func(string, string, []*pb.PracticeWord, []string, string) (*pb.CreatePracticeExamResponse, error)) *MockExamService_CreatePracticeExam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateQuestion provides a mock function with given fields: examId, ask, answers, acceptedAnswers, points, partialCredit, hint, explanation, sectionId, userId
+func (_m *MockExamService) CreateQuestion(examId string, ask string, answers []string, acceptedAnswers []*pb.AcceptedAnswers, points int32, partialCredit bool, hint string, explanation string, sectionId string, userId string) (*pb.CreateQuestionResponse, error) {
+	ret := _m.Called(examId, ask, answers, acceptedAnswers, points, partialCredit, hint, explanation, sectionId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateQuestion")
+	}
+
+	var r0 *pb.CreateQuestionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, []string, []*pb.AcceptedAnswers, int32, bool, string, string, string, string) (*pb.CreateQuestionResponse, error)); ok {
+		return rf(examId, ask, answers, acceptedAnswers, points, partialCredit, hint, explanation, sectionId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, []string, []*pb.AcceptedAnswers, int32, bool, string, string, string, string) *pb.CreateQuestionResponse); ok {
+		r0 = rf(examId, ask, answers, acceptedAnswers, points, partialCredit, hint, explanation, sectionId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.CreateQuestionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, []string, []*pb.AcceptedAnswers, int32, bool, string, string, string, string) error); ok {
+		r1 = rf(examId, ask, answers, acceptedAnswers, points, partialCredit, hint, explanation, sectionId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_CreateQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateQuestion'
+type MockExamService_CreateQuestion_Call struct {
+	*mock.Call
+}
+
+// CreateQuestion is a helper method to define mock.On call
+//   - examId string
+//   - ask string
+//   - answers []string
+//   - acceptedAnswers []*pb.AcceptedAnswers
+//   - points int32
+//   - partialCredit bool
+//   - hint string
+//   - explanation string
+//   - sectionId string
+//   - userId string
+func (_e *MockExamService_Expecter) CreateQuestion(examId interface{}, ask interface{}, answers interface{}, acceptedAnswers interface{}, points interface{}, partialCredit interface{}, hint interface{}, explanation interface{}, sectionId interface{}, userId interface{}) *MockExamService_CreateQuestion_Call {
+	return &MockExamService_CreateQuestion_Call{Call: _e.mock.On("CreateQuestion", examId, ask, answers, acceptedAnswers, points, partialCredit, hint, explanation, sectionId, userId)}
+}
+
+func (_c *MockExamService_CreateQuestion_Call) Run(run func(examId string, ask string, answers []string, acceptedAnswers []*pb.AcceptedAnswers, points int32, partialCredit bool, hint string, explanation string, sectionId string, userId string)) *MockExamService_CreateQuestion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].([]string), args[3].([]*pb.AcceptedAnswers), args[4].(int32), args[5].(bool), args[6].(string), args[7].(string), args[8].(string), args[9].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_CreateQuestion_Call) Return(_a0 *pb.CreateQuestionResponse, _a1 error) *MockExamService_CreateQuestion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_CreateQuestion_Call) RunAndReturn(run func(string, string, []string, []*pb.AcceptedAnswers, int32, bool, string, string, string, string) (*pb.CreateQuestionResponse, error)) *MockExamService_CreateQuestion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBankQuestion provides a mock function with given fields: bankQuestionId, userId
+func (_m *MockExamService) DeleteBankQuestion(bankQuestionId string, userId string) (*pb.DeleteBankQuestionResponse, error) {
+	ret := _m.Called(bankQuestionId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBankQuestion")
+	}
+
+	var r0 *pb.DeleteBankQuestionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*pb.DeleteBankQuestionResponse, error)); ok {
+		return rf(bankQuestionId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *pb.DeleteBankQuestionResponse); ok {
+		r0 = rf(bankQuestionId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.DeleteBankQuestionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(bankQuestionId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_DeleteBankQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBankQuestion'
+type MockExamService_DeleteBankQuestion_Call struct {
+	*mock.Call
+}
+
+// DeleteBankQuestion is a helper method to define mock.On call
+//   - bankQuestionId string
+//   - userId string
+func (_e *MockExamService_Expecter) DeleteBankQuestion(bankQuestionId interface{}, userId interface{}) *MockExamService_DeleteBankQuestion_Call {
+	return &MockExamService_DeleteBankQuestion_Call{Call: _e.mock.On("DeleteBankQuestion", bankQuestionId, userId)}
+}
+
+func (_c *MockExamService_DeleteBankQuestion_Call) Run(run func(bankQuestionId string, userId string)) *MockExamService_DeleteBankQuestion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_DeleteBankQuestion_Call) Return(_a0 *pb.DeleteBankQuestionResponse, _a1 error) *MockExamService_DeleteBankQuestion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_DeleteBankQuestion_Call) RunAndReturn(run func(string, string) (*pb.DeleteBankQuestionResponse, error)) *MockExamService_DeleteBankQuestion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExam provides a mock function with given fields: examId, userId
+func (_m *MockExamService) DeleteExam(examId string, userId string) (*pb.DeleteExamResponse, error) {
+	ret := _m.Called(examId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExam")
+	}
+
+	var r0 *pb.DeleteExamResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*pb.DeleteExamResponse, error)); ok {
+		return rf(examId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *pb.DeleteExamResponse); ok {
+		r0 = rf(examId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.DeleteExamResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(examId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_DeleteExam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExam'
+type MockExamService_DeleteExam_Call struct {
+	*mock.Call
+}
+
+// DeleteExam is a helper method to define mock.On call
+//   - examId string
+//   - userId string
+func (_e *MockExamService_Expecter) DeleteExam(examId interface{}, userId interface{}) *MockExamService_DeleteExam_Call {
+	return &MockExamService_DeleteExam_Call{Call: _e.mock.On("DeleteExam", examId, userId)}
+}
+
+func (_c *MockExamService_DeleteExam_Call) Run(run func(examId string, userId string)) *MockExamService_DeleteExam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_DeleteExam_Call) Return(_a0 *pb.DeleteExamResponse, _a1 error) *MockExamService_DeleteExam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_DeleteExam_Call) RunAndReturn(run func(string, string) (*pb.DeleteExamResponse, error)) *MockExamService_DeleteExam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExamSection provides a mock function with given fields: sectionId, userId
+func (_m *MockExamService) DeleteExamSection(sectionId string, userId string) (*pb.DeleteExamSectionResponse, error) {
+	ret := _m.Called(sectionId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExamSection")
+	}
+
+	var r0 *pb.DeleteExamSectionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*pb.DeleteExamSectionResponse, error)); ok {
+		return rf(sectionId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *pb.DeleteExamSectionResponse); ok {
+		r0 = rf(sectionId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.DeleteExamSectionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(sectionId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_DeleteExamSection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExamSection'
+type MockExamService_DeleteExamSection_Call struct {
+	*mock.Call
+}
+
+// DeleteExamSection is a helper method to define mock.On call
+//   - sectionId string
+//   - userId string
+func (_e *MockExamService_Expecter) DeleteExamSection(sectionId interface{}, userId interface{}) *MockExamService_DeleteExamSection_Call {
+	return &MockExamService_DeleteExamSection_Call{Call: _e.mock.On("DeleteExamSection", sectionId, userId)}
+}
+
+func (_c *MockExamService_DeleteExamSection_Call) Run(run func(sectionId string, userId string)) *MockExamService_DeleteExamSection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_DeleteExamSection_Call) Return(_a0 *pb.DeleteExamSectionResponse, _a1 error) *MockExamService_DeleteExamSection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_DeleteExamSection_Call) RunAndReturn(run func(string, string) (*pb.DeleteExamSectionResponse, error)) *MockExamService_DeleteExamSection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteQuestion provides a mock function with given fields: questionId, userId
+func (_m *MockExamService) DeleteQuestion(questionId string, userId string) (*pb.DeleteQuestionResponse, error) {
+	ret := _m.Called(questionId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteQuestion")
+	}
+
+	var r0 *pb.DeleteQuestionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*pb.DeleteQuestionResponse, error)); ok {
+		return rf(questionId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *pb.DeleteQuestionResponse); ok {
+		r0 = rf(questionId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.DeleteQuestionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(questionId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_DeleteQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQuestion'
+type MockExamService_DeleteQuestion_Call struct {
+	*mock.Call
+}
+
+// DeleteQuestion is a helper method to define mock.On call
+//   - questionId string
+//   - userId string
+func (_e *MockExamService_Expecter) DeleteQuestion(questionId interface{}, userId interface{}) *MockExamService_DeleteQuestion_Call {
+	return &MockExamService_DeleteQuestion_Call{Call: _e.mock.On("DeleteQuestion", questionId, userId)}
+}
+
+func (_c *MockExamService_DeleteQuestion_Call) Run(run func(questionId string, userId string)) *MockExamService_DeleteQuestion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_DeleteQuestion_Call) Return(_a0 *pb.DeleteQuestionResponse, _a1 error) *MockExamService_DeleteQuestion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_DeleteQuestion_Call) RunAndReturn(run func(string, string) (*pb.DeleteQuestionResponse, error)) *MockExamService_DeleteQuestion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Disconnect provides a mock function with given fields:
+func (_m *MockExamService) Disconnect() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Disconnect")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockExamService_Disconnect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Disconnect'
+type MockExamService_Disconnect_Call struct {
+	*mock.Call
+}
+
+// Disconnect is a helper method to define mock.On call
+func (_e *MockExamService_Expecter) Disconnect() *MockExamService_Disconnect_Call {
+	return &MockExamService_Disconnect_Call{Call: _e.mock.On("Disconnect")}
+}
+
+func (_c *MockExamService_Disconnect_Call) Run(run func()) *MockExamService_Disconnect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockExamService_Disconnect_Call) Return(_a0 error) *MockExamService_Disconnect_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockExamService_Disconnect_Call) RunAndReturn(run func() error) *MockExamService_Disconnect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportExam provides a mock function with given fields: examId, userId, format
+func (_m *MockExamService) ExportExam(examId string, userId string, format string) (pb.ExamService_ExportExamClient, error) {
+	ret := _m.Called(examId, userId, format)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportExam")
+	}
+
+	var r0 pb.ExamService_ExportExamClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (pb.ExamService_ExportExamClient, error)); ok {
+		return rf(examId, userId, format)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) pb.ExamService_ExportExamClient); ok {
+		r0 = rf(examId, userId, format)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pb.ExamService_ExportExamClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(examId, userId, format)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_ExportExam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportExam'
+type MockExamService_ExportExam_Call struct {
+	*mock.Call
+}
+
+// ExportExam is a helper method to define mock.On call
+//   - examId string
+//   - userId string
+//   - format string
+func (_e *MockExamService_Expecter) ExportExam(examId interface{}, userId interface{}, format interface{}) *MockExamService_ExportExam_Call {
+	return &MockExamService_ExportExam_Call{Call: _e.mock.On("ExportExam", examId, userId, format)}
+}
+
+func (_c *MockExamService_ExportExam_Call) Run(run func(examId string, userId string, format string)) *MockExamService_ExportExam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_ExportExam_Call) Return(_a0 pb.ExamService_ExportExamClient, _a1 error) *MockExamService_ExportExam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_ExportExam_Call) RunAndReturn(run func(string, string, string) (pb.ExamService_ExportExamClient, error)) *MockExamService_ExportExam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAssignments provides a mock function with given fields: classId, userId
+func (_m *MockExamService) FindAssignments(classId string, userId string) (*pb.FindAssignmentsResponse, error) {
+	ret := _m.Called(classId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAssignments")
+	}
+
+	var r0 *pb.FindAssignmentsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*pb.FindAssignmentsResponse, error)); ok {
+		return rf(classId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *pb.FindAssignmentsResponse); ok {
+		r0 = rf(classId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FindAssignmentsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(classId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_FindAssignments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAssignments'
+type MockExamService_FindAssignments_Call struct {
+	*mock.Call
+}
+
+// FindAssignments is a helper method to define mock.On call
+//   - classId string
+//   - userId string
+func (_e *MockExamService_Expecter) FindAssignments(classId interface{}, userId interface{}) *MockExamService_FindAssignments_Call {
+	return &MockExamService_FindAssignments_Call{Call: _e.mock.On("FindAssignments", classId, userId)}
+}
+
+func (_c *MockExamService_FindAssignments_Call) Run(run func(classId string, userId string)) *MockExamService_FindAssignments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_FindAssignments_Call) Return(_a0 *pb.FindAssignmentsResponse, _a1 error) *MockExamService_FindAssignments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_FindAssignments_Call) RunAndReturn(run func(string, string) (*pb.FindAssignmentsResponse, error)) *MockExamService_FindAssignments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBankQuestions provides a mock function with given fields: pageIndex, pageSize, tag, userId
+func (_m *MockExamService) FindBankQuestions(pageIndex int32, pageSize int32, tag string, userId string) (*pb.FindBankQuestionsResponse, error) {
+	ret := _m.Called(pageIndex, pageSize, tag, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBankQuestions")
+	}
+
+	var r0 *pb.FindBankQuestionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int32, int32, string, string) (*pb.FindBankQuestionsResponse, error)); ok {
+		return rf(pageIndex, pageSize, tag, userId)
+	}
+	if rf, ok := ret.Get(0).(func(int32, int32, string, string) *pb.FindBankQuestionsResponse); ok {
+		r0 = rf(pageIndex, pageSize, tag, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FindBankQuestionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int32, int32, string, string) error); ok {
+		r1 = rf(pageIndex, pageSize, tag, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_FindBankQuestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBankQuestions'
+type MockExamService_FindBankQuestions_Call struct {
+	*mock.Call
+}
+
+// FindBankQuestions is a helper method to define mock.On call
+//   - pageIndex int32
+//   - pageSize int32
+//   - tag string
+//   - userId string
+func (_e *MockExamService_Expecter) FindBankQuestions(pageIndex interface{}, pageSize interface{}, tag interface{}, userId interface{}) *MockExamService_FindBankQuestions_Call {
+	return &MockExamService_FindBankQuestions_Call{Call: _e.mock.On("FindBankQuestions", pageIndex, pageSize, tag, userId)}
+}
+
+func (_c *MockExamService_FindBankQuestions_Call) Run(run func(pageIndex int32, pageSize int32, tag string, userId string)) *MockExamService_FindBankQuestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int32), args[1].(int32), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_FindBankQuestions_Call) Return(_a0 *pb.FindBankQuestionsResponse, _a1 error) *MockExamService_FindBankQuestions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_FindBankQuestions_Call) RunAndReturn(run func(int32, int32, string, string) (*pb.FindBankQuestionsResponse, error)) *MockExamService_FindBankQuestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindClassGradebook provides a mock function with given fields: classId, userId
+func (_m *MockExamService) FindClassGradebook(classId string, userId string) (*pb.FindClassGradebookResponse, error) {
+	ret := _m.Called(classId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindClassGradebook")
+	}
+
+	var r0 *pb.FindClassGradebookResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*pb.FindClassGradebookResponse, error)); ok {
+		return rf(classId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *pb.FindClassGradebookResponse); ok {
+		r0 = rf(classId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FindClassGradebookResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(classId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_FindClassGradebook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindClassGradebook'
+type MockExamService_FindClassGradebook_Call struct {
+	*mock.Call
+}
+
+// FindClassGradebook is a helper method to define mock.On call
+//   - classId string
+//   - userId string
+func (_e *MockExamService_Expecter) FindClassGradebook(classId interface{}, userId interface{}) *MockExamService_FindClassGradebook_Call {
+	return &MockExamService_FindClassGradebook_Call{Call: _e.mock.On("FindClassGradebook", classId, userId)}
+}
+
+func (_c *MockExamService_FindClassGradebook_Call) Run(run func(classId string, userId string)) *MockExamService_FindClassGradebook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_FindClassGradebook_Call) Return(_a0 *pb.FindClassGradebookResponse, _a1 error) *MockExamService_FindClassGradebook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_FindClassGradebook_Call) RunAndReturn(run func(string, string) (*pb.FindClassGradebookResponse, error)) *MockExamService_FindClassGradebook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindClasses provides a mock function with given fields: userId
+func (_m *MockExamService) FindClasses(userId string) (*pb.FindClassesResponse, error) {
+	ret := _m.Called(userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindClasses")
+	}
+
+	var r0 *pb.FindClassesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*pb.FindClassesResponse, error)); ok {
+		return rf(userId)
+	}
+	if rf, ok := ret.Get(0).(func(string) *pb.FindClassesResponse); ok {
+		r0 = rf(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FindClassesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_FindClasses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindClasses'
+type MockExamService_FindClasses_Call struct {
+	*mock.Call
+}
+
+// FindClasses is a helper method to define mock.On call
+//   - userId string
+func (_e *MockExamService_Expecter) FindClasses(userId interface{}) *MockExamService_FindClasses_Call {
+	return &MockExamService_FindClasses_Call{Call: _e.mock.On("FindClasses", userId)}
+}
+
+func (_c *MockExamService_FindClasses_Call) Run(run func(userId string)) *MockExamService_FindClasses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_FindClasses_Call) Return(_a0 *pb.FindClassesResponse, _a1 error) *MockExamService_FindClasses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_FindClasses_Call) RunAndReturn(run func(string) (*pb.FindClassesResponse, error)) *MockExamService_FindClasses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExamCatalog provides a mock function with given fields: keyword, tags, sortBy, pageIndex, pageSize
+func (_m *MockExamService) FindExamCatalog(keyword string, tags []string, sortBy string, pageIndex int32, pageSize int32) (*pb.FindExamCatalogResponse, error) {
+	ret := _m.Called(keyword, tags, sortBy, pageIndex, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExamCatalog")
+	}
+
+	var r0 *pb.FindExamCatalogResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []string, string, int32, int32) (*pb.FindExamCatalogResponse, error)); ok {
+		return rf(keyword, tags, sortBy, pageIndex, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(string, []string, string, int32, int32) *pb.FindExamCatalogResponse); ok {
+		r0 = rf(keyword, tags, sortBy, pageIndex, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FindExamCatalogResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []string, string, int32, int32) error); ok {
+		r1 = rf(keyword, tags, sortBy, pageIndex, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_FindExamCatalog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamCatalog'
+type MockExamService_FindExamCatalog_Call struct {
+	*mock.Call
+}
+
+// FindExamCatalog is a helper method to define mock.On call
+//   - keyword string
+//   - tags []string
+//   - sortBy string
+//   - pageIndex int32
+//   - pageSize int32
+func (_e *MockExamService_Expecter) FindExamCatalog(keyword interface{}, tags interface{}, sortBy interface{}, pageIndex interface{}, pageSize interface{}) *MockExamService_FindExamCatalog_Call {
+	return &MockExamService_FindExamCatalog_Call{Call: _e.mock.On("FindExamCatalog", keyword, tags, sortBy, pageIndex, pageSize)}
+}
+
+func (_c *MockExamService_FindExamCatalog_Call) Run(run func(keyword string, tags []string, sortBy string, pageIndex int32, pageSize int32)) *MockExamService_FindExamCatalog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]string), args[2].(string), args[3].(int32), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *MockExamService_FindExamCatalog_Call) Return(_a0 *pb.FindExamCatalogResponse, _a1 error) *MockExamService_FindExamCatalog_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_FindExamCatalog_Call) RunAndReturn(run func(string, []string, string, int32, int32) (*pb.FindExamCatalogResponse, error)) *MockExamService_FindExamCatalog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExamInfos provides a mock function with given fields: userId, isPublic, tags
+func (_m *MockExamService) FindExamInfos(userId string, isPublic bool, tags []string) (*pb.FindExamInfosResponse, error) {
+	ret := _m.Called(userId, isPublic, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExamInfos")
+	}
+
+	var r0 *pb.FindExamInfosResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, bool, []string) (*pb.FindExamInfosResponse, error)); ok {
+		return rf(userId, isPublic, tags)
+	}
+	if rf, ok := ret.Get(0).(func(string, bool, []string) *pb.FindExamInfosResponse); ok {
+		r0 = rf(userId, isPublic, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FindExamInfosResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, bool, []string) error); ok {
+		r1 = rf(userId, isPublic, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_FindExamInfos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamInfos'
+type MockExamService_FindExamInfos_Call struct {
+	*mock.Call
+}
+
+// FindExamInfos is a helper method to define mock.On call
+//   - userId string
+//   - isPublic bool
+//   - tags []string
+func (_e *MockExamService_Expecter) FindExamInfos(userId interface{}, isPublic interface{}, tags interface{}) *MockExamService_FindExamInfos_Call {
+	return &MockExamService_FindExamInfos_Call{Call: _e.mock.On("FindExamInfos", userId, isPublic, tags)}
+}
+
+func (_c *MockExamService_FindExamInfos_Call) Run(run func(userId string, isPublic bool, tags []string)) *MockExamService_FindExamInfos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(bool), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_FindExamInfos_Call) Return(_a0 *pb.FindExamInfosResponse, _a1 error) *MockExamService_FindExamInfos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_FindExamInfos_Call) RunAndReturn(run func(string, bool, []string) (*pb.FindExamInfosResponse, error)) *MockExamService_FindExamInfos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExamLeaderboard provides a mock function with given fields: examId, window, size, excludedUserIds
+func (_m *MockExamService) FindExamLeaderboard(examId string, window string, size int32, excludedUserIds []string) (*pb.FindExamLeaderboardResponse, error) {
+	ret := _m.Called(examId, window, size, excludedUserIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExamLeaderboard")
+	}
+
+	var r0 *pb.FindExamLeaderboardResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int32, []string) (*pb.FindExamLeaderboardResponse, error)); ok {
+		return rf(examId, window, size, excludedUserIds)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int32, []string) *pb.FindExamLeaderboardResponse); ok {
+		r0 = rf(examId, window, size, excludedUserIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FindExamLeaderboardResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int32, []string) error); ok {
+		r1 = rf(examId, window, size, excludedUserIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_FindExamLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamLeaderboard'
+type MockExamService_FindExamLeaderboard_Call struct {
+	*mock.Call
+}
+
+// FindExamLeaderboard is a helper method to define mock.On call
+//   - examId string
+//   - window string
+//   - size int32
+//   - excludedUserIds []string
+func (_e *MockExamService_Expecter) FindExamLeaderboard(examId interface{}, window interface{}, size interface{}, excludedUserIds interface{}) *MockExamService_FindExamLeaderboard_Call {
+	return &MockExamService_FindExamLeaderboard_Call{Call: _e.mock.On("FindExamLeaderboard", examId, window, size, excludedUserIds)}
+}
+
+func (_c *MockExamService_FindExamLeaderboard_Call) Run(run func(examId string, window string, size int32, excludedUserIds []string)) *MockExamService_FindExamLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(int32), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_FindExamLeaderboard_Call) Return(_a0 *pb.FindExamLeaderboardResponse, _a1 error) *MockExamService_FindExamLeaderboard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_FindExamLeaderboard_Call) RunAndReturn(run func(string, string, int32, []string) (*pb.FindExamLeaderboardResponse, error)) *MockExamService_FindExamLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExamRecordOverview provides a mock function with given fields: examId, userId, startDate, endDate, examVersion, dateRange, timezone, movingAverageWindow
+func (_m *MockExamService) FindExamRecordOverview(examId string, userId string, startDate time.Time, endDate time.Time, examVersion int32, dateRange string, timezone string, movingAverageWindow int32) (*pb.FindExamRecordOverviewResponse, error) {
+	ret := _m.Called(examId, userId, startDate, endDate, examVersion, dateRange, timezone, movingAverageWindow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExamRecordOverview")
+	}
+
+	var r0 *pb.FindExamRecordOverviewResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, time.Time, time.Time, int32, string, string, int32) (*pb.FindExamRecordOverviewResponse, error)); ok {
+		return rf(examId, userId, startDate, endDate, examVersion, dateRange, timezone, movingAverageWindow)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, time.Time, time.Time, int32, string, string, int32) *pb.FindExamRecordOverviewResponse); ok {
+		r0 = rf(examId, userId, startDate, endDate, examVersion, dateRange, timezone, movingAverageWindow)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FindExamRecordOverviewResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, time.Time, time.Time, int32, string, string, int32) error); ok {
+		r1 = rf(examId, userId, startDate, endDate, examVersion, dateRange, timezone, movingAverageWindow)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_FindExamRecordOverview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamRecordOverview'
+type MockExamService_FindExamRecordOverview_Call struct {
+	*mock.Call
+}
+
+// FindExamRecordOverview is a helper method to define mock.On call
+//   - examId string
+//   - userId string
+//   - startDate time.Time
+//   - endDate time.Time
+//   - examVersion int32
+//   - dateRange string
+//   - timezone string
+//   - movingAverageWindow int32
+func (_e *MockExamService_Expecter) FindExamRecordOverview(examId interface{}, userId interface{}, startDate interface{}, endDate interface{}, examVersion interface{}, dateRange interface{}, timezone interface{}, movingAverageWindow interface{}) *MockExamService_FindExamRecordOverview_Call {
+	return &MockExamService_FindExamRecordOverview_Call{Call: _e.mock.On("FindExamRecordOverview", examId, userId, startDate, endDate, examVersion, dateRange, timezone, movingAverageWindow)}
+}
+
+func (_c *MockExamService_FindExamRecordOverview_Call) Run(run func(examId string, userId string, startDate time.Time, endDate time.Time, examVersion int32, dateRange string, timezone string, movingAverageWindow int32)) *MockExamService_FindExamRecordOverview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(time.Time), args[3].(time.Time), args[4].(int32), args[5].(string), args[6].(string), args[7].(int32))
+	})
+	return _c
+}
+
+func (_c *MockExamService_FindExamRecordOverview_Call) Return(_a0 *pb.FindExamRecordOverviewResponse, _a1 error) *MockExamService_FindExamRecordOverview_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_FindExamRecordOverview_Call) RunAndReturn(run func(string, string, time.Time, time.Time, int32, string, string, int32) (*pb.FindExamRecordOverviewResponse, error)) *MockExamService_FindExamRecordOverview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExamRecords provides a mock function with given fields: pageIndex, pageSize, examId, userId, cursor
+func (_m *MockExamService) FindExamRecords(pageIndex int32, pageSize int32, examId string, userId string, cursor string) (*pb.FindExamRecordsResponse, error) {
+	ret := _m.Called(pageIndex, pageSize, examId, userId, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExamRecords")
+	}
+
+	var r0 *pb.FindExamRecordsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int32, int32, string, string, string) (*pb.FindExamRecordsResponse, error)); ok {
+		return rf(pageIndex, pageSize, examId, userId, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(int32, int32, string, string, string) *pb.FindExamRecordsResponse); ok {
+		r0 = rf(pageIndex, pageSize, examId, userId, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FindExamRecordsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int32, int32, string, string, string) error); ok {
+		r1 = rf(pageIndex, pageSize, examId, userId, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_FindExamRecords_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamRecords'
+type MockExamService_FindExamRecords_Call struct {
+	*mock.Call
+}
+
+// FindExamRecords is a helper method to define mock.On call
+//   - pageIndex int32
+//   - pageSize int32
+//   - examId string
+//   - userId string
+//   - cursor string
+func (_e *MockExamService_Expecter) FindExamRecords(pageIndex interface{}, pageSize interface{}, examId interface{}, userId interface{}, cursor interface{}) *MockExamService_FindExamRecords_Call {
+	return &MockExamService_FindExamRecords_Call{Call: _e.mock.On("FindExamRecords", pageIndex, pageSize, examId, userId, cursor)}
+}
+
+func (_c *MockExamService_FindExamRecords_Call) Run(run func(pageIndex int32, pageSize int32, examId string, userId string, cursor string)) *MockExamService_FindExamRecords_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int32), args[1].(int32), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_FindExamRecords_Call) Return(_a0 *pb.FindExamRecordsResponse, _a1 error) *MockExamService_FindExamRecords_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_FindExamRecords_Call) RunAndReturn(run func(int32, int32, string, string, string) (*pb.FindExamRecordsResponse, error)) *MockExamService_FindExamRecords_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExamSections provides a mock function with given fields: examId, userId
+func (_m *MockExamService) FindExamSections(examId string, userId string) (*pb.FindExamSectionsResponse, error) {
+	ret := _m.Called(examId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExamSections")
+	}
+
+	var r0 *pb.FindExamSectionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*pb.FindExamSectionsResponse, error)); ok {
+		return rf(examId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *pb.FindExamSectionsResponse); ok {
+		r0 = rf(examId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FindExamSectionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(examId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_FindExamSections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamSections'
+type MockExamService_FindExamSections_Call struct {
+	*mock.Call
+}
+
+// FindExamSections is a helper method to define mock.On call
+//   - examId string
+//   - userId string
+func (_e *MockExamService_Expecter) FindExamSections(examId interface{}, userId interface{}) *MockExamService_FindExamSections_Call {
+	return &MockExamService_FindExamSections_Call{Call: _e.mock.On("FindExamSections", examId, userId)}
+}
+
+func (_c *MockExamService_FindExamSections_Call) Run(run func(examId string, userId string)) *MockExamService_FindExamSections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_FindExamSections_Call) Return(_a0 *pb.FindExamSectionsResponse, _a1 error) *MockExamService_FindExamSections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_FindExamSections_Call) RunAndReturn(run func(string, string) (*pb.FindExamSectionsResponse, error)) *MockExamService_FindExamSections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExamShares provides a mock function with given fields: examId, userId
+func (_m *MockExamService) FindExamShares(examId string, userId string) (*pb.FindExamSharesResponse, error) {
+	ret := _m.Called(examId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExamShares")
+	}
+
+	var r0 *pb.FindExamSharesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*pb.FindExamSharesResponse, error)); ok {
+		return rf(examId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *pb.FindExamSharesResponse); ok {
+		r0 = rf(examId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FindExamSharesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(examId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_FindExamShares_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamShares'
+type MockExamService_FindExamShares_Call struct {
+	*mock.Call
+}
+
+// FindExamShares is a helper method to define mock.On call
+//   - examId string
+//   - userId string
+func (_e *MockExamService_Expecter) FindExamShares(examId interface{}, userId interface{}) *MockExamService_FindExamShares_Call {
+	return &MockExamService_FindExamShares_Call{Call: _e.mock.On("FindExamShares", examId, userId)}
+}
+
+func (_c *MockExamService_FindExamShares_Call) Run(run func(examId string, userId string)) *MockExamService_FindExamShares_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_FindExamShares_Call) Return(_a0 *pb.FindExamSharesResponse, _a1 error) *MockExamService_FindExamShares_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_FindExamShares_Call) RunAndReturn(run func(string, string) (*pb.FindExamSharesResponse, error)) *MockExamService_FindExamShares_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExamTagCounts provides a mock function with given fields: userId, size
+func (_m *MockExamService) FindExamTagCounts(userId string, size int32) (*pb.FindExamTagCountsResponse, error) {
+	ret := _m.Called(userId, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExamTagCounts")
+	}
+
+	var r0 *pb.FindExamTagCountsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int32) (*pb.FindExamTagCountsResponse, error)); ok {
+		return rf(userId, size)
+	}
+	if rf, ok := ret.Get(0).(func(string, int32) *pb.FindExamTagCountsResponse); ok {
+		r0 = rf(userId, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FindExamTagCountsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int32) error); ok {
+		r1 = rf(userId, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_FindExamTagCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamTagCounts'
+type MockExamService_FindExamTagCounts_Call struct {
+	*mock.Call
+}
+
+// FindExamTagCounts is a helper method to define mock.On call
+//   - userId string
+//   - size int32
+func (_e *MockExamService_Expecter) FindExamTagCounts(userId interface{}, size interface{}) *MockExamService_FindExamTagCounts_Call {
+	return &MockExamService_FindExamTagCounts_Call{Call: _e.mock.On("FindExamTagCounts", userId, size)}
+}
+
+func (_c *MockExamService_FindExamTagCounts_Call) Run(run func(userId string, size int32)) *MockExamService_FindExamTagCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockExamService_FindExamTagCounts_Call) Return(_a0 *pb.FindExamTagCountsResponse, _a1 error) *MockExamService_FindExamTagCounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_FindExamTagCounts_Call) RunAndReturn(run func(string, int32) (*pb.FindExamTagCountsResponse, error)) *MockExamService_FindExamTagCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExams provides a mock function with given fields: pageIndex, pageSize, userId, cursor
+func (_m *MockExamService) FindExams(pageIndex int32, pageSize int32, userId string, cursor string) (*pb.FindExamsResponse, error) {
+	ret := _m.Called(pageIndex, pageSize, userId, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExams")
+	}
+
+	var r0 *pb.FindExamsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int32, int32, string, string) (*pb.FindExamsResponse, error)); ok {
+		return rf(pageIndex, pageSize, userId, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(int32, int32, string, string) *pb.FindExamsResponse); ok {
+		r0 = rf(pageIndex, pageSize, userId, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FindExamsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int32, int32, string, string) error); ok {
+		r1 = rf(pageIndex, pageSize, userId, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_FindExams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExams'
+type MockExamService_FindExams_Call struct {
+	*mock.Call
+}
+
+// FindExams is a helper method to define mock.On call
+//   - pageIndex int32
+//   - pageSize int32
+//   - userId string
+//   - cursor string
+func (_e *MockExamService_Expecter) FindExams(pageIndex interface{}, pageSize interface{}, userId interface{}, cursor interface{}) *MockExamService_FindExams_Call {
+	return &MockExamService_FindExams_Call{Call: _e.mock.On("FindExams", pageIndex, pageSize, userId, cursor)}
+}
+
+func (_c *MockExamService_FindExams_Call) Run(run func(pageIndex int32, pageSize int32, userId string, cursor string)) *MockExamService_FindExams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int32), args[1].(int32), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_FindExams_Call) Return(_a0 *pb.FindExamsResponse, _a1 error) *MockExamService_FindExams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_FindExams_Call) RunAndReturn(run func(int32, int32, string, string) (*pb.FindExamsResponse, error)) *MockExamService_FindExams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindExamsByTags provides a mock function with given fields: tags, userId, pageIndex, pageSize
+func (_m *MockExamService) FindExamsByTags(tags []string, userId string, pageIndex int32, pageSize int32) (*pb.FindExamsByTagsResponse, error) {
+	ret := _m.Called(tags, userId, pageIndex, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExamsByTags")
+	}
+
+	var r0 *pb.FindExamsByTagsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string, string, int32, int32) (*pb.FindExamsByTagsResponse, error)); ok {
+		return rf(tags, userId, pageIndex, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func([]string, string, int32, int32) *pb.FindExamsByTagsResponse); ok {
+		r0 = rf(tags, userId, pageIndex, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FindExamsByTagsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string, string, int32, int32) error); ok {
+		r1 = rf(tags, userId, pageIndex, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_FindExamsByTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamsByTags'
+type MockExamService_FindExamsByTags_Call struct {
+	*mock.Call
+}
+
+// FindExamsByTags is a helper method to define mock.On call
+//   - tags []string
+//   - userId string
+//   - pageIndex int32
+//   - pageSize int32
+func (_e *MockExamService_Expecter) FindExamsByTags(tags interface{}, userId interface{}, pageIndex interface{}, pageSize interface{}) *MockExamService_FindExamsByTags_Call {
+	return &MockExamService_FindExamsByTags_Call{Call: _e.mock.On("FindExamsByTags", tags, userId, pageIndex, pageSize)}
+}
+
+func (_c *MockExamService_FindExamsByTags_Call) Run(run func(tags []string, userId string, pageIndex int32, pageSize int32)) *MockExamService_FindExamsByTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string), args[1].(string), args[2].(int32), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *MockExamService_FindExamsByTags_Call) Return(_a0 *pb.FindExamsByTagsResponse, _a1 error) *MockExamService_FindExamsByTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_FindExamsByTags_Call) RunAndReturn(run func([]string, string, int32, int32) (*pb.FindExamsByTagsResponse, error)) *MockExamService_FindExamsByTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindMistakeNotebook provides a mock function with given fields: userId, examId, startDate, endDate, pageIndex, pageSize
+func (_m *MockExamService) FindMistakeNotebook(userId string, examId string, startDate time.Time, endDate time.Time, pageIndex int32, pageSize int32) (*pb.FindMistakeNotebookResponse, error) {
+	ret := _m.Called(userId, examId, startDate, endDate, pageIndex, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMistakeNotebook")
+	}
+
+	var r0 *pb.FindMistakeNotebookResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, time.Time, time.Time, int32, int32) (*pb.FindMistakeNotebookResponse, error)); ok {
+		return rf(userId, examId, startDate, endDate, pageIndex, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, time.Time, time.Time, int32, int32) *pb.FindMistakeNotebookResponse); ok {
+		r0 = rf(userId, examId, startDate, endDate, pageIndex, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FindMistakeNotebookResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, time.Time, time.Time, int32, int32) error); ok {
+		r1 = rf(userId, examId, startDate, endDate, pageIndex, pageSize)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_FindMistakeNotebook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMistakeNotebook'
+type MockExamService_FindMistakeNotebook_Call struct {
+	*mock.Call
+}
+
+// FindMistakeNotebook is a helper method to define mock.On call
+//   - userId string
+//   - examId string
+//   - startDate time.Time
+//   - endDate time.Time
+//   - pageIndex int32
+//   - pageSize int32
+func (_e *MockExamService_Expecter) FindMistakeNotebook(userId interface{}, examId interface{}, startDate interface{}, endDate interface{}, pageIndex interface{}, pageSize interface{}) *MockExamService_FindMistakeNotebook_Call {
+	return &MockExamService_FindMistakeNotebook_Call{Call: _e.mock.On("FindMistakeNotebook", userId, examId, startDate, endDate, pageIndex, pageSize)}
+}
+
+func (_c *MockExamService_FindMistakeNotebook_Call) Run(run func(userId string, examId string, startDate time.Time, endDate time.Time, pageIndex int32, pageSize int32)) *MockExamService_FindMistakeNotebook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(time.Time), args[3].(time.Time), args[4].(int32), args[5].(int32))
+	})
+	return _c
+}
+
+func (_c *MockExamService_FindMistakeNotebook_Call) Return(_a0 *pb.FindMistakeNotebookResponse, _a1 error) *MockExamService_FindMistakeNotebook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_FindMistakeNotebook_Call) RunAndReturn(run func(string, string, time.Time, time.Time, int32, int32) (*pb.FindMistakeNotebookResponse, error)) *MockExamService_FindMistakeNotebook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindQuestionStatistics provides a mock function with given fields: examId, userId
+func (_m *MockExamService) FindQuestionStatistics(examId string, userId string) (*pb.FindQuestionStatisticsResponse, error) {
+	ret := _m.Called(examId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindQuestionStatistics")
+	}
+
+	var r0 *pb.FindQuestionStatisticsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*pb.FindQuestionStatisticsResponse, error)); ok {
+		return rf(examId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *pb.FindQuestionStatisticsResponse); ok {
+		r0 = rf(examId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FindQuestionStatisticsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(examId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_FindQuestionStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindQuestionStatistics'
+type MockExamService_FindQuestionStatistics_Call struct {
+	*mock.Call
+}
+
+// FindQuestionStatistics is a helper method to define mock.On call
+//   - examId string
+//   - userId string
+func (_e *MockExamService_Expecter) FindQuestionStatistics(examId interface{}, userId interface{}) *MockExamService_FindQuestionStatistics_Call {
+	return &MockExamService_FindQuestionStatistics_Call{Call: _e.mock.On("FindQuestionStatistics", examId, userId)}
+}
+
+func (_c *MockExamService_FindQuestionStatistics_Call) Run(run func(examId string, userId string)) *MockExamService_FindQuestionStatistics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_FindQuestionStatistics_Call) Return(_a0 *pb.FindQuestionStatisticsResponse, _a1 error) *MockExamService_FindQuestionStatistics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_FindQuestionStatistics_Call) RunAndReturn(run func(string, string) (*pb.FindQuestionStatisticsResponse, error)) *MockExamService_FindQuestionStatistics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindQuestions provides a mock function with given fields: pageIndex, pageSize, examId, userId, cursor
+func (_m *MockExamService) FindQuestions(pageIndex int32, pageSize int32, examId string, userId string, cursor string) (*pb.FindQuestionsResponse, error) {
+	ret := _m.Called(pageIndex, pageSize, examId, userId, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindQuestions")
+	}
+
+	var r0 *pb.FindQuestionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int32, int32, string, string, string) (*pb.FindQuestionsResponse, error)); ok {
+		return rf(pageIndex, pageSize, examId, userId, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(int32, int32, string, string, string) *pb.FindQuestionsResponse); ok {
+		r0 = rf(pageIndex, pageSize, examId, userId, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FindQuestionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int32, int32, string, string, string) error); ok {
+		r1 = rf(pageIndex, pageSize, examId, userId, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_FindQuestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindQuestions'
+type MockExamService_FindQuestions_Call struct {
+	*mock.Call
+}
+
+// FindQuestions is a helper method to define mock.On call
+//   - pageIndex int32
+//   - pageSize int32
+//   - examId string
+//   - userId string
+//   - cursor string
+func (_e *MockExamService_Expecter) FindQuestions(pageIndex interface{}, pageSize interface{}, examId interface{}, userId interface{}, cursor interface{}) *MockExamService_FindQuestions_Call {
+	return &MockExamService_FindQuestions_Call{Call: _e.mock.On("FindQuestions", pageIndex, pageSize, examId, userId, cursor)}
+}
+
+func (_c *MockExamService_FindQuestions_Call) Run(run func(pageIndex int32, pageSize int32, examId string, userId string, cursor string)) *MockExamService_FindQuestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int32), args[1].(int32), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_FindQuestions_Call) Return(_a0 *pb.FindQuestionsResponse, _a1 error) *MockExamService_FindQuestions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_FindQuestions_Call) RunAndReturn(run func(int32, int32, string, string, string) (*pb.FindQuestionsResponse, error)) *MockExamService_FindQuestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRandomQuestions provides a mock function with given fields: examId, userId, size
+func (_m *MockExamService) FindRandomQuestions(examId string, userId string, size int32) (*pb.FindRandomQuestionsResponse, error) {
+	ret := _m.Called(examId, userId, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRandomQuestions")
+	}
+
+	var r0 *pb.FindRandomQuestionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int32) (*pb.FindRandomQuestionsResponse, error)); ok {
+		return rf(examId, userId, size)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int32) *pb.FindRandomQuestionsResponse); ok {
+		r0 = rf(examId, userId, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FindRandomQuestionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int32) error); ok {
+		r1 = rf(examId, userId, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_FindRandomQuestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRandomQuestions'
+type MockExamService_FindRandomQuestions_Call struct {
+	*mock.Call
+}
+
+// FindRandomQuestions is a helper method to define mock.On call
+//   - examId string
+//   - userId string
+//   - size int32
+func (_e *MockExamService_Expecter) FindRandomQuestions(examId interface{}, userId interface{}, size interface{}) *MockExamService_FindRandomQuestions_Call {
+	return &MockExamService_FindRandomQuestions_Call{Call: _e.mock.On("FindRandomQuestions", examId, userId, size)}
+}
+
+func (_c *MockExamService_FindRandomQuestions_Call) Run(run func(examId string, userId string, size int32)) *MockExamService_FindRandomQuestions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(string), args[1].(string), args[2].(int32))
 	})
 	return _c
 }
 
-func (_c *MockExamService_Connect_Call) Return(_a0 error) *MockExamService_Connect_Call {
-	_c.Call.Return(_a0)
+func (_c *MockExamService_FindRandomQuestions_Call) Return(_a0 *pb.FindRandomQuestionsResponse, _a1 error) *MockExamService_FindRandomQuestions_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockExamService_Connect_Call) RunAndReturn(run func() error) *MockExamService_Connect_Call {
+func (_c *MockExamService_FindRandomQuestions_Call) RunAndReturn(run func(string, string, int32) (*pb.FindRandomQuestionsResponse, error)) *MockExamService_FindRandomQuestions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateExam provides a mock function with given fields: topic, description, isPublic, userId
-func (_m *MockExamService) CreateExam(topic string, description string, isPublic bool, userId string) (*pb.CreateExamResponse, error) {
-	ret := _m.Called(topic, description, isPublic, userId)
+// ForkExam provides a mock function with given fields: examId, userId
+func (_m *MockExamService) ForkExam(examId string, userId string) (*pb.ForkExamResponse, error) {
+	ret := _m.Called(examId, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateExam")
+		panic("no return value specified for ForkExam")
 	}
 
-	var r0 *pb.CreateExamResponse
+	var r0 *pb.ForkExamResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string, bool, string) (*pb.CreateExamResponse, error)); ok {
-		return rf(topic, description, isPublic, userId)
+	if rf, ok := ret.Get(0).(func(string, string) (*pb.ForkExamResponse, error)); ok {
+		return rf(examId, userId)
 	}
-	if rf, ok := ret.Get(0).(func(string, string, bool, string) *pb.CreateExamResponse); ok {
-		r0 = rf(topic, description, isPublic, userId)
+	if rf, ok := ret.Get(0).(func(string, string) *pb.ForkExamResponse); ok {
+		r0 = rf(examId, userId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pb.CreateExamResponse)
+			r0 = ret.Get(0).(*pb.ForkExamResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string, bool, string) error); ok {
-		r1 = rf(topic, description, isPublic, userId)
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(examId, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -97,60 +2212,58 @@ func (_m *MockExamService) CreateExam(topic string, description string, isPublic
 	return r0, r1
 }
 
-// MockExamService_CreateExam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExam'
-type MockExamService_CreateExam_Call struct {
+// MockExamService_ForkExam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForkExam'
+type MockExamService_ForkExam_Call struct {
 	*mock.Call
 }
 
-// CreateExam is a helper method to define mock.On call
-//   - topic string
-//   - description string
-//   - isPublic bool
+// ForkExam is a helper method to define mock.On call
+//   - examId string
 //   - userId string
-func (_e *MockExamService_Expecter) CreateExam(topic interface{}, description interface{}, isPublic interface{}, userId interface{}) *MockExamService_CreateExam_Call {
-	return &MockExamService_CreateExam_Call{Call: _e.mock.On("CreateExam", topic, description, isPublic, userId)}
+func (_e *MockExamService_Expecter) ForkExam(examId interface{}, userId interface{}) *MockExamService_ForkExam_Call {
+	return &MockExamService_ForkExam_Call{Call: _e.mock.On("ForkExam", examId, userId)}
 }
 
-func (_c *MockExamService_CreateExam_Call) Run(run func(topic string, description string, isPublic bool, userId string)) *MockExamService_CreateExam_Call {
+func (_c *MockExamService_ForkExam_Call) Run(run func(examId string, userId string)) *MockExamService_ForkExam_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string), args[2].(bool), args[3].(string))
+		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockExamService_CreateExam_Call) Return(_a0 *pb.CreateExamResponse, _a1 error) *MockExamService_CreateExam_Call {
+func (_c *MockExamService_ForkExam_Call) Return(_a0 *pb.ForkExamResponse, _a1 error) *MockExamService_ForkExam_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockExamService_CreateExam_Call) RunAndReturn(run func(string, string, bool, string) (*pb.CreateExamResponse, error)) *MockExamService_CreateExam_Call {
+func (_c *MockExamService_ForkExam_Call) RunAndReturn(run func(string, string) (*pb.ForkExamResponse, error)) *MockExamService_ForkExam_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateExamRecord provides a mock function with given fields: examId, score, wrongQuestionIds, userId
-func (_m *MockExamService) CreateExamRecord(examId string, score int32, wrongQuestionIds []string, userId string) (*pb.CreateExamRecordResponse, error) {
-	ret := _m.Called(examId, score, wrongQuestionIds, userId)
+// GetExam provides a mock function with given fields: examId, userId
+func (_m *MockExamService) GetExam(examId string, userId string) (*pb.GetExamResponse, error) {
+	ret := _m.Called(examId, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateExamRecord")
+		panic("no return value specified for GetExam")
 	}
 
-	var r0 *pb.CreateExamRecordResponse
+	var r0 *pb.GetExamResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, int32, []string, string) (*pb.CreateExamRecordResponse, error)); ok {
-		return rf(examId, score, wrongQuestionIds, userId)
+	if rf, ok := ret.Get(0).(func(string, string) (*pb.GetExamResponse, error)); ok {
+		return rf(examId, userId)
 	}
-	if rf, ok := ret.Get(0).(func(string, int32, []string, string) *pb.CreateExamRecordResponse); ok {
-		r0 = rf(examId, score, wrongQuestionIds, userId)
+	if rf, ok := ret.Get(0).(func(string, string) *pb.GetExamResponse); ok {
+		r0 = rf(examId, userId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pb.CreateExamRecordResponse)
+			r0 = ret.Get(0).(*pb.GetExamResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, int32, []string, string) error); ok {
-		r1 = rf(examId, score, wrongQuestionIds, userId)
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(examId, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -158,60 +2271,58 @@ func (_m *MockExamService) CreateExamRecord(examId string, score int32, wrongQue
 	return r0, r1
 }
 
-// MockExamService_CreateExamRecord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExamRecord'
-type MockExamService_CreateExamRecord_Call struct {
+// MockExamService_GetExam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExam'
+type MockExamService_GetExam_Call struct {
 	*mock.Call
 }
 
-// CreateExamRecord is a helper method to define mock.On call
+// GetExam is a helper method to define mock.On call
 //   - examId string
-//   - score int32
-//   - wrongQuestionIds []string
 //   - userId string
-func (_e *MockExamService_Expecter) CreateExamRecord(examId interface{}, score interface{}, wrongQuestionIds interface{}, userId interface{}) *MockExamService_CreateExamRecord_Call {
-	return &MockExamService_CreateExamRecord_Call{Call: _e.mock.On("CreateExamRecord", examId, score, wrongQuestionIds, userId)}
+func (_e *MockExamService_Expecter) GetExam(examId interface{}, userId interface{}) *MockExamService_GetExam_Call {
+	return &MockExamService_GetExam_Call{Call: _e.mock.On("GetExam", examId, userId)}
 }
 
-func (_c *MockExamService_CreateExamRecord_Call) Run(run func(examId string, score int32, wrongQuestionIds []string, userId string)) *MockExamService_CreateExamRecord_Call {
+func (_c *MockExamService_GetExam_Call) Run(run func(examId string, userId string)) *MockExamService_GetExam_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(int32), args[2].([]string), args[3].(string))
+		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockExamService_CreateExamRecord_Call) Return(_a0 *pb.CreateExamRecordResponse, _a1 error) *MockExamService_CreateExamRecord_Call {
+func (_c *MockExamService_GetExam_Call) Return(_a0 *pb.GetExamResponse, _a1 error) *MockExamService_GetExam_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockExamService_CreateExamRecord_Call) RunAndReturn(run func(string, int32, []string, string) (*pb.CreateExamRecordResponse, error)) *MockExamService_CreateExamRecord_Call {
+func (_c *MockExamService_GetExam_Call) RunAndReturn(run func(string, string) (*pb.GetExamResponse, error)) *MockExamService_GetExam_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateQuestion provides a mock function with given fields: examId, ask, answers, userId
-func (_m *MockExamService) CreateQuestion(examId string, ask string, answers []string, userId string) (*pb.CreateQuestionResponse, error) {
-	ret := _m.Called(examId, ask, answers, userId)
+// GetExamRecordDetail provides a mock function with given fields: examId, examRecordId, userId
+func (_m *MockExamService) GetExamRecordDetail(examId string, examRecordId string, userId string) (*pb.GetExamRecordDetailResponse, error) {
+	ret := _m.Called(examId, examRecordId, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateQuestion")
+		panic("no return value specified for GetExamRecordDetail")
 	}
 
-	var r0 *pb.CreateQuestionResponse
+	var r0 *pb.GetExamRecordDetailResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string, []string, string) (*pb.CreateQuestionResponse, error)); ok {
-		return rf(examId, ask, answers, userId)
+	if rf, ok := ret.Get(0).(func(string, string, string) (*pb.GetExamRecordDetailResponse, error)); ok {
+		return rf(examId, examRecordId, userId)
 	}
-	if rf, ok := ret.Get(0).(func(string, string, []string, string) *pb.CreateQuestionResponse); ok {
-		r0 = rf(examId, ask, answers, userId)
+	if rf, ok := ret.Get(0).(func(string, string, string) *pb.GetExamRecordDetailResponse); ok {
+		r0 = rf(examId, examRecordId, userId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pb.CreateQuestionResponse)
+			r0 = ret.Get(0).(*pb.GetExamRecordDetailResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string, []string, string) error); ok {
-		r1 = rf(examId, ask, answers, userId)
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(examId, examRecordId, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -219,60 +2330,59 @@ func (_m *MockExamService) CreateQuestion(examId string, ask string, answers []s
 	return r0, r1
 }
 
-// MockExamService_CreateQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateQuestion'
-type MockExamService_CreateQuestion_Call struct {
+// MockExamService_GetExamRecordDetail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExamRecordDetail'
+type MockExamService_GetExamRecordDetail_Call struct {
 	*mock.Call
 }
 
-// CreateQuestion is a helper method to define mock.On call
+// GetExamRecordDetail is a helper method to define mock.On call
 //   - examId string
-//   - ask string
-//   - answers []string
+//   - examRecordId string
 //   - userId string
-func (_e *MockExamService_Expecter) CreateQuestion(examId interface{}, ask interface{}, answers interface{}, userId interface{}) *MockExamService_CreateQuestion_Call {
-	return &MockExamService_CreateQuestion_Call{Call: _e.mock.On("CreateQuestion", examId, ask, answers, userId)}
+func (_e *MockExamService_Expecter) GetExamRecordDetail(examId interface{}, examRecordId interface{}, userId interface{}) *MockExamService_GetExamRecordDetail_Call {
+	return &MockExamService_GetExamRecordDetail_Call{Call: _e.mock.On("GetExamRecordDetail", examId, examRecordId, userId)}
 }
 
-func (_c *MockExamService_CreateQuestion_Call) Run(run func(examId string, ask string, answers []string, userId string)) *MockExamService_CreateQuestion_Call {
+func (_c *MockExamService_GetExamRecordDetail_Call) Run(run func(examId string, examRecordId string, userId string)) *MockExamService_GetExamRecordDetail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string), args[2].([]string), args[3].(string))
+		run(args[0].(string), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockExamService_CreateQuestion_Call) Return(_a0 *pb.CreateQuestionResponse, _a1 error) *MockExamService_CreateQuestion_Call {
+func (_c *MockExamService_GetExamRecordDetail_Call) Return(_a0 *pb.GetExamRecordDetailResponse, _a1 error) *MockExamService_GetExamRecordDetail_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockExamService_CreateQuestion_Call) RunAndReturn(run func(string, string, []string, string) (*pb.CreateQuestionResponse, error)) *MockExamService_CreateQuestion_Call {
+func (_c *MockExamService_GetExamRecordDetail_Call) RunAndReturn(run func(string, string, string) (*pb.GetExamRecordDetailResponse, error)) *MockExamService_GetExamRecordDetail_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteExam provides a mock function with given fields: examId, userId
-func (_m *MockExamService) DeleteExam(examId string, userId string) (*pb.DeleteExamResponse, error) {
-	ret := _m.Called(examId, userId)
+// GetQuestion provides a mock function with given fields: questionId, userId
+func (_m *MockExamService) GetQuestion(questionId string, userId string) (*pb.GetQuestionResponse, error) {
+	ret := _m.Called(questionId, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteExam")
+		panic("no return value specified for GetQuestion")
 	}
 
-	var r0 *pb.DeleteExamResponse
+	var r0 *pb.GetQuestionResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (*pb.DeleteExamResponse, error)); ok {
-		return rf(examId, userId)
+	if rf, ok := ret.Get(0).(func(string, string) (*pb.GetQuestionResponse, error)); ok {
+		return rf(questionId, userId)
 	}
-	if rf, ok := ret.Get(0).(func(string, string) *pb.DeleteExamResponse); ok {
-		r0 = rf(examId, userId)
+	if rf, ok := ret.Get(0).(func(string, string) *pb.GetQuestionResponse); ok {
+		r0 = rf(questionId, userId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pb.DeleteExamResponse)
+			r0 = ret.Get(0).(*pb.GetQuestionResponse)
 		}
 	}
 
 	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(examId, userId)
+		r1 = rf(questionId, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -280,58 +2390,58 @@ func (_m *MockExamService) DeleteExam(examId string, userId string) (*pb.DeleteE
 	return r0, r1
 }
 
-// MockExamService_DeleteExam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExam'
-type MockExamService_DeleteExam_Call struct {
+// MockExamService_GetQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQuestion'
+type MockExamService_GetQuestion_Call struct {
 	*mock.Call
 }
 
-// DeleteExam is a helper method to define mock.On call
-//   - examId string
+// GetQuestion is a helper method to define mock.On call
+//   - questionId string
 //   - userId string
-func (_e *MockExamService_Expecter) DeleteExam(examId interface{}, userId interface{}) *MockExamService_DeleteExam_Call {
-	return &MockExamService_DeleteExam_Call{Call: _e.mock.On("DeleteExam", examId, userId)}
+func (_e *MockExamService_Expecter) GetQuestion(questionId interface{}, userId interface{}) *MockExamService_GetQuestion_Call {
+	return &MockExamService_GetQuestion_Call{Call: _e.mock.On("GetQuestion", questionId, userId)}
 }
 
-func (_c *MockExamService_DeleteExam_Call) Run(run func(examId string, userId string)) *MockExamService_DeleteExam_Call {
+func (_c *MockExamService_GetQuestion_Call) Run(run func(questionId string, userId string)) *MockExamService_GetQuestion_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockExamService_DeleteExam_Call) Return(_a0 *pb.DeleteExamResponse, _a1 error) *MockExamService_DeleteExam_Call {
+func (_c *MockExamService_GetQuestion_Call) Return(_a0 *pb.GetQuestionResponse, _a1 error) *MockExamService_GetQuestion_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockExamService_DeleteExam_Call) RunAndReturn(run func(string, string) (*pb.DeleteExamResponse, error)) *MockExamService_DeleteExam_Call {
+func (_c *MockExamService_GetQuestion_Call) RunAndReturn(run func(string, string) (*pb.GetQuestionResponse, error)) *MockExamService_GetQuestion_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteQuestion provides a mock function with given fields: questionId, userId
-func (_m *MockExamService) DeleteQuestion(questionId string, userId string) (*pb.DeleteQuestionResponse, error) {
-	ret := _m.Called(questionId, userId)
+// ImportExam provides a mock function with given fields: topic, description, tags, format, content, userId
+func (_m *MockExamService) ImportExam(topic string, description string, tags []string, format string, content []byte, userId string) (*pb.ImportExamResponse, error) {
+	ret := _m.Called(topic, description, tags, format, content, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteQuestion")
+		panic("no return value specified for ImportExam")
 	}
 
-	var r0 *pb.DeleteQuestionResponse
+	var r0 *pb.ImportExamResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (*pb.DeleteQuestionResponse, error)); ok {
-		return rf(questionId, userId)
+	if rf, ok := ret.Get(0).(func(string, string, []string, string, []byte, string) (*pb.ImportExamResponse, error)); ok {
+		return rf(topic, description, tags, format, content, userId)
 	}
-	if rf, ok := ret.Get(0).(func(string, string) *pb.DeleteQuestionResponse); ok {
-		r0 = rf(questionId, userId)
+	if rf, ok := ret.Get(0).(func(string, string, []string, string, []byte, string) *pb.ImportExamResponse); ok {
+		r0 = rf(topic, description, tags, format, content, userId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pb.DeleteQuestionResponse)
+			r0 = ret.Get(0).(*pb.ImportExamResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(questionId, userId)
+	if rf, ok := ret.Get(1).(func(string, string, []string, string, []byte, string) error); ok {
+		r1 = rf(topic, description, tags, format, content, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -339,103 +2449,121 @@ func (_m *MockExamService) DeleteQuestion(questionId string, userId string) (*pb
 	return r0, r1
 }
 
-// MockExamService_DeleteQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQuestion'
-type MockExamService_DeleteQuestion_Call struct {
+// MockExamService_ImportExam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportExam'
+type MockExamService_ImportExam_Call struct {
 	*mock.Call
 }
 
-// DeleteQuestion is a helper method to define mock.On call
-//   - questionId string
+// ImportExam is a helper method to define mock.On call
+//   - topic string
+//   - description string
+//   - tags []string
+//   - format string
+//   - content []byte
 //   - userId string
-func (_e *MockExamService_Expecter) DeleteQuestion(questionId interface{}, userId interface{}) *MockExamService_DeleteQuestion_Call {
-	return &MockExamService_DeleteQuestion_Call{Call: _e.mock.On("DeleteQuestion", questionId, userId)}
+func (_e *MockExamService_Expecter) ImportExam(topic interface{}, description interface{}, tags interface{}, format interface{}, content interface{}, userId interface{}) *MockExamService_ImportExam_Call {
+	return &MockExamService_ImportExam_Call{Call: _e.mock.On("ImportExam", topic, description, tags, format, content, userId)}
 }
 
-func (_c *MockExamService_DeleteQuestion_Call) Run(run func(questionId string, userId string)) *MockExamService_DeleteQuestion_Call {
+func (_c *MockExamService_ImportExam_Call) Run(run func(topic string, description string, tags []string, format string, content []byte, userId string)) *MockExamService_ImportExam_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(string), args[1].(string), args[2].([]string), args[3].(string), args[4].([]byte), args[5].(string))
 	})
 	return _c
 }
 
-func (_c *MockExamService_DeleteQuestion_Call) Return(_a0 *pb.DeleteQuestionResponse, _a1 error) *MockExamService_DeleteQuestion_Call {
+func (_c *MockExamService_ImportExam_Call) Return(_a0 *pb.ImportExamResponse, _a1 error) *MockExamService_ImportExam_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockExamService_DeleteQuestion_Call) RunAndReturn(run func(string, string) (*pb.DeleteQuestionResponse, error)) *MockExamService_DeleteQuestion_Call {
+func (_c *MockExamService_ImportExam_Call) RunAndReturn(run func(string, string, []string, string, []byte, string) (*pb.ImportExamResponse, error)) *MockExamService_ImportExam_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Disconnect provides a mock function with given fields:
-func (_m *MockExamService) Disconnect() error {
-	ret := _m.Called()
+// JoinClass provides a mock function with given fields: joinCode, userId
+func (_m *MockExamService) JoinClass(joinCode string, userId string) (*pb.JoinClassResponse, error) {
+	ret := _m.Called(joinCode, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Disconnect")
+		panic("no return value specified for JoinClass")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	var r0 *pb.JoinClassResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*pb.JoinClassResponse, error)); ok {
+		return rf(joinCode, userId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *pb.JoinClassResponse); ok {
+		r0 = rf(joinCode, userId)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.JoinClassResponse)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(joinCode, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockExamService_Disconnect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Disconnect'
-type MockExamService_Disconnect_Call struct {
+// MockExamService_JoinClass_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JoinClass'
+type MockExamService_JoinClass_Call struct {
 	*mock.Call
 }
 
-// Disconnect is a helper method to define mock.On call
-func (_e *MockExamService_Expecter) Disconnect() *MockExamService_Disconnect_Call {
-	return &MockExamService_Disconnect_Call{Call: _e.mock.On("Disconnect")}
+// JoinClass is a helper method to define mock.On call
+//   - joinCode string
+//   - userId string
+func (_e *MockExamService_Expecter) JoinClass(joinCode interface{}, userId interface{}) *MockExamService_JoinClass_Call {
+	return &MockExamService_JoinClass_Call{Call: _e.mock.On("JoinClass", joinCode, userId)}
 }
 
-func (_c *MockExamService_Disconnect_Call) Run(run func()) *MockExamService_Disconnect_Call {
+func (_c *MockExamService_JoinClass_Call) Run(run func(joinCode string, userId string)) *MockExamService_JoinClass_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockExamService_Disconnect_Call) Return(_a0 error) *MockExamService_Disconnect_Call {
-	_c.Call.Return(_a0)
+func (_c *MockExamService_JoinClass_Call) Return(_a0 *pb.JoinClassResponse, _a1 error) *MockExamService_JoinClass_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockExamService_Disconnect_Call) RunAndReturn(run func() error) *MockExamService_Disconnect_Call {
+func (_c *MockExamService_JoinClass_Call) RunAndReturn(run func(string, string) (*pb.JoinClassResponse, error)) *MockExamService_JoinClass_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindExamInfos provides a mock function with given fields: userId, isPublic
-func (_m *MockExamService) FindExamInfos(userId string, isPublic bool) (*pb.FindExamInfosResponse, error) {
-	ret := _m.Called(userId, isPublic)
+// PublishExam provides a mock function with given fields: examId, userId
+func (_m *MockExamService) PublishExam(examId string, userId string) (*pb.PublishExamResponse, error) {
+	ret := _m.Called(examId, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindExamInfos")
+		panic("no return value specified for PublishExam")
 	}
 
-	var r0 *pb.FindExamInfosResponse
+	var r0 *pb.PublishExamResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, bool) (*pb.FindExamInfosResponse, error)); ok {
-		return rf(userId, isPublic)
+	if rf, ok := ret.Get(0).(func(string, string) (*pb.PublishExamResponse, error)); ok {
+		return rf(examId, userId)
 	}
-	if rf, ok := ret.Get(0).(func(string, bool) *pb.FindExamInfosResponse); ok {
-		r0 = rf(userId, isPublic)
+	if rf, ok := ret.Get(0).(func(string, string) *pb.PublishExamResponse); ok {
+		r0 = rf(examId, userId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pb.FindExamInfosResponse)
+			r0 = ret.Get(0).(*pb.PublishExamResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, bool) error); ok {
-		r1 = rf(userId, isPublic)
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(examId, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -443,58 +2571,58 @@ func (_m *MockExamService) FindExamInfos(userId string, isPublic bool) (*pb.Find
 	return r0, r1
 }
 
-// MockExamService_FindExamInfos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamInfos'
-type MockExamService_FindExamInfos_Call struct {
+// MockExamService_PublishExam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishExam'
+type MockExamService_PublishExam_Call struct {
 	*mock.Call
 }
 
-// FindExamInfos is a helper method to define mock.On call
+// PublishExam is a helper method to define mock.On call
+//   - examId string
 //   - userId string
-//   - isPublic bool
-func (_e *MockExamService_Expecter) FindExamInfos(userId interface{}, isPublic interface{}) *MockExamService_FindExamInfos_Call {
-	return &MockExamService_FindExamInfos_Call{Call: _e.mock.On("FindExamInfos", userId, isPublic)}
+func (_e *MockExamService_Expecter) PublishExam(examId interface{}, userId interface{}) *MockExamService_PublishExam_Call {
+	return &MockExamService_PublishExam_Call{Call: _e.mock.On("PublishExam", examId, userId)}
 }
 
-func (_c *MockExamService_FindExamInfos_Call) Run(run func(userId string, isPublic bool)) *MockExamService_FindExamInfos_Call {
+func (_c *MockExamService_PublishExam_Call) Run(run func(examId string, userId string)) *MockExamService_PublishExam_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(bool))
+		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockExamService_FindExamInfos_Call) Return(_a0 *pb.FindExamInfosResponse, _a1 error) *MockExamService_FindExamInfos_Call {
+func (_c *MockExamService_PublishExam_Call) Return(_a0 *pb.PublishExamResponse, _a1 error) *MockExamService_PublishExam_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockExamService_FindExamInfos_Call) RunAndReturn(run func(string, bool) (*pb.FindExamInfosResponse, error)) *MockExamService_FindExamInfos_Call {
+func (_c *MockExamService_PublishExam_Call) RunAndReturn(run func(string, string) (*pb.PublishExamResponse, error)) *MockExamService_PublishExam_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindExamRecordOverview provides a mock function with given fields: examId, userId, startDate
-func (_m *MockExamService) FindExamRecordOverview(examId string, userId string, startDate time.Time) (*pb.FindExamRecordOverviewResponse, error) {
-	ret := _m.Called(examId, userId, startDate)
+// RevealQuestionHint provides a mock function with given fields: examId, examAttemptId, questionId, userId
+func (_m *MockExamService) RevealQuestionHint(examId string, examAttemptId string, questionId string, userId string) (*pb.RevealQuestionHintResponse, error) {
+	ret := _m.Called(examId, examAttemptId, questionId, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindExamRecordOverview")
+		panic("no return value specified for RevealQuestionHint")
 	}
 
-	var r0 *pb.FindExamRecordOverviewResponse
+	var r0 *pb.RevealQuestionHintResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string, time.Time) (*pb.FindExamRecordOverviewResponse, error)); ok {
-		return rf(examId, userId, startDate)
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (*pb.RevealQuestionHintResponse, error)); ok {
+		return rf(examId, examAttemptId, questionId, userId)
 	}
-	if rf, ok := ret.Get(0).(func(string, string, time.Time) *pb.FindExamRecordOverviewResponse); ok {
-		r0 = rf(examId, userId, startDate)
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *pb.RevealQuestionHintResponse); ok {
+		r0 = rf(examId, examAttemptId, questionId, userId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pb.FindExamRecordOverviewResponse)
+			r0 = ret.Get(0).(*pb.RevealQuestionHintResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string, time.Time) error); ok {
-		r1 = rf(examId, userId, startDate)
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(examId, examAttemptId, questionId, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -502,59 +2630,60 @@ func (_m *MockExamService) FindExamRecordOverview(examId string, userId string,
 	return r0, r1
 }
 
-// MockExamService_FindExamRecordOverview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamRecordOverview'
-type MockExamService_FindExamRecordOverview_Call struct {
+// MockExamService_RevealQuestionHint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevealQuestionHint'
+type MockExamService_RevealQuestionHint_Call struct {
 	*mock.Call
 }
 
-// FindExamRecordOverview is a helper method to define mock.On call
+// RevealQuestionHint is a helper method to define mock.On call
 //   - examId string
+//   - examAttemptId string
+//   - questionId string
 //   - userId string
-//   - startDate time.Time
-func (_e *MockExamService_Expecter) FindExamRecordOverview(examId interface{}, userId interface{}, startDate interface{}) *MockExamService_FindExamRecordOverview_Call {
-	return &MockExamService_FindExamRecordOverview_Call{Call: _e.mock.On("FindExamRecordOverview", examId, userId, startDate)}
+func (_e *MockExamService_Expecter) RevealQuestionHint(examId interface{}, examAttemptId interface{}, questionId interface{}, userId interface{}) *MockExamService_RevealQuestionHint_Call {
+	return &MockExamService_RevealQuestionHint_Call{Call: _e.mock.On("RevealQuestionHint", examId, examAttemptId, questionId, userId)}
 }
 
-func (_c *MockExamService_FindExamRecordOverview_Call) Run(run func(examId string, userId string, startDate time.Time)) *MockExamService_FindExamRecordOverview_Call {
+func (_c *MockExamService_RevealQuestionHint_Call) Run(run func(examId string, examAttemptId string, questionId string, userId string)) *MockExamService_RevealQuestionHint_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string), args[2].(time.Time))
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *MockExamService_FindExamRecordOverview_Call) Return(_a0 *pb.FindExamRecordOverviewResponse, _a1 error) *MockExamService_FindExamRecordOverview_Call {
+func (_c *MockExamService_RevealQuestionHint_Call) Return(_a0 *pb.RevealQuestionHintResponse, _a1 error) *MockExamService_RevealQuestionHint_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockExamService_FindExamRecordOverview_Call) RunAndReturn(run func(string, string, time.Time) (*pb.FindExamRecordOverviewResponse, error)) *MockExamService_FindExamRecordOverview_Call {
+func (_c *MockExamService_RevealQuestionHint_Call) RunAndReturn(run func(string, string, string, string) (*pb.RevealQuestionHintResponse, error)) *MockExamService_RevealQuestionHint_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindExamRecords provides a mock function with given fields: pageIndex, pageSize, examId, userId
-func (_m *MockExamService) FindExamRecords(pageIndex int32, pageSize int32, examId string, userId string) (*pb.FindExamRecordsResponse, error) {
-	ret := _m.Called(pageIndex, pageSize, examId, userId)
+// SetExamBankSource provides a mock function with given fields: examId, questionIds, pools, userId
+func (_m *MockExamService) SetExamBankSource(examId string, questionIds []string, pools []*pb.ExamBankPool, userId string) (*pb.SetExamBankSourceResponse, error) {
+	ret := _m.Called(examId, questionIds, pools, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindExamRecords")
+		panic("no return value specified for SetExamBankSource")
 	}
 
-	var r0 *pb.FindExamRecordsResponse
+	var r0 *pb.SetExamBankSourceResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int32, int32, string, string) (*pb.FindExamRecordsResponse, error)); ok {
-		return rf(pageIndex, pageSize, examId, userId)
+	if rf, ok := ret.Get(0).(func(string, []string, []*pb.ExamBankPool, string) (*pb.SetExamBankSourceResponse, error)); ok {
+		return rf(examId, questionIds, pools, userId)
 	}
-	if rf, ok := ret.Get(0).(func(int32, int32, string, string) *pb.FindExamRecordsResponse); ok {
-		r0 = rf(pageIndex, pageSize, examId, userId)
+	if rf, ok := ret.Get(0).(func(string, []string, []*pb.ExamBankPool, string) *pb.SetExamBankSourceResponse); ok {
+		r0 = rf(examId, questionIds, pools, userId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pb.FindExamRecordsResponse)
+			r0 = ret.Get(0).(*pb.SetExamBankSourceResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int32, int32, string, string) error); ok {
-		r1 = rf(pageIndex, pageSize, examId, userId)
+	if rf, ok := ret.Get(1).(func(string, []string, []*pb.ExamBankPool, string) error); ok {
+		r1 = rf(examId, questionIds, pools, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -562,60 +2691,60 @@ func (_m *MockExamService) FindExamRecords(pageIndex int32, pageSize int32, exam
 	return r0, r1
 }
 
-// MockExamService_FindExamRecords_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExamRecords'
-type MockExamService_FindExamRecords_Call struct {
+// MockExamService_SetExamBankSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetExamBankSource'
+type MockExamService_SetExamBankSource_Call struct {
 	*mock.Call
 }
 
-// FindExamRecords is a helper method to define mock.On call
-//   - pageIndex int32
-//   - pageSize int32
+// SetExamBankSource is a helper method to define mock.On call
 //   - examId string
+//   - questionIds []string
+//   - pools []*pb.ExamBankPool
 //   - userId string
-func (_e *MockExamService_Expecter) FindExamRecords(pageIndex interface{}, pageSize interface{}, examId interface{}, userId interface{}) *MockExamService_FindExamRecords_Call {
-	return &MockExamService_FindExamRecords_Call{Call: _e.mock.On("FindExamRecords", pageIndex, pageSize, examId, userId)}
+func (_e *MockExamService_Expecter) SetExamBankSource(examId interface{}, questionIds interface{}, pools interface{}, userId interface{}) *MockExamService_SetExamBankSource_Call {
+	return &MockExamService_SetExamBankSource_Call{Call: _e.mock.On("SetExamBankSource", examId, questionIds, pools, userId)}
 }
 
-func (_c *MockExamService_FindExamRecords_Call) Run(run func(pageIndex int32, pageSize int32, examId string, userId string)) *MockExamService_FindExamRecords_Call {
+func (_c *MockExamService_SetExamBankSource_Call) Run(run func(examId string, questionIds []string, pools []*pb.ExamBankPool, userId string)) *MockExamService_SetExamBankSource_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int32), args[1].(int32), args[2].(string), args[3].(string))
+		run(args[0].(string), args[1].([]string), args[2].([]*pb.ExamBankPool), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *MockExamService_FindExamRecords_Call) Return(_a0 *pb.FindExamRecordsResponse, _a1 error) *MockExamService_FindExamRecords_Call {
+func (_c *MockExamService_SetExamBankSource_Call) Return(_a0 *pb.SetExamBankSourceResponse, _a1 error) *MockExamService_SetExamBankSource_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockExamService_FindExamRecords_Call) RunAndReturn(run func(int32, int32, string, string) (*pb.FindExamRecordsResponse, error)) *MockExamService_FindExamRecords_Call {
+func (_c *MockExamService_SetExamBankSource_Call) RunAndReturn(run func(string, []string, []*pb.ExamBankPool, string) (*pb.SetExamBankSourceResponse, error)) *MockExamService_SetExamBankSource_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindExams provides a mock function with given fields: pageIndex, pageSize, userId
-func (_m *MockExamService) FindExams(pageIndex int32, pageSize int32, userId string) (*pb.FindExamsResponse, error) {
-	ret := _m.Called(pageIndex, pageSize, userId)
+// ShareExam provides a mock function with given fields: examId, sharedUserId, role, userId
+func (_m *MockExamService) ShareExam(examId string, sharedUserId string, role string, userId string) (*pb.ShareExamResponse, error) {
+	ret := _m.Called(examId, sharedUserId, role, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindExams")
+		panic("no return value specified for ShareExam")
 	}
 
-	var r0 *pb.FindExamsResponse
+	var r0 *pb.ShareExamResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int32, int32, string) (*pb.FindExamsResponse, error)); ok {
-		return rf(pageIndex, pageSize, userId)
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (*pb.ShareExamResponse, error)); ok {
+		return rf(examId, sharedUserId, role, userId)
 	}
-	if rf, ok := ret.Get(0).(func(int32, int32, string) *pb.FindExamsResponse); ok {
-		r0 = rf(pageIndex, pageSize, userId)
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *pb.ShareExamResponse); ok {
+		r0 = rf(examId, sharedUserId, role, userId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pb.FindExamsResponse)
+			r0 = ret.Get(0).(*pb.ShareExamResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int32, int32, string) error); ok {
-		r1 = rf(pageIndex, pageSize, userId)
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(examId, sharedUserId, role, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -623,59 +2752,60 @@ func (_m *MockExamService) FindExams(pageIndex int32, pageSize int32, userId str
 	return r0, r1
 }
 
-// MockExamService_FindExams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExams'
-type MockExamService_FindExams_Call struct {
+// MockExamService_ShareExam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShareExam'
+type MockExamService_ShareExam_Call struct {
 	*mock.Call
 }
 
-// FindExams is a helper method to define mock.On call
-//   - pageIndex int32
-//   - pageSize int32
+// ShareExam is a helper method to define mock.On call
+//   - examId string
+//   - sharedUserId string
+//   - role string
 //   - userId string
-func (_e *MockExamService_Expecter) FindExams(pageIndex interface{}, pageSize interface{}, userId interface{}) *MockExamService_FindExams_Call {
-	return &MockExamService_FindExams_Call{Call: _e.mock.On("FindExams", pageIndex, pageSize, userId)}
+func (_e *MockExamService_Expecter) ShareExam(examId interface{}, sharedUserId interface{}, role interface{}, userId interface{}) *MockExamService_ShareExam_Call {
+	return &MockExamService_ShareExam_Call{Call: _e.mock.On("ShareExam", examId, sharedUserId, role, userId)}
 }
 
-func (_c *MockExamService_FindExams_Call) Run(run func(pageIndex int32, pageSize int32, userId string)) *MockExamService_FindExams_Call {
+func (_c *MockExamService_ShareExam_Call) Run(run func(examId string, sharedUserId string, role string, userId string)) *MockExamService_ShareExam_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int32), args[1].(int32), args[2].(string))
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *MockExamService_FindExams_Call) Return(_a0 *pb.FindExamsResponse, _a1 error) *MockExamService_FindExams_Call {
+func (_c *MockExamService_ShareExam_Call) Return(_a0 *pb.ShareExamResponse, _a1 error) *MockExamService_ShareExam_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockExamService_FindExams_Call) RunAndReturn(run func(int32, int32, string) (*pb.FindExamsResponse, error)) *MockExamService_FindExams_Call {
+func (_c *MockExamService_ShareExam_Call) RunAndReturn(run func(string, string, string, string) (*pb.ShareExamResponse, error)) *MockExamService_ShareExam_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindQuestions provides a mock function with given fields: pageIndex, pageSize, examId, userId
-func (_m *MockExamService) FindQuestions(pageIndex int32, pageSize int32, examId string, userId string) (*pb.FindQuestionsResponse, error) {
-	ret := _m.Called(pageIndex, pageSize, examId, userId)
+// UnshareExam provides a mock function with given fields: examId, sharedUserId, userId
+func (_m *MockExamService) UnshareExam(examId string, sharedUserId string, userId string) (*pb.UnshareExamResponse, error) {
+	ret := _m.Called(examId, sharedUserId, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindQuestions")
+		panic("no return value specified for UnshareExam")
 	}
 
-	var r0 *pb.FindQuestionsResponse
+	var r0 *pb.UnshareExamResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int32, int32, string, string) (*pb.FindQuestionsResponse, error)); ok {
-		return rf(pageIndex, pageSize, examId, userId)
+	if rf, ok := ret.Get(0).(func(string, string, string) (*pb.UnshareExamResponse, error)); ok {
+		return rf(examId, sharedUserId, userId)
 	}
-	if rf, ok := ret.Get(0).(func(int32, int32, string, string) *pb.FindQuestionsResponse); ok {
-		r0 = rf(pageIndex, pageSize, examId, userId)
+	if rf, ok := ret.Get(0).(func(string, string, string) *pb.UnshareExamResponse); ok {
+		r0 = rf(examId, sharedUserId, userId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pb.FindQuestionsResponse)
+			r0 = ret.Get(0).(*pb.UnshareExamResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int32, int32, string, string) error); ok {
-		r1 = rf(pageIndex, pageSize, examId, userId)
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(examId, sharedUserId, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -683,60 +2813,59 @@ func (_m *MockExamService) FindQuestions(pageIndex int32, pageSize int32, examId
 	return r0, r1
 }
 
-// MockExamService_FindQuestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindQuestions'
-type MockExamService_FindQuestions_Call struct {
+// MockExamService_UnshareExam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnshareExam'
+type MockExamService_UnshareExam_Call struct {
 	*mock.Call
 }
 
-// FindQuestions is a helper method to define mock.On call
-//   - pageIndex int32
-//   - pageSize int32
+// UnshareExam is a helper method to define mock.On call
 //   - examId string
+//   - sharedUserId string
 //   - userId string
-func (_e *MockExamService_Expecter) FindQuestions(pageIndex interface{}, pageSize interface{}, examId interface{}, userId interface{}) *MockExamService_FindQuestions_Call {
-	return &MockExamService_FindQuestions_Call{Call: _e.mock.On("FindQuestions", pageIndex, pageSize, examId, userId)}
+func (_e *MockExamService_Expecter) UnshareExam(examId interface{}, sharedUserId interface{}, userId interface{}) *MockExamService_UnshareExam_Call {
+	return &MockExamService_UnshareExam_Call{Call: _e.mock.On("UnshareExam", examId, sharedUserId, userId)}
 }
 
-func (_c *MockExamService_FindQuestions_Call) Run(run func(pageIndex int32, pageSize int32, examId string, userId string)) *MockExamService_FindQuestions_Call {
+func (_c *MockExamService_UnshareExam_Call) Run(run func(examId string, sharedUserId string, userId string)) *MockExamService_UnshareExam_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int32), args[1].(int32), args[2].(string), args[3].(string))
+		run(args[0].(string), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockExamService_FindQuestions_Call) Return(_a0 *pb.FindQuestionsResponse, _a1 error) *MockExamService_FindQuestions_Call {
+func (_c *MockExamService_UnshareExam_Call) Return(_a0 *pb.UnshareExamResponse, _a1 error) *MockExamService_UnshareExam_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockExamService_FindQuestions_Call) RunAndReturn(run func(int32, int32, string, string) (*pb.FindQuestionsResponse, error)) *MockExamService_FindQuestions_Call {
+func (_c *MockExamService_UnshareExam_Call) RunAndReturn(run func(string, string, string) (*pb.UnshareExamResponse, error)) *MockExamService_UnshareExam_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindRandomQuestions provides a mock function with given fields: examId, userId, size
-func (_m *MockExamService) FindRandomQuestions(examId string, userId string, size int32) (*pb.FindRandomQuestionsResponse, error) {
-	ret := _m.Called(examId, userId, size)
+// UpdateBankQuestion provides a mock function with given fields: bankQuestionId, ask, answers, tags, points, partialCredit, hint, explanation, userId
+func (_m *MockExamService) UpdateBankQuestion(bankQuestionId string, ask string, answers []string, tags []string, points int32, partialCredit bool, hint string, explanation string, userId string) (*pb.UpdateBankQuestionResponse, error) {
+	ret := _m.Called(bankQuestionId, ask, answers, tags, points, partialCredit, hint, explanation, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindRandomQuestions")
+		panic("no return value specified for UpdateBankQuestion")
 	}
 
-	var r0 *pb.FindRandomQuestionsResponse
+	var r0 *pb.UpdateBankQuestionResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string, int32) (*pb.FindRandomQuestionsResponse, error)); ok {
-		return rf(examId, userId, size)
+	if rf, ok := ret.Get(0).(func(string, string, []string, []string, int32, bool, string, string, string) (*pb.UpdateBankQuestionResponse, error)); ok {
+		return rf(bankQuestionId, ask, answers, tags, points, partialCredit, hint, explanation, userId)
 	}
-	if rf, ok := ret.Get(0).(func(string, string, int32) *pb.FindRandomQuestionsResponse); ok {
-		r0 = rf(examId, userId, size)
+	if rf, ok := ret.Get(0).(func(string, string, []string, []string, int32, bool, string, string, string) *pb.UpdateBankQuestionResponse); ok {
+		r0 = rf(bankQuestionId, ask, answers, tags, points, partialCredit, hint, explanation, userId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*pb.FindRandomQuestionsResponse)
+			r0 = ret.Get(0).(*pb.UpdateBankQuestionResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string, int32) error); ok {
-		r1 = rf(examId, userId, size)
+	if rf, ok := ret.Get(1).(func(string, string, []string, []string, int32, bool, string, string, string) error); ok {
+		r1 = rf(bankQuestionId, ask, answers, tags, points, partialCredit, hint, explanation, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -744,39 +2873,45 @@ func (_m *MockExamService) FindRandomQuestions(examId string, userId string, siz
 	return r0, r1
 }
 
-// MockExamService_FindRandomQuestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRandomQuestions'
-type MockExamService_FindRandomQuestions_Call struct {
+// MockExamService_UpdateBankQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBankQuestion'
+type MockExamService_UpdateBankQuestion_Call struct {
 	*mock.Call
 }
 
-// FindRandomQuestions is a helper method to define mock.On call
-//   - examId string
+// UpdateBankQuestion is a helper method to define mock.On call
+//   - bankQuestionId string
+//   - ask string
+//   - answers []string
+//   - tags []string
+//   - points int32
+//   - partialCredit bool
+//   - hint string
+//   - explanation string
 //   - userId string
-//   - size int32
-func (_e *MockExamService_Expecter) FindRandomQuestions(examId interface{}, userId interface{}, size interface{}) *MockExamService_FindRandomQuestions_Call {
-	return &MockExamService_FindRandomQuestions_Call{Call: _e.mock.On("FindRandomQuestions", examId, userId, size)}
+func (_e *MockExamService_Expecter) UpdateBankQuestion(bankQuestionId interface{}, ask interface{}, answers interface{}, tags interface{}, points interface{}, partialCredit interface{}, hint interface{}, explanation interface{}, userId interface{}) *MockExamService_UpdateBankQuestion_Call {
+	return &MockExamService_UpdateBankQuestion_Call{Call: _e.mock.On("UpdateBankQuestion", bankQuestionId, ask, answers, tags, points, partialCredit, hint, explanation, userId)}
 }
 
-func (_c *MockExamService_FindRandomQuestions_Call) Run(run func(examId string, userId string, size int32)) *MockExamService_FindRandomQuestions_Call {
+func (_c *MockExamService_UpdateBankQuestion_Call) Run(run func(bankQuestionId string, ask string, answers []string, tags []string, points int32, partialCredit bool, hint string, explanation string, userId string)) *MockExamService_UpdateBankQuestion_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string), args[2].(int32))
+		run(args[0].(string), args[1].(string), args[2].([]string), args[3].([]string), args[4].(int32), args[5].(bool), args[6].(string), args[7].(string), args[8].(string))
 	})
 	return _c
 }
 
-func (_c *MockExamService_FindRandomQuestions_Call) Return(_a0 *pb.FindRandomQuestionsResponse, _a1 error) *MockExamService_FindRandomQuestions_Call {
+func (_c *MockExamService_UpdateBankQuestion_Call) Return(_a0 *pb.UpdateBankQuestionResponse, _a1 error) *MockExamService_UpdateBankQuestion_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockExamService_FindRandomQuestions_Call) RunAndReturn(run func(string, string, int32) (*pb.FindRandomQuestionsResponse, error)) *MockExamService_FindRandomQuestions_Call {
+func (_c *MockExamService_UpdateBankQuestion_Call) RunAndReturn(run func(string, string, []string, []string, int32, bool, string, string, string) (*pb.UpdateBankQuestionResponse, error)) *MockExamService_UpdateBankQuestion_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateExam provides a mock function with given fields: examId, topic, description, isPublic, userId
-func (_m *MockExamService) UpdateExam(examId string, topic string, description string, isPublic bool, userId string) (*pb.UpdateExamResponse, error) {
-	ret := _m.Called(examId, topic, description, isPublic, userId)
+// UpdateExam provides a mock function with given fields: examId, topic, description, tags, isPublic, settings, expectedVersion, userId
+func (_m *MockExamService) UpdateExam(examId string, topic string, description string, tags []string, isPublic bool, settings *pb.ExamSettings, expectedVersion *int32, userId string) (*pb.UpdateExamResponse, error) {
+	ret := _m.Called(examId, topic, description, tags, isPublic, settings, expectedVersion, userId)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateExam")
@@ -784,19 +2919,19 @@ func (_m *MockExamService) UpdateExam(examId string, topic string, description s
 
 	var r0 *pb.UpdateExamResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string, string, bool, string) (*pb.UpdateExamResponse, error)); ok {
-		return rf(examId, topic, description, isPublic, userId)
+	if rf, ok := ret.Get(0).(func(string, string, string, []string, bool, *pb.ExamSettings, *int32, string) (*pb.UpdateExamResponse, error)); ok {
+		return rf(examId, topic, description, tags, isPublic, settings, expectedVersion, userId)
 	}
-	if rf, ok := ret.Get(0).(func(string, string, string, bool, string) *pb.UpdateExamResponse); ok {
-		r0 = rf(examId, topic, description, isPublic, userId)
+	if rf, ok := ret.Get(0).(func(string, string, string, []string, bool, *pb.ExamSettings, *int32, string) *pb.UpdateExamResponse); ok {
+		r0 = rf(examId, topic, description, tags, isPublic, settings, expectedVersion, userId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*pb.UpdateExamResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string, string, bool, string) error); ok {
-		r1 = rf(examId, topic, description, isPublic, userId)
+	if rf, ok := ret.Get(1).(func(string, string, string, []string, bool, *pb.ExamSettings, *int32, string) error); ok {
+		r1 = rf(examId, topic, description, tags, isPublic, settings, expectedVersion, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -813,15 +2948,18 @@ type MockExamService_UpdateExam_Call struct {
 //   - examId string
 //   - topic string
 //   - description string
+//   - tags []string
 //   - isPublic bool
+//   - settings *pb.ExamSettings
+//   - expectedVersion *int32
 //   - userId string
-func (_e *MockExamService_Expecter) UpdateExam(examId interface{}, topic interface{}, description interface{}, isPublic interface{}, userId interface{}) *MockExamService_UpdateExam_Call {
-	return &MockExamService_UpdateExam_Call{Call: _e.mock.On("UpdateExam", examId, topic, description, isPublic, userId)}
+func (_e *MockExamService_Expecter) UpdateExam(examId interface{}, topic interface{}, description interface{}, tags interface{}, isPublic interface{}, settings interface{}, expectedVersion interface{}, userId interface{}) *MockExamService_UpdateExam_Call {
+	return &MockExamService_UpdateExam_Call{Call: _e.mock.On("UpdateExam", examId, topic, description, tags, isPublic, settings, expectedVersion, userId)}
 }
 
-func (_c *MockExamService_UpdateExam_Call) Run(run func(examId string, topic string, description string, isPublic bool, userId string)) *MockExamService_UpdateExam_Call {
+func (_c *MockExamService_UpdateExam_Call) Run(run func(examId string, topic string, description string, tags []string, isPublic bool, settings *pb.ExamSettings, expectedVersion *int32, userId string)) *MockExamService_UpdateExam_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string), args[2].(string), args[3].(bool), args[4].(string))
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].([]string), args[4].(bool), args[5].(*pb.ExamSettings), args[6].(*int32), args[7].(string))
 	})
 	return _c
 }
@@ -831,14 +2969,78 @@ func (_c *MockExamService_UpdateExam_Call) Return(_a0 *pb.UpdateExamResponse, _a
 	return _c
 }
 
-func (_c *MockExamService_UpdateExam_Call) RunAndReturn(run func(string, string, string, bool, string) (*pb.UpdateExamResponse, error)) *MockExamService_UpdateExam_Call {
+func (_c *MockExamService_UpdateExam_Call) RunAndReturn(run func(string, string, string, []string, bool, *pb.ExamSettings, *int32, string) (*pb.UpdateExamResponse, error)) *MockExamService_UpdateExam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateExamSection provides a mock function with given fields: sectionId, sectionType, title, passage, audioUrl, order, userId
+func (_m *MockExamService) UpdateExamSection(sectionId string, sectionType string, title string, passage string, audioUrl string, order int32, userId string) (*pb.UpdateExamSectionResponse, error) {
+	ret := _m.Called(sectionId, sectionType, title, passage, audioUrl, order, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateExamSection")
+	}
+
+	var r0 *pb.UpdateExamSectionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, string, int32, string) (*pb.UpdateExamSectionResponse, error)); ok {
+		return rf(sectionId, sectionType, title, passage, audioUrl, order, userId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string, string, int32, string) *pb.UpdateExamSectionResponse); ok {
+		r0 = rf(sectionId, sectionType, title, passage, audioUrl, order, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.UpdateExamSectionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string, string, int32, string) error); ok {
+		r1 = rf(sectionId, sectionType, title, passage, audioUrl, order, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExamService_UpdateExamSection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateExamSection'
+type MockExamService_UpdateExamSection_Call struct {
+	*mock.Call
+}
+
+// UpdateExamSection is a helper method to define mock.On call
+//   - sectionId string
+//   - sectionType string
+//   - title string
+//   - passage string
+//   - audioUrl string
+//   - order int32
+//   - userId string
+func (_e *MockExamService_Expecter) UpdateExamSection(sectionId interface{}, sectionType interface{}, title interface{}, passage interface{}, audioUrl interface{}, order interface{}, userId interface{}) *MockExamService_UpdateExamSection_Call {
+	return &MockExamService_UpdateExamSection_Call{Call: _e.mock.On("UpdateExamSection", sectionId, sectionType, title, passage, audioUrl, order, userId)}
+}
+
+func (_c *MockExamService_UpdateExamSection_Call) Run(run func(sectionId string, sectionType string, title string, passage string, audioUrl string, order int32, userId string)) *MockExamService_UpdateExamSection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(int32), args[6].(string))
+	})
+	return _c
+}
+
+func (_c *MockExamService_UpdateExamSection_Call) Return(_a0 *pb.UpdateExamSectionResponse, _a1 error) *MockExamService_UpdateExamSection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExamService_UpdateExamSection_Call) RunAndReturn(run func(string, string, string, string, string, int32, string) (*pb.UpdateExamSectionResponse, error)) *MockExamService_UpdateExamSection_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateQuestion provides a mock function with given fields: questionId, ask, answers, userId
-func (_m *MockExamService) UpdateQuestion(questionId string, ask string, answers []string, userId string) (*pb.UpdateQuestionResponse, error) {
-	ret := _m.Called(questionId, ask, answers, userId)
+// UpdateQuestion provides a mock function with given fields: questionId, ask, answers, acceptedAnswers, points, partialCredit, hint, explanation, sectionId, expectedVersion, userId
+func (_m *MockExamService) UpdateQuestion(questionId string, ask string, answers []string, acceptedAnswers []*pb.AcceptedAnswers, points int32, partialCredit bool, hint string, explanation string, sectionId string, expectedVersion *int32, userId string) (*pb.UpdateQuestionResponse, error) {
+	ret := _m.Called(questionId, ask, answers, acceptedAnswers, points, partialCredit, hint, explanation, sectionId, expectedVersion, userId)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateQuestion")
@@ -846,19 +3048,19 @@ func (_m *MockExamService) UpdateQuestion(questionId string, ask string, answers
 
 	var r0 *pb.UpdateQuestionResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string, []string, string) (*pb.UpdateQuestionResponse, error)); ok {
-		return rf(questionId, ask, answers, userId)
+	if rf, ok := ret.Get(0).(func(string, string, []string, []*pb.AcceptedAnswers, int32, bool, string, string, string, *int32, string) (*pb.UpdateQuestionResponse, error)); ok {
+		return rf(questionId, ask, answers, acceptedAnswers, points, partialCredit, hint, explanation, sectionId, expectedVersion, userId)
 	}
-	if rf, ok := ret.Get(0).(func(string, string, []string, string) *pb.UpdateQuestionResponse); ok {
-		r0 = rf(questionId, ask, answers, userId)
+	if rf, ok := ret.Get(0).(func(string, string, []string, []*pb.AcceptedAnswers, int32, bool, string, string, string, *int32, string) *pb.UpdateQuestionResponse); ok {
+		r0 = rf(questionId, ask, answers, acceptedAnswers, points, partialCredit, hint, explanation, sectionId, expectedVersion, userId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*pb.UpdateQuestionResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string, []string, string) error); ok {
-		r1 = rf(questionId, ask, answers, userId)
+	if rf, ok := ret.Get(1).(func(string, string, []string, []*pb.AcceptedAnswers, int32, bool, string, string, string, *int32, string) error); ok {
+		r1 = rf(questionId, ask, answers, acceptedAnswers, points, partialCredit, hint, explanation, sectionId, expectedVersion, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -875,14 +3077,21 @@ type MockExamService_UpdateQuestion_Call struct {
 //   - questionId string
 //   - ask string
 //   - answers []string
+//   - acceptedAnswers []*pb.AcceptedAnswers
+//   - points int32
+//   - partialCredit bool
+//   - hint string
+//   - explanation string
+//   - sectionId string
+//   - expectedVersion *int32
 //   - userId string
-func (_e *MockExamService_Expecter) UpdateQuestion(questionId interface{}, ask interface{}, answers interface{}, userId interface{}) *MockExamService_UpdateQuestion_Call {
-	return &MockExamService_UpdateQuestion_Call{Call: _e.mock.On("UpdateQuestion", questionId, ask, answers, userId)}
+func (_e *MockExamService_Expecter) UpdateQuestion(questionId interface{}, ask interface{}, answers interface{}, acceptedAnswers interface{}, points interface{}, partialCredit interface{}, hint interface{}, explanation interface{}, sectionId interface{}, expectedVersion interface{}, userId interface{}) *MockExamService_UpdateQuestion_Call {
+	return &MockExamService_UpdateQuestion_Call{Call: _e.mock.On("UpdateQuestion", questionId, ask, answers, acceptedAnswers, points, partialCredit, hint, explanation, sectionId, expectedVersion, userId)}
 }
 
-func (_c *MockExamService_UpdateQuestion_Call) Run(run func(questionId string, ask string, answers []string, userId string)) *MockExamService_UpdateQuestion_Call {
+func (_c *MockExamService_UpdateQuestion_Call) Run(run func(questionId string, ask string, answers []string, acceptedAnswers []*pb.AcceptedAnswers, points int32, partialCredit bool, hint string, explanation string, sectionId string, expectedVersion *int32, userId string)) *MockExamService_UpdateQuestion_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string), args[2].([]string), args[3].(string))
+		run(args[0].(string), args[1].(string), args[2].([]string), args[3].([]*pb.AcceptedAnswers), args[4].(int32), args[5].(bool), args[6].(string), args[7].(string), args[8].(string), args[9].(*int32), args[10].(string))
 	})
 	return _c
 }
@@ -892,7 +3101,7 @@ func (_c *MockExamService_UpdateQuestion_Call) Return(_a0 *pb.UpdateQuestionResp
 	return _c
 }
 
-func (_c *MockExamService_UpdateQuestion_Call) RunAndReturn(run func(string, string, []string, string) (*pb.UpdateQuestionResponse, error)) *MockExamService_UpdateQuestion_Call {
+func (_c *MockExamService_UpdateQuestion_Call) RunAndReturn(run func(string, string, []string, []*pb.AcceptedAnswers, int32, bool, string, string, string, *int32, string) (*pb.UpdateQuestionResponse, error)) *MockExamService_UpdateQuestion_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -20,6 +20,64 @@ func (_m *MockWordService) EXPECT() *MockWordService_Expecter {
 	return &MockWordService_Expecter{mock: &_m.Mock}
 }
 
+// ClearLookupHistory provides a mock function with given fields: userId
+func (_m *MockWordService) ClearLookupHistory(userId string) (*pb.ClearLookupHistoryResponse, error) {
+	ret := _m.Called(userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearLookupHistory")
+	}
+
+	var r0 *pb.ClearLookupHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*pb.ClearLookupHistoryResponse, error)); ok {
+		return rf(userId)
+	}
+	if rf, ok := ret.Get(0).(func(string) *pb.ClearLookupHistoryResponse); ok {
+		r0 = rf(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ClearLookupHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWordService_ClearLookupHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearLookupHistory'
+type MockWordService_ClearLookupHistory_Call struct {
+	*mock.Call
+}
+
+// ClearLookupHistory is a helper method to define mock.On call
+//   - userId string
+func (_e *MockWordService_Expecter) ClearLookupHistory(userId interface{}) *MockWordService_ClearLookupHistory_Call {
+	return &MockWordService_ClearLookupHistory_Call{Call: _e.mock.On("ClearLookupHistory", userId)}
+}
+
+func (_c *MockWordService_ClearLookupHistory_Call) Run(run func(userId string)) *MockWordService_ClearLookupHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockWordService_ClearLookupHistory_Call) Return(_a0 *pb.ClearLookupHistoryResponse, _a1 error) *MockWordService_ClearLookupHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWordService_ClearLookupHistory_Call) RunAndReturn(run func(string) (*pb.ClearLookupHistoryResponse, error)) *MockWordService_ClearLookupHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Connect provides a mock function with given fields:
 func (_m *MockWordService) Connect() error {
 	ret := _m.Called()
@@ -228,9 +286,127 @@ func (_c *MockWordService_Disconnect_Call) RunAndReturn(run func() error) *MockW
 	return _c
 }
 
-// FindFavoriteWordMeanings provides a mock function with given fields: pageIndex, pageSize, userId, word
-func (_m *MockWordService) FindFavoriteWordMeanings(pageIndex int32, pageSize int32, userId string, word string) (*pb.FindFavoriteWordMeaningsResponse, error) {
-	ret := _m.Called(pageIndex, pageSize, userId, word)
+// FindDictationHistory provides a mock function with given fields: userId, size
+func (_m *MockWordService) FindDictationHistory(userId string, size int32) (*pb.FindDictationHistoryResponse, error) {
+	ret := _m.Called(userId, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDictationHistory")
+	}
+
+	var r0 *pb.FindDictationHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int32) (*pb.FindDictationHistoryResponse, error)); ok {
+		return rf(userId, size)
+	}
+	if rf, ok := ret.Get(0).(func(string, int32) *pb.FindDictationHistoryResponse); ok {
+		r0 = rf(userId, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FindDictationHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int32) error); ok {
+		r1 = rf(userId, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWordService_FindDictationHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDictationHistory'
+type MockWordService_FindDictationHistory_Call struct {
+	*mock.Call
+}
+
+// FindDictationHistory is a helper method to define mock.On call
+//   - userId string
+//   - size int32
+func (_e *MockWordService_Expecter) FindDictationHistory(userId interface{}, size interface{}) *MockWordService_FindDictationHistory_Call {
+	return &MockWordService_FindDictationHistory_Call{Call: _e.mock.On("FindDictationHistory", userId, size)}
+}
+
+func (_c *MockWordService_FindDictationHistory_Call) Run(run func(userId string, size int32)) *MockWordService_FindDictationHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockWordService_FindDictationHistory_Call) Return(_a0 *pb.FindDictationHistoryResponse, _a1 error) *MockWordService_FindDictationHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWordService_FindDictationHistory_Call) RunAndReturn(run func(string, int32) (*pb.FindDictationHistoryResponse, error)) *MockWordService_FindDictationHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDictationSentences provides a mock function with given fields: userId, size
+func (_m *MockWordService) FindDictationSentences(userId string, size int32) (*pb.FindDictationSentencesResponse, error) {
+	ret := _m.Called(userId, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDictationSentences")
+	}
+
+	var r0 *pb.FindDictationSentencesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int32) (*pb.FindDictationSentencesResponse, error)); ok {
+		return rf(userId, size)
+	}
+	if rf, ok := ret.Get(0).(func(string, int32) *pb.FindDictationSentencesResponse); ok {
+		r0 = rf(userId, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FindDictationSentencesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int32) error); ok {
+		r1 = rf(userId, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWordService_FindDictationSentences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDictationSentences'
+type MockWordService_FindDictationSentences_Call struct {
+	*mock.Call
+}
+
+// FindDictationSentences is a helper method to define mock.On call
+//   - userId string
+//   - size int32
+func (_e *MockWordService_Expecter) FindDictationSentences(userId interface{}, size interface{}) *MockWordService_FindDictationSentences_Call {
+	return &MockWordService_FindDictationSentences_Call{Call: _e.mock.On("FindDictationSentences", userId, size)}
+}
+
+func (_c *MockWordService_FindDictationSentences_Call) Run(run func(userId string, size int32)) *MockWordService_FindDictationSentences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockWordService_FindDictationSentences_Call) Return(_a0 *pb.FindDictationSentencesResponse, _a1 error) *MockWordService_FindDictationSentences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWordService_FindDictationSentences_Call) RunAndReturn(run func(string, int32) (*pb.FindDictationSentencesResponse, error)) *MockWordService_FindDictationSentences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindFavoriteWordMeanings provides a mock function with given fields: pageIndex, pageSize, userId, word, cursor
+func (_m *MockWordService) FindFavoriteWordMeanings(pageIndex int32, pageSize int32, userId string, word string, cursor string) (*pb.FindFavoriteWordMeaningsResponse, error) {
+	ret := _m.Called(pageIndex, pageSize, userId, word, cursor)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindFavoriteWordMeanings")
@@ -238,19 +414,19 @@ func (_m *MockWordService) FindFavoriteWordMeanings(pageIndex int32, pageSize in
 
 	var r0 *pb.FindFavoriteWordMeaningsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int32, int32, string, string) (*pb.FindFavoriteWordMeaningsResponse, error)); ok {
-		return rf(pageIndex, pageSize, userId, word)
+	if rf, ok := ret.Get(0).(func(int32, int32, string, string, string) (*pb.FindFavoriteWordMeaningsResponse, error)); ok {
+		return rf(pageIndex, pageSize, userId, word, cursor)
 	}
-	if rf, ok := ret.Get(0).(func(int32, int32, string, string) *pb.FindFavoriteWordMeaningsResponse); ok {
-		r0 = rf(pageIndex, pageSize, userId, word)
+	if rf, ok := ret.Get(0).(func(int32, int32, string, string, string) *pb.FindFavoriteWordMeaningsResponse); ok {
+		r0 = rf(pageIndex, pageSize, userId, word, cursor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*pb.FindFavoriteWordMeaningsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int32, int32, string, string) error); ok {
-		r1 = rf(pageIndex, pageSize, userId, word)
+	if rf, ok := ret.Get(1).(func(int32, int32, string, string, string) error); ok {
+		r1 = rf(pageIndex, pageSize, userId, word, cursor)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -268,13 +444,14 @@ type MockWordService_FindFavoriteWordMeanings_Call struct {
 //   - pageSize int32
 //   - userId string
 //   - word string
-func (_e *MockWordService_Expecter) FindFavoriteWordMeanings(pageIndex interface{}, pageSize interface{}, userId interface{}, word interface{}) *MockWordService_FindFavoriteWordMeanings_Call {
-	return &MockWordService_FindFavoriteWordMeanings_Call{Call: _e.mock.On("FindFavoriteWordMeanings", pageIndex, pageSize, userId, word)}
+//   - cursor string
+func (_e *MockWordService_Expecter) FindFavoriteWordMeanings(pageIndex interface{}, pageSize interface{}, userId interface{}, word interface{}, cursor interface{}) *MockWordService_FindFavoriteWordMeanings_Call {
+	return &MockWordService_FindFavoriteWordMeanings_Call{Call: _e.mock.On("FindFavoriteWordMeanings", pageIndex, pageSize, userId, word, cursor)}
 }
 
-func (_c *MockWordService_FindFavoriteWordMeanings_Call) Run(run func(pageIndex int32, pageSize int32, userId string, word string)) *MockWordService_FindFavoriteWordMeanings_Call {
+func (_c *MockWordService_FindFavoriteWordMeanings_Call) Run(run func(pageIndex int32, pageSize int32, userId string, word string, cursor string)) *MockWordService_FindFavoriteWordMeanings_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int32), args[1].(int32), args[2].(string), args[3].(string))
+		run(args[0].(int32), args[1].(int32), args[2].(string), args[3].(string), args[4].(string))
 	})
 	return _c
 }
@@ -284,14 +461,73 @@ func (_c *MockWordService_FindFavoriteWordMeanings_Call) Return(_a0 *pb.FindFavo
 	return _c
 }
 
-func (_c *MockWordService_FindFavoriteWordMeanings_Call) RunAndReturn(run func(int32, int32, string, string) (*pb.FindFavoriteWordMeaningsResponse, error)) *MockWordService_FindFavoriteWordMeanings_Call {
+func (_c *MockWordService_FindFavoriteWordMeanings_Call) RunAndReturn(run func(int32, int32, string, string, string) (*pb.FindFavoriteWordMeaningsResponse, error)) *MockWordService_FindFavoriteWordMeanings_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindRandomFavoriteWordMeanings provides a mock function with given fields: userId, size
-func (_m *MockWordService) FindRandomFavoriteWordMeanings(userId string, size int32) (*pb.FindRandomFavoriteWordMeaningsResponse, error) {
-	ret := _m.Called(userId, size)
+// FindFavoriteWordMeaningsByIds provides a mock function with given fields: favoriteWordMeaningIds, userId
+func (_m *MockWordService) FindFavoriteWordMeaningsByIds(favoriteWordMeaningIds []string, userId string) (*pb.FindFavoriteWordMeaningsByIdsResponse, error) {
+	ret := _m.Called(favoriteWordMeaningIds, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFavoriteWordMeaningsByIds")
+	}
+
+	var r0 *pb.FindFavoriteWordMeaningsByIdsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string, string) (*pb.FindFavoriteWordMeaningsByIdsResponse, error)); ok {
+		return rf(favoriteWordMeaningIds, userId)
+	}
+	if rf, ok := ret.Get(0).(func([]string, string) *pb.FindFavoriteWordMeaningsByIdsResponse); ok {
+		r0 = rf(favoriteWordMeaningIds, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FindFavoriteWordMeaningsByIdsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string, string) error); ok {
+		r1 = rf(favoriteWordMeaningIds, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWordService_FindFavoriteWordMeaningsByIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindFavoriteWordMeaningsByIds'
+type MockWordService_FindFavoriteWordMeaningsByIds_Call struct {
+	*mock.Call
+}
+
+// FindFavoriteWordMeaningsByIds is a helper method to define mock.On call
+//   - favoriteWordMeaningIds []string
+//   - userId string
+func (_e *MockWordService_Expecter) FindFavoriteWordMeaningsByIds(favoriteWordMeaningIds interface{}, userId interface{}) *MockWordService_FindFavoriteWordMeaningsByIds_Call {
+	return &MockWordService_FindFavoriteWordMeaningsByIds_Call{Call: _e.mock.On("FindFavoriteWordMeaningsByIds", favoriteWordMeaningIds, userId)}
+}
+
+func (_c *MockWordService_FindFavoriteWordMeaningsByIds_Call) Run(run func(favoriteWordMeaningIds []string, userId string)) *MockWordService_FindFavoriteWordMeaningsByIds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockWordService_FindFavoriteWordMeaningsByIds_Call) Return(_a0 *pb.FindFavoriteWordMeaningsByIdsResponse, _a1 error) *MockWordService_FindFavoriteWordMeaningsByIds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWordService_FindFavoriteWordMeaningsByIds_Call) RunAndReturn(run func([]string, string) (*pb.FindFavoriteWordMeaningsByIdsResponse, error)) *MockWordService_FindFavoriteWordMeaningsByIds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRandomFavoriteWordMeanings provides a mock function with given fields: userId, size, weighting
+func (_m *MockWordService) FindRandomFavoriteWordMeanings(userId string, size int32, weighting string) (*pb.FindRandomFavoriteWordMeaningsResponse, error) {
+	ret := _m.Called(userId, size, weighting)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindRandomFavoriteWordMeanings")
@@ -299,19 +535,19 @@ func (_m *MockWordService) FindRandomFavoriteWordMeanings(userId string, size in
 
 	var r0 *pb.FindRandomFavoriteWordMeaningsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, int32) (*pb.FindRandomFavoriteWordMeaningsResponse, error)); ok {
-		return rf(userId, size)
+	if rf, ok := ret.Get(0).(func(string, int32, string) (*pb.FindRandomFavoriteWordMeaningsResponse, error)); ok {
+		return rf(userId, size, weighting)
 	}
-	if rf, ok := ret.Get(0).(func(string, int32) *pb.FindRandomFavoriteWordMeaningsResponse); ok {
-		r0 = rf(userId, size)
+	if rf, ok := ret.Get(0).(func(string, int32, string) *pb.FindRandomFavoriteWordMeaningsResponse); ok {
+		r0 = rf(userId, size, weighting)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*pb.FindRandomFavoriteWordMeaningsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, int32) error); ok {
-		r1 = rf(userId, size)
+	if rf, ok := ret.Get(1).(func(string, int32, string) error); ok {
+		r1 = rf(userId, size, weighting)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -327,13 +563,14 @@ type MockWordService_FindRandomFavoriteWordMeanings_Call struct {
 // FindRandomFavoriteWordMeanings is a helper method to define mock.On call
 //   - userId string
 //   - size int32
-func (_e *MockWordService_Expecter) FindRandomFavoriteWordMeanings(userId interface{}, size interface{}) *MockWordService_FindRandomFavoriteWordMeanings_Call {
-	return &MockWordService_FindRandomFavoriteWordMeanings_Call{Call: _e.mock.On("FindRandomFavoriteWordMeanings", userId, size)}
+//   - weighting string
+func (_e *MockWordService_Expecter) FindRandomFavoriteWordMeanings(userId interface{}, size interface{}, weighting interface{}) *MockWordService_FindRandomFavoriteWordMeanings_Call {
+	return &MockWordService_FindRandomFavoriteWordMeanings_Call{Call: _e.mock.On("FindRandomFavoriteWordMeanings", userId, size, weighting)}
 }
 
-func (_c *MockWordService_FindRandomFavoriteWordMeanings_Call) Run(run func(userId string, size int32)) *MockWordService_FindRandomFavoriteWordMeanings_Call {
+func (_c *MockWordService_FindRandomFavoriteWordMeanings_Call) Run(run func(userId string, size int32, weighting string)) *MockWordService_FindRandomFavoriteWordMeanings_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(int32))
+		run(args[0].(string), args[1].(int32), args[2].(string))
 	})
 	return _c
 }
@@ -343,7 +580,66 @@ func (_c *MockWordService_FindRandomFavoriteWordMeanings_Call) Return(_a0 *pb.Fi
 	return _c
 }
 
-func (_c *MockWordService_FindRandomFavoriteWordMeanings_Call) RunAndReturn(run func(string, int32) (*pb.FindRandomFavoriteWordMeaningsResponse, error)) *MockWordService_FindRandomFavoriteWordMeanings_Call {
+func (_c *MockWordService_FindRandomFavoriteWordMeanings_Call) RunAndReturn(run func(string, int32, string) (*pb.FindRandomFavoriteWordMeaningsResponse, error)) *MockWordService_FindRandomFavoriteWordMeanings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRecentLookups provides a mock function with given fields: userId, size
+func (_m *MockWordService) FindRecentLookups(userId string, size int32) (*pb.FindRecentLookupsResponse, error) {
+	ret := _m.Called(userId, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRecentLookups")
+	}
+
+	var r0 *pb.FindRecentLookupsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int32) (*pb.FindRecentLookupsResponse, error)); ok {
+		return rf(userId, size)
+	}
+	if rf, ok := ret.Get(0).(func(string, int32) *pb.FindRecentLookupsResponse); ok {
+		r0 = rf(userId, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FindRecentLookupsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int32) error); ok {
+		r1 = rf(userId, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWordService_FindRecentLookups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRecentLookups'
+type MockWordService_FindRecentLookups_Call struct {
+	*mock.Call
+}
+
+// FindRecentLookups is a helper method to define mock.On call
+//   - userId string
+//   - size int32
+func (_e *MockWordService_Expecter) FindRecentLookups(userId interface{}, size interface{}) *MockWordService_FindRecentLookups_Call {
+	return &MockWordService_FindRecentLookups_Call{Call: _e.mock.On("FindRecentLookups", userId, size)}
+}
+
+func (_c *MockWordService_FindRecentLookups_Call) Run(run func(userId string, size int32)) *MockWordService_FindRecentLookups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockWordService_FindRecentLookups_Call) Return(_a0 *pb.FindRecentLookupsResponse, _a1 error) *MockWordService_FindRecentLookups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWordService_FindRecentLookups_Call) RunAndReturn(run func(string, int32) (*pb.FindRecentLookupsResponse, error)) *MockWordService_FindRecentLookups_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -407,6 +703,126 @@ func (_c *MockWordService_FindWordByDictionary_Call) RunAndReturn(run func(strin
 	return _c
 }
 
+// GradeDictation provides a mock function with given fields: userId, wordMeaningId, audioUrl, answer
+func (_m *MockWordService) GradeDictation(userId string, wordMeaningId string, audioUrl string, answer string) (*pb.GradeDictationResponse, error) {
+	ret := _m.Called(userId, wordMeaningId, audioUrl, answer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GradeDictation")
+	}
+
+	var r0 *pb.GradeDictationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (*pb.GradeDictationResponse, error)); ok {
+		return rf(userId, wordMeaningId, audioUrl, answer)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *pb.GradeDictationResponse); ok {
+		r0 = rf(userId, wordMeaningId, audioUrl, answer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GradeDictationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(userId, wordMeaningId, audioUrl, answer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWordService_GradeDictation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GradeDictation'
+type MockWordService_GradeDictation_Call struct {
+	*mock.Call
+}
+
+// GradeDictation is a helper method to define mock.On call
+//   - userId string
+//   - wordMeaningId string
+//   - audioUrl string
+//   - answer string
+func (_e *MockWordService_Expecter) GradeDictation(userId interface{}, wordMeaningId interface{}, audioUrl interface{}, answer interface{}) *MockWordService_GradeDictation_Call {
+	return &MockWordService_GradeDictation_Call{Call: _e.mock.On("GradeDictation", userId, wordMeaningId, audioUrl, answer)}
+}
+
+func (_c *MockWordService_GradeDictation_Call) Run(run func(userId string, wordMeaningId string, audioUrl string, answer string)) *MockWordService_GradeDictation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockWordService_GradeDictation_Call) Return(_a0 *pb.GradeDictationResponse, _a1 error) *MockWordService_GradeDictation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWordService_GradeDictation_Call) RunAndReturn(run func(string, string, string, string) (*pb.GradeDictationResponse, error)) *MockWordService_GradeDictation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkFavoriteWordMeaningsReviewed provides a mock function with given fields: favoriteWordMeaningIds, userId
+func (_m *MockWordService) MarkFavoriteWordMeaningsReviewed(favoriteWordMeaningIds []string, userId string) (*pb.MarkFavoriteWordMeaningsReviewedResponse, error) {
+	ret := _m.Called(favoriteWordMeaningIds, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkFavoriteWordMeaningsReviewed")
+	}
+
+	var r0 *pb.MarkFavoriteWordMeaningsReviewedResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string, string) (*pb.MarkFavoriteWordMeaningsReviewedResponse, error)); ok {
+		return rf(favoriteWordMeaningIds, userId)
+	}
+	if rf, ok := ret.Get(0).(func([]string, string) *pb.MarkFavoriteWordMeaningsReviewedResponse); ok {
+		r0 = rf(favoriteWordMeaningIds, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.MarkFavoriteWordMeaningsReviewedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string, string) error); ok {
+		r1 = rf(favoriteWordMeaningIds, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWordService_MarkFavoriteWordMeaningsReviewed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkFavoriteWordMeaningsReviewed'
+type MockWordService_MarkFavoriteWordMeaningsReviewed_Call struct {
+	*mock.Call
+}
+
+// MarkFavoriteWordMeaningsReviewed is a helper method to define mock.On call
+//   - favoriteWordMeaningIds []string
+//   - userId string
+func (_e *MockWordService_Expecter) MarkFavoriteWordMeaningsReviewed(favoriteWordMeaningIds interface{}, userId interface{}) *MockWordService_MarkFavoriteWordMeaningsReviewed_Call {
+	return &MockWordService_MarkFavoriteWordMeaningsReviewed_Call{Call: _e.mock.On("MarkFavoriteWordMeaningsReviewed", favoriteWordMeaningIds, userId)}
+}
+
+func (_c *MockWordService_MarkFavoriteWordMeaningsReviewed_Call) Run(run func(favoriteWordMeaningIds []string, userId string)) *MockWordService_MarkFavoriteWordMeaningsReviewed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockWordService_MarkFavoriteWordMeaningsReviewed_Call) Return(_a0 *pb.MarkFavoriteWordMeaningsReviewedResponse, _a1 error) *MockWordService_MarkFavoriteWordMeaningsReviewed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWordService_MarkFavoriteWordMeaningsReviewed_Call) RunAndReturn(run func([]string, string) (*pb.MarkFavoriteWordMeaningsReviewedResponse, error)) *MockWordService_MarkFavoriteWordMeaningsReviewed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockWordService creates a new instance of MockWordService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWordService(t interface {
@@ -25,11 +25,32 @@ type WordService interface {
 	) (*pb.DeleteFavoriteWordMeaningResponse, error)
 	FindFavoriteWordMeanings(
 		pageIndex, pageSize int32,
-		userId, word string,
+		userId, word, cursor string,
 	) (*pb.FindFavoriteWordMeaningsResponse, error)
 	FindRandomFavoriteWordMeanings(
-		userId string, size int32,
+		userId string, size int32, weighting string,
 	) (*pb.FindRandomFavoriteWordMeaningsResponse, error)
+	FindFavoriteWordMeaningsByIds(
+		favoriteWordMeaningIds []string, userId string,
+	) (*pb.FindFavoriteWordMeaningsByIdsResponse, error)
+	MarkFavoriteWordMeaningsReviewed(
+		favoriteWordMeaningIds []string, userId string,
+	) (*pb.MarkFavoriteWordMeaningsReviewedResponse, error)
+	FindRecentLookups(
+		userId string, size int32,
+	) (*pb.FindRecentLookupsResponse, error)
+	ClearLookupHistory(
+		userId string,
+	) (*pb.ClearLookupHistoryResponse, error)
+	FindDictationSentences(
+		userId string, size int32,
+	) (*pb.FindDictationSentencesResponse, error)
+	GradeDictation(
+		userId, wordMeaningId, audioUrl, answer string,
+	) (*pb.GradeDictationResponse, error)
+	FindDictationHistory(
+		userId string, size int32,
+	) (*pb.FindDictationHistoryResponse, error)
 }
 
 func New(serverAddress string) WordService {
@@ -110,7 +131,7 @@ func (service wordService) DeleteFavoriteWordMeaning(
 
 func (service wordService) FindFavoriteWordMeanings(
 	pageIndex, pageSize int32,
-	userId, word string,
+	userId, word, cursor string,
 ) (*pb.FindFavoriteWordMeaningsResponse, error) {
 	return service.client.FindFavoriteWordMeanings(
 		context.Background(),
@@ -119,16 +140,103 @@ func (service wordService) FindFavoriteWordMeanings(
 			PageSize:  pageSize,
 			UserId:    userId,
 			Word:      word,
+			Cursor:    cursor,
 		},
 	)
 }
 
 func (service wordService) FindRandomFavoriteWordMeanings(
-	userId string, size int32,
+	userId string, size int32, weighting string,
 ) (*pb.FindRandomFavoriteWordMeaningsResponse, error) {
 	return service.client.FindRandomFavoriteWordMeanings(
 		context.Background(),
 		&pb.FindRandomFavoriteWordMeaningsRequest{
+			UserId:    userId,
+			Size:      size,
+			Weighting: weighting,
+		},
+	)
+}
+
+func (service wordService) FindFavoriteWordMeaningsByIds(
+	favoriteWordMeaningIds []string, userId string,
+) (*pb.FindFavoriteWordMeaningsByIdsResponse, error) {
+	return service.client.FindFavoriteWordMeaningsByIds(
+		context.Background(),
+		&pb.FindFavoriteWordMeaningsByIdsRequest{
+			FavoriteWordMeaningIds: favoriteWordMeaningIds,
+			UserId:                 userId,
+		},
+	)
+}
+
+func (service wordService) MarkFavoriteWordMeaningsReviewed(
+	favoriteWordMeaningIds []string, userId string,
+) (*pb.MarkFavoriteWordMeaningsReviewedResponse, error) {
+	return service.client.MarkFavoriteWordMeaningsReviewed(
+		context.Background(),
+		&pb.MarkFavoriteWordMeaningsReviewedRequest{
+			FavoriteWordMeaningIds: favoriteWordMeaningIds,
+			UserId:                 userId,
+		},
+	)
+}
+
+func (service wordService) FindRecentLookups(
+	userId string, size int32,
+) (*pb.FindRecentLookupsResponse, error) {
+	return service.client.FindRecentLookups(
+		context.Background(),
+		&pb.FindRecentLookupsRequest{
+			UserId: userId,
+			Size:   size,
+		},
+	)
+}
+
+func (service wordService) ClearLookupHistory(
+	userId string,
+) (*pb.ClearLookupHistoryResponse, error) {
+	return service.client.ClearLookupHistory(
+		context.Background(),
+		&pb.ClearLookupHistoryRequest{
+			UserId: userId,
+		},
+	)
+}
+
+func (service wordService) FindDictationSentences(
+	userId string, size int32,
+) (*pb.FindDictationSentencesResponse, error) {
+	return service.client.FindDictationSentences(
+		context.Background(),
+		&pb.FindDictationSentencesRequest{
+			UserId: userId,
+			Size:   size,
+		},
+	)
+}
+
+func (service wordService) GradeDictation(
+	userId, wordMeaningId, audioUrl, answer string,
+) (*pb.GradeDictationResponse, error) {
+	return service.client.GradeDictation(
+		context.Background(),
+		&pb.GradeDictationRequest{
+			UserId:        userId,
+			WordMeaningId: wordMeaningId,
+			AudioUrl:      audioUrl,
+			Answer:        answer,
+		},
+	)
+}
+
+func (service wordService) FindDictationHistory(
+	userId string, size int32,
+) (*pb.FindDictationHistoryResponse, error) {
+	return service.client.FindDictationHistory(
+		context.Background(),
+		&pb.FindDictationHistoryRequest{
 			UserId: userId,
 			Size:   size,
 		},
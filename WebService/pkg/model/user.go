@@ -8,12 +8,13 @@ import (
 )
 
 type User struct {
-	Id        primitive.ObjectID `bson:"_id,omitempty"`
-	Username  string             `bson:"username"`
-	Password  string             `bson:"password"`
-	Role      string             `bson:"role"`
-	CreatedAt time.Time          `bson:"createdAt"`
-	UpdatedAt time.Time          `bson:"updatedAt"`
+	Id                  primitive.ObjectID `bson:"_id,omitempty"`
+	Username            string             `bson:"username"`
+	Password            string             `bson:"password"`
+	Role                string             `bson:"role"`
+	HideFromLeaderboard bool               `bson:"hideFromLeaderboard"` // 不在排行榜顯示
+	CreatedAt           time.Time          `bson:"createdAt"`
+	UpdatedAt           time.Time          `bson:"updatedAt"`
 }
 
 /*
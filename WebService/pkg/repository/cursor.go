@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 分頁用的排序，加上 _id 讓 updatedAt 相同的資料也有固定順序
+var pageSort = bson.D{{"updatedAt", -1}, {"_id", -1}} // descending
+
+// 分頁 cursor 的內容，記錄上一頁最後一筆資料的位置
+type pageCursor struct {
+	UpdatedAt time.Time          `json:"updatedAt"`
+	Id        primitive.ObjectID `json:"_id"`
+}
+
+/*
+將上一頁最後一筆資料的 updatedAt 與 _id 編碼成不透明的 cursor 字串，
+下一頁從此資料之後開始查詢，已看過的資料不會重複出現；
+但翻頁期間被更新的資料 updatedAt 會變大而移到最前面，之後的頁面不會再出現，
+需要從第一頁重新查詢才看得到
+*/
+func EncodeCursor(updatedAt time.Time, id primitive.ObjectID) string {
+	data, _ := json.Marshal(pageCursor{
+		UpdatedAt: updatedAt,
+		Id:        id,
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// 產生查詢 cursor 之後資料的條件
+func afterCursorFilter(cursor string) (bson.E, error) {
+	invalidCursorError := fmt.Errorf("Invalid cursor: %s", cursor)
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return bson.E{}, invalidCursorError
+	}
+
+	var position pageCursor
+	if err = json.Unmarshal(data, &position); err != nil || position.Id.IsZero() {
+		return bson.E{}, invalidCursorError
+	}
+
+	return bson.E{"$or", bson.A{
+		bson.D{{"updatedAt", bson.D{{"$lt", position.UpdatedAt}}}},
+		bson.D{{"updatedAt", position.UpdatedAt}, {"_id", bson.D{{"$lt", position.Id}}}},
+	}}, nil
+}
@@ -70,6 +70,55 @@ func (_c *MockCacheRepository_ConnectDB_Call) RunAndReturn(run func(string) erro
 	return _c
 }
 
+// CreateLeaderboard provides a mock function with given fields: ctx, key, leaderboardEntries, expiration
+func (_m *MockCacheRepository) CreateLeaderboard(ctx context.Context, key string, leaderboardEntries []*pb.LeaderboardEntry, expiration time.Duration) error {
+	ret := _m.Called(ctx, key, leaderboardEntries, expiration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLeaderboard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*pb.LeaderboardEntry, time.Duration) error); ok {
+		r0 = rf(ctx, key, leaderboardEntries, expiration)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCacheRepository_CreateLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLeaderboard'
+type MockCacheRepository_CreateLeaderboard_Call struct {
+	*mock.Call
+}
+
+// CreateLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - leaderboardEntries []*pb.LeaderboardEntry
+//   - expiration time.Duration
+func (_e *MockCacheRepository_Expecter) CreateLeaderboard(ctx interface{}, key interface{}, leaderboardEntries interface{}, expiration interface{}) *MockCacheRepository_CreateLeaderboard_Call {
+	return &MockCacheRepository_CreateLeaderboard_Call{Call: _e.mock.On("CreateLeaderboard", ctx, key, leaderboardEntries, expiration)}
+}
+
+func (_c *MockCacheRepository_CreateLeaderboard_Call) Run(run func(ctx context.Context, key string, leaderboardEntries []*pb.LeaderboardEntry, expiration time.Duration)) *MockCacheRepository_CreateLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]*pb.LeaderboardEntry), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockCacheRepository_CreateLeaderboard_Call) Return(_a0 error) *MockCacheRepository_CreateLeaderboard_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCacheRepository_CreateLeaderboard_Call) RunAndReturn(run func(context.Context, string, []*pb.LeaderboardEntry, time.Duration) error) *MockCacheRepository_CreateLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateWordMeanings provides a mock function with given fields: ctx, key, wordMeanings, expiration
 func (_m *MockCacheRepository) CreateWordMeanings(ctx context.Context, key string, wordMeanings []*pb.WordMeaning, expiration time.Duration) error {
 	ret := _m.Called(ctx, key, wordMeanings, expiration)
@@ -119,6 +168,53 @@ func (_c *MockCacheRepository_CreateWordMeanings_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// DeleteLeaderboards provides a mock function with given fields: ctx, keys
+func (_m *MockCacheRepository) DeleteLeaderboards(ctx context.Context, keys []string) error {
+	ret := _m.Called(ctx, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLeaderboards")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = rf(ctx, keys)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCacheRepository_DeleteLeaderboards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLeaderboards'
+type MockCacheRepository_DeleteLeaderboards_Call struct {
+	*mock.Call
+}
+
+// DeleteLeaderboards is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keys []string
+func (_e *MockCacheRepository_Expecter) DeleteLeaderboards(ctx interface{}, keys interface{}) *MockCacheRepository_DeleteLeaderboards_Call {
+	return &MockCacheRepository_DeleteLeaderboards_Call{Call: _e.mock.On("DeleteLeaderboards", ctx, keys)}
+}
+
+func (_c *MockCacheRepository_DeleteLeaderboards_Call) Run(run func(ctx context.Context, keys []string)) *MockCacheRepository_DeleteLeaderboards_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockCacheRepository_DeleteLeaderboards_Call) Return(_a0 error) *MockCacheRepository_DeleteLeaderboards_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCacheRepository_DeleteLeaderboards_Call) RunAndReturn(run func(context.Context, []string) error) *MockCacheRepository_DeleteLeaderboards_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DisconnectDB provides a mock function with given fields:
 func (_m *MockCacheRepository) DisconnectDB() error {
 	ret := _m.Called()
@@ -164,6 +260,65 @@ func (_c *MockCacheRepository_DisconnectDB_Call) RunAndReturn(run func() error)
 	return _c
 }
 
+// FindLeaderboard provides a mock function with given fields: ctx, key
+func (_m *MockCacheRepository) FindLeaderboard(ctx context.Context, key string) ([]*pb.LeaderboardEntry, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindLeaderboard")
+	}
+
+	var r0 []*pb.LeaderboardEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*pb.LeaderboardEntry, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*pb.LeaderboardEntry); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*pb.LeaderboardEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCacheRepository_FindLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindLeaderboard'
+type MockCacheRepository_FindLeaderboard_Call struct {
+	*mock.Call
+}
+
+// FindLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockCacheRepository_Expecter) FindLeaderboard(ctx interface{}, key interface{}) *MockCacheRepository_FindLeaderboard_Call {
+	return &MockCacheRepository_FindLeaderboard_Call{Call: _e.mock.On("FindLeaderboard", ctx, key)}
+}
+
+func (_c *MockCacheRepository_FindLeaderboard_Call) Run(run func(ctx context.Context, key string)) *MockCacheRepository_FindLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCacheRepository_FindLeaderboard_Call) Return(leaderboardEntries []*pb.LeaderboardEntry, err error) *MockCacheRepository_FindLeaderboard_Call {
+	_c.Call.Return(leaderboardEntries, err)
+	return _c
+}
+
+func (_c *MockCacheRepository_FindLeaderboard_Call) RunAndReturn(run func(context.Context, string) ([]*pb.LeaderboardEntry, error)) *MockCacheRepository_FindLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindWordMeanings provides a mock function with given fields: ctx, key
 func (_m *MockCacheRepository) FindWordMeanings(ctx context.Context, key string) ([]*pb.WordMeaning, error) {
 	ret := _m.Called(ctx, key)
@@ -285,34 +285,41 @@ func (_c *MockDatabaseRepository_DisconnectDB_Call) RunAndReturn(run func(contex
 	return _c
 }
 
-// FindUserHistoryResponsesOrderByUpdatedAt provides a mock function with given fields: ctx, pageIndex, pageSize
-func (_m *MockDatabaseRepository) FindUserHistoryResponsesOrderByUpdatedAt(ctx context.Context, pageIndex int32, pageSize int32) ([]UserHistoryResponse, error) {
-	ret := _m.Called(ctx, pageIndex, pageSize)
+// FindUserHistoryResponsesOrderByUpdatedAt provides a mock function with given fields: ctx, cursor, pageIndex, pageSize
+func (_m *MockDatabaseRepository) FindUserHistoryResponsesOrderByUpdatedAt(ctx context.Context, cursor string, pageIndex int32, pageSize int32) ([]UserHistoryResponse, string, error) {
+	ret := _m.Called(ctx, cursor, pageIndex, pageSize)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindUserHistoryResponsesOrderByUpdatedAt")
 	}
 
 	var r0 []UserHistoryResponse
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32, int32) ([]UserHistoryResponse, error)); ok {
-		return rf(ctx, pageIndex, pageSize)
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32, int32) ([]UserHistoryResponse, string, error)); ok {
+		return rf(ctx, cursor, pageIndex, pageSize)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32, int32) []UserHistoryResponse); ok {
-		r0 = rf(ctx, pageIndex, pageSize)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32, int32) []UserHistoryResponse); ok {
+		r0 = rf(ctx, cursor, pageIndex, pageSize)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]UserHistoryResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32, int32) error); ok {
-		r1 = rf(ctx, pageIndex, pageSize)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32, int32) string); ok {
+		r1 = rf(ctx, cursor, pageIndex, pageSize)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(string)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, string, int32, int32) error); ok {
+		r2 = rf(ctx, cursor, pageIndex, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // MockDatabaseRepository_FindUserHistoryResponsesOrderByUpdatedAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserHistoryResponsesOrderByUpdatedAt'
@@ -322,25 +329,143 @@ type MockDatabaseRepository_FindUserHistoryResponsesOrderByUpdatedAt_Call struct
 
 // FindUserHistoryResponsesOrderByUpdatedAt is a helper method to define mock.On call
 //   - ctx context.Context
+//   - cursor string
 //   - pageIndex int32
 //   - pageSize int32
-func (_e *MockDatabaseRepository_Expecter) FindUserHistoryResponsesOrderByUpdatedAt(ctx interface{}, pageIndex interface{}, pageSize interface{}) *MockDatabaseRepository_FindUserHistoryResponsesOrderByUpdatedAt_Call {
-	return &MockDatabaseRepository_FindUserHistoryResponsesOrderByUpdatedAt_Call{Call: _e.mock.On("FindUserHistoryResponsesOrderByUpdatedAt", ctx, pageIndex, pageSize)}
+func (_e *MockDatabaseRepository_Expecter) FindUserHistoryResponsesOrderByUpdatedAt(ctx interface{}, cursor interface{}, pageIndex interface{}, pageSize interface{}) *MockDatabaseRepository_FindUserHistoryResponsesOrderByUpdatedAt_Call {
+	return &MockDatabaseRepository_FindUserHistoryResponsesOrderByUpdatedAt_Call{Call: _e.mock.On("FindUserHistoryResponsesOrderByUpdatedAt", ctx, cursor, pageIndex, pageSize)}
+}
+
+func (_c *MockDatabaseRepository_FindUserHistoryResponsesOrderByUpdatedAt_Call) Run(run func(ctx context.Context, cursor string, pageIndex int32, pageSize int32)) *MockDatabaseRepository_FindUserHistoryResponsesOrderByUpdatedAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindUserHistoryResponsesOrderByUpdatedAt_Call) Return(userHistoryResponses []UserHistoryResponse, nextCursor string, err error) *MockDatabaseRepository_FindUserHistoryResponsesOrderByUpdatedAt_Call {
+	_c.Call.Return(userHistoryResponses, nextCursor, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindUserHistoryResponsesOrderByUpdatedAt_Call) RunAndReturn(run func(context.Context, string, int32, int32) ([]UserHistoryResponse, string, error)) *MockDatabaseRepository_FindUserHistoryResponsesOrderByUpdatedAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUserIdsByHideFromLeaderboard provides a mock function with given fields: ctx
+func (_m *MockDatabaseRepository) FindUserIdsByHideFromLeaderboard(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUserIdsByHideFromLeaderboard")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindUserIdsByHideFromLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserIdsByHideFromLeaderboard'
+type MockDatabaseRepository_FindUserIdsByHideFromLeaderboard_Call struct {
+	*mock.Call
+}
+
+// FindUserIdsByHideFromLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDatabaseRepository_Expecter) FindUserIdsByHideFromLeaderboard(ctx interface{}) *MockDatabaseRepository_FindUserIdsByHideFromLeaderboard_Call {
+	return &MockDatabaseRepository_FindUserIdsByHideFromLeaderboard_Call{Call: _e.mock.On("FindUserIdsByHideFromLeaderboard", ctx)}
+}
+
+func (_c *MockDatabaseRepository_FindUserIdsByHideFromLeaderboard_Call) Run(run func(ctx context.Context)) *MockDatabaseRepository_FindUserIdsByHideFromLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindUserIdsByHideFromLeaderboard_Call) Return(userIds []string, err error) *MockDatabaseRepository_FindUserIdsByHideFromLeaderboard_Call {
+	_c.Call.Return(userIds, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindUserIdsByHideFromLeaderboard_Call) RunAndReturn(run func(context.Context) ([]string, error)) *MockDatabaseRepository_FindUserIdsByHideFromLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
 }
 
-func (_c *MockDatabaseRepository_FindUserHistoryResponsesOrderByUpdatedAt_Call) Run(run func(ctx context.Context, pageIndex int32, pageSize int32)) *MockDatabaseRepository_FindUserHistoryResponsesOrderByUpdatedAt_Call {
+// FindUsersByIds provides a mock function with given fields: ctx, userIds
+func (_m *MockDatabaseRepository) FindUsersByIds(ctx context.Context, userIds []string) ([]model.User, error) {
+	ret := _m.Called(ctx, userIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUsersByIds")
+	}
+
+	var r0 []model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]model.User, error)); ok {
+		return rf(ctx, userIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []model.User); ok {
+		r0 = rf(ctx, userIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, userIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindUsersByIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUsersByIds'
+type MockDatabaseRepository_FindUsersByIds_Call struct {
+	*mock.Call
+}
+
+// FindUsersByIds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIds []string
+func (_e *MockDatabaseRepository_Expecter) FindUsersByIds(ctx interface{}, userIds interface{}) *MockDatabaseRepository_FindUsersByIds_Call {
+	return &MockDatabaseRepository_FindUsersByIds_Call{Call: _e.mock.On("FindUsersByIds", ctx, userIds)}
+}
+
+func (_c *MockDatabaseRepository_FindUsersByIds_Call) Run(run func(ctx context.Context, userIds []string)) *MockDatabaseRepository_FindUsersByIds_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32), args[2].(int32))
+		run(args[0].(context.Context), args[1].([]string))
 	})
 	return _c
 }
 
-func (_c *MockDatabaseRepository_FindUserHistoryResponsesOrderByUpdatedAt_Call) Return(userHistoryResponses []UserHistoryResponse, err error) *MockDatabaseRepository_FindUserHistoryResponsesOrderByUpdatedAt_Call {
-	_c.Call.Return(userHistoryResponses, err)
+func (_c *MockDatabaseRepository_FindUsersByIds_Call) Return(users []model.User, err error) *MockDatabaseRepository_FindUsersByIds_Call {
+	_c.Call.Return(users, err)
 	return _c
 }
 
-func (_c *MockDatabaseRepository_FindUserHistoryResponsesOrderByUpdatedAt_Call) RunAndReturn(run func(context.Context, int32, int32) ([]UserHistoryResponse, error)) *MockDatabaseRepository_FindUserHistoryResponsesOrderByUpdatedAt_Call {
+func (_c *MockDatabaseRepository_FindUsersByIds_Call) RunAndReturn(run func(context.Context, []string) ([]model.User, error)) *MockDatabaseRepository_FindUsersByIds_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -521,6 +646,54 @@ func (_c *MockDatabaseRepository_GetUserByUsername_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// UpdateUserHideFromLeaderboard provides a mock function with given fields: ctx, userId, hide
+func (_m *MockDatabaseRepository) UpdateUserHideFromLeaderboard(ctx context.Context, userId string, hide bool) error {
+	ret := _m.Called(ctx, userId, hide)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserHideFromLeaderboard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, userId, hide)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatabaseRepository_UpdateUserHideFromLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserHideFromLeaderboard'
+type MockDatabaseRepository_UpdateUserHideFromLeaderboard_Call struct {
+	*mock.Call
+}
+
+// UpdateUserHideFromLeaderboard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - hide bool
+func (_e *MockDatabaseRepository_Expecter) UpdateUserHideFromLeaderboard(ctx interface{}, userId interface{}, hide interface{}) *MockDatabaseRepository_UpdateUserHideFromLeaderboard_Call {
+	return &MockDatabaseRepository_UpdateUserHideFromLeaderboard_Call{Call: _e.mock.On("UpdateUserHideFromLeaderboard", ctx, userId, hide)}
+}
+
+func (_c *MockDatabaseRepository_UpdateUserHideFromLeaderboard_Call) Run(run func(ctx context.Context, userId string, hide bool)) *MockDatabaseRepository_UpdateUserHideFromLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_UpdateUserHideFromLeaderboard_Call) Return(_a0 error) *MockDatabaseRepository_UpdateUserHideFromLeaderboard_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_UpdateUserHideFromLeaderboard_Call) RunAndReturn(run func(context.Context, string, bool) error) *MockDatabaseRepository_UpdateUserHideFromLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithTransaction provides a mock function with given fields: ctx, transactoinFunc
 func (_m *MockDatabaseRepository) WithTransaction(ctx context.Context, transactoinFunc transactionFunc) (interface{}, error) {
 	ret := _m.Called(ctx, transactoinFunc)
@@ -153,12 +153,117 @@ func (repo *MongoDBRepository) GetAdminUser(
 	return &result, nil
 }
 
+func (repo *MongoDBRepository) FindUsersByIds(
+	ctx context.Context,
+	userIds []string,
+) (users []model.User, err error) {
+	ids := []primitive.ObjectID{}
+
+	for _, userId := range userIds {
+		id, err := primitive.ObjectIDFromHex(userId)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	filter := bson.D{
+		{"_id", bson.D{{"$in", ids}}},
+	}
+	collection := repo.getCollection(USER_COLLECTION)
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	users = []model.User{}
+	if err = cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (repo *MongoDBRepository) UpdateUserHideFromLeaderboard(
+	ctx context.Context,
+	userId string,
+	hide bool,
+) error {
+	id, err := primitive.ObjectIDFromHex(userId)
+	if err != nil {
+		return err
+	}
+
+	filter := bson.D{
+		{"_id", id},
+	}
+	update := bson.D{
+		{"$set", bson.D{
+			{"hideFromLeaderboard", hide},
+			{"updatedAt", time.Now()},
+		}},
+	}
+	collection := repo.getCollection(USER_COLLECTION)
+	_, err = collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+// 查詢設定不顯示在排行榜的使用者 id
+func (repo *MongoDBRepository) FindUserIdsByHideFromLeaderboard(
+	ctx context.Context,
+) (userIds []string, err error) {
+	filter := bson.D{
+		{"hideFromLeaderboard", true},
+	}
+	opts := options.Find().SetProjection(bson.D{{"_id", 1}})
+	collection := repo.getCollection(USER_COLLECTION)
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	users := []model.User{}
+	if err = cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+
+	userIds = []string{}
+
+	for _, user := range users {
+		userIds = append(userIds, user.Id.Hex())
+	}
+
+	return userIds, nil
+}
+
 func (repo *MongoDBRepository) FindUserHistoryResponsesOrderByUpdatedAt(
-	ctx context.Context, pageIndex, pageSize int32,
-) (userHistoryResponses []UserHistoryResponse, err error) {
-	sortStage := bson.D{{"$sort", bson.D{{"updatedAt", -1}}}}
-	skipStage := bson.D{{"$skip", pageIndex * pageSize}}
-	limitStage := bson.D{{"$limit", pageSize}}
+	ctx context.Context, cursor string, pageIndex, pageSize int32,
+) (userHistoryResponses []UserHistoryResponse, nextCursor string, err error) {
+	if pageSize <= 0 {
+		return nil, "", fmt.Errorf("Invalid pageSize: %d", pageSize)
+	}
+
+	match := bson.D{}
+	skip := pageIndex * pageSize
+
+	// 有 cursor 時從 cursor 之後開始查詢，忽略 pageIndex
+	if cursor != "" {
+		afterCursor, err := afterCursorFilter(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+
+		match = append(match, afterCursor)
+		skip = 0
+	}
+
+	matchStage := bson.D{{"$match", match}}
+	sortStage := bson.D{{"$sort", pageSort}}
+	skipStage := bson.D{{"$skip", skip}}
+
+	// 多查一筆用來判斷是否還有下一頁
+	limitStage := bson.D{{"$limit", pageSize + 1}}
 
 	// 在 addFields 階段判斷 userId 是否為空字串
 	addUserObjectIdStage := bson.D{{
@@ -209,9 +314,10 @@ func (repo *MongoDBRepository) FindUserHistoryResponsesOrderByUpdatedAt(
 	collection := repo.getCollection(USER_HISTORY_COLLECTION)
 
 	// pass the pipeline to the Aggregate() method
-	cursor, err := collection.Aggregate(
+	aggregateCursor, err := collection.Aggregate(
 		ctx,
 		mongo.Pipeline{
+			matchStage,
 			sortStage,
 			skipStage,
 			limitStage,
@@ -223,11 +329,22 @@ func (repo *MongoDBRepository) FindUserHistoryResponsesOrderByUpdatedAt(
 		},
 	)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	if err = cursor.All(ctx, &userHistoryResponses); err != nil {
-		return nil, err
+	if err = aggregateCursor.All(ctx, &userHistoryResponses); err != nil {
+		return nil, "", err
+	}
+
+	if len(userHistoryResponses) > int(pageSize) {
+		userHistoryResponses = userHistoryResponses[:pageSize]
+		lastUserHistoryResponse := userHistoryResponses[pageSize-1]
+		id, err := primitive.ObjectIDFromHex(lastUserHistoryResponse.Id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		nextCursor = EncodeCursor(lastUserHistoryResponse.UpdatedAt, id)
 	}
 
 	// Empty slice 轉成前端 JSON 是空陣列
@@ -237,7 +354,7 @@ func (repo *MongoDBRepository) FindUserHistoryResponsesOrderByUpdatedAt(
 		userHistoryResponses = []UserHistoryResponse{}
 	}
 
-	return userHistoryResponses, nil
+	return userHistoryResponses, nextCursor, nil
 }
 
 func (repo *MongoDBRepository) CountUserHistories(ctx context.Context) (count int32, err error) {
@@ -186,6 +186,77 @@ func (s *MyTestSuite) TestGetAdminUser() {
 	s.NotNil(user)
 }
 
+func (s *MyTestSuite) TestFindUsersByIds() {
+	ctx := context.Background()
+
+	result, err := s.userCollection.InsertMany(ctx, []interface{}{
+		model.User{
+			Username: "TestFindUsersByIds01",
+			Password: "TestFindUsersByIds01",
+			Role:     "user",
+		},
+		model.User{
+			Username: "TestFindUsersByIds02",
+			Password: "TestFindUsersByIds02",
+			Role:     "user",
+		},
+	})
+	s.Nil(err)
+
+	userIds := []string{}
+
+	for _, insertedID := range result.InsertedIDs {
+		userIds = append(userIds, insertedID.(primitive.ObjectID).Hex())
+	}
+
+	users, err := s.repo.FindUsersByIds(ctx, userIds)
+	s.Nil(err)
+	s.Len(users, 2)
+}
+
+func (s *MyTestSuite) TestUpdateUserHideFromLeaderboard() {
+	ctx := context.Background()
+
+	result, err := s.userCollection.InsertOne(ctx, model.User{
+		Username: "TestUpdateUserHideFromLeaderboard",
+		Password: "TestUpdateUserHideFromLeaderboard",
+		Role:     "user",
+	})
+	s.Nil(err)
+
+	userId := result.InsertedID.(primitive.ObjectID).Hex()
+	err = s.repo.UpdateUserHideFromLeaderboard(ctx, userId, true)
+	s.Nil(err)
+
+	user, err := s.repo.GetUserById(ctx, userId)
+	s.Nil(err)
+	s.True(user.HideFromLeaderboard)
+}
+
+func (s *MyTestSuite) TestFindUserIdsByHideFromLeaderboard() {
+	ctx := context.Background()
+
+	result, err := s.userCollection.InsertMany(ctx, []interface{}{
+		model.User{
+			Username:            "TestFindUserIdsByHideFromLeaderboard01",
+			Password:            "TestFindUserIdsByHideFromLeaderboard01",
+			Role:                "user",
+			HideFromLeaderboard: true,
+		},
+		model.User{
+			Username: "TestFindUserIdsByHideFromLeaderboard02",
+			Password: "TestFindUserIdsByHideFromLeaderboard02",
+			Role:     "user",
+		},
+	})
+	s.Nil(err)
+
+	userIds, err := s.repo.FindUserIdsByHideFromLeaderboard(ctx)
+	s.Nil(err)
+	s.Contains(userIds, result.InsertedIDs[0].(primitive.ObjectID).Hex())
+	s.NotContains(userIds, result.InsertedIDs[1].(primitive.ObjectID).Hex())
+}
+
 func (s *MyTestSuite) TestFindUserHistoryResponsesOrderByUpdatedAt() {
 	ctx := context.Background()
 
@@ -219,13 +290,49 @@ func (s *MyTestSuite) TestFindUserHistoryResponsesOrderByUpdatedAt() {
 		s.Nil(err)
 	}
 
-	userHistoryResponses, err := s.repo.FindUserHistoryResponsesOrderByUpdatedAt(
+	userHistoryResponses, nextCursor, err := s.repo.FindUserHistoryResponsesOrderByUpdatedAt(
 		ctx,
+		"",
 		0,
 		10,
 	)
 	s.Nil(err)
 	s.Len(userHistoryResponses, 10)
+	s.Empty(nextCursor)
+
+	// 依 cursor 逐頁查詢，資料不可重複或遺漏；updatedAt 都相同，由 _id 決定順序
+	ids := map[string]bool{}
+	cursor := ""
+	pageCount := 0
+
+	for pageCount < size {
+		userHistoryResponses, nextCursor, err := s.repo.FindUserHistoryResponsesOrderByUpdatedAt(
+			ctx,
+			cursor,
+			0,
+			4,
+		)
+		s.Nil(err)
+		pageCount++
+
+		for _, userHistoryResponse := range userHistoryResponses {
+			s.False(ids[userHistoryResponse.Id])
+			ids[userHistoryResponse.Id] = true
+		}
+
+		if nextCursor == "" {
+			break
+		}
+
+		cursor = nextCursor
+	}
+
+	s.Len(ids, size)
+	s.Equal(3, pageCount)
+
+	// 不合法的 cursor
+	_, _, err = s.repo.FindUserHistoryResponsesOrderByUpdatedAt(ctx, "invalid cursor", 0, 4)
+	s.NotNil(err)
 }
 
 func (s *MyTestSuite) TestCountUserHistories() {
@@ -11,7 +11,10 @@ import (
 	"github.com/kakurineuin/learn-english-microservices/web-service/pb"
 )
 
-const KEY_WORD_MEANING = "word-meaning:"
+const (
+	KEY_WORD_MEANING = "word-meaning:"
+	KEY_LEADERBOARD  = "leaderboard:"
+)
 
 type RedisRepository struct {
 	client *redis.Client
@@ -86,3 +89,55 @@ func (repo *RedisRepository) FindWordMeanings(
 
 	return wordMeanings, nil
 }
+
+/*
+排行榜依 ExamService 排好的名次順序存成一個 JSON 陣列，
+名次的排序條件不只分數，所以不使用 sorted set
+*/
+func (repo *RedisRepository) CreateLeaderboard(
+	ctx context.Context,
+	key string,
+	leaderboardEntries []*pb.LeaderboardEntry,
+	expiration time.Duration,
+) error {
+	bytes, err := json.Marshal(leaderboardEntries)
+	if err != nil {
+		return err
+	}
+
+	fullKey := KEY_LEADERBOARD + key
+	_, err = repo.client.Set(ctx, fullKey, bytes, expiration).Result()
+	return err
+}
+
+func (repo *RedisRepository) FindLeaderboard(
+	ctx context.Context,
+	key string,
+) (leaderboardEntries []*pb.LeaderboardEntry, err error) {
+	data, err := repo.client.Get(ctx, KEY_LEADERBOARD+key).Result()
+	if err != nil {
+
+		// Key 不存在
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	err = json.Unmarshal([]byte(data), &leaderboardEntries)
+	if err != nil {
+		return nil, err
+	}
+
+	return leaderboardEntries, nil
+}
+
+func (repo *RedisRepository) DeleteLeaderboards(ctx context.Context, keys []string) error {
+	fullKeys := []string{}
+
+	for _, key := range keys {
+		fullKeys = append(fullKeys, KEY_LEADERBOARD+key)
+	}
+
+	return repo.client.Del(ctx, fullKeys...).Err()
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/testcontainers/testcontainers-go"
 	tcredis "github.com/testcontainers/testcontainers-go/modules/redis"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/kakurineuin/learn-english-microservices/web-service/pb"
 )
@@ -146,3 +147,49 @@ func (s *RedisRepositoryTestSuite) TestFindWordMeanings() {
 	s.Nil(err)
 	s.Len(wordMeanings, 2)
 }
+
+func (s *RedisRepositoryTestSuite) TestCreateLeaderboardAndFindLeaderboard() {
+	ctx := context.Background()
+
+	// 同分的項目也要保持 ExamService 排好的名次順序
+	now := time.Now()
+	leaderboardEntries := []*pb.LeaderboardEntry{
+		{
+			UserId:          "user03",
+			Score:           9,
+			Percentage:      90,
+			DurationSeconds: 60,
+			CreatedAt:       timestamppb.New(now),
+		},
+		{
+			UserId:          "user02",
+			Score:           9,
+			Percentage:      90,
+			DurationSeconds: 90,
+			CreatedAt:       timestamppb.New(now.Add(-time.Hour)),
+		},
+		{
+			UserId:     "user01",
+			Score:      8,
+			Percentage: 80,
+			CreatedAt:  timestamppb.New(now),
+		},
+	}
+	key := "exam01:all_time"
+	err := s.repo.CreateLeaderboard(ctx, key, leaderboardEntries, 5*time.Minute)
+	s.Nil(err)
+
+	result, err := s.repo.FindLeaderboard(ctx, key)
+	s.Nil(err)
+	s.Len(result, 3)
+	s.Equal("user03", result[0].UserId)
+	s.Equal("user02", result[1].UserId)
+	s.Equal("user01", result[2].UserId)
+
+	err = s.repo.DeleteLeaderboards(ctx, []string{key})
+	s.Nil(err)
+
+	result, err = s.repo.FindLeaderboard(ctx, key)
+	s.Nil(err)
+	s.Nil(result)
+}
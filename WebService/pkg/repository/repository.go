@@ -34,11 +34,14 @@ type DatabaseRepository interface {
 	GetUserById(ctx context.Context, userId string) (user *model.User, err error)
 	GetUserByUsername(ctx context.Context, username string) (user *model.User, err error)
 	GetAdminUser(ctx context.Context) (user *model.User, err error)
+	FindUsersByIds(ctx context.Context, userIds []string) (users []model.User, err error)
+	UpdateUserHideFromLeaderboard(ctx context.Context, userId string, hide bool) error
+	FindUserIdsByHideFromLeaderboard(ctx context.Context) (userIds []string, err error)
 
 	// UserHistory
 	FindUserHistoryResponsesOrderByUpdatedAt(
-		ctx context.Context, pageIndex, pageSize int32,
-	) (userHistoryResponses []UserHistoryResponse, err error)
+		ctx context.Context, cursor string, pageIndex, pageSize int32,
+	) (userHistoryResponses []UserHistoryResponse, nextCursor string, err error)
 	CountUserHistories(ctx context.Context) (count int32, err error)
 	CreateUserHistory(
 		ctx context.Context,
@@ -59,4 +62,17 @@ type CacheRepository interface {
 		expiration time.Duration,
 	) error
 	FindWordMeanings(ctx context.Context, key string) (wordMeanings []*pb.WordMeaning, err error)
+
+	// Leaderboard
+	CreateLeaderboard(
+		ctx context.Context,
+		key string,
+		leaderboardEntries []*pb.LeaderboardEntry,
+		expiration time.Duration,
+	) error
+	FindLeaderboard(
+		ctx context.Context,
+		key string,
+	) (leaderboardEntries []*pb.LeaderboardEntry, err error)
+	DeleteLeaderboards(ctx context.Context, keys []string) error
 }
@@ -3,6 +3,7 @@ package util
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -22,6 +23,20 @@ type JwtCustomClaims struct {
 	jwt.RegisteredClaims
 }
 
+/*
+取得已解碼的 path 參數，echo 在網址含有非預設編碼的字元時（例如 %27）以 RawPath 比對路由，
+此時參數仍是編碼過的值，需要解碼一次；其他情況參數已經解碼過，不能再解碼
+*/
+func PathParam(c echo.Context, name string) (string, error) {
+	value := c.Param(name)
+
+	if c.Request().URL.RawPath == "" {
+		return value, nil
+	}
+
+	return url.PathUnescape(value)
+}
+
 func GetJWTClaims(c echo.Context) *JwtCustomClaims {
 	user := c.Get("user")
 
@@ -64,6 +79,20 @@ func SendJSONBadRequest(c echo.Context) error {
 	})
 }
 
+// 修改的資料在讀取後已被其他人修改
+func SendJSONConflict(c echo.Context) error {
+	return c.JSON(http.StatusConflict, echo.Map{
+		"message": "資料已被其他人修改，請重新載入後再試！",
+	})
+}
+
+// 已達測驗的作答次數上限
+func SendJSONForbidden(c echo.Context) error {
+	return c.JSON(http.StatusForbidden, echo.Map{
+		"message": "已達作答次數上限！",
+	})
+}
+
 func SendJSONInternalServerError(c echo.Context) error {
 	return c.JSON(http.StatusInternalServerError, echo.Map{
 		"message": "系統發生錯誤！",
@@ -27,6 +27,13 @@ type WordServiceClient interface {
 	DeleteFavoriteWordMeaning(ctx context.Context, in *DeleteFavoriteWordMeaningRequest, opts ...grpc.CallOption) (*DeleteFavoriteWordMeaningResponse, error)
 	FindFavoriteWordMeanings(ctx context.Context, in *FindFavoriteWordMeaningsRequest, opts ...grpc.CallOption) (*FindFavoriteWordMeaningsResponse, error)
 	FindRandomFavoriteWordMeanings(ctx context.Context, in *FindRandomFavoriteWordMeaningsRequest, opts ...grpc.CallOption) (*FindRandomFavoriteWordMeaningsResponse, error)
+	FindFavoriteWordMeaningsByIds(ctx context.Context, in *FindFavoriteWordMeaningsByIdsRequest, opts ...grpc.CallOption) (*FindFavoriteWordMeaningsByIdsResponse, error)
+	MarkFavoriteWordMeaningsReviewed(ctx context.Context, in *MarkFavoriteWordMeaningsReviewedRequest, opts ...grpc.CallOption) (*MarkFavoriteWordMeaningsReviewedResponse, error)
+	FindRecentLookups(ctx context.Context, in *FindRecentLookupsRequest, opts ...grpc.CallOption) (*FindRecentLookupsResponse, error)
+	ClearLookupHistory(ctx context.Context, in *ClearLookupHistoryRequest, opts ...grpc.CallOption) (*ClearLookupHistoryResponse, error)
+	FindDictationSentences(ctx context.Context, in *FindDictationSentencesRequest, opts ...grpc.CallOption) (*FindDictationSentencesResponse, error)
+	GradeDictation(ctx context.Context, in *GradeDictationRequest, opts ...grpc.CallOption) (*GradeDictationResponse, error)
+	FindDictationHistory(ctx context.Context, in *FindDictationHistoryRequest, opts ...grpc.CallOption) (*FindDictationHistoryResponse, error)
 }
 
 type wordServiceClient struct {
@@ -82,6 +89,69 @@ func (c *wordServiceClient) FindRandomFavoriteWordMeanings(ctx context.Context,
 	return out, nil
 }
 
+func (c *wordServiceClient) FindFavoriteWordMeaningsByIds(ctx context.Context, in *FindFavoriteWordMeaningsByIdsRequest, opts ...grpc.CallOption) (*FindFavoriteWordMeaningsByIdsResponse, error) {
+	out := new(FindFavoriteWordMeaningsByIdsResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/FindFavoriteWordMeaningsByIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) MarkFavoriteWordMeaningsReviewed(ctx context.Context, in *MarkFavoriteWordMeaningsReviewedRequest, opts ...grpc.CallOption) (*MarkFavoriteWordMeaningsReviewedResponse, error) {
+	out := new(MarkFavoriteWordMeaningsReviewedResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/MarkFavoriteWordMeaningsReviewed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) FindRecentLookups(ctx context.Context, in *FindRecentLookupsRequest, opts ...grpc.CallOption) (*FindRecentLookupsResponse, error) {
+	out := new(FindRecentLookupsResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/FindRecentLookups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) ClearLookupHistory(ctx context.Context, in *ClearLookupHistoryRequest, opts ...grpc.CallOption) (*ClearLookupHistoryResponse, error) {
+	out := new(ClearLookupHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/ClearLookupHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) FindDictationSentences(ctx context.Context, in *FindDictationSentencesRequest, opts ...grpc.CallOption) (*FindDictationSentencesResponse, error) {
+	out := new(FindDictationSentencesResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/FindDictationSentences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) GradeDictation(ctx context.Context, in *GradeDictationRequest, opts ...grpc.CallOption) (*GradeDictationResponse, error) {
+	out := new(GradeDictationResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/GradeDictation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) FindDictationHistory(ctx context.Context, in *FindDictationHistoryRequest, opts ...grpc.CallOption) (*FindDictationHistoryResponse, error) {
+	out := new(FindDictationHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/FindDictationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WordServiceServer is the server API for WordService service.
 // All implementations must embed UnimplementedWordServiceServer
 // for forward compatibility
@@ -91,6 +161,13 @@ type WordServiceServer interface {
 	DeleteFavoriteWordMeaning(context.Context, *DeleteFavoriteWordMeaningRequest) (*DeleteFavoriteWordMeaningResponse, error)
 	FindFavoriteWordMeanings(context.Context, *FindFavoriteWordMeaningsRequest) (*FindFavoriteWordMeaningsResponse, error)
 	FindRandomFavoriteWordMeanings(context.Context, *FindRandomFavoriteWordMeaningsRequest) (*FindRandomFavoriteWordMeaningsResponse, error)
+	FindFavoriteWordMeaningsByIds(context.Context, *FindFavoriteWordMeaningsByIdsRequest) (*FindFavoriteWordMeaningsByIdsResponse, error)
+	MarkFavoriteWordMeaningsReviewed(context.Context, *MarkFavoriteWordMeaningsReviewedRequest) (*MarkFavoriteWordMeaningsReviewedResponse, error)
+	FindRecentLookups(context.Context, *FindRecentLookupsRequest) (*FindRecentLookupsResponse, error)
+	ClearLookupHistory(context.Context, *ClearLookupHistoryRequest) (*ClearLookupHistoryResponse, error)
+	FindDictationSentences(context.Context, *FindDictationSentencesRequest) (*FindDictationSentencesResponse, error)
+	GradeDictation(context.Context, *GradeDictationRequest) (*GradeDictationResponse, error)
+	FindDictationHistory(context.Context, *FindDictationHistoryRequest) (*FindDictationHistoryResponse, error)
 	mustEmbedUnimplementedWordServiceServer()
 }
 
@@ -113,6 +190,27 @@ func (UnimplementedWordServiceServer) FindFavoriteWordMeanings(context.Context,
 func (UnimplementedWordServiceServer) FindRandomFavoriteWordMeanings(context.Context, *FindRandomFavoriteWordMeaningsRequest) (*FindRandomFavoriteWordMeaningsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindRandomFavoriteWordMeanings not implemented")
 }
+func (UnimplementedWordServiceServer) FindFavoriteWordMeaningsByIds(context.Context, *FindFavoriteWordMeaningsByIdsRequest) (*FindFavoriteWordMeaningsByIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindFavoriteWordMeaningsByIds not implemented")
+}
+func (UnimplementedWordServiceServer) MarkFavoriteWordMeaningsReviewed(context.Context, *MarkFavoriteWordMeaningsReviewedRequest) (*MarkFavoriteWordMeaningsReviewedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkFavoriteWordMeaningsReviewed not implemented")
+}
+func (UnimplementedWordServiceServer) FindRecentLookups(context.Context, *FindRecentLookupsRequest) (*FindRecentLookupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindRecentLookups not implemented")
+}
+func (UnimplementedWordServiceServer) ClearLookupHistory(context.Context, *ClearLookupHistoryRequest) (*ClearLookupHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearLookupHistory not implemented")
+}
+func (UnimplementedWordServiceServer) FindDictationSentences(context.Context, *FindDictationSentencesRequest) (*FindDictationSentencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDictationSentences not implemented")
+}
+func (UnimplementedWordServiceServer) GradeDictation(context.Context, *GradeDictationRequest) (*GradeDictationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GradeDictation not implemented")
+}
+func (UnimplementedWordServiceServer) FindDictationHistory(context.Context, *FindDictationHistoryRequest) (*FindDictationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDictationHistory not implemented")
+}
 func (UnimplementedWordServiceServer) mustEmbedUnimplementedWordServiceServer() {}
 
 // UnsafeWordServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -216,6 +314,132 @@ func _WordService_FindRandomFavoriteWordMeanings_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WordService_FindFavoriteWordMeaningsByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindFavoriteWordMeaningsByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).FindFavoriteWordMeaningsByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/FindFavoriteWordMeaningsByIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).FindFavoriteWordMeaningsByIds(ctx, req.(*FindFavoriteWordMeaningsByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_MarkFavoriteWordMeaningsReviewed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkFavoriteWordMeaningsReviewedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).MarkFavoriteWordMeaningsReviewed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/MarkFavoriteWordMeaningsReviewed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).MarkFavoriteWordMeaningsReviewed(ctx, req.(*MarkFavoriteWordMeaningsReviewedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_FindRecentLookups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindRecentLookupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).FindRecentLookups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/FindRecentLookups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).FindRecentLookups(ctx, req.(*FindRecentLookupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_ClearLookupHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearLookupHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).ClearLookupHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/ClearLookupHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).ClearLookupHistory(ctx, req.(*ClearLookupHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_FindDictationSentences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDictationSentencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).FindDictationSentences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/FindDictationSentences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).FindDictationSentences(ctx, req.(*FindDictationSentencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_GradeDictation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradeDictationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).GradeDictation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/GradeDictation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).GradeDictation(ctx, req.(*GradeDictationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_FindDictationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDictationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).FindDictationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/FindDictationHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).FindDictationHistory(ctx, req.(*FindDictationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WordService_ServiceDesc is the grpc.ServiceDesc for WordService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -243,6 +467,34 @@ var WordService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindRandomFavoriteWordMeanings",
 			Handler:    _WordService_FindRandomFavoriteWordMeanings_Handler,
 		},
+		{
+			MethodName: "FindFavoriteWordMeaningsByIds",
+			Handler:    _WordService_FindFavoriteWordMeaningsByIds_Handler,
+		},
+		{
+			MethodName: "MarkFavoriteWordMeaningsReviewed",
+			Handler:    _WordService_MarkFavoriteWordMeaningsReviewed_Handler,
+		},
+		{
+			MethodName: "FindRecentLookups",
+			Handler:    _WordService_FindRecentLookups_Handler,
+		},
+		{
+			MethodName: "ClearLookupHistory",
+			Handler:    _WordService_ClearLookupHistory_Handler,
+		},
+		{
+			MethodName: "FindDictationSentences",
+			Handler:    _WordService_FindDictationSentences_Handler,
+		},
+		{
+			MethodName: "GradeDictation",
+			Handler:    _WordService_GradeDictation_Handler,
+		},
+		{
+			MethodName: "FindDictationHistory",
+			Handler:    _WordService_FindDictationHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "word_service.proto",
@@ -3,6 +3,8 @@ package crawler
 import (
 	"fmt"
 	"log"
+	"net/url"
+	"regexp"
 	"strings"
 
 	"github.com/PuerkitoBio/goquery"
@@ -14,6 +16,15 @@ import (
 
 const LONGMAN_DICTIONARY_DOMAIN = "www.ldoceonline.com"
 
+var (
+	whitespaceRegexp  = regexp.MustCompile(`\s+`)
+	apostropheRegexp  = regexp.MustCompile(`['’‘]`)
+	nonSlugCharRegexp = regexp.MustCompile(`[^a-z0-9]+`)
+
+	// 片語動詞在朗文中常帶有受詞，例如 "look after somebody"
+	expressionObjects = []string{" somebody/something", " something", " somebody", " sth", " sb"}
+)
+
 //go:generate mockery --name Spider
 type Spider interface {
 	FindWordMeaningsFromDictionary(
@@ -27,11 +38,46 @@ func NewSpider() Spider {
 	return &spider{}
 }
 
+/*
+統一查詢單字或片語的格式：轉小寫、去除前後空白、多個空白合併成一個，
+並將彎引號統一成直引號，例如 " Look  Forward To " => "look forward to"
+*/
+func NormalizeWord(word string) string {
+	word = strings.ToLower(strings.TrimSpace(word))
+	word = whitespaceRegexp.ReplaceAllString(word, " ")
+	return apostropheRegexp.ReplaceAllString(word, "'")
+}
+
+/*
+將單字或片語轉成朗文網址使用的格式，
+例如 "look forward to" => "look-forward-to"、"rock 'n' roll" => "rock-n-roll"
+*/
+func ToDictionarySlug(word string) string {
+	slug := apostropheRegexp.ReplaceAllString(NormalizeWord(word), "")
+	slug = nonSlugCharRegexp.ReplaceAllString(slug, "-")
+	return strings.Trim(slug, "-")
+}
+
+// 是否為多個單字組成的片語，例如片語動詞或慣用語
+func IsExpression(word string) bool {
+	return strings.Contains(NormalizeWord(word), " ")
+}
+
 func (mySpider spider) FindWordMeaningsFromDictionary(
 	word string,
 ) ([]model.WordMeaning, error) {
+	word = NormalizeWord(word)
+	slug := ToDictionarySlug(word)
+
+	if slug == "" {
+		return []model.WordMeaning{}, nil
+	}
+
 	wordMeangins := []model.WordMeaning{}
 
+	// 片語動詞或慣用語的解釋，查詢片語時只回傳符合的解釋
+	expressionWordMeanings := []model.WordMeaning{}
+
 	// 排序用的編號
 	var orderByNo int32 = 0
 
@@ -65,17 +111,20 @@ func (mySpider spider) FindWordMeaningsFromDictionary(
 			}
 
 			dictlink := dictentry.DOM.Find("span.dictlink")
-			senses := dictlink.Find("span.Sense:has(span.DEF)")
 
-			if senses.Length() == 0 {
+			// 片語動詞的解釋另外處理
+			senses := dictlink.Find("span.Sense:has(span.DEF)").Not("span.PhrVbEntry span.Sense")
+			phrVbEntries := dictlink.Find("span.PhrVbEntry:has(span.DEF)")
+
+			if senses.Length() == 0 && phrVbEntries.Length() == 0 {
 				return true // continue
 			}
 
-			partOfSpeech := strings.TrimSpace(dictlink.Find("span.Head span.POS").Text())
-			headGram := strings.TrimSpace(dictlink.Find("span.Head span.GRAM").Text())
+			partOfSpeech := strings.TrimSpace(dictlink.Find("span.Head span.POS").First().Text())
+			headGram := strings.TrimSpace(dictlink.Find("span.Head span.GRAM").First().Text())
 
 			// 音標與發音
-			pronText := strings.TrimSpace(dictlink.Find("span.Head span.PronCodes").Text())
+			pronText := strings.TrimSpace(dictlink.Find("span.Head span.PronCodes").First().Text())
 			ukAudioUrl, ukAudioUrlExists := dictlink.Find("span.speaker.brefile").
 				Attr("data-src-mp3")
 			usAudioUrl, usAudioUrlExists := dictlink.Find("span.speaker.amefile").
@@ -85,81 +134,63 @@ func (mySpider spider) FindWordMeaningsFromDictionary(
 				return true // continue
 			}
 
+			pronunciation := model.Pronunciation{
+				Text:       pronText,
+				UkAudioUrl: ukAudioUrl,
+				UsAudioUrl: usAudioUrl,
+			}
+
 			// Find meanings
 			senses.Each(func(senseIndex int, sense *goquery.Selection) {
-				defGram := strings.TrimSpace(sense.Find("span.GRAM").Text())
-				def := sense.Find("span.DEF")
-
-				// 朗文網頁中會在某些單字右上角標注小數字，移除它
-				def.Find("span.REFHOMNUM").Remove()
-				definition := strings.TrimSpace(def.Text())
 				orderByNo += 1
+				wordMeaning := parseSense(sense, pageTitleWord, word)
+				wordMeaning.PartOfSpeech = partOfSpeech
+				wordMeaning.Gram = headGram
+				wordMeaning.Pronunciation = pronunciation
+				wordMeaning.OrderByNo = orderByNo
+				wordMeangins = append(wordMeangins, wordMeaning)
 
-				var queryByWords []string
-				if pageTitleWord == word {
-					queryByWords = []string{word}
-				} else {
-					queryByWords = []string{pageTitleWord, word}
+				// 慣用語，例如 break the ice
+				if isMatchedExpression(wordMeaning.Word, word) {
+					expressionWordMeanings = append(expressionWordMeanings, wordMeaning)
 				}
+			})
 
-				wordMeaning := model.WordMeaning{
-					Word:         pageTitleWord,
-					PartOfSpeech: partOfSpeech,
-					Gram:         headGram,
-					Pronunciation: model.Pronunciation{
-						Text:       pronText,
-						UkAudioUrl: ukAudioUrl,
-						UsAudioUrl: usAudioUrl,
-					},
-					DefGram:      defGram,
-					Definition:   definition,
-					Examples:     []model.Example{},
-					OrderByNo:    orderByNo,
-					QueryByWords: queryByWords,
+			// Find phrasal verb meanings，例如 look forward to
+			phrVbEntries.Each(func(phrVbEntryIndex int, phrVbEntry *goquery.Selection) {
+				phrasalVerb := strings.TrimSpace(
+					phrVbEntry.Find("span.Head span.PHRVBHWD").First().Text(),
+				)
+				phrasalVerb = whitespaceRegexp.ReplaceAllString(phrasalVerb, " ")
+
+				if phrasalVerb == "" {
+					return
 				}
 
-				// Find examples
-				sense.ChildrenFiltered("span.GramExa, span.EXAMPLE").
-					Each(func(childIndex int, child *goquery.Selection) {
-						var example model.Example
-						pattern := strings.TrimSpace(
-							child.Find("span.PROPFORMPREP, span.PROPFORM").Text(),
+				phrasalVerbPronunciation := model.Pronunciation{}
+				phrasalVerbUkAudioUrl, _ := phrVbEntry.Find("span.Head span.speaker.brefile").
+					Attr("data-src-mp3")
+				phrasalVerbUsAudioUrl, _ := phrVbEntry.Find("span.Head span.speaker.amefile").
+					Attr("data-src-mp3")
+				phrasalVerbPronunciation.UkAudioUrl = phrasalVerbUkAudioUrl
+				phrasalVerbPronunciation.UsAudioUrl = phrasalVerbUsAudioUrl
+
+				phrVbEntry.Find("span.Sense:has(span.DEF)").
+					Each(func(senseIndex int, sense *goquery.Selection) {
+						orderByNo += 1
+						wordMeaning := parseSense(sense, phrasalVerb, word, pageTitleWord)
+						wordMeaning.PartOfSpeech = "phrasal verb"
+						wordMeaning.Gram = strings.TrimSpace(
+							phrVbEntry.Find("span.Head span.GRAM").First().Text(),
 						)
+						wordMeaning.Pronunciation = phrasalVerbPronunciation
+						wordMeaning.OrderByNo = orderByNo
+						wordMeangins = append(wordMeangins, wordMeaning)
 
-						if child.Is(".GramExa") {
-							example = model.Example{
-								Pattern:  pattern,
-								Examples: []model.Sentence{},
-							}
-
-							child.Find("span.EXAMPLE").
-								Each(func(gramExaExampleIndex int, gramExaExample *goquery.Selection) {
-									audioUrl, _ := gramExaExample.Find("span[data-src-mp3]").
-										Attr("data-src-mp3")
-									text := strings.TrimSpace(gramExaExample.Text())
-									example.Examples = append(example.Examples, model.Sentence{
-										AudioUrl: audioUrl,
-										Text:     text,
-									})
-								})
-
-						} else {
-							audioUrl, _ := child.Find("span[data-src-mp3]").Attr("data-src-mp3")
-							example = model.Example{
-								Pattern: "",
-								Examples: []model.Sentence{
-									{
-										AudioUrl: audioUrl,
-										Text:     strings.TrimSpace(child.Text()),
-									},
-								},
-							}
+						if isMatchedExpression(wordMeaning.Word, word) {
+							expressionWordMeanings = append(expressionWordMeanings, wordMeaning)
 						}
-
-						wordMeaning.Examples = append(wordMeaning.Examples, example)
 					})
-
-				wordMeangins = append(wordMeangins, wordMeaning)
 			})
 
 			return true
@@ -167,11 +198,162 @@ func (mySpider spider) FindWordMeaningsFromDictionary(
 	})
 
 	// Start scraping
-	c.Visit(fmt.Sprintf("https://%s/dictionary/%s", LONGMAN_DICTIONARY_DOMAIN, word))
+	c.Visit(fmt.Sprintf(
+		"https://%s/dictionary/%s",
+		LONGMAN_DICTIONARY_DOMAIN,
+		url.PathEscape(slug),
+	))
 
 	if parseHtmlErr != nil {
 		return nil, parseHtmlErr
 	}
 
+	// 查詢片語時，朗文可能會導向到主要單字的頁面，例如 break the ice => ice，
+	// 此時只回傳該片語的解釋，避免把整頁單字的解釋都存到此片語底下
+	if IsExpression(word) && len(expressionWordMeanings) > 0 {
+		return withoutPageQueryByWords(expressionWordMeanings, word), nil
+	}
+
 	return wordMeangins, nil
 }
+
+/*
+解析一個 span.Sense 的解釋與例句，
+若此解釋屬於慣用語（span.LEXUNIT），則以慣用語作為單字
+*/
+func parseSense(
+	sense *goquery.Selection,
+	headword string,
+	otherQueryByWords ...string,
+) model.WordMeaning {
+	word := headword
+	lexUnit := strings.TrimSpace(sense.Find("span.LEXUNIT").First().Text())
+
+	if lexUnit != "" {
+		word = whitespaceRegexp.ReplaceAllString(lexUnit, " ")
+	}
+
+	defGram := strings.TrimSpace(sense.Find("span.GRAM").Text())
+	def := sense.Find("span.DEF")
+
+	// 朗文網頁中會在某些單字右上角標注小數字，移除它
+	def.Find("span.REFHOMNUM").Remove()
+	definition := strings.TrimSpace(def.Text())
+
+	wordMeaning := model.WordMeaning{
+		Word:         word,
+		DefGram:      defGram,
+		Definition:   definition,
+		Examples:     []model.Example{},
+		QueryByWords: toQueryByWords(append([]string{word, headword}, otherQueryByWords...)...),
+	}
+
+	// Find examples
+	sense.ChildrenFiltered("span.GramExa, span.EXAMPLE").
+		Each(func(childIndex int, child *goquery.Selection) {
+			var example model.Example
+			pattern := strings.TrimSpace(
+				child.Find("span.PROPFORMPREP, span.PROPFORM").Text(),
+			)
+
+			if child.Is(".GramExa") {
+				example = model.Example{
+					Pattern:  pattern,
+					Examples: []model.Sentence{},
+				}
+
+				child.Find("span.EXAMPLE").
+					Each(func(gramExaExampleIndex int, gramExaExample *goquery.Selection) {
+						audioUrl, _ := gramExaExample.Find("span[data-src-mp3]").
+							Attr("data-src-mp3")
+						text := strings.TrimSpace(gramExaExample.Text())
+						example.Examples = append(example.Examples, model.Sentence{
+							AudioUrl: audioUrl,
+							Text:     text,
+						})
+					})
+
+			} else {
+				audioUrl, _ := child.Find("span[data-src-mp3]").Attr("data-src-mp3")
+				example = model.Example{
+					Pattern: "",
+					Examples: []model.Sentence{
+						{
+							AudioUrl: audioUrl,
+							Text:     strings.TrimSpace(child.Text()),
+						},
+					},
+				}
+			}
+
+			wordMeaning.Examples = append(wordMeaning.Examples, example)
+		})
+
+	return wordMeaning
+}
+
+/*
+產生可用來查詢此解釋的單字清單，
+片語會同時保存完整片語與去除受詞後的形式，例如 "look forward to something" => "look forward to"
+*/
+func toQueryByWords(words ...string) []string {
+	queryByWords := []string{}
+
+	add := func(word string) {
+		if word == "" {
+			return
+		}
+
+		for _, queryByWord := range queryByWords {
+			if queryByWord == word {
+				return
+			}
+		}
+
+		queryByWords = append(queryByWords, word)
+	}
+
+	for _, word := range words {
+		word = NormalizeWord(word)
+		add(word)
+
+		for _, object := range expressionObjects {
+			if strings.HasSuffix(word, object) {
+				add(strings.TrimSuffix(word, object))
+				break
+			}
+		}
+	}
+
+	return queryByWords
+}
+
+// 片語解釋是否符合查詢的片語
+/*
+只保留片語的解釋時，這些解釋不能再用頁面的主要單字查詢，
+否則之後查詢主要單字時只會找到片語的解釋，例如 break the ice 不能用 ice 查詢
+*/
+func withoutPageQueryByWords(
+	wordMeanings []model.WordMeaning,
+	word string,
+) []model.WordMeaning {
+	for i, wordMeaning := range wordMeanings {
+		wordMeanings[i].QueryByWords = toQueryByWords(wordMeaning.Word, word)
+	}
+
+	return wordMeanings
+}
+
+func isMatchedExpression(expression, word string) bool {
+	if !IsExpression(word) {
+		return false
+	}
+
+	for _, queryByWord := range toQueryByWords(expression) {
+		if queryByWord == word {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,121 @@
+package crawler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kakurineuin/learn-english-microservices/word-service/pkg/model"
+)
+
+type MyTestSuite struct {
+	suite.Suite
+}
+
+func TestMyTestSuite(t *testing.T) {
+	suite.Run(t, new(MyTestSuite))
+}
+
+func (s *MyTestSuite) TestNormalizeWord() {
+	testCases := []struct {
+		name     string
+		word     string
+		expected string
+	}{
+		{name: "Single word", word: "Test", expected: "test"},
+		{name: "Phrasal verb", word: "  Look  Forward\tTo ", expected: "look forward to"},
+		{name: "Curly apostrophe", word: "Rock ’n’ Roll", expected: "rock 'n' roll"},
+		{name: "Blank", word: "   ", expected: ""},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Equal(tc.expected, NormalizeWord(tc.word))
+		})
+	}
+}
+
+func (s *MyTestSuite) TestToDictionarySlug() {
+	testCases := []struct {
+		name     string
+		word     string
+		expected string
+	}{
+		{name: "Single word", word: "Test", expected: "test"},
+		{name: "Phrasal verb", word: "look forward to", expected: "look-forward-to"},
+		{name: "Idiom", word: "Break the ice", expected: "break-the-ice"},
+		{name: "Apostrophe", word: "rock 'n' roll", expected: "rock-n-roll"},
+		{name: "Unsafe characters", word: "../ice?x=1", expected: "ice-x-1"},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Equal(tc.expected, ToDictionarySlug(tc.word))
+		})
+	}
+}
+
+func (s *MyTestSuite) TestToQueryByWords() {
+	testCases := []struct {
+		name     string
+		words    []string
+		expected []string
+	}{
+		{
+			name:     "Same word",
+			words:    []string{"test", "Test"},
+			expected: []string{"test"},
+		},
+		{
+			name:     "Page title and query",
+			words:    []string{"test", "tests"},
+			expected: []string{"test", "tests"},
+		},
+		{
+			name:     "Phrasal verb with object",
+			words:    []string{"look forward to something", "look forward to", "look"},
+			expected: []string{"look forward to something", "look forward to", "look"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Equal(tc.expected, toQueryByWords(tc.words...))
+		})
+	}
+}
+
+func (s *MyTestSuite) TestWithoutPageQueryByWords() {
+	testCases := []struct {
+		name        string
+		word        string
+		wordMeaning model.WordMeaning
+		expected    []string
+	}{
+		{
+			name: "Idiom on page of main word",
+			word: "break the ice",
+			wordMeaning: model.WordMeaning{
+				Word:         "break the ice",
+				QueryByWords: []string{"break the ice", "ice"},
+			},
+			expected: []string{"break the ice"},
+		},
+		{
+			name: "Phrasal verb with object",
+			word: "look forward to",
+			wordMeaning: model.WordMeaning{
+				Word:         "look forward to something",
+				QueryByWords: []string{"look forward to something", "look forward to", "look"},
+			},
+			expected: []string{"look forward to something", "look forward to"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			result := withoutPageQueryByWords([]model.WordMeaning{tc.wordMeaning}, tc.word)
+			s.Equal(tc.expected, result[0].QueryByWords)
+		})
+	}
+}
@@ -16,11 +16,18 @@ import (
 )
 
 type Endpoints struct {
-	FindWordByDictionary           endpoint.Endpoint
-	CreateFavoriteWordMeaning      endpoint.Endpoint
-	DeleteFavoriteWordMeaning      endpoint.Endpoint
-	FindFavoriteWordMeanings       endpoint.Endpoint
-	FindRandomFavoriteWordMeanings endpoint.Endpoint
+	FindWordByDictionary             endpoint.Endpoint
+	CreateFavoriteWordMeaning        endpoint.Endpoint
+	DeleteFavoriteWordMeaning        endpoint.Endpoint
+	FindFavoriteWordMeanings         endpoint.Endpoint
+	FindRandomFavoriteWordMeanings   endpoint.Endpoint
+	FindFavoriteWordMeaningsByIds    endpoint.Endpoint
+	MarkFavoriteWordMeaningsReviewed endpoint.Endpoint
+	FindRecentLookups                endpoint.Endpoint
+	ClearLookupHistory               endpoint.Endpoint
+	FindDictationSentences           endpoint.Endpoint
+	GradeDictation                   endpoint.Endpoint
+	FindDictationHistory             endpoint.Endpoint
 }
 
 // MakeAddEndpoint struct holds the endpoint response definition
@@ -180,12 +187,254 @@ func MakeEndpoints(wordService service.WordService, logger log.Logger) Endpoints
 		)
 	}
 
+	var findFavoriteWordMeaningsByIdsEndpoint endpoint.Endpoint
+	{
+		findFavoriteWordMeaningsByIdsEndpoint = makeFindFavoriteWordMeaningsByIdsEndpoint(
+			wordService,
+		)
+		findFavoriteWordMeaningsByIdsEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			findFavoriteWordMeaningsByIdsEndpoint,
+		)
+		findFavoriteWordMeaningsByIdsEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			findFavoriteWordMeaningsByIdsEndpoint,
+		)
+		findFavoriteWordMeaningsByIdsEndpoint = LoggingMiddleware(
+			log.With(
+				logger,
+				"method",
+				"FindFavoriteWordMeaningsByIds",
+			),
+		)(
+			findFavoriteWordMeaningsByIdsEndpoint,
+		)
+		findFavoriteWordMeaningsByIdsEndpoint = RecoverMiddleware(
+			log.With(
+				logger,
+				"method",
+				"FindFavoriteWordMeaningsByIds",
+			),
+		)(
+			findFavoriteWordMeaningsByIdsEndpoint,
+		)
+	}
+
+	var markFavoriteWordMeaningsReviewedEndpoint endpoint.Endpoint
+	{
+		markFavoriteWordMeaningsReviewedEndpoint = makeMarkFavoriteWordMeaningsReviewedEndpoint(
+			wordService,
+		)
+		markFavoriteWordMeaningsReviewedEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			markFavoriteWordMeaningsReviewedEndpoint,
+		)
+		markFavoriteWordMeaningsReviewedEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			markFavoriteWordMeaningsReviewedEndpoint,
+		)
+		markFavoriteWordMeaningsReviewedEndpoint = LoggingMiddleware(
+			log.With(
+				logger,
+				"method",
+				"MarkFavoriteWordMeaningsReviewed",
+			),
+		)(
+			markFavoriteWordMeaningsReviewedEndpoint,
+		)
+		markFavoriteWordMeaningsReviewedEndpoint = RecoverMiddleware(
+			log.With(
+				logger,
+				"method",
+				"MarkFavoriteWordMeaningsReviewed",
+			),
+		)(
+			markFavoriteWordMeaningsReviewedEndpoint,
+		)
+	}
+
+	var findRecentLookupsEndpoint endpoint.Endpoint
+	{
+		findRecentLookupsEndpoint = makeFindRecentLookupsEndpoint(wordService)
+		findRecentLookupsEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			findRecentLookupsEndpoint,
+		)
+		findRecentLookupsEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			findRecentLookupsEndpoint,
+		)
+		findRecentLookupsEndpoint = LoggingMiddleware(
+			log.With(
+				logger,
+				"method",
+				"FindRecentLookups",
+			),
+		)(
+			findRecentLookupsEndpoint,
+		)
+		findRecentLookupsEndpoint = RecoverMiddleware(
+			log.With(
+				logger,
+				"method",
+				"FindRecentLookups",
+			),
+		)(
+			findRecentLookupsEndpoint,
+		)
+	}
+
+	var clearLookupHistoryEndpoint endpoint.Endpoint
+	{
+		clearLookupHistoryEndpoint = makeClearLookupHistoryEndpoint(wordService)
+		clearLookupHistoryEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			clearLookupHistoryEndpoint,
+		)
+		clearLookupHistoryEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			clearLookupHistoryEndpoint,
+		)
+		clearLookupHistoryEndpoint = LoggingMiddleware(
+			log.With(
+				logger,
+				"method",
+				"ClearLookupHistory",
+			),
+		)(
+			clearLookupHistoryEndpoint,
+		)
+		clearLookupHistoryEndpoint = RecoverMiddleware(
+			log.With(
+				logger,
+				"method",
+				"ClearLookupHistory",
+			),
+		)(
+			clearLookupHistoryEndpoint,
+		)
+	}
+
+	var findDictationSentencesEndpoint endpoint.Endpoint
+	{
+		findDictationSentencesEndpoint = makeFindDictationSentencesEndpoint(wordService)
+		findDictationSentencesEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			findDictationSentencesEndpoint,
+		)
+		findDictationSentencesEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			findDictationSentencesEndpoint,
+		)
+		findDictationSentencesEndpoint = LoggingMiddleware(
+			log.With(
+				logger,
+				"method",
+				"FindDictationSentences",
+			),
+		)(
+			findDictationSentencesEndpoint,
+		)
+		findDictationSentencesEndpoint = RecoverMiddleware(
+			log.With(
+				logger,
+				"method",
+				"FindDictationSentences",
+			),
+		)(
+			findDictationSentencesEndpoint,
+		)
+	}
+
+	var gradeDictationEndpoint endpoint.Endpoint
+	{
+		gradeDictationEndpoint = makeGradeDictationEndpoint(wordService)
+		gradeDictationEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			gradeDictationEndpoint,
+		)
+		gradeDictationEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			gradeDictationEndpoint,
+		)
+		gradeDictationEndpoint = LoggingMiddleware(
+			log.With(
+				logger,
+				"method",
+				"GradeDictation",
+			),
+		)(
+			gradeDictationEndpoint,
+		)
+		gradeDictationEndpoint = RecoverMiddleware(
+			log.With(
+				logger,
+				"method",
+				"GradeDictation",
+			),
+		)(
+			gradeDictationEndpoint,
+		)
+	}
+
+	var findDictationHistoryEndpoint endpoint.Endpoint
+	{
+		findDictationHistoryEndpoint = makeFindDictationHistoryEndpoint(wordService)
+		findDictationHistoryEndpoint = ratelimit.NewErroringLimiter(
+			rate.NewLimiter(rate.Every(time.Second), limitCount),
+		)(
+			findDictationHistoryEndpoint,
+		)
+		findDictationHistoryEndpoint = circuitbreaker.Gobreaker(
+			gobreaker.NewCircuitBreaker(gobreaker.Settings{}),
+		)(
+			findDictationHistoryEndpoint,
+		)
+		findDictationHistoryEndpoint = LoggingMiddleware(
+			log.With(
+				logger,
+				"method",
+				"FindDictationHistory",
+			),
+		)(
+			findDictationHistoryEndpoint,
+		)
+		findDictationHistoryEndpoint = RecoverMiddleware(
+			log.With(
+				logger,
+				"method",
+				"FindDictationHistory",
+			),
+		)(
+			findDictationHistoryEndpoint,
+		)
+	}
+
 	return Endpoints{
-		FindWordByDictionary:           findWordByDictionaryEndpoint,
-		CreateFavoriteWordMeaning:      createFavoriteWordMeaningEndpoint,
-		DeleteFavoriteWordMeaning:      deleteFavoriteWordMeaningEndpoint,
-		FindFavoriteWordMeanings:       findFavoriteWordMeaningsEndpoint,
-		FindRandomFavoriteWordMeanings: findRandomFavoriteWordMeaningsEndpoint,
+		FindWordByDictionary:             findWordByDictionaryEndpoint,
+		CreateFavoriteWordMeaning:        createFavoriteWordMeaningEndpoint,
+		DeleteFavoriteWordMeaning:        deleteFavoriteWordMeaningEndpoint,
+		FindFavoriteWordMeanings:         findFavoriteWordMeaningsEndpoint,
+		FindRandomFavoriteWordMeanings:   findRandomFavoriteWordMeaningsEndpoint,
+		FindFavoriteWordMeaningsByIds:    findFavoriteWordMeaningsByIdsEndpoint,
+		MarkFavoriteWordMeaningsReviewed: markFavoriteWordMeaningsReviewedEndpoint,
+		FindRecentLookups:                findRecentLookupsEndpoint,
+		ClearLookupHistory:               clearLookupHistoryEndpoint,
+		FindDictationSentences:           findDictationSentencesEndpoint,
+		GradeDictation:                   gradeDictationEndpoint,
+		FindDictationHistory:             findDictationHistoryEndpoint,
 	}
 }
 
@@ -262,23 +511,26 @@ type FindFavoriteWordMeaningsRequest struct {
 	PageSize int32
 	UserId   string
 	Word     string
+	Cursor   string
 }
 
 type FindFavoriteWordMeaningsResponse struct {
 	Total                int32
 	PageCount            int32
 	FavoriteWordMeanings []model.WordMeaning
+	NextCursor           string
 }
 
 func makeFindFavoriteWordMeaningsEndpoint(wordService service.WordService) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(FindFavoriteWordMeaningsRequest)
-		total, pageCount, favoriteWordMeanings, err := wordService.FindFavoriteWordMeanings(
+		total, pageCount, favoriteWordMeanings, nextCursor, err := wordService.FindFavoriteWordMeanings(
 			ctx,
 			req.PageInde,
 			req.PageSize,
 			req.UserId,
 			req.Word,
+			req.Cursor,
 		)
 		if err != nil {
 			return nil, err
@@ -287,13 +539,15 @@ func makeFindFavoriteWordMeaningsEndpoint(wordService service.WordService) endpo
 			Total:                total,
 			PageCount:            pageCount,
 			FavoriteWordMeanings: favoriteWordMeanings,
+			NextCursor:           nextCursor,
 		}, nil
 	}
 }
 
 type FindRandomFavoriteWordMeaningsRequest struct {
-	UserId string
-	Size   int32
+	UserId    string
+	Size      int32
+	Weighting string
 }
 
 type FindRandomFavoriteWordMeaningsResponse struct {
@@ -307,6 +561,7 @@ func makeFindRandomFavoriteWordMeaningsEndpoint(wordService service.WordService)
 			ctx,
 			req.UserId,
 			req.Size,
+			req.Weighting,
 		)
 		if err != nil {
 			return nil, err
@@ -316,3 +571,172 @@ func makeFindRandomFavoriteWordMeaningsEndpoint(wordService service.WordService)
 		}, nil
 	}
 }
+
+type FindFavoriteWordMeaningsByIdsRequest struct {
+	FavoriteWordMeaningIds []string
+	UserId                 string
+}
+
+type FindFavoriteWordMeaningsByIdsResponse struct {
+	FavoriteWordMeanings []model.WordMeaning
+}
+
+func makeFindFavoriteWordMeaningsByIdsEndpoint(
+	wordService service.WordService,
+) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(FindFavoriteWordMeaningsByIdsRequest)
+		favoriteWordMeanings, err := wordService.FindFavoriteWordMeaningsByIds(
+			ctx,
+			req.FavoriteWordMeaningIds,
+			req.UserId,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return FindFavoriteWordMeaningsByIdsResponse{
+			FavoriteWordMeanings: favoriteWordMeanings,
+		}, nil
+	}
+}
+
+type MarkFavoriteWordMeaningsReviewedRequest struct {
+	FavoriteWordMeaningIds []string
+	UserId                 string
+}
+
+type MarkFavoriteWordMeaningsReviewedResponse struct {
+	ModifiedCount int32
+}
+
+func makeMarkFavoriteWordMeaningsReviewedEndpoint(
+	wordService service.WordService,
+) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(MarkFavoriteWordMeaningsReviewedRequest)
+		modifiedCount, err := wordService.MarkFavoriteWordMeaningsReviewed(
+			ctx,
+			req.FavoriteWordMeaningIds,
+			req.UserId,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return MarkFavoriteWordMeaningsReviewedResponse{ModifiedCount: modifiedCount}, nil
+	}
+}
+
+type FindRecentLookupsRequest struct {
+	UserId string
+	Size   int32
+}
+
+type FindRecentLookupsResponse struct {
+	LookupHistories []model.LookupHistory
+}
+
+func makeFindRecentLookupsEndpoint(wordService service.WordService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(FindRecentLookupsRequest)
+		lookupHistories, err := wordService.FindRecentLookups(ctx, req.UserId, req.Size)
+		if err != nil {
+			return nil, err
+		}
+		return FindRecentLookupsResponse{LookupHistories: lookupHistories}, nil
+	}
+}
+
+type ClearLookupHistoryRequest struct {
+	UserId string
+}
+
+type ClearLookupHistoryResponse struct {
+	DeletedCount int32
+}
+
+func makeClearLookupHistoryEndpoint(wordService service.WordService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ClearLookupHistoryRequest)
+		deletedCount, err := wordService.ClearLookupHistory(ctx, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		return ClearLookupHistoryResponse{DeletedCount: deletedCount}, nil
+	}
+}
+
+type FindDictationSentencesRequest struct {
+	UserId string
+	Size   int32
+}
+
+type FindDictationSentencesResponse struct {
+	DictationSentences []service.DictationSentence
+}
+
+func makeFindDictationSentencesEndpoint(wordService service.WordService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(FindDictationSentencesRequest)
+		dictationSentences, err := wordService.FindDictationSentences(ctx, req.UserId, req.Size)
+		if err != nil {
+			return nil, err
+		}
+		return FindDictationSentencesResponse{DictationSentences: dictationSentences}, nil
+	}
+}
+
+type GradeDictationRequest struct {
+	UserId        string
+	WordMeaningId string
+	AudioUrl      string
+	Answer        string
+}
+
+type GradeDictationResponse struct {
+	DictationRecord *model.DictationRecord
+}
+
+func makeGradeDictationEndpoint(wordService service.WordService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GradeDictationRequest)
+		dictationRecord, err := wordService.GradeDictation(
+			ctx,
+			req.UserId,
+			req.WordMeaningId,
+			req.AudioUrl,
+			req.Answer,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return GradeDictationResponse{DictationRecord: dictationRecord}, nil
+	}
+}
+
+type FindDictationHistoryRequest struct {
+	UserId string
+	Size   int32
+}
+
+type FindDictationHistoryResponse struct {
+	DictationRecords []model.DictationRecord
+	AverageAccuracy  float64
+}
+
+func makeFindDictationHistoryEndpoint(wordService service.WordService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(FindDictationHistoryRequest)
+		dictationRecords, averageAccuracy, err := wordService.FindDictationHistory(
+			ctx,
+			req.UserId,
+			req.Size,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return FindDictationHistoryResponse{
+			DictationRecords: dictationRecords,
+			AverageAccuracy:  averageAccuracy,
+		}, nil
+	}
+}
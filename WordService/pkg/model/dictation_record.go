@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 聽寫的逐字比對結果，Type 為 correct、missing、extra 或 misspelled
+type DictationWordDiff struct {
+	Type     string `json:"type"     bson:"type"`
+	Expected string `json:"expected" bson:"expected,omitempty"`
+	Actual   string `json:"actual"   bson:"actual,omitempty"`
+}
+
+// 使用者的聽寫紀錄，Text 是例句的內容，Answer 是使用者輸入的內容，Accuracy 是正確率百分比
+type DictationRecord struct {
+	Id            primitive.ObjectID  `json:"_id"           bson:"_id,omitempty"`
+	UserId        string              `json:"userId"        bson:"userId"`
+	WordMeaningId string              `json:"wordMeaningId" bson:"wordMeaningId"`
+	Word          string              `json:"word"          bson:"word"`
+	AudioUrl      string              `json:"audioUrl"      bson:"audioUrl"`
+	Text          string              `json:"text"          bson:"text"`
+	Answer        string              `json:"answer"        bson:"answer"`
+	Accuracy      float64             `json:"accuracy"      bson:"accuracy"`
+	WordCount     int32               `json:"wordCount"     bson:"wordCount"`
+	CorrectCount  int32               `json:"correctCount"  bson:"correctCount"`
+	Diffs         []DictationWordDiff `json:"diffs"         bson:"diffs"`
+	CreatedAt     time.Time           `json:"createdAt"     bson:"createdAt"`
+	UpdatedAt     time.Time           `json:"updatedAt"     bson:"updatedAt"`
+}
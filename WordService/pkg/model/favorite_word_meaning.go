@@ -10,6 +10,7 @@ type FavoriteWordMeaning struct {
 	Id            primitive.ObjectID `json:"_id"           bson:"_id,omitempty"`
 	UserId        string             `json:"userId"        bson:"userId"`
 	WordMeaningId primitive.ObjectID `json:"wordMeaningId" bson:"wordMeaningId"`
+	ReviewedTimes int32              `json:"reviewedTimes" bson:"reviewedTimes"`
 	CreatedAt     time.Time          `json:"createdAt"     bson:"createdAt"`
 	UpdatedAt     time.Time          `json:"updatedAt"     bson:"updatedAt"`
 }
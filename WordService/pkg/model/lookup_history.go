@@ -0,0 +1,17 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 使用者查詢單字的紀錄，同一個單字只保留一筆，以 times 記錄查詢次數
+type LookupHistory struct {
+	Id        primitive.ObjectID `json:"_id"       bson:"_id,omitempty"`
+	UserId    string             `json:"userId"    bson:"userId"`
+	Word      string             `json:"word"      bson:"word"`
+	Times     int32              `json:"times"     bson:"times"`
+	CreatedAt time.Time          `json:"createdAt" bson:"createdAt"`
+	UpdatedAt time.Time          `json:"updatedAt" bson:"updatedAt"`
+}
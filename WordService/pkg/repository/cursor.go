@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 依更新時間排序，加上 _id 讓 updatedAt 相同的資料也有固定順序
+var pageSort = bson.D{{"updatedAt", -1}, {"_id", -1}} // descending
+
+// 收藏的單字解釋依單字與解釋順序排序，加上收藏的 _id 讓排序固定
+var favoriteWordMeaningSort = bson.D{
+	{"wordMeaning.word", 1},
+	{"wordMeaning.orderByNo", 1},
+	{"_id", 1},
+}
+
+// 收藏分頁 cursor 的內容，記錄上一頁最後一筆收藏的位置
+type favoriteWordMeaningCursor struct {
+	Word      string             `json:"word"`
+	OrderByNo int32              `json:"orderByNo"`
+	Id        primitive.ObjectID `json:"_id"`
+}
+
+/*
+將上一頁最後一筆收藏的單字、解釋順序與收藏 _id 編碼成不透明的 cursor 字串，
+單字解釋建立後不會再變動，複習次數等欄位更新也不影響排序，
+所以翻頁期間不會出現重複或遺漏，被刪除的收藏則直接不再出現
+*/
+func encodeFavoriteWordMeaningCursor(word string, orderByNo int32, id primitive.ObjectID) string {
+	data, _ := json.Marshal(favoriteWordMeaningCursor{
+		Word:      word,
+		OrderByNo: orderByNo,
+		Id:        id,
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// 產生查詢 cursor 之後收藏的條件
+func afterFavoriteWordMeaningCursorFilter(cursor string) (bson.E, error) {
+	invalidCursorError := fmt.Errorf("Invalid cursor: %s", cursor)
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return bson.E{}, invalidCursorError
+	}
+
+	var position favoriteWordMeaningCursor
+	if err = json.Unmarshal(data, &position); err != nil || position.Id.IsZero() {
+		return bson.E{}, invalidCursorError
+	}
+
+	return bson.E{"$or", bson.A{
+		bson.D{{"wordMeaning.word", bson.D{{"$gt", position.Word}}}},
+		bson.D{
+			{"wordMeaning.word", position.Word},
+			{"wordMeaning.orderByNo", bson.D{{"$gt", position.OrderByNo}}},
+		},
+		bson.D{
+			{"wordMeaning.word", position.Word},
+			{"wordMeaning.orderByNo", position.OrderByNo},
+			{"_id", bson.D{{"$gt", position.Id}}},
+		},
+	}}, nil
+}
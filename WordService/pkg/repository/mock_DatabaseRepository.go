@@ -127,6 +127,63 @@ func (_c *MockDatabaseRepository_CountFavoriteWordMeaningsByUserIdAndWord_Call)
 	return _c
 }
 
+// CreateDictationRecord provides a mock function with given fields: ctx, dictationRecord
+func (_m *MockDatabaseRepository) CreateDictationRecord(ctx context.Context, dictationRecord model.DictationRecord) (string, error) {
+	ret := _m.Called(ctx, dictationRecord)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDictationRecord")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.DictationRecord) (string, error)); ok {
+		return rf(ctx, dictationRecord)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.DictationRecord) string); ok {
+		r0 = rf(ctx, dictationRecord)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.DictationRecord) error); ok {
+		r1 = rf(ctx, dictationRecord)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_CreateDictationRecord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDictationRecord'
+type MockDatabaseRepository_CreateDictationRecord_Call struct {
+	*mock.Call
+}
+
+// CreateDictationRecord is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dictationRecord model.DictationRecord
+func (_e *MockDatabaseRepository_Expecter) CreateDictationRecord(ctx interface{}, dictationRecord interface{}) *MockDatabaseRepository_CreateDictationRecord_Call {
+	return &MockDatabaseRepository_CreateDictationRecord_Call{Call: _e.mock.On("CreateDictationRecord", ctx, dictationRecord)}
+}
+
+func (_c *MockDatabaseRepository_CreateDictationRecord_Call) Run(run func(ctx context.Context, dictationRecord model.DictationRecord)) *MockDatabaseRepository_CreateDictationRecord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.DictationRecord))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CreateDictationRecord_Call) Return(dictationRecordId string, err error) *MockDatabaseRepository_CreateDictationRecord_Call {
+	_c.Call.Return(dictationRecordId, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_CreateDictationRecord_Call) RunAndReturn(run func(context.Context, model.DictationRecord) (string, error)) *MockDatabaseRepository_CreateDictationRecord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateFavoriteWordMeaning provides a mock function with given fields: ctx, userId, wordMeaningId
 func (_m *MockDatabaseRepository) CreateFavoriteWordMeaning(ctx context.Context, userId string, wordMeaningId string) (string, error) {
 	ret := _m.Called(ctx, userId, wordMeaningId)
@@ -301,6 +358,121 @@ func (_c *MockDatabaseRepository_DeleteFavoriteWordMeaningById_Call) RunAndRetur
 	return _c
 }
 
+// DeleteLookupHistoriesByUserId provides a mock function with given fields: ctx, userId
+func (_m *MockDatabaseRepository) DeleteLookupHistoriesByUserId(ctx context.Context, userId string) (int32, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLookupHistoriesByUserId")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_DeleteLookupHistoriesByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLookupHistoriesByUserId'
+type MockDatabaseRepository_DeleteLookupHistoriesByUserId_Call struct {
+	*mock.Call
+}
+
+// DeleteLookupHistoriesByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+func (_e *MockDatabaseRepository_Expecter) DeleteLookupHistoriesByUserId(ctx interface{}, userId interface{}) *MockDatabaseRepository_DeleteLookupHistoriesByUserId_Call {
+	return &MockDatabaseRepository_DeleteLookupHistoriesByUserId_Call{Call: _e.mock.On("DeleteLookupHistoriesByUserId", ctx, userId)}
+}
+
+func (_c *MockDatabaseRepository_DeleteLookupHistoriesByUserId_Call) Run(run func(ctx context.Context, userId string)) *MockDatabaseRepository_DeleteLookupHistoriesByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteLookupHistoriesByUserId_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteLookupHistoriesByUserId_Call {
+	_c.Call.Return(deletedCount, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteLookupHistoriesByUserId_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockDatabaseRepository_DeleteLookupHistoriesByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLookupHistoriesByUserIdAndSkip provides a mock function with given fields: ctx, userId, skip
+func (_m *MockDatabaseRepository) DeleteLookupHistoriesByUserIdAndSkip(ctx context.Context, userId string, skip int32) (int32, error) {
+	ret := _m.Called(ctx, userId, skip)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLookupHistoriesByUserIdAndSkip")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) (int32, error)); ok {
+		return rf(ctx, userId, skip)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) int32); ok {
+		r0 = rf(ctx, userId, skip)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32) error); ok {
+		r1 = rf(ctx, userId, skip)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_DeleteLookupHistoriesByUserIdAndSkip_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLookupHistoriesByUserIdAndSkip'
+type MockDatabaseRepository_DeleteLookupHistoriesByUserIdAndSkip_Call struct {
+	*mock.Call
+}
+
+// DeleteLookupHistoriesByUserIdAndSkip is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - skip int32
+func (_e *MockDatabaseRepository_Expecter) DeleteLookupHistoriesByUserIdAndSkip(ctx interface{}, userId interface{}, skip interface{}) *MockDatabaseRepository_DeleteLookupHistoriesByUserIdAndSkip_Call {
+	return &MockDatabaseRepository_DeleteLookupHistoriesByUserIdAndSkip_Call{Call: _e.mock.On("DeleteLookupHistoriesByUserIdAndSkip", ctx, userId, skip)}
+}
+
+func (_c *MockDatabaseRepository_DeleteLookupHistoriesByUserIdAndSkip_Call) Run(run func(ctx context.Context, userId string, skip int32)) *MockDatabaseRepository_DeleteLookupHistoriesByUserIdAndSkip_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteLookupHistoriesByUserIdAndSkip_Call) Return(deletedCount int32, err error) *MockDatabaseRepository_DeleteLookupHistoriesByUserIdAndSkip_Call {
+	_c.Call.Return(deletedCount, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_DeleteLookupHistoriesByUserIdAndSkip_Call) RunAndReturn(run func(context.Context, string, int32) (int32, error)) *MockDatabaseRepository_DeleteLookupHistoriesByUserIdAndSkip_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DisconnectDB provides a mock function with given fields: ctx
 func (_m *MockDatabaseRepository) DisconnectDB(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -347,29 +519,89 @@ func (_c *MockDatabaseRepository_DisconnectDB_Call) RunAndReturn(run func(contex
 	return _c
 }
 
-// FindFavoriteWordMeaningsByUserIdAndWord provides a mock function with given fields: ctx, userId, word, skip, limit
-func (_m *MockDatabaseRepository) FindFavoriteWordMeaningsByUserIdAndWord(ctx context.Context, userId string, word string, skip int32, limit int32) ([]model.WordMeaning, error) {
-	ret := _m.Called(ctx, userId, word, skip, limit)
+// FindDictationRecordsByUserIdOrderByCreatedAtDesc provides a mock function with given fields: ctx, userId, limit
+func (_m *MockDatabaseRepository) FindDictationRecordsByUserIdOrderByCreatedAtDesc(ctx context.Context, userId string, limit int32) ([]model.DictationRecord, error) {
+	ret := _m.Called(ctx, userId, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindFavoriteWordMeaningsByUserIdAndWord")
+		panic("no return value specified for FindDictationRecordsByUserIdOrderByCreatedAtDesc")
+	}
+
+	var r0 []model.DictationRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) ([]model.DictationRecord, error)); ok {
+		return rf(ctx, userId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) []model.DictationRecord); ok {
+		r0 = rf(ctx, userId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.DictationRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32) error); ok {
+		r1 = rf(ctx, userId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindDictationRecordsByUserIdOrderByCreatedAtDesc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDictationRecordsByUserIdOrderByCreatedAtDesc'
+type MockDatabaseRepository_FindDictationRecordsByUserIdOrderByCreatedAtDesc_Call struct {
+	*mock.Call
+}
+
+// FindDictationRecordsByUserIdOrderByCreatedAtDesc is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - limit int32
+func (_e *MockDatabaseRepository_Expecter) FindDictationRecordsByUserIdOrderByCreatedAtDesc(ctx interface{}, userId interface{}, limit interface{}) *MockDatabaseRepository_FindDictationRecordsByUserIdOrderByCreatedAtDesc_Call {
+	return &MockDatabaseRepository_FindDictationRecordsByUserIdOrderByCreatedAtDesc_Call{Call: _e.mock.On("FindDictationRecordsByUserIdOrderByCreatedAtDesc", ctx, userId, limit)}
+}
+
+func (_c *MockDatabaseRepository_FindDictationRecordsByUserIdOrderByCreatedAtDesc_Call) Run(run func(ctx context.Context, userId string, limit int32)) *MockDatabaseRepository_FindDictationRecordsByUserIdOrderByCreatedAtDesc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindDictationRecordsByUserIdOrderByCreatedAtDesc_Call) Return(dictationRecords []model.DictationRecord, err error) *MockDatabaseRepository_FindDictationRecordsByUserIdOrderByCreatedAtDesc_Call {
+	_c.Call.Return(dictationRecords, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindDictationRecordsByUserIdOrderByCreatedAtDesc_Call) RunAndReturn(run func(context.Context, string, int32) ([]model.DictationRecord, error)) *MockDatabaseRepository_FindDictationRecordsByUserIdOrderByCreatedAtDesc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindFavoriteWordMeaningsByUserIdAndIds provides a mock function with given fields: ctx, userId, favoriteWordMeaningIds
+func (_m *MockDatabaseRepository) FindFavoriteWordMeaningsByUserIdAndIds(ctx context.Context, userId string, favoriteWordMeaningIds []string) ([]model.WordMeaning, error) {
+	ret := _m.Called(ctx, userId, favoriteWordMeaningIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFavoriteWordMeaningsByUserIdAndIds")
 	}
 
 	var r0 []model.WordMeaning
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32, int32) ([]model.WordMeaning, error)); ok {
-		return rf(ctx, userId, word, skip, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) ([]model.WordMeaning, error)); ok {
+		return rf(ctx, userId, favoriteWordMeaningIds)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32, int32) []model.WordMeaning); ok {
-		r0 = rf(ctx, userId, word, skip, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []model.WordMeaning); ok {
+		r0 = rf(ctx, userId, favoriteWordMeaningIds)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.WordMeaning)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32, int32) error); ok {
-		r1 = rf(ctx, userId, word, skip, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, userId, favoriteWordMeaningIds)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -377,6 +609,73 @@ func (_m *MockDatabaseRepository) FindFavoriteWordMeaningsByUserIdAndWord(ctx co
 	return r0, r1
 }
 
+// MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindFavoriteWordMeaningsByUserIdAndIds'
+type MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndIds_Call struct {
+	*mock.Call
+}
+
+// FindFavoriteWordMeaningsByUserIdAndIds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - favoriteWordMeaningIds []string
+func (_e *MockDatabaseRepository_Expecter) FindFavoriteWordMeaningsByUserIdAndIds(ctx interface{}, userId interface{}, favoriteWordMeaningIds interface{}) *MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndIds_Call {
+	return &MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndIds_Call{Call: _e.mock.On("FindFavoriteWordMeaningsByUserIdAndIds", ctx, userId, favoriteWordMeaningIds)}
+}
+
+func (_c *MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndIds_Call) Run(run func(ctx context.Context, userId string, favoriteWordMeaningIds []string)) *MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndIds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndIds_Call) Return(wordMeanings []model.WordMeaning, err error) *MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndIds_Call {
+	_c.Call.Return(wordMeanings, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndIds_Call) RunAndReturn(run func(context.Context, string, []string) ([]model.WordMeaning, error)) *MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndIds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindFavoriteWordMeaningsByUserIdAndWord provides a mock function with given fields: ctx, userId, word, cursor, skip, limit
+func (_m *MockDatabaseRepository) FindFavoriteWordMeaningsByUserIdAndWord(ctx context.Context, userId string, word string, cursor string, skip int32, limit int32) ([]model.WordMeaning, string, error) {
+	ret := _m.Called(ctx, userId, word, cursor, skip, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFavoriteWordMeaningsByUserIdAndWord")
+	}
+
+	var r0 []model.WordMeaning
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int32, int32) ([]model.WordMeaning, string, error)); ok {
+		return rf(ctx, userId, word, cursor, skip, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int32, int32) []model.WordMeaning); ok {
+		r0 = rf(ctx, userId, word, cursor, skip, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WordMeaning)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, int32, int32) string); ok {
+		r1 = rf(ctx, userId, word, cursor, skip, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, string, int32, int32) error); ok {
+		r2 = rf(ctx, userId, word, cursor, skip, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndWord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindFavoriteWordMeaningsByUserIdAndWord'
 type MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndWord_Call struct {
 	*mock.Call
@@ -386,25 +685,207 @@ type MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndWord_Call struct
 //   - ctx context.Context
 //   - userId string
 //   - word string
+//   - cursor string
 //   - skip int32
 //   - limit int32
-func (_e *MockDatabaseRepository_Expecter) FindFavoriteWordMeaningsByUserIdAndWord(ctx interface{}, userId interface{}, word interface{}, skip interface{}, limit interface{}) *MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndWord_Call {
-	return &MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndWord_Call{Call: _e.mock.On("FindFavoriteWordMeaningsByUserIdAndWord", ctx, userId, word, skip, limit)}
+func (_e *MockDatabaseRepository_Expecter) FindFavoriteWordMeaningsByUserIdAndWord(ctx interface{}, userId interface{}, word interface{}, cursor interface{}, skip interface{}, limit interface{}) *MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndWord_Call {
+	return &MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndWord_Call{Call: _e.mock.On("FindFavoriteWordMeaningsByUserIdAndWord", ctx, userId, word, cursor, skip, limit)}
+}
+
+func (_c *MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndWord_Call) Run(run func(ctx context.Context, userId string, word string, cursor string, skip int32, limit int32)) *MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndWord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(int32), args[5].(int32))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndWord_Call) Return(wordMeanings []model.WordMeaning, nextCursor string, err error) *MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndWord_Call {
+	_c.Call.Return(wordMeanings, nextCursor, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndWord_Call) RunAndReturn(run func(context.Context, string, string, string, int32, int32) ([]model.WordMeaning, string, error)) *MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndWord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindLookupHistoriesByUserIdOrderByUpdatedAtDesc provides a mock function with given fields: ctx, userId, limit
+func (_m *MockDatabaseRepository) FindLookupHistoriesByUserIdOrderByUpdatedAtDesc(ctx context.Context, userId string, limit int32) ([]model.LookupHistory, error) {
+	ret := _m.Called(ctx, userId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindLookupHistoriesByUserIdOrderByUpdatedAtDesc")
+	}
+
+	var r0 []model.LookupHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) ([]model.LookupHistory, error)); ok {
+		return rf(ctx, userId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) []model.LookupHistory); ok {
+		r0 = rf(ctx, userId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.LookupHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32) error); ok {
+		r1 = rf(ctx, userId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindLookupHistoriesByUserIdOrderByUpdatedAtDesc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindLookupHistoriesByUserIdOrderByUpdatedAtDesc'
+type MockDatabaseRepository_FindLookupHistoriesByUserIdOrderByUpdatedAtDesc_Call struct {
+	*mock.Call
+}
+
+// FindLookupHistoriesByUserIdOrderByUpdatedAtDesc is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - limit int32
+func (_e *MockDatabaseRepository_Expecter) FindLookupHistoriesByUserIdOrderByUpdatedAtDesc(ctx interface{}, userId interface{}, limit interface{}) *MockDatabaseRepository_FindLookupHistoriesByUserIdOrderByUpdatedAtDesc_Call {
+	return &MockDatabaseRepository_FindLookupHistoriesByUserIdOrderByUpdatedAtDesc_Call{Call: _e.mock.On("FindLookupHistoriesByUserIdOrderByUpdatedAtDesc", ctx, userId, limit)}
 }
 
-func (_c *MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndWord_Call) Run(run func(ctx context.Context, userId string, word string, skip int32, limit int32)) *MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndWord_Call {
+func (_c *MockDatabaseRepository_FindLookupHistoriesByUserIdOrderByUpdatedAtDesc_Call) Run(run func(ctx context.Context, userId string, limit int32)) *MockDatabaseRepository_FindLookupHistoriesByUserIdOrderByUpdatedAtDesc_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int32), args[4].(int32))
+		run(args[0].(context.Context), args[1].(string), args[2].(int32))
 	})
 	return _c
 }
 
-func (_c *MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndWord_Call) Return(wordMeanings []model.WordMeaning, err error) *MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndWord_Call {
+func (_c *MockDatabaseRepository_FindLookupHistoriesByUserIdOrderByUpdatedAtDesc_Call) Return(lookupHistories []model.LookupHistory, err error) *MockDatabaseRepository_FindLookupHistoriesByUserIdOrderByUpdatedAtDesc_Call {
+	_c.Call.Return(lookupHistories, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindLookupHistoriesByUserIdOrderByUpdatedAtDesc_Call) RunAndReturn(run func(context.Context, string, int32) ([]model.LookupHistory, error)) *MockDatabaseRepository_FindLookupHistoriesByUserIdOrderByUpdatedAtDesc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRandomFavoriteSentencesByUserId provides a mock function with given fields: ctx, userId, size
+func (_m *MockDatabaseRepository) FindRandomFavoriteSentencesByUserId(ctx context.Context, userId string, size int32) ([]FavoriteSentence, error) {
+	ret := _m.Called(ctx, userId, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRandomFavoriteSentencesByUserId")
+	}
+
+	var r0 []FavoriteSentence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) ([]FavoriteSentence, error)); ok {
+		return rf(ctx, userId, size)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) []FavoriteSentence); ok {
+		r0 = rf(ctx, userId, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]FavoriteSentence)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32) error); ok {
+		r1 = rf(ctx, userId, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindRandomFavoriteSentencesByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRandomFavoriteSentencesByUserId'
+type MockDatabaseRepository_FindRandomFavoriteSentencesByUserId_Call struct {
+	*mock.Call
+}
+
+// FindRandomFavoriteSentencesByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - size int32
+func (_e *MockDatabaseRepository_Expecter) FindRandomFavoriteSentencesByUserId(ctx interface{}, userId interface{}, size interface{}) *MockDatabaseRepository_FindRandomFavoriteSentencesByUserId_Call {
+	return &MockDatabaseRepository_FindRandomFavoriteSentencesByUserId_Call{Call: _e.mock.On("FindRandomFavoriteSentencesByUserId", ctx, userId, size)}
+}
+
+func (_c *MockDatabaseRepository_FindRandomFavoriteSentencesByUserId_Call) Run(run func(ctx context.Context, userId string, size int32)) *MockDatabaseRepository_FindRandomFavoriteSentencesByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindRandomFavoriteSentencesByUserId_Call) Return(favoriteSentences []FavoriteSentence, err error) *MockDatabaseRepository_FindRandomFavoriteSentencesByUserId_Call {
+	_c.Call.Return(favoriteSentences, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindRandomFavoriteSentencesByUserId_Call) RunAndReturn(run func(context.Context, string, int32) ([]FavoriteSentence, error)) *MockDatabaseRepository_FindRandomFavoriteSentencesByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRandomFavoriteWordMeaningsByUserId provides a mock function with given fields: ctx, userId, size, weighting
+func (_m *MockDatabaseRepository) FindRandomFavoriteWordMeaningsByUserId(ctx context.Context, userId string, size int32, weighting string) ([]model.WordMeaning, error) {
+	ret := _m.Called(ctx, userId, size, weighting)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRandomFavoriteWordMeaningsByUserId")
+	}
+
+	var r0 []model.WordMeaning
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32, string) ([]model.WordMeaning, error)); ok {
+		return rf(ctx, userId, size, weighting)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32, string) []model.WordMeaning); ok {
+		r0 = rf(ctx, userId, size, weighting)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WordMeaning)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32, string) error); ok {
+		r1 = rf(ctx, userId, size, weighting)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_FindRandomFavoriteWordMeaningsByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRandomFavoriteWordMeaningsByUserId'
+type MockDatabaseRepository_FindRandomFavoriteWordMeaningsByUserId_Call struct {
+	*mock.Call
+}
+
+// FindRandomFavoriteWordMeaningsByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - size int32
+//   - weighting string
+func (_e *MockDatabaseRepository_Expecter) FindRandomFavoriteWordMeaningsByUserId(ctx interface{}, userId interface{}, size interface{}, weighting interface{}) *MockDatabaseRepository_FindRandomFavoriteWordMeaningsByUserId_Call {
+	return &MockDatabaseRepository_FindRandomFavoriteWordMeaningsByUserId_Call{Call: _e.mock.On("FindRandomFavoriteWordMeaningsByUserId", ctx, userId, size, weighting)}
+}
+
+func (_c *MockDatabaseRepository_FindRandomFavoriteWordMeaningsByUserId_Call) Run(run func(ctx context.Context, userId string, size int32, weighting string)) *MockDatabaseRepository_FindRandomFavoriteWordMeaningsByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int32), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_FindRandomFavoriteWordMeaningsByUserId_Call) Return(wordMeanings []model.WordMeaning, err error) *MockDatabaseRepository_FindRandomFavoriteWordMeaningsByUserId_Call {
 	_c.Call.Return(wordMeanings, err)
 	return _c
 }
 
-func (_c *MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndWord_Call) RunAndReturn(run func(context.Context, string, string, int32, int32) ([]model.WordMeaning, error)) *MockDatabaseRepository_FindFavoriteWordMeaningsByUserIdAndWord_Call {
+func (_c *MockDatabaseRepository_FindRandomFavoriteWordMeaningsByUserId_Call) RunAndReturn(run func(context.Context, string, int32, string) ([]model.WordMeaning, error)) *MockDatabaseRepository_FindRandomFavoriteWordMeaningsByUserId_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -469,6 +950,65 @@ func (_c *MockDatabaseRepository_FindWordMeaningsByWordAndUserId_Call) RunAndRet
 	return _c
 }
 
+// GetDictationRecordById provides a mock function with given fields: ctx, dictationRecordId
+func (_m *MockDatabaseRepository) GetDictationRecordById(ctx context.Context, dictationRecordId string) (*model.DictationRecord, error) {
+	ret := _m.Called(ctx, dictationRecordId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDictationRecordById")
+	}
+
+	var r0 *model.DictationRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.DictationRecord, error)); ok {
+		return rf(ctx, dictationRecordId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.DictationRecord); ok {
+		r0 = rf(ctx, dictationRecordId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DictationRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, dictationRecordId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_GetDictationRecordById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDictationRecordById'
+type MockDatabaseRepository_GetDictationRecordById_Call struct {
+	*mock.Call
+}
+
+// GetDictationRecordById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dictationRecordId string
+func (_e *MockDatabaseRepository_Expecter) GetDictationRecordById(ctx interface{}, dictationRecordId interface{}) *MockDatabaseRepository_GetDictationRecordById_Call {
+	return &MockDatabaseRepository_GetDictationRecordById_Call{Call: _e.mock.On("GetDictationRecordById", ctx, dictationRecordId)}
+}
+
+func (_c *MockDatabaseRepository_GetDictationRecordById_Call) Run(run func(ctx context.Context, dictationRecordId string)) *MockDatabaseRepository_GetDictationRecordById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_GetDictationRecordById_Call) Return(dictationRecord *model.DictationRecord, err error) *MockDatabaseRepository_GetDictationRecordById_Call {
+	_c.Call.Return(dictationRecord, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_GetDictationRecordById_Call) RunAndReturn(run func(context.Context, string) (*model.DictationRecord, error)) *MockDatabaseRepository_GetDictationRecordById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFavoriteWordMeaningById provides a mock function with given fields: ctx, favoriteWordMeaningId
 func (_m *MockDatabaseRepository) GetFavoriteWordMeaningById(ctx context.Context, favoriteWordMeaningId string) (*model.FavoriteWordMeaning, error) {
 	ret := _m.Called(ctx, favoriteWordMeaningId)
@@ -528,6 +1068,188 @@ func (_c *MockDatabaseRepository_GetFavoriteWordMeaningById_Call) RunAndReturn(r
 	return _c
 }
 
+// GetWordMeaningById provides a mock function with given fields: ctx, wordMeaningId
+func (_m *MockDatabaseRepository) GetWordMeaningById(ctx context.Context, wordMeaningId string) (*model.WordMeaning, error) {
+	ret := _m.Called(ctx, wordMeaningId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWordMeaningById")
+	}
+
+	var r0 *model.WordMeaning
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.WordMeaning, error)); ok {
+		return rf(ctx, wordMeaningId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.WordMeaning); ok {
+		r0 = rf(ctx, wordMeaningId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WordMeaning)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, wordMeaningId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_GetWordMeaningById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWordMeaningById'
+type MockDatabaseRepository_GetWordMeaningById_Call struct {
+	*mock.Call
+}
+
+// GetWordMeaningById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - wordMeaningId string
+func (_e *MockDatabaseRepository_Expecter) GetWordMeaningById(ctx interface{}, wordMeaningId interface{}) *MockDatabaseRepository_GetWordMeaningById_Call {
+	return &MockDatabaseRepository_GetWordMeaningById_Call{Call: _e.mock.On("GetWordMeaningById", ctx, wordMeaningId)}
+}
+
+func (_c *MockDatabaseRepository_GetWordMeaningById_Call) Run(run func(ctx context.Context, wordMeaningId string)) *MockDatabaseRepository_GetWordMeaningById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_GetWordMeaningById_Call) Return(wordMeaning *model.WordMeaning, err error) *MockDatabaseRepository_GetWordMeaningById_Call {
+	_c.Call.Return(wordMeaning, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_GetWordMeaningById_Call) RunAndReturn(run func(context.Context, string) (*model.WordMeaning, error)) *MockDatabaseRepository_GetWordMeaningById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncreaseFavoriteWordMeaningsReviewedTimes provides a mock function with given fields: ctx, userId, favoriteWordMeaningIds
+func (_m *MockDatabaseRepository) IncreaseFavoriteWordMeaningsReviewedTimes(ctx context.Context, userId string, favoriteWordMeaningIds []string) (int32, error) {
+	ret := _m.Called(ctx, userId, favoriteWordMeaningIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncreaseFavoriteWordMeaningsReviewedTimes")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) (int32, error)); ok {
+		return rf(ctx, userId, favoriteWordMeaningIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) int32); ok {
+		r0 = rf(ctx, userId, favoriteWordMeaningIds)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, userId, favoriteWordMeaningIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabaseRepository_IncreaseFavoriteWordMeaningsReviewedTimes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncreaseFavoriteWordMeaningsReviewedTimes'
+type MockDatabaseRepository_IncreaseFavoriteWordMeaningsReviewedTimes_Call struct {
+	*mock.Call
+}
+
+// IncreaseFavoriteWordMeaningsReviewedTimes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - favoriteWordMeaningIds []string
+func (_e *MockDatabaseRepository_Expecter) IncreaseFavoriteWordMeaningsReviewedTimes(ctx interface{}, userId interface{}, favoriteWordMeaningIds interface{}) *MockDatabaseRepository_IncreaseFavoriteWordMeaningsReviewedTimes_Call {
+	return &MockDatabaseRepository_IncreaseFavoriteWordMeaningsReviewedTimes_Call{Call: _e.mock.On("IncreaseFavoriteWordMeaningsReviewedTimes", ctx, userId, favoriteWordMeaningIds)}
+}
+
+func (_c *MockDatabaseRepository_IncreaseFavoriteWordMeaningsReviewedTimes_Call) Run(run func(ctx context.Context, userId string, favoriteWordMeaningIds []string)) *MockDatabaseRepository_IncreaseFavoriteWordMeaningsReviewedTimes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_IncreaseFavoriteWordMeaningsReviewedTimes_Call) Return(modifiedCount int32, err error) *MockDatabaseRepository_IncreaseFavoriteWordMeaningsReviewedTimes_Call {
+	_c.Call.Return(modifiedCount, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_IncreaseFavoriteWordMeaningsReviewedTimes_Call) RunAndReturn(run func(context.Context, string, []string) (int32, error)) *MockDatabaseRepository_IncreaseFavoriteWordMeaningsReviewedTimes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertLookupHistoryByTimesPlusOne provides a mock function with given fields: ctx, userId, word
+func (_m *MockDatabaseRepository) UpsertLookupHistoryByTimesPlusOne(ctx context.Context, userId string, word string) (int32, int32, error) {
+	ret := _m.Called(ctx, userId, word)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertLookupHistoryByTimesPlusOne")
+	}
+
+	var r0 int32
+	var r1 int32
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (int32, int32, error)); ok {
+		return rf(ctx, userId, word)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int32); ok {
+		r0 = rf(ctx, userId, word)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) int32); ok {
+		r1 = rf(ctx, userId, word)
+	} else {
+		r1 = ret.Get(1).(int32)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, userId, word)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockDatabaseRepository_UpsertLookupHistoryByTimesPlusOne_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertLookupHistoryByTimesPlusOne'
+type MockDatabaseRepository_UpsertLookupHistoryByTimesPlusOne_Call struct {
+	*mock.Call
+}
+
+// UpsertLookupHistoryByTimesPlusOne is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId string
+//   - word string
+func (_e *MockDatabaseRepository_Expecter) UpsertLookupHistoryByTimesPlusOne(ctx interface{}, userId interface{}, word interface{}) *MockDatabaseRepository_UpsertLookupHistoryByTimesPlusOne_Call {
+	return &MockDatabaseRepository_UpsertLookupHistoryByTimesPlusOne_Call{Call: _e.mock.On("UpsertLookupHistoryByTimesPlusOne", ctx, userId, word)}
+}
+
+func (_c *MockDatabaseRepository_UpsertLookupHistoryByTimesPlusOne_Call) Run(run func(ctx context.Context, userId string, word string)) *MockDatabaseRepository_UpsertLookupHistoryByTimesPlusOne_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatabaseRepository_UpsertLookupHistoryByTimesPlusOne_Call) Return(modifiedCount int32, upsertedCount int32, err error) *MockDatabaseRepository_UpsertLookupHistoryByTimesPlusOne_Call {
+	_c.Call.Return(modifiedCount, upsertedCount, err)
+	return _c
+}
+
+func (_c *MockDatabaseRepository_UpsertLookupHistoryByTimesPlusOne_Call) RunAndReturn(run func(context.Context, string, string) (int32, int32, error)) *MockDatabaseRepository_UpsertLookupHistoryByTimesPlusOne_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithTransaction provides a mock function with given fields: ctx, transactoinFunc
 func (_m *MockDatabaseRepository) WithTransaction(ctx context.Context, transactoinFunc transactionFunc) (interface{}, error) {
 	ret := _m.Called(ctx, transactoinFunc)
@@ -18,6 +18,8 @@ import (
 const (
 	WORD_MEANING_COLLECTION          = "wordmeanings"
 	FAVORITE_WORD_MEANING_COLLECTION = "favoritewordmeanings"
+	LOOKUP_HISTORY_COLLECTION        = "lookuphistories"
+	DICTATION_RECORD_COLLECTION      = "dictationrecords"
 )
 
 type MongoDBRepository struct {
@@ -50,10 +52,30 @@ func (repo *MongoDBRepository) ConnectDB(ctx context.Context, uri string) error
 		return fmt.Errorf("ConnectDB ping database failed! error: %w", err)
 	}
 
+	err = repo.createIndexes(ctx)
+	if err != nil {
+		return fmt.Errorf("ConnectDB create indexes failed! error: %w", err)
+	}
+
 	fmt.Println("Connected to MongoDB")
 	return nil
 }
 
+// 建立需要的索引，索引已存在時 MongoDB 不會重複建立
+func (repo *MongoDBRepository) createIndexes(ctx context.Context) error {
+	// 同一個使用者的同一個單字只保留一筆查詢紀錄，避免同時 upsert 時新增重複的資料
+	collection := repo.getCollection(LOOKUP_HISTORY_COLLECTION)
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{"userId", 1}, {"word", 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (repo *MongoDBRepository) DisconnectDB(ctx context.Context) error {
 	if err := repo.client.Disconnect(ctx); err != nil {
 		return fmt.Errorf("DisconnectDB failed! error: %w", err)
@@ -140,6 +162,32 @@ func (repo *MongoDBRepository) FindWordMeaningsByWordAndUserId(
 	return wordMeanings, nil
 }
 
+func (repo *MongoDBRepository) GetWordMeaningById(
+	ctx context.Context,
+	wordMeaningId string,
+) (wordMeaning *model.WordMeaning, err error) {
+	id, err := primitive.ObjectIDFromHex(wordMeaningId)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.D{{"_id", id}}
+	var result model.WordMeaning
+	collection := repo.getCollection(WORD_MEANING_COLLECTION)
+	err = collection.FindOne(ctx, filter).Decode(&result)
+
+	if err != nil {
+		// 查無資料不視為錯誤
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 func (repo *MongoDBRepository) CreateFavoriteWordMeaning(
 	ctx context.Context,
 	userId, wordMeaningId string,
@@ -149,10 +197,13 @@ func (repo *MongoDBRepository) CreateFavoriteWordMeaning(
 		return "", err
 	}
 
+	now := time.Now()
 	colleciton := repo.getCollection(FAVORITE_WORD_MEANING_COLLECTION)
 	result, err := colleciton.InsertOne(ctx, model.FavoriteWordMeaning{
 		UserId:        userId,
 		WordMeaningId: wordMeaningObjectId,
+		CreatedAt:     now,
+		UpdatedAt:     now,
 	})
 	if err != nil {
 		return "", err
@@ -191,15 +242,29 @@ func (repo *MongoDBRepository) GetFavoriteWordMeaningById(
 
 func (repo *MongoDBRepository) FindFavoriteWordMeaningsByUserIdAndWord(
 	ctx context.Context,
-	userId, word string,
+	userId, word, cursor string,
 	skip, limit int32,
-) (wordMeanings []model.WordMeaning, err error) {
-	matchWord := bson.D{{}}
+) (wordMeanings []model.WordMeaning, nextCursor string, err error) {
+	if limit <= 0 {
+		return nil, "", fmt.Errorf("Invalid limit: %d", limit)
+	}
+
+	matchWord := bson.D{}
 
 	if word != "" {
 		matchWord = bson.D{{"wordMeaning.queryByWords", word}}
 	}
 
+	// 有 cursor 時從 cursor 之後開始查詢
+	if cursor != "" {
+		afterCursor, err := afterFavoriteWordMeaningCursorFilter(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+
+		matchWord = append(matchWord, afterCursor)
+	}
+
 	matchStage := bson.D{{"$match", bson.D{{"userId", userId}}}}
 	lookupStage := bson.D{{
 		"$lookup", bson.D{
@@ -211,14 +276,16 @@ func (repo *MongoDBRepository) FindFavoriteWordMeaningsByUserIdAndWord(
 	}}
 	unwindStage := bson.D{{"$unwind", "$wordMeaning"}}
 	matchWordStage := bson.D{{"$match", matchWord}}
-	sortStage := bson.D{{"$sort", bson.D{{"wordMeaning.word", 1}, {"wordMeaning.orderByNo", 1}}}}
+	sortStage := bson.D{{"$sort", favoriteWordMeaningSort}}
 	skipStage := bson.D{{"$skip", skip}}
-	limitStage := bson.D{{"$limit", limit}}
+
+	// 多查一筆用來判斷是否還有下一頁
+	limitStage := bson.D{{"$limit", limit + 1}}
 
 	collection := repo.getCollection(FAVORITE_WORD_MEANING_COLLECTION)
 
 	// pass the pipeline to the Aggregate() method
-	cursor, err := collection.Aggregate(
+	aggregateCursor, err := collection.Aggregate(
 		ctx,
 		mongo.Pipeline{
 			matchStage,
@@ -231,7 +298,7 @@ func (repo *MongoDBRepository) FindFavoriteWordMeaningsByUserIdAndWord(
 		},
 	)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	type result struct {
@@ -244,8 +311,18 @@ func (repo *MongoDBRepository) FindFavoriteWordMeaningsByUserIdAndWord(
 	}
 
 	var results []result
-	if err = cursor.All(ctx, &results); err != nil {
-		return nil, err
+	if err = aggregateCursor.All(ctx, &results); err != nil {
+		return nil, "", err
+	}
+
+	if len(results) > int(limit) {
+		results = results[:limit]
+		lastResult := results[limit-1]
+		nextCursor = encodeFavoriteWordMeaningCursor(
+			lastResult.WordMeaning.Word,
+			lastResult.WordMeaning.OrderByNo,
+			lastResult.Id,
+		)
 	}
 
 	for i := range results {
@@ -253,7 +330,7 @@ func (repo *MongoDBRepository) FindFavoriteWordMeaningsByUserIdAndWord(
 		wordMeanings = append(wordMeanings, results[i].WordMeaning)
 	}
 
-	return wordMeanings, nil
+	return wordMeanings, nextCursor, nil
 }
 
 func (repo *MongoDBRepository) CountFavoriteWordMeaningsByUserIdAndWord(
@@ -312,6 +389,191 @@ func (repo *MongoDBRepository) CountFavoriteWordMeaningsByUserIdAndWord(
 	return count, nil
 }
 
+func (repo *MongoDBRepository) FindRandomFavoriteWordMeaningsByUserId(
+	ctx context.Context,
+	userId string,
+	size int32,
+	weighting string,
+) (wordMeanings []model.WordMeaning, err error) {
+	matchStage := bson.D{{"$match", bson.D{{"userId", userId}}}}
+	lookupStage := bson.D{{
+		"$lookup", bson.D{
+			{"from", "wordmeanings"},
+			{"localField", "wordMeaningId"},
+			{"foreignField", "_id"},
+			{"as", "wordMeaning"},
+		},
+	}}
+	unwindStage := bson.D{{"$unwind", "$wordMeaning"}}
+
+	var weight interface{}
+
+	switch weighting {
+	case RANDOM_WEIGHTING_RECENT:
+		// 依加入天數遞減權重，剛加入為 1，加入 30 天為 0.5，
+		// 加入時間取自 _id 內含的時間戳記
+		weight = bson.D{{"$divide", bson.A{
+			1,
+			bson.D{{"$add", bson.A{
+				1,
+				bson.D{{"$divide", bson.A{
+					bson.D{{"$subtract", bson.A{"$$NOW", bson.D{{"$toDate", "$_id"}}}}},
+					30 * 24 * 60 * 60 * 1000,
+				}}},
+			}}},
+		}}}
+	case RANDOM_WEIGHTING_LESS_REVIEWED:
+		// 複習次數越少權重越高
+		weight = bson.D{{"$divide", bson.A{
+			1,
+			bson.D{{"$add", bson.A{1, bson.D{{"$ifNull", bson.A{"$reviewedTimes", 0}}}}}},
+		}}}
+	}
+
+	var pipeline mongo.Pipeline
+
+	if weight == nil {
+		// 不加權時直接用 $sample 隨機取出不重複的資料
+		pipeline = mongo.Pipeline{
+			matchStage,
+			bson.D{{"$sample", bson.D{{"size", size}}}},
+			lookupStage,
+			unwindStage,
+		}
+	} else {
+		// 加權隨機抽樣（A-Res）：每筆資料的隨機鍵為 rand^(1/weight)，取隨機鍵最大的前 size 筆
+		pipeline = mongo.Pipeline{
+			matchStage,
+			bson.D{{"$addFields", bson.D{{"randomKey", bson.D{{"$pow", bson.A{
+				bson.D{{"$rand", bson.D{}}},
+				bson.D{{"$divide", bson.A{1, weight}}},
+			}}}}}}},
+			bson.D{{"$sort", bson.D{{"randomKey", -1}}}},
+			bson.D{{"$limit", size}},
+			lookupStage,
+			unwindStage,
+		}
+	}
+
+	collection := repo.getCollection(FAVORITE_WORD_MEANING_COLLECTION)
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	type result struct {
+		Id          primitive.ObjectID `bson:"_id,omitempty"`
+		WordMeaning model.WordMeaning  `bson:"wordMeaning"`
+	}
+
+	var results []result
+	if err = cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	wordMeanings = []model.WordMeaning{}
+
+	for i := range results {
+		results[i].WordMeaning.FavoriteWordMeaningId = results[i].Id
+		wordMeanings = append(wordMeanings, results[i].WordMeaning)
+	}
+
+	return wordMeanings, nil
+}
+
+func (repo *MongoDBRepository) FindFavoriteWordMeaningsByUserIdAndIds(
+	ctx context.Context,
+	userId string,
+	favoriteWordMeaningIds []string,
+) (wordMeanings []model.WordMeaning, err error) {
+	ids := []primitive.ObjectID{}
+
+	for _, favoriteWordMeaningId := range favoriteWordMeaningIds {
+		id, err := primitive.ObjectIDFromHex(favoriteWordMeaningId)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	// 只查詢使用者自己的資料
+	matchStage := bson.D{{"$match", bson.D{
+		{"_id", bson.D{{"$in", ids}}},
+		{"userId", userId},
+	}}}
+	lookupStage := bson.D{{
+		"$lookup", bson.D{
+			{"from", "wordmeanings"},
+			{"localField", "wordMeaningId"},
+			{"foreignField", "_id"},
+			{"as", "wordMeaning"},
+		},
+	}}
+	unwindStage := bson.D{{"$unwind", "$wordMeaning"}}
+	sortStage := bson.D{{"$sort", favoriteWordMeaningSort}}
+
+	collection := repo.getCollection(FAVORITE_WORD_MEANING_COLLECTION)
+	cursor, err := collection.Aggregate(
+		ctx,
+		mongo.Pipeline{matchStage, lookupStage, unwindStage, sortStage},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	type result struct {
+		Id          primitive.ObjectID `bson:"_id,omitempty"`
+		WordMeaning model.WordMeaning  `bson:"wordMeaning"`
+	}
+
+	var results []result
+	if err = cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	wordMeanings = []model.WordMeaning{}
+
+	for i := range results {
+		results[i].WordMeaning.FavoriteWordMeaningId = results[i].Id
+		wordMeanings = append(wordMeanings, results[i].WordMeaning)
+	}
+
+	return wordMeanings, nil
+}
+
+func (repo *MongoDBRepository) IncreaseFavoriteWordMeaningsReviewedTimes(
+	ctx context.Context,
+	userId string,
+	favoriteWordMeaningIds []string,
+) (modifiedCount int32, err error) {
+	ids := []primitive.ObjectID{}
+
+	for _, favoriteWordMeaningId := range favoriteWordMeaningIds {
+		id, err := primitive.ObjectIDFromHex(favoriteWordMeaningId)
+		if err != nil {
+			return 0, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	// 只更新使用者自己的資料
+	filter := bson.D{
+		{"_id", bson.D{{"$in", ids}}},
+		{"userId", userId},
+	}
+	// 不更新 updatedAt，避免複習後改變收藏清單的分頁順序
+	update := bson.D{{"$inc", bson.D{{"reviewedTimes", 1}}}}
+	collection := repo.getCollection(FAVORITE_WORD_MEANING_COLLECTION)
+	result, err := collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(result.ModifiedCount), nil
+}
+
 func (repo *MongoDBRepository) DeleteFavoriteWordMeaningById(
 	ctx context.Context,
 	favoriteWordMeaningId string,
@@ -333,6 +595,233 @@ func (repo *MongoDBRepository) DeleteFavoriteWordMeaningById(
 	return int32(result.DeletedCount), nil
 }
 
+func (repo *MongoDBRepository) UpsertLookupHistoryByTimesPlusOne(
+	ctx context.Context,
+	userId, word string,
+) (modifiedCount, upsertedCount int32, err error) {
+	filter := bson.D{
+		{"userId", userId},
+		{"word", word},
+	}
+
+	now := time.Now()
+
+	// times 遞增 1，若是新增則補上新增日期時間
+	update := bson.D{
+		{"$inc", bson.D{
+			{"times", 1},
+		}},
+		{"$set", bson.D{
+			{"updatedAt", now},
+		}},
+		{"$setOnInsert", bson.D{
+			{"createdAt", now},
+		}},
+	}
+
+	// Enable update or insert
+	opts := options.Update().SetUpsert(true)
+
+	collection := repo.getCollection(LOOKUP_HISTORY_COLLECTION)
+	result, err := collection.UpdateOne(ctx, filter, update, opts)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return int32(result.ModifiedCount), int32(result.UpsertedCount), nil
+}
+
+func (repo *MongoDBRepository) FindLookupHistoriesByUserIdOrderByUpdatedAtDesc(
+	ctx context.Context,
+	userId string,
+	limit int32,
+) (lookupHistories []model.LookupHistory, err error) {
+	collection := repo.getCollection(LOOKUP_HISTORY_COLLECTION)
+	filter := bson.D{{"userId", userId}}
+	opts := options.Find().SetSort(pageSort).SetLimit(int64(limit))
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	lookupHistories = []model.LookupHistory{}
+	if err = cursor.All(ctx, &lookupHistories); err != nil {
+		return nil, err
+	}
+
+	return lookupHistories, nil
+}
+
+// 刪除最近 skip 筆以外較舊的查詢紀錄，用來限制每個使用者保留的筆數
+func (repo *MongoDBRepository) DeleteLookupHistoriesByUserIdAndSkip(
+	ctx context.Context,
+	userId string,
+	skip int32,
+) (deletedCount int32, err error) {
+	collection := repo.getCollection(LOOKUP_HISTORY_COLLECTION)
+	filter := bson.D{{"userId", userId}}
+	opts := options.Find().
+		SetSort(pageSort).
+		SetSkip(int64(skip)).
+		SetProjection(bson.D{{"_id", 1}})
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	var lookupHistories []model.LookupHistory
+	if err = cursor.All(ctx, &lookupHistories); err != nil {
+		return 0, err
+	}
+
+	if len(lookupHistories) == 0 {
+		return 0, nil
+	}
+
+	ids := []primitive.ObjectID{}
+
+	for _, lookupHistory := range lookupHistories {
+		ids = append(ids, lookupHistory.Id)
+	}
+
+	result, err := collection.DeleteMany(ctx, bson.D{{"_id", bson.D{{"$in", ids}}}})
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(result.DeletedCount), nil
+}
+
+func (repo *MongoDBRepository) DeleteLookupHistoriesByUserId(
+	ctx context.Context,
+	userId string,
+) (deletedCount int32, err error) {
+	filter := bson.D{
+		{"userId", userId},
+	}
+	collection := repo.getCollection(LOOKUP_HISTORY_COLLECTION)
+	result, err := collection.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(result.DeletedCount), nil
+}
+
+// 從使用者喜歡的單字解釋的例句中，隨機取出有音檔的例句
+func (repo *MongoDBRepository) FindRandomFavoriteSentencesByUserId(
+	ctx context.Context,
+	userId string,
+	size int32,
+) (favoriteSentences []FavoriteSentence, err error) {
+	pipeline := mongo.Pipeline{
+		bson.D{{"$match", bson.D{{"userId", userId}}}},
+		bson.D{{
+			"$lookup", bson.D{
+				{"from", "wordmeanings"},
+				{"localField", "wordMeaningId"},
+				{"foreignField", "_id"},
+				{"as", "wordMeaning"},
+			},
+		}},
+		bson.D{{"$unwind", "$wordMeaning"}},
+		bson.D{{"$unwind", "$wordMeaning.examples"}},
+		bson.D{{"$unwind", "$wordMeaning.examples.examples"}},
+		bson.D{{"$match", bson.D{
+			{"wordMeaning.examples.examples.audioUrl", bson.D{{"$nin", bson.A{"", nil}}}},
+			{"wordMeaning.examples.examples.text", bson.D{{"$nin", bson.A{"", nil}}}},
+		}}},
+		bson.D{{"$sample", bson.D{{"size", size}}}},
+		bson.D{{"$project", bson.D{
+			{"_id", 0},
+			{"wordMeaningId", "$wordMeaning._id"},
+			{"word", "$wordMeaning.word"},
+			{"audioUrl", "$wordMeaning.examples.examples.audioUrl"},
+			{"text", "$wordMeaning.examples.examples.text"},
+		}}},
+	}
+
+	collection := repo.getCollection(FAVORITE_WORD_MEANING_COLLECTION)
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	favoriteSentences = []FavoriteSentence{}
+
+	if err = cursor.All(ctx, &favoriteSentences); err != nil {
+		return nil, err
+	}
+
+	return favoriteSentences, nil
+}
+
+func (repo *MongoDBRepository) CreateDictationRecord(
+	ctx context.Context,
+	dictationRecord model.DictationRecord,
+) (dictationRecordId string, err error) {
+	now := time.Now()
+	dictationRecord.CreatedAt = now
+	dictationRecord.UpdatedAt = now
+
+	collection := repo.getCollection(DICTATION_RECORD_COLLECTION)
+	result, err := collection.InsertOne(ctx, dictationRecord)
+	if err != nil {
+		return "", err
+	}
+
+	dictationRecordId = result.InsertedID.(primitive.ObjectID).Hex()
+	return dictationRecordId, nil
+}
+
+func (repo *MongoDBRepository) GetDictationRecordById(
+	ctx context.Context,
+	dictationRecordId string,
+) (dictationRecord *model.DictationRecord, err error) {
+	id, err := primitive.ObjectIDFromHex(dictationRecordId)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.D{{"_id", id}}
+	var result model.DictationRecord
+	collection := repo.getCollection(DICTATION_RECORD_COLLECTION)
+	err = collection.FindOne(ctx, filter).Decode(&result)
+
+	if err != nil {
+		// 查無資料不視為錯誤
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (repo *MongoDBRepository) FindDictationRecordsByUserIdOrderByCreatedAtDesc(
+	ctx context.Context,
+	userId string,
+	limit int32,
+) (dictationRecords []model.DictationRecord, err error) {
+	collection := repo.getCollection(DICTATION_RECORD_COLLECTION)
+	filter := bson.D{{"userId", userId}}
+	sort := bson.D{{"createdAt", -1}, {"_id", -1}} // descending
+	opts := options.Find().SetSort(sort).SetLimit(int64(limit))
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	dictationRecords = []model.DictationRecord{}
+	if err = cursor.All(ctx, &dictationRecords); err != nil {
+		return nil, err
+	}
+
+	return dictationRecords, nil
+}
+
 func (repo *MongoDBRepository) WithTransaction(
 	ctx context.Context,
 	transactoinFunc transactionFunc,
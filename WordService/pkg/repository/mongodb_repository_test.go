@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -733,19 +735,121 @@ func (s *MyTestSuite) TestFindFavoriteWordMeaningsByUserIdAndWord() {
 			args := tc.newArgs(*tc.setupDB(s))
 
 			// Test
-			wordMeanings, err := s.repo.FindFavoriteWordMeaningsByUserIdAndWord(
+			wordMeanings, nextCursor, err := s.repo.FindFavoriteWordMeaningsByUserIdAndWord(
 				args.ctx,
 				args.userId,
 				args.word,
+				"",
 				args.skip,
 				args.limit,
 			)
 			s.Nil(err)
 			s.Len(wordMeanings, tc.expectedLength)
+			s.Empty(nextCursor)
 		})
 	}
 }
 
+func (s *MyTestSuite) TestFindFavoriteWordMeaningsByUserIdAndWord_WhenUseCursor() {
+	ctx := context.Background()
+	userId := "cursorUser01"
+	word := "TestFindFavoriteWordMeaningsByUserIdAndWord_WhenUseCursor"
+	now := time.Now()
+	size := 13
+	wordMeaningDocuments := []interface{}{}
+
+	for i := 0; i < size; i++ {
+		wordMeaningDocuments = append(wordMeaningDocuments, model.WordMeaning{
+			Word:         word,
+			PartOfSpeech: "partOfSpeech",
+			Definition:   fmt.Sprintf("this is a definition %d", i+1),
+			Examples:     []model.Example{},
+			OrderByNo:    int32(i + 1),
+			QueryByWords: []string{word},
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		})
+	}
+
+	result, err := s.wordMeaningCollection.InsertMany(ctx, wordMeaningDocuments)
+	s.Nil(err)
+
+	// 反向收藏，讓收藏的 _id 順序與解釋順序相反
+	favoriteWordMeaningDocuments := []interface{}{}
+
+	for i := size - 1; i >= 0; i-- {
+		favoriteWordMeaningDocuments = append(
+			favoriteWordMeaningDocuments,
+			model.FavoriteWordMeaning{
+				UserId:        userId,
+				WordMeaningId: result.InsertedIDs[i].(primitive.ObjectID),
+				CreatedAt:     now,
+				UpdatedAt:     now,
+			},
+		)
+	}
+
+	_, err = s.favoriteWordMeaningCollection.InsertMany(ctx, favoriteWordMeaningDocuments)
+	s.Nil(err)
+
+	// 依 cursor 逐頁查詢，資料須依解釋順序排列且不可重複或遺漏
+	favoriteWordMeaningIds := map[primitive.ObjectID]bool{}
+	orderByNos := []int32{}
+	cursor := ""
+	pageCount := 0
+
+	for pageCount < size {
+		wordMeanings, nextCursor, err := s.repo.FindFavoriteWordMeaningsByUserIdAndWord(
+			ctx,
+			userId,
+			word,
+			cursor,
+			0,
+			5,
+		)
+		s.Nil(err)
+		pageCount++
+
+		for _, wordMeaning := range wordMeanings {
+			s.False(favoriteWordMeaningIds[wordMeaning.FavoriteWordMeaningId])
+			favoriteWordMeaningIds[wordMeaning.FavoriteWordMeaningId] = true
+			orderByNos = append(orderByNos, wordMeaning.OrderByNo)
+		}
+
+		if nextCursor == "" {
+			break
+		}
+
+		// 翻頁期間複習收藏會更新 updatedAt，不可影響之後的頁面
+		_, err = s.favoriteWordMeaningCollection.UpdateMany(
+			ctx,
+			bson.D{{"userId", userId}},
+			bson.D{{"$set", bson.D{{"updatedAt", time.Now()}}}},
+		)
+		s.Nil(err)
+
+		cursor = nextCursor
+	}
+
+	s.Len(favoriteWordMeaningIds, size)
+	s.Equal(3, pageCount)
+
+	for i, orderByNo := range orderByNos {
+		s.Equal(int32(i+1), orderByNo)
+	}
+
+	// 不合法的 cursor
+	_, _, err = s.repo.FindFavoriteWordMeaningsByUserIdAndWord(
+		ctx,
+		userId,
+		word,
+		"invalid cursor",
+		0,
+		5,
+	)
+	s.NotNil(err)
+}
+
 func (s *MyTestSuite) TestCountFavoriteWordMeaningsByUserIdAndWord() {
 	type args struct {
 		ctx    context.Context
@@ -939,6 +1043,285 @@ func (s *MyTestSuite) TestCountFavoriteWordMeaningsByUserIdAndWord() {
 	}
 }
 
+func (s *MyTestSuite) TestFindRandomFavoriteWordMeaningsByUserId() {
+	type args struct {
+		ctx       context.Context
+		userId    string
+		size      int32
+		weighting string
+	}
+
+	ctx := context.Background()
+
+	setupDB := func(s *MyTestSuite, userId string, size int) {
+		now := time.Now()
+		wordMeaningDocuments := []interface{}{}
+
+		for i := 0; i < size; i++ {
+			wordMeaningDocuments = append(wordMeaningDocuments, model.WordMeaning{
+				Word:         fmt.Sprintf("TestFindRandomFavoriteWordMeaningsByUserId%d", i+1),
+				PartOfSpeech: "partOfSpeech",
+				DefGram:      "defGram",
+				Definition:   fmt.Sprintf("this is a definition %d", i+1),
+				Examples:     []model.Example{},
+				OrderByNo:    1,
+				CreatedAt:    now,
+				UpdatedAt:    now,
+			})
+		}
+
+		result, err := s.wordMeaningCollection.InsertMany(ctx, wordMeaningDocuments)
+		s.Nil(err)
+		s.Equal(size, len(result.InsertedIDs))
+
+		favoriteWordMeaningDocuments := []interface{}{}
+
+		for i := 0; i < size; i++ {
+			favoriteWordMeaningDocuments = append(
+				favoriteWordMeaningDocuments,
+				model.FavoriteWordMeaning{
+					UserId:        userId,
+					WordMeaningId: result.InsertedIDs[i].(primitive.ObjectID),
+					ReviewedTimes: int32(i),
+				},
+			)
+		}
+
+		result2, err := s.favoriteWordMeaningCollection.InsertMany(
+			ctx,
+			favoriteWordMeaningDocuments,
+		)
+		s.Nil(err)
+		s.Equal(size, len(result2.InsertedIDs))
+	}
+
+	testCases := []struct {
+		name           string
+		setupDB        func(s *MyTestSuite)
+		args           *args
+		expectedLength int
+	}{
+		{
+			name: "Find random favoriteWordMeaning01",
+			setupDB: func(s *MyTestSuite) {
+				setupDB(s, "randomUser01", 20)
+			},
+			args: &args{
+				ctx:       ctx,
+				userId:    "randomUser01",
+				size:      10,
+				weighting: RANDOM_WEIGHTING_NONE,
+			},
+			expectedLength: 10,
+		},
+		{
+			name: "Find random favoriteWordMeaning02",
+			setupDB: func(s *MyTestSuite) {
+				setupDB(s, "randomUser02", 3)
+			},
+			args: &args{
+				ctx:       ctx,
+				userId:    "randomUser02",
+				size:      10,
+				weighting: RANDOM_WEIGHTING_NONE,
+			},
+			expectedLength: 3,
+		},
+		{
+			name: "Find random favoriteWordMeaning with recent weighting",
+			setupDB: func(s *MyTestSuite) {
+				setupDB(s, "randomUser03", 20)
+			},
+			args: &args{
+				ctx:       ctx,
+				userId:    "randomUser03",
+				size:      10,
+				weighting: RANDOM_WEIGHTING_RECENT,
+			},
+			expectedLength: 10,
+		},
+		{
+			name: "Find random favoriteWordMeaning with less reviewed weighting",
+			setupDB: func(s *MyTestSuite) {
+				setupDB(s, "randomUser04", 5)
+			},
+			args: &args{
+				ctx:       ctx,
+				userId:    "randomUser04",
+				size:      10,
+				weighting: RANDOM_WEIGHTING_LESS_REVIEWED,
+			},
+			expectedLength: 5,
+		},
+		{
+			name:    "Find random favoriteWordMeaning when user has no favorites",
+			setupDB: func(s *MyTestSuite) {},
+			args: &args{
+				ctx:       ctx,
+				userId:    "randomUser05",
+				size:      10,
+				weighting: RANDOM_WEIGHTING_NONE,
+			},
+			expectedLength: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			tc.setupDB(s)
+			args := tc.args
+
+			// Test
+			wordMeanings, err := s.repo.FindRandomFavoriteWordMeaningsByUserId(
+				args.ctx,
+				args.userId,
+				args.size,
+				args.weighting,
+			)
+			s.Nil(err)
+			s.Len(wordMeanings, tc.expectedLength)
+
+			// 不可重複
+			favoriteWordMeaningIds := map[primitive.ObjectID]bool{}
+
+			for _, wordMeaning := range wordMeanings {
+				s.False(wordMeaning.FavoriteWordMeaningId.IsZero())
+				s.False(favoriteWordMeaningIds[wordMeaning.FavoriteWordMeaningId])
+				favoriteWordMeaningIds[wordMeaning.FavoriteWordMeaningId] = true
+			}
+		})
+	}
+}
+
+func (s *MyTestSuite) TestFindFavoriteWordMeaningsByUserIdAndIds() {
+	ctx := context.Background()
+	now := time.Now()
+	result, err := s.wordMeaningCollection.InsertMany(
+		ctx,
+		[]interface{}{
+			model.WordMeaning{
+				Word:       "TestFindFavoriteWordMeaningsByUserIdAndIds02",
+				Definition: "this is a definition 2",
+				Examples:   []model.Example{},
+				OrderByNo:  1,
+				CreatedAt:  now,
+				UpdatedAt:  now,
+			},
+			model.WordMeaning{
+				Word:       "TestFindFavoriteWordMeaningsByUserIdAndIds01",
+				Definition: "this is a definition 1",
+				Examples:   []model.Example{},
+				OrderByNo:  1,
+				CreatedAt:  now,
+				UpdatedAt:  now,
+			},
+		},
+	)
+	s.Nil(err)
+
+	result2, err := s.favoriteWordMeaningCollection.InsertMany(
+		ctx,
+		[]interface{}{
+			model.FavoriteWordMeaning{
+				UserId:        "deckUser01",
+				WordMeaningId: result.InsertedIDs[0].(primitive.ObjectID),
+			},
+			model.FavoriteWordMeaning{
+				UserId:        "deckUser01",
+				WordMeaningId: result.InsertedIDs[1].(primitive.ObjectID),
+			},
+			model.FavoriteWordMeaning{
+				UserId:        "deckUser02",
+				WordMeaningId: result.InsertedIDs[1].(primitive.ObjectID),
+			},
+		},
+	)
+	s.Nil(err)
+
+	favoriteWordMeaningIds := []string{}
+
+	for _, insertedId := range result2.InsertedIDs {
+		favoriteWordMeaningIds = append(
+			favoriteWordMeaningIds,
+			insertedId.(primitive.ObjectID).Hex(),
+		)
+	}
+
+	// Test
+	wordMeanings, err := s.repo.FindFavoriteWordMeaningsByUserIdAndIds(
+		ctx,
+		"deckUser01",
+		favoriteWordMeaningIds,
+	)
+	s.Nil(err)
+
+	// 不會查詢到別人的資料，並依單字排序
+	s.Len(wordMeanings, 2)
+	s.Equal("TestFindFavoriteWordMeaningsByUserIdAndIds01", wordMeanings[0].Word)
+	s.Equal(favoriteWordMeaningIds[1], wordMeanings[0].FavoriteWordMeaningId.Hex())
+	s.Equal("TestFindFavoriteWordMeaningsByUserIdAndIds02", wordMeanings[1].Word)
+	s.Equal(favoriteWordMeaningIds[0], wordMeanings[1].FavoriteWordMeaningId.Hex())
+
+	// 不合法的 id
+	_, err = s.repo.FindFavoriteWordMeaningsByUserIdAndIds(
+		ctx,
+		"deckUser01",
+		[]string{"invalid id"},
+	)
+	s.NotNil(err)
+}
+
+func (s *MyTestSuite) TestIncreaseFavoriteWordMeaningsReviewedTimes() {
+	ctx := context.Background()
+	result, err := s.favoriteWordMeaningCollection.InsertMany(
+		ctx,
+		[]interface{}{
+			model.FavoriteWordMeaning{
+				UserId:        "randomUser01",
+				WordMeaningId: primitive.NewObjectID(),
+			},
+			model.FavoriteWordMeaning{
+				UserId:        "randomUser01",
+				WordMeaningId: primitive.NewObjectID(),
+				ReviewedTimes: 3,
+			},
+			model.FavoriteWordMeaning{
+				UserId:        "randomUser02",
+				WordMeaningId: primitive.NewObjectID(),
+			},
+		},
+	)
+	s.Nil(err)
+
+	favoriteWordMeaningIds := []string{}
+
+	for _, insertedId := range result.InsertedIDs {
+		favoriteWordMeaningIds = append(
+			favoriteWordMeaningIds,
+			insertedId.(primitive.ObjectID).Hex(),
+		)
+	}
+
+	// Test
+	modifiedCount, err := s.repo.IncreaseFavoriteWordMeaningsReviewedTimes(
+		ctx,
+		"randomUser01",
+		favoriteWordMeaningIds,
+	)
+	s.Nil(err)
+	s.EqualValues(2, modifiedCount)
+
+	favoriteWordMeaning, err := s.repo.GetFavoriteWordMeaningById(ctx, favoriteWordMeaningIds[1])
+	s.Nil(err)
+	s.EqualValues(4, favoriteWordMeaning.ReviewedTimes)
+
+	// 不會更新別人的資料
+	favoriteWordMeaning, err = s.repo.GetFavoriteWordMeaningById(ctx, favoriteWordMeaningIds[2])
+	s.Nil(err)
+	s.EqualValues(0, favoriteWordMeaning.ReviewedTimes)
+}
+
 func (s *MyTestSuite) TestDeleteFavoriteWordMeaningById() {
 	type args struct {
 		ctx                   context.Context
@@ -1023,3 +1406,219 @@ func (s *MyTestSuite) TestDeleteFavoriteWordMeaningById() {
 		})
 	}
 }
+
+func (s *MyTestSuite) TestUpsertLookupHistoryByTimesPlusOne() {
+	ctx := context.Background()
+	userId := "lookupUser01"
+
+	// Test
+	modifiedCount, upsertedCount, err := s.repo.UpsertLookupHistoryByTimesPlusOne(
+		ctx,
+		userId,
+		"test",
+	)
+	s.Nil(err)
+	s.EqualValues(0, modifiedCount)
+	s.EqualValues(1, upsertedCount)
+
+	modifiedCount, upsertedCount, err = s.repo.UpsertLookupHistoryByTimesPlusOne(
+		ctx,
+		userId,
+		"test",
+	)
+	s.Nil(err)
+	s.EqualValues(1, modifiedCount)
+	s.EqualValues(0, upsertedCount)
+
+	lookupHistories, err := s.repo.FindLookupHistoriesByUserIdOrderByUpdatedAtDesc(
+		ctx,
+		userId,
+		10,
+	)
+	s.Nil(err)
+	s.Len(lookupHistories, 1)
+	s.Equal("test", lookupHistories[0].Word)
+	s.EqualValues(2, lookupHistories[0].Times)
+	s.False(lookupHistories[0].CreatedAt.IsZero())
+}
+
+func (s *MyTestSuite) TestUpsertLookupHistoryByTimesPlusOne_WhenConcurrent() {
+	ctx := context.Background()
+	userId := "lookupUser02"
+	size := 10
+
+	// 同時查詢同一個單字，唯一索引確保只會有一筆紀錄
+	var wg sync.WaitGroup
+	errs := make(chan error, size)
+
+	for i := 0; i < size; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, err := s.repo.UpsertLookupHistoryByTimesPlusOne(ctx, userId, "test")
+			errs <- err
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		s.Nil(err)
+	}
+
+	lookupHistories, err := s.repo.FindLookupHistoriesByUserIdOrderByUpdatedAtDesc(
+		ctx,
+		userId,
+		10,
+	)
+	s.Nil(err)
+	s.Len(lookupHistories, 1)
+	s.EqualValues(size, lookupHistories[0].Times)
+}
+
+func (s *MyTestSuite) TestFindLookupHistoriesByUserIdOrderByUpdatedAtDesc() {
+	ctx := context.Background()
+	userId := "lookupUser02"
+
+	for _, word := range []string{"apple", "book", "cat"} {
+		_, _, err := s.repo.UpsertLookupHistoryByTimesPlusOne(ctx, userId, word)
+		s.Nil(err)
+	}
+
+	// 再查一次 apple，應該排到最前面，間隔一下避免 updatedAt 相同
+	time.Sleep(10 * time.Millisecond)
+	_, _, err := s.repo.UpsertLookupHistoryByTimesPlusOne(ctx, userId, "apple")
+	s.Nil(err)
+
+	// Test
+	lookupHistories, err := s.repo.FindLookupHistoriesByUserIdOrderByUpdatedAtDesc(
+		ctx,
+		userId,
+		2,
+	)
+	s.Nil(err)
+	s.Len(lookupHistories, 2)
+	s.Equal("apple", lookupHistories[0].Word)
+	s.Equal("cat", lookupHistories[1].Word)
+}
+
+func (s *MyTestSuite) TestDeleteLookupHistoriesByUserIdAndSkip() {
+	ctx := context.Background()
+	userId := "lookupUser03"
+
+	for _, word := range []string{"apple", "book", "cat"} {
+		_, _, err := s.repo.UpsertLookupHistoryByTimesPlusOne(ctx, userId, word)
+		s.Nil(err)
+	}
+
+	// Test
+	deletedCount, err := s.repo.DeleteLookupHistoriesByUserIdAndSkip(ctx, userId, 2)
+	s.Nil(err)
+	s.EqualValues(1, deletedCount)
+
+	lookupHistories, err := s.repo.FindLookupHistoriesByUserIdOrderByUpdatedAtDesc(
+		ctx,
+		userId,
+		10,
+	)
+	s.Nil(err)
+	s.Len(lookupHistories, 2)
+	s.Equal("cat", lookupHistories[0].Word)
+	s.Equal("book", lookupHistories[1].Word)
+}
+
+func (s *MyTestSuite) TestDeleteLookupHistoriesByUserId() {
+	ctx := context.Background()
+	userId := "lookupUser04"
+
+	for _, word := range []string{"apple", "book"} {
+		_, _, err := s.repo.UpsertLookupHistoryByTimesPlusOne(ctx, userId, word)
+		s.Nil(err)
+	}
+
+	// Test
+	deletedCount, err := s.repo.DeleteLookupHistoriesByUserId(ctx, userId)
+	s.Nil(err)
+	s.EqualValues(2, deletedCount)
+}
+
+func (s *MyTestSuite) TestFindRandomFavoriteSentencesByUserId() {
+	ctx := context.Background()
+	userId := "dictationUser01"
+
+	result, err := s.wordMeaningCollection.InsertOne(ctx, model.WordMeaning{
+		Word: "dictation",
+		Examples: []model.Example{
+			{
+				Examples: []model.Sentence{
+					{
+						AudioUrl: "https://example.com/dictation01.mp3",
+						Text:     "The teacher gave us a dictation.",
+					},
+					{
+						AudioUrl: "",
+						Text:     "This sentence has no audio.",
+					},
+				},
+			},
+		},
+	})
+	s.Nil(err)
+	wordMeaningId := result.InsertedID.(primitive.ObjectID)
+
+	_, err = s.favoriteWordMeaningCollection.InsertOne(ctx, model.FavoriteWordMeaning{
+		UserId:        userId,
+		WordMeaningId: wordMeaningId,
+	})
+	s.Nil(err)
+
+	// Test
+	favoriteSentences, err := s.repo.FindRandomFavoriteSentencesByUserId(ctx, userId, 10)
+	s.Nil(err)
+	s.Equal([]FavoriteSentence{
+		{
+			WordMeaningId: wordMeaningId,
+			Word:          "dictation",
+			AudioUrl:      "https://example.com/dictation01.mp3",
+			Text:          "The teacher gave us a dictation.",
+		},
+	}, favoriteSentences)
+
+	wordMeaning, err := s.repo.GetWordMeaningById(ctx, wordMeaningId.Hex())
+	s.Nil(err)
+	s.Equal("dictation", wordMeaning.Word)
+}
+
+func (s *MyTestSuite) TestCreateDictationRecord() {
+	ctx := context.Background()
+	userId := "dictationUser02"
+
+	for _, accuracy := range []float64{50, 100} {
+		dictationRecordId, err := s.repo.CreateDictationRecord(ctx, model.DictationRecord{
+			UserId:   userId,
+			Word:     "test",
+			Accuracy: accuracy,
+		})
+		s.Nil(err)
+
+		dictationRecord, err := s.repo.GetDictationRecordById(ctx, dictationRecordId)
+		s.Nil(err)
+		s.Equal(accuracy, dictationRecord.Accuracy)
+		s.False(dictationRecord.CreatedAt.IsZero())
+
+		// 間隔一下避免 createdAt 相同
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Test
+	dictationRecords, err := s.repo.FindDictationRecordsByUserIdOrderByCreatedAtDesc(
+		ctx,
+		userId,
+		10,
+	)
+	s.Nil(err)
+	s.Len(dictationRecords, 2)
+	s.Equal(100.0, dictationRecords[0].Accuracy)
+	s.Equal(50.0, dictationRecords[1].Accuracy)
+}
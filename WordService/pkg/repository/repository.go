@@ -3,9 +3,26 @@ package repository
 import (
 	"context"
 
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
 	"github.com/kakurineuin/learn-english-microservices/word-service/pkg/model"
 )
 
+// 隨機取出喜歡的單字解釋時的加權方式
+const (
+	RANDOM_WEIGHTING_NONE          = ""
+	RANDOM_WEIGHTING_RECENT        = "recent"        // 偏重最近加入的
+	RANDOM_WEIGHTING_LESS_REVIEWED = "less_reviewed" // 偏重較少複習的
+)
+
+// 喜歡的單字解釋中的一句例句，用於聽寫練習
+type FavoriteSentence struct {
+	WordMeaningId primitive.ObjectID `bson:"wordMeaningId"`
+	Word          string             `bson:"word"`
+	AudioUrl      string             `bson:"audioUrl"`
+	Text          string             `bson:"text"`
+}
+
 type transactionFunc func(ctx context.Context) (interface{}, error)
 
 //go:generate mockery --name DatabaseRepository
@@ -25,6 +42,10 @@ type DatabaseRepository interface {
 		ctx context.Context,
 		word, userId string,
 	) (wordMeanings []model.WordMeaning, err error)
+	GetWordMeaningById(
+		ctx context.Context,
+		wordMeaningId string,
+	) (wordMeaning *model.WordMeaning, err error)
 
 	// FavoriteWordMeaning
 	CreateFavoriteWordMeaning(
@@ -37,15 +58,71 @@ type DatabaseRepository interface {
 	) (favoriteWordMeaning *model.FavoriteWordMeaning, err error)
 	FindFavoriteWordMeaningsByUserIdAndWord(
 		ctx context.Context,
-		userId, word string,
+		userId, word, cursor string,
 		skip, limit int32,
-	) (wordMeanings []model.WordMeaning, err error)
+	) (wordMeanings []model.WordMeaning, nextCursor string, err error)
 	CountFavoriteWordMeaningsByUserIdAndWord(
 		ctx context.Context,
 		userId, word string,
 	) (count int32, err error)
+	FindRandomFavoriteWordMeaningsByUserId(
+		ctx context.Context,
+		userId string,
+		size int32,
+		weighting string,
+	) (wordMeanings []model.WordMeaning, err error)
+	FindFavoriteWordMeaningsByUserIdAndIds(
+		ctx context.Context,
+		userId string,
+		favoriteWordMeaningIds []string,
+	) (wordMeanings []model.WordMeaning, err error)
+	IncreaseFavoriteWordMeaningsReviewedTimes(
+		ctx context.Context,
+		userId string,
+		favoriteWordMeaningIds []string,
+	) (modifiedCount int32, err error)
 	DeleteFavoriteWordMeaningById(
 		ctx context.Context,
 		favoriteWordMeaningId string,
 	) (deletedCount int32, err error)
+
+	// LookupHistory
+	UpsertLookupHistoryByTimesPlusOne(
+		ctx context.Context,
+		userId, word string,
+	) (modifiedCount, upsertedCount int32, err error)
+	FindLookupHistoriesByUserIdOrderByUpdatedAtDesc(
+		ctx context.Context,
+		userId string,
+		limit int32,
+	) (lookupHistories []model.LookupHistory, err error)
+	DeleteLookupHistoriesByUserIdAndSkip(
+		ctx context.Context,
+		userId string,
+		skip int32,
+	) (deletedCount int32, err error)
+	DeleteLookupHistoriesByUserId(
+		ctx context.Context,
+		userId string,
+	) (deletedCount int32, err error)
+
+	// DictationRecord
+	FindRandomFavoriteSentencesByUserId(
+		ctx context.Context,
+		userId string,
+		size int32,
+	) (favoriteSentences []FavoriteSentence, err error)
+	CreateDictationRecord(
+		ctx context.Context,
+		dictationRecord model.DictationRecord,
+	) (dictationRecordId string, err error)
+	GetDictationRecordById(
+		ctx context.Context,
+		dictationRecordId string,
+	) (dictationRecord *model.DictationRecord, err error)
+	FindDictationRecordsByUserIdOrderByCreatedAtDesc(
+		ctx context.Context,
+		userId string,
+		limit int32,
+	) (dictationRecords []model.DictationRecord, err error)
 }
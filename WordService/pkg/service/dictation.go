@@ -0,0 +1,339 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/kakurineuin/learn-english-microservices/word-service/pkg/model"
+)
+
+// 聽寫逐字比對結果的類型
+const (
+	DICTATION_DIFF_CORRECT    = "correct"
+	DICTATION_DIFF_MISSING    = "missing"
+	DICTATION_DIFF_EXTRA      = "extra"
+	DICTATION_DIFF_MISSPELLED = "misspelled"
+)
+
+const (
+	defaultDictationSentenceSize = 10
+	maxDictationSentenceSize     = 20
+	maxDictationHistorySize      = 100
+	maxDictationAnswerLength     = 1000
+)
+
+// 聽寫用的例句，不回傳例句內容，避免使用者直接看到答案
+type DictationSentence struct {
+	WordMeaningId string
+	Word          string
+	AudioUrl      string
+	WordCount     int32
+}
+
+/*
+將句子拆成單字來比對，忽略大小寫與前後的標點符號，
+例如 "Don't  worry, be happy!" => ["don't", "worry", "be", "happy"]
+*/
+func tokenizeDictationText(text string) []string {
+	text = strings.ToLower(strings.ReplaceAll(text, "’", "'"))
+	words := []string{}
+
+	for _, field := range strings.Fields(text) {
+		word := strings.TrimFunc(field, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+
+		if word != "" {
+			words = append(words, word)
+		}
+	}
+
+	return words
+}
+
+// 拼字相近的單字才視為拼錯，容許的字母差異數為單字長度的三分之一，至少一個字母
+func isMisspelledWord(expected, actual string) bool {
+	expectedRunes, actualRunes := []rune(expected), []rune(actual)
+	distances := make([]int, len(actualRunes)+1)
+
+	for j := range distances {
+		distances[j] = j
+	}
+
+	for i := 1; i <= len(expectedRunes); i++ {
+		previous := distances[0]
+		distances[0] = i
+
+		for j := 1; j <= len(actualRunes); j++ {
+			cost := 1
+			if expectedRunes[i-1] == actualRunes[j-1] {
+				cost = 0
+			}
+
+			current := min(previous+cost, distances[j]+1, distances[j-1]+1)
+			previous, distances[j] = distances[j], current
+		}
+	}
+
+	return distances[len(actualRunes)] <= max(1, len(expectedRunes)/3)
+}
+
+/*
+以編輯距離比對例句與答案的單字，
+相同的單字為 correct，拼字相近的單字為 misspelled，
+例句有但答案沒有的為 missing，答案多出來的為 extra，
+拼字差太多的單字視為 missing 加上 extra
+*/
+func diffDictationWords(expected, actual []string) []model.DictationWordDiff {
+	rows, cols := len(expected)+1, len(actual)+1
+	distances := make([][]int, rows)
+
+	for i := range distances {
+		distances[i] = make([]int, cols)
+		distances[i][0] = i
+	}
+
+	for j := 0; j < cols; j++ {
+		distances[0][j] = j
+	}
+
+	for i := 1; i < rows; i++ {
+		for j := 1; j < cols; j++ {
+			distances[i][j] = min(distances[i-1][j]+1, distances[i][j-1]+1)
+
+			if expected[i-1] == actual[j-1] {
+				distances[i][j] = min(distances[i][j], distances[i-1][j-1])
+			} else if isMisspelledWord(expected[i-1], actual[j-1]) {
+				distances[i][j] = min(distances[i][j], distances[i-1][j-1]+1)
+			}
+		}
+	}
+
+	// 從最後往回推出比對的路徑
+	diffs := []model.DictationWordDiff{}
+	i, j := len(expected), len(actual)
+
+	for i > 0 || j > 0 {
+		switch {
+		case i > 0 && j > 0 && expected[i-1] == actual[j-1] &&
+			distances[i][j] == distances[i-1][j-1]:
+			diffs = append(diffs, model.DictationWordDiff{
+				Type:     DICTATION_DIFF_CORRECT,
+				Expected: expected[i-1],
+				Actual:   actual[j-1],
+			})
+			i--
+			j--
+		case i > 0 && j > 0 && distances[i][j] == distances[i-1][j-1]+1 &&
+			isMisspelledWord(expected[i-1], actual[j-1]):
+			diffs = append(diffs, model.DictationWordDiff{
+				Type:     DICTATION_DIFF_MISSPELLED,
+				Expected: expected[i-1],
+				Actual:   actual[j-1],
+			})
+			i--
+			j--
+		case i > 0 && distances[i][j] == distances[i-1][j]+1:
+			diffs = append(diffs, model.DictationWordDiff{
+				Type:     DICTATION_DIFF_MISSING,
+				Expected: expected[i-1],
+			})
+			i--
+		default:
+			diffs = append(diffs, model.DictationWordDiff{
+				Type:   DICTATION_DIFF_EXTRA,
+				Actual: actual[j-1],
+			})
+			j--
+		}
+	}
+
+	for left, right := 0, len(diffs)-1; left < right; left, right = left+1, right-1 {
+		diffs[left], diffs[right] = diffs[right], diffs[left]
+	}
+
+	return diffs
+}
+
+/*
+計算正確率百分比，取到小數第二位，
+多打的單字也會計入分母，避免把整段字典都打上去也能拿到滿分
+*/
+func dictationAccuracy(
+	diffs []model.DictationWordDiff,
+) (accuracy float64, wordCount, correctCount int32) {
+	var extraCount int32
+
+	for _, diff := range diffs {
+		switch diff.Type {
+		case DICTATION_DIFF_CORRECT:
+			wordCount++
+			correctCount++
+		case DICTATION_DIFF_EXTRA:
+			extraCount++
+		default:
+			wordCount++
+		}
+	}
+
+	if wordCount+extraCount == 0 {
+		return 0, wordCount, correctCount
+	}
+
+	accuracy = float64(correctCount) * 100 / float64(wordCount+extraCount)
+	accuracy = math.Round(accuracy*100) / 100
+	return accuracy, wordCount, correctCount
+}
+
+func (wordService wordService) FindDictationSentences(
+	ctx context.Context, userId string, size int32,
+) (dictationSentences []DictationSentence, err error) {
+	errorLogger := wordService.errorLogger
+	errorMessage := "FindDictationSentences failed! error: %w"
+
+	if size <= 0 {
+		size = defaultDictationSentenceSize
+	}
+
+	if size > maxDictationSentenceSize {
+		size = maxDictationSentenceSize
+	}
+
+	favoriteSentences, err := wordService.databaseRepository.FindRandomFavoriteSentencesByUserId(
+		ctx,
+		userId,
+		size,
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	dictationSentences = []DictationSentence{}
+
+	for _, favoriteSentence := range favoriteSentences {
+		dictationSentences = append(dictationSentences, DictationSentence{
+			WordMeaningId: favoriteSentence.WordMeaningId.Hex(),
+			Word:          favoriteSentence.Word,
+			AudioUrl:      favoriteSentence.AudioUrl,
+			WordCount:     int32(len(tokenizeDictationText(favoriteSentence.Text))),
+		})
+	}
+
+	return dictationSentences, nil
+}
+
+func (wordService wordService) GradeDictation(
+	ctx context.Context, userId, wordMeaningId, audioUrl, answer string,
+) (dictationRecord *model.DictationRecord, err error) {
+	errorLogger := wordService.errorLogger
+	errorMessage := "GradeDictation failed! error: %w"
+
+	if utf8.RuneCountInString(answer) > maxDictationAnswerLength {
+		err = fmt.Errorf("Answer is too long: %d", utf8.RuneCountInString(answer))
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	databaseRepository := wordService.databaseRepository
+	wordMeaning, err := databaseRepository.GetWordMeaningById(ctx, wordMeaningId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if wordMeaning == nil {
+		err = fmt.Errorf("WordMeaning not found by id: %s", wordMeaningId)
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	// 以音檔網址找出例句
+	text, found := "", false
+
+	for _, example := range wordMeaning.Examples {
+		for _, sentence := range example.Examples {
+			if audioUrl != "" && sentence.AudioUrl == audioUrl {
+				text, found = sentence.Text, true
+				break
+			}
+		}
+
+		if found {
+			break
+		}
+	}
+
+	if !found {
+		err = fmt.Errorf("Sentence not found by audioUrl: %s", audioUrl)
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	diffs := diffDictationWords(tokenizeDictationText(text), tokenizeDictationText(answer))
+	accuracy, wordCount, correctCount := dictationAccuracy(diffs)
+	record := model.DictationRecord{
+		UserId:        userId,
+		WordMeaningId: wordMeaningId,
+		Word:          wordMeaning.Word,
+		AudioUrl:      audioUrl,
+		Text:          text,
+		Answer:        answer,
+		Accuracy:      accuracy,
+		WordCount:     wordCount,
+		CorrectCount:  correctCount,
+		Diffs:         diffs,
+	}
+
+	dictationRecordId, err := databaseRepository.CreateDictationRecord(ctx, record)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	createdRecord, err := databaseRepository.GetDictationRecordById(ctx, dictationRecordId)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	return createdRecord, nil
+}
+
+func (wordService wordService) FindDictationHistory(
+	ctx context.Context, userId string, size int32,
+) (dictationRecords []model.DictationRecord, averageAccuracy float64, err error) {
+	errorLogger := wordService.errorLogger
+	errorMessage := "FindDictationHistory failed! error: %w"
+
+	if size <= 0 || size > maxDictationHistorySize {
+		size = maxDictationHistorySize
+	}
+
+	dictationRecords, err = wordService.databaseRepository.FindDictationRecordsByUserIdOrderByCreatedAtDesc(
+		ctx,
+		userId,
+		size,
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, 0, fmt.Errorf(errorMessage, err)
+	}
+
+	if len(dictationRecords) == 0 {
+		return dictationRecords, 0, nil
+	}
+
+	total := 0.0
+
+	for _, dictationRecord := range dictationRecords {
+		total += dictationRecord.Accuracy
+	}
+
+	averageAccuracy = math.Round(total/float64(len(dictationRecords))*100) / 100
+	return dictationRecords, averageAccuracy, nil
+}
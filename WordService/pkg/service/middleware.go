@@ -61,8 +61,13 @@ func (mw loggingMiddleware) DeleteFavoriteWordMeaning(
 func (mw loggingMiddleware) FindFavoriteWordMeanings(
 	ctx context.Context,
 	pageIndex, pageSize int32,
-	userId, word string,
-) (total, pageCount int32, favoriteWordMeanings []model.WordMeaning, err error) {
+	userId, word, cursor string,
+) (
+	total, pageCount int32,
+	favoriteWordMeanings []model.WordMeaning,
+	nextCursor string,
+	err error,
+) {
 	defer func() {
 		mw.logger.Log(
 			"method",
@@ -75,15 +80,17 @@ func (mw loggingMiddleware) FindFavoriteWordMeanings(
 			userId,
 			"word",
 			word,
+			"cursor",
+			cursor,
 			"err",
 			err,
 		)
 	}()
-	return mw.next.FindFavoriteWordMeanings(ctx, pageIndex, pageSize, userId, word)
+	return mw.next.FindFavoriteWordMeanings(ctx, pageIndex, pageSize, userId, word, cursor)
 }
 
 func (mw loggingMiddleware) FindRandomFavoriteWordMeanings(
-	ctx context.Context, userId string, size int32,
+	ctx context.Context, userId string, size int32, weighting string,
 ) (wordMeanings []model.WordMeaning, err error) {
 	defer func() {
 		mw.logger.Log(
@@ -93,9 +100,141 @@ func (mw loggingMiddleware) FindRandomFavoriteWordMeanings(
 			userId,
 			"size",
 			size,
+			"weighting",
+			weighting,
+			"err",
+			err,
+		)
+	}()
+	return mw.next.FindRandomFavoriteWordMeanings(ctx, userId, size, weighting)
+}
+
+func (mw loggingMiddleware) FindRecentLookups(
+	ctx context.Context, userId string, size int32,
+) (lookupHistories []model.LookupHistory, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method",
+			"FindRecentLookups",
+			"userId",
+			userId,
+			"size",
+			size,
+			"err",
+			err,
+		)
+	}()
+	return mw.next.FindRecentLookups(ctx, userId, size)
+}
+
+func (mw loggingMiddleware) FindFavoriteWordMeaningsByIds(
+	ctx context.Context, favoriteWordMeaningIds []string, userId string,
+) (wordMeanings []model.WordMeaning, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method",
+			"FindFavoriteWordMeaningsByIds",
+			"favoriteWordMeaningIds size",
+			len(favoriteWordMeaningIds),
+			"userId",
+			userId,
+			"wordMeanings size",
+			len(wordMeanings),
+			"err",
+			err,
+		)
+	}()
+	return mw.next.FindFavoriteWordMeaningsByIds(ctx, favoriteWordMeaningIds, userId)
+}
+
+func (mw loggingMiddleware) MarkFavoriteWordMeaningsReviewed(
+	ctx context.Context, favoriteWordMeaningIds []string, userId string,
+) (modifiedCount int32, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method",
+			"MarkFavoriteWordMeaningsReviewed",
+			"favoriteWordMeaningIds size",
+			len(favoriteWordMeaningIds),
+			"userId",
+			userId,
+			"modifiedCount",
+			modifiedCount,
+			"err",
+			err,
+		)
+	}()
+	return mw.next.MarkFavoriteWordMeaningsReviewed(ctx, favoriteWordMeaningIds, userId)
+}
+
+func (mw loggingMiddleware) ClearLookupHistory(
+	ctx context.Context, userId string,
+) (deletedCount int32, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method",
+			"ClearLookupHistory",
+			"userId",
+			userId,
+			"err",
+			err,
+		)
+	}()
+	return mw.next.ClearLookupHistory(ctx, userId)
+}
+
+func (mw loggingMiddleware) FindDictationSentences(
+	ctx context.Context, userId string, size int32,
+) (dictationSentences []DictationSentence, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method",
+			"FindDictationSentences",
+			"userId",
+			userId,
+			"size",
+			size,
+			"err",
+			err,
+		)
+	}()
+	return mw.next.FindDictationSentences(ctx, userId, size)
+}
+
+func (mw loggingMiddleware) GradeDictation(
+	ctx context.Context, userId, wordMeaningId, audioUrl, answer string,
+) (dictationRecord *model.DictationRecord, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method",
+			"GradeDictation",
+			"userId",
+			userId,
+			"wordMeaningId",
+			wordMeaningId,
+			"audioUrl",
+			audioUrl,
+			"err",
+			err,
+		)
+	}()
+	return mw.next.GradeDictation(ctx, userId, wordMeaningId, audioUrl, answer)
+}
+
+func (mw loggingMiddleware) FindDictationHistory(
+	ctx context.Context, userId string, size int32,
+) (dictationRecords []model.DictationRecord, averageAccuracy float64, err error) {
+	defer func() {
+		mw.logger.Log(
+			"method",
+			"FindDictationHistory",
+			"userId",
+			userId,
+			"size",
+			size,
 			"err",
 			err,
 		)
 	}()
-	return mw.next.FindRandomFavoriteWordMeanings(ctx, userId, size)
+	return mw.next.FindDictationHistory(ctx, userId, size)
 }
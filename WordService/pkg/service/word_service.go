@@ -4,9 +4,6 @@ import (
 	"context"
 	"fmt"
 	"math"
-	"math/rand"
-	"strings"
-	"time"
 
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
@@ -18,6 +15,12 @@ import (
 
 var unauthorizedOperationError = fmt.Errorf("Unauthorized operation")
 
+// 每個使用者最多保留的查詢紀錄筆數
+const maxLookupHistorySize = 100
+
+// 每次最多隨機取出或記錄複習的單字卡數量
+const maxRandomFavoriteWordMeaningSize = 100
+
 type WordService interface {
 	FindWordByDictionary(
 		ctx context.Context, word, userId string) ([]model.WordMeaning, error)
@@ -30,11 +33,37 @@ type WordService interface {
 	FindFavoriteWordMeanings(
 		ctx context.Context,
 		pageIndex, pageSize int32,
-		userId, word string,
-	) (total, pageCount int32, favoriteWordMeanings []model.WordMeaning, err error)
+		userId, word, cursor string,
+	) (
+		total, pageCount int32,
+		favoriteWordMeanings []model.WordMeaning,
+		nextCursor string,
+		err error,
+	)
 	FindRandomFavoriteWordMeanings(
-		ctx context.Context, userId string, size int32,
+		ctx context.Context, userId string, size int32, weighting string,
 	) (wordMeanings []model.WordMeaning, err error)
+	FindFavoriteWordMeaningsByIds(
+		ctx context.Context, favoriteWordMeaningIds []string, userId string,
+	) (wordMeanings []model.WordMeaning, err error)
+	MarkFavoriteWordMeaningsReviewed(
+		ctx context.Context, favoriteWordMeaningIds []string, userId string,
+	) (modifiedCount int32, err error)
+	FindRecentLookups(
+		ctx context.Context, userId string, size int32,
+	) (lookupHistories []model.LookupHistory, err error)
+	ClearLookupHistory(
+		ctx context.Context, userId string,
+	) (deletedCount int32, err error)
+	FindDictationSentences(
+		ctx context.Context, userId string, size int32,
+	) (dictationSentences []DictationSentence, err error)
+	GradeDictation(
+		ctx context.Context, userId, wordMeaningId, audioUrl, answer string,
+	) (dictationRecord *model.DictationRecord, err error)
+	FindDictationHistory(
+		ctx context.Context, userId string, size int32,
+	) (dictationRecords []model.DictationRecord, averageAccuracy float64, err error)
 }
 
 type wordService struct {
@@ -66,8 +95,16 @@ func (wordService wordService) FindWordByDictionary(
 	databaseRepository := wordService.databaseRepository
 	spider := wordService.spider
 
-	// 統一以小寫去查詢
-	word = strings.ToLower(word)
+	// 統一以小寫去查詢，片語則合併多餘的空白，例如 "Look  Forward To" => "look forward to"
+	word = crawler.NormalizeWord(word)
+
+	// 只有空白的單字無法查詢
+	if word == "" {
+		err := fmt.Errorf("Invalid word: %s", "empty")
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
 	wordMeanings, err := databaseRepository.FindWordMeaningsByWordAndUserId(
 		ctx,
 		word,
@@ -116,9 +153,42 @@ func (wordService wordService) FindWordByDictionary(
 		}
 	}
 
+	// 記錄使用者的查詢紀錄，失敗不影響查詢結果
+	if userId != "" {
+		err = wordService.recordLookup(ctx, userId, word)
+		if err != nil {
+			errorLogger.Log("err", err)
+		}
+	}
+
 	return wordMeanings, nil
 }
 
+// 新增或累加查詢次數，並刪除超過上限的舊紀錄
+func (wordService wordService) recordLookup(ctx context.Context, userId, word string) error {
+	databaseRepository := wordService.databaseRepository
+	_, upsertedCount, err := databaseRepository.UpsertLookupHistoryByTimesPlusOne(
+		ctx,
+		userId,
+		word,
+	)
+	if err != nil {
+		return err
+	}
+
+	// 只有新增紀錄時才可能超過上限
+	if upsertedCount == 0 {
+		return nil
+	}
+
+	_, err = databaseRepository.DeleteLookupHistoriesByUserIdAndSkip(
+		ctx,
+		userId,
+		maxLookupHistorySize,
+	)
+	return err
+}
+
 func (wordService wordService) CreateFavoriteWordMeaning(
 	ctx context.Context, userId, wordMeaningId string,
 ) (favoriteWordMeaningId string, err error) {
@@ -184,24 +254,41 @@ func (wordService wordService) DeleteFavoriteWordMeaning(
 func (wordService wordService) FindFavoriteWordMeanings(
 	ctx context.Context,
 	pageIndex, pageSize int32,
-	userId, word string,
-) (total, pageCount int32, favoriteWordMeanings []model.WordMeaning, err error) {
+	userId, word, cursor string,
+) (
+	total, pageCount int32,
+	favoriteWordMeanings []model.WordMeaning,
+	nextCursor string,
+	err error,
+) {
 	errorLogger := wordService.errorLogger
 	errorMessage := "FindFavoriteWordMeanings failed! error: %w"
 
 	skip := pageSize * pageIndex
+
+	// 有 cursor 時改用 cursor 分頁，忽略 pageIndex
+	if cursor != "" {
+		skip = 0
+	}
+
 	limit := pageSize
 	databaseRepository := wordService.databaseRepository
-	favoriteWordMeanings, err = databaseRepository.FindFavoriteWordMeaningsByUserIdAndWord(
+	favoriteWordMeanings, nextCursor, err = databaseRepository.FindFavoriteWordMeaningsByUserIdAndWord(
 		ctx,
 		userId,
 		word,
+		cursor,
 		skip,
 		limit,
 	)
 	if err != nil {
 		errorLogger.Log("err", err)
-		return 0, 0, nil, fmt.Errorf(errorMessage, err)
+		return 0, 0, nil, "", fmt.Errorf(errorMessage, err)
+	}
+
+	// 使用 cursor 分頁時不需要再查詢總數
+	if cursor != "" {
+		return 0, 0, favoriteWordMeanings, nextCursor, nil
 	}
 
 	total, err = databaseRepository.CountFavoriteWordMeaningsByUserIdAndWord(
@@ -211,73 +298,157 @@ func (wordService wordService) FindFavoriteWordMeanings(
 	)
 	if err != nil {
 		errorLogger.Log("err", err)
-		return 0, 0, nil, fmt.Errorf(errorMessage, err)
+		return 0, 0, nil, "", fmt.Errorf(errorMessage, err)
 	}
 
 	// PageCount
 	pageCount = int32(math.Ceil(float64(total) / float64(pageSize)))
-	return total, pageCount, favoriteWordMeanings, nil
+	return total, pageCount, favoriteWordMeanings, nextCursor, nil
 }
 
 func (wordService wordService) FindRandomFavoriteWordMeanings(
-	ctx context.Context, userId string, size int32,
+	ctx context.Context, userId string, size int32, weighting string,
 ) (wordMeanings []model.WordMeaning, err error) {
 	errorLogger := wordService.errorLogger
 	errorMessage := "FindRandomFavoriteWordMeanings failed! error: %w"
 
+	switch weighting {
+	case repository.RANDOM_WEIGHTING_NONE,
+		repository.RANDOM_WEIGHTING_RECENT,
+		repository.RANDOM_WEIGHTING_LESS_REVIEWED:
+	default:
+		err = fmt.Errorf("Invalid weighting: %s", weighting)
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	// $sample 的 size 必須是正數
+	if size <= 0 || size > maxRandomFavoriteWordMeaningSize {
+		err = fmt.Errorf("Invalid size: %d", size)
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
 	databaseRepository := wordService.databaseRepository
-	total, err := databaseRepository.CountFavoriteWordMeaningsByUserIdAndWord(
+	wordMeanings, err = databaseRepository.FindRandomFavoriteWordMeaningsByUserId(
 		ctx,
 		userId,
-		"",
+		size,
+		weighting,
 	)
 	if err != nil {
 		errorLogger.Log("err", err)
 		return nil, fmt.Errorf(errorMessage, err)
 	}
 
-	// 總數是零，表示使用者還沒新增喜歡的單字解釋
-	if total == 0 {
+	// 使用者還沒新增喜歡的單字解釋
+	if len(wordMeanings) == 0 {
+		return []model.WordMeaning{}, nil
+	}
+
+	return wordMeanings, nil
+}
+
+// 依 id 查詢使用者喜歡的單字解釋，只會查出使用者自己的資料
+func (wordService wordService) FindFavoriteWordMeaningsByIds(
+	ctx context.Context, favoriteWordMeaningIds []string, userId string,
+) (wordMeanings []model.WordMeaning, err error) {
+	errorLogger := wordService.errorLogger
+	errorMessage := "FindFavoriteWordMeaningsByIds failed! error: %w"
+
+	if len(favoriteWordMeaningIds) > maxRandomFavoriteWordMeaningSize {
+		err = fmt.Errorf("Too many favoriteWordMeaningIds: %d", len(favoriteWordMeaningIds))
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
+
+	if len(favoriteWordMeaningIds) == 0 {
 		return []model.WordMeaning{}, nil
 	}
 
-	indexes := []int32{}
+	wordMeanings, err = wordService.databaseRepository.FindFavoriteWordMeaningsByUserIdAndIds(
+		ctx,
+		userId,
+		favoriteWordMeaningIds,
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
+	}
 
-	for i := int32(0); i < total; i++ {
-		indexes = append(indexes, i)
+	return wordMeanings, nil
+}
+
+/*
+使用者實際複習過單字卡後才記錄複習次數，供「偏重較少複習的」加權使用，
+只會更新使用者自己的資料
+*/
+func (wordService wordService) MarkFavoriteWordMeaningsReviewed(
+	ctx context.Context, favoriteWordMeaningIds []string, userId string,
+) (modifiedCount int32, err error) {
+	errorLogger := wordService.errorLogger
+	errorMessage := "MarkFavoriteWordMeaningsReviewed failed! error: %w"
+
+	if len(favoriteWordMeaningIds) > maxRandomFavoriteWordMeaningSize {
+		err = fmt.Errorf("Too many favoriteWordMeaningIds: %d", len(favoriteWordMeaningIds))
+		errorLogger.Log("err", err)
+		return 0, fmt.Errorf(errorMessage, err)
+	}
+
+	if len(favoriteWordMeaningIds) == 0 {
+		return 0, nil
+	}
+
+	modifiedCount, err = wordService.databaseRepository.IncreaseFavoriteWordMeaningsReviewedTimes(
+		ctx,
+		userId,
+		favoriteWordMeaningIds,
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return 0, fmt.Errorf(errorMessage, err)
 	}
 
-	// 將 indexes 隨機洗牌，然後根據洗牌後的 indexes 順序去查詢，達到隨機排序的效果
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
-	rng.Shuffle(len(indexes), func(i, j int) {
-		indexes[i], indexes[j] = indexes[j], indexes[i]
-	})
+	return modifiedCount, nil
+}
 
-	maxQueryTotal := min(total, size)
+func (wordService wordService) FindRecentLookups(
+	ctx context.Context, userId string, size int32,
+) (lookupHistories []model.LookupHistory, err error) {
+	errorLogger := wordService.errorLogger
+	errorMessage := "FindRecentLookups failed! error: %w"
 
-	for i := int32(0); i < maxQueryTotal; i++ {
-		findWordMeanings, err := databaseRepository.FindFavoriteWordMeaningsByUserIdAndWord(
-			ctx,
-			userId,
-			"",
-			indexes[i],
-			1,
-		)
-		if err != nil {
-			errorLogger.Log("err", err)
-			return nil, fmt.Errorf(errorMessage, err)
-		}
+	if size <= 0 || size > maxLookupHistorySize {
+		size = maxLookupHistorySize
+	}
 
-		wordMeanings = append(wordMeanings, findWordMeanings[0])
+	lookupHistories, err = wordService.databaseRepository.FindLookupHistoriesByUserIdOrderByUpdatedAtDesc(
+		ctx,
+		userId,
+		size,
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return nil, fmt.Errorf(errorMessage, err)
 	}
 
-	return wordMeanings, nil
+	return lookupHistories, nil
 }
 
-func min(a, b int32) int32 {
-	if a < b {
-		return a
+func (wordService wordService) ClearLookupHistory(
+	ctx context.Context, userId string,
+) (deletedCount int32, err error) {
+	errorLogger := wordService.errorLogger
+	errorMessage := "ClearLookupHistory failed! error: %w"
+
+	deletedCount, err = wordService.databaseRepository.DeleteLookupHistoriesByUserId(
+		ctx,
+		userId,
+	)
+	if err != nil {
+		errorLogger.Log("err", err)
+		return 0, fmt.Errorf(errorMessage, err)
 	}
 
-	return b
+	return deletedCount, nil
 }
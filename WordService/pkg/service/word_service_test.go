@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"os"
 	"testing"
@@ -134,6 +136,12 @@ func (s *MyTestSuite) TestFindWordByDictionary_WhenDataFromDB() {
 					FindWordMeaningsByWordAndUserId(
 						mock.Anything, args.word, args.userId).
 					Return(mockWordMeanings01, nil)
+				s.mockDatabaseRepository.EXPECT().
+					UpsertLookupHistoryByTimesPlusOne(mock.Anything, args.userId, args.word).
+					Return(0, 1, nil)
+				s.mockDatabaseRepository.EXPECT().
+					DeleteLookupHistoriesByUserIdAndSkip(mock.Anything, args.userId, int32(100)).
+					Return(0, nil)
 			},
 		},
 		{
@@ -151,6 +159,46 @@ func (s *MyTestSuite) TestFindWordByDictionary_WhenDataFromDB() {
 					FindWordMeaningsByWordAndUserId(
 						mock.Anything, args.word, args.userId).
 					Return(mockWordMeanings02, nil)
+				s.mockDatabaseRepository.EXPECT().
+					UpsertLookupHistoryByTimesPlusOne(mock.Anything, args.userId, args.word).
+					Return(1, 0, nil)
+			},
+		},
+		{
+			name: "Find without userId",
+			args: &args{
+				word:   word02,
+				userId: "",
+			},
+			expected: &result{
+				wordMeanings: mockWordMeanings02,
+				err:          nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					FindWordMeaningsByWordAndUserId(
+						mock.Anything, args.word, args.userId).
+					Return(mockWordMeanings02, nil)
+			},
+		},
+		{
+			name: "Record lookup history failed",
+			args: &args{
+				word:   word02,
+				userId: "user01",
+			},
+			expected: &result{
+				wordMeanings: mockWordMeanings02,
+				err:          nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					FindWordMeaningsByWordAndUserId(
+						mock.Anything, args.word, args.userId).
+					Return(mockWordMeanings02, nil)
+				s.mockDatabaseRepository.EXPECT().
+					UpsertLookupHistoryByTimesPlusOne(mock.Anything, args.userId, args.word).
+					Return(0, 0, errors.New("upsert failed"))
 			},
 		},
 	}
@@ -236,6 +284,12 @@ func (s *MyTestSuite) TestFindWordByDictionary_WhenDataFromCrawler() {
 					FindWordMeaningsByWordAndUserId(
 						mock.Anything, args.word, args.userId).
 					Return(mockWordMeanings01, nil)
+				s.mockDatabaseRepository.EXPECT().
+					UpsertLookupHistoryByTimesPlusOne(mock.Anything, args.userId, args.word).
+					Return(0, 1, nil)
+				s.mockDatabaseRepository.EXPECT().
+					DeleteLookupHistoriesByUserIdAndSkip(mock.Anything, args.userId, int32(100)).
+					Return(0, nil)
 			},
 		},
 		{
@@ -263,8 +317,126 @@ func (s *MyTestSuite) TestFindWordByDictionary_WhenDataFromCrawler() {
 					FindWordMeaningsByWordAndUserId(
 						mock.Anything, args.word, args.userId).
 					Return(mockWordMeanings02, nil)
+				s.mockDatabaseRepository.EXPECT().
+					UpsertLookupHistoryByTimesPlusOne(mock.Anything, args.userId, args.word).
+					Return(1, 0, nil)
+			},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			wordMeanings, err := s.wordService.FindWordByDictionary(
+				ctx, args.word, args.userId,
+			)
+			expected := tc.expected
+			s.Equal(expected.wordMeanings, wordMeanings)
+			s.Equal(expected.err, err)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestFindWordByDictionary_WhenWordIsExpression() {
+	type args struct {
+		word   string
+		userId string
+	}
+
+	type result struct {
+		wordMeanings []model.WordMeaning
+		err          error
+	}
+
+	expression := "look forward to"
+	mockWordMeanings := []model.WordMeaning{
+		{
+			Word:         "look forward to something",
+			PartOfSpeech: "phrasal verb",
+			QueryByWords: []string{"look forward to something", "look forward to", "look"},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Find phrasal verb with extra spaces and upper case",
+			args: &args{
+				word:   "  Look  Forward TO ",
+				userId: "user01",
+			},
+			expected: &result{
+				wordMeanings: mockWordMeanings,
+				err:          nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					FindWordMeaningsByWordAndUserId(
+						mock.Anything, expression, args.userId).
+					Return(nil, nil).
+					Once()
+				s.mockSpider.EXPECT().FindWordMeaningsFromDictionary(expression).
+					Return(mockWordMeanings, nil)
+				s.mockDatabaseRepository.EXPECT().
+					CreateWordMeanings(mock.Anything, mockWordMeanings).
+					Return([]string{"id1"}, nil)
+				s.mockDatabaseRepository.EXPECT().
+					FindWordMeaningsByWordAndUserId(
+						mock.Anything, expression, args.userId).
+					Return(mockWordMeanings, nil)
+				s.mockDatabaseRepository.EXPECT().
+					UpsertLookupHistoryByTimesPlusOne(mock.Anything, args.userId, expression).
+					Return(0, 1, nil)
+				s.mockDatabaseRepository.EXPECT().
+					DeleteLookupHistoriesByUserIdAndSkip(mock.Anything, args.userId, int32(100)).
+					Return(0, nil)
+			},
+		},
+		{
+			name: "Find idiom with curly apostrophe",
+			args: &args{
+				word:   "It’s A Deal",
+				userId: "user01",
+			},
+			expected: &result{
+				wordMeanings: mockWordMeanings,
+				err:          nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					FindWordMeaningsByWordAndUserId(
+						mock.Anything, "it's a deal", args.userId).
+					Return(mockWordMeanings, nil)
+				s.mockDatabaseRepository.EXPECT().
+					UpsertLookupHistoryByTimesPlusOne(mock.Anything, args.userId, "it's a deal").
+					Return(1, 0, nil)
 			},
 		},
+		{
+			name: "Find blank word",
+			args: &args{
+				word:   "   ",
+				userId: "user01",
+			},
+			expected: &result{
+				wordMeanings: nil,
+				err: fmt.Errorf(
+					"FindWordByDictionary failed! error: %w",
+					fmt.Errorf("Invalid word: %s", "empty"),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
 	}
 
 	ctx := context.Background()
@@ -454,12 +626,14 @@ func (s *MyTestSuite) TestFindFavoriteWordMeanings() {
 		pageSize  int32
 		userId    string
 		word      string
+		cursor    string
 	}
 
 	type result struct {
 		total        int32
 		pageCount    int32
 		wordMeanings []model.WordMeaning
+		nextCursor   string
 		err          error
 	}
 
@@ -509,8 +683,8 @@ func (s *MyTestSuite) TestFindFavoriteWordMeanings() {
 
 				s.mockDatabaseRepository.EXPECT().
 					FindFavoriteWordMeaningsByUserIdAndWord(
-						mock.Anything, args.userId, args.word, skip, limit).
-					Return(mockWordMeanings01, nil)
+						mock.Anything, args.userId, args.word, args.cursor, skip, limit).
+					Return(mockWordMeanings01, "", nil)
 				s.mockDatabaseRepository.EXPECT().
 					CountFavoriteWordMeaningsByUserIdAndWord(
 						mock.Anything, args.userId, args.word).
@@ -537,14 +711,64 @@ func (s *MyTestSuite) TestFindFavoriteWordMeanings() {
 
 				s.mockDatabaseRepository.EXPECT().
 					FindFavoriteWordMeaningsByUserIdAndWord(
-						mock.Anything, args.userId, args.word, skip, limit).
-					Return(mockWordMeanings02, nil)
+						mock.Anything, args.userId, args.word, args.cursor, skip, limit).
+					Return(mockWordMeanings02, "", nil)
 				s.mockDatabaseRepository.EXPECT().
 					CountFavoriteWordMeaningsByUserIdAndWord(
 						mock.Anything, args.userId, args.word).
 					Return(int32(11), nil)
 			},
 		},
+		{
+			name: "Find favoriteWordMeanings by page1 when has next page",
+			args: &args{
+				pageIndex: 0,
+				pageSize:  1,
+				userId:    "user02",
+				word:      word02,
+			},
+			expected: &result{
+				total:        11,
+				pageCount:    11,
+				wordMeanings: mockWordMeanings02,
+				nextCursor:   "nextCursor01",
+				err:          nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					FindFavoriteWordMeaningsByUserIdAndWord(
+						mock.Anything, args.userId, args.word, args.cursor, int32(0), args.pageSize).
+					Return(mockWordMeanings02, "nextCursor01", nil)
+				s.mockDatabaseRepository.EXPECT().
+					CountFavoriteWordMeaningsByUserIdAndWord(
+						mock.Anything, args.userId, args.word).
+					Return(int32(11), nil)
+			},
+		},
+		{
+			name: "Find favoriteWordMeanings by cursor",
+			args: &args{
+				pageIndex: 3,
+				pageSize:  10,
+				userId:    "user02",
+				word:      word02,
+				cursor:    "cursor01",
+			},
+			expected: &result{
+				total:        0,
+				pageCount:    0,
+				wordMeanings: mockWordMeanings02,
+				nextCursor:   "nextCursor02",
+				err:          nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				// 使用 cursor 時忽略 pageIndex，也不查詢總數
+				s.mockDatabaseRepository.EXPECT().
+					FindFavoriteWordMeaningsByUserIdAndWord(
+						mock.Anything, args.userId, args.word, args.cursor, int32(0), args.pageSize).
+					Return(mockWordMeanings02, "nextCursor02", nil)
+			},
+		},
 	}
 
 	ctx := context.Background()
@@ -556,17 +780,19 @@ func (s *MyTestSuite) TestFindFavoriteWordMeanings() {
 			tc.on(s, args)
 
 			// Test
-			total, pageCount, wordMeanings, err := s.wordService.FindFavoriteWordMeanings(
+			total, pageCount, wordMeanings, nextCursor, err := s.wordService.FindFavoriteWordMeanings(
 				ctx,
 				args.pageIndex,
 				args.pageSize,
 				args.userId,
 				args.word,
+				args.cursor,
 			)
 			expected := tc.expected
 			s.Equal(expected.total, total)
 			s.Equal(expected.pageCount, pageCount)
 			s.Equal(expected.wordMeanings, wordMeanings)
+			s.Equal(expected.nextCursor, nextCursor)
 			s.Equal(expected.err, err)
 		})
 	}
@@ -574,8 +800,9 @@ func (s *MyTestSuite) TestFindFavoriteWordMeanings() {
 
 func (s *MyTestSuite) TestFindRandomFavoriteWordMeanings() {
 	type args struct {
-		userId string
-		size   int32
+		userId    string
+		size      int32
+		weighting string
 	}
 
 	type result struct {
@@ -583,6 +810,9 @@ func (s *MyTestSuite) TestFindRandomFavoriteWordMeanings() {
 		err          error
 	}
 
+	favoriteWordMeaningId01 := primitive.NewObjectID()
+	favoriteWordMeaningId02 := primitive.NewObjectID()
+
 	testCases := []struct {
 		name     string
 		args     *args
@@ -592,61 +822,77 @@ func (s *MyTestSuite) TestFindRandomFavoriteWordMeanings() {
 		{
 			name: "Find random favoriteWordMeanings01",
 			args: &args{
-				userId: "user01",
-				size:   10,
+				userId:    "user01",
+				size:      10,
+				weighting: repository.RANDOM_WEIGHTING_NONE,
 			},
 			expected: &result{
 				wordMeanings: []model.WordMeaning{
-					{}, {}, {},
+					{FavoriteWordMeaningId: favoriteWordMeaningId01},
+					{FavoriteWordMeaningId: favoriteWordMeaningId02},
 				},
 				err: nil,
 			},
 			on: func(s *MyTestSuite, args *args) {
 				s.mockDatabaseRepository.EXPECT().
-					FindFavoriteWordMeaningsByUserIdAndWord(
+					FindRandomFavoriteWordMeaningsByUserId(
 						mock.Anything,
 						args.userId,
-						"",
-						mock.AnythingOfType("int32"),
-						int32(1),
+						args.size,
+						args.weighting,
 					).
 					Return([]model.WordMeaning{
-						{},
+						{FavoriteWordMeaningId: favoriteWordMeaningId01},
+						{FavoriteWordMeaningId: favoriteWordMeaningId02},
 					}, nil)
-				s.mockDatabaseRepository.EXPECT().
-					CountFavoriteWordMeaningsByUserIdAndWord(
-						mock.Anything, args.userId, "").
-					Return(int32(3), nil)
 			},
 		},
 		{
-			name: "Find random favoriteWordMeanings02",
+			name: "Find random favoriteWordMeanings with weighting",
 			args: &args{
-				userId: "user02",
-				size:   10,
+				userId:    "user02",
+				size:      10,
+				weighting: repository.RANDOM_WEIGHTING_LESS_REVIEWED,
 			},
 			expected: &result{
 				wordMeanings: []model.WordMeaning{
-					{},
+					{FavoriteWordMeaningId: favoriteWordMeaningId01},
 				},
 				err: nil,
 			},
 			on: func(s *MyTestSuite, args *args) {
 				s.mockDatabaseRepository.EXPECT().
-					FindFavoriteWordMeaningsByUserIdAndWord(
+					FindRandomFavoriteWordMeaningsByUserId(
 						mock.Anything,
 						args.userId,
-						"",
-						mock.AnythingOfType("int32"),
-						int32(1),
+						args.size,
+						args.weighting,
 					).
 					Return([]model.WordMeaning{
-						{},
+						{FavoriteWordMeaningId: favoriteWordMeaningId01},
 					}, nil)
+			},
+		},
+		{
+			name: "Find random favoriteWordMeanings when user has no favorites",
+			args: &args{
+				userId:    "user03",
+				size:      10,
+				weighting: repository.RANDOM_WEIGHTING_RECENT,
+			},
+			expected: &result{
+				wordMeanings: []model.WordMeaning{},
+				err:          nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
 				s.mockDatabaseRepository.EXPECT().
-					CountFavoriteWordMeaningsByUserIdAndWord(
-						mock.Anything, args.userId, "").
-					Return(int32(1), nil)
+					FindRandomFavoriteWordMeaningsByUserId(
+						mock.Anything,
+						args.userId,
+						args.size,
+						args.weighting,
+					).
+					Return([]model.WordMeaning{}, nil)
 			},
 		},
 	}
@@ -664,6 +910,7 @@ func (s *MyTestSuite) TestFindRandomFavoriteWordMeanings() {
 				ctx,
 				args.userId,
 				args.size,
+				args.weighting,
 			)
 			expected := tc.expected
 			s.Equal(expected.wordMeanings, wordMeanings)
@@ -671,3 +918,572 @@ func (s *MyTestSuite) TestFindRandomFavoriteWordMeanings() {
 		})
 	}
 }
+
+func (s *MyTestSuite) TestFindRandomFavoriteWordMeanings_WhenWeightingIsInvalid() {
+	// Test
+	wordMeanings, err := s.wordService.FindRandomFavoriteWordMeanings(
+		context.Background(),
+		"user01",
+		10,
+		"unknown",
+	)
+	s.Nil(wordMeanings)
+	s.ErrorContains(err, "Invalid weighting")
+}
+
+func (s *MyTestSuite) TestFindRandomFavoriteWordMeanings_WhenSizeIsInvalid() {
+	for _, size := range []int32{0, -1, maxRandomFavoriteWordMeaningSize + 1} {
+		// Test
+		wordMeanings, err := s.wordService.FindRandomFavoriteWordMeanings(
+			context.Background(),
+			"user01",
+			size,
+			repository.RANDOM_WEIGHTING_NONE,
+		)
+		s.Nil(wordMeanings)
+		s.Equal(
+			fmt.Errorf(
+				"FindRandomFavoriteWordMeanings failed! error: %w",
+				fmt.Errorf("Invalid size: %d", size),
+			),
+			err,
+		)
+	}
+}
+
+func (s *MyTestSuite) TestFindFavoriteWordMeaningsByIds() {
+	type args struct {
+		favoriteWordMeaningIds []string
+		userId                 string
+	}
+
+	type result struct {
+		wordMeanings []model.WordMeaning
+		err          error
+	}
+
+	favoriteWordMeaningId := primitive.NewObjectID()
+	wordMeanings := []model.WordMeaning{
+		{
+			Id:                    primitive.NewObjectID(),
+			Word:                  "book",
+			Definition:            "a written text",
+			FavoriteWordMeaningId: favoriteWordMeaningId,
+		},
+	}
+	tooManyIds := make([]string, maxRandomFavoriteWordMeaningSize+1)
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Find by ids",
+			args: &args{
+				favoriteWordMeaningIds: []string{favoriteWordMeaningId.Hex()},
+				userId:                 "user01",
+			},
+			expected: &result{
+				wordMeanings: wordMeanings,
+				err:          nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					FindFavoriteWordMeaningsByUserIdAndIds(
+						mock.Anything,
+						args.userId,
+						args.favoriteWordMeaningIds,
+					).
+					Return(wordMeanings, nil)
+			},
+		},
+		{
+			name: "No ids",
+			args: &args{
+				favoriteWordMeaningIds: []string{},
+				userId:                 "user01",
+			},
+			expected: &result{
+				wordMeanings: []model.WordMeaning{},
+				err:          nil,
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+		{
+			name: "Too many ids",
+			args: &args{
+				favoriteWordMeaningIds: tooManyIds,
+				userId:                 "user01",
+			},
+			expected: &result{
+				wordMeanings: nil,
+				err: fmt.Errorf(
+					"FindFavoriteWordMeaningsByIds failed! error: %w",
+					fmt.Errorf("Too many favoriteWordMeaningIds: %d", len(tooManyIds)),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			wordMeanings, err := s.wordService.FindFavoriteWordMeaningsByIds(
+				ctx,
+				args.favoriteWordMeaningIds,
+				args.userId,
+			)
+			expected := tc.expected
+			s.Equal(expected.wordMeanings, wordMeanings)
+			s.Equal(expected.err, err)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestMarkFavoriteWordMeaningsReviewed() {
+	type args struct {
+		favoriteWordMeaningIds []string
+		userId                 string
+	}
+
+	type result struct {
+		modifiedCount int32
+		err           error
+	}
+
+	favoriteWordMeaningId := primitive.NewObjectID().Hex()
+	tooManyIds := make([]string, maxRandomFavoriteWordMeaningSize+1)
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Mark reviewed",
+			args: &args{
+				favoriteWordMeaningIds: []string{favoriteWordMeaningId},
+				userId:                 "user01",
+			},
+			expected: &result{
+				modifiedCount: 1,
+				err:           nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					IncreaseFavoriteWordMeaningsReviewedTimes(
+						mock.Anything,
+						args.userId,
+						args.favoriteWordMeaningIds,
+					).
+					Return(int32(1), nil)
+			},
+		},
+		{
+			name: "No ids",
+			args: &args{
+				favoriteWordMeaningIds: []string{},
+				userId:                 "user01",
+			},
+			expected: &result{
+				modifiedCount: 0,
+				err:           nil,
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+		{
+			name: "Too many ids",
+			args: &args{
+				favoriteWordMeaningIds: tooManyIds,
+				userId:                 "user01",
+			},
+			expected: &result{
+				modifiedCount: 0,
+				err: fmt.Errorf(
+					"MarkFavoriteWordMeaningsReviewed failed! error: %w",
+					fmt.Errorf("Too many favoriteWordMeaningIds: %d", len(tooManyIds)),
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			modifiedCount, err := s.wordService.MarkFavoriteWordMeaningsReviewed(
+				ctx,
+				args.favoriteWordMeaningIds,
+				args.userId,
+			)
+			expected := tc.expected
+			s.Equal(expected.modifiedCount, modifiedCount)
+			s.Equal(expected.err, err)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestFindRecentLookups() {
+	type args struct {
+		userId string
+		size   int32
+	}
+
+	type result struct {
+		lookupHistories []model.LookupHistory
+		err             error
+	}
+
+	mockLookupHistories := []model.LookupHistory{
+		{
+			Id:     primitive.NewObjectID(),
+			UserId: "user01",
+			Word:   "test",
+			Times:  3,
+		},
+		{
+			Id:     primitive.NewObjectID(),
+			UserId: "user01",
+			Word:   "look forward to",
+			Times:  1,
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Find recent lookups",
+			args: &args{
+				userId: "user01",
+				size:   10,
+			},
+			expected: &result{
+				lookupHistories: mockLookupHistories,
+				err:             nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					FindLookupHistoriesByUserIdOrderByUpdatedAtDesc(
+						mock.Anything, args.userId, args.size).
+					Return(mockLookupHistories, nil)
+			},
+		},
+		{
+			name: "Find recent lookups when size is out of range",
+			args: &args{
+				userId: "user01",
+				size:   0,
+			},
+			expected: &result{
+				lookupHistories: mockLookupHistories,
+				err:             nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					FindLookupHistoriesByUserIdOrderByUpdatedAtDesc(
+						mock.Anything, args.userId, int32(100)).
+					Return(mockLookupHistories, nil)
+			},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			lookupHistories, err := s.wordService.FindRecentLookups(
+				ctx,
+				args.userId,
+				args.size,
+			)
+			expected := tc.expected
+			s.Equal(expected.lookupHistories, lookupHistories)
+			s.Equal(expected.err, err)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestClearLookupHistory() {
+	userId := "user01"
+	s.mockDatabaseRepository.EXPECT().
+		DeleteLookupHistoriesByUserId(mock.Anything, userId).
+		Return(int32(5), nil)
+
+	// Test
+	deletedCount, err := s.wordService.ClearLookupHistory(context.Background(), userId)
+	s.Equal(int32(5), deletedCount)
+	s.Nil(err)
+}
+
+func (s *MyTestSuite) TestDiffDictationWords() {
+	testCases := []struct {
+		name             string
+		text             string
+		answer           string
+		expectedDiffs    []model.DictationWordDiff
+		expectedAccuracy float64
+	}{
+		{
+			name:   "All words are correct, ignore case and punctuation",
+			text:   "Don’t worry, be happy!",
+			answer: "don't  worry be Happy",
+			expectedDiffs: []model.DictationWordDiff{
+				{Type: DICTATION_DIFF_CORRECT, Expected: "don't", Actual: "don't"},
+				{Type: DICTATION_DIFF_CORRECT, Expected: "worry", Actual: "worry"},
+				{Type: DICTATION_DIFF_CORRECT, Expected: "be", Actual: "be"},
+				{Type: DICTATION_DIFF_CORRECT, Expected: "happy", Actual: "happy"},
+			},
+			expectedAccuracy: 100,
+		},
+		{
+			name:   "Missing, extra and misspelled words",
+			text:   "She sells sea shells.",
+			answer: "she sell the sea",
+			expectedDiffs: []model.DictationWordDiff{
+				{Type: DICTATION_DIFF_CORRECT, Expected: "she", Actual: "she"},
+				{Type: DICTATION_DIFF_MISSPELLED, Expected: "sells", Actual: "sell"},
+				{Type: DICTATION_DIFF_EXTRA, Actual: "the"},
+				{Type: DICTATION_DIFF_CORRECT, Expected: "sea", Actual: "sea"},
+				{Type: DICTATION_DIFF_MISSING, Expected: "shells"},
+			},
+			expectedAccuracy: 40,
+		},
+		{
+			name:   "Empty answer",
+			text:   "Good morning",
+			answer: "",
+			expectedDiffs: []model.DictationWordDiff{
+				{Type: DICTATION_DIFF_MISSING, Expected: "good"},
+				{Type: DICTATION_DIFF_MISSING, Expected: "morning"},
+			},
+			expectedAccuracy: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			diffs := diffDictationWords(
+				tokenizeDictationText(tc.text),
+				tokenizeDictationText(tc.answer),
+			)
+			s.Equal(tc.expectedDiffs, diffs)
+
+			accuracy, _, _ := dictationAccuracy(diffs)
+			s.Equal(tc.expectedAccuracy, accuracy)
+		})
+	}
+}
+
+func (s *MyTestSuite) TestFindDictationSentences() {
+	userId := "user01"
+	wordMeaningId := primitive.NewObjectID()
+	s.mockDatabaseRepository.EXPECT().
+		FindRandomFavoriteSentencesByUserId(mock.Anything, userId, int32(20)).
+		Return([]repository.FavoriteSentence{
+			{
+				WordMeaningId: wordMeaningId,
+				Word:          "test",
+				AudioUrl:      "https://example.com/test.mp3",
+				Text:          "We had a test at school today.",
+			},
+		}, nil)
+
+	// Test
+	dictationSentences, err := s.wordService.FindDictationSentences(
+		context.Background(),
+		userId,
+		50,
+	)
+	s.Nil(err)
+	s.Equal([]DictationSentence{
+		{
+			WordMeaningId: wordMeaningId.Hex(),
+			Word:          "test",
+			AudioUrl:      "https://example.com/test.mp3",
+			WordCount:     7,
+		},
+	}, dictationSentences)
+}
+
+func (s *MyTestSuite) TestGradeDictation() {
+	type args struct {
+		userId        string
+		wordMeaningId string
+		audioUrl      string
+		answer        string
+	}
+
+	type result struct {
+		dictationRecord *model.DictationRecord
+		err             error
+	}
+
+	wordMeaningId := primitive.NewObjectID().Hex()
+	dictationRecordId := primitive.NewObjectID()
+	audioUrl := "https://example.com/test.mp3"
+	mockWordMeaning := &model.WordMeaning{
+		Word: "test",
+		Examples: []model.Example{
+			{
+				Examples: []model.Sentence{
+					{
+						AudioUrl: audioUrl,
+						Text:     "Pass the test.",
+					},
+				},
+			},
+		},
+	}
+	expectedRecord := model.DictationRecord{
+		UserId:        "user01",
+		WordMeaningId: wordMeaningId,
+		Word:          "test",
+		AudioUrl:      audioUrl,
+		Text:          "Pass the test.",
+		Answer:        "pass a tast",
+		Accuracy:      25,
+		WordCount:     3,
+		CorrectCount:  1,
+		Diffs: []model.DictationWordDiff{
+			{Type: DICTATION_DIFF_CORRECT, Expected: "pass", Actual: "pass"},
+			{Type: DICTATION_DIFF_EXTRA, Actual: "a"},
+			{Type: DICTATION_DIFF_MISSING, Expected: "the"},
+			{Type: DICTATION_DIFF_MISSPELLED, Expected: "test", Actual: "tast"},
+		},
+	}
+	createdRecord := expectedRecord
+	createdRecord.Id = dictationRecordId
+
+	testCases := []struct {
+		name     string
+		args     *args
+		expected *result
+		on       func(s *MyTestSuite, args *args)
+	}{
+		{
+			name: "Grade dictation",
+			args: &args{
+				userId:        "user01",
+				wordMeaningId: wordMeaningId,
+				audioUrl:      audioUrl,
+				answer:        "pass a tast",
+			},
+			expected: &result{
+				dictationRecord: &createdRecord,
+				err:             nil,
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetWordMeaningById(mock.Anything, args.wordMeaningId).
+					Return(mockWordMeaning, nil)
+				s.mockDatabaseRepository.EXPECT().
+					CreateDictationRecord(mock.Anything, expectedRecord).
+					Return(dictationRecordId.Hex(), nil)
+				s.mockDatabaseRepository.EXPECT().
+					GetDictationRecordById(mock.Anything, dictationRecordId.Hex()).
+					Return(&createdRecord, nil)
+			},
+		},
+		{
+			name: "Grade dictation when sentence not found",
+			args: &args{
+				userId:        "user01",
+				wordMeaningId: wordMeaningId,
+				audioUrl:      "https://example.com/other.mp3",
+				answer:        "pass a tast",
+			},
+			expected: &result{
+				dictationRecord: nil,
+				err: errors.New(
+					"GradeDictation failed! error: Sentence not found by audioUrl: https://example.com/other.mp3",
+				),
+			},
+			on: func(s *MyTestSuite, args *args) {
+				s.mockDatabaseRepository.EXPECT().
+					GetWordMeaningById(mock.Anything, args.wordMeaningId).
+					Return(mockWordMeaning, nil)
+			},
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			args := tc.args
+			tc.on(s, args)
+
+			// Test
+			dictationRecord, err := s.wordService.GradeDictation(
+				ctx,
+				args.userId,
+				args.wordMeaningId,
+				args.audioUrl,
+				args.answer,
+			)
+			expected := tc.expected
+			s.Equal(expected.dictationRecord, dictationRecord)
+
+			if expected.err == nil {
+				s.Nil(err)
+			} else {
+				s.EqualError(err, expected.err.Error())
+			}
+		})
+	}
+}
+
+func (s *MyTestSuite) TestFindDictationHistory() {
+	userId := "user01"
+	mockDictationRecords := []model.DictationRecord{
+		{
+			Id:       primitive.NewObjectID(),
+			UserId:   userId,
+			Accuracy: 100,
+		},
+		{
+			Id:       primitive.NewObjectID(),
+			UserId:   userId,
+			Accuracy: 66.67,
+		},
+	}
+	s.mockDatabaseRepository.EXPECT().
+		FindDictationRecordsByUserIdOrderByCreatedAtDesc(mock.Anything, userId, int32(100)).
+		Return(mockDictationRecords, nil)
+
+	// Test
+	dictationRecords, averageAccuracy, err := s.wordService.FindDictationHistory(
+		context.Background(),
+		userId,
+		0,
+	)
+	s.Nil(err)
+	s.Equal(mockDictationRecords, dictationRecords)
+	s.Equal(83.34, averageAccuracy)
+}
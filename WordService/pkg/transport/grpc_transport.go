@@ -7,6 +7,7 @@ import (
 
 	gt "github.com/go-kit/kit/transport/grpc"
 	"github.com/go-kit/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/kakurineuin/learn-english-microservices/word-service/pb"
 	"github.com/kakurineuin/learn-english-microservices/word-service/pkg/endpoint"
@@ -16,11 +17,18 @@ import (
 const TIMEOUT = 20 * time.Second
 
 type GRPCServer struct {
-	findWordByDictionary           gt.Handler
-	createFavoriteWordMeaning      gt.Handler
-	deleteFavoriteWordMeaning      gt.Handler
-	findFavoriteWordMeanings       gt.Handler
-	findRandomFavoriteWordMeanings gt.Handler
+	findWordByDictionary             gt.Handler
+	createFavoriteWordMeaning        gt.Handler
+	deleteFavoriteWordMeaning        gt.Handler
+	findFavoriteWordMeanings         gt.Handler
+	findRandomFavoriteWordMeanings   gt.Handler
+	findFavoriteWordMeaningsByIds    gt.Handler
+	markFavoriteWordMeaningsReviewed gt.Handler
+	findRecentLookups                gt.Handler
+	clearLookupHistory               gt.Handler
+	findDictationSentences           gt.Handler
+	gradeDictation                   gt.Handler
+	findDictationHistory             gt.Handler
 
 	pb.UnimplementedWordServiceServer
 }
@@ -53,6 +61,41 @@ func NewGRPCServer(endpointds endpoint.Endpoints, logger log.Logger) pb.WordServ
 			decodeFindRandomFavoriteWordMeaningsRequest,
 			encodeFindRandomFavoriteWordMeaningsResponse,
 		),
+		findRecentLookups: gt.NewServer(
+			endpointds.FindRecentLookups,
+			decodeFindRecentLookupsRequest,
+			encodeFindRecentLookupsResponse,
+		),
+		clearLookupHistory: gt.NewServer(
+			endpointds.ClearLookupHistory,
+			decodeClearLookupHistoryRequest,
+			encodeClearLookupHistoryResponse,
+		),
+		findFavoriteWordMeaningsByIds: gt.NewServer(
+			endpointds.FindFavoriteWordMeaningsByIds,
+			decodeFindFavoriteWordMeaningsByIdsRequest,
+			encodeFindFavoriteWordMeaningsByIdsResponse,
+		),
+		markFavoriteWordMeaningsReviewed: gt.NewServer(
+			endpointds.MarkFavoriteWordMeaningsReviewed,
+			decodeMarkFavoriteWordMeaningsReviewedRequest,
+			encodeMarkFavoriteWordMeaningsReviewedResponse,
+		),
+		findDictationSentences: gt.NewServer(
+			endpointds.FindDictationSentences,
+			decodeFindDictationSentencesRequest,
+			encodeFindDictationSentencesResponse,
+		),
+		gradeDictation: gt.NewServer(
+			endpointds.GradeDictation,
+			decodeGradeDictationRequest,
+			encodeGradeDictationResponse,
+		),
+		findDictationHistory: gt.NewServer(
+			endpointds.FindDictationHistory,
+			decodeFindDictationHistoryRequest,
+			encodeFindDictationHistoryResponse,
+		),
 	}
 }
 
@@ -211,6 +254,7 @@ func decodeFindFavoriteWordMeaningsRequest(
 		PageSize: req.PageSize,
 		UserId:   req.UserId,
 		Word:     req.Word,
+		Cursor:   req.Cursor,
 	}, nil
 }
 
@@ -227,6 +271,7 @@ func encodeFindFavoriteWordMeaningsResponse(
 		Total:                resp.Total,
 		PageCount:            resp.PageCount,
 		FavoriteWordMeanings: toPBWordMeanings(resp.FavoriteWordMeanings),
+		NextCursor:           resp.NextCursor,
 	}, nil
 }
 
@@ -254,8 +299,9 @@ func decodeFindRandomFavoriteWordMeaningsRequest(
 	}
 
 	return endpoint.FindRandomFavoriteWordMeaningsRequest{
-		UserId: req.UserId,
-		Size:   req.Size,
+		UserId:    req.UserId,
+		Size:      req.Size,
+		Weighting: req.Weighting,
 	}, nil
 }
 
@@ -273,6 +319,371 @@ func encodeFindRandomFavoriteWordMeaningsResponse(
 	}, nil
 }
 
+func (s GRPCServer) FindRecentLookups(
+	ctx context.Context,
+	req *pb.FindRecentLookupsRequest,
+) (*pb.FindRecentLookupsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.findRecentLookups.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*pb.FindRecentLookupsResponse), nil
+}
+
+func decodeFindRecentLookupsRequest(
+	_ context.Context,
+	request interface{},
+) (interface{}, error) {
+	req, ok := request.(*pb.FindRecentLookupsRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.FindRecentLookupsRequest{
+		UserId: req.UserId,
+		Size:   req.Size,
+	}, nil
+}
+
+func encodeFindRecentLookupsResponse(
+	_ context.Context,
+	response interface{},
+) (interface{}, error) {
+	resp, ok := response.(endpoint.FindRecentLookupsResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.FindRecentLookupsResponse{
+		LookupHistories: toPBLookupHistories(resp.LookupHistories),
+	}, nil
+}
+
+func (s GRPCServer) FindFavoriteWordMeaningsByIds(
+	ctx context.Context,
+	req *pb.FindFavoriteWordMeaningsByIdsRequest,
+) (*pb.FindFavoriteWordMeaningsByIdsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.findFavoriteWordMeaningsByIds.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*pb.FindFavoriteWordMeaningsByIdsResponse), nil
+}
+
+func decodeFindFavoriteWordMeaningsByIdsRequest(
+	_ context.Context,
+	request interface{},
+) (interface{}, error) {
+	req, ok := request.(*pb.FindFavoriteWordMeaningsByIdsRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.FindFavoriteWordMeaningsByIdsRequest{
+		FavoriteWordMeaningIds: req.FavoriteWordMeaningIds,
+		UserId:                 req.UserId,
+	}, nil
+}
+
+func encodeFindFavoriteWordMeaningsByIdsResponse(
+	_ context.Context,
+	response interface{},
+) (interface{}, error) {
+	resp, ok := response.(endpoint.FindFavoriteWordMeaningsByIdsResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.FindFavoriteWordMeaningsByIdsResponse{
+		FavoriteWordMeanings: toPBWordMeanings(resp.FavoriteWordMeanings),
+	}, nil
+}
+
+func (s GRPCServer) MarkFavoriteWordMeaningsReviewed(
+	ctx context.Context,
+	req *pb.MarkFavoriteWordMeaningsReviewedRequest,
+) (*pb.MarkFavoriteWordMeaningsReviewedResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.markFavoriteWordMeaningsReviewed.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*pb.MarkFavoriteWordMeaningsReviewedResponse), nil
+}
+
+func decodeMarkFavoriteWordMeaningsReviewedRequest(
+	_ context.Context,
+	request interface{},
+) (interface{}, error) {
+	req, ok := request.(*pb.MarkFavoriteWordMeaningsReviewedRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.MarkFavoriteWordMeaningsReviewedRequest{
+		FavoriteWordMeaningIds: req.FavoriteWordMeaningIds,
+		UserId:                 req.UserId,
+	}, nil
+}
+
+func encodeMarkFavoriteWordMeaningsReviewedResponse(
+	_ context.Context,
+	response interface{},
+) (interface{}, error) {
+	resp, ok := response.(endpoint.MarkFavoriteWordMeaningsReviewedResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.MarkFavoriteWordMeaningsReviewedResponse{
+		ModifiedCount: resp.ModifiedCount,
+	}, nil
+}
+
+func (s GRPCServer) ClearLookupHistory(
+	ctx context.Context,
+	req *pb.ClearLookupHistoryRequest,
+) (*pb.ClearLookupHistoryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.clearLookupHistory.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*pb.ClearLookupHistoryResponse), nil
+}
+
+func decodeClearLookupHistoryRequest(
+	_ context.Context,
+	request interface{},
+) (interface{}, error) {
+	req, ok := request.(*pb.ClearLookupHistoryRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.ClearLookupHistoryRequest{
+		UserId: req.UserId,
+	}, nil
+}
+
+func encodeClearLookupHistoryResponse(
+	_ context.Context,
+	response interface{},
+) (interface{}, error) {
+	resp, ok := response.(endpoint.ClearLookupHistoryResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.ClearLookupHistoryResponse{
+		DeletedCount: resp.DeletedCount,
+	}, nil
+}
+
+func (s GRPCServer) FindDictationSentences(
+	ctx context.Context,
+	req *pb.FindDictationSentencesRequest,
+) (*pb.FindDictationSentencesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.findDictationSentences.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*pb.FindDictationSentencesResponse), nil
+}
+
+func decodeFindDictationSentencesRequest(
+	_ context.Context,
+	request interface{},
+) (interface{}, error) {
+	req, ok := request.(*pb.FindDictationSentencesRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.FindDictationSentencesRequest{
+		UserId: req.UserId,
+		Size:   req.Size,
+	}, nil
+}
+
+func encodeFindDictationSentencesResponse(
+	_ context.Context,
+	response interface{},
+) (interface{}, error) {
+	resp, ok := response.(endpoint.FindDictationSentencesResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	pbSentences := []*pb.DictationSentence{}
+
+	for _, dictationSentence := range resp.DictationSentences {
+		pbSentences = append(pbSentences, &pb.DictationSentence{
+			WordMeaningId: dictationSentence.WordMeaningId,
+			Word:          dictationSentence.Word,
+			AudioUrl:      dictationSentence.AudioUrl,
+			WordCount:     dictationSentence.WordCount,
+		})
+	}
+
+	return &pb.FindDictationSentencesResponse{
+		Sentences: pbSentences,
+	}, nil
+}
+
+func (s GRPCServer) GradeDictation(
+	ctx context.Context,
+	req *pb.GradeDictationRequest,
+) (*pb.GradeDictationResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.gradeDictation.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*pb.GradeDictationResponse), nil
+}
+
+func decodeGradeDictationRequest(
+	_ context.Context,
+	request interface{},
+) (interface{}, error) {
+	req, ok := request.(*pb.GradeDictationRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.GradeDictationRequest{
+		UserId:        req.UserId,
+		WordMeaningId: req.WordMeaningId,
+		AudioUrl:      req.AudioUrl,
+		Answer:        req.Answer,
+	}, nil
+}
+
+func encodeGradeDictationResponse(
+	_ context.Context,
+	response interface{},
+) (interface{}, error) {
+	resp, ok := response.(endpoint.GradeDictationResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	return &pb.GradeDictationResponse{
+		DictationRecord: toPBDictationRecord(*resp.DictationRecord),
+	}, nil
+}
+
+func (s GRPCServer) FindDictationHistory(
+	ctx context.Context,
+	req *pb.FindDictationHistoryRequest,
+) (*pb.FindDictationHistoryResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, TIMEOUT)
+	defer cancel()
+	_, resp, err := s.findDictationHistory.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*pb.FindDictationHistoryResponse), nil
+}
+
+func decodeFindDictationHistoryRequest(
+	_ context.Context,
+	request interface{},
+) (interface{}, error) {
+	req, ok := request.(*pb.FindDictationHistoryRequest)
+	if !ok {
+		return nil, errors.New("invalid request body")
+	}
+
+	return endpoint.FindDictationHistoryRequest{
+		UserId: req.UserId,
+		Size:   req.Size,
+	}, nil
+}
+
+func encodeFindDictationHistoryResponse(
+	_ context.Context,
+	response interface{},
+) (interface{}, error) {
+	resp, ok := response.(endpoint.FindDictationHistoryResponse)
+	if !ok {
+		return nil, errors.New("invalid response body")
+	}
+
+	pbDictationRecords := []*pb.DictationRecord{}
+
+	for _, dictationRecord := range resp.DictationRecords {
+		pbDictationRecords = append(pbDictationRecords, toPBDictationRecord(dictationRecord))
+	}
+
+	return &pb.FindDictationHistoryResponse{
+		DictationRecords: pbDictationRecords,
+		AverageAccuracy:  resp.AverageAccuracy,
+	}, nil
+}
+
+func toPBDictationRecord(dictationRecord model.DictationRecord) *pb.DictationRecord {
+	pbDiffs := []*pb.DictationWordDiff{}
+
+	for _, diff := range dictationRecord.Diffs {
+		pbDiffs = append(pbDiffs, &pb.DictationWordDiff{
+			Type:     diff.Type,
+			Expected: diff.Expected,
+			Actual:   diff.Actual,
+		})
+	}
+
+	return &pb.DictationRecord{
+		Id:            dictationRecord.Id.Hex(),
+		UserId:        dictationRecord.UserId,
+		WordMeaningId: dictationRecord.WordMeaningId,
+		Word:          dictationRecord.Word,
+		AudioUrl:      dictationRecord.AudioUrl,
+		Text:          dictationRecord.Text,
+		Answer:        dictationRecord.Answer,
+		Accuracy:      dictationRecord.Accuracy,
+		WordCount:     dictationRecord.WordCount,
+		CorrectCount:  dictationRecord.CorrectCount,
+		Diffs:         pbDiffs,
+		CreatedAt:     timestamppb.New(dictationRecord.CreatedAt),
+		UpdatedAt:     timestamppb.New(dictationRecord.UpdatedAt),
+	}
+}
+
+func toPBLookupHistories(lookupHistories []model.LookupHistory) []*pb.LookupHistory {
+	pbLookupHistories := []*pb.LookupHistory{}
+
+	for _, lookupHistory := range lookupHistories {
+		pbLookupHistories = append(pbLookupHistories, &pb.LookupHistory{
+			Id:        lookupHistory.Id.Hex(),
+			UserId:    lookupHistory.UserId,
+			Word:      lookupHistory.Word,
+			Times:     lookupHistory.Times,
+			CreatedAt: timestamppb.New(lookupHistory.CreatedAt),
+			UpdatedAt: timestamppb.New(lookupHistory.UpdatedAt),
+		})
+	}
+
+	return pbLookupHistories
+}
+
 func toPBWordMeanings(wordMeanings []model.WordMeaning) []*pb.WordMeaning {
 	pbWordMeanings := []*pb.WordMeaning{}
 